@@ -3,16 +3,21 @@ package attestation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -45,6 +50,9 @@ type Service struct {
 	store              attestationStore
 	pooledAttestations []*ethpb.Attestation
 	poolLimit          int
+	// checkpointStates caches the states of the target checkpoints, whose committees
+	// designate the participants of the attestations.
+	checkpointStates *cache.CheckpointStateCache
 }
 
 // Config options for the service.
@@ -65,6 +73,7 @@ func NewAttestationService(ctx context.Context, cfg *Config) *Service {
 		store:              attestationStore{m: make(map[[48]byte]*ethpb.Attestation)},
 		pooledAttestations: make([]*ethpb.Attestation, 0, 1),
 		poolLimit:          1,
+		checkpointStates:   cache.NewCheckpointStateCache(),
 	}
 }
 
@@ -176,19 +185,12 @@ func (a *Service) handleAttestation(ctx context.Context, msg proto.Message) erro
 }
 
 // UpdateLatestAttestation inputs an new attestation and checks whether
-// the attesters who submitted this attestation with the higher slot number
+// the attesters who submitted this attestation with a higher target epoch
 // have been noted in the attestation pool. If not, it updates the
 // attestation pool with attester's public key to attestation.
 func (a *Service) UpdateLatestAttestation(ctx context.Context, attestation *ethpb.Attestation) error {
 	totalAttestationSeen.Inc()
-
-	// Potential improvement, instead of getting the state,
-	// we could get a mapping of validator index to public key.
-	beaconState, err := a.beaconDB.HeadState(ctx)
-	if err != nil {
-		return err
-	}
-	return a.updateAttestation(beaconState, attestation)
+	return a.updateAttestation(ctx, attestation)
 }
 
 // BatchUpdateLatestAttestation updates multiple attestations and adds them into the attestation store
@@ -198,15 +200,9 @@ func (a *Service) BatchUpdateLatestAttestation(ctx context.Context, attestations
 	if attestations == nil {
 		return nil
 	}
-	// Potential improvement, instead of getting the state,
-	// we could get a mapping of validator index to public key.
-	beaconState, err := a.beaconDB.HeadState(ctx)
-	if err != nil {
-		return err
-	}
 
 	for _, attestation := range attestations {
-		if err := a.updateAttestation(beaconState, attestation); err != nil {
+		if err := a.updateAttestation(ctx, attestation); err != nil {
 			log.Error(err)
 		}
 	}
@@ -222,72 +218,140 @@ func (a *Service) InsertAttestationIntoStore(pubkey [48]byte, att *ethpb.Attesta
 	a.store.m[pubkey] = att
 }
 
-func (a *Service) updateAttestation(beaconState *pb.BeaconState, attestation *ethpb.Attestation) error {
+// updateAttestation records the attestation as the latest message of each of its
+// participants, unless they already attested to a later target epoch. The participants
+// are found in the committees of the target checkpoint state, as in the spec's
+// on_attestation.
+//
+// Spec pseudocode definition:
+//	def on_attestation(store: Store, attestation: Attestation) -> None:
+//	    ...
+//	    # Get state at the `target` to validate attestation and calculate the committees
+//	    indexed_attestation = get_indexed_attestation(target_state, attestation)
+//	    assert is_valid_indexed_attestation(target_state, indexed_attestation)
+//
+//	    # Update latest messages
+//	    for i in indexed_attestation.custody_bit_0_indices + indexed_attestation.custody_bit_1_indices:
+//	        if i not in store.latest_messages or target.epoch > store.latest_messages[i].epoch:
+//	            store.latest_messages[i] = LatestMessage(epoch=target.epoch, root=attestation.data.beacon_block_root)
+func (a *Service) updateAttestation(ctx context.Context, attestation *ethpb.Attestation) error {
 	totalAttestationSeen.Inc()
 
-	committee, err := helpers.CrosslinkCommittee(beaconState, helpers.CurrentEpoch(beaconState), attestation.Data.Crosslink.Shard)
+	if attestation.Data == nil || attestation.Data.Target == nil || attestation.Data.Crosslink == nil {
+		return errors.New("attestation is missing its target or crosslink")
+	}
+	target := attestation.Data.Target
+	targetState, err := a.checkpointState(ctx, target)
 	if err != nil {
-		return err
+		return fmt.Errorf("could not get target checkpoint state: %v", err)
 	}
-	slot, err := helpers.AttestationDataSlot(beaconState, attestation.Data)
+
+	committee, err := helpers.CrosslinkCommittee(targetState, target.Epoch, attestation.Data.Crosslink.Shard)
 	if err != nil {
-		return fmt.Errorf("could not get attestation slot: %v", err)
+		return err
 	}
 	log.WithFields(logrus.Fields{
-		"attestationSlot":    slot,
+		"targetEpoch":        target.Epoch,
 		"attestationShard":   attestation.Data.Crosslink.Shard,
 		"committeesList":     committee,
 		"lengthOfCommittees": len(committee),
 	}).Debug("Updating latest attestation")
 
-	// Check each bit of participation bitfield to find out which
-	// attester has submitted new attestation.
-	// This is has O(n) run time and could be optimized down the line.
-	for i := uint64(0); i < attestation.AggregationBits.Len(); i++ {
-		if !attestation.AggregationBits.BitAt(i) {
-			continue
-		}
-
-		if i >= uint64(len(committee)) {
+	if attestation.AggregationBits.Len() > uint64(len(committee)) {
+		// This should never happen.
+		log.Warnf("bitfield points to an invalid index in the committee: bitfield %08b", attestation.AggregationBits)
+		return nil
+	}
+	indexedAtt, err := blocks.ConvertToIndexed(targetState, attestation)
+	if err != nil {
+		return fmt.Errorf("could not convert to indexed attestation: %v", err)
+	}
+	participants := make([]uint64, 0, len(indexedAtt.CustodyBit_0Indices)+len(indexedAtt.CustodyBit_1Indices))
+	participants = append(participants, indexedAtt.CustodyBit_0Indices...)
+	participants = append(participants, indexedAtt.CustodyBit_1Indices...)
+	for _, index := range participants {
+		if index >= uint64(len(targetState.Validators)) {
 			// This should never happen.
-			log.Warnf("bitfield points to an invalid index in the committee: bitfield %08b", attestation.AggregationBits)
+			log.Warnf("index doesn't exist in validator registry: index %d", index)
 			return nil
 		}
+	}
+	if err := blocks.VerifyIndexedAttestation(
+		targetState,
+		indexedAtt,
+		featureconfig.FeatureConfig().EnableBlockSignatureVerification,
+	); err != nil {
+		return fmt.Errorf("could not verify indexed attestation: %v", err)
+	}
 
-		if int(committee[i]) >= len(beaconState.Validators) {
-			// This should never happen.
-			log.Warnf("index doesn't exist in validator registry: index %d", committee[i])
-			return nil
-		}
+	blockRoot := bytesutil.ToBytes32(attestation.Data.BeaconBlockRoot)
+	votedBlock, err := a.beaconDB.Block(blockRoot)
+	if err != nil {
+		return err
+	}
 
-		// If the attestation came from this attester. We use the slot committee to find the
-		// validator's actual index.
-		pubkey := bytesutil.ToBytes48(beaconState.Validators[committee[i]].PublicKey)
-		newAttestationSlot := slot
-		currentAttestationSlot := uint64(0)
-		a.store.Lock()
-		defer a.store.Unlock()
-		if _, exists := a.store.m[pubkey]; exists {
-			currentAttestationSlot = slot
-		}
-		// If the attestation is newer than this attester's one in pool.
-		if newAttestationSlot > currentAttestationSlot {
-			a.store.m[pubkey] = attestation
-
-			log.WithFields(
-				logrus.Fields{
-					"attestationSlot": slot,
-					"sourceEpoch":     attestation.Data.Source.Epoch,
-				},
-			).Debug("Attestation store updated")
-
-			blockRoot := bytesutil.ToBytes32(attestation.Data.BeaconBlockRoot)
-			votedBlock, err := a.beaconDB.Block(blockRoot)
-			if err != nil {
-				return err
-			}
-			reportVoteMetrics(committee[i], votedBlock)
+	var updated []uint64
+	a.store.Lock()
+	for _, index := range participants {
+		pubkey := bytesutil.ToBytes48(targetState.Validators[index].PublicKey)
+		// The attestation replaces the latest message of the attester if it has a later target.
+		if latest, exists := a.store.m[pubkey]; exists && latest.GetData().GetTarget().GetEpoch() >= target.Epoch {
+			continue
 		}
+		a.store.m[pubkey] = attestation
+		updated = append(updated, index)
+	}
+	a.store.Unlock()
+
+	log.WithFields(logrus.Fields{
+		"targetEpoch":  target.Epoch,
+		"sourceEpoch":  attestation.Data.Source.Epoch,
+		"participants": len(updated),
+	}).Debug("Attestation store updated")
+	for _, index := range updated {
+		reportVoteMetrics(index, votedBlock)
 	}
 	return nil
 }
+
+// checkpointState returns the state of the checkpoint, which is the state of its block
+// advanced to the start slot of its epoch. The state is cached, and must not be modified.
+func (a *Service) checkpointState(ctx context.Context, checkpoint *ethpb.Checkpoint) (*pb.BeaconState, error) {
+	cached, err := a.checkpointStates.StateByCheckpoint(checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve checkpoint state from cache: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	block, err := a.beaconDB.Block(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve target block: %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("unknown target block %#x", bytesutil.Trunc(checkpoint.Root))
+	}
+	startSlot := helpers.StartSlot(checkpoint.Epoch)
+	if block.Slot > startSlot {
+		return nil, fmt.Errorf("target block slot %d is after the start slot %d of the target epoch", block.Slot, startSlot)
+	}
+	checkpointState, err := a.beaconDB.HistoricalStateFromSlot(ctx, block.Slot, root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve target block state: %v", err)
+	}
+	if checkpointState.Slot < startSlot {
+		checkpointState, err = state.ProcessSlots(ctx, checkpointState, startSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not process slots up to %d: %v", startSlot, err)
+		}
+	}
+	if err := a.checkpointStates.AddCheckpointState(&cache.CheckpointState{
+		Checkpoint: checkpoint,
+		State:      checkpointState,
+	}); err != nil {
+		return nil, fmt.Errorf("could not save checkpoint state to cache: %v", err)
+	}
+	return checkpointState, nil
+}
@@ -9,6 +9,9 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -25,6 +28,17 @@ func init() {
 
 var _ = TargetHandler(&Service{})
 
+// seedCheckpointState caches the state as the state of the checkpoint, so that the
+// participants of the attestations to the checkpoint are found in its committees.
+func seedCheckpointState(t *testing.T, service *Service, checkpoint *ethpb.Checkpoint, beaconState *pb.BeaconState) {
+	if err := service.checkpointStates.AddCheckpointState(&cache.CheckpointState{
+		Checkpoint: checkpoint,
+		State:      beaconState,
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestUpdateLatestAttestation_UpdatesLatest(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
@@ -55,6 +69,7 @@ func TestUpdateLatestAttestation_UpdatesLatest(t *testing.T) {
 		t.Fatal(err)
 	}
 	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	seedCheckpointState(t, service, &ethpb.Checkpoint{}, beaconState)
 
 	attestation := &ethpb.Attestation{
 		AggregationBits: bitfield.Bitlist{0x03},
@@ -246,6 +261,7 @@ func TestUpdateLatestAttestation_InvalidIndex(t *testing.T) {
 		t.Fatal(err)
 	}
 	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	seedCheckpointState(t, service, &ethpb.Checkpoint{}, beaconState)
 	attestation := &ethpb.Attestation{
 		AggregationBits: bitfield.Bitlist{0xC0, 0x01},
 		Data: &ethpb.AttestationData{
@@ -266,6 +282,49 @@ func TestUpdateLatestAttestation_InvalidIndex(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, wanted)
 }
 
+func TestUpdateLatestAttestation_RejectsInvalidIndexedAttestation(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	var validators []*ethpb.Validator
+	for i := 0; i < 64; i++ {
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:       []byte{byte(i)},
+			ActivationEpoch: 0,
+			ExitEpoch:       10,
+		})
+	}
+	beaconState := &pb.BeaconState{
+		Slot:             1,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		Validators:       validators,
+	}
+	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	seedCheckpointState(t, service, &ethpb.Checkpoint{}, beaconState)
+
+	// Custody bits are not allowed in phase 0, so the indexed attestation is invalid.
+	attestation := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0x03},
+		CustodyBits:     bitfield.Bitlist{0x03},
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{
+				Shard: 1,
+			},
+			Target: &ethpb.Checkpoint{},
+			Source: &ethpb.Checkpoint{},
+		},
+	}
+	want := "expected no bit 1 indices"
+	if err := service.UpdateLatestAttestation(ctx, attestation); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+	if len(service.store.m) != 0 {
+		t.Errorf("Expected the attestation store to be left untouched, received %d attestations", len(service.store.m))
+	}
+}
+
 func TestBatchUpdate_FromSync(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
@@ -351,6 +410,7 @@ func TestUpdateLatestAttestation_BatchUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	seedCheckpointState(t, service, &ethpb.Checkpoint{}, beaconState)
 	attestations := make([]*ethpb.Attestation, 0)
 	for i := 0; i < 10; i++ {
 		attestations = append(attestations, &ethpb.Attestation{
@@ -369,3 +429,93 @@ func TestUpdateLatestAttestation_BatchUpdate(t *testing.T) {
 		t.Fatalf("could not update latest attestation: %v", err)
 	}
 }
+
+func TestUpdateLatestAttestation_KeepsLatestTargetEpoch(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	var validators []*ethpb.Validator
+	for i := 0; i < 64; i++ {
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:       []byte{byte(i)},
+			ActivationEpoch: 0,
+			ExitEpoch:       10,
+		})
+	}
+	beaconState := &pb.BeaconState{
+		Slot:             1,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		Validators:       validators,
+	}
+	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	seedCheckpointState(t, service, &ethpb.Checkpoint{Epoch: 0}, beaconState)
+	seedCheckpointState(t, service, &ethpb.Checkpoint{Epoch: 1}, beaconState)
+
+	startShard, err := helpers.StartShard(beaconState, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newer := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0x03},
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{Shard: startShard},
+			Target:    &ethpb.Checkpoint{Epoch: 1},
+			Source:    &ethpb.Checkpoint{},
+		},
+	}
+	if err := service.UpdateLatestAttestation(ctx, newer); err != nil {
+		t.Fatal(err)
+	}
+	indexedAtt, err := blocks.ConvertToIndexed(beaconState, newer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indexedAtt.CustodyBit_0Indices) != 1 {
+		t.Fatalf("Expected 1 participant, received %d", len(indexedAtt.CustodyBit_0Indices))
+	}
+	attester := indexedAtt.CustodyBit_0Indices[0]
+	pubkey := bytesutil.ToBytes48(validators[attester].PublicKey)
+	if service.store.m[pubkey] != newer {
+		t.Fatalf("Expected the attestation of validator %d to be stored", attester)
+	}
+
+	// An attestation of the same validator to an earlier target does not replace it.
+	committeeCount, err := helpers.CommitteeCount(beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var older *ethpb.Attestation
+	for shard := uint64(0); shard < committeeCount; shard++ {
+		committee, err := helpers.CrosslinkCommittee(beaconState, 0, shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, index := range committee {
+			if index != attester {
+				continue
+			}
+			aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+			aggregationBits.SetBitAt(uint64(i), true)
+			older = &ethpb.Attestation{
+				AggregationBits: aggregationBits,
+				Data: &ethpb.AttestationData{
+					Crosslink: &ethpb.Crosslink{Shard: shard},
+					Target:    &ethpb.Checkpoint{Epoch: 0},
+					Source:    &ethpb.Checkpoint{},
+				},
+			}
+		}
+	}
+	if older == nil {
+		t.Fatalf("Could not find the committee of validator %d in the previous epoch", attester)
+	}
+	if err := service.UpdateLatestAttestation(ctx, older); err != nil {
+		t.Fatal(err)
+	}
+	if service.store.m[pubkey] != newer {
+		t.Errorf("Expected the attestation to the latest target to be kept")
+	}
+}
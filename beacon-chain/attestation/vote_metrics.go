@@ -4,27 +4,27 @@ import (
 	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 var (
-	validatorLastVoteGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	validatorLastVoteGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "validators_last_vote",
 		Help: "Votes of validators, updated when there's a new attestation",
 	}, []string{
 		"validatorIndex",
 	})
-	totalAttestationSeen = promauto.NewGauge(prometheus.GaugeOpts{
+	totalAttestationSeen = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "total_seen_attestations",
 		Help: "Total number of attestations seen by the validators",
 	})
 
-	attestationPoolLimit = promauto.NewGauge(prometheus.GaugeOpts{
+	attestationPoolLimit = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "attestation_pool_limit",
 		Help: "The limit of the attestation pool",
 	})
-	attestationPoolSize = promauto.NewGauge(prometheus.GaugeOpts{
+	attestationPoolSize = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "attestation_pool_size",
 		Help: "The current size of the attestation pool",
 	})
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/segment"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/urfave/cli"
+)
+
+var (
+	benchmarkSegmentFlag = cli.StringFlag{
+		Name:  "segment",
+		Usage: "Path to the directory of the recorded chain segment, whose first block and its state are the starting point of the import",
+	}
+	benchmarkDataDirFlag = cli.StringFlag{
+		Name:  "datadir",
+		Usage: "Directory under which the database of the benchmark is created, a temporary directory if not set. Set it to compare storage backends.",
+	}
+)
+
+// benchmarkCommand measures the throughput of the block processing pipeline.
+var benchmarkCommand = cli.Command{
+	Name:  "benchmark",
+	Usage: "import a recorded chain segment through the block processing pipeline and report its throughput",
+	Flags: []cli.Flag{
+		benchmarkSegmentFlag,
+		benchmarkDataDirFlag,
+	},
+	Action: runBenchmark,
+}
+
+func runBenchmark(ctx *cli.Context) error {
+	dir := ctx.String(benchmarkSegmentFlag.Name)
+	if dir == "" {
+		return fmt.Errorf("--%s is required", benchmarkSegmentFlag.Name)
+	}
+	blocks, err := segment.ReadBlocks(dir)
+	if err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return fmt.Errorf("no blocks in segment %s", dir)
+	}
+	anchorState, err := segment.ReadState(dir, blocks[0].Slot)
+	if err != nil {
+		return err
+	}
+	if anchorState == nil {
+		return fmt.Errorf("no state recorded for the first block of the segment at slot %d", blocks[0].Slot)
+	}
+
+	dataDir := ctx.String(benchmarkDataDirFlag.Name)
+	if dataDir == "" {
+		dataDir, err = ioutil.TempDir("", "benchmark")
+		if err != nil {
+			return fmt.Errorf("could not create data directory: %v", err)
+		}
+		defer os.RemoveAll(dataDir)
+	}
+	res, err := simulator.Benchmark(context.Background(), dataDir, blocks[0], anchorState, blocks[1:])
+	if err != nil {
+		return err
+	}
+	return printBenchmarkResult(res)
+}
+
+// printBenchmarkResult prints the throughput, the time spent in each stage of processing,
+// slowest first, and the allocations of the benchmark.
+func printBenchmarkResult(res *simulator.BenchmarkResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "blocks\t%d\n", res.Blocks)
+	fmt.Fprintf(w, "elapsed\t%v\n", res.Elapsed)
+	fmt.Fprintf(w, "blocks/sec\t%.2f\n", res.BlocksPerSecond())
+
+	stages := make([]string, 0, len(res.Stages))
+	for stage := range res.Stages {
+		stages = append(stages, stage)
+	}
+	sort.Slice(stages, func(i, j int) bool { return res.Stages[stages[i]] > res.Stages[stages[j]] })
+	for _, stage := range stages {
+		var perBlock time.Duration
+		if res.Blocks > 0 {
+			perBlock = res.Stages[stage] / time.Duration(res.Blocks)
+		}
+		fmt.Fprintf(w, "stage %s\t%v\t%v/block\n", stage, res.Stages[stage], perBlock)
+	}
+
+	fmt.Fprintf(w, "allocs\t%d\n", res.Allocs)
+	fmt.Fprintf(w, "alloc bytes\t%d\n", res.AllocBytes)
+	fmt.Fprintf(w, "gc cycles\t%d\n", res.GCCycles)
+	return w.Flush()
+}
@@ -0,0 +1,152 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// ArchiveHead archives the epochs entered by the canonical chain up to the new head, when
+// running in archive mode. It is called whenever the head of the chain is updated, so
+// that only the epochs of the canonical chain are archived, from the state at the start
+// of each epoch. An epoch is archived again if a reorg changes the block its starting
+// state descends from. The first head update after the node starts archives the epoch
+// of the head only.
+func (c *ChainService) ArchiveHead(ctx context.Context, head *ethpb.BeaconBlock, headState *pb.BeaconState) error {
+	if !c.archive {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ArchiveHead")
+	defer span.End()
+	c.archiveLock.Lock()
+	defer c.archiveLock.Unlock()
+
+	headEpoch := helpers.CurrentEpoch(headState)
+	start := headEpoch
+	if c.archivedAny && c.archivedEpoch < headEpoch {
+		start = c.archivedEpoch + 1
+	}
+
+	// The ancestors of the head from the last block before the start of the first epoch
+	// to archive, ordered by slot.
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return fmt.Errorf("could not hash head block: %v", err)
+	}
+	roots := [][32]byte{headRoot}
+	blocks := []*ethpb.BeaconBlock{head}
+	for block := head; block.Slot >= helpers.StartSlot(start) && block.Slot > 0; {
+		parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+		parent, err := c.beaconDB.Block(parentRoot)
+		if err != nil {
+			return fmt.Errorf("could not retrieve parent block: %v", err)
+		}
+		if parent == nil {
+			return fmt.Errorf("parent block %#x of block at slot %d is missing", bytesutil.Trunc(parentRoot[:]), block.Slot)
+		}
+		roots = append([][32]byte{parentRoot}, roots...)
+		blocks = append([]*ethpb.BeaconBlock{parent}, blocks...)
+		block = parent
+	}
+
+	i := 0
+	for epoch := start; epoch <= headEpoch; epoch++ {
+		startSlot := helpers.StartSlot(epoch)
+		// The last block before the start of the epoch, whose post state is processed up
+		// to the start of the epoch.
+		for i+1 < len(blocks) && blocks[i+1].Slot < startSlot {
+			i++
+		}
+		if c.archivedAny && epoch == c.archivedEpoch && roots[i] == c.archivedRoot {
+			continue
+		}
+		epochState, err := c.epochStartState(ctx, epoch, blocks[i], roots[i])
+		if err != nil {
+			return err
+		}
+		if epochState == nil {
+			log.WithField("epoch", epoch).Warn("Could not archive epoch, its state is no longer available")
+			continue
+		}
+		if err := c.archiveEpoch(ctx, epochState); err != nil {
+			return fmt.Errorf("could not archive epoch %d: %v", epoch, err)
+		}
+		c.archivedAny = true
+		c.archivedEpoch = epoch
+		c.archivedRoot = roots[i]
+	}
+	// Epochs past the head after a reorg to an earlier epoch are archived again once the
+	// new head enters them.
+	if c.archivedAny && c.archivedEpoch > headEpoch {
+		c.archivedEpoch = headEpoch
+	}
+	return nil
+}
+
+// epochStartState returns the state at the start of the epoch, from the post state of the
+// given block processed up to the start of the epoch. It returns nil if the post state of
+// the block is no longer available.
+func (c *ChainService) epochStartState(ctx context.Context, epoch uint64, block *ethpb.BeaconBlock, blockRoot [32]byte) (*pb.BeaconState, error) {
+	beaconState, err := c.beaconDB.StateByBlockRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve state of block at slot %d: %v", block.Slot, err)
+	}
+	if beaconState == nil {
+		return nil, nil
+	}
+	if startSlot := helpers.StartSlot(epoch); beaconState.Slot < startSlot {
+		beaconState, err = state.ProcessSlots(ctx, beaconState, startSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not process slots up to epoch %d: %v", epoch, err)
+		}
+	}
+	return beaconState, nil
+}
+
+// archiveEpoch persists the state at the start of its epoch along with the balances,
+// committees and proposers of the epoch, so that an archive node can answer queries about
+// any past epoch.
+func (c *ChainService) archiveEpoch(ctx context.Context, beaconState *pb.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.archiveEpoch")
+	defer span.End()
+
+	epoch := helpers.CurrentEpoch(beaconState)
+	if err := c.beaconDB.SaveArchivedState(ctx, epoch, beaconState); err != nil {
+		return fmt.Errorf("could not archive state: %v", err)
+	}
+	if err := c.beaconDB.SaveArchivedBalances(ctx, epoch, beaconState.Balances); err != nil {
+		return fmt.Errorf("could not archive validator balances: %v", err)
+	}
+
+	activeCount, err := helpers.ActiveValidatorCount(beaconState, epoch)
+	if err != nil {
+		return fmt.Errorf("could not get active validator count: %v", err)
+	}
+	committees, err := helpers.EpochCommittees(beaconState, epoch)
+	if err != nil {
+		return err
+	}
+	if err := c.beaconDB.SaveArchivedCommittees(ctx, &ethpb.BeaconCommittees{
+		Epoch:                epoch,
+		Committees:           committees,
+		ActiveValidatorCount: activeCount,
+	}); err != nil {
+		return fmt.Errorf("could not archive committees: %v", err)
+	}
+
+	proposers, err := helpers.EpochProposers(beaconState, epoch)
+	if err != nil {
+		return err
+	}
+	if err := c.beaconDB.SaveArchivedProposers(ctx, epoch, proposers); err != nil {
+		return fmt.Errorf("could not archive proposers: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// saveArchiveTestBlock saves a child block of the parent at the slot along with its post
+// state, which is the parent state processed up to the slot.
+func saveArchiveTestBlock(t *testing.T, cs *ChainService, parentRoot [32]byte, parentState *pb.BeaconState, slot uint64) (*ethpb.BeaconBlock, [32]byte, *pb.BeaconState) {
+	ctx := context.Background()
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		Body:       &ethpb.BeaconBlockBody{},
+	}
+	if err := cs.beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postState, err := state.ProcessSlots(ctx, proto.Clone(parentState).(*pb.BeaconState), slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.beaconDB.SaveStateByBlockRoot(ctx, root, postState); err != nil {
+		t.Fatal(err)
+	}
+	return block, root, postState
+}
+
+func TestArchiveHead_ArchivesEpochsOfCanonicalHead(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	cs := setupBeaconChain(t, db, nil)
+	cs.archive = true
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 64)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateByBlockRoot(ctx, genesisRoot, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first head update only archives the epoch of the head, from the state at its start.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	head, headRoot, headState := saveArchiveTestBlock(t, cs, genesisRoot, genesisState, slotsPerEpoch+1)
+	if err := cs.ArchiveHead(ctx, head, headState); err != nil {
+		t.Fatal(err)
+	}
+	for epoch, archived := range map[uint64]bool{0: false, 1: true} {
+		archivedState, err := db.ArchivedState(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (archivedState != nil) != archived {
+			t.Fatalf("Expected epoch %d archived: %v, got state %v", epoch, archived, archivedState)
+		}
+		if archived && archivedState.Slot != helpers.StartSlot(epoch) {
+			t.Errorf("Expected state archived at slot %d, got %d", helpers.StartSlot(epoch), archivedState.Slot)
+		}
+	}
+	balances, err := db.ArchivedBalances(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != len(deposits) {
+		t.Errorf("Expected %d archived balances, got %d", len(deposits), len(balances))
+	}
+
+	// A head skipping an epoch archives every epoch it entered.
+	head, _, headState = saveArchiveTestBlock(t, cs, headRoot, headState, 3*slotsPerEpoch)
+	if err := cs.ArchiveHead(ctx, head, headState); err != nil {
+		t.Fatal(err)
+	}
+	for _, epoch := range []uint64{2, 3} {
+		archivedState, err := db.ArchivedState(ctx, epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if archivedState == nil || archivedState.Slot != helpers.StartSlot(epoch) {
+			t.Errorf("Expected state of epoch %d to be archived at its start, got %v", epoch, archivedState)
+		}
+	}
+}
+
+func TestArchiveHead_NotInArchiveMode(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	cs := setupBeaconChain(t, db, nil)
+	headState := &pb.BeaconState{Slot: params.BeaconConfig().SlotsPerEpoch}
+	if err := cs.ArchiveHead(ctx, &ethpb.BeaconBlock{Slot: headState.Slot}, headState); err != nil {
+		t.Fatal(err)
+	}
+	archivedState, err := db.ArchivedState(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archivedState != nil {
+		t.Error("Expected no state to be archived outside of archive mode")
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/prysmaticlabs/go-ssz"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
@@ -15,6 +17,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -22,7 +25,7 @@ import (
 // BlockReceiver interface defines the methods in the blockchain service which
 // directly receives a new block from other services and applies the full processing pipeline.
 type BlockReceiver interface {
-	CanonicalBlockFeed() *event.Feed
+	CanonicalBlockFeed() *event.Topic
 	ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error)
 	IsCanonical(slot uint64, hash []byte) bool
 	UpdateCanonicalRoots(block *ethpb.BeaconBlock, root [32]byte)
@@ -34,8 +37,12 @@ type BlockProcessor interface {
 	VerifyBlockValidity(ctx context.Context, block *ethpb.BeaconBlock, beaconState *pb.BeaconState) error
 	AdvanceState(ctx context.Context, beaconState *pb.BeaconState, block *ethpb.BeaconBlock) (*pb.BeaconState, error)
 	CleanupBlockOperations(ctx context.Context, block *ethpb.BeaconBlock) error
+	ArchiveHead(ctx context.Context, head *ethpb.BeaconBlock, headState *pb.BeaconState) error
 }
 
+// ErrParentNotFound is returned when a received block's parent does not exist in the DB.
+var ErrParentNotFound = errors.New("parent does not exist in DB")
+
 // BlockFailedProcessingErr represents a block failing a state transition function.
 type BlockFailedProcessingErr struct {
 	err error
@@ -49,7 +56,8 @@ func (b *BlockFailedProcessingErr) Error() string {
 // any block that is received from p2p layer or rpc. It performs the following actions: It checks the block to see
 // 1. Verify a block passes pre-processing conditions
 // 2. Save and broadcast the block via p2p to other peers
-// 3. Apply the block state transition function and account for skip slots.
+// 3. Apply the block state transition function and account for skip slots, and insert
+//    the block into the fork choice store.
 // 4. Process and cleanup any block operations, such as attestations and deposits, which would need to be
 //    either included or flushed from the beacon node's runtime.
 func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
@@ -57,18 +65,20 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 	defer c.receiveBlockLock.Unlock()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.ReceiveBlock")
 	defer span.End()
+	start := time.Now()
 	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
 	parent, err := c.beaconDB.Block(parentRoot)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get parent block: %v", err)
 	}
 	if parent == nil {
-		return nil, errors.New("parent does not exist in DB")
+		return nil, ErrParentNotFound
 	}
-	beaconState, err := c.beaconDB.HistoricalStateFromSlot(ctx, parent.Slot, parentRoot)
+	beaconState, err := c.blockState(ctx, parent.Slot, parentRoot)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
 	}
+	start = c.timeStage("load_state", start)
 
 	blockRoot, err := ssz.SigningRoot(block)
 	if err != nil {
@@ -78,6 +88,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 	if err := c.VerifyBlockValidity(ctx, block, beaconState); err != nil {
 		return beaconState, fmt.Errorf("block with slot %d is not ready for processing: %v", block.Slot, err)
 	}
+	start = c.timeStage("verify", start)
 
 	// We save the block to the DB and broadcast it to our peers.
 	if err := c.SaveAndBroadcastBlock(ctx, block); err != nil {
@@ -86,6 +97,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 			block.Slot, err,
 		)
 	}
+	start = c.timeStage("save", start)
 
 	log.WithField("slot", block.Slot).Info("Executing state transition")
 
@@ -105,6 +117,8 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 		}
 	}
 
+	start = c.timeStage("state_transition", start)
+
 	log.WithFields(logrus.Fields{
 		"slot":  block.Slot,
 		"epoch": helpers.SlotToEpoch(block.Slot),
@@ -118,12 +132,23 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 	if !bytes.Equal(block.StateRoot, stateRoot[:]) {
 		return nil, fmt.Errorf("beacon state root is not equal to block state root: %#x != %#x", stateRoot, block.StateRoot)
 	}
+	start = c.timeStage("state_root", start)
+
+	// The valid block is a candidate head of the fork choice.
+	if err := c.insertForkChoiceBlock(block, beaconState); err != nil {
+		return beaconState, fmt.Errorf("could not insert block into fork choice store: %v", err)
+	}
 
 	// We process the block's contained deposits, attestations, and other operations
 	// and that may need to be stored or deleted from the beacon node's persistent storage.
 	if err := c.CleanupBlockOperations(ctx, block); err != nil {
 		return beaconState, fmt.Errorf("could not process block deposits, attestations, and other operations: %v", err)
 	}
+	c.timeStage("cleanup", start)
+
+	if err := c.saveDepositInclusions(ctx, block, blockRoot, beaconState); err != nil {
+		return beaconState, fmt.Errorf("could not save deposit inclusions: %v", err)
+	}
 
 	log.WithFields(logrus.Fields{
 		"slot":         block.Slot,
@@ -134,6 +159,16 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 	return beaconState, nil
 }
 
+// timeStage reports the time elapsed since the start of a stage of ReceiveBlock to the
+// stage timer of the service, if any, and returns the start of the next stage.
+func (c *ChainService) timeStage(stage string, start time.Time) time.Time {
+	now := time.Now()
+	if c.stageTimer != nil {
+		c.stageTimer(stage, now.Sub(start))
+	}
+	return now
+}
+
 // VerifyBlockValidity cross-checks the block against the pre-processing conditions from
 // Ethereum 2.0, namely:
 //   The parent block with root block.parent_root has been processed and accepted.
@@ -213,12 +248,24 @@ func (c *ChainService) AdvanceState(
 	block *ethpb.BeaconBlock,
 ) (*pb.BeaconState, error) {
 	finalizedEpoch := beaconState.FinalizedCheckpoint.Epoch
+	// The participation of the previous epoch is final once the state transitions into
+	// a new epoch, and it has to be computed before the transition rotates out its
+	// attestations.
+	var participation *ethpb.ValidatorParticipation
+	if block != nil && helpers.SlotToEpoch(block.Slot) > helpers.CurrentEpoch(beaconState) {
+		var err error
+		participation, err = epoch.PreviousEpochParticipation(beaconState)
+		if err != nil {
+			return beaconState, fmt.Errorf("could not compute validator participation: %v", err)
+		}
+	}
 	newState, err := state.ExecuteStateTransition(
 		ctx,
 		beaconState,
 		block,
 		&state.TransitionConfig{
-			VerifySignatures: false, // We disable signature verification for now.
+			VerifySignatures:      featureconfig.FeatureConfig().EnableBlockSignatureVerification,
+			BatchVerifySignatures: true,
 		},
 	)
 	if err != nil {
@@ -243,8 +290,8 @@ func (c *ChainService) AdvanceState(
 		if err != nil {
 			return nil, err
 		}
-		// Save Historical States.
-		if err := c.beaconDB.SaveHistoricalState(ctx, beaconState, blockRoot); err != nil {
+		// Save the post state of the block, which is the pre state of its children.
+		if err := c.beaconDB.SaveStateByBlockRoot(ctx, blockRoot, newState); err != nil {
 			return nil, fmt.Errorf("could not save historical state: %v", err)
 		}
 	}
@@ -262,11 +309,52 @@ func (c *ChainService) AdvanceState(
 		if err := c.updateFFGCheckPts(ctx, newState); err != nil {
 			return newState, fmt.Errorf("could not update FFG checkpts: %v", err)
 		}
+		if participation != nil {
+			if err := c.beaconDB.SaveArchivedParticipation(ctx, participation.Epoch, participation); err != nil {
+				return newState, fmt.Errorf("could not archive validator participation: %v", err)
+			}
+		}
 		logEpochData(newState)
+		logValidatorActivations(newState)
+		if err := reportEpochMetrics(newState, participation); err != nil {
+			log.WithError(err).Error("Could not report epoch metrics")
+		}
 	}
 	return newState, nil
 }
 
+// saveDepositInclusions records the block as the block including the deposits it contains,
+// so that the progress of a deposit towards activation can be looked up by public key.
+func (c *ChainService) saveDepositInclusions(
+	ctx context.Context,
+	block *ethpb.BeaconBlock,
+	blockRoot [32]byte,
+	postState *pb.BeaconState,
+) error {
+	if len(block.Body.Deposits) == 0 {
+		return nil
+	}
+	validatorIndices := make(map[[48]byte]int, len(postState.Validators))
+	for i, v := range postState.Validators {
+		validatorIndices[bytesutil.ToBytes48(v.PublicKey)] = i
+	}
+	for _, dep := range block.Body.Deposits {
+		pubkey := dep.Data.PublicKey
+		if err := c.beaconDB.SaveDepositInclusion(ctx, pubkey, block.Slot, blockRoot); err != nil {
+			return err
+		}
+		fields := logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", pubkey),
+			"slot":      block.Slot,
+		}
+		if idx, ok := validatorIndices[bytesutil.ToBytes48(pubkey)]; ok {
+			fields["validatorIndex"] = idx
+		}
+		log.WithFields(fields).Info("Deposit included in block")
+	}
+	return nil
+}
+
 // saveValidatorIdx saves the validators public key to index mapping in DB, these
 // validators were activated from current epoch. After it saves, current epoch key
 // is deleted from ActivatedValidators mapping.
@@ -333,3 +421,30 @@ func logEpochData(beaconState *pb.BeaconState) {
 		"SlotsSinceGenesis", beaconState.Slot,
 	).Info("Epoch transition successfully processed")
 }
+
+// logValidatorActivations logs the validators whose activation progressed during the
+// epoch transition into the current epoch of the state: validators which became eligible
+// for activation, validators scheduled for activation and validators activated.
+func logValidatorActivations(beaconState *pb.BeaconState) {
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	if currentEpoch == 0 {
+		return
+	}
+	prevEpoch := currentEpoch - 1
+	scheduledEpoch := helpers.DelayedActivationExitEpoch(prevEpoch)
+	for i, v := range beaconState.Validators {
+		fields := logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", v.PublicKey),
+			"validatorIndex": i,
+		}
+		if v.ActivationEligibilityEpoch == prevEpoch {
+			log.WithFields(fields).Info("Validator eligible for activation")
+		}
+		if v.ActivationEpoch == scheduledEpoch {
+			log.WithFields(fields).WithField("activationEpoch", v.ActivationEpoch).Info("Validator scheduled for activation")
+		}
+		if v.ActivationEpoch == currentEpoch {
+			log.WithFields(fields).Info("Validator activated")
+		}
+	}
+}
@@ -641,7 +641,15 @@ func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 	if len(db.PendingDeposits(chainService.ctx, nil)) != 0 {
 		t.Fatalf("Expected 0 pending deposits, but there are %+v", db.PendingDeposits(chainService.ctx, nil))
 	}
+	inclusion, err := db.DepositInclusion(ctx, pendingDeposits[0].Data.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion == nil || inclusion.Slot != block.Slot || inclusion.BlockRoot != blockRoot {
+		t.Errorf("Expected deposit to be included in block %#x at slot %d, received %+v", blockRoot, block.Slot, inclusion)
+	}
 	testutil.AssertLogsContain(t, hook, "Executing state transition")
+	testutil.AssertLogsContain(t, hook, "Deposit included in block")
 }
 
 // Scenario graph: http://bit.ly/2K1k2KZ
@@ -1055,3 +1063,25 @@ func TestSaveValidatorIdx_IdxNotInState(t *testing.T) {
 		t.Error("Did not get wanted validator from activation queue")
 	}
 }
+
+func TestLogValidatorActivations(t *testing.T) {
+	hook := logTest.NewGlobal()
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	beaconState := &pb.BeaconState{
+		Slot: 5 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: []*ethpb.Validator{
+			{ActivationEligibilityEpoch: 4, ActivationEpoch: farFutureEpoch},
+			{ActivationEligibilityEpoch: 3, ActivationEpoch: helpers.DelayedActivationExitEpoch(4)},
+			{ActivationEligibilityEpoch: 0, ActivationEpoch: 5},
+			{ActivationEligibilityEpoch: 0, ActivationEpoch: 0},
+		},
+	}
+	logValidatorActivations(beaconState)
+
+	testutil.AssertLogsContain(t, hook, "Validator eligible for activation")
+	testutil.AssertLogsContain(t, hook, "Validator scheduled for activation")
+	testutil.AssertLogsContain(t, hook, "Validator activated")
+	if len(hook.AllEntries()) != 3 {
+		t.Errorf("Expected 3 log entries, received %d", len(hook.AllEntries()))
+	}
+}
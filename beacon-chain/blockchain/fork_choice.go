@@ -7,20 +7,21 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
 var (
-	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+	reorgCount = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "reorg_counter",
 		Help: "The number of chain reorganization events that have happened in the fork choice rule",
 	})
@@ -73,7 +74,7 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 			return err
 		}
 		// Fetch justified state from historical states db.
-		newJustifiedState, err := c.beaconDB.HistoricalStateFromSlot(ctx, newJustifiedBlock.Slot, newJustifiedRoot)
+		newJustifiedState, err := c.blockState(ctx, newJustifiedBlock.Slot, newJustifiedRoot)
 		if err != nil {
 			return err
 		}
@@ -116,7 +117,7 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 		}
 		// Generate the new finalized state with using new finalized block and
 		// save it.
-		newFinalizedState, err := c.beaconDB.HistoricalStateFromSlot(ctx, lastFinalizedSlot, newFinalizedRoot)
+		newFinalizedState, err := c.blockState(ctx, lastFinalizedSlot, newFinalizedRoot)
 		if err != nil {
 			return err
 		}
@@ -126,6 +127,9 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 		if err := c.beaconDB.SaveFinalizedState(newFinalizedState); err != nil {
 			return err
 		}
+		if err := c.beaconDB.PruneFinalizedDeposits(ctx, int(newFinalizedState.Eth1DepositIndex)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -155,6 +159,13 @@ func (c *ChainService) ApplyForkChoiceRule(
 	if err != nil {
 		return fmt.Errorf("could not retrieve justified head: %v", err)
 	}
+	finalizedBlock, err := c.beaconDB.FinalizedBlock()
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	if err := c.pruneForkChoiceStore(ctx, finalizedBlock); err != nil {
+		return fmt.Errorf("could not update fork choice store: %v", err)
+	}
 
 	newHead, err := c.lmdGhost(ctx, justifiedHead, justifiedState, attestationTargets)
 	if err != nil {
@@ -191,7 +202,7 @@ func (c *ChainService) ApplyForkChoiceRule(
 			"newRoot":     fmt.Sprintf("%#x", bytesutil.Trunc(newHeadRoot[:])),
 		}).Warn("Reorg happened")
 		// Only regenerate head state if there was a reorg.
-		newState, err = c.beaconDB.HistoricalStateFromSlot(ctx, newHead.Slot, newHeadRoot)
+		newState, err = c.blockState(ctx, newHead.Slot, newHeadRoot)
 		if err != nil {
 			return fmt.Errorf("could not gen state: %v", err)
 		}
@@ -211,7 +222,7 @@ func (c *ChainService) ApplyForkChoiceRule(
 
 	// If we receive forked blocks.
 	if newHead.Slot != newState.Slot {
-		newState, err = c.beaconDB.HistoricalStateFromSlot(ctx, newHead.Slot, newHeadRoot)
+		newState, err = c.blockState(ctx, newHead.Slot, newHeadRoot)
 		if err != nil {
 			return fmt.Errorf("could not gen state: %v", err)
 		}
@@ -220,6 +231,9 @@ func (c *ChainService) ApplyForkChoiceRule(
 	if err := c.beaconDB.UpdateChainHead(ctx, newHead, newState); err != nil {
 		return fmt.Errorf("failed to update chain: %v", err)
 	}
+	if err := c.ArchiveHead(ctx, newHead, newState); err != nil {
+		return fmt.Errorf("could not archive chain head: %v", err)
+	}
 	h, err := ssz.SigningRoot(newHead)
 	if err != nil {
 		return fmt.Errorf("could not hash head: %v", err)
@@ -230,11 +244,17 @@ func (c *ChainService) ApplyForkChoiceRule(
 		"stateSlot": newState.Slot,
 	}).Info("Chain head block and state updated")
 
+	if !proto.Equal(currentHead, newHead) {
+		c.headUpdatedFeed.Send(newHead)
+	}
 	return nil
 }
 
 // lmdGhost applies the Latest Message Driven, Greediest Heaviest Observed Sub-Tree
 // fork-choice rule defined in the Ethereum Serenity specification for the beacon chain.
+// Rather than counting the votes of every child at every step as the specification does,
+// the weights of the blocks are kept in the fork choice store and only updated with the
+// votes and balances which changed since the previous run.
 //
 // Spec pseudocode definition:
 //	def lmd_ghost(store: Store, start_state: BeaconState, start_block: BeaconBlock) -> BeaconBlock:
@@ -267,42 +287,143 @@ func (c *ChainService) lmdGhost(
 	startState *pb.BeaconState,
 	voteTargets map[uint64]*pb.AttestationTarget,
 ) (*ethpb.BeaconBlock, error) {
-	highestSlot := c.beaconDB.HighestBlockSlot()
-	head := startBlock
-	for {
-		children, err := c.BlockChildren(ctx, head, highestSlot)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch block children: %v", err)
-		}
-		if len(children) == 0 {
-			return head, nil
-		}
-		maxChild := children[0]
+	c.forkChoiceLock.Lock()
+	defer c.forkChoiceLock.Unlock()
+
+	if err := c.updateForkChoiceStore(ctx, startBlock); err != nil {
+		return nil, fmt.Errorf("could not update fork choice store: %v", err)
+	}
+	// Only the votes which changed since the previous run move weight between blocks.
+	for validatorIndex, target := range voteTargets {
+		c.forkChoiceStore.ProcessVote(validatorIndex, bytesutil.ToBytes32(target.BeaconBlockRoot))
+	}
+	balances := make([]uint64, len(startState.Validators))
+	for i, v := range startState.Validators {
+		balances[i] = v.EffectiveBalance
+	}
+	startRoot, err := ssz.SigningRoot(startBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash start block: %v", err)
+	}
+	headRoot, err := c.forkChoiceStore.Head(startRoot, balances)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute head: %v", err)
+	}
+	head, err := c.beaconDB.Block(headRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head block: %v", err)
+	}
+	if head == nil {
+		return nil, fmt.Errorf("head block %#x does not exist", bytesutil.Trunc(headRoot[:]))
+	}
+	return head, nil
+}
 
-		maxChildVotes, err := VoteCount(maxChild, startState, voteTargets, c.beaconDB)
+// insertForkChoiceBlock inserts a processed block into the fork choice store, along with
+// the checkpoints of its post state. If the parent of the block is not in the store, as
+// for the first block received after initial sync or a restart, the store is marked to be
+// rebuilt from the database at its next update.
+func (c *ChainService) insertForkChoiceBlock(block *ethpb.BeaconBlock, postState *pb.BeaconState) error {
+	c.forkChoiceLock.Lock()
+	defer c.forkChoiceLock.Unlock()
+
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	if !c.forkChoiceStore.ProcessBlock(
+		blockRoot,
+		bytesutil.ToBytes32(block.ParentRoot),
+		postState.GetCurrentJustifiedCheckpoint().GetEpoch(),
+		postState.GetFinalizedCheckpoint().GetEpoch(),
+	) {
+		c.forkChoiceStale = true
+	}
+	return nil
+}
+
+// pruneForkChoiceStore updates the fork choice store and prunes the blocks preceding the
+// finalized block.
+func (c *ChainService) pruneForkChoiceStore(ctx context.Context, finalizedBlock *ethpb.BeaconBlock) error {
+	c.forkChoiceLock.Lock()
+	defer c.forkChoiceLock.Unlock()
+
+	if err := c.updateForkChoiceStore(ctx, finalizedBlock); err != nil {
+		return err
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalizedBlock)
+	if err != nil {
+		return fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	return c.forkChoiceStore.Prune(finalizedRoot)
+}
+
+// updateForkChoiceStore rebuilds the fork choice store from the root block and its
+// descendants saved in the database, if the store does not contain the root block or
+// misses blocks which were not received through ReceiveBlock, such as the blocks saved by
+// initial sync. The store is otherwise fed by ReceiveBlock and left untouched. The caller
+// must hold the fork choice lock.
+func (c *ChainService) updateForkChoiceStore(ctx context.Context, rootBlock *ethpb.BeaconBlock) error {
+	rootBlockRoot, err := ssz.SigningRoot(rootBlock)
+	if err != nil {
+		return fmt.Errorf("could not hash root block: %v", err)
+	}
+	if c.forkChoiceStore.HasNode(rootBlockRoot) && !c.forkChoiceStale {
+		return nil
+	}
+
+	store := forkchoice.NewStore()
+	justifiedEpoch, finalizedEpoch, err := c.blockCheckpoints(ctx, rootBlockRoot, 0, 0)
+	if err != nil {
+		return err
+	}
+	store.ProcessBlock(rootBlockRoot, bytesutil.ToBytes32(rootBlock.ParentRoot), justifiedEpoch, finalizedEpoch)
+	// The blocks are inserted breadth first from the root block, hence after their parent.
+	type queuedBlock struct {
+		root           [32]byte
+		justifiedEpoch uint64
+		finalizedEpoch uint64
+	}
+	queue := []queuedBlock{{root: rootBlockRoot, justifiedEpoch: justifiedEpoch, finalizedEpoch: finalizedEpoch}}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		childRoots, err := c.beaconDB.ChildrenOfBlock(parent.root)
 		if err != nil {
-			return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
+			return fmt.Errorf("could not get children of block: %v", err)
 		}
-		for i := 1; i < len(children); i++ {
-			candidateChildVotes, err := VoteCount(children[i], startState, voteTargets, c.beaconDB)
-			if err != nil {
-				return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
-			}
-			maxChildRoot, err := ssz.SigningRoot(maxChild)
-			if err != nil {
-				return nil, err
-			}
-			candidateChildRoot, err := ssz.SigningRoot(children[i])
+		for _, root := range childRoots {
+			// A block without post state, such as a block which failed processing, gets the
+			// checkpoints of its parent.
+			justifiedEpoch, finalizedEpoch, err := c.blockCheckpoints(ctx, root, parent.justifiedEpoch, parent.finalizedEpoch)
 			if err != nil {
-				return nil, err
-			}
-			if candidateChildVotes > maxChildVotes ||
-				(candidateChildVotes == maxChildVotes && bytesutil.LowerThan(maxChildRoot[:], candidateChildRoot[:])) {
-				maxChild = children[i]
+				return err
 			}
+			store.ProcessBlock(root, parent.root, justifiedEpoch, finalizedEpoch)
+			queue = append(queue, queuedBlock{root: root, justifiedEpoch: justifiedEpoch, finalizedEpoch: finalizedEpoch})
 		}
-		head = maxChild
 	}
+	c.forkChoiceStore = store
+	c.forkChoiceStale = false
+	return nil
+}
+
+// blockCheckpoints returns the justified and finalized epochs of the post state of the
+// block with the given root, or the given default epochs if the state is not saved.
+func (c *ChainService) blockCheckpoints(
+	ctx context.Context,
+	blockRoot [32]byte,
+	defaultJustifiedEpoch uint64,
+	defaultFinalizedEpoch uint64,
+) (uint64, uint64, error) {
+	postState, err := c.beaconDB.StateByBlockRoot(ctx, blockRoot)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not retrieve post state of block: %v", err)
+	}
+	if postState == nil {
+		return defaultJustifiedEpoch, defaultFinalizedEpoch, nil
+	}
+	return postState.GetCurrentJustifiedCheckpoint().GetEpoch(), postState.GetFinalizedCheckpoint().GetEpoch(), nil
 }
 
 // BlockChildren returns the child blocks of the given block up to a given
@@ -322,24 +443,43 @@ func (c *ChainService) BlockChildren(ctx context.Context, block *ethpb.BeaconBlo
 	if err != nil {
 		return nil, err
 	}
-	var children []*ethpb.BeaconBlock
-	startSlot := block.Slot + 1
-	for i := startSlot; i <= highestSlot; i++ {
-		kids, err := c.beaconDB.BlocksBySlot(ctx, i)
+	childRoots, err := c.beaconDB.ChildrenOfBlock(blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get children of block: %v", err)
+	}
+
+	children := []*ethpb.BeaconBlock{}
+	for _, root := range childRoots {
+		kid, err := c.beaconDB.Block(root)
 		if err != nil {
-			return nil, fmt.Errorf("could not get block by slot: %v", err)
+			return nil, fmt.Errorf("could not get child block: %v", err)
+		}
+		// A child whose block is missing from the database is skipped, the later children
+		// are still listed.
+		if kid == nil {
+			continue
+		}
+		// The children are ordered by slot.
+		if kid.Slot > highestSlot {
+			break
 		}
-		children = append(children, kids...)
+		children = append(children, kid)
 	}
+	return children, nil
+}
 
-	filteredChildren := []*ethpb.BeaconBlock{}
-	for _, kid := range children {
-		parentRoot := bytesutil.ToBytes32(kid.ParentRoot)
-		if blockRoot == parentRoot {
-			filteredChildren = append(filteredChildren, kid)
-		}
+// blockState returns the post state of the block with the given root. States saved only
+// by slot, as the head states saved at skipped slots, are looked up as the historical
+// state closest to the given slot.
+func (c *ChainService) blockState(ctx context.Context, slot uint64, blockRoot [32]byte) (*pb.BeaconState, error) {
+	beaconState, err := c.beaconDB.StateByBlockRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve state of block %#x: %v", bytesutil.Trunc(blockRoot[:]), err)
+	}
+	if beaconState != nil {
+		return beaconState, nil
 	}
-	return filteredChildren, nil
+	return c.beaconDB.HistoricalStateFromSlot(ctx, slot, blockRoot)
 }
 
 // isDescendant checks if the new head block is a descendant block of the current head.
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -675,6 +676,68 @@ func TestLMDGhost_3WayChainSplitsSameHeight(t *testing.T) {
 	}
 }
 
+func TestLMDGhost_UpdatesStoreIncrementally(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	beaconState := &pb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{EffectiveBalance: maxBalance},
+			{EffectiveBalance: maxBalance},
+			{EffectiveBalance: maxBalance},
+		},
+	}
+	saveBlock := func(slot uint64, parentRoot []byte) (*ethpb.BeaconBlock, *pb.AttestationTarget) {
+		block := &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot}
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return block, &pb.AttestationTarget{Slot: slot, BeaconBlockRoot: root[:], ParentRoot: parentRoot}
+	}
+
+	// Construct the following chain:
+	// B1 - B2
+	//    \- B3
+	block1, target1 := saveBlock(1, []byte{'A'})
+	block2, target2 := saveBlock(2, target1.BeaconBlockRoot)
+	_, target3 := saveBlock(3, target1.BeaconBlockRoot)
+	voteTargets := map[uint64]*pb.AttestationTarget{0: target2, 1: target2, 2: target3}
+	head, err := chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, block2) {
+		t.Errorf("Expected head %v, received %v", block2, head)
+	}
+
+	// A block received after the previous run, which gets the votes moved away from B2.
+	// B1 - B2
+	//    \- B3 - B4
+	block4, target4 := saveBlock(4, target3.BeaconBlockRoot)
+	if err := chainService.insertForkChoiceBlock(block4, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	voteTargets[1] = target4
+	head, err = chainService.lmdGhost(ctx, block1, beaconState, voteTargets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, block4) {
+		t.Errorf("Expected head %v, received %v", block4, head)
+	}
+	weight, _ := chainService.forkChoiceStore.Weight(bytesutil.ToBytes32(target3.BeaconBlockRoot))
+	if weight != 2*maxBalance {
+		t.Errorf("Expected weight %d for block 3, received %d", 2*maxBalance, weight)
+	}
+}
+
 func TestIsDescendant_Ok(t *testing.T) {
 	// TODO(#2307): Fix test once v0.6 is merged.
 	t.Skip()
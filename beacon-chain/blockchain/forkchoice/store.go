@@ -0,0 +1,350 @@
+// Package forkchoice implements an in-memory proto-array of the block tree, which keeps
+// the weights of the blocks up to date as the latest votes of the validators change, so
+// that the LMD-GHOST head is found without walking the tree or reading the database.
+package forkchoice
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// nonExistentNode is the index of the parent of a node whose parent is not in the
+// store, and the index of the best child of a node without children.
+const nonExistentNode = ^uint64(0)
+
+// node is a block of the proto-array. The weight of a node is the sum of the balances
+// of the validators voting for the block or for one of its descendants. The justified
+// and finalized epochs are the checkpoint epochs of the post state of the block.
+type node struct {
+	root           [32]byte
+	parent         uint64
+	children       []uint64
+	justifiedEpoch uint64
+	finalizedEpoch uint64
+	weight         uint64
+	bestChild      uint64
+	bestDescendant uint64
+}
+
+// vote is the latest vote of a validator. The current root is the root the balance of
+// the validator is counted for, and the next root the root it is counted for once the
+// weights are updated.
+type vote struct {
+	currentRoot [32]byte
+	nextRoot    [32]byte
+}
+
+// Store is a proto-array of the blocks of the tree, ordered so that the parent of a
+// node always comes before the node. The weights are only updated when the head is
+// computed, from the votes and balances changed since the previous computation, and only
+// the blocks whose weight or subtree changed are visited, along with their ancestors.
+//
+// The justified and finalized epochs of the store are the highest checkpoint epochs of
+// its blocks. As in the proto-array design, only blocks whose checkpoints match those of
+// the store are viable heads, so that a branch conflicting with the latest justified or
+// finalized checkpoint cannot become the head, however heavy it is.
+type Store struct {
+	lock           sync.RWMutex
+	nodes          []*node
+	nodeIndices    map[[32]byte]uint64
+	votes          []vote
+	balances       []uint64
+	justifiedEpoch uint64
+	finalizedEpoch uint64
+	// dirty holds the nodes inserted since the previous head computation, whose ancestors
+	// must refresh their best descendant.
+	dirty map[uint64]bool
+	// checkpointsChanged is set when the checkpoints of the store changed since the
+	// previous head computation, which changes the viability of every node.
+	checkpointsChanged bool
+}
+
+// NewStore creates an empty proto-array store.
+func NewStore() *Store {
+	return &Store{
+		nodeIndices: make(map[[32]byte]uint64),
+		dirty:       make(map[uint64]bool),
+	}
+}
+
+// HasNode returns true if the block with the given root is in the store.
+func (s *Store) HasNode(root [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.nodeIndices[root]
+	return ok
+}
+
+// Weight returns the weight of the block with the given root as of the last head
+// computation, and false if the block is not in the store.
+func (s *Store) Weight(root [32]byte) (uint64, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	index, ok := s.nodeIndices[root]
+	if !ok {
+		return 0, false
+	}
+	return s.nodes[index].weight, true
+}
+
+// Checkpoints returns the justified and finalized epochs of the store.
+func (s *Store) Checkpoints() (justifiedEpoch uint64, finalizedEpoch uint64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.justifiedEpoch, s.finalizedEpoch
+}
+
+// ProcessBlock inserts a block into the store, along with the justified and finalized
+// epochs of its post state. Blocks must be inserted after their parent, and a block whose
+// parent is not in the store becomes the root of a new tree. Inserting a block already
+// in the store is a no-op. It returns false if the parent of the block is not in the store.
+func (s *Store) ProcessBlock(root [32]byte, parentRoot [32]byte, justifiedEpoch uint64, finalizedEpoch uint64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.nodeIndices[root]; ok {
+		return true
+	}
+
+	index := uint64(len(s.nodes))
+	parent, ok := s.nodeIndices[parentRoot]
+	if !ok {
+		parent = nonExistentNode
+	}
+	s.nodeIndices[root] = index
+	s.nodes = append(s.nodes, &node{
+		root:           root,
+		parent:         parent,
+		justifiedEpoch: justifiedEpoch,
+		finalizedEpoch: finalizedEpoch,
+		bestChild:      nonExistentNode,
+		bestDescendant: nonExistentNode,
+	})
+	if parent != nonExistentNode {
+		s.nodes[parent].children = append(s.nodes[parent].children, index)
+	}
+	s.dirty[index] = true
+	if justifiedEpoch > s.justifiedEpoch {
+		s.justifiedEpoch = justifiedEpoch
+		s.checkpointsChanged = true
+	}
+	if finalizedEpoch > s.finalizedEpoch {
+		s.finalizedEpoch = finalizedEpoch
+		s.checkpointsChanged = true
+	}
+	return ok
+}
+
+// ProcessVote records the block the validator with the given index votes for, which
+// must be the latest message of the validator. The vote is accounted for in the weights
+// of the blocks at the next head computation.
+func (s *Store) ProcessVote(validatorIndex uint64, blockRoot [32]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for uint64(len(s.votes)) <= validatorIndex {
+		s.votes = append(s.votes, vote{})
+	}
+	s.votes[validatorIndex].nextRoot = blockRoot
+}
+
+// Head applies the votes and balances changed since the previous head computation to
+// the weights of the blocks, and returns the head of the tree rooted at the justified
+// block: the viable descendant reached by following the heaviest child leading to a
+// viable head from the justified block, breaking ties in favor of the highest root. The
+// justified block is returned if none of its descendants is viable. The balances are
+// indexed by validator.
+func (s *Store) Head(justifiedRoot [32]byte, balances []uint64) ([32]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	justifiedIndex, ok := s.nodeIndices[justifiedRoot]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("unknown justified root %#x", bytesutil.Trunc(justifiedRoot[:]))
+	}
+	if err := s.applyWeightChanges(s.computeDeltas(balances)); err != nil {
+		return [32]byte{}, err
+	}
+	s.balances = balances
+
+	justified := s.nodes[justifiedIndex]
+	if justified.bestDescendant == nonExistentNode {
+		return justified.root, nil
+	}
+	return s.nodes[justified.bestDescendant].root, nil
+}
+
+// Prune removes the blocks inserted before the finalized block, which are either its
+// ancestors or blocks of forks it does not descend from. Blocks inserted after it which
+// do not descend from it are no longer reachable from the justified block, and are
+// removed on a later prune once they precede the finalized block.
+func (s *Store) Prune(finalizedRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	finalizedIndex, ok := s.nodeIndices[finalizedRoot]
+	if !ok {
+		return fmt.Errorf("unknown finalized root %#x", bytesutil.Trunc(finalizedRoot[:]))
+	}
+	if finalizedIndex == 0 {
+		return nil
+	}
+
+	for _, n := range s.nodes[:finalizedIndex] {
+		delete(s.nodeIndices, n.root)
+	}
+	// The remaining nodes are copied so that the pruned nodes can be garbage collected.
+	s.nodes = append([]*node{}, s.nodes[finalizedIndex:]...)
+	shift := func(index uint64) uint64 {
+		if index == nonExistentNode || index < finalizedIndex {
+			return nonExistentNode
+		}
+		return index - finalizedIndex
+	}
+	for i, n := range s.nodes {
+		s.nodeIndices[n.root] = uint64(i)
+		n.parent = shift(n.parent)
+		n.bestChild = shift(n.bestChild)
+		n.bestDescendant = shift(n.bestDescendant)
+		// Children always come after their parent, so none of them is pruned.
+		for j := range n.children {
+			n.children[j] -= finalizedIndex
+		}
+	}
+	dirty := make(map[uint64]bool, len(s.dirty))
+	for index := range s.dirty {
+		if index >= finalizedIndex {
+			dirty[index-finalizedIndex] = true
+		}
+	}
+	s.dirty = dirty
+	return nil
+}
+
+// computeDeltas returns the change of the weight of the nodes caused by the votes and
+// balances changed since the previous head computation, and moves every vote to its
+// next root. A vote for a block which is not in the store has no weight.
+func (s *Store) computeDeltas(newBalances []uint64) map[uint64]int64 {
+	deltas := make(map[uint64]int64)
+	for i := range s.votes {
+		v := &s.votes[i]
+		if v.currentRoot == [32]byte{} && v.nextRoot == [32]byte{} {
+			continue
+		}
+		var oldBalance, newBalance uint64
+		if i < len(s.balances) {
+			oldBalance = s.balances[i]
+		}
+		if i < len(newBalances) {
+			newBalance = newBalances[i]
+		}
+		if v.currentRoot == v.nextRoot && oldBalance == newBalance {
+			continue
+		}
+		if index, ok := s.nodeIndices[v.currentRoot]; ok {
+			deltas[index] -= int64(oldBalance)
+		}
+		if index, ok := s.nodeIndices[v.nextRoot]; ok {
+			deltas[index] += int64(newBalance)
+		}
+		v.currentRoot = v.nextRoot
+	}
+	return deltas
+}
+
+// applyWeightChanges applies the deltas to the weights of the nodes and of their
+// ancestors, then refreshes the best child and best descendant of these ancestors and of
+// the ancestors of the nodes inserted since the previous head computation. Every node is
+// refreshed when the checkpoints of the store changed. Children always come after their
+// parent, so visiting the nodes by decreasing index visits every node after all of its
+// descendants.
+func (s *Store) applyWeightChanges(deltas map[uint64]int64) error {
+	var indices []uint64
+	if s.checkpointsChanged {
+		indices = make([]uint64, len(s.nodes))
+		for i := range s.nodes {
+			indices[i] = uint64(i)
+		}
+	} else {
+		visited := make(map[uint64]bool)
+		visit := func(index uint64) {
+			for index != nonExistentNode && !visited[index] {
+				visited[index] = true
+				indices = append(indices, index)
+				index = s.nodes[index].parent
+			}
+		}
+		for index := range deltas {
+			visit(index)
+		}
+		for index := range s.dirty {
+			visit(index)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] > indices[j] })
+
+	for _, index := range indices {
+		n := s.nodes[index]
+		delta := deltas[index]
+		if delta < 0 && uint64(-delta) > n.weight {
+			return fmt.Errorf("negative weight for block %#x", bytesutil.Trunc(n.root[:]))
+		}
+		n.weight = uint64(int64(n.weight) + delta)
+		if n.parent != nonExistentNode {
+			deltas[n.parent] += delta
+		}
+	}
+	// The best children are only compared once all weights are final.
+	for _, index := range indices {
+		s.updateBestChild(index)
+	}
+	s.dirty = make(map[uint64]bool)
+	s.checkpointsChanged = false
+	return nil
+}
+
+// updateBestChild makes the heaviest child leading to a viable head the best child of
+// the node, breaking ties in favor of the highest root, and its best descendant, or the
+// child itself if it has none, the best descendant of the node. The best descendants of
+// the children must be up to date.
+func (s *Store) updateBestChild(index uint64) {
+	n := s.nodes[index]
+	n.bestChild = nonExistentNode
+	n.bestDescendant = nonExistentNode
+	for _, childIndex := range n.children {
+		child := s.nodes[childIndex]
+		if !s.leadsToViableHead(child) {
+			continue
+		}
+		if n.bestChild != nonExistentNode {
+			best := s.nodes[n.bestChild]
+			if child.weight < best.weight ||
+				(child.weight == best.weight && bytes.Compare(child.root[:], best.root[:]) < 0) {
+				continue
+			}
+		}
+		n.bestChild = childIndex
+		n.bestDescendant = child.bestDescendant
+		if n.bestDescendant == nonExistentNode {
+			n.bestDescendant = childIndex
+		}
+	}
+}
+
+// leadsToViableHead returns true if the node or its best descendant is a viable head.
+func (s *Store) leadsToViableHead(n *node) bool {
+	if n.bestDescendant != nonExistentNode && s.isViableForHead(s.nodes[n.bestDescendant]) {
+		return true
+	}
+	return s.isViableForHead(n)
+}
+
+// isViableForHead returns true if the checkpoints of the node are the checkpoints of the
+// store. Every node is viable before the first justification of the chain.
+func (s *Store) isViableForHead(n *node) bool {
+	justified := s.justifiedEpoch == 0 || n.justifiedEpoch == s.justifiedEpoch
+	finalized := s.finalizedEpoch == 0 || n.finalizedEpoch == s.finalizedEpoch
+	return justified && finalized
+}
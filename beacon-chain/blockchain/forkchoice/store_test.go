@@ -0,0 +1,207 @@
+package forkchoice
+
+import (
+	"testing"
+)
+
+func root(b byte) [32]byte {
+	return [32]byte{b}
+}
+
+// setupStore builds the following tree:
+//
+//	  /- 2 - 4
+//	1 - 3 - 5
+//	      \- 6
+func setupStore() *Store {
+	s := NewStore()
+	s.ProcessBlock(root(1), [32]byte{}, 0, 0)
+	s.ProcessBlock(root(2), root(1), 0, 0)
+	s.ProcessBlock(root(3), root(1), 0, 0)
+	s.ProcessBlock(root(4), root(2), 0, 0)
+	s.ProcessBlock(root(5), root(3), 0, 0)
+	s.ProcessBlock(root(6), root(3), 0, 0)
+	return s
+}
+
+func TestStore_HeadWithoutVotes(t *testing.T) {
+	s := setupStore()
+	head, err := s.Head(root(1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ties are broken in favor of the highest root.
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+
+	if _, err := s.Head(root(7), nil); err == nil {
+		t.Error("Expected an error for an unknown justified root")
+	}
+}
+
+func TestStore_HeadFollowsVotes(t *testing.T) {
+	s := setupStore()
+	balances := []uint64{10, 10, 10}
+	s.ProcessVote(0, root(4))
+	s.ProcessVote(1, root(5))
+	s.ProcessVote(2, root(5))
+	head, err := s.Head(root(1), balances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(5) {
+		t.Errorf("Expected head %#x, received %#x", root(5), head)
+	}
+	if weight, _ := s.Weight(root(3)); weight != 20 {
+		t.Errorf("Expected weight 20 for block 3, received %d", weight)
+	}
+
+	// Moving votes only applies the differences to the weights.
+	s.ProcessVote(1, root(4))
+	s.ProcessVote(2, root(6))
+	head, err = s.Head(root(1), balances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(4) {
+		t.Errorf("Expected head %#x, received %#x", root(4), head)
+	}
+	for r, want := range map[byte]uint64{1: 30, 2: 20, 3: 10, 4: 20, 5: 0, 6: 10} {
+		if weight, _ := s.Weight(root(r)); weight != want {
+			t.Errorf("Expected weight %d for block %d, received %d", want, r, weight)
+		}
+	}
+
+	// A balance change is applied without any new vote.
+	head, err = s.Head(root(1), []uint64{10, 10, 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(6) {
+		t.Errorf("Expected head %#x, received %#x", root(6), head)
+	}
+
+	// The head is a descendant of the justified block.
+	head, err = s.Head(root(2), []uint64{10, 10, 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(4) {
+		t.Errorf("Expected head %#x, received %#x", root(4), head)
+	}
+}
+
+func TestStore_ProcessBlockUpdatesParent(t *testing.T) {
+	s := setupStore()
+	s.ProcessVote(0, root(4))
+	if _, err := s.Head(root(1), []uint64{10}); err != nil {
+		t.Fatal(err)
+	}
+	s.ProcessBlock(root(7), root(4), 0, 0)
+	// Inserting a known block is a no-op.
+	s.ProcessBlock(root(7), root(6), 0, 0)
+	head, err := s.Head(root(1), []uint64{10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(7) {
+		t.Errorf("Expected head %#x, received %#x", root(7), head)
+	}
+}
+
+func TestStore_HeadIgnoresNonViableBranch(t *testing.T) {
+	// Build the following tree, where only the blocks of the upper branch include the
+	// justification of epoch 1:
+	//
+	//	  /- 2 (justified 1) - 4 (justified 1)
+	//	1 - 3 (justified 0) - 5 (justified 0)
+	s := NewStore()
+	s.ProcessBlock(root(1), [32]byte{}, 0, 0)
+	s.ProcessBlock(root(3), root(1), 0, 0)
+	s.ProcessBlock(root(5), root(3), 0, 0)
+	if _, err := s.Head(root(1), nil); err != nil {
+		t.Fatal(err)
+	}
+	s.ProcessBlock(root(2), root(1), 1, 0)
+	s.ProcessBlock(root(4), root(2), 1, 0)
+	if justified, finalized := s.Checkpoints(); justified != 1 || finalized != 0 {
+		t.Errorf("Expected checkpoints (1, 0), received (%d, %d)", justified, finalized)
+	}
+
+	// The lower branch is heavier, but it conflicts with the justified checkpoint.
+	s.ProcessVote(0, root(5))
+	s.ProcessVote(1, root(5))
+	s.ProcessVote(2, root(4))
+	head, err := s.Head(root(1), []uint64{10, 10, 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(4) {
+		t.Errorf("Expected head %#x, received %#x", root(4), head)
+	}
+	if weight, _ := s.Weight(root(3)); weight != 20 {
+		t.Errorf("Expected weight 20 for block 3, received %d", weight)
+	}
+
+	// Without any viable descendant, the justified block is the head.
+	head, err = s.Head(root(3), []uint64{10, 10, 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(3) {
+		t.Errorf("Expected head %#x, received %#x", root(3), head)
+	}
+}
+
+func TestStore_ProcessBlockUnknownParent(t *testing.T) {
+	s := setupStore()
+	if !s.ProcessBlock(root(7), root(6), 0, 0) {
+		t.Error("Expected parent of block 7 to be known")
+	}
+	if s.ProcessBlock(root(8), root(9), 0, 0) {
+		t.Error("Expected parent of block 8 to be unknown")
+	}
+}
+
+func TestStore_Prune(t *testing.T) {
+	s := setupStore()
+	s.ProcessVote(0, root(5))
+	s.ProcessVote(1, root(4))
+	if _, err := s.Head(root(1), []uint64{10, 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Prune(root(3)); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []byte{1, 2} {
+		if s.HasNode(root(r)) {
+			t.Errorf("Expected block %d to be pruned", r)
+		}
+	}
+	for _, r := range []byte{3, 4, 5, 6} {
+		if !s.HasNode(root(r)) {
+			t.Errorf("Expected block %d to be kept", r)
+		}
+	}
+
+	// Votes keep being applied to the remaining blocks.
+	s.ProcessVote(1, root(6))
+	s.ProcessBlock(root(7), root(6), 0, 0)
+	s.ProcessVote(0, root(7))
+	head, err := s.Head(root(3), []uint64{10, 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != root(7) {
+		t.Errorf("Expected head %#x, received %#x", root(7), head)
+	}
+	if weight, _ := s.Weight(root(3)); weight != 20 {
+		t.Errorf("Expected weight 20 for block 3, received %d", weight)
+	}
+
+	if err := s.Prune(root(1)); err == nil {
+		t.Error("Expected an error pruning at an unknown block")
+	}
+}
@@ -0,0 +1,114 @@
+package blockchain
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Statuses of the validators counted by the validator count gauge.
+const (
+	validatorPending      = "pending"
+	validatorActive       = "active"
+	validatorExiting      = "exiting"
+	validatorSlashing     = "slashing"
+	validatorExited       = "exited"
+	validatorWithdrawable = "withdrawable"
+)
+
+var validatorStatuses = []string{
+	validatorPending,
+	validatorActive,
+	validatorExiting,
+	validatorSlashing,
+	validatorExited,
+	validatorWithdrawable,
+}
+
+var (
+	previousJustifiedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_previous_justified_epoch",
+		Help: "The previous justified epoch of the head state, updated on epoch transition",
+	})
+	currentJustifiedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_current_justified_epoch",
+		Help: "The current justified epoch of the head state, updated on epoch transition",
+	})
+	finalizedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_finalized_epoch",
+		Help: "The finalized epoch of the head state, updated on epoch transition",
+	})
+	epochsSinceFinalityGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_epochs_since_finality",
+		Help: "The number of epochs between the current epoch and the finalized epoch",
+	})
+	participationRateGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_global_participation_rate",
+		Help: "The ratio of the eligible ether which voted for the target of the previous epoch",
+	})
+	totalActiveBalanceGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_total_active_balance",
+		Help: "The combined effective balance of the active validators, in Gwei",
+	})
+	validatorCountGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "beacon_validators",
+		Help: "The number of validators in the registry, by status",
+	}, []string{"status"})
+)
+
+// reportEpochMetrics updates the chain health metrics with the state resulting from an
+// epoch transition and the participation of the previous epoch, if known.
+func reportEpochMetrics(beaconState *pb.BeaconState, participation *ethpb.ValidatorParticipation) error {
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	previousJustifiedEpochGauge.Set(float64(beaconState.PreviousJustifiedCheckpoint.Epoch))
+	currentJustifiedEpochGauge.Set(float64(beaconState.CurrentJustifiedCheckpoint.Epoch))
+	finalizedEpochGauge.Set(float64(beaconState.FinalizedCheckpoint.Epoch))
+	epochsSinceFinalityGauge.Set(float64(currentEpoch - beaconState.FinalizedCheckpoint.Epoch))
+	if participation != nil {
+		participationRateGauge.Set(float64(participation.GlobalParticipationRate))
+	}
+
+	totalActiveBalance, err := helpers.TotalActiveBalance(beaconState)
+	if err != nil {
+		return err
+	}
+	totalActiveBalanceGauge.Set(float64(totalActiveBalance))
+
+	for status, count := range validatorCounts(beaconState.Validators, currentEpoch) {
+		validatorCountGauge.WithLabelValues(status).Set(float64(count))
+	}
+	return nil
+}
+
+// validatorCounts counts the validators by status at the epoch, including the statuses
+// without any validator.
+func validatorCounts(validators []*ethpb.Validator, epoch uint64) map[string]int {
+	counts := make(map[string]int, len(validatorStatuses))
+	for _, status := range validatorStatuses {
+		counts[status] = 0
+	}
+	for _, v := range validators {
+		counts[validatorStatus(v, epoch)]++
+	}
+	return counts
+}
+
+func validatorStatus(v *ethpb.Validator, epoch uint64) string {
+	switch {
+	case epoch < v.ActivationEpoch:
+		return validatorPending
+	case epoch < v.ExitEpoch && v.Slashed:
+		return validatorSlashing
+	case epoch < v.ExitEpoch && v.ExitEpoch != params.BeaconConfig().FarFutureEpoch:
+		return validatorExiting
+	case epoch < v.ExitEpoch:
+		return validatorActive
+	case epoch < v.WithdrawableEpoch:
+		return validatorExited
+	default:
+		return validatorWithdrawable
+	}
+}
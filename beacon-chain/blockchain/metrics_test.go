@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"reflect"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestValidatorCounts_ByStatus(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		{ActivationEpoch: 6, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		{ActivationEpoch: 0, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		{ActivationEpoch: 0, ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		{ActivationEpoch: 0, ExitEpoch: 7, WithdrawableEpoch: 10},
+		{ActivationEpoch: 0, ExitEpoch: 7, WithdrawableEpoch: 20, Slashed: true},
+		{ActivationEpoch: 0, ExitEpoch: 3, WithdrawableEpoch: 10},
+		{ActivationEpoch: 0, ExitEpoch: 2, WithdrawableEpoch: 4},
+	}
+
+	want := map[string]int{
+		validatorPending:      1,
+		validatorActive:       2,
+		validatorExiting:      1,
+		validatorSlashing:     1,
+		validatorExited:       1,
+		validatorWithdrawable: 1,
+	}
+	if got := validatorCounts(validators, 5); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted %v, received %v", want, got)
+	}
+
+	want = map[string]int{
+		validatorPending:      0,
+		validatorActive:       0,
+		validatorExiting:      0,
+		validatorSlashing:     0,
+		validatorExited:       0,
+		validatorWithdrawable: 0,
+	}
+	if got := validatorCounts(nil, 5); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted %v, received %v", want, got)
+	}
+}
@@ -7,12 +7,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"runtime"
 	"sync"
 	"time"
 
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/forkchoice"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
@@ -21,6 +21,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -30,7 +31,7 @@ var log = logrus.WithField("prefix", "blockchain")
 // ChainFeeds interface defines the methods of the ChainService which provide
 // information feeds.
 type ChainFeeds interface {
-	StateInitializedFeed() *event.Feed
+	StateInitializedFeed() *event.Topic
 }
 
 // ChainService represents a service that handles the internal
@@ -43,15 +44,25 @@ type ChainService struct {
 	attsService          attestation.TargetHandler
 	opsPoolService       operations.OperationFeeds
 	chainStartChan       chan time.Time
-	canonicalBlockFeed   *event.Feed
+	canonicalBlockFeed   *event.Topic
+	headUpdatedFeed      *event.Topic
 	genesisTime          time.Time
 	finalizedEpoch       uint64
-	stateInitializedFeed *event.Feed
+	stateInitializedFeed *event.Topic
 	p2p                  p2p.Broadcaster
 	canonicalBlocks      map[uint64][]byte
 	canonicalBlocksLock  sync.RWMutex
 	receiveBlockLock     sync.Mutex
-	maxRoutines          int64
+	archive              bool
+	archiveLock          sync.Mutex
+	archivedAny          bool
+	archivedEpoch        uint64
+	archivedRoot         [32]byte
+	stageTimer           func(stage string, elapsed time.Duration)
+	forkChoiceStore      *forkchoice.Store
+	forkChoiceStale      bool
+	forkChoiceLock       sync.Mutex
+	supervisor           *supervisor.Supervisor
 }
 
 // Config options for the service.
@@ -63,7 +74,13 @@ type Config struct {
 	OpsPoolService operations.OperationFeeds
 	DevMode        bool
 	P2p            p2p.Broadcaster
-	MaxRoutines    int64
+	// Archive retains the state, the balances and the committee assignments of every past
+	// epoch of the canonical chain, which a default node does not keep.
+	Archive bool
+	// StageTimer, if set, is called with the time spent in each stage of ReceiveBlock.
+	StageTimer func(stage string, elapsed time.Duration)
+	// RestartPolicy defines how the goroutines of the service are restarted after a panic.
+	RestartPolicy supervisor.Policy
 }
 
 // NewChainService instantiates a new service instance that will
@@ -77,12 +94,16 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		web3Service:          cfg.Web3Service,
 		opsPoolService:       cfg.OpsPoolService,
 		attsService:          cfg.AttsService,
-		canonicalBlockFeed:   new(event.Feed),
+		canonicalBlockFeed:   event.NewTopic("canonical_blocks"),
+		headUpdatedFeed:      event.NewTopic("head_updates"),
 		chainStartChan:       make(chan time.Time),
-		stateInitializedFeed: new(event.Feed),
+		stateInitializedFeed: event.NewTopic("state_initialized"),
 		p2p:                  cfg.P2p,
 		canonicalBlocks:      make(map[uint64][]byte),
-		maxRoutines:          cfg.MaxRoutines,
+		archive:              cfg.Archive,
+		stageTimer:           cfg.StageTimer,
+		forkChoiceStore:      forkchoice.NewStore(),
+		supervisor:           supervisor.New(ctx, "blockchain", cfg.RestartPolicy),
 	}, nil
 }
 
@@ -104,11 +125,10 @@ func (c *ChainService) Start() {
 			return // return need for TestStartUninitializedChainWithoutConfigPOWChain.
 		}
 		subChainStart := c.web3Service.ChainStartFeed().Subscribe(c.chainStartChan)
-		go func() {
+		c.supervisor.Go("processChainStart", func() {
 			genesisTime := <-c.chainStartChan
 			c.processChainStartTime(genesisTime, subChainStart)
-			return
-		}()
+		})
 	}
 }
 
@@ -180,32 +200,41 @@ func (c *ChainService) initializeBeaconChain(genesisTime time.Time, deposits []*
 	return beaconState, nil
 }
 
-// Stop the blockchain service's main event loop and associated goroutines.
+// Stop the blockchain service's main event loop and associated goroutines, waiting for
+// the block being imported, if any, so that it is fully saved before the database is
+// closed.
 func (c *ChainService) Stop() error {
 	defer c.cancel()
 
 	log.Info("Stopping service")
+	c.receiveBlockLock.Lock()
+	defer c.receiveBlockLock.Unlock()
 	return nil
 }
 
-// Status always returns nil.
+// Status returns an error if a goroutine of the service panicked more times than its
+// restart policy allows. The goroutine limit is checked by the resource monitor.
 // TODO(1202): Add service health checks.
 func (c *ChainService) Status() error {
-	if runtime.NumGoroutine() > int(c.maxRoutines) {
-		return fmt.Errorf("too many goroutines %d", runtime.NumGoroutine())
-	}
-	return nil
+	return c.supervisor.Status()
 }
 
 // CanonicalBlockFeed returns a channel that is written to
 // whenever a new block is determined to be canonical in the chain.
-func (c *ChainService) CanonicalBlockFeed() *event.Feed {
+func (c *ChainService) CanonicalBlockFeed() *event.Topic {
 	return c.canonicalBlockFeed
 }
 
+// HeadUpdatedFeed returns a feed that is written to with the new
+// head block whenever fork choice changes the head of the chain.
+// Subscribers should use a dropping policy so that they never block fork choice.
+func (c *ChainService) HeadUpdatedFeed() *event.Topic {
+	return c.headUpdatedFeed
+}
+
 // StateInitializedFeed returns a feed that is written to
 // when the beacon state is first initialized.
-func (c *ChainService) StateInitializedFeed() *event.Feed {
+func (c *ChainService) StateInitializedFeed() *event.Topic {
 	return c.stateInitializedFeed
 }
 
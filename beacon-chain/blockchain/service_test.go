@@ -36,15 +36,15 @@ func init() {
 
 type mockOperationService struct{}
 
-func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Topic {
+	return new(event.Topic)
 }
 
-func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
+func (ms *mockOperationService) IncomingAttFeed() *event.Topic {
 	return nil
 }
 
-func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
+func (ms *mockOperationService) IncomingExitFeed() *event.Topic {
 	return nil
 }
 
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"k8s.io/client-go/tools/cache"
 )
@@ -20,11 +20,11 @@ var (
 	maxActiveBalanceListSize = 1000
 
 	// Metrics.
-	activeBalanceCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	activeBalanceCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_balance_cache_miss",
 		Help: "The number of active balance requests that aren't present in the cache.",
 	})
-	activeBalanceCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	activeBalanceCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_balance_cache_hit",
 		Help: "The number of active balance requests that are present in the cache.",
 	})
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"k8s.io/client-go/tools/cache"
 )
@@ -20,11 +20,11 @@ var (
 	maxActiveCountListSize = 1000
 
 	// Metrics.
-	activeCountCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	activeCountCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_count_cache_miss",
 		Help: "The number of active validator count requests that aren't present in the cache.",
 	})
-	activeCountCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	activeCountCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_count_cache_hit",
 		Help: "The number of active validator count requests that are present in the cache.",
 	})
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -19,11 +19,11 @@ var (
 	maxActiveIndicesListSize = 4
 
 	// Metrics.
-	activeIndicesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	activeIndicesCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_indices_cache_miss",
 		Help: "The number of active validator indices requests that aren't present in the cache.",
 	})
-	activeIndicesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	activeIndicesCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "active_validator_indices_cache_hit",
 		Help: "The number of active validator indices requests that are present in the cache.",
 	})
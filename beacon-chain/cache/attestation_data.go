@@ -9,9 +9,9 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -22,15 +22,15 @@ var (
 	delayFactor = 1.1
 
 	// Metrics
-	attestationCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	attestationCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "attestation_cache_miss",
 		Help: "The number of attestation data requests that aren't present in the cache.",
 	})
-	attestationCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	attestationCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "attestation_cache_hit",
 		Help: "The number of attestation data requests that are present in the cache.",
 	})
-	attestationCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+	attestationCacheSize = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "attestation_cache_size",
 		Help: "The number of attestation data in the attestations cache",
 	})
@@ -6,8 +6,8 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -16,15 +16,15 @@ var (
 	// block ancestor cache obj.
 	ErrNotAncestorCacheObj = errors.New("object is not an ancestor object for cache")
 	// Metrics
-	ancestorBlockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	ancestorBlockCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "ancestor_block_cache_miss",
 		Help: "The number of ancestor block requests that aren't present in the cache.",
 	})
-	ancestorBlockCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	ancestorBlockCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "ancestor_block_cache_hit",
 		Help: "The number of ancestor block requests that are present in the cache.",
 	})
-	ancestorBlockCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+	ancestorBlockCacheSize = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "ancestor_block_cache_size",
 		Help: "The number of ancestor blocks in the ancestorBlock cache",
 	})
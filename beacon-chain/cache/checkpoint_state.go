@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// ErrNotCheckpointState will be returned when a cache object is not a pointer to
+	// a CheckpointState struct.
+	ErrNotCheckpointState = errors.New("object is not a checkpoint state obj")
+
+	// maxCheckpointStateSize defines the max number of checkpoint states the cache holds,
+	// which is kept small as every entry is a full state.
+	maxCheckpointStateSize = 8
+
+	// Metrics.
+	checkpointStateCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "checkpoint_state_cache_miss",
+		Help: "The number of checkpoint state requests that aren't present in the cache.",
+	})
+	checkpointStateCacheHit = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "checkpoint_state_cache_hit",
+		Help: "The number of checkpoint state requests that are present in the cache.",
+	})
+)
+
+// CheckpointState defines the state of a checkpoint, which is the state of its block
+// advanced to the start slot of its epoch.
+type CheckpointState struct {
+	Checkpoint *ethpb.Checkpoint
+	State      *pb.BeaconState
+}
+
+// CheckpointStateCache is a struct with 1 queue for looking up states by checkpoint.
+// The states are shared between the callers, which must not modify them.
+type CheckpointStateCache struct {
+	checkpointStateCache *cache.FIFO
+	lock                 sync.RWMutex
+}
+
+// checkpointKey returns the key of a checkpoint, made of its epoch and its root.
+func checkpointKey(checkpoint *ethpb.Checkpoint) string {
+	return fmt.Sprintf("%d-%#x", checkpoint.Epoch, checkpoint.Root)
+}
+
+// checkpointStateKeyFn takes the checkpoint as the key for the state of a given checkpoint.
+func checkpointStateKeyFn(obj interface{}) (string, error) {
+	info, ok := obj.(*CheckpointState)
+	if !ok {
+		return "", ErrNotCheckpointState
+	}
+
+	return checkpointKey(info.Checkpoint), nil
+}
+
+// NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing
+// the states of checkpoints.
+func NewCheckpointStateCache() *CheckpointStateCache {
+	return &CheckpointStateCache{
+		checkpointStateCache: cache.NewFIFO(checkpointStateKeyFn),
+	}
+}
+
+// StateByCheckpoint fetches the state of the checkpoint. Returns the state if it
+// exists, otherwise returns nil.
+func (c *CheckpointStateCache) StateByCheckpoint(checkpoint *ethpb.Checkpoint) (*pb.BeaconState, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	obj, exists, err := c.checkpointStateCache.GetByKey(checkpointKey(checkpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		checkpointStateCacheHit.Inc()
+	} else {
+		checkpointStateCacheMiss.Inc()
+		return nil, nil
+	}
+
+	info, ok := obj.(*CheckpointState)
+	if !ok {
+		return nil, ErrNotCheckpointState
+	}
+
+	return info.State, nil
+}
+
+// AddCheckpointState adds the CheckpointState object to the cache. This method also trims
+// the least recently added CheckpointState object if the cache size has reached the max
+// cache size limit.
+func (c *CheckpointStateCache) AddCheckpointState(info *CheckpointState) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.checkpointStateCache.AddIfNotPresent(info); err != nil {
+		return err
+	}
+
+	trim(c.checkpointStateCache, maxCheckpointStateSize)
+	return nil
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestCheckpointStateKeyFn_InvalidObj(t *testing.T) {
+	_, err := checkpointStateKeyFn("bad")
+	if err != ErrNotCheckpointState {
+		t.Errorf("Expected error %v, got %v", ErrNotCheckpointState, err)
+	}
+}
+
+func TestCheckpointStateCache_StateByCheckpoint(t *testing.T) {
+	cache := NewCheckpointStateCache()
+
+	info := &CheckpointState{
+		Checkpoint: &ethpb.Checkpoint{Epoch: 1, Root: []byte{'A'}},
+		State:      &pb.BeaconState{Slot: 64},
+	}
+	st, err := cache.StateByCheckpoint(info.Checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != nil {
+		t.Error("Expected state not to exist in empty cache")
+	}
+
+	if err := cache.AddCheckpointState(info); err != nil {
+		t.Fatal(err)
+	}
+	st, err = cache.StateByCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: []byte{'A'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(st, info.State) {
+		t.Errorf("Expected fetched state to be %v, got %v", info.State, st)
+	}
+
+	// A checkpoint of the same epoch on another fork has another state.
+	st, err = cache.StateByCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: []byte{'B'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != nil {
+		t.Error("Expected no state for a checkpoint with another root")
+	}
+}
+
+func TestCheckpointStateCache_MaxSize(t *testing.T) {
+	cache := NewCheckpointStateCache()
+
+	for i := 0; i < maxCheckpointStateSize+10; i++ {
+		info := &CheckpointState{
+			Checkpoint: &ethpb.Checkpoint{Epoch: uint64(i), Root: []byte(strconv.Itoa(i))},
+			State:      &pb.BeaconState{},
+		}
+		if err := cache.AddCheckpointState(info); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(cache.checkpointStateCache.ListKeys()) != maxCheckpointStateSize {
+		t.Errorf(
+			"Expected hash cache key size to be %d, got %d",
+			maxCheckpointStateSize,
+			len(cache.checkpointStateCache.ListKeys()),
+		)
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -18,11 +18,11 @@ var (
 	maxEth1DataVoteSize = 1000
 
 	// Metrics.
-	eth1DataVoteCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	eth1DataVoteCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "eth1_data_vote_cache_miss",
 		Help: "The number of eth1 data vote count requests that aren't present in the cache.",
 	})
-	eth1DataVoteCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	eth1DataVoteCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "eth1_data_vote_cache_hit",
 		Help: "The number of eth1 data vote count requests that are present in the cache.",
 	})
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -19,11 +19,11 @@ var (
 	maxSeedListSize = 1000
 
 	// Metrics.
-	seedCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	seedCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "seed_cache_miss",
 		Help: "The number of seed requests that aren't present in the cache.",
 	})
-	seedCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	seedCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "seed_cache_hit",
 		Help: "The number of seed requests that are present in the cache.",
 	})
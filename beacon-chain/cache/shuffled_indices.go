@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -19,11 +19,11 @@ var (
 	maxShuffledListSize = 1000
 
 	// Metrics.
-	shuffledIndicesCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	shuffledIndicesCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "shuffled_validators_cache_miss",
 		Help: "The number of shuffled validators requests that aren't present in the cache.",
 	})
-	shuffledIndicesCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	shuffledIndicesCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "shuffled_validators_cache_hit",
 		Help: "The number of shuffled validators requests that are present in the cache.",
 	})
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"k8s.io/client-go/tools/cache"
 )
@@ -20,11 +20,11 @@ var (
 	maxStartShardListSize = int(params.BeaconConfig().ShardCount)
 
 	// Metrics.
-	startShardCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	startShardCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "start_shard_cache_miss",
 		Help: "The number of start shard requests that aren't present in the cache.",
 	})
-	startShardCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	startShardCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "start_shard_cache_hit",
 		Help: "The number of start shard requests that are present in the cache.",
 	})
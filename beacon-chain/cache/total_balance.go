@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"k8s.io/client-go/tools/cache"
 )
@@ -20,11 +20,11 @@ var (
 	maxTotalBalanceListSize = 1000
 
 	// Metrics.
-	totalBalanceCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	totalBalanceCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "total_balance_cache_miss",
 		Help: "The number of total balance requests that aren't present in the cache.",
 	})
-	totalBalanceCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	totalBalanceCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "total_balance_cache_hit",
 		Help: "The number of total balance requests that are present in the cache.",
 	})
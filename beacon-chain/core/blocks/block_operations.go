@@ -719,30 +719,16 @@ func VerifyIndexedAttestation(beaconState *pb.BeaconState, indexedAtt *ethpb.Ind
 		domain := helpers.Domain(beaconState, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainAttestation)
 		var pubkeys []*bls.PublicKey
 		if len(custodyBit0Indices) > 0 {
-			pubkey, err := bls.PublicKeyFromBytes(beaconState.Validators[custodyBit0Indices[0]].PublicKey)
+			pubkey, err := aggregatePublicKeys(beaconState, custodyBit0Indices)
 			if err != nil {
-				return fmt.Errorf("could not deserialize validator public key: %v", err)
-			}
-			for _, i := range custodyBit0Indices[1:] {
-				pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
-				if err != nil {
-					return fmt.Errorf("could not deserialize validator public key: %v", err)
-				}
-				pubkey.Aggregate(pk)
+				return err
 			}
 			pubkeys = append(pubkeys, pubkey)
 		}
 		if len(custodyBit1Indices) > 0 {
-			pubkey, err := bls.PublicKeyFromBytes(beaconState.Validators[custodyBit1Indices[0]].PublicKey)
+			pubkey, err := aggregatePublicKeys(beaconState, custodyBit1Indices)
 			if err != nil {
-				return fmt.Errorf("could not deserialize validator public key: %v", err)
-			}
-			for _, i := range custodyBit1Indices[1:] {
-				pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
-				if err != nil {
-					return fmt.Errorf("could not deserialize validator public key: %v", err)
-				}
-				pubkey.Aggregate(pk)
+				return err
 			}
 			pubkeys = append(pubkeys, pubkey)
 		}
@@ -773,6 +759,22 @@ func VerifyIndexedAttestation(beaconState *pb.BeaconState, indexedAtt *ethpb.Ind
 	return nil
 }
 
+// aggregatePublicKeys aggregates the public keys of the validators at the given indices.
+func aggregatePublicKeys(beaconState *pb.BeaconState, indices []uint64) (*bls.PublicKey, error) {
+	pubkey, err := bls.PublicKeyFromBytes(beaconState.Validators[indices[0]].PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize validator public key: %v", err)
+	}
+	for _, i := range indices[1:] {
+		pk, err := bls.PublicKeyFromBytes(beaconState.Validators[i].PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize validator public key: %v", err)
+		}
+		pubkey.Aggregate(pk)
+	}
+	return pubkey, nil
+}
+
 // ProcessDeposits is one of the operations performed on each processed
 // beacon block to verify queued validators from the Ethereum 1.0 Deposit Contract
 // into the beacon chain.
@@ -0,0 +1,143 @@
+package blocks
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SignatureBatch collects the signatures of a block to verify them together once the
+// block is processed. The signatures of a domain are weighted by random scalars and
+// aggregated into a single verification, which takes one pairing per distinct message
+// rather than two per signature.
+type SignatureBatch struct {
+	sets []*signatureSet
+}
+
+// signatureSet is a signature of a message by a public key, described to report the
+// signature when it is invalid.
+type signatureSet struct {
+	signature   *bls.Signature
+	publicKey   *bls.PublicKey
+	message     [32]byte
+	domain      uint64
+	description string
+}
+
+// AddProposerSignatures adds the signature of the block and its randao reveal to the batch.
+// The state must be the state of the slot of the block, before the block is processed.
+func (b *SignatureBatch) AddProposerSignatures(beaconState *pb.BeaconState, block *ethpb.BeaconBlock) error {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	proposerPub, err := bls.PublicKeyFromBytes(beaconState.Validators[proposerIdx].PublicKey)
+	if err != nil {
+		return fmt.Errorf("could not convert bytes to public key: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return fmt.Errorf("could not get signing root: %v", err)
+	}
+	domain := helpers.Domain(beaconState, currentEpoch, params.BeaconConfig().DomainBeaconProposer)
+	if err := b.add(block.Signature, proposerPub, blockRoot, domain, "block signature"); err != nil {
+		return err
+	}
+
+	var epochRoot [32]byte
+	binary.LittleEndian.PutUint64(epochRoot[:], currentEpoch)
+	domain = helpers.Domain(beaconState, currentEpoch, params.BeaconConfig().DomainRandao)
+	return b.add(block.Body.RandaoReveal, proposerPub, epochRoot, domain, "block randao")
+}
+
+// AddAttestations adds the aggregated signatures of the attestations to the batch. The
+// attestations must have been processed, as this only converts them to their indexed form.
+func (b *SignatureBatch) AddAttestations(beaconState *pb.BeaconState, atts []*ethpb.Attestation) error {
+	for idx, att := range atts {
+		indexedAtt, err := ConvertToIndexed(beaconState, att)
+		if err != nil {
+			return fmt.Errorf("could not convert attestation at index %d to indexed attestation: %v", idx, err)
+		}
+		// Attestations without votes have no signature to verify, and there are no custody
+		// bit 1 indices until phase 1.
+		if len(indexedAtt.CustodyBit_0Indices) == 0 {
+			continue
+		}
+		pubkey, err := aggregatePublicKeys(beaconState, indexedAtt.CustodyBit_0Indices)
+		if err != nil {
+			return err
+		}
+		cus0 := &pb.AttestationDataAndCustodyBit{Data: indexedAtt.Data, CustodyBit: false}
+		cus0Root, err := ssz.HashTreeRoot(cus0)
+		if err != nil {
+			return fmt.Errorf("could not tree hash att data and custody bit 0: %v", err)
+		}
+		domain := helpers.Domain(beaconState, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainAttestation)
+		description := fmt.Sprintf("signature of attestation at index %d", idx)
+		if err := b.add(indexedAtt.Signature, pubkey, cus0Root, domain, description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify the signatures of the batch. When the batched verification of a domain fails,
+// its signatures are verified one by one to report the invalid signature.
+func (b *SignatureBatch) Verify() error {
+	var domains []uint64
+	setsByDomain := make(map[uint64][]*signatureSet)
+	for _, set := range b.sets {
+		if _, ok := setsByDomain[set.domain]; !ok {
+			domains = append(domains, set.domain)
+		}
+		setsByDomain[set.domain] = append(setsByDomain[set.domain], set)
+	}
+
+	for _, domain := range domains {
+		sets := setsByDomain[domain]
+		sigs := make([]*bls.Signature, len(sets))
+		pubkeys := make([]*bls.PublicKey, len(sets))
+		msgs := make([][32]byte, len(sets))
+		for i, set := range sets {
+			sigs[i] = set.signature
+			pubkeys[i] = set.publicKey
+			msgs[i] = set.message
+		}
+		valid, err := bls.VerifyBatch(sigs, pubkeys, msgs, domain)
+		if err != nil {
+			return fmt.Errorf("could not batch verify signatures: %v", err)
+		}
+		if valid {
+			continue
+		}
+		for _, set := range sets {
+			if !set.signature.Verify(set.message[:], set.publicKey, set.domain) {
+				return fmt.Errorf("%s did not verify", set.description)
+			}
+		}
+	}
+	return nil
+}
+
+func (b *SignatureBatch) add(signature []byte, pub *bls.PublicKey, msg [32]byte, domain uint64, description string) error {
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return fmt.Errorf("could not convert bytes to %s: %v", description, err)
+	}
+	b.sets = append(b.sets, &signatureSet{
+		signature:   sig,
+		publicKey:   pub,
+		message:     msg,
+		domain:      domain,
+		description: description,
+	})
+	return nil
+}
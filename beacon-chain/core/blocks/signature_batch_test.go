@@ -0,0 +1,149 @@
+package blocks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestSignatureBatch_ProposerSignatures(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	epochSignature, err := helpers.CreateRandaoReveal(beaconState, helpers.CurrentEpoch(beaconState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: epochSignature,
+		},
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, 0, params.BeaconConfig().DomainBeaconProposer)
+	block.Signature = privKeys[proposerIdx].Sign(signingRoot[:], domain).Marshal()
+
+	batch := &blocks.SignatureBatch{}
+	if err := batch.AddProposerSignatures(beaconState, block); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Unexpected error verifying proposer signatures: %v", err)
+	}
+
+	// A randao reveal signed by another validator does not verify.
+	otherIdx := (proposerIdx + 1) % uint64(len(privKeys))
+	buf := make([]byte, 32)
+	domain = helpers.Domain(beaconState, 0, params.BeaconConfig().DomainRandao)
+	block.Body.RandaoReveal = privKeys[otherIdx].Sign(buf, domain).Marshal()
+	signingRoot, err = ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain = helpers.Domain(beaconState, 0, params.BeaconConfig().DomainBeaconProposer)
+	block.Signature = privKeys[proposerIdx].Sign(signingRoot[:], domain).Marshal()
+	batch = &blocks.SignatureBatch{}
+	if err := batch.AddProposerSignatures(beaconState, block); err != nil {
+		t.Fatal(err)
+	}
+	want := "block randao did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestSignatureBatch_Attestations(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot += params.BeaconConfig().MinAttestationInclusionDelay
+
+	// Find a committee of at least two validators, to split it in two attestations.
+	var shard uint64
+	var committee []uint64
+	for ; shard < params.BeaconConfig().SlotsPerEpoch; shard++ {
+		committee, err = helpers.CrosslinkCommittee(beaconState, 0, shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(committee) >= 2 {
+			break
+		}
+	}
+	if len(committee) < 2 {
+		t.Fatal("Expected a committee of at least two validators")
+	}
+
+	// Both attestations sign the same data, so their messages are the same.
+	var atts []*ethpb.Attestation
+	for i := 0; i < 2; i++ {
+		aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+		aggregationBits.SetBitAt(uint64(i), true)
+		atts = append(atts, &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Source:    &ethpb.Checkpoint{},
+				Target:    &ethpb.Checkpoint{Epoch: 0},
+				Crosslink: &ethpb.Crosslink{Shard: shard},
+			},
+			AggregationBits: aggregationBits,
+			CustodyBits:     bitfield.NewBitlist(uint64(len(committee))),
+		})
+	}
+	domain := helpers.Domain(beaconState, 0, params.BeaconConfig().DomainAttestation)
+	for _, att := range atts {
+		indexedAtt, err := blocks.ConvertToIndexed(beaconState, att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.HashTreeRoot(&pb.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: false})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sigs []*bls.Signature
+		for _, idx := range indexedAtt.CustodyBit_0Indices {
+			sigs = append(sigs, privKeys[idx].Sign(root[:], domain))
+		}
+		att.Signature = bls.AggregateSignatures(sigs).Marshal()
+	}
+
+	batch := &blocks.SignatureBatch{}
+	if err := batch.AddAttestations(beaconState, atts); err != nil {
+		t.Fatal(err)
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Unexpected error verifying attestation signatures: %v", err)
+	}
+
+	atts[1].Signature = atts[0].Signature
+	batch = &blocks.SignatureBatch{}
+	if err := batch.AddAttestations(beaconState, atts); err != nil {
+		t.Fatal(err)
+	}
+	want := "signature of attestation at index 1 did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
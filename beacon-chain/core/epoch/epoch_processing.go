@@ -119,6 +119,32 @@ func AttestingBalance(state *pb.BeaconState, atts []*pb.PendingAttestation) (uin
 	return helpers.TotalBalance(state, indices), nil
 }
 
+// PreviousEpochParticipation returns the participation of the previous epoch of the
+// state, that is the balance of the validators which voted for the correct target
+// relative to the balance of all the validators eligible to vote.
+func PreviousEpochParticipation(state *pb.BeaconState) (*ethpb.ValidatorParticipation, error) {
+	prevEpoch := helpers.PrevEpoch(state)
+	matched, err := MatchAttestations(state, prevEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not match attestations: %v", err)
+	}
+	voted, err := AttestingBalance(state, matched.Target)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attesting balance: %v", err)
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(state, prevEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active indices: %v", err)
+	}
+	eligible := helpers.TotalBalance(state, activeIndices)
+	return &ethpb.ValidatorParticipation{
+		Epoch:                   prevEpoch,
+		VotedEther:              voted,
+		EligibleEther:           eligible,
+		GlobalParticipationRate: float32(voted) / float32(eligible),
+	}, nil
+}
+
 // ProcessJustificationAndFinalization processes justification and finalization during
 // epoch processing. This is where a beacon node can justify and finalize a new epoch.
 //
@@ -124,6 +124,65 @@ func TestAttestingBalance_CorrectBalance(t *testing.T) {
 	}
 }
 
+func TestPreviousEpochParticipation(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	// Generate 2 attestations voting for the correct target of the previous epoch.
+	atts := make([]*pb.PendingAttestation, 2)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard: uint64(i),
+				},
+				Target: &ethpb.Checkpoint{Root: []byte{1}},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+				0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01},
+		}
+	}
+
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount)
+	balances := make([]uint64, params.BeaconConfig().MinGenesisActiveValidatorCount)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := 0; i < len(blockRoots); i++ {
+		blockRoots[i] = []byte{1}
+	}
+	state := &pb.BeaconState{
+		Slot:                      params.BeaconConfig().SlotsPerEpoch,
+		RandaoMixes:               make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots:          make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		BlockRoots:                blockRoots,
+		Validators:                validators,
+		Balances:                  balances,
+		PreviousEpochAttestations: atts,
+	}
+
+	participation, err := PreviousEpochParticipation(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	voted := 256 * params.BeaconConfig().MaxEffectiveBalance
+	eligible := uint64(len(validators)) * params.BeaconConfig().MaxEffectiveBalance
+	wanted := &ethpb.ValidatorParticipation{
+		Epoch:                   0,
+		VotedEther:              voted,
+		EligibleEther:           eligible,
+		GlobalParticipationRate: float32(voted) / float32(eligible),
+	}
+	if !reflect.DeepEqual(participation, wanted) {
+		t.Errorf("Wanted participation %v, got %v", wanted, participation)
+	}
+}
+
 func TestMatchAttestations_PrevEpoch(t *testing.T) {
 	helpers.ClearAllCaches()
 	e := params.BeaconConfig().SlotsPerEpoch
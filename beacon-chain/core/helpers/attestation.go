@@ -6,6 +6,8 @@ import (
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -50,3 +52,21 @@ func AttestationDataSlot(state *pb.BeaconState, data *ethpb.AttestationData) (ui
 
 	return StartSlot(data.Target.Epoch) + (offset / (committeeCount / params.BeaconConfig().SlotsPerEpoch)), nil
 }
+
+// IsAggregator returns true if the validator which produced the slot signature is selected
+// to aggregate the attestations of its committee of the given size. The selection is
+// based on the signature so that it can only be known in advance by the validator itself.
+//
+// Spec pseudocode definition:
+//   def is_aggregator(state: BeaconState, slot: Slot, index: CommitteeIndex, slot_signature: BLSSignature) -> bool:
+//    committee = get_beacon_committee(state, slot, index)
+//    modulo = max(1, len(committee) // TARGET_AGGREGATORS_PER_COMMITTEE)
+//    return bytes_to_int(hash(slot_signature)[0:8]) % modulo == 0
+func IsAggregator(committeeSize uint64, slotSig []byte) bool {
+	modulo := committeeSize / params.BeaconConfig().TargetAggregatorsPerCommittee
+	if modulo == 0 {
+		modulo = 1
+	}
+	h := hashutil.Hash(slotSig)
+	return bytesutil.FromBytes8(h[:8])%modulo == 0
+}
@@ -100,3 +100,21 @@ func TestAttestationDataSlot_ReturnsErrorWhenTargetEpochLessThanCurrentEpoch(t *
 		t.Logf("attestation slot=%v", s)
 	}
 }
+
+func TestIsAggregator(t *testing.T) {
+	target := params.BeaconConfig().TargetAggregatorsPerCommittee
+	sig := []byte{'A'}
+	if !helpers.IsAggregator(target-1, sig) {
+		t.Error("Expected every member of a committee smaller than the target to aggregate")
+	}
+
+	aggregators := 0
+	for i := 0; i < 100; i++ {
+		if helpers.IsAggregator(1000*target, []byte{byte(i)}) {
+			aggregators++
+		}
+	}
+	if aggregators == 100 {
+		t.Error("Expected only a fraction of a large committee to aggregate")
+	}
+}
@@ -356,6 +356,40 @@ func StartShard(state *pb.BeaconState, epoch uint64) (uint64, error) {
 	return startShard, nil
 }
 
+// EpochCommittees returns the crosslink committees of every slot of the epoch, ordered
+// by slot, along with the shard each committee is assigned to.
+func EpochCommittees(state *pb.BeaconState, epoch uint64) ([]*ethpb.BeaconCommittees_CommitteeItem, error) {
+	committeeCount, err := CommitteeCount(state, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get committee count: %v", err)
+	}
+	startShard, err := StartShard(state, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get start shard: %v", err)
+	}
+
+	committeesPerSlot := committeeCount / params.BeaconConfig().SlotsPerEpoch
+	committees := make([]*ethpb.BeaconCommittees_CommitteeItem, 0, committeeCount)
+	startSlot := StartSlot(epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		offset := committeesPerSlot * (slot % params.BeaconConfig().SlotsPerEpoch)
+		slotStartShard := (startShard + offset) % params.BeaconConfig().ShardCount
+		for i := uint64(0); i < committeesPerSlot; i++ {
+			shard := (slotStartShard + i) % params.BeaconConfig().ShardCount
+			committee, err := CrosslinkCommittee(state, epoch, shard)
+			if err != nil {
+				return nil, fmt.Errorf("could not get crosslink committee: %v", err)
+			}
+			committees = append(committees, &ethpb.BeaconCommittees_CommitteeItem{
+				Slot:             slot,
+				Shard:            shard,
+				ValidatorIndices: committee,
+			})
+		}
+	}
+	return committees, nil
+}
+
 // VerifyAttestationBitfield verifies that an attestations bitfield is valid in respect
 // to the committees at that slot.
 func VerifyAttestationBitfield(bState *pb.BeaconState, att *ethpb.Attestation) (bool, error) {
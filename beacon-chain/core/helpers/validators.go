@@ -218,6 +218,22 @@ func BeaconProposerIndex(state *pb.BeaconState) (uint64, error) {
 	}
 }
 
+// EpochProposers returns the index of the proposer of every slot of the epoch, in slot
+// order, as computed from the state advanced to each slot without processing it.
+func EpochProposers(state *pb.BeaconState, epoch uint64) ([]uint64, error) {
+	proposers := make([]uint64, params.BeaconConfig().SlotsPerEpoch)
+	proposerState := *state
+	for i := range proposers {
+		proposerState.Slot = StartSlot(epoch) + uint64(i)
+		idx, err := BeaconProposerIndex(&proposerState)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proposer index at slot %d: %v", proposerState.Slot, err)
+		}
+		proposers[i] = idx
+	}
+	return proposers, nil
+}
+
 // Domain returns the domain version for BLS private key to sign and verify.
 //
 // Spec pseudocode definition:
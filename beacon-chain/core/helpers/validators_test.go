@@ -213,6 +213,44 @@ func TestBeaconProposerIndex_OK(t *testing.T) {
 	}
 }
 
+func TestEpochProposers_MatchesProposerIndex(t *testing.T) {
+	ClearAllCaches()
+
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount/8)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state := &pb.BeaconState{
+		Validators:       validators,
+		Slot:             0,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+
+	proposers, err := EpochProposers(state, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Slot != 0 {
+		t.Error("Expected the state not to be modified")
+	}
+	if uint64(len(proposers)) != params.BeaconConfig().SlotsPerEpoch {
+		t.Fatalf("Expected a proposer for every slot, received %d", len(proposers))
+	}
+	for _, slot := range []uint64{1, 5, 19, 30, 43} {
+		state.Slot = slot
+		want, err := BeaconProposerIndex(state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proposers[slot] != want {
+			t.Errorf("Expected proposer %d at slot %d, received %d", want, slot, proposers[slot])
+		}
+	}
+}
+
 func TestBeaconProposerIndex_EmptyCommittee(t *testing.T) {
 	ClearAllCaches()
 	beaconState := &pb.BeaconState{
@@ -223,7 +223,8 @@ func GenesisBeaconState(deposits []*ethpb.Deposit, genesisTime uint64, eth1Data
 
 // IsValidGenesisState gets called whenever there's a deposit event,
 // it checks whether there's enough effective balance to trigger and
-// if the minimum genesis time arrived already.
+// if the genesis time the deposit would result in is past the minimum
+// genesis time.
 //
 // Spec pseudocode definition:
 //  def is_valid_genesis_state(state: BeaconState) -> bool:
@@ -234,8 +235,8 @@ func GenesisBeaconState(deposits []*ethpb.Deposit, genesisTime uint64, eth1Data
 //     return True
 // This method has been modified from the spec to allow whole states not to be saved
 // but instead only cache the relevant information.
-func IsValidGenesisState(chainStartDepositCount uint64, currentTime uint64) bool {
-	if currentTime < params.BeaconConfig().MinGenesisTime {
+func IsValidGenesisState(chainStartDepositCount uint64, genesisTime uint64) bool {
+	if genesisTime < params.BeaconConfig().MinGenesisTime {
 		return false
 	}
 	if chainStartDepositCount < params.BeaconConfig().MinGenesisActiveValidatorCount {
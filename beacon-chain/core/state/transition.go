@@ -26,6 +26,10 @@ import (
 type TransitionConfig struct {
 	VerifySignatures bool
 	VerifyStateRoot  bool
+	// BatchVerifySignatures verifies the proposer, randao and attestation signatures of
+	// a block together once the block is processed, rather than one by one. It only
+	// applies when VerifySignatures is set.
+	BatchVerifySignatures bool
 }
 
 // DefaultConfig option for executing state transitions.
@@ -183,12 +187,21 @@ func ProcessBlock(
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessBlock")
 	defer span.End()
 
-	state, err := b.ProcessBlockHeader(state, block, config.VerifySignatures)
+	var batch *b.SignatureBatch
+	if config.VerifySignatures && config.BatchVerifySignatures {
+		batch = &b.SignatureBatch{}
+		if err := batch.AddProposerSignatures(state, block); err != nil {
+			return nil, fmt.Errorf("could not collect proposer signatures: %v", err)
+		}
+	}
+	verifySignatures := config.VerifySignatures && batch == nil
+
+	state, err := b.ProcessBlockHeader(state, block, verifySignatures)
 	if err != nil {
 		return nil, fmt.Errorf("could not process block header: %v", err)
 	}
 
-	state, err = b.ProcessRandao(state, block.Body, config.VerifySignatures)
+	state, err = b.ProcessRandao(state, block.Body, verifySignatures)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify and process randao: %v", err)
 	}
@@ -203,6 +216,17 @@ func ProcessBlock(
 		return nil, fmt.Errorf("could not process block operation: %v", err)
 	}
 
+	if batch != nil {
+		// The operations of a block do not change the committees of the current and previous
+		// epochs, so the attestations are converted against the processed state.
+		if err := batch.AddAttestations(state, block.Body.Attestations); err != nil {
+			return nil, fmt.Errorf("could not collect attestation signatures: %v", err)
+		}
+		if err := batch.Verify(); err != nil {
+			return nil, fmt.Errorf("could not verify block signatures: %v", err)
+		}
+	}
+
 	return state, nil
 }
 
@@ -228,6 +252,9 @@ func ProcessBlock(
 //    for operations, function in all_operations:
 //        for operation in operations:
 //            function(state, operation)
+//
+// When signatures are batch verified, the signatures of the attestations are left to the
+// caller to verify, as done by ProcessBlock.
 func ProcessOperations(
 	ctx context.Context,
 	state *pb.BeaconState,
@@ -261,7 +288,8 @@ func ProcessOperations(
 	if err != nil {
 		return nil, fmt.Errorf("could not process block attester slashings: %v", err)
 	}
-	state, err = b.ProcessAttestations(state, body, config.VerifySignatures)
+	verifyAttestations := config.VerifySignatures && !config.BatchVerifySignatures
+	state, err = b.ProcessAttestations(state, body, verifyAttestations)
 	if err != nil {
 		return nil, fmt.Errorf("could not process block attestations: %v", err)
 	}
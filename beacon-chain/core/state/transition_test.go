@@ -137,6 +137,63 @@ func TestProcessBlock_IncorrectProposerSlashing(t *testing.T) {
 	}
 }
 
+func TestProcessBlock_BatchVerifiesSignatures(t *testing.T) {
+	for _, validSignature := range []bool{true, false} {
+		helpers.ClearAllCaches()
+		deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
+		beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentRoot, err := ssz.SigningRoot(beaconState.LatestBlockHeader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		randaoReveal, err := helpers.CreateRandaoReveal(beaconState, 0, privKeys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &ethpb.BeaconBlock{
+			ParentRoot: parentRoot[:],
+			Slot:       0,
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: randaoReveal,
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: []byte{2},
+					BlockHash:   []byte{3},
+				},
+			},
+		}
+		proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signer := privKeys[proposerIdx]
+		if !validSignature {
+			signer = privKeys[(proposerIdx+1)%uint64(len(privKeys))]
+		}
+		signingRoot, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := helpers.Domain(beaconState, 0, params.BeaconConfig().DomainBeaconProposer)
+		block.Signature = signer.Sign(signingRoot[:], domain).Marshal()
+
+		config := &state.TransitionConfig{
+			VerifySignatures:      true,
+			BatchVerifySignatures: true,
+		}
+		_, err = state.ProcessBlock(context.Background(), beaconState, block, config)
+		if validSignature && err != nil {
+			t.Errorf("Unexpected error processing block: %v", err)
+		}
+		want := "block signature did not verify"
+		if !validSignature && (err == nil || !strings.Contains(err.Error(), want)) {
+			t.Errorf("Expected %s, received %v", want, err)
+		}
+	}
+}
+
 func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 	deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
 	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
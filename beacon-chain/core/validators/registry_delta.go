@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// RegistryDelta returns the changes of the validator registry from the pre state to the
+// post state, ordered by validator index: the validators which became active or stopped
+// being active, the validators slashed and the validators whose effective balance changed.
+// The pre state is usually the state of an earlier epoch on the same chain. A validator
+// added to the registry in between is compared against an empty validator.
+func RegistryDelta(preState *pb.BeaconState, postState *pb.BeaconState) *ethpb.ValidatorRegistryChanges {
+	preEpoch := helpers.CurrentEpoch(preState)
+	postEpoch := helpers.CurrentEpoch(postState)
+	var changes []*ethpb.ValidatorRegistryChanges_Change
+	for i, post := range postState.Validators {
+		pre := &ethpb.Validator{}
+		if i < len(preState.Validators) {
+			pre = preState.Validators[i]
+		}
+		wasActive := helpers.IsActiveValidator(pre, preEpoch)
+		isActive := helpers.IsActiveValidator(post, postEpoch)
+		change := &ethpb.ValidatorRegistryChanges_Change{
+			PublicKey:                post.PublicKey,
+			Index:                    uint64(i),
+			Activated:                !wasActive && isActive,
+			Exited:                   wasActive && !isActive,
+			Slashed:                  !pre.Slashed && post.Slashed,
+			PreviousEffectiveBalance: pre.EffectiveBalance,
+			EffectiveBalance:         post.EffectiveBalance,
+		}
+		if change.Activated || change.Exited || change.Slashed ||
+			change.PreviousEffectiveBalance != change.EffectiveBalance {
+			changes = append(changes, change)
+		}
+	}
+	return &ethpb.ValidatorRegistryChanges{
+		Epoch:         postEpoch,
+		PreviousEpoch: preEpoch,
+		Changes:       changes,
+	}
+}
@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestRegistryDelta_OK(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	preState := &pb.BeaconState{
+		Slot: params.BeaconConfig().SlotsPerEpoch,
+		Validators: []*ethpb.Validator{
+			// Unchanged.
+			{PublicKey: []byte{0}, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			// Activated.
+			{PublicKey: []byte{1}, ActivationEpoch: 2, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			// Exited.
+			{PublicKey: []byte{2}, ExitEpoch: 2, EffectiveBalance: maxBalance},
+			// Slashed and exited.
+			{PublicKey: []byte{3}, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			// Effective balance decreased.
+			{PublicKey: []byte{4}, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+		},
+	}
+	postState := &pb.BeaconState{
+		Slot: 2 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: []*ethpb.Validator{
+			{PublicKey: []byte{0}, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{1}, ActivationEpoch: 2, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{2}, ExitEpoch: 2, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{3}, ExitEpoch: 2, Slashed: true, EffectiveBalance: maxBalance - 1e9},
+			{PublicKey: []byte{4}, ExitEpoch: farFuture, EffectiveBalance: maxBalance - 1e9},
+			// Added to the registry, pending activation.
+			{PublicKey: []byte{5}, ActivationEpoch: farFuture, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+		},
+	}
+
+	want := &ethpb.ValidatorRegistryChanges{
+		Epoch:         2,
+		PreviousEpoch: 1,
+		Changes: []*ethpb.ValidatorRegistryChanges_Change{
+			{PublicKey: []byte{1}, Index: 1, Activated: true, PreviousEffectiveBalance: maxBalance, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{2}, Index: 2, Exited: true, PreviousEffectiveBalance: maxBalance, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{3}, Index: 3, Exited: true, Slashed: true, PreviousEffectiveBalance: maxBalance, EffectiveBalance: maxBalance - 1e9},
+			{PublicKey: []byte{4}, Index: 4, PreviousEffectiveBalance: maxBalance, EffectiveBalance: maxBalance - 1e9},
+			{PublicKey: []byte{5}, Index: 5, EffectiveBalance: maxBalance},
+		},
+	}
+	if delta := RegistryDelta(preState, postState); !reflect.DeepEqual(delta, want) {
+		t.Errorf("Wanted registry delta %v, received %v", want, delta)
+	}
+}
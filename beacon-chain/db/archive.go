@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// SaveArchivedBalances persists the validator balances at the start of the given epoch,
+// so that historical balances can be served without regenerating past states.
+func (db *BeaconDB) SaveArchivedBalances(ctx context.Context, epoch uint64, balances []uint64) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedBalances")
+	defer span.End()
+
+	return db.saveArchivedUint64s(archivedBalancesBucket, epoch, balances)
+}
+
+// ArchivedBalances retrieves the validator balances archived for the given epoch.
+// It returns nil if no balances were archived for the epoch.
+func (db *BeaconDB) ArchivedBalances(ctx context.Context, epoch uint64) ([]uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedBalances")
+	defer span.End()
+
+	return db.archivedUint64s(archivedBalancesBucket, epoch)
+}
+
+// SaveArchivedParticipation persists the participation of validators in the given epoch,
+// which is only known once the epoch has ended.
+func (db *BeaconDB) SaveArchivedParticipation(ctx context.Context, epoch uint64, participation *ethpb.ValidatorParticipation) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedParticipation")
+	defer span.End()
+
+	enc, err := proto.Marshal(participation)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedParticipationBucket)
+		return bucket.Put(encodeSlotNumber(epoch), enc)
+	})
+}
+
+// ArchivedParticipation retrieves the validator participation archived for the given
+// epoch. It returns nil if no participation was archived for the epoch.
+func (db *BeaconDB) ArchivedParticipation(ctx context.Context, epoch uint64) (*ethpb.ValidatorParticipation, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedParticipation")
+	defer span.End()
+
+	var participation *ethpb.ValidatorParticipation
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedParticipationBucket)
+		enc := bucket.Get(encodeSlotNumber(epoch))
+		if enc == nil {
+			return nil
+		}
+		participation = &ethpb.ValidatorParticipation{}
+		return proto.Unmarshal(enc, participation)
+	})
+	return participation, err
+}
+
+// SaveArchivedState persists the state at the start of the given epoch. Unlike the
+// historical states, archived states are not deleted on finalization.
+func (db *BeaconDB) SaveArchivedState(ctx context.Context, epoch uint64, beaconState *pb.BeaconState) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedState")
+	defer span.End()
+
+	enc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedStatesBucket)
+		return bucket.Put(encodeSlotNumber(epoch), enc)
+	})
+}
+
+// ArchivedState retrieves the state archived at the start of the given epoch. It returns
+// nil if no state was archived for the epoch.
+func (db *BeaconDB) ArchivedState(ctx context.Context, epoch uint64) (*pb.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedState")
+	defer span.End()
+
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedStatesBucket)
+		enc := bucket.Get(encodeSlotNumber(epoch))
+		if enc == nil {
+			return nil
+		}
+		var err error
+		beaconState, err = createState(enc)
+		return err
+	})
+	return beaconState, err
+}
+
+// SaveArchivedCommittees persists the crosslink committees of an epoch.
+func (db *BeaconDB) SaveArchivedCommittees(ctx context.Context, committees *ethpb.BeaconCommittees) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedCommittees")
+	defer span.End()
+
+	enc, err := proto.Marshal(committees)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedCommitteesBucket)
+		return bucket.Put(encodeSlotNumber(committees.Epoch), enc)
+	})
+}
+
+// ArchivedCommittees retrieves the crosslink committees archived for the given epoch.
+// It returns nil if no committees were archived for the epoch.
+func (db *BeaconDB) ArchivedCommittees(ctx context.Context, epoch uint64) (*ethpb.BeaconCommittees, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedCommittees")
+	defer span.End()
+
+	var committees *ethpb.BeaconCommittees
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(archivedCommitteesBucket)
+		enc := bucket.Get(encodeSlotNumber(epoch))
+		if enc == nil {
+			return nil
+		}
+		committees = &ethpb.BeaconCommittees{}
+		return proto.Unmarshal(enc, committees)
+	})
+	return committees, err
+}
+
+// SaveArchivedProposers persists the index of the proposer of every slot of an epoch,
+// in slot order.
+func (db *BeaconDB) SaveArchivedProposers(ctx context.Context, epoch uint64, proposers []uint64) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveArchivedProposers")
+	defer span.End()
+
+	return db.saveArchivedUint64s(archivedProposersBucket, epoch, proposers)
+}
+
+// ArchivedProposers retrieves the proposer indices archived for the given epoch, in slot
+// order. It returns nil if no proposers were archived for the epoch.
+func (db *BeaconDB) ArchivedProposers(ctx context.Context, epoch uint64) ([]uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ArchivedProposers")
+	defer span.End()
+
+	return db.archivedUint64s(archivedProposersBucket, epoch)
+}
+
+func (db *BeaconDB) saveArchivedUint64s(bucketName []byte, epoch uint64, values []uint64) error {
+	enc := make([]byte, 8*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(enc[8*i:], v)
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		return bucket.Put(encodeSlotNumber(epoch), enc)
+	})
+}
+
+func (db *BeaconDB) archivedUint64s(bucketName []byte, epoch uint64) ([]uint64, error) {
+	var values []uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		enc := bucket.Get(encodeSlotNumber(epoch))
+		if enc == nil {
+			return nil
+		}
+		if len(enc)%8 != 0 {
+			return fmt.Errorf("archived data of bucket %s is corrupted", bucketName)
+		}
+		values = make([]uint64, len(enc)/8)
+		for i := range values {
+			values[i] = binary.LittleEndian.Uint64(enc[8*i:])
+		}
+		return nil
+	})
+	return values, err
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestSaveAndRetrieveArchivedBalances_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	balances := []uint64{32000000000, 31000000000, 0, 1}
+	if err := db.SaveArchivedBalances(ctx, 5, balances); err != nil {
+		t.Fatalf("Failed to save archived balances: %v", err)
+	}
+
+	received, err := db.ArchivedBalances(ctx, 5)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived balances: %v", err)
+	}
+	if !reflect.DeepEqual(received, balances) {
+		t.Errorf("Expected balances %v, received %v", balances, received)
+	}
+
+	received, err = db.ArchivedBalances(ctx, 6)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived balances: %v", err)
+	}
+	if received != nil {
+		t.Errorf("Expected no balances for an epoch which was not archived, received %v", received)
+	}
+}
+
+func TestSaveAndRetrieveArchivedParticipation_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	participation := &ethpb.ValidatorParticipation{
+		Epoch:                   3,
+		GlobalParticipationRate: 0.75,
+		VotedEther:              96000000000,
+		EligibleEther:           128000000000,
+	}
+	if err := db.SaveArchivedParticipation(ctx, 3, participation); err != nil {
+		t.Fatalf("Failed to save archived participation: %v", err)
+	}
+
+	received, err := db.ArchivedParticipation(ctx, 3)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived participation: %v", err)
+	}
+	if !proto.Equal(received, participation) {
+		t.Errorf("Expected participation %v, received %v", participation, received)
+	}
+
+	received, err = db.ArchivedParticipation(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived participation: %v", err)
+	}
+	if received != nil {
+		t.Errorf("Expected no participation for an epoch which was not archived, received %v", received)
+	}
+}
+
+func TestSaveAndRetrieveArchivedState_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState := &pb.BeaconState{Slot: 64, Balances: []uint64{1, 2, 3}}
+	if err := db.SaveArchivedState(ctx, 1, beaconState); err != nil {
+		t.Fatalf("Failed to save archived state: %v", err)
+	}
+	// Historical states are deleted on finalization, archived states are not.
+	if err := db.SaveFinalizedState(&pb.BeaconState{Slot: 128}); err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := db.ArchivedState(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived state: %v", err)
+	}
+	if !proto.Equal(received, beaconState) {
+		t.Errorf("Expected state %v, received %v", beaconState, received)
+	}
+
+	received, err = db.ArchivedState(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived state: %v", err)
+	}
+	if received != nil {
+		t.Errorf("Expected no state for an epoch which was not archived, received %v", received)
+	}
+}
+
+func TestSaveAndRetrieveArchivedCommittees_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	committees := &ethpb.BeaconCommittees{
+		Epoch: 2,
+		Committees: []*ethpb.BeaconCommittees_CommitteeItem{
+			{Slot: 16, Shard: 4, ValidatorIndices: []uint64{3, 1}},
+			{Slot: 17, Shard: 5, ValidatorIndices: []uint64{0, 2}},
+		},
+		ActiveValidatorCount: 4,
+	}
+	if err := db.SaveArchivedCommittees(ctx, committees); err != nil {
+		t.Fatalf("Failed to save archived committees: %v", err)
+	}
+	received, err := db.ArchivedCommittees(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived committees: %v", err)
+	}
+	if !proto.Equal(received, committees) {
+		t.Errorf("Expected committees %v, received %v", committees, received)
+	}
+
+	proposers := []uint64{3, 0, 2, 2}
+	if err := db.SaveArchivedProposers(ctx, 2, proposers); err != nil {
+		t.Fatalf("Failed to save archived proposers: %v", err)
+	}
+	receivedProposers, err := db.ArchivedProposers(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to retrieve archived proposers: %v", err)
+	}
+	if !reflect.DeepEqual(receivedProposers, proposers) {
+		t.Errorf("Expected proposers %v, received %v", proposers, receivedProposers)
+	}
+}
@@ -9,27 +9,28 @@ import (
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"go.opencensus.io/trace"
 )
 
 var (
-	badBlockCount = promauto.NewCounter(prometheus.CounterOpts{
+	badBlockCount = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "bad_blocks",
 		Help: "Number of bad, blacklisted blocks received",
 	})
-	blockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	blockCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_block_cache_miss",
 		Help: "The number of block requests that aren't present in the cache.",
 	})
-	blockCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	blockCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_block_cache_hit",
 		Help: "The number of block requests that are present in the cache.",
 	})
-	blockCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+	blockCacheSize = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "beacon_block_cache_size",
 		Help: "The number of beacon blocks in the block cache",
 	})
@@ -163,7 +164,14 @@ func (db *BeaconDB) SaveBlock(block *ethpb.BeaconBlock) error {
 		if err := bucket.Put(slotRootBinary, enc); err != nil {
 			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
 		}
-		return bucket.Put(signingRoot[:], enc)
+		if err := bucket.Put(signingRoot[:], enc); err != nil {
+			return err
+		}
+		childKey := encodeChildKey(bytesutil.ToBytes32(block.ParentRoot), block.Slot, signingRoot)
+		if err := tx.Bucket(blockChildrenBucket).Put(childKey, []byte{}); err != nil {
+			return fmt.Errorf("failed to index the block as a child of its parent: %v", err)
+		}
+		return nil
 	})
 }
 
@@ -188,8 +196,51 @@ func (db *BeaconDB) DeleteBlock(block *ethpb.BeaconBlock) error {
 		if err := bucket.Delete(slotRootBinary); err != nil {
 			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
 		}
-		return bucket.Delete(signingRoot[:])
+		if err := bucket.Delete(signingRoot[:]); err != nil {
+			return err
+		}
+		childKey := encodeChildKey(bytesutil.ToBytes32(block.ParentRoot), block.Slot, signingRoot)
+		return tx.Bucket(blockChildrenBucket).Delete(childKey)
+	})
+}
+
+// ChildrenOfBlock returns the roots of the saved blocks whose parent is the block with
+// the given root, ordered by slot.
+func (db *BeaconDB) ChildrenOfBlock(root [32]byte) ([][32]byte, error) {
+	var children [][32]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(blockChildrenBucket).Cursor()
+		for k, _ := c.Seek(root[:]); k != nil && bytes.HasPrefix(k, root[:]); k, _ = c.Next() {
+			children = append(children, bytesutil.ToBytes32(k[len(k)-32:]))
+		}
+		return nil
 	})
+	return children, err
+}
+
+// indexBlockChildren indexes every saved block as a child of its parent, for databases
+// created before the children index.
+func indexBlockChildren(tx *bolt.Tx) error {
+	children := tx.Bucket(blockChildrenBucket)
+	return tx.Bucket(blockBucket).ForEach(func(k, v []byte) error {
+		// Blocks are saved under both their slot and root and their root alone.
+		if len(k) != 32 {
+			return nil
+		}
+		block, err := createBlock(v)
+		if err != nil {
+			return err
+		}
+		childKey := encodeChildKey(bytesutil.ToBytes32(block.ParentRoot), block.Slot, bytesutil.ToBytes32(k))
+		return children.Put(childKey, []byte{})
+	})
+}
+
+// encodeChildKey encodes the key of a block in the children index, which is prefixed by
+// the root of its parent and then its big-endian slot so that children sort by slot.
+func encodeChildKey(parentRoot [32]byte, slot uint64, root [32]byte) []byte {
+	key := append(parentRoot[:], encodeUint64(slot)...)
+	return append(key, root[:]...)
 }
 
 // SaveJustifiedBlock saves the last justified block from canonical chain to DB.
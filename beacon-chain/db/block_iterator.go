@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// CanonicalBlockIterator iterates over a range of the blocks of the canonical chain in
+// increasing slot order, reading one block at a time from the database.
+type CanonicalBlockIterator struct {
+	db    *BeaconDB
+	roots [][32]byte
+}
+
+// CanonicalBlocks returns an iterator over the blocks of the chain of the current head
+// with slots in [fromSlot, toSlot]. The chain is determined by following the parent roots
+// back from the head, so that the blocks of abandoned forks are never returned. Blocks are
+// read without going through the block cache, so that iterating over a long range does not
+// hold the whole range in memory.
+func (db *BeaconDB) CanonicalBlocks(ctx context.Context, fromSlot uint64, toSlot uint64) (*CanonicalBlockIterator, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CanonicalBlocks")
+	defer span.End()
+
+	head, err := db.ChainHead()
+	if err != nil {
+		return nil, err
+	}
+	root, err := ssz.SigningRoot(head)
+	if err != nil {
+		return nil, err
+	}
+	var roots [][32]byte
+	err = db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(blockBucket)
+		block := head
+		for block != nil && block.Slot >= fromSlot {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if block.Slot <= toSlot {
+				roots = append(roots, root)
+			}
+			if block.Slot == 0 {
+				break
+			}
+			root = bytesutil.ToBytes32(block.ParentRoot)
+			// The parent of the first block of a node started from a checkpoint is not
+			// in the database, which ends the chain.
+			enc := bucket.Get(root[:])
+			if enc == nil {
+				break
+			}
+			var err error
+			if block, err = createBlock(enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(roots)-1; i < j; i, j = i+1, j-1 {
+		roots[i], roots[j] = roots[j], roots[i]
+	}
+	return &CanonicalBlockIterator{db: db, roots: roots}, nil
+}
+
+// Len returns the number of blocks left to iterate over.
+func (it *CanonicalBlockIterator) Len() int {
+	return len(it.roots)
+}
+
+// Next returns the next block of the range, or nil once every block has been returned.
+func (it *CanonicalBlockIterator) Next() (*ethpb.BeaconBlock, error) {
+	if len(it.roots) == 0 {
+		return nil, nil
+	}
+	root := it.roots[0]
+	it.roots = it.roots[1:]
+	var block *ethpb.BeaconBlock
+	err := it.db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(blockBucket).Get(root[:])
+		if enc == nil {
+			return errors.New("canonical block was deleted during iteration")
+		}
+		var err error
+		block, err = createBlock(enc)
+		return err
+	})
+	return block, err
+}
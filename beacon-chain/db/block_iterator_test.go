@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestCanonicalBlocks_SkipsForks(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("failed to initialize state: %v", err)
+	}
+	beaconState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The canonical chain holds the blocks at slots 1, 2 and 4, the block at slot 3 is
+	// the head of an abandoned fork.
+	saveChild := func(parent *ethpb.BeaconBlock, slot uint64) *ethpb.BeaconBlock {
+		parentRoot, err := ssz.SigningRoot(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, block, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		return block
+	}
+	b1 := saveChild(genesis, 1)
+	b2 := saveChild(b1, 2)
+	saveChild(b2, 3)
+	saveChild(b2, 4)
+
+	tests := []struct {
+		from  uint64
+		to    uint64
+		slots []uint64
+	}{
+		{from: 0, to: 10, slots: []uint64{0, 1, 2, 4}},
+		{from: 1, to: 3, slots: []uint64{1, 2}},
+		{from: 3, to: 3, slots: nil},
+		{from: 5, to: 10, slots: nil},
+	}
+	for _, tt := range tests {
+		it, err := db.CanonicalBlocks(ctx, tt.from, tt.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if it.Len() != len(tt.slots) {
+			t.Errorf("Expected %d blocks in [%d, %d], received %d", len(tt.slots), tt.from, tt.to, it.Len())
+		}
+		var slots []uint64
+		for {
+			block, err := it.Next()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if block == nil {
+				break
+			}
+			slots = append(slots, block.Slot)
+		}
+		if !reflect.DeepEqual(slots, tt.slots) {
+			t.Errorf("Expected blocks at slots %v in [%d, %d], received %v", tt.slots, tt.from, tt.to, slots)
+		}
+	}
+}
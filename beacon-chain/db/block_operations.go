@@ -41,3 +41,27 @@ func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	}
 	return exists
 }
+
+// DeleteExit removes the exit request from the db.
+func (db *BeaconDB) DeleteExit(exit *ethpb.VoluntaryExit) error {
+	return db.deleteOperation(blockOperationsBucket, exit)
+}
+
+// Exits retrieves all the exit requests from the db.
+func (db *BeaconDB) Exits(ctx context.Context) ([]*ethpb.VoluntaryExit, error) {
+	_, span := trace.StartSpan(ctx, "beaconDB.Exits")
+	defer span.End()
+
+	var exits []*ethpb.VoluntaryExit
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(blockOperationsBucket).ForEach(func(k, v []byte) error {
+			exit := &ethpb.VoluntaryExit{}
+			if err := proto.Unmarshal(v, exit); err != nil {
+				return err
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
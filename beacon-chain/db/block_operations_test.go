@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -31,3 +32,34 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_ExitsAndDeleteExit(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	exits := []*ethpb.VoluntaryExit{{Epoch: 1}, {Epoch: 2, ValidatorIndex: 3}}
+	for _, exit := range exits {
+		if err := db.SaveExit(ctx, exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	saved, err := db.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != len(exits) {
+		t.Fatalf("Expected %d exit requests, received %d", len(exits), len(saved))
+	}
+
+	if err := db.DeleteExit(exits[0]); err != nil {
+		t.Fatalf("Failed to delete exit request: %v", err)
+	}
+	saved, err = db.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || !proto.Equal(saved[0], exits[1]) {
+		t.Errorf("Expected only the second exit request to remain, received %v", saved)
+	}
+}
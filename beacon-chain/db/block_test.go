@@ -233,6 +233,52 @@ func TestBlocksBySlot_MultipleBlocks(t *testing.T) {
 	}
 }
 
+func TestChildrenOfBlock_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	parent := &ethpb.BeaconBlock{Slot: 1}
+	parentRoot, _ := ssz.SigningRoot(parent)
+	child1 := &ethpb.BeaconBlock{Slot: 3, ParentRoot: parentRoot[:]}
+	child1Root, _ := ssz.SigningRoot(child1)
+	child2 := &ethpb.BeaconBlock{Slot: 2, ParentRoot: parentRoot[:]}
+	child2Root, _ := ssz.SigningRoot(child2)
+	other := &ethpb.BeaconBlock{Slot: 2, ParentRoot: []byte("other")}
+	for _, b := range []*ethpb.BeaconBlock{parent, child1, child2, other} {
+		if err := db.SaveBlock(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	children, err := db.ChildrenOfBlock(parentRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The children are ordered by slot.
+	if len(children) != 2 || children[0] != child2Root || children[1] != child1Root {
+		t.Errorf("Expected children %#x and %#x, received %#x", child2Root, child1Root, children)
+	}
+
+	if err := db.DeleteBlock(child2); err != nil {
+		t.Fatal(err)
+	}
+	children, err = db.ChildrenOfBlock(parentRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 1 || children[0] != child1Root {
+		t.Errorf("Expected child %#x, received %#x", child1Root, children)
+	}
+
+	children, err = db.ChildrenOfBlock(child1Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 0 {
+		t.Errorf("Expected no children, received %#x", children)
+	}
+}
+
 func TestUpdateChainHead_NoBlock(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -50,6 +50,13 @@ func (db *BeaconDB) Close() error {
 	return db.db.Close()
 }
 
+// Ping returns an error if the underlying boltdb database is not open.
+func (db *BeaconDB) Ping() error {
+	return db.view(func(tx *bolt.Tx) error {
+		return nil
+	})
+}
+
 func (db *BeaconDB) update(fn func(*bolt.Tx) error) error {
 	return db.db.Update(fn)
 }
@@ -88,8 +95,27 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 	db.blocks = make(map[[32]byte]*ethpb.BeaconBlock)
 
 	if err := db.update(func(tx *bolt.Tx) error {
-		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
-			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+		indexChildren := tx.Bucket(blockChildrenBucket) == nil
+		indexStates := tx.Bucket(blockStatesBucket) == nil
+		if err := createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
+			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+			peerReputationBucket, archivedBalancesBucket, archivedParticipationBucket, depositLogsBucket,
+			eth1BlocksBucket, proposalHeadersBucket, proposerSlashingsBucket, attesterSlashingsBucket,
+			archivedStatesBucket, archivedCommitteesBucket, archivedProposersBucket, depositInclusionsBucket,
+			blockChildrenBucket, blockStatesBucket); err != nil {
+			return err
+		}
+		if indexChildren {
+			if err := indexBlockChildren(tx); err != nil {
+				return err
+			}
+		}
+		if indexStates {
+			if err := indexBlockStates(tx); err != nil {
+				return err
+			}
+		}
+		return backfillGenesisValidatorsRoot(tx)
 	}); err != nil {
 		return nil, err
 	}
@@ -53,3 +53,14 @@ func TestClearDB(t *testing.T) {
 		t.Fatalf("db wasnt cleared %v", err)
 	}
 }
+
+func TestPing(t *testing.T) {
+	beaconDB := setupDB(t)
+	if err := beaconDB.Ping(); err != nil {
+		t.Fatalf("Expected open database to respond, received %v", err)
+	}
+	teardownDB(t, beaconDB)
+	if err := beaconDB.Ping(); err == nil {
+		t.Error("Expected closed database to return an error")
+	}
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// DepositInclusion is the beacon block which included the deposit of a public key.
+type DepositInclusion struct {
+	Slot      uint64
+	BlockRoot [32]byte
+}
+
+// SaveDepositInclusion records the block which included the deposit of the public key. A
+// deposit included again in a block of another fork replaces the previous record, so the
+// record follows the latest block processed by the node.
+func (db *BeaconDB) SaveDepositInclusion(ctx context.Context, pubkey []byte, slot uint64, blockRoot [32]byte) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositInclusion")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(depositInclusionsBucket).Put(pubkey, encodeSlotNumberRoot(slot, blockRoot))
+	})
+}
+
+// DepositInclusion returns the block which included the deposit of the public key, or nil
+// if no processed block included it.
+func (db *BeaconDB) DepositInclusion(ctx context.Context, pubkey []byte) (*DepositInclusion, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DepositInclusion")
+	defer span.End()
+
+	var inclusion *DepositInclusion
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(depositInclusionsBucket).Get(pubkey)
+		if enc == nil {
+			return nil
+		}
+		if len(enc) != 8+32 {
+			return errors.New("invalid persisted deposit inclusion")
+		}
+		inclusion = &DepositInclusion{
+			Slot:      decodeToSlotNumber(enc[:8]),
+			BlockRoot: bytesutil.ToBytes32(enc[8:]),
+		}
+		return nil
+	})
+	return inclusion, err
+}
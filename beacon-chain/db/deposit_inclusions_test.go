@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDepositInclusion_SaveAndRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	pubkey := []byte("pubkey")
+
+	inclusion, err := db.DepositInclusion(ctx, pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inclusion != nil {
+		t.Errorf("Expected no deposit inclusion, received %+v", inclusion)
+	}
+
+	if err := db.SaveDepositInclusion(ctx, pubkey, 10, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveDepositInclusion(ctx, pubkey, 12, [32]byte{'B'}); err != nil {
+		t.Fatal(err)
+	}
+	inclusion, err = db.DepositInclusion(ctx, pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &DepositInclusion{Slot: 12, BlockRoot: [32]byte{'B'}}
+	if inclusion == nil || *inclusion != *want {
+		t.Errorf("Expected deposit inclusion %+v, received %+v", want, inclusion)
+	}
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+var (
+	lastProcessedEth1BlockKey = []byte("last-processed-eth1-block")
+	chainStartDataKey         = []byte("chain-start-data")
+)
+
+// DepositRoot returns the root of the deposit trie after the deposit was inserted.
+func (c *DepositContainer) DepositRoot() [32]byte {
+	return c.depositRoot
+}
+
+// PersistDeposits stores the historical deposits starting at the given index in the
+// deposit trie, so that the deposits and the deposit trie can be restored on restart.
+func (db *BeaconDB) PersistDeposits(ctx context.Context, fromIndex int) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PersistDeposits")
+	defer span.End()
+	db.depositsLock.RLock()
+	defer db.depositsLock.RUnlock()
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(depositLogsBucket)
+		for _, ctnr := range db.deposits {
+			if ctnr.Index < fromIndex {
+				continue
+			}
+			enc, err := encodeStoredDeposit(ctnr)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(encodeDepositIndex(uint64(ctnr.Index)), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PruneFinalizedDeposits removes the pending deposits older than the given deposit
+// index, along with the Merkle proofs of the historical deposits older than the index,
+// both in memory and in the persisted deposits. It is called with the deposit index of
+// the finalized state, as the deposits it includes never need to be proposed again.
+func (db *BeaconDB) PruneFinalizedDeposits(ctx context.Context, merkleTreeIndex int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneFinalizedDeposits")
+	defer span.End()
+
+	db.PrunePendingDeposits(ctx, merkleTreeIndex)
+
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	var pruned []*DepositContainer
+	for _, ctnr := range db.deposits {
+		if ctnr.Index >= merkleTreeIndex {
+			break
+		}
+		if ctnr.Deposit.Proof == nil {
+			continue
+		}
+		// The deposit is shared with the caches of other services, so it is copied
+		// rather than modified in place.
+		deposit := *ctnr.Deposit
+		deposit.Proof = nil
+		ctnr.Deposit = &deposit
+		pruned = append(pruned, ctnr)
+	}
+	if len(pruned) == 0 {
+		return nil
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(depositLogsBucket)
+		for _, ctnr := range pruned {
+			key := encodeDepositIndex(uint64(ctnr.Index))
+			if bucket.Get(key) == nil {
+				continue
+			}
+			enc, err := encodeStoredDeposit(ctnr)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(key, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PersistedDeposits retrieves the persisted deposits ordered by their index in the
+// deposit trie.
+func (db *BeaconDB) PersistedDeposits(ctx context.Context) ([]*DepositContainer, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.PersistedDeposits")
+	defer span.End()
+
+	var containers []*DepositContainer
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(depositLogsBucket)
+		return bucket.ForEach(func(k, v []byte) error {
+			stored := &pb.StoredDeposit{}
+			if err := proto.Unmarshal(v, stored); err != nil {
+				return err
+			}
+			containers = append(containers, &DepositContainer{
+				Deposit:     stored.Deposit,
+				Block:       big.NewInt(0).SetUint64(stored.Eth1BlockHeight),
+				Index:       int(stored.Index),
+				depositRoot: bytesutil.ToBytes32(stored.DepositRoot),
+			})
+			return nil
+		})
+	})
+	return containers, err
+}
+
+// SaveLastProcessedEth1Block persists the number of the last eth1 block whose deposit
+// logs were processed.
+func (db *BeaconDB) SaveLastProcessedEth1Block(ctx context.Context, blockNum *big.Int) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLastProcessedEth1Block")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		return chainInfo.Put(lastProcessedEth1BlockKey, blockNum.Bytes())
+	})
+}
+
+// LastProcessedEth1Block retrieves the number of the last eth1 block whose deposit logs
+// were processed. It returns nil if no block was processed yet.
+func (db *BeaconDB) LastProcessedEth1Block(ctx context.Context) (*big.Int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastProcessedEth1Block")
+	defer span.End()
+
+	var blockNum *big.Int
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		enc := chainInfo.Get(lastProcessedEth1BlockKey)
+		if enc != nil {
+			blockNum = big.NewInt(0).SetBytes(enc)
+		}
+		return nil
+	})
+	return blockNum, err
+}
+
+// SaveChainStartData persists the chain start event observed in the deposit contract logs.
+func (db *BeaconDB) SaveChainStartData(ctx context.Context, data *pb.ChainStartData) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveChainStartData")
+	defer span.End()
+
+	enc, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		return chainInfo.Put(chainStartDataKey, enc)
+	})
+}
+
+// ChainStartData retrieves the persisted chain start event. It returns nil if the chain
+// did not start yet.
+func (db *BeaconDB) ChainStartData(ctx context.Context) (*pb.ChainStartData, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ChainStartData")
+	defer span.End()
+
+	var data *pb.ChainStartData
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		enc := chainInfo.Get(chainStartDataKey)
+		if enc == nil {
+			return nil
+		}
+		data = &pb.ChainStartData{}
+		return proto.Unmarshal(enc, data)
+	})
+	return data, err
+}
+
+func encodeStoredDeposit(ctnr *DepositContainer) ([]byte, error) {
+	return proto.Marshal(&pb.StoredDeposit{
+		Deposit:         ctnr.Deposit,
+		Eth1BlockHeight: ctnr.Block.Uint64(),
+		Index:           uint64(ctnr.Index),
+		DepositRoot:     ctnr.depositRoot[:],
+	})
+}
+
+// encodeDepositIndex encodes a deposit index as big-endian, so that the deposits are
+// iterated in the order of their index.
+func encodeDepositIndex(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	return enc
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestPersistDeposits_OrderedByIndex(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for _, index := range []int{2, 0, 256} {
+		deposit := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(index)}}}
+		db.InsertDeposit(ctx, deposit, big.NewInt(int64(100+index)), index, [32]byte{byte(index)})
+	}
+	if err := db.PersistDeposits(ctx, 0); err != nil {
+		t.Fatalf("Failed to persist deposits: %v", err)
+	}
+	db.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{}}, big.NewInt(101), 1, [32]byte{1})
+	if err := db.PersistDeposits(ctx, 1); err != nil {
+		t.Fatalf("Failed to persist deposits: %v", err)
+	}
+
+	containers, err := db.PersistedDeposits(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve persisted deposits: %v", err)
+	}
+	wantedIndices := []int{0, 1, 2, 256}
+	if len(containers) != len(wantedIndices) {
+		t.Fatalf("Expected %d deposits, received %d", len(wantedIndices), len(containers))
+	}
+	for i, c := range containers {
+		if c.Index != wantedIndices[i] {
+			t.Errorf("Expected deposit %d to have index %d, received %d", i, wantedIndices[i], c.Index)
+		}
+		if c.Block.Int64() != int64(100+c.Index) {
+			t.Errorf("Expected deposit %d in block %d, received %d", c.Index, 100+c.Index, c.Block)
+		}
+		if c.DepositRoot() != [32]byte{byte(c.Index)} {
+			t.Errorf("Unexpected deposit root %#x for deposit %d", c.DepositRoot(), c.Index)
+		}
+	}
+}
+
+func TestSaveAndRetrieveLastProcessedEth1Block_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	blockNum, err := db.LastProcessedEth1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNum != nil {
+		t.Errorf("Expected no processed block, received %d", blockNum)
+	}
+
+	if err := db.SaveLastProcessedEth1Block(ctx, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	blockNum, err = db.LastProcessedEth1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockNum == nil || blockNum.Int64() != 1000 {
+		t.Errorf("Expected last processed block 1000, received %v", blockNum)
+	}
+}
+
+func TestSaveAndRetrieveChainStartData_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	data := &pb.ChainStartData{
+		GenesisTime: 100,
+		Eth1Data:    &ethpb.Eth1Data{DepositCount: 64, BlockHash: []byte("hash")},
+	}
+	if err := db.SaveChainStartData(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+	received, err := db.ChainStartData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(received, data) {
+		t.Errorf("Expected chain start data %v, received %v", data, received)
+	}
+}
+
+func TestPruneFinalizedDeposits_RemovesPendingDepositsAndProofs(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		deposit := &ethpb.Deposit{
+			Data:  &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}},
+			Proof: [][]byte{{byte(i)}},
+		}
+		db.InsertDeposit(ctx, deposit, big.NewInt(int64(i)), i, [32]byte{})
+		db.InsertPendingDeposit(ctx, deposit, big.NewInt(int64(i)), i, [32]byte{})
+	}
+	if err := db.PersistDeposits(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.PruneFinalizedDeposits(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+	if pending := db.PendingDeposits(ctx, nil); len(pending) != 2 {
+		t.Errorf("Expected 2 pending deposits after pruning, received %d", len(pending))
+	}
+	containers, err := db.PersistedDeposits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, deposit := range db.AllDeposits(ctx, nil) {
+		pruned := i < 2
+		if pruned != (deposit.Proof == nil) {
+			t.Errorf("Expected proof of deposit %d to be pruned: %v, received %v", i, pruned, deposit.Proof)
+		}
+		if pruned != (containers[i].Deposit.Proof == nil) {
+			t.Errorf("Expected persisted proof of deposit %d to be pruned: %v, received %v", i, pruned, containers[i].Deposit.Proof)
+		}
+	}
+}
@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"sort"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
 var (
-	historicalDepositsCount = promauto.NewCounter(prometheus.CounterOpts{
+	historicalDepositsCount = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "beacondb_all_deposits",
 		Help: "The number of total deposits in the beaconDB in-memory database",
 	})
@@ -124,3 +128,57 @@ func (db *BeaconDB) DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.
 	}
 	return deposit, blockNum
 }
+
+// DepositsWithProofs returns the deposits with Merkle indices in [start, end), each
+// along with its Merkle proof against the deposit root of the eth1 data, so that they
+// can be included in a block voting for the eth1 data. The eth1 data commits to its
+// first DepositCount deposits, which must all be known and hash to its deposit root.
+// The cached deposits are not modified.
+func (db *BeaconDB) DepositsWithProofs(ctx context.Context, eth1Data *ethpb.Eth1Data, start uint64, end uint64) ([]*ethpb.Deposit, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DepositsWithProofs")
+	defer span.End()
+	if start > end || end > eth1Data.DepositCount {
+		return nil, fmt.Errorf("deposit range [%d, %d) is not covered by the %d deposits of the eth1 data", start, end, eth1Data.DepositCount)
+	}
+	if start == end {
+		return nil, nil
+	}
+
+	db.depositsLock.RLock()
+	defer db.depositsLock.RUnlock()
+	if uint64(len(db.deposits)) < eth1Data.DepositCount {
+		return nil, fmt.Errorf("only %d of the %d deposits of the eth1 data are known", len(db.deposits), eth1Data.DepositCount)
+	}
+	leaves := make([][]byte, eth1Data.DepositCount)
+	for i := range leaves {
+		ctnr := db.deposits[i]
+		if ctnr.Index != i {
+			return nil, fmt.Errorf("deposit %d is missing", i)
+		}
+		leaf, err := hashutil.DepositHash(ctnr.Deposit.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash deposit data: %v", err)
+		}
+		leaves[i] = leaf[:]
+	}
+	trie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate deposit trie: %v", err)
+	}
+	if root := trie.Root(); !bytes.Equal(root[:], eth1Data.DepositRoot) {
+		return nil, fmt.Errorf("deposit root %#x of the known deposits does not match the eth1 data deposit root %#x", root, eth1Data.DepositRoot)
+	}
+
+	deposits := make([]*ethpb.Deposit, 0, end-start)
+	for i := start; i < end; i++ {
+		proof, err := trie.MerkleProof(int(i))
+		if err != nil {
+			return nil, fmt.Errorf("could not generate merkle proof for deposit at index %d: %v", i, err)
+		}
+		deposits = append(deposits, &ethpb.Deposit{
+			Data:  db.deposits[i].Deposit.Data,
+			Proof: proof,
+		})
+	}
+	return deposits, nil
+}
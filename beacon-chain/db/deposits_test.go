@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestDepositsWithProofs_VerifyAgainstEth1DataRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	var leaves [][]byte
+	var eth1Data *ethpb.Eth1Data
+	for i := 0; i < 4; i++ {
+		data := &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}, Signature: make([]byte, 96), WithdrawalCredentials: make([]byte, 32)}
+		leaf, err := hashutil.DepositHash(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		leaves = append(leaves, leaf[:])
+		trie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+		if err != nil {
+			t.Fatal(err)
+		}
+		root := trie.Root()
+		db.InsertDeposit(ctx, &ethpb.Deposit{Data: data}, big.NewInt(int64(i)), i, root)
+		// The eth1 data commits to the first 3 deposits.
+		if i == 2 {
+			eth1Data = &ethpb.Eth1Data{DepositCount: 3, DepositRoot: root[:]}
+		}
+	}
+
+	deposits, err := db.DepositsWithProofs(ctx, eth1Data, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != 2 {
+		t.Fatalf("Expected 2 deposits, received %d", len(deposits))
+	}
+	for i, dep := range deposits {
+		index := i + 1
+		if !trieutil.VerifyMerkleProof(eth1Data.DepositRoot, leaves[index], index, dep.Proof) {
+			t.Errorf("Proof of deposit %d does not verify against the eth1 data root", index)
+		}
+	}
+	for _, dep := range db.AllDeposits(ctx, nil) {
+		if dep.Proof != nil {
+			t.Error("Expected the cached deposits not to be modified")
+		}
+	}
+}
+
+func TestDepositsWithProofs_Errors(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	data := &ethpb.Deposit_Data{PublicKey: []byte{1}, Signature: make([]byte, 96), WithdrawalCredentials: make([]byte, 32)}
+	db.InsertDeposit(ctx, &ethpb.Deposit{Data: data}, big.NewInt(0), 0, [32]byte{})
+
+	tests := []struct {
+		eth1Data *ethpb.Eth1Data
+		start    uint64
+		end      uint64
+	}{
+		// Range beyond the deposits of the eth1 data.
+		{eth1Data: &ethpb.Eth1Data{DepositCount: 1}, start: 0, end: 2},
+		// Eth1 data committing to more deposits than known.
+		{eth1Data: &ethpb.Eth1Data{DepositCount: 2}, start: 0, end: 1},
+		// Deposit root not matching the known deposits.
+		{eth1Data: &ethpb.Eth1Data{DepositCount: 1, DepositRoot: make([]byte, 32)}, start: 0, end: 1},
+	}
+	for i, tt := range tests {
+		if _, err := db.DepositsWithProofs(ctx, tt.eth1Data, tt.start, tt.end); err == nil {
+			t.Errorf("Test %d: expected error", i)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/boltdb/bolt"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opencensus.io/trace"
+)
+
+// Eth1BlockInfo is the hash, number and timestamp of an eth1 block, persisted so that
+// the eth1 blocks looked up by the powchain service do not need to be requested again
+// after a restart.
+type Eth1BlockInfo struct {
+	Hash   common.Hash
+	Number *big.Int
+	Time   uint64
+}
+
+// SaveEth1BlockInfos persists the eth1 block infos. Only blocks which are not expected
+// to be reorged should be saved, as the block at a height is not replaced.
+func (db *BeaconDB) SaveEth1BlockInfos(ctx context.Context, infos []*Eth1BlockInfo) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveEth1BlockInfos")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(eth1BlocksBucket)
+		for _, info := range infos {
+			if !info.Number.IsUint64() {
+				return errors.New("eth1 block number does not fit in 64 bits")
+			}
+			if err := bucket.Put(encodeEth1BlockKey(info), encodeUint64(info.Time)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RecentEth1BlockInfos retrieves at most limit of the persisted eth1 block infos with
+// the highest block numbers, ordered by decreasing block number.
+func (db *BeaconDB) RecentEth1BlockInfos(ctx context.Context, limit int) ([]*Eth1BlockInfo, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.RecentEth1BlockInfos")
+	defer span.End()
+
+	var infos []*Eth1BlockInfo
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(eth1BlocksBucket).Cursor()
+		for k, v := c.Last(); k != nil && len(infos) < limit; k, v = c.Prev() {
+			if len(k) != 8+common.HashLength || len(v) != 8 {
+				return errors.New("invalid persisted eth1 block info")
+			}
+			infos = append(infos, &Eth1BlockInfo{
+				Number: big.NewInt(0).SetUint64(binary.BigEndian.Uint64(k[:8])),
+				Hash:   common.BytesToHash(k[8:]),
+				Time:   binary.BigEndian.Uint64(v),
+			})
+		}
+		return nil
+	})
+	return infos, err
+}
+
+// encodeEth1BlockKey keys the block info by its big-endian block number followed by its
+// hash, so that the bucket is iterated in block number order.
+func encodeEth1BlockKey(info *Eth1BlockInfo) []byte {
+	return append(encodeUint64(info.Number.Uint64()), info.Hash.Bytes()...)
+}
+
+func encodeUint64(v uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, v)
+	return enc
+}
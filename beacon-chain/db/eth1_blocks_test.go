@@ -0,0 +1,54 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestSaveEth1BlockInfos_RecentFirst(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	var infos []*Eth1BlockInfo
+	for i := uint64(0); i < 5; i++ {
+		infos = append(infos, &Eth1BlockInfo{
+			Hash:   common.BytesToHash([]byte{byte(i + 1)}),
+			Number: big.NewInt(int64(i) * 300),
+			Time:   1000 + i*14,
+		})
+	}
+	if err := db.SaveEth1BlockInfos(ctx, infos); err != nil {
+		t.Fatal(err)
+	}
+
+	recent, err := db.RecentEth1BlockInfos(ctx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 3 {
+		t.Fatalf("Expected 3 block infos, got %d", len(recent))
+	}
+	for i, info := range recent {
+		want := infos[len(infos)-1-i]
+		if info.Hash != want.Hash || info.Number.Cmp(want.Number) != 0 || info.Time != want.Time {
+			t.Errorf("Block info %d = %+v, want %+v", i, info, want)
+		}
+	}
+}
+
+func TestRecentEth1BlockInfos_Empty(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	infos, err := db.RecentEth1BlockInfos(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 0 {
+		t.Errorf("Expected no block infos, got %d", len(infos))
+	}
+}
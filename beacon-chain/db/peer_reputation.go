@@ -0,0 +1,38 @@
+package db
+
+import (
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+)
+
+// SavePeerReputations persists the reputation scores of peers, keyed by their base58 peer ID.
+func (db *BeaconDB) SavePeerReputations(scores map[string]int) error {
+	return db.batch(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(peerReputationBucket)
+		for peerID, val := range scores {
+			enc := make([]byte, 8)
+			binary.LittleEndian.PutUint64(enc, uint64(int64(val)))
+			if err := bucket.Put([]byte(peerID), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PeerReputations returns the persisted reputation score of every known peer.
+func (db *BeaconDB) PeerReputations() (map[string]int, error) {
+	scores := make(map[string]int)
+	err := db.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(peerReputationBucket)
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(v) != 8 {
+				return nil
+			}
+			scores[string(k)] = int(int64(binary.LittleEndian.Uint64(v)))
+			return nil
+		})
+	})
+	return scores, err
+}
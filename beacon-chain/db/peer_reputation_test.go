@@ -0,0 +1,31 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestSaveAndRetrievePeerReputation_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.SavePeerReputations(map[string]int{"peerA": 10, "peerB": -1000}); err != nil {
+		t.Fatalf("Failed to save peer reputations: %v", err)
+	}
+	if err := db.SavePeerReputations(map[string]int{"peerA": 14}); err != nil {
+		t.Fatalf("Failed to save peer reputations: %v", err)
+	}
+
+	scores, err := db.PeerReputations()
+	if err != nil {
+		t.Fatalf("Failed to retrieve peer reputations: %v", err)
+	}
+	if len(scores) != 2 {
+		t.Fatalf("Expected 2 peer reputations, received %d", len(scores))
+	}
+	if scores["peerA"] != 14 {
+		t.Errorf("Expected score 14 for peerA, received %d", scores["peerA"])
+	}
+	if scores["peerB"] != -1000 {
+		t.Errorf("Expected score -1000 for peerB, received %d", scores["peerB"])
+	}
+}
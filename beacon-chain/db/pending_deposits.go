@@ -6,15 +6,15 @@ import (
 	"sort"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
 var (
-	pendingDepositsCount = promauto.NewGauge(prometheus.GaugeOpts{
+	pendingDepositsCount = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "beacondb_pending_deposits",
 		Help: "The number of pending deposits in the beaconDB in-memory database",
 	})
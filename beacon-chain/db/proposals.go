@@ -0,0 +1,78 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposalHeader persists a signed block header of the proposer, keyed by its slot,
+// the proposer index and the signing root of the header, so that conflicting proposals
+// can be detected after a restart.
+func (db *BeaconDB) SaveProposalHeader(ctx context.Context, proposerIndex uint64, signingRoot [32]byte, header *ethpb.BeaconBlockHeader) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveProposalHeader")
+	defer span.End()
+
+	enc, err := proto.Marshal(header)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(proposalHeadersBucket)
+		return bucket.Put(encodeProposalKey(header.Slot, proposerIndex, signingRoot), enc)
+	})
+}
+
+// ProposalHeaders retrieves the signed block headers of the proposer at the slot, keyed
+// by their signing root.
+func (db *BeaconDB) ProposalHeaders(ctx context.Context, proposerIndex uint64, slot uint64) (map[[32]byte]*ethpb.BeaconBlockHeader, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposalHeaders")
+	defer span.End()
+
+	headers := make(map[[32]byte]*ethpb.BeaconBlockHeader)
+	prefix := append(encodeUint64(slot), encodeUint64(proposerIndex)...)
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(proposalHeadersBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			header := &ethpb.BeaconBlockHeader{}
+			if err := proto.Unmarshal(v, header); err != nil {
+				return err
+			}
+			var root [32]byte
+			copy(root[:], k[len(prefix):])
+			headers[root] = header
+		}
+		return nil
+	})
+	return headers, err
+}
+
+// DeleteProposalHeadersBefore removes the persisted block headers of the slots before
+// the given slot.
+func (db *BeaconDB) DeleteProposalHeadersBefore(ctx context.Context, slot uint64) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteProposalHeadersBefore")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(proposalHeadersBucket).Cursor()
+		// Deleting through the cursor moves it to the next key.
+		for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k[:8]) < slot; k, _ = c.First() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// encodeProposalKey keys a header by its big-endian slot and proposer index followed by
+// its signing root, so that the bucket is iterated in slot order.
+func encodeProposalKey(slot uint64, proposerIndex uint64, signingRoot [32]byte) []byte {
+	key := append(encodeUint64(slot), encodeUint64(proposerIndex)...)
+	return append(key, signingRoot[:]...)
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestProposalHeaders_SaveAndRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	header1 := &ethpb.BeaconBlockHeader{Slot: 5, StateRoot: []byte{'A'}, Signature: []byte{'1'}}
+	header2 := &ethpb.BeaconBlockHeader{Slot: 5, StateRoot: []byte{'B'}, Signature: []byte{'2'}}
+	other := &ethpb.BeaconBlockHeader{Slot: 6, StateRoot: []byte{'C'}}
+	if err := db.SaveProposalHeader(ctx, 3, [32]byte{1}, header1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveProposalHeader(ctx, 3, [32]byte{2}, header2); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveProposalHeader(ctx, 4, [32]byte{3}, header1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveProposalHeader(ctx, 3, [32]byte{4}, other); err != nil {
+		t.Fatal(err)
+	}
+
+	headers, err := db.ProposalHeaders(ctx, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(headers) != 2 {
+		t.Fatalf("Expected 2 headers, received %d", len(headers))
+	}
+	if !proto.Equal(headers[[32]byte{1}], header1) || !proto.Equal(headers[[32]byte{2}], header2) {
+		t.Errorf("Unexpected headers %v", headers)
+	}
+}
+
+func TestDeleteProposalHeadersBefore(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	for slot := uint64(1); slot <= 4; slot++ {
+		if err := db.SaveProposalHeader(ctx, 1, [32]byte{byte(slot)}, &ethpb.BeaconBlockHeader{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.DeleteProposalHeadersBefore(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	for slot := uint64(1); slot <= 4; slot++ {
+		headers, err := db.ProposalHeaders(ctx, 1, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := slot >= 3; (len(headers) == 1) != want {
+			t.Errorf("Expected header at slot %d to be kept: %v, received %d headers", slot, want, len(headers))
+		}
+	}
+}
@@ -23,6 +23,39 @@ var (
 	histStateBucket         = []byte("historical-state-bucket")
 	chainInfoBucket         = []byte("chain-info")
 	validatorBucket         = []byte("validator")
+	peerReputationBucket    = []byte("peer-reputation")
+
+	// Roots of the child blocks of each block, keyed by the parent root.
+	blockChildrenBucket = []byte("block-children")
+
+	// Hashes of the post states of the blocks, keyed by block root. The states are saved
+	// in the chain info bucket under their hash, as the historical states.
+	blockStatesBucket = []byte("block-states")
+
+	// Data archived at epoch transitions for historical queries.
+	archivedBalancesBucket      = []byte("archived-balances")
+	archivedParticipationBucket = []byte("archived-participation")
+
+	// Data archived at epoch transitions only by nodes in archive mode.
+	archivedStatesBucket     = []byte("archived-states")
+	archivedCommitteesBucket = []byte("archived-committees")
+	archivedProposersBucket  = []byte("archived-proposers")
+
+	// Deposit contract logs processed by the powchain service.
+	depositLogsBucket = []byte("deposit-logs")
+
+	// Blocks including the deposit of each public key, recorded by the chain service.
+	depositInclusionsBucket = []byte("deposit-inclusions")
+
+	// Eth1 block infos looked up by the powchain service.
+	eth1BlocksBucket = []byte("eth1-blocks")
+
+	// Signed block headers checked by the slasher for conflicting proposals.
+	proposalHeadersBucket = []byte("proposal-headers")
+
+	// Slashings pending inclusion in a block, kept by the operations service.
+	proposerSlashingsBucket = []byte("proposer-slashings")
+	attesterSlashingsBucket = []byte("attester-slashings")
 
 	mainChainHeightKey      = []byte("chain-height")
 	canonicalHeadKey        = []byte("canonical-head")
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveProposerSlashing puts the proposer slashing into the beacon chain db, keyed by
+// its hash.
+func (db *BeaconDB) SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveProposerSlashing")
+	defer span.End()
+
+	return db.saveOperation(proposerSlashingsBucket, slashing)
+}
+
+// HasProposerSlashing checks if the proposer slashing exists.
+func (db *BeaconDB) HasProposerSlashing(hash [32]byte) bool {
+	return db.hasOperation(proposerSlashingsBucket, hash)
+}
+
+// DeleteProposerSlashing removes the proposer slashing from the db.
+func (db *BeaconDB) DeleteProposerSlashing(slashing *ethpb.ProposerSlashing) error {
+	return db.deleteOperation(proposerSlashingsBucket, slashing)
+}
+
+// ProposerSlashings retrieves all the proposer slashings from the db.
+func (db *BeaconDB) ProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ProposerSlashings")
+	defer span.End()
+
+	var slashings []*ethpb.ProposerSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(proposerSlashingsBucket).ForEach(func(k, v []byte) error {
+			slashing := &ethpb.ProposerSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// SaveAttesterSlashing puts the attester slashing into the beacon chain db, keyed by
+// its hash.
+func (db *BeaconDB) SaveAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveAttesterSlashing")
+	defer span.End()
+
+	return db.saveOperation(attesterSlashingsBucket, slashing)
+}
+
+// HasAttesterSlashing checks if the attester slashing exists.
+func (db *BeaconDB) HasAttesterSlashing(hash [32]byte) bool {
+	return db.hasOperation(attesterSlashingsBucket, hash)
+}
+
+// DeleteAttesterSlashing removes the attester slashing from the db.
+func (db *BeaconDB) DeleteAttesterSlashing(slashing *ethpb.AttesterSlashing) error {
+	return db.deleteOperation(attesterSlashingsBucket, slashing)
+}
+
+// AttesterSlashings retrieves all the attester slashings from the db.
+func (db *BeaconDB) AttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.AttesterSlashings")
+	defer span.End()
+
+	var slashings []*ethpb.AttesterSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(attesterSlashingsBucket).ForEach(func(k, v []byte) error {
+			slashing := &ethpb.AttesterSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return err
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+func (db *BeaconDB) saveOperation(bucket []byte, op proto.Message) error {
+	hash, err := hashutil.HashProto(op)
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(op)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(hash[:], enc)
+	})
+}
+
+func (db *BeaconDB) hasOperation(bucket []byte, hash [32]byte) bool {
+	exists := false
+	// #nosec G104
+	db.view(func(tx *bolt.Tx) error {
+		exists = tx.Bucket(bucket).Get(hash[:]) != nil
+		return nil
+	})
+	return exists
+}
+
+func (db *BeaconDB) deleteOperation(bucket []byte, op proto.Message) error {
+	hash, err := hashutil.HashProto(op)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Delete(hash[:])
+	})
+}
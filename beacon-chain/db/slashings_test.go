@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestProposerSlashings_SaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slashing := &ethpb.ProposerSlashing{
+		ProposerIndex: 5,
+		Header_1:      &ethpb.BeaconBlockHeader{Slot: 1, StateRoot: []byte{'A'}},
+		Header_2:      &ethpb.BeaconBlockHeader{Slot: 1, StateRoot: []byte{'B'}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveProposerSlashing(ctx, slashing); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing to exist")
+	}
+	slashings, err := db.ProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 || !proto.Equal(slashings[0], slashing) {
+		t.Errorf("Unexpected proposer slashings %v", slashings)
+	}
+
+	if err := db.DeleteProposerSlashing(slashing); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasProposerSlashing(hash) {
+		t.Error("Expected proposer slashing to be deleted")
+	}
+}
+
+func TestAttesterSlashings_SaveRetrieveDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{1, 2}},
+		Attestation_2: &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{2, 3}},
+	}
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttesterSlashing(ctx, slashing); err != nil {
+		t.Fatal(err)
+	}
+	if !db.HasAttesterSlashing(hash) {
+		t.Error("Expected attester slashing to exist")
+	}
+	slashings, err := db.AttesterSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 || !proto.Equal(slashings[0], slashing) {
+		t.Errorf("Unexpected attester slashings %v", slashings)
+	}
+
+	if err := db.DeleteAttesterSlashing(slashing); err != nil {
+		t.Fatal(err)
+	}
+	if db.HasAttesterSlashing(hash) {
+		t.Error("Expected attester slashing to be deleted")
+	}
+}
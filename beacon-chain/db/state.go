@@ -10,19 +10,21 @@ import (
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-ssz"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
 var (
-	stateBytes = promauto.NewGauge(prometheus.GaugeOpts{
+	stateBytes = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "beacondb_state_size_bytes",
 		Help: "The protobuf encoded size of the last saved state in the beaconDB",
 	})
@@ -39,6 +41,11 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 		return err
 	}
 
+	validatorsRoot, err := ssz.HashTreeRootWithCapacity(beaconState.Validators, params.BeaconConfig().ValidatorRegistryLimit)
+	if err != nil {
+		return fmt.Errorf("could not compute genesis validators root: %v", err)
+	}
+
 	// #nosec G104
 	stateEnc, _ := proto.Marshal(beaconState)
 	stateHash := hashutil.Hash(stateEnc)
@@ -55,6 +62,9 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	if err := db.SaveState(ctx, beaconState); err != nil {
 		return err
 	}
+	if err := db.SaveStateByBlockRoot(ctx, blockRoot, beaconState); err != nil {
+		return err
+	}
 
 	return db.update(func(tx *bolt.Tx) error {
 		blockBkt := tx.Bucket(blockBucket)
@@ -87,6 +97,10 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 			}
 		}
 
+		if err := chainInfo.Put(genesisValidatorsRootKey, validatorsRoot[:]); err != nil {
+			return err
+		}
+
 		// Putting in finalized state.
 		if err := chainInfo.Put(finalizedStateLookupKey, stateEnc); err != nil {
 			return err
@@ -96,6 +110,58 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	})
 }
 
+var genesisValidatorsRootKey = []byte("genesis-validators-root")
+
+// GenesisValidatorsRoot returns the hash tree root of the validator registry of the
+// genesis state, or nil if the node was not initialized from the genesis state.
+func (db *BeaconDB) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.GenesisValidatorsRoot")
+	defer span.End()
+
+	var root []byte
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if r := chainInfo.Get(genesisValidatorsRootKey); r != nil {
+			root = append([]byte{}, r...)
+		}
+		return nil
+	})
+	return root, err
+}
+
+// backfillGenesisValidatorsRoot stores the genesis validators root for databases which were
+// initialized before the root was recorded, by looking up the genesis state of the canonical
+// chain. Nothing is stored if the chain has not started yet or the genesis state was pruned.
+func backfillGenesisValidatorsRoot(tx *bolt.Tx) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	if chainInfo.Get(genesisValidatorsRootKey) != nil {
+		return nil
+	}
+	genesisKey, _ := tx.Bucket(mainChainBucket).Cursor().Seek(encodeSlotNumber(0))
+	if genesisKey == nil || decodeToSlotNumber(genesisKey[:8]) != 0 {
+		return nil
+	}
+	stateHash := tx.Bucket(histStateBucket).Get(genesisKey)
+	if stateHash == nil {
+		log.Warn("Genesis state was pruned, could not backfill genesis validators root")
+		return nil
+	}
+	encState := chainInfo.Get(stateHash)
+	if encState == nil {
+		log.Warn("Genesis state was pruned, could not backfill genesis validators root")
+		return nil
+	}
+	genesisState, err := createState(encState)
+	if err != nil {
+		return err
+	}
+	validatorsRoot, err := ssz.HashTreeRootWithCapacity(genesisState.Validators, params.BeaconConfig().ValidatorRegistryLimit)
+	if err != nil {
+		return fmt.Errorf("could not compute genesis validators root: %v", err)
+	}
+	return chainInfo.Put(genesisValidatorsRootKey, validatorsRoot[:])
+}
+
 // HeadState fetches the canonical beacon chain's head state from the DB.
 func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	if ctx.Err() != nil {
@@ -224,11 +290,25 @@ func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
 	})
 }
 
-// SaveHistoricalState saves the last finalized state in the db.
+// SaveHistoricalState saves a state in the db, retrieved by its slot and the root of its
+// latest block with HistoricalStateFromSlot. States which are the post state of a block
+// are saved with SaveStateByBlockRoot instead.
 func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.BeaconState, blockRoot [32]byte) error {
-	ctx, span := trace.StartSpan(ctx, "beacon-chain.db.SaveHistoricalState")
+	_, span := trace.StartSpan(ctx, "beacon-chain.db.SaveHistoricalState")
+	defer span.End()
+	return db.saveHistoricalState(beaconState, blockRoot, false /* index by block root */)
+}
+
+// SaveStateByBlockRoot saves the post state of the block with the given root, which is
+// retrieved with StateByBlockRoot, and with HistoricalStateFromSlot as the historical
+// states, until it is pruned at finalization.
+func (db *BeaconDB) SaveStateByBlockRoot(ctx context.Context, blockRoot [32]byte, beaconState *pb.BeaconState) error {
+	_, span := trace.StartSpan(ctx, "beacon-chain.db.SaveStateByBlockRoot")
 	defer span.End()
+	return db.saveHistoricalState(beaconState, blockRoot, true /* index by block root */)
+}
 
+func (db *BeaconDB) saveHistoricalState(beaconState *pb.BeaconState, blockRoot [32]byte, indexByBlockRoot bool) error {
 	slotRootBinary := encodeSlotNumberRoot(beaconState.Slot, blockRoot)
 	stateHash, err := hashutil.HashProto(beaconState)
 	if err != nil {
@@ -241,6 +321,11 @@ func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.Bea
 		if err := histState.Put(slotRootBinary, stateHash[:]); err != nil {
 			return err
 		}
+		if indexByBlockRoot {
+			if err := tx.Bucket(blockStatesBucket).Put(blockRoot[:], stateHash[:]); err != nil {
+				return err
+			}
+		}
 		beaconStateEnc, err := proto.Marshal(beaconState)
 		if err != nil {
 			return err
@@ -249,6 +334,48 @@ func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.Bea
 	})
 }
 
+// StateByBlockRoot retrieves the post state of the block with the given root.
+// Returns nil if no state was saved for the block or if it was pruned.
+func (db *BeaconDB) StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (*pb.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "beacon-chain.db.StateByBlockRoot")
+	defer span.End()
+
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		stateHash := tx.Bucket(blockStatesBucket).Get(blockRoot[:])
+		if stateHash == nil {
+			return nil
+		}
+		encState := tx.Bucket(chainInfoBucket).Get(stateHash)
+		if encState == nil {
+			return nil
+		}
+
+		var err error
+		beaconState, err = createState(encState)
+		return err
+	})
+	return beaconState, err
+}
+
+// indexBlockStates indexes the historical states by their block root, for databases
+// created before the states were saved by block root. A block root may have states at
+// several slots when the head state was saved at skipped slots, in which case the state
+// at the lowest slot is the post state of the block.
+func indexBlockStates(tx *bolt.Tx) error {
+	blockStates := tx.Bucket(blockStatesBucket)
+	lowestSlots := make(map[[32]byte]uint64)
+	return tx.Bucket(histStateBucket).ForEach(func(k, v []byte) error {
+		slot := decodeToSlotNumber(k[:8])
+		root := bytesutil.ToBytes32(k[8:])
+		if lowest, ok := lowestSlots[root]; ok && lowest <= slot {
+			return nil
+		}
+		lowestSlots[root] = slot
+		return blockStates.Put(root[:], v)
+	})
+}
+
 // JustifiedState retrieves the justified state from the db.
 func (db *BeaconDB) JustifiedState() (*pb.BeaconState, error) {
 	var beaconState *pb.BeaconState
@@ -475,6 +602,7 @@ func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
 	}
 	return db.update(func(tx *bolt.Tx) error {
 		histState := tx.Bucket(histStateBucket)
+		blockStates := tx.Bucket(blockStatesBucket)
 		chainInfo := tx.Bucket(chainInfoBucket)
 		hsCursor := histState.Cursor()
 
@@ -485,6 +613,9 @@ func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
 				if err := histState.Delete(k); err != nil {
 					return err
 				}
+				if err := blockStates.Delete(k[8:]); err != nil {
+					return err
+				}
 				if err := chainInfo.Delete(v); err != nil {
 					return err
 				}
@@ -5,53 +5,53 @@ import (
 	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 var (
-	validatorBalancesGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	validatorBalancesGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "state_validator_balances",
 		Help: "Balances of validators, updated on epoch transition",
 	}, []string{
 		"validator",
 	})
-	validatorActivatedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	validatorActivatedGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "state_validator_activated_epoch",
 		Help: "Activated epoch of validators, updated on epoch transition",
 	}, []string{
 		"validatorIndex",
 	})
-	validatorExitedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	validatorExitedGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "state_validator_exited_epoch",
 		Help: "Exited epoch of validators, updated on epoch transition",
 	}, []string{
 		"validatorIndex",
 	})
-	validatorSlashedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	validatorSlashedGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "state_validator_slashed_epoch",
 		Help: "Slashed epoch of validators, updated on epoch transition",
 	}, []string{
 		"validatorIndex",
 	})
-	lastSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	lastSlotGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "state_last_slot",
 		Help: "Last slot number of the processed state",
 	})
-	lastJustifiedEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	lastJustifiedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "state_last_justified_epoch",
 		Help: "Last justified epoch of the processed state",
 	})
-	lastPrevJustifiedEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	lastPrevJustifiedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "state_last_prev_justified_epoch",
 		Help: "Last prev justified epoch of the processed state",
 	})
-	lastFinalizedEpochGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	lastFinalizedEpochGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "state_last_finalized_epoch",
 		Help: "Last finalized epoch of the processed state",
 	})
-	activeValidatorsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	activeValidatorsGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "state_active_validators",
 		Help: "Total number of active validators",
 	})
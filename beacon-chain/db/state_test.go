@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -63,6 +65,56 @@ func TestInitializeState_OK(t *testing.T) {
 	if !bytes.Equal(beaconStateEnc, statePrimeEnc) {
 		t.Fatalf("Expected %#x and %#x to be equal", beaconStateEnc, statePrimeEnc)
 	}
+
+	validatorsRoot, err := ssz.HashTreeRootWithCapacity(beaconState.Validators, params.BeaconConfig().ValidatorRegistryLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisValidatorsRoot, err := db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(genesisValidatorsRoot, validatorsRoot[:]) {
+		t.Errorf("Expected genesis validators root %#x, received %#x", validatorsRoot, genesisValidatorsRoot)
+	}
+}
+
+func TestNewDB_BackfillsGenesisValidatorsRoot(t *testing.T) {
+	db := setupDB(t)
+	defer func() {
+		teardownDB(t, db)
+	}()
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	want, err := db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Simulate a database which was initialized before the root was recorded.
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Delete(genesisValidatorsRootKey)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	received, err := db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(received, want) {
+		t.Errorf("Expected backfilled genesis validators root %#x, received %#x", want, received)
+	}
 }
 
 func TestFinalizeState_OK(t *testing.T) {
@@ -358,3 +410,88 @@ func TestHistoricalState_Pruning(t *testing.T) {
 
 	}
 }
+
+func TestStateByBlockRoot_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// Blocks of two forks at the same slot have their own states.
+	state1 := &pb.BeaconState{Slot: 10, GenesisTime: 1}
+	state2 := &pb.BeaconState{Slot: 10, GenesisTime: 2}
+	root1 := [32]byte{'A'}
+	root2 := [32]byte{'B'}
+	if err := db.SaveStateByBlockRoot(ctx, root1, state1); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateByBlockRoot(ctx, root2, state2); err != nil {
+		t.Fatal(err)
+	}
+
+	for root, want := range map[[32]byte]*pb.BeaconState{root1: state1, root2: state2} {
+		retState, err := db.StateByBlockRoot(ctx, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(want, retState) {
+			t.Errorf("Wanted state %v for root %#x, received %v", want, root, retState)
+		}
+	}
+
+	retState, err := db.StateByBlockRoot(ctx, [32]byte{'C'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retState != nil {
+		t.Errorf("Expected no state for an unknown block, received %v", retState)
+	}
+
+	// The states are pruned with the historical states at finalization.
+	if err := db.SaveFinalizedState(&pb.BeaconState{Slot: 11}); err != nil {
+		t.Fatal(err)
+	}
+	retState, err = db.StateByBlockRoot(ctx, root1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retState != nil {
+		t.Errorf("Expected the state to be pruned, received %v", retState)
+	}
+}
+
+func TestStateByBlockRoot_IndexesExistingStates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// A database whose states were only saved by slot, with the state of the block and a
+	// state at a later skipped slot.
+	root := [32]byte{'A'}
+	blockState := &pb.BeaconState{Slot: 10}
+	if err := db.SaveHistoricalState(ctx, blockState, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: 12}, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.DeleteBucket(blockStatesBucket)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	retState, err := db.StateByBlockRoot(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(blockState, retState) {
+		t.Errorf("Wanted state %v, received %v", blockState, retState)
+	}
+}
@@ -0,0 +1,383 @@
+// Package e2e runs a small network end to end under the minimal config: a simulated
+// ETH1.0 chain holding the deposits of the genesis validators, beacon nodes starting the
+// beacon chain from its deposit logs, and a validator client binary for each node, which
+// proposes and attests to blocks through the RPC server of the node. The cluster follows
+// the wall clock, gossiping the blocks and attestations over the simulated network during
+// each slot and checking the invariants every honest node upholds at the end of the slot,
+// so that scenarios such as reaching finality or recovering from a fork run as regular
+// tests.
+package e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "e2e")
+
+// Config options of a cluster.
+type Config struct {
+	// NodeCount is the number of beacon nodes.
+	NodeCount int
+	// ValidatorCount is the number of genesis validators deposited on the ETH1.0 chain,
+	// which are run by the validator clients of the nodes in a round robin fashion.
+	ValidatorCount uint64
+	// ValidatorBinary is the path of the validator client binary.
+	ValidatorBinary string
+	// DataDir is the directory under which the databases, keystores and validator client
+	// logs are created. It defaults to a temporary directory.
+	DataDir string
+}
+
+// Cluster is a running network of beacon nodes and their validator clients.
+type Cluster struct {
+	ctx         context.Context
+	eth1        *eth1
+	sim         *simulator.Simulator
+	rpcServices []*rpc.Service
+	validators  []*validatorClient
+	invariants  []Invariant
+	genesisTime time.Time
+	slot        uint64
+	prevConfig  *params.BeaconChainConfig
+	prevFeature *featureconfig.FeatureFlagConfig
+}
+
+// Start deposits the genesis validators on a simulated ETH1.0 chain, waits for the
+// deposits to start the beacon chain and starts the beacon nodes from the resulting
+// genesis state, along with a validator client for each node. It returns once the genesis
+// time is reached. The beacon chain config is replaced by the minimal config and the
+// genesis delay is disabled until the cluster is stopped, so only one cluster can run at
+// a time.
+func Start(ctx context.Context, cfg *Config) (*Cluster, error) {
+	if cfg.NodeCount <= 0 {
+		return nil, errors.New("cluster requires at least one node")
+	}
+	if cfg.ValidatorCount == 0 {
+		return nil, errors.New("cluster requires at least one validator")
+	}
+	if cfg.ValidatorBinary == "" {
+		return nil, errors.New("cluster requires the validator client binary")
+	}
+	dataDir := cfg.DataDir
+	if dataDir == "" {
+		dataDir = path.Join(os.TempDir(), "e2e")
+	}
+
+	c := &Cluster{
+		ctx:         ctx,
+		invariants:  DefaultInvariants(),
+		prevConfig:  params.BeaconConfig(),
+		prevFeature: featureconfig.FeatureConfig(),
+	}
+	params.OverrideBeaconConfig(minimalConfig(cfg.ValidatorCount))
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{NoGenesisDelay: true})
+
+	keys, err := simulator.InteropKeys(cfg.ValidatorCount)
+	if err != nil {
+		c.Stop()
+		return nil, err
+	}
+	eth1, genesisState, err := startEth1(ctx, keys, path.Join(dataDir, "eth1"))
+	if err != nil {
+		c.Stop()
+		return nil, fmt.Errorf("could not start beacon chain from eth1 deposits: %v", err)
+	}
+	c.eth1 = eth1
+	c.genesisTime = time.Unix(int64(genesisState.GenesisTime), 0)
+	c.sim, err = simulator.New(ctx, &simulator.Config{
+		NodeCount:          cfg.NodeCount,
+		Genesis:            genesisState,
+		Eth1:               eth1.chain,
+		DataDir:            dataDir,
+		ExternalValidators: true,
+	})
+	if err != nil {
+		c.Stop()
+		return nil, err
+	}
+	if err := c.startValidators(cfg.ValidatorBinary, keys, dataDir); err != nil {
+		c.Stop()
+		return nil, err
+	}
+	if err := c.checkInvariants(); err != nil {
+		c.Stop()
+		return nil, err
+	}
+
+	log.WithFields(logrus.Fields{
+		"nodes":       cfg.NodeCount,
+		"validators":  cfg.ValidatorCount,
+		"genesisTime": genesisState.GenesisTime,
+	}).Info("Started cluster, waiting for genesis")
+	if err := c.waitUntil(c.genesisTime); err != nil {
+		c.Stop()
+		return nil, err
+	}
+	return c, nil
+}
+
+// startValidators starts the RPC server of each node and a validator client connected to
+// it, which runs the validators assigned to the node in a round robin fashion.
+func (c *Cluster) startValidators(binary string, keys []*bls.SecretKey, dataDir string) error {
+	nodes := c.Nodes()
+	for _, node := range nodes {
+		service, endpoint, err := startRPC(c.ctx, node, c.eth1)
+		if err != nil {
+			return err
+		}
+		c.rpcServices = append(c.rpcServices, service)
+
+		nodeKeys := make(map[uint64]*bls.SecretKey)
+		for i := node.Index(); i < len(keys); i += len(nodes) {
+			nodeKeys[uint64(i)] = keys[i]
+		}
+		validatorDir := path.Join(dataDir, fmt.Sprintf("validator-%d", node.Index()))
+		if err := os.RemoveAll(validatorDir); err != nil {
+			return fmt.Errorf("could not clear validator directory: %v", err)
+		}
+		v, err := startValidator(binary, node.Index(), endpoint, nodeKeys, validatorDir)
+		if err != nil {
+			return fmt.Errorf("could not start validator client of node %d: %v", node.Index(), err)
+		}
+		c.validators = append(c.validators, v)
+	}
+	return nil
+}
+
+// minimalConfig returns the config of the minimal preset, which the validator clients run
+// with, in which the deposits of the validators start the chain.
+func minimalConfig(validatorCount uint64) *params.BeaconChainConfig {
+	c := params.MinimalPresetConfig()
+	c.MinGenesisActiveValidatorCount = validatorCount
+	return c
+}
+
+// Nodes returns the beacon nodes of the cluster.
+func (c *Cluster) Nodes() []*simulator.Node {
+	return c.sim.Nodes()
+}
+
+// Slot returns the last slot the cluster ran through.
+func (c *Cluster) Slot() uint64 {
+	return c.slot
+}
+
+// Run waits for the given number of slots to pass, checking the invariants at the end of
+// each slot.
+func (c *Cluster) Run(slots uint64) error {
+	for i := uint64(0); i < slots; i++ {
+		if err := c.runSlot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runSlot waits for the next slot to pass. Validator clients propose at the start of a
+// slot and attest halfway through it, so the blocks are gossiped a third of the way
+// through the slot, before the attesters of other nodes vote for the head, and the
+// attestations at the end of the slot, before the next proposer packs them into a block.
+func (c *Cluster) runSlot() error {
+	c.slot++
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	slotStart := c.genesisTime.Add(time.Duration(c.slot) * secondsPerSlot)
+	for _, t := range []time.Time{slotStart.Add(secondsPerSlot / 3), slotStart.Add(secondsPerSlot)} {
+		if err := c.waitUntil(t); err != nil {
+			return err
+		}
+		if err := c.sim.Network().Gossip(c.ctx); err != nil {
+			return fmt.Errorf("could not gossip at slot %d: %v", c.slot, err)
+		}
+	}
+	for _, v := range c.validators {
+		if err := v.checkRunning(); err != nil {
+			return err
+		}
+	}
+	return c.checkInvariants()
+}
+
+// waitUntil blocks until the time, returning an error if the context of the cluster is
+// canceled first.
+func (c *Cluster) waitUntil(t time.Time) error {
+	select {
+	case <-time.After(time.Until(t)):
+		return nil
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+// RunUntilFinalized runs the cluster until every node has finalized the epoch,
+// returning an error if that takes more than maxSlots slots.
+func (c *Cluster) RunUntilFinalized(epoch uint64, maxSlots uint64) error {
+	return c.runUntil(maxSlots, func() (bool, error) {
+		epochs, err := c.FinalizedEpochs()
+		if err != nil {
+			return false, err
+		}
+		for _, e := range epochs {
+			if e < epoch {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// ForceFork partitions the network into the groups of node indices for the number of
+// slots, so that each group builds its own chain, then heals the network and runs until
+// the nodes agree on a head again, within maxSlots slots. It returns the indices of
+// the nodes which reorged, whose head before the network healed is not an ancestor of
+// the agreed head.
+func (c *Cluster) ForceFork(slots uint64, maxSlots uint64, groups ...[]int) ([]int, error) {
+	network := c.sim.Network()
+	network.Partition(groups...)
+	if err := c.Run(slots); err != nil {
+		network.Heal()
+		return nil, err
+	}
+	agree, err := c.sim.HeadsAgree()
+	if err != nil {
+		network.Heal()
+		return nil, err
+	}
+	if agree {
+		network.Heal()
+		return nil, errors.New("partitioned nodes did not fork")
+	}
+	nodes := c.Nodes()
+	forkHeads := make([][32]byte, len(nodes))
+	for i, node := range nodes {
+		_, forkHeads[i], err = node.Head()
+		if err != nil {
+			network.Heal()
+			return nil, err
+		}
+	}
+
+	network.Heal()
+	if err := c.runUntil(maxSlots, c.sim.HeadsAgree); err != nil {
+		return nil, fmt.Errorf("nodes did not agree on a head after the network healed: %v", err)
+	}
+	var reorged []int
+	for i, node := range nodes {
+		_, head, err := node.Head()
+		if err != nil {
+			return nil, err
+		}
+		ok, err := isAncestor(node.DB(), forkHeads[i], head)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			reorged = append(reorged, node.Index())
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"slot":    c.Slot(),
+		"reorged": reorged,
+	}).Info("Nodes agree on a head after fork")
+	return reorged, nil
+}
+
+// FinalizedEpochs returns the finalized epoch of the head state of each node.
+func (c *Cluster) FinalizedEpochs() ([]uint64, error) {
+	nodes := c.Nodes()
+	epochs := make([]uint64, len(nodes))
+	for i, node := range nodes {
+		headState, err := node.DB().HeadState(c.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve head state of node %d: %v", node.Index(), err)
+		}
+		epochs[i] = headState.FinalizedCheckpoint.Epoch
+	}
+	return epochs, nil
+}
+
+// Stop shuts down the validator clients, the nodes and the ETH1.0 chain and restores the
+// beacon chain config and the feature config.
+func (c *Cluster) Stop() {
+	for _, v := range c.validators {
+		v.stop()
+	}
+	for _, service := range c.rpcServices {
+		if err := service.Stop(); err != nil {
+			log.WithError(err).Error("Could not stop RPC server")
+		}
+	}
+	if c.sim != nil {
+		c.sim.Stop()
+	}
+	if c.eth1 != nil {
+		c.eth1.stop()
+	}
+	params.OverrideBeaconConfig(c.prevConfig)
+	featureconfig.InitFeatureConfig(c.prevFeature)
+}
+
+// runUntil runs the cluster until done returns true, returning an error if that takes
+// more than maxSlots slots.
+func (c *Cluster) runUntil(maxSlots uint64, done func() (bool, error)) error {
+	for i := uint64(0); ; i++ {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if i == maxSlots {
+			return fmt.Errorf("condition not reached within %d slots", maxSlots)
+		}
+		if err := c.Run(1); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Cluster) checkInvariants() error {
+	for _, inv := range c.invariants {
+		if err := inv.Check(c.ctx, c.Nodes()); err != nil {
+			return fmt.Errorf("invariant %q violated at slot %d: %v", inv.Name, c.Slot(), err)
+		}
+	}
+	return nil
+}
+
+// isAncestor returns true if the block with the ancestor root is the block with the root
+// or one of its ancestors in the database.
+func isAncestor(beaconDB *db.BeaconDB, ancestor [32]byte, root [32]byte) (bool, error) {
+	ancestorBlock, err := beaconDB.Block(ancestor)
+	if err != nil {
+		return false, err
+	}
+	if ancestorBlock == nil {
+		return false, nil
+	}
+	for root != ancestor {
+		block, err := beaconDB.Block(root)
+		if err != nil {
+			return false, err
+		}
+		if block == nil || block.Slot <= ancestorBlock.Slot {
+			return false, nil
+		}
+		root = bytesutil.ToBytes32(block.ParentRoot)
+	}
+	return true, nil
+}
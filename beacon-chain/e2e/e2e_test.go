@@ -0,0 +1,81 @@
+package e2e
+
+import (
+	"context"
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetOutput(ioutil.Discard)
+}
+
+func TestEndToEnd_MinimalConfig(t *testing.T) {
+	validatorBinary, ok := bazel.FindBinary("validator", "validator")
+	if !ok {
+		t.Skip("Validator binary not found, run the test with bazel")
+	}
+	cluster, err := Start(context.Background(), &Config{
+		NodeCount:       3,
+		ValidatorCount:  64,
+		ValidatorBinary: validatorBinary,
+		DataDir:         path.Join(testutil.TempDir(), "e2e-test"),
+	})
+	if err != nil {
+		t.Fatalf("Could not start cluster: %v", err)
+	}
+	defer cluster.Stop()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	// Every node starts from the genesis state of the deposits on the eth1 chain.
+	for _, node := range cluster.Nodes() {
+		headState, err := node.DB().HeadState(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if headState.Slot != 0 {
+			t.Errorf("Expected node %d to start at slot 0, received %d", node.Index(), headState.Slot)
+		}
+		if len(headState.Validators) != 64 {
+			t.Errorf("Expected node %d to start with 64 validators, received %d", node.Index(), len(headState.Validators))
+		}
+		if headState.Eth1Data.DepositCount != 64 {
+			t.Errorf("Expected node %d to start with 64 deposits, received %d", node.Index(), headState.Eth1Data.DepositCount)
+		}
+		if headState.GenesisTime > uint64(time.Now().Unix()) {
+			t.Errorf("Expected node %d to start with a genesis in the past, received %d", node.Index(), headState.GenesisTime)
+		}
+	}
+
+	if err := cluster.RunUntilFinalized(2, 6*slotsPerEpoch); err != nil {
+		t.Fatalf("Nodes did not finalize epoch 2: %v", err)
+	}
+
+	// The validators are assigned to the nodes in a round robin fashion, so nodes 1 and 2
+	// hold 42 of the 64 validators, one short of the two thirds of the stake needed to
+	// justify an epoch. Neither side of the partition finalizes until the network heals and
+	// node 0 reorgs onto the heavier chain of nodes 1 and 2.
+	reorged, err := cluster.ForceFork(slotsPerEpoch, 2*slotsPerEpoch, []int{0}, []int{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reorged) == 0 {
+		t.Error("Expected a node to reorg after the network healed")
+	}
+
+	epochs, err := cluster.FinalizedEpochs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster.RunUntilFinalized(epochs[0]+1, 6*slotsPerEpoch); err != nil {
+		t.Fatalf("Nodes did not finalize after the network healed: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// depositContract is the address of the deposit contract on the simulated ETH1.0 chain.
+var depositContract = common.HexToAddress("0xe2e")
+
+// chainStartTimeout is how long to wait for the deposits to start the beacon chain.
+var chainStartTimeout = 30 * time.Second
+
+// eth1 is the simulated ETH1.0 chain of a cluster, followed by a powchain service backed
+// by its own database. The RPC servers of the nodes share the powchain service, which
+// tells the validator clients when the beacon chain starts.
+type eth1 struct {
+	chain       *powchain.SimulatedChain
+	web3Service *powchain.Web3Service
+	beaconDB    *db.BeaconDB
+	dbPath      string
+}
+
+// startEth1 starts a simulated ETH1.0 chain holding a deposit of the max effective balance
+// for each key, and follows it with a powchain service until the deposits start the
+// beacon chain. It returns the ETH1.0 chain along with the genesis state built from the
+// chain start deposits, the same way the chain service builds it on chain start.
+func startEth1(ctx context.Context, keys []*bls.SecretKey, dbPath string) (*eth1, *pb.BeaconState, error) {
+	deposits, _, err := simulator.InteropDeposits(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := make([]*ethpb.Deposit_Data, len(deposits))
+	for i, d := range deposits {
+		data[i] = d.Data
+	}
+	// The deposits are included in the second block of the chain, which is produced now.
+	genesisTime := time.Now().Add(-powchain.SimulatedBlockTime)
+	chain, err := powchain.NewSimulatedChain(ctx, depositContract, uint64(genesisTime.Unix()), data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create eth1 chain: %v", err)
+	}
+	chain.Start()
+	e := &eth1{
+		chain:  chain,
+		dbPath: dbPath,
+	}
+
+	genesisState, err := e.waitForChainStart(ctx)
+	if err != nil {
+		e.stop()
+		return nil, nil, err
+	}
+	return e, genesisState, nil
+}
+
+// waitForChainStart follows the ETH1.0 chain with a powchain service until the deposit
+// logs start the beacon chain.
+func (e *eth1) waitForChainStart(ctx context.Context) (*pb.BeaconState, error) {
+	if err := db.ClearDB(e.dbPath); err != nil {
+		return nil, fmt.Errorf("could not clear database: %v", err)
+	}
+	beaconDB, err := db.NewDB(e.dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not create database: %v", err)
+	}
+	e.beaconDB = beaconDB
+
+	e.web3Service, err = powchain.NewWeb3Service(ctx, &powchain.Web3ServiceConfig{
+		Endpoint:        "ipc://e2e",
+		DepositContract: depositContract,
+		Client:          e.chain,
+		Reader:          e.chain,
+		Logger:          e.chain,
+		HTTPLogger:      e.chain,
+		BlockFetcher:    e.chain,
+		ContractBackend: e.chain,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create web3 service: %v", err)
+	}
+	chainStart := make(chan time.Time, 1)
+	sub := e.web3Service.ChainStartFeed().Subscribe(chainStart)
+	defer sub.Unsubscribe()
+	e.web3Service.Start()
+
+	var genesisTime time.Time
+	select {
+	case genesisTime = <-chainStart:
+	case <-time.After(chainStartTimeout):
+		return nil, errors.New("timed out waiting for the deposits to start the beacon chain")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	genesisState, err := state.GenesisBeaconState(
+		e.web3Service.ChainStartDeposits(),
+		uint64(genesisTime.Unix()),
+		e.web3Service.ChainStartETH1Data(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create genesis state: %v", err)
+	}
+	return genesisState, nil
+}
+
+// stop shuts down the powchain service and the ETH1.0 chain and removes the database.
+func (e *eth1) stop() {
+	if e.web3Service != nil {
+		if err := e.web3Service.Stop(); err != nil {
+			log.WithError(err).Error("Could not stop web3 service")
+		}
+	}
+	if err := e.chain.Stop(); err != nil {
+		log.WithError(err).Error("Could not stop eth1 chain")
+	}
+	if e.beaconDB != nil {
+		if err := e.beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close eth1 database")
+		}
+		if err := db.ClearDB(e.dbPath); err != nil {
+			log.WithError(err).Error("Could not clear eth1 database")
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Invariant is a property every honest node upholds, checked after every slot of a
+// cluster.
+type Invariant struct {
+	// Name identifies the invariant in the error reporting a violation.
+	Name string
+	// Check returns an error describing the violation of the invariant by the nodes.
+	Check func(ctx context.Context, nodes []*simulator.Node) error
+}
+
+// DefaultInvariants returns the invariants checked by a cluster. Some of them keep track
+// of earlier checks, so each cluster needs its own.
+func DefaultInvariants() []Invariant {
+	return []Invariant{
+		FinalizedCheckpointsAgree(),
+		FinalityNeverReverts(),
+		HeadDescendsFromFinalized(),
+		NoValidatorSlashed(),
+	}
+}
+
+// FinalizedCheckpointsAgree checks that nodes which finalized the same epoch finalized the
+// same block.
+func FinalizedCheckpointsAgree() Invariant {
+	return Invariant{
+		Name: "finalized checkpoints agree",
+		Check: func(ctx context.Context, nodes []*simulator.Node) error {
+			roots := make(map[uint64][]byte)
+			for _, node := range nodes {
+				headState, err := node.DB().HeadState(ctx)
+				if err != nil {
+					return err
+				}
+				cp := headState.FinalizedCheckpoint
+				root, ok := roots[cp.Epoch]
+				if !ok {
+					roots[cp.Epoch] = cp.Root
+					continue
+				}
+				if !bytes.Equal(root, cp.Root) {
+					return fmt.Errorf("node %d finalized %#x at epoch %d, other nodes %#x", node.Index(), cp.Root, cp.Epoch, root)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// FinalityNeverReverts checks that the finalized epoch of a node never decreases and
+// never exceeds its justified epoch.
+func FinalityNeverReverts() Invariant {
+	finalized := make(map[int]uint64)
+	return Invariant{
+		Name: "finality never reverts",
+		Check: func(ctx context.Context, nodes []*simulator.Node) error {
+			for _, node := range nodes {
+				headState, err := node.DB().HeadState(ctx)
+				if err != nil {
+					return err
+				}
+				epoch := headState.FinalizedCheckpoint.Epoch
+				if epoch < finalized[node.Index()] {
+					return fmt.Errorf("node %d finalized epoch %d after epoch %d", node.Index(), epoch, finalized[node.Index()])
+				}
+				if epoch > headState.CurrentJustifiedCheckpoint.Epoch {
+					return fmt.Errorf(
+						"node %d finalized epoch %d after its justified epoch %d",
+						node.Index(),
+						epoch,
+						headState.CurrentJustifiedCheckpoint.Epoch,
+					)
+				}
+				finalized[node.Index()] = epoch
+			}
+			return nil
+		},
+	}
+}
+
+// HeadDescendsFromFinalized checks that the head of a node descends from the block it
+// finalized.
+func HeadDescendsFromFinalized() Invariant {
+	return Invariant{
+		Name: "head descends from finalized block",
+		Check: func(ctx context.Context, nodes []*simulator.Node) error {
+			for _, node := range nodes {
+				headState, err := node.DB().HeadState(ctx)
+				if err != nil {
+					return err
+				}
+				// The genesis checkpoint has no root.
+				if headState.FinalizedCheckpoint.Epoch == 0 {
+					continue
+				}
+				_, head, err := node.Head()
+				if err != nil {
+					return err
+				}
+				finalizedRoot := bytesutil.ToBytes32(headState.FinalizedCheckpoint.Root)
+				ok, err := isAncestor(node.DB(), finalizedRoot, head)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return fmt.Errorf("head %#x of node %d does not descend from finalized block %#x", head, node.Index(), finalizedRoot)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// NoValidatorSlashed checks that no validator is slashed, as every validator of a cluster
+// is honest.
+func NoValidatorSlashed() Invariant {
+	return Invariant{
+		Name: "no validator slashed",
+		Check: func(ctx context.Context, nodes []*simulator.Node) error {
+			for _, node := range nodes {
+				headState, err := node.DB().HeadState(ctx)
+				if err != nil {
+					return err
+				}
+				for i, v := range headState.Validators {
+					if v.Slashed {
+						return fmt.Errorf("validator %d is slashed in the head state of node %d", i, node.Index())
+					}
+				}
+			}
+			return nil
+		},
+	}
+}
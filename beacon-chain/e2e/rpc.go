@@ -0,0 +1,71 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+)
+
+// startRPC starts the RPC server of the node on a free port, which validator clients
+// connect to. The server shares the powchain service following the ETH1.0 chain of the
+// cluster. It returns the server along with its endpoint.
+func startRPC(ctx context.Context, node *simulator.Node, chain *eth1) (*rpc.Service, string, error) {
+	port, err := freePort()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not find a free port for the RPC server of node %d: %v", node.Index(), err)
+	}
+	service := rpc.NewRPCService(ctx, &rpc.Config{
+		Port:             strconv.Itoa(port),
+		BeaconDB:         node.DB(),
+		ChainService:     node.Chain(),
+		POWChainService:  chain.web3Service,
+		OperationService: node.Operations(),
+		SyncService:      syncedService{},
+		Broadcaster:      node,
+	})
+	service.Start()
+	return service, fmt.Sprintf("localhost:%d", port), nil
+}
+
+// freePort returns a TCP port which is free at the time of the call.
+func freePort() (int, error) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	if err := lis.Close(); err != nil {
+		return 0, err
+	}
+	return port, nil
+}
+
+// syncedService reports the nodes of a cluster as synced, as the cluster gossips the
+// blocks of their peers to them during every slot.
+type syncedService struct{}
+
+// Status of the sync service, which is always healthy.
+func (syncedService) Status() error {
+	return nil
+}
+
+// Syncing returns false.
+func (syncedService) Syncing() bool {
+	return false
+}
+
+// SyncState returns sync.Synced.
+func (syncedService) SyncState() sync.State {
+	return sync.Synced
+}
+
+// EstimatedSyncCompletion returns zero, as the node is synced.
+func (syncedService) EstimatedSyncCompletion() time.Duration {
+	return 0
+}
@@ -0,0 +1,135 @@
+package e2e
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// validatorPassword is the password of the keystores of the validator clients.
+const validatorPassword = "e2e"
+
+// validatorStopTimeout is how long a validator client is given to shut down once
+// interrupted, before it is killed.
+var validatorStopTimeout = 10 * time.Second
+
+// validatorClient is a validator client binary running the validators hosted by a node.
+type validatorClient struct {
+	node    int
+	cmd     *exec.Cmd
+	logFile *os.File
+	exited  chan struct{}
+	err     error
+}
+
+// startValidator writes the keys to a keystore and starts the validator client binary
+// with the keystore, connected to the RPC endpoint of the node. The client runs with the
+// minimal preset and logs to a file in its data directory.
+func startValidator(binary string, node int, endpoint string, keys map[uint64]*bls.SecretKey, dataDir string) (*validatorClient, error) {
+	keystorePath := path.Join(dataDir, "keystore")
+	if err := writeKeystore(keystorePath, keys); err != nil {
+		return nil, fmt.Errorf("could not write keystore: %v", err)
+	}
+	monitoringPort, err := freePort()
+	if err != nil {
+		return nil, fmt.Errorf("could not find a free monitoring port: %v", err)
+	}
+	logFile, err := os.Create(path.Join(dataDir, "validator.log"))
+	if err != nil {
+		return nil, fmt.Errorf("could not create log file: %v", err)
+	}
+
+	// #nosec G204
+	cmd := exec.Command(
+		binary,
+		"--network", params.MinimalPreset,
+		"--beacon-rpc-provider", endpoint,
+		"--keystore-path", keystorePath,
+		"--password", validatorPassword,
+		"--datadir", path.Join(dataDir, "db"),
+		"--monitoring-port", strconv.Itoa(monitoringPort),
+		"--ntp-servers", "",
+		"--verbosity", "debug",
+	)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	if err := cmd.Start(); err != nil {
+		if err := logFile.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator log file")
+		}
+		return nil, fmt.Errorf("could not start validator client: %v", err)
+	}
+	v := &validatorClient{
+		node:    node,
+		cmd:     cmd,
+		logFile: logFile,
+		exited:  make(chan struct{}),
+	}
+	go func() {
+		v.err = cmd.Wait()
+		close(v.exited)
+	}()
+	return v, nil
+}
+
+// writeKeystore writes each key to its own file of the keystore at the path, encrypted
+// with the fast key derivation function so that the client starts quickly.
+func writeKeystore(keystorePath string, keys map[uint64]*bls.SecretKey) error {
+	if err := os.MkdirAll(keystorePath, 0700); err != nil {
+		return err
+	}
+	ks := keystore.NewKeystoreWithKDF(keystorePath, keystore.FastKDF)
+	for _, secretKey := range keys {
+		key := &keystore.Key{
+			ID:        uuid.NewRandom(),
+			PublicKey: secretKey.PublicKey(),
+			SecretKey: secretKey,
+		}
+		fileName := params.BeaconConfig().ValidatorPrivkeyFileName + hex.EncodeToString(key.PublicKey.Marshal())[:12]
+		if err := ks.StoreKey(path.Join(keystorePath, fileName), key, validatorPassword); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRunning returns an error if the validator client exited.
+func (v *validatorClient) checkRunning() error {
+	select {
+	case <-v.exited:
+		return fmt.Errorf("validator client of node %d exited: %v, see %s", v.node, v.err, v.logFile.Name())
+	default:
+		return nil
+	}
+}
+
+// stop interrupts the validator client, killing it if it does not shut down in time.
+func (v *validatorClient) stop() {
+	select {
+	case <-v.exited:
+	default:
+		if err := v.cmd.Process.Signal(os.Interrupt); err != nil {
+			log.WithError(err).Errorf("Could not interrupt validator client of node %d", v.node)
+		}
+		select {
+		case <-v.exited:
+		case <-time.After(validatorStopTimeout):
+			if err := v.cmd.Process.Kill(); err != nil {
+				log.WithError(err).Errorf("Could not kill validator client of node %d", v.node)
+			}
+			<-v.exited
+		}
+	}
+	if err := v.logFile.Close(); err != nil {
+		log.WithError(err).Error("Could not close validator log file")
+	}
+}
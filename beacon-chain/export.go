@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/segment"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var (
+	exportFromSlotFlag = cli.Uint64Flag{
+		Name:  "from-slot",
+		Usage: "Slot of the first exported block. The state of the first block is exported along with it, so it must not be older than the historical states kept by the node.",
+	}
+	exportToSlotFlag = cli.Uint64Flag{
+		Name:  "to-slot",
+		Usage: "Slot of the last exported block, the slot of the chain head if not set",
+	}
+	exportOutputFlag = cli.StringFlag{
+		Name:  "output",
+		Usage: "Path to the directory the chain segment is written to",
+	}
+	exportEpochStatesFlag = cli.BoolFlag{
+		Name:  "epoch-states",
+		Usage: "Also export the state of the first block of each epoch",
+	}
+)
+
+// exportCommand writes a range of the canonical chain of a stopped node to a chain segment.
+var exportCommand = cli.Command{
+	Name:  "export-chain",
+	Usage: "export a range of the canonical chain from the database of a stopped node to a chain segment of ssz files",
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		exportFromSlotFlag,
+		exportToSlotFlag,
+		exportOutputFlag,
+		exportEpochStatesFlag,
+	},
+	Action: runExport,
+}
+
+func runExport(ctx *cli.Context) error {
+	output := ctx.String(exportOutputFlag.Name)
+	if output == "" {
+		return fmt.Errorf("--%s is required", exportOutputFlag.Name)
+	}
+	beaconDB, err := db.NewDB(node.DBPath(ctx.String(cmd.DataDirFlag.Name)))
+	if err != nil {
+		return fmt.Errorf("could not open database: %v", err)
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			logrus.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	toSlot := ctx.Uint64(exportToSlotFlag.Name)
+	if !ctx.IsSet(exportToSlotFlag.Name) {
+		head, err := beaconDB.ChainHead()
+		if err != nil {
+			return fmt.Errorf("could not retrieve chain head: %v", err)
+		}
+		toSlot = head.Slot
+	}
+	return exportChain(
+		context.Background(),
+		beaconDB,
+		ctx.Uint64(exportFromSlotFlag.Name),
+		toSlot,
+		output,
+		ctx.Bool(exportEpochStatesFlag.Name),
+	)
+}
+
+// exportChain writes the canonical blocks with slots in [fromSlot, toSlot] to the segment
+// directory, along with the state of the first block, which the following blocks can be
+// replayed from, and the state of the first block of each epoch if epochStates is set.
+func exportChain(
+	ctx context.Context,
+	beaconDB *db.BeaconDB,
+	fromSlot uint64,
+	toSlot uint64,
+	output string,
+	epochStates bool,
+) error {
+	log := logrus.WithField("prefix", "export")
+	if fromSlot > toSlot {
+		return fmt.Errorf("from slot %d is after to slot %d", fromSlot, toSlot)
+	}
+	it, err := beaconDB.CanonicalBlocks(ctx, fromSlot, toSlot)
+	if err != nil {
+		return fmt.Errorf("could not iterate over canonical blocks: %v", err)
+	}
+	if it.Len() == 0 {
+		return fmt.Errorf("no canonical blocks between slots %d and %d", fromSlot, toSlot)
+	}
+
+	var blocks, states int
+	lastEpoch := uint64(0)
+	for {
+		block, err := it.Next()
+		if err != nil {
+			return fmt.Errorf("could not read canonical block: %v", err)
+		}
+		if block == nil {
+			break
+		}
+		if err := segment.WriteBlock(output, block); err != nil {
+			return err
+		}
+		blocks++
+
+		epoch := helpers.SlotToEpoch(block.Slot)
+		first := blocks == 1
+		if first || (epochStates && epoch > lastEpoch) {
+			blockState, err := stateOfBlock(ctx, beaconDB, block)
+			if err != nil {
+				return err
+			}
+			switch {
+			case blockState != nil:
+				if err := segment.WriteState(output, blockState); err != nil {
+					return err
+				}
+				states++
+			case first:
+				return fmt.Errorf(
+					"no state of the block at slot %d in the database, the states before the finalized epoch may have been pruned",
+					block.Slot,
+				)
+			default:
+				log.WithField("slot", block.Slot).Warn("No state of the first block of the epoch in the database")
+			}
+		}
+		lastEpoch = epoch
+	}
+
+	log.WithFields(logrus.Fields{
+		"output": output,
+		"blocks": blocks,
+		"states": states,
+	}).Info("Exported chain segment")
+	return nil
+}
+
+// stateOfBlock returns the state resulting from the block, or nil if the database does
+// not hold it. The state is found among the historical states, which hold the closest
+// older state if they do not hold the state of the block itself, so the latest block
+// header of the state is checked against the block.
+func stateOfBlock(ctx context.Context, beaconDB *db.BeaconDB, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	st, err := beaconDB.HistoricalStateFromSlot(ctx, block.Slot, root)
+	if err != nil {
+		// No historical state at or before the slot of the block.
+		return nil, nil
+	}
+	if st.Slot != block.Slot || st.LatestBlockHeader == nil {
+		return nil, nil
+	}
+	bodyRoot, err := ssz.HashTreeRoot(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block body: %v", err)
+	}
+	header := st.LatestBlockHeader
+	if header.Slot != block.Slot || !bytes.Equal(header.ParentRoot, block.ParentRoot) || !bytes.Equal(header.BodyRoot, bodyRoot[:]) {
+		return nil, nil
+	}
+	return st, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/segment"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestExportChain_WritesCanonicalSegment(t *testing.T) {
+	prevConfig := params.BeaconConfig()
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(prevConfig)
+	ctx := context.Background()
+
+	sim, err := simulator.New(ctx, &simulator.Config{
+		NodeCount:      1,
+		ValidatorCount: 64,
+		DataDir:        path.Join(testutil.TempDir(), "export-test"),
+	})
+	if err != nil {
+		t.Fatalf("Could not start simulation: %v", err)
+	}
+	defer sim.Stop()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	if err := sim.Run(slotsPerEpoch + 2); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB := sim.Nodes()[0].DB()
+
+	output, err := ioutil.TempDir("", "export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(output)
+	if err := exportChain(ctx, beaconDB, 2, sim.Slot(), output, true); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks, err := segment.ReadBlocks(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := int(sim.Slot() - 1); len(blocks) != want {
+		t.Fatalf("Expected %d blocks, received %d", want, len(blocks))
+	}
+	for i := 1; i < len(blocks); i++ {
+		parentRoot, err := ssz.SigningRoot(blocks[i-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(blocks[i].ParentRoot, parentRoot[:]) {
+			t.Errorf("Expected block at slot %d to descend from the block at slot %d", blocks[i].Slot, blocks[i-1].Slot)
+		}
+	}
+
+	// The anchor state of the first block and the state of the first block of the next epoch.
+	stateSlots, err := segment.StateSlots(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stateSlots) != 2 || stateSlots[0] != 2 || stateSlots[1] != slotsPerEpoch {
+		t.Fatalf("Expected states at slots 2 and %d, received %v", slotsPerEpoch, stateSlots)
+	}
+	for _, block := range blocks {
+		if block.Slot != stateSlots[1] {
+			continue
+		}
+		st, err := segment.ReadState(output, block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stateRoot, err := ssz.HashTreeRoot(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(block.StateRoot, stateRoot[:]) {
+			t.Errorf("Expected state at slot %d to be the state of the block", block.Slot)
+		}
+	}
+}
+
+func TestExportChain_EmptyRange(t *testing.T) {
+	prevConfig := params.BeaconConfig()
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(prevConfig)
+	ctx := context.Background()
+
+	sim, err := simulator.New(ctx, &simulator.Config{
+		NodeCount:      1,
+		ValidatorCount: 64,
+		DataDir:        path.Join(testutil.TempDir(), "export-test"),
+	})
+	if err != nil {
+		t.Fatalf("Could not start simulation: %v", err)
+	}
+	defer sim.Stop()
+
+	if err := exportChain(ctx, sim.Nodes()[0].DB(), 5, 10, testutil.TempDir(), false); err == nil {
+		t.Error("Expected an error exporting a range without blocks")
+	}
+}
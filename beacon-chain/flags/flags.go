@@ -1,6 +1,9 @@
 package flags
 
 import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/urfave/cli"
 )
 
@@ -27,6 +30,43 @@ var (
 		Name:  "deposit-contract",
 		Usage: "Deposit contract address. Beacon chain node will listen logs coming from the deposit contract to determine when validator is eligible to participate.",
 	}
+	// Eth1LogBatchSizeFlag defines the max number of eth1 blocks requested in a single deposit log query.
+	Eth1LogBatchSizeFlag = cli.Uint64Flag{
+		Name:  "eth1-log-batch-size",
+		Usage: "The max number of eth1 blocks whose deposit logs are requested at once. Providers such as Infura reject log queries over large block ranges.",
+		Value: 1000,
+	}
+	// Eth1RequestsPerSecondFlag defines the max average rate of requests to the eth1 endpoints.
+	Eth1RequestsPerSecondFlag = cli.Float64Flag{
+		Name:  "eth1-requests-per-second",
+		Usage: "The max average number of requests per second sent to the eth1 endpoints, unlimited if 0. Set it below the rate limit of hosted providers such as Infura.",
+	}
+	// Eth1RequestBurstFlag defines the max number of requests sent at once to the eth1 endpoints.
+	Eth1RequestBurstFlag = cli.IntFlag{
+		Name:  "eth1-request-burst",
+		Usage: "The max number of requests sent at once to the eth1 endpoints within --eth1-requests-per-second.",
+		Value: 10,
+	}
+	// InteropEth1Flag runs the node against a simulated eth1 chain instead of an eth1 node.
+	InteropEth1Flag = cli.BoolFlag{
+		Name:  "interop-eth1",
+		Usage: "Run against a simulated eth1 chain including the deposits of the keys in --interop-eth1-keystore, instead of connecting to an eth1 node. For devnets only.",
+	}
+	// InteropEth1KeystoreFlag defines the validator keystore whose keys are deposited on the simulated eth1 chain.
+	InteropEth1KeystoreFlag = cli.StringFlag{
+		Name:  "interop-eth1-keystore",
+		Usage: "Path to the validator keystore whose keys are deposited on the simulated eth1 chain",
+	}
+	// InteropEth1PasswordFlag defines the password of the keystore deposited on the simulated eth1 chain.
+	InteropEth1PasswordFlag = cli.StringFlag{
+		Name:  "interop-eth1-password",
+		Usage: "Password of the validator keystore deposited on the simulated eth1 chain",
+	}
+	// InteropEth1GenesisTimeFlag defines the unix time of the first block of the simulated eth1 chain.
+	InteropEth1GenesisTimeFlag = cli.Uint64Flag{
+		Name:  "interop-eth1-genesis-time",
+		Usage: "Unix time of the first block of the simulated eth1 chain, the time the node starts if not set. Nodes of a devnet must use the same time to agree on the chain.",
+	}
 	// RPCPort defines a beacon node RPC port to open.
 	RPCPort = cli.IntFlag{
 		Name:  "rpc-port",
@@ -43,6 +83,64 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// ClientCAFlag defines a flag for the CA certificate used to verify the certificates of
+	// validator clients.
+	ClientCAFlag = cli.StringFlag{
+		Name:  "tls-client-ca",
+		Usage: "CA certificate which validator client certificates must be signed by. Pass this along with the tls-cert and tls-key flags to require mutual TLS.",
+	}
+	// RPCAuthTokenFlag defines a flag for the bearer token validator clients must present.
+	RPCAuthTokenFlag = cli.StringFlag{
+		Name:  "rpc-auth-token",
+		Usage: "Bearer token validator clients must present in order to use the validator RPC services",
+	}
+	// RPCMaxConcurrentStreamsFlag defines the max number of concurrent streams per RPC client connection.
+	RPCMaxConcurrentStreamsFlag = cli.Uint64Flag{
+		Name:  "rpc-max-concurrent-streams",
+		Usage: "The max number of concurrent streams per RPC client connection. The default is unlimited.",
+	}
+	// RPCMaxRecvMsgSizeFlag defines the max size of a message accepted by the RPC server.
+	RPCMaxRecvMsgSizeFlag = cli.IntFlag{
+		Name:  "rpc-max-recv-msg-size",
+		Usage: "The max size in bytes of a message accepted by the RPC server.",
+		Value: 1 << 22,
+	}
+	// RPCKeepaliveTimeFlag defines how long a client connection may be idle before it is pinged.
+	RPCKeepaliveTimeFlag = cli.DurationFlag{
+		Name:  "rpc-keepalive-time",
+		Usage: "Duration after which the RPC server pings an idle client connection.",
+		Value: 2 * time.Hour,
+	}
+	// RPCKeepaliveTimeoutFlag defines how long the server waits for a ping to be acknowledged.
+	RPCKeepaliveTimeoutFlag = cli.DurationFlag{
+		Name:  "rpc-keepalive-timeout",
+		Usage: "Duration the RPC server waits for a ping to be acknowledged before closing the connection.",
+		Value: 20 * time.Second,
+	}
+	// RPCKeepaliveMinTimeFlag defines the min duration clients must wait between pings.
+	RPCKeepaliveMinTimeFlag = cli.DurationFlag{
+		Name:  "rpc-keepalive-min-time",
+		Usage: "The min duration RPC clients must wait between pings. Clients pinging more often are disconnected.",
+		Value: 5 * time.Minute,
+	}
+	// RPCRateLimitFlag defines the max rate of requests handled for an RPC method.
+	RPCRateLimitFlag = cli.StringSliceFlag{
+		Name: "rpc-rate-limit",
+		Usage: "Rate limit for an RPC method in the form <method>=<requests per second>, for example " +
+			"ProposeBlock=10. This flag may be used multiple times.",
+	}
+	// RPCDefaultPageSizeFlag defines the page size of list RPC responses when requests do not specify one.
+	RPCDefaultPageSizeFlag = cli.IntFlag{
+		Name:  "rpc-default-page-size",
+		Usage: "Number of items returned by list RPC methods when requests do not specify a page size",
+		Value: 250,
+	}
+	// RPCMaxPageSizeFlag defines the max page size of list RPC responses.
+	RPCMaxPageSizeFlag = cli.IntFlag{
+		Name:  "rpc-max-page-size",
+		Usage: "Max number of items returned by list RPC methods in a single response",
+		Value: 500,
+	}
 	// EnableDBCleanup tells the beacon node to automatically clean DB content such as block vote cache.
 	EnableDBCleanup = cli.BoolFlag{
 		Name:  "enable-db-cleanup",
@@ -51,6 +149,83 @@ var (
 	// GRPCGatewayPort enables a gRPC gateway to be exposed for Prysm.
 	GRPCGatewayPort = cli.IntFlag{
 		Name:  "grpc-gateway-port",
-		Usage: "Enable gRPC gateway for JSON requests",
+		Usage: "Enable gRPC gateway for JSON requests. Connects to the RPC server over TLS if the tls-cert and tls-key flags are set.",
+	}
+	// GRPCGatewayHost specifies the interface the gRPC gateway listens on.
+	GRPCGatewayHost = cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "The host on which the gateway server runs on",
+		Value: "127.0.0.1",
+	}
+	// ReadinessSlotLagFlag specifies how many slots the head of the chain may lag behind the
+	// current slot for the node to be reported as ready.
+	ReadinessSlotLagFlag = cli.Uint64Flag{
+		Name:  "readiness-slot-lag",
+		Usage: "Maximum number of slots the chain head may be behind the current slot for /readyz to report the node as ready",
+		Value: 4,
+	}
+	// ReadinessMinPeersFlag specifies the minimum number of connected peers for the node to be
+	// reported as ready.
+	ReadinessMinPeersFlag = cli.IntFlag{
+		Name:  "readiness-min-peers",
+		Usage: "Minimum number of connected peers for /readyz to report the node as ready",
+		Value: 1,
+	}
+	// EnableSlasherFlag runs the slasher service, which detects the slashable offenses of
+	// validators from the operations seen by the node.
+	EnableSlasherFlag = cli.BoolFlag{
+		Name:  "slasher",
+		Usage: "Detect slashable attestations and block proposals among the operations received by the node",
+	}
+	// SlasherHistoryEpochsFlag specifies how many epochs of operations the slasher keeps.
+	SlasherHistoryEpochsFlag = cli.Uint64Flag{
+		Name:  "slasher-history-epochs",
+		Usage: "Number of epochs of attestations and block headers kept by the slasher",
+		Value: 4096,
+	}
+	// AttestationPoolMaxSizeFlag specifies how many attestations the operations pool keeps.
+	AttestationPoolMaxSizeFlag = cli.IntFlag{
+		Name:  "attestation-pool-max-size",
+		Usage: "Maximum number of attestations kept in the attestation pool, beyond which the oldest attestations are evicted (0 for no limit)",
+		Value: 16384,
+	}
+	// AttestationPoolMaxAgeFlag specifies how many epochs attestations are kept in the
+	// operations pool.
+	AttestationPoolMaxAgeFlag = cli.Uint64Flag{
+		Name:  "attestation-pool-max-age",
+		Usage: "Number of epochs an attestation is kept in the attestation pool before it is evicted",
+		Value: 1,
+	}
+	// ArchiveFlag retains the state, committees and balances of every epoch, in order to
+	// serve historical RPC queries.
+	ArchiveFlag = cli.BoolFlag{
+		Name:  "archive",
+		Usage: "Retain the state, committee assignments and balances of every past epoch to serve historical queries",
+	}
+	// ServiceMaxRestartsFlag specifies how many times a service goroutine is restarted
+	// after a panic.
+	ServiceMaxRestartsFlag = cli.IntFlag{
+		Name:  "service-max-restarts",
+		Usage: "Number of times a service goroutine is restarted after a panic, after which the service reports an error (-1 for no limit)",
+		Value: 5,
+	}
+	// ServiceRestartBackoffFlag specifies the delay before restarting a service goroutine
+	// after a panic.
+	ServiceRestartBackoffFlag = cli.DurationFlag{
+		Name:  "service-restart-backoff",
+		Usage: "Delay before restarting a service goroutine after a panic, doubled at every restart up to one minute",
+		Value: time.Second,
+	}
+	// P2PMaxChunkSize defines the max size, in bytes, of a single p2p response chunk.
+	P2PMaxChunkSize = cli.Uint64Flag{
+		Name:  "p2p-max-chunk-size",
+		Usage: "The max size in bytes of a single chunk of a p2p response.",
+		Value: p2p.DefaultMaxChunkSize,
+	}
+	// P2PMaxRequestCount defines the max number of chunks in a single p2p response.
+	P2PMaxRequestCount = cli.IntFlag{
+		Name:  "p2p-max-request-count",
+		Usage: "The max number of chunks sent or accepted in a single p2p response.",
+		Value: p2p.DefaultMaxRequestCount,
 	}
 )
@@ -0,0 +1,132 @@
+package fuzz
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func seedBlock(slot uint64) *ethpb.BeaconBlock {
+	return &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: bytes.Repeat([]byte{'p'}, 32),
+		StateRoot:  bytes.Repeat([]byte{'s'}, 32),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot: make([]byte, 32),
+				BlockHash:   make([]byte, 32),
+			},
+			Graffiti: make([]byte, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func seedAttestation() *ethpb.Attestation {
+	return &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0x05},
+		CustodyBits:     bitfield.Bitlist{0x04},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytes.Repeat([]byte{'b'}, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			Crosslink: &ethpb.Crosslink{
+				ParentRoot: make([]byte, 32),
+				DataRoot:   make([]byte, 32),
+			},
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+func encode(t *testing.T, val interface{}) []byte {
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enc
+}
+
+// seedCorpus returns valid inputs of every harness, keyed by harness.
+func seedCorpus(t *testing.T) map[string][][]byte {
+	var stream bytes.Buffer
+	w := ggio.NewDelimitedWriter(&stream)
+	payload, err := (&pb.BeaconBlockResponse{Block: seedBlock(1), Attestation: seedAttestation()}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteMsg(&pb.Envelope{Payload: payload}); err != nil {
+		t.Fatal(err)
+	}
+	// The topic selector of block responses.
+	p2pSeed := append([]byte{3}, stream.Bytes()...)
+
+	return map[string][][]byte{
+		"BeaconBlockSSZ":  {encode(t, seedBlock(1))},
+		"BeaconStateSSZ":  {encode(t, fuzzGenesisState())},
+		"AttestationSSZ":  {encode(t, seedAttestation())},
+		"StateTransition": {encode(t, seedBlock(1))},
+		"P2PMessage":      {p2pSeed},
+	}
+}
+
+var harnesses = map[string]func([]byte) int{
+	"BeaconBlockSSZ":  BeaconBlockSSZ,
+	"BeaconStateSSZ":  BeaconStateSSZ,
+	"AttestationSSZ":  AttestationSSZ,
+	"StateTransition": StateTransition,
+	"P2PMessage":      P2PMessage,
+}
+
+func TestHarnesses_DecodeSeeds(t *testing.T) {
+	for name, seeds := range seedCorpus(t) {
+		if name == "StateTransition" {
+			// The seed block is not a valid child of the genesis state.
+			continue
+		}
+		for i, seed := range seeds {
+			if res := harnesses[name](seed); res != 1 {
+				t.Errorf("Expected harness %s to decode seed %d, returned %d", name, i, res)
+			}
+		}
+	}
+}
+
+func TestHarnesses_RejectInvalidInput(t *testing.T) {
+	for name, harness := range harnesses {
+		if res := harness(nil); res != 0 {
+			t.Errorf("Expected harness %s to reject empty input, returned %d", name, res)
+		}
+	}
+	if res := StateTransition(encode(t, seedBlock(3*params.BeaconConfig().SlotsPerEpoch))); res != -1 {
+		t.Errorf("Expected block beyond the first epochs to be skipped, returned %d", res)
+	}
+}
+
+// TestHarnesses_MutatedSeeds runs the harnesses over random mutations of the seeds, which
+// catches the most shallow panics without a fuzzer.
+func TestHarnesses_MutatedSeeds(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for name, seeds := range seedCorpus(t) {
+		for _, seed := range seeds {
+			for i := 0; i < 20; i++ {
+				mutated := append([]byte{}, seed...)
+				for j := 0; j < 1+r.Intn(8); j++ {
+					mutated[r.Intn(len(mutated))] = byte(r.Intn(256))
+				}
+				if r.Intn(2) == 0 {
+					mutated = mutated[:r.Intn(len(mutated))]
+				}
+				harnesses[name](mutated)
+			}
+		}
+	}
+}
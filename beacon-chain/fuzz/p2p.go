@@ -0,0 +1,97 @@
+package fuzz
+
+import (
+	"bytes"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+// p2pMessages creates the messages of the topics of the beacon node, in the order of the
+// topic selector byte of P2PMessage.
+var p2pMessages = []func() proto.Message{
+	func() proto.Message { return &pb.BeaconBlockAnnounce{} },
+	func() proto.Message { return &pb.BeaconBlockRequest{} },
+	func() proto.Message { return &pb.BeaconBlockRequestBySlotNumber{} },
+	func() proto.Message { return &pb.BeaconBlockResponse{} },
+	func() proto.Message { return &pb.BatchedBeaconBlockRequest{} },
+	func() proto.Message { return &pb.BatchedBeaconBlockResponse{} },
+	func() proto.Message { return &pb.ChainHeadRequest{} },
+	func() proto.Message { return &pb.ChainHeadResponse{} },
+	func() proto.Message { return &pb.BeaconStateHashAnnounce{} },
+	func() proto.Message { return &pb.BeaconStateRequest{} },
+	func() proto.Message { return &pb.BeaconStateResponse{} },
+	func() proto.Message { return &pb.AttestationAnnounce{} },
+	func() proto.Message { return &pb.AttestationRequest{} },
+	func() proto.Message { return &pb.AttestationResponse{} },
+}
+
+// P2PMessage decodes a stream of p2p messages the way the p2p service reads the streams
+// of peers: as varint delimited envelopes of up to the default max chunk size, whose
+// payloads are decoded as the message of the topic selected by the first byte of the
+// data. The blocks, states and attestations carried by the messages are hashed, as the
+// handlers of these messages do.
+func P2PMessage(data []byte) int {
+	if len(data) == 0 {
+		return 0
+	}
+	newMessage := p2pMessages[int(data[0])%len(p2pMessages)]
+	r := ggio.NewDelimitedReader(bytes.NewReader(data[1:]), int(p2p.DefaultMaxChunkSize))
+	defer r.Close()
+
+	decoded := 0
+	for {
+		envelope := &pb.Envelope{}
+		if err := r.ReadMsg(envelope); err != nil {
+			break
+		}
+		msg := newMessage()
+		if err := proto.Unmarshal(envelope.Payload, msg); err != nil {
+			continue
+		}
+		hashContents(msg)
+		decoded++
+	}
+	if decoded == 0 {
+		return 0
+	}
+	return 1
+}
+
+// hashContents hashes the blocks, states and attestations carried by the message.
+func hashContents(msg proto.Message) {
+	var contents []interface{}
+	switch m := msg.(type) {
+	case *pb.BeaconBlockResponse:
+		if m.Block != nil {
+			contents = append(contents, m.Block)
+		}
+		if m.Attestation != nil {
+			contents = append(contents, m.Attestation)
+		}
+	case *pb.BatchedBeaconBlockResponse:
+		for _, b := range m.BatchedBlocks {
+			if b != nil {
+				contents = append(contents, b)
+			}
+		}
+	case *pb.BeaconStateResponse:
+		if m.FinalizedState != nil {
+			contents = append(contents, m.FinalizedState)
+		}
+		if m.FinalizedBlock != nil {
+			contents = append(contents, m.FinalizedBlock)
+		}
+	case *pb.AttestationResponse:
+		if m.Attestation != nil {
+			contents = append(contents, m.Attestation)
+		}
+	}
+	for _, c := range contents {
+		// Errors are expected for incomplete messages, only panics are bugs.
+		_, _ = ssz.HashTreeRoot(c)
+	}
+}
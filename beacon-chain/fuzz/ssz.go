@@ -0,0 +1,60 @@
+// Package fuzz holds the fuzzing harnesses of the inputs received from untrusted peers:
+// the ssz encoding of blocks, states and attestations, the state transition of arbitrary
+// blocks and the decoding of p2p messages. Each harness follows the go-fuzz convention,
+// returning 1 for inputs which are decoded and worth keeping in the corpus and 0 for the
+// others, and panics when it finds a bug. The harnesses are built for libFuzzer by
+// scripts/fuzz.sh, and run over a seed corpus as part of the tests of this package.
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// BeaconBlockSSZ decodes an ssz encoded block.
+func BeaconBlockSSZ(data []byte) int {
+	return decodeSSZ(data, func() interface{} { return &ethpb.BeaconBlock{} })
+}
+
+// BeaconStateSSZ decodes an ssz encoded state.
+func BeaconStateSSZ(data []byte) int {
+	return decodeSSZ(data, func() interface{} { return &pb.BeaconState{} })
+}
+
+// AttestationSSZ decodes an ssz encoded attestation.
+func AttestationSSZ(data []byte) int {
+	return decodeSSZ(data, func() interface{} { return &ethpb.Attestation{} })
+}
+
+// decodeSSZ decodes the data into a new value, checking that the value can be hashed
+// and that its encoding is canonical: encoding it, decoding the encoding and encoding
+// the result again must yield the same bytes.
+func decodeSSZ(data []byte, newValue func() interface{}) int {
+	val := newValue()
+	if err := ssz.Unmarshal(data, val); err != nil {
+		return 0
+	}
+	if _, err := ssz.HashTreeRoot(val); err != nil {
+		return 0
+	}
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		panic(fmt.Sprintf("could not encode decoded %T: %v", val, err))
+	}
+	again := newValue()
+	if err := ssz.Unmarshal(enc, again); err != nil {
+		panic(fmt.Sprintf("could not decode encoding of %T: %v", val, err))
+	}
+	reenc, err := ssz.Marshal(again)
+	if err != nil {
+		panic(fmt.Sprintf("could not encode decoded %T: %v", val, err))
+	}
+	if !bytes.Equal(enc, reenc) {
+		panic(fmt.Sprintf("encoding of %T is not stable: %#x != %#x", val, enc, reenc))
+	}
+	return 1
+}
@@ -0,0 +1,67 @@
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// fuzzValidatorCount is the number of validators of the genesis state blocks are applied to.
+const fuzzValidatorCount = 64
+
+var (
+	genesisOnce  sync.Once
+	genesisState *pb.BeaconState
+)
+
+// fuzzGenesisState returns the interop genesis state the fuzzed blocks are applied to,
+// which is created once per process.
+func fuzzGenesisState() *pb.BeaconState {
+	genesisOnce.Do(func() {
+		keys, err := simulator.InteropKeys(fuzzValidatorCount)
+		if err != nil {
+			panic(fmt.Sprintf("could not create interop keys: %v", err))
+		}
+		deposits, eth1Data, err := simulator.InteropDeposits(keys)
+		if err != nil {
+			panic(fmt.Sprintf("could not create interop deposits: %v", err))
+		}
+		genesisState, err = state.GenesisBeaconState(deposits, 0, eth1Data)
+		if err != nil {
+			panic(fmt.Sprintf("could not create genesis state: %v", err))
+		}
+	})
+	return genesisState
+}
+
+// StateTransition applies an ssz encoded block to the genesis state. Blocks beyond the
+// first two epochs are skipped, as processing the empty slots before them would only
+// slow the fuzzer down. Signatures are not verified, so that the fuzzer can reach the
+// processing of the block operations.
+func StateTransition(data []byte) int {
+	block := &ethpb.BeaconBlock{}
+	if err := ssz.Unmarshal(data, block); err != nil {
+		return 0
+	}
+	if block.Slot > 2*params.BeaconConfig().SlotsPerEpoch {
+		return -1
+	}
+	preState := proto.Clone(fuzzGenesisState()).(*pb.BeaconState)
+	if _, err := state.ExecuteStateTransition(
+		context.Background(),
+		preState,
+		block,
+		&state.TransitionConfig{VerifySignatures: false},
+	); err != nil {
+		return 0
+	}
+	return 1
+}
@@ -9,9 +9,11 @@ import (
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 )
 
 var _ = shared.Service(&Gateway{})
@@ -24,6 +26,7 @@ type Gateway struct {
 	cancel      context.CancelFunc
 	gatewayAddr string
 	remoteAddr  string
+	remoteCert  string
 	server      *http.Server
 	mux         *http.ServeMux
 
@@ -38,7 +41,7 @@ func (g *Gateway) Start() {
 
 	log.WithField("address", g.gatewayAddr).Info("Starting gRPC gateway.")
 
-	conn, err := dial(ctx, "tcp", g.remoteAddr)
+	conn, err := dial(ctx, "tcp", g.remoteAddr, g.remoteCert)
 	if err != nil {
 		log.WithError(err).Error("Failed to connect to gRPC server")
 		g.startFailure = err
@@ -50,6 +53,12 @@ func (g *Gateway) Start() {
 	gwmux := gwruntime.NewServeMux()
 	for _, f := range []func(context.Context, *gwruntime.ServeMux, *grpc.ClientConn) error{
 		pb.RegisterBeaconServiceHandler,
+		pb.RegisterAttesterServiceHandler,
+		pb.RegisterProposerServiceHandler,
+		pb.RegisterValidatorServiceHandler,
+		pb.RegisterDebugServiceHandler,
+		ethpb.RegisterNodeHandler,
+		ethpb.RegisterBeaconChainHandler,
 	} {
 		if err := f(ctx, gwmux, conn); err != nil {
 			log.WithError(err).Error("Failed to start gateway")
@@ -58,6 +67,7 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	newStandardAPI(conn).register(g.mux)
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -90,8 +100,10 @@ func (g *Gateway) Status() error {
 
 // Stop the gateway with a graceful shutdown.
 func (g *Gateway) Stop() error {
-	if err := g.server.Shutdown(g.ctx); err != nil {
-		log.WithError(err).Error("Failed to shut down server")
+	if g.server != nil {
+		if err := g.server.Shutdown(g.ctx); err != nil {
+			log.WithError(err).Error("Failed to shut down server")
+		}
 	}
 
 	if g.cancel != nil {
@@ -102,14 +114,16 @@ func (g *Gateway) Stop() error {
 }
 
 // New returns a new gateway server which translates HTTP into gRPC.
-// Accepts a context and optional http.ServeMux.
-func New(ctx context.Context, remoteAddress, gatewayAddress string, mux *http.ServeMux) *Gateway {
+// Accepts a context and optional http.ServeMux. If the certificate of the gRPC server
+// is given, the gateway connects to it over TLS.
+func New(ctx context.Context, remoteAddress, remoteCert, gatewayAddress string, mux *http.ServeMux) *Gateway {
 	if mux == nil {
 		mux = http.NewServeMux()
 	}
 
 	return &Gateway{
 		remoteAddr:  remoteAddress,
+		remoteCert:  remoteCert,
 		gatewayAddr: gatewayAddress,
 		ctx:         ctx,
 		mux:         mux,
@@ -117,10 +131,10 @@ func New(ctx context.Context, remoteAddress, gatewayAddress string, mux *http.Se
 }
 
 // dial the gRPC server.
-func dial(ctx context.Context, network, addr string) (*grpc.ClientConn, error) {
+func dial(ctx context.Context, network, addr string, cert string) (*grpc.ClientConn, error) {
 	switch network {
 	case "tcp":
-		return dialTCP(ctx, addr)
+		return dialTCP(ctx, addr, cert)
 	case "unix":
 		return dialUnix(ctx, addr)
 	default:
@@ -128,10 +142,17 @@ func dial(ctx context.Context, network, addr string) (*grpc.ClientConn, error) {
 	}
 }
 
-// dialTCP creates a client connection via TCP.
-// "addr" must be a valid TCP address with a port number.
-func dialTCP(ctx context.Context, addr string) (*grpc.ClientConn, error) {
-	return grpc.DialContext(ctx, addr, grpc.WithInsecure())
+// dialTCP creates a client connection via TCP, over TLS if the server certificate
+// is given. "addr" must be a valid TCP address with a port number.
+func dialTCP(ctx context.Context, addr string, cert string) (*grpc.ClientConn, error) {
+	if cert == "" {
+		return grpc.DialContext(ctx, addr, grpc.WithInsecure())
+	}
+	creds, err := credentials.NewClientTLSFromFile(cert, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not load server certificate: %v", err)
+	}
+	return grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
 }
 
 // dialUnix creates a client connection via a unix domain socket.
@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1_gateway"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type mockNodeServer struct {
+	ethpb.NodeServer
+}
+
+func (m *mockNodeServer) GetVersion(_ context.Context, _ *empty.Empty) (*ethpb.Version, error) {
+	return &ethpb.Version{Version: "Prysm/v0.0.0"}, nil
+}
+
+type mockValidatorServer struct {
+	pb.ValidatorServiceServer
+}
+
+func (m *mockValidatorServer) GetDuties(_ context.Context, _ *pb.DutiesRequest) (*pb.DutiesResponse, error) {
+	return &pb.DutiesResponse{}, nil
+}
+
+// requireToken mirrors the authentication of a beacon node started with --rpc-auth-token.
+func requireToken(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, v := range md.Get("authorization") {
+			if v == "Bearer "+token {
+				return handler(ctx, req)
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+}
+
+// freeAddress returns a local address with a port no one listens on.
+func freeAddress(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
+func TestGateway_ServesJSON(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	ethpb.RegisterNodeServer(server, &mockNodeServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	gatewayAddress := freeAddress(t)
+	g := New(context.Background(), lis.Addr().String(), "", gatewayAddress, nil)
+	g.Start()
+	defer g.Stop()
+
+	var res *http.Response
+	for i := 0; i < 100; i++ {
+		if res, err = http.Get(fmt.Sprintf("http://%s/eth/v1alpha1/node/version", gatewayAddress)); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, received %d", http.StatusOK, res.StatusCode)
+	}
+	var version struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version.Version != "Prysm/v0.0.0" {
+		t.Errorf("Expected version Prysm/v0.0.0, received %s", version.Version)
+	}
+}
+
+func TestGateway_StartFailsWithInvalidCert(t *testing.T) {
+	g := New(context.Background(), freeAddress(t), "/does/not/exist.pem", freeAddress(t), nil)
+	g.Start()
+	if err := g.Status(); err == nil {
+		t.Error("Expected an error with an invalid server certificate")
+	}
+	if err := g.Stop(); err != nil {
+		t.Errorf("Expected a gateway which failed to start to stop cleanly, received %v", err)
+	}
+}
+
+func TestGateway_ForwardsAuthorization(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(grpc.UnaryInterceptor(requireToken("secret")))
+	pb.RegisterValidatorServiceServer(server, &mockValidatorServer{})
+	go server.Serve(lis)
+	defer server.Stop()
+
+	gatewayAddress := freeAddress(t)
+	g := New(context.Background(), lis.Addr().String(), "", gatewayAddress, nil)
+	g.Start()
+	defer g.Stop()
+
+	get := func(path string, auth string) int {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s%s", gatewayAddress, path), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		var res *http.Response
+		for i := 0; i < 100; i++ {
+			if res, err = http.DefaultClient.Do(req); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+	for _, path := range []string{"/v1/validator/duties", "/eth/v1/validator/duties/0"} {
+		if code := get(path, "Bearer secret"); code != http.StatusOK {
+			t.Errorf("%s: expected status %d with token, received %d", path, http.StatusOK, code)
+		}
+		if code := get(path, ""); code != http.StatusUnauthorized {
+			t.Errorf("%s: expected status %d without token, received %d", path, http.StatusUnauthorized, code)
+		}
+	}
+}
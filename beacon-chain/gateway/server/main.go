@@ -14,6 +14,7 @@ import (
 
 var (
 	beaconRPC = flag.String("beacon-rpc", "localhost:4000", "Beacon chain gRPC endpoint")
+	rpcCert   = flag.String("beacon-rpc-cert", "", "Certificate of the beacon chain gRPC server, to connect to it over TLS")
 	port      = flag.Int("port", 8000, "Port to serve on")
 	debug     = flag.Bool("debug", false, "Enable debug logging")
 )
@@ -31,7 +32,7 @@ func main() {
 	}
 
 	mux := http.NewServeMux()
-	gw := gateway.New(context.Background(), *beaconRPC, fmt.Sprintf("0.0.0.0:%d", *port), mux)
+	gw := gateway.New(context.Background(), *beaconRPC, *rpcCert, fmt.Sprintf("0.0.0.0:%d", *port), mux)
 	mux.HandleFunc("/swagger/", gateway.SwaggerServer())
 	mux.HandleFunc("/healthz", healthzServer(gw))
 	gw.Start()
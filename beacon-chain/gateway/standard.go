@@ -0,0 +1,292 @@
+package gateway
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/empty"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1_gateway"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// standardAPI translates the routes of the standard eth2 beacon node API onto the gRPC
+// services of the node, so that validators and tooling built against the standard API
+// can talk to it. Results are wrapped in a data object, with integers encoded as decimal
+// strings and byte arrays as 0x-prefixed hex strings. Blocks are encoded with the JSON
+// mapping of their protobuf messages.
+type standardAPI struct {
+	node        ethpb.NodeClient
+	beaconChain ethpb.BeaconChainClient
+	validator   pb.ValidatorServiceClient
+	proposer    pb.ProposerServiceClient
+}
+
+func newStandardAPI(conn *grpc.ClientConn) *standardAPI {
+	return &standardAPI{
+		node:        ethpb.NewNodeClient(conn),
+		beaconChain: ethpb.NewBeaconChainClient(conn),
+		validator:   pb.NewValidatorServiceClient(conn),
+		proposer:    pb.NewProposerServiceClient(conn),
+	}
+}
+
+// register adds the standard API routes to the mux.
+func (s *standardAPI) register(mux *http.ServeMux) {
+	mux.HandleFunc("/eth/v1/node/version", get(s.version))
+	mux.HandleFunc("/eth/v1/node/syncing", get(s.syncing))
+	mux.HandleFunc("/eth/v1/beacon/genesis", get(s.genesis))
+	mux.HandleFunc("/eth/v1/beacon/headers/head", get(s.headHeader))
+	mux.HandleFunc("/eth/v1/beacon/states/head/finality_checkpoints", get(s.finalityCheckpoints))
+	mux.HandleFunc("/eth/v1/beacon/blocks", s.publishBlock)
+	mux.HandleFunc("/eth/v1/validator/duties/", get(s.duties))
+	mux.HandleFunc("/eth/v1/validator/blocks/", get(s.produceBlock))
+}
+
+type checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type duty struct {
+	PublicKey      string   `json:"pubkey"`
+	ValidatorIndex string   `json:"validator_index"`
+	Status         string   `json:"status"`
+	CommitteeIndex string   `json:"committee_index"`
+	Slot           string   `json:"slot"`
+	ProposerSlots  []string `json:"proposer_slots"`
+	IsAggregator   bool     `json:"is_aggregator"`
+}
+
+func (s *standardAPI) version(r *http.Request) (interface{}, error) {
+	res, err := s.node.GetVersion(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"version": res.Version}, nil
+}
+
+func (s *standardAPI) syncing(r *http.Request) (interface{}, error) {
+	res, err := s.node.GetSyncStatus(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]bool{"is_syncing": res.Syncing}, nil
+}
+
+func (s *standardAPI) genesis(r *http.Request) (interface{}, error) {
+	res, err := s.node.GetGenesis(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	var genesisTime int64
+	if res.GenesisTime != nil {
+		genesisTime = res.GenesisTime.Seconds
+	}
+	return map[string]string{
+		"genesis_time":            strconv.FormatInt(genesisTime, 10),
+		"genesis_validators_root": encodeHex(res.GenesisValidatorsRoot),
+		"genesis_fork_version":    encodeHex(res.GenesisForkVersion),
+	}, nil
+}
+
+func (s *standardAPI) headHeader(r *http.Request) (interface{}, error) {
+	res, err := s.beaconChain.GetChainHead(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"root":      encodeHex(res.BlockRoot),
+		"canonical": true,
+		"slot":      encodeUint(res.BlockSlot),
+	}, nil
+}
+
+func (s *standardAPI) finalityCheckpoints(r *http.Request) (interface{}, error) {
+	res, err := s.beaconChain.GetChainHead(outgoingContext(r), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]checkpoint{
+		"previous_justified": {Epoch: encodeUint(res.PreviousJustifiedEpoch), Root: encodeHex(res.PreviousJustifiedBlockRoot)},
+		"current_justified":  {Epoch: encodeUint(res.JustifiedEpoch), Root: encodeHex(res.JustifiedBlockRoot)},
+		"finalized":          {Epoch: encodeUint(res.FinalizedEpoch), Root: encodeHex(res.FinalizedBlockRoot)},
+	}, nil
+}
+
+// duties serves /eth/v1/validator/duties/{epoch}?pubkey=0x...&pubkey=0x..., where the
+// optional slot_signature parameters follow the order of the public keys.
+func (s *standardAPI) duties(r *http.Request) (interface{}, error) {
+	epoch, err := uintPathParam(r, "/eth/v1/validator/duties/")
+	if err != nil {
+		return nil, err
+	}
+	query := r.URL.Query()
+	pubKeys, err := decodeHexParams(query["pubkey"])
+	if err != nil {
+		return nil, err
+	}
+	slotSigs, err := decodeHexParams(query["slot_signature"])
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.validator.GetDuties(outgoingContext(r), &pb.DutiesRequest{
+		Epoch:          epoch,
+		PublicKeys:     pubKeys,
+		SlotSignatures: slotSigs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	duties := make([]duty, len(res.Duties))
+	for i, d := range res.Duties {
+		proposerSlots := make([]string, len(d.ProposerSlots))
+		for j, slot := range d.ProposerSlots {
+			proposerSlots[j] = encodeUint(slot)
+		}
+		duties[i] = duty{
+			PublicKey:      encodeHex(d.PublicKey),
+			ValidatorIndex: encodeUint(d.ValidatorIndex),
+			Status:         strings.ToLower(d.Status.String()),
+			CommitteeIndex: encodeUint(d.Shard),
+			Slot:           encodeUint(d.AttesterSlot),
+			ProposerSlots:  proposerSlots,
+			IsAggregator:   d.IsAggregator,
+		}
+	}
+	return duties, nil
+}
+
+// produceBlock serves /eth/v1/validator/blocks/{slot}?randao_reveal=0x..., returning an
+// unsigned block for the proposer of the slot.
+func (s *standardAPI) produceBlock(r *http.Request) (interface{}, error) {
+	slot, err := uintPathParam(r, "/eth/v1/validator/blocks/")
+	if err != nil {
+		return nil, err
+	}
+	randaoReveal, err := decodeHex(r.URL.Query().Get("randao_reveal"))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid randao reveal: %v", err)
+	}
+	res, err := s.proposer.RequestBlock(outgoingContext(r), &pb.BlockRequest{
+		Slot:         slot,
+		RandaoReveal: randaoReveal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := &jsonpb.Marshaler{OrigName: true}
+	enc, err := m.MarshalToString(res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not encode block: %v", err)
+	}
+	return json.RawMessage(enc), nil
+}
+
+// publishBlock serves POST /eth/v1/beacon/blocks with a signed block in the body.
+func (s *standardAPI) publishBlock(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+		return
+	}
+	blk := &ethpb.BeaconBlock{}
+	if err := jsonpb.Unmarshal(r.Body, blk); err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "could not decode block: %v", err))
+		return
+	}
+	if _, err := s.proposer.ProposeBlock(outgoingContext(r), blk); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// get wraps a handler of GET requests, writing its result in a data object.
+func get(handler func(r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+			return
+		}
+		data, err := handler(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"data": data}); err != nil {
+			log.WithError(err).Error("Could not write response")
+		}
+	}
+}
+
+// writeError writes the error in the standard error format, with the HTTP status
+// corresponding to its gRPC code.
+func writeError(w http.ResponseWriter, err error) {
+	st, _ := status.FromError(err)
+	code := gwruntime.HTTPStatusFromCode(st.Code())
+	if st.Code() == codes.Unimplemented {
+		code = http.StatusMethodNotAllowed
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"code":    code,
+		"message": st.Message(),
+	}); err != nil {
+		log.WithError(err).Error("Could not write error response")
+	}
+}
+
+// outgoingContext forwards the authorization header of the request to the gRPC server,
+// which requires a bearer token for the validator services when started with an auth token.
+func outgoingContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	return ctx
+}
+
+// uintPathParam parses the last element of the path following the prefix.
+func uintPathParam(r *http.Request, prefix string) (uint64, error) {
+	param := strings.TrimPrefix(r.URL.Path, prefix)
+	v, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid path parameter %q", param)
+	}
+	return v, nil
+}
+
+func decodeHexParams(params []string) ([][]byte, error) {
+	decoded := make([][]byte, len(params))
+	for i, p := range params {
+		b, err := decodeHex(p)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid hex parameter %q: %v", p, err)
+		}
+		decoded[i] = b
+	}
+	return decoded, nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
+
+func encodeHex(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func encodeUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
@@ -0,0 +1,76 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1_gateway"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockNodeClient struct {
+	ethpb.NodeClient
+	syncErr error
+}
+
+func (m *mockNodeClient) GetVersion(_ context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*ethpb.Version, error) {
+	return &ethpb.Version{Version: "Prysm/v0.0.0"}, nil
+}
+
+func (m *mockNodeClient) GetSyncStatus(_ context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	if m.syncErr != nil {
+		return nil, m.syncErr
+	}
+	return &ethpb.SyncStatus{Syncing: true}, nil
+}
+
+func TestStandardAPI_Version(t *testing.T) {
+	mux := http.NewServeMux()
+	(&standardAPI{node: &mockNodeClient{}}).register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/node/version", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, received %d", http.StatusOK, rec.Code)
+	}
+	var res struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Data.Version != "Prysm/v0.0.0" {
+		t.Errorf("Expected version Prysm/v0.0.0, received %q", res.Data.Version)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/eth/v1/node/version", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d for POST request, received %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+}
+
+func TestStandardAPI_ErrorStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	(&standardAPI{node: &mockNodeClient{syncErr: status.Error(codes.Unavailable, "syncing")}}).register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/node/syncing", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d, received %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1/validator/duties/abc", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for invalid epoch, received %d", http.StatusBadRequest, rec.Code)
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var (
+	genesisDepositDataFlag = cli.StringFlag{
+		Name:  "deposit-data",
+		Usage: "Path to a deposit_data.json file holding the deposits of the genesis validators",
+	}
+	genesisKeystoreFlag = cli.StringFlag{
+		Name:  "keystore",
+		Usage: "Path to a validator keystore whose keys are deposited at genesis with the max effective balance, instead of --deposit-data",
+	}
+	genesisPasswordFlag = cli.StringFlag{
+		Name:  "password",
+		Usage: "Password of the validator keystore",
+	}
+	genesisTimeFlag = cli.Uint64Flag{
+		Name:  "genesis-time",
+		Usage: "Unix time of the genesis of the chain",
+	}
+	genesisEth1BlockHashFlag = cli.StringFlag{
+		Name:  "eth1-block-hash",
+		Usage: "Hash of the eth1 block triggering the genesis, hex encoded, zero if not set",
+	}
+	genesisOutputFlag = cli.StringFlag{
+		Name:  "output",
+		Usage: "Path of the generated genesis state",
+		Value: "genesis.ssz",
+	}
+)
+
+// genesisCommand generates the ssz encoded genesis state of a custom network.
+var genesisCommand = cli.Command{
+	Name:  "genesis",
+	Usage: "generate the genesis.ssz of a custom network from deposit data and a genesis time",
+	Flags: []cli.Flag{
+		genesisDepositDataFlag,
+		genesisKeystoreFlag,
+		genesisPasswordFlag,
+		genesisTimeFlag,
+		genesisEth1BlockHashFlag,
+		genesisOutputFlag,
+	},
+	Action: generateGenesis,
+}
+
+func generateGenesis(ctx *cli.Context) error {
+	log := logrus.WithField("prefix", "genesis")
+	var deposits []*ethpb.Deposit_Data
+	var err error
+	switch depositPath, keystorePath := ctx.String(genesisDepositDataFlag.Name), ctx.String(genesisKeystoreFlag.Name); {
+	case depositPath != "" && keystorePath != "":
+		return fmt.Errorf("only one of --%s and --%s can be set", genesisDepositDataFlag.Name, genesisKeystoreFlag.Name)
+	case depositPath != "":
+		deposits, err = readDepositDataJSON(depositPath)
+	case keystorePath != "":
+		deposits, err = keystore.InteropDeposits(keystorePath, ctx.String(genesisPasswordFlag.Name))
+	default:
+		return fmt.Errorf("one of --%s and --%s is required", genesisDepositDataFlag.Name, genesisKeystoreFlag.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if !ctx.IsSet(genesisTimeFlag.Name) {
+		return fmt.Errorf("--%s is required", genesisTimeFlag.Name)
+	}
+	eth1BlockHash, err := decodeHex(ctx.String(genesisEth1BlockHashFlag.Name))
+	if err != nil {
+		return fmt.Errorf("could not decode eth1 block hash: %v", err)
+	}
+	if len(eth1BlockHash) == 0 {
+		eth1BlockHash = make([]byte, 32)
+	}
+	if len(eth1BlockHash) != 32 {
+		return fmt.Errorf("eth1 block hash has length %d, expected 32", len(eth1BlockHash))
+	}
+
+	genesisState, err := genesisBeaconState(deposits, ctx.Uint64(genesisTimeFlag.Name), eth1BlockHash)
+	if err != nil {
+		return err
+	}
+	enc, err := ssz.Marshal(genesisState)
+	if err != nil {
+		return fmt.Errorf("could not encode genesis state: %v", err)
+	}
+	output := ctx.String(genesisOutputFlag.Name)
+	if err := ioutil.WriteFile(output, enc, 0600); err != nil {
+		return fmt.Errorf("could not write genesis state: %v", err)
+	}
+
+	activeCount := 0
+	for _, v := range genesisState.Validators {
+		if v.ActivationEpoch == 0 {
+			activeCount++
+		}
+	}
+	if uint64(activeCount) < params.BeaconConfig().MinGenesisActiveValidatorCount {
+		log.Warnf(
+			"The genesis state holds %d active validators, fewer than the %d required by the genesis of the spec",
+			activeCount,
+			params.BeaconConfig().MinGenesisActiveValidatorCount,
+		)
+	}
+	log.WithFields(logrus.Fields{
+		"output":           output,
+		"genesisTime":      genesisState.GenesisTime,
+		"validators":       len(genesisState.Validators),
+		"activeValidators": activeCount,
+	}).Info("Generated genesis state")
+	return nil
+}
+
+// genesisBeaconState initializes the beacon state from the deposits, as the genesis of
+// the spec does from the deposits of the eth1 block with the hash. The deposits are
+// included with their proofs against the deposit root of all the deposits.
+func genesisBeaconState(data []*ethpb.Deposit_Data, genesisTime uint64, eth1BlockHash []byte) (*pb.BeaconState, error) {
+	if len(data) == 0 {
+		return nil, errors.New("no deposits for the genesis state")
+	}
+	leaves := make([][]byte, len(data))
+	for i, d := range data {
+		leaf, err := hashutil.DepositHash(d)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute deposit data root: %v", err)
+		}
+		leaves[i] = leaf[:]
+	}
+	trie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate deposit trie: %v", err)
+	}
+	deposits := make([]*ethpb.Deposit, len(data))
+	for i, d := range data {
+		proof, err := trie.MerkleProof(i)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate proof of deposit %d: %v", i, err)
+		}
+		deposits[i] = &ethpb.Deposit{Proof: proof, Data: d}
+	}
+	return state.GenesisBeaconState(deposits, genesisTime, &ethpb.Eth1Data{BlockHash: eth1BlockHash})
+}
+
+// depositDataJSON is the deposit data of a validator in the deposit_data.json format of
+// the deposit launchpad.
+type depositDataJSON struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+// readDepositDataJSON reads the deposits of a deposit_data.json file.
+func readDepositDataJSON(path string) ([]*ethpb.Deposit_Data, error) {
+	// #nosec - Inclusion of file via variable is OK for the deposit data file.
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deposit data: %v", err)
+	}
+	var entries []*depositDataJSON
+	if err := json.Unmarshal(enc, &entries); err != nil {
+		return nil, fmt.Errorf("could not decode deposit data: %v", err)
+	}
+	deposits := make([]*ethpb.Deposit_Data, len(entries))
+	for i, entry := range entries {
+		data := &ethpb.Deposit_Data{Amount: entry.Amount}
+		if data.PublicKey, err = decodeHex(entry.PublicKey); err != nil {
+			return nil, fmt.Errorf("could not decode public key of deposit %d: %v", i, err)
+		}
+		if data.WithdrawalCredentials, err = decodeHex(entry.WithdrawalCredentials); err != nil {
+			return nil, fmt.Errorf("could not decode withdrawal credentials of deposit %d: %v", i, err)
+		}
+		if data.Signature, err = decodeHex(entry.Signature); err != nil {
+			return nil, fmt.Errorf("could not decode signature of deposit %d: %v", i, err)
+		}
+		deposits[i] = data
+	}
+	return deposits, nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(s, "0x"))
+}
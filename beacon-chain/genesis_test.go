@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestGenesisBeaconState_FromDepositDataJSON(t *testing.T) {
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	entries := make([]*depositDataJSON, len(deposits))
+	for i, d := range deposits {
+		entries[i] = &depositDataJSON{
+			PublicKey:             "0x" + hex.EncodeToString(d.Data.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(d.Data.WithdrawalCredentials),
+			Amount:                d.Data.Amount,
+			Signature:             hex.EncodeToString(d.Data.Signature),
+		}
+	}
+	enc, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "genesis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "deposit_data.json")
+	if err := ioutil.WriteFile(path, enc, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := readDepositDataJSON(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eth1BlockHash := bytes.Repeat([]byte{'a'}, 32)
+	genesisState, err := genesisBeaconState(data, 100, eth1BlockHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if genesisState.GenesisTime != 100 {
+		t.Errorf("Wanted genesis time 100, received %d", genesisState.GenesisTime)
+	}
+	if !bytes.Equal(genesisState.Eth1Data.BlockHash, eth1BlockHash) {
+		t.Errorf("Wanted eth1 block hash %#x, received %#x", eth1BlockHash, genesisState.Eth1Data.BlockHash)
+	}
+	if len(genesisState.Validators) != len(deposits) {
+		t.Fatalf("Wanted %d validators, received %d", len(deposits), len(genesisState.Validators))
+	}
+	for i, v := range genesisState.Validators {
+		if !bytes.Equal(v.PublicKey, deposits[i].Data.PublicKey) {
+			t.Errorf("Wanted public key %#x for validator %d, received %#x", deposits[i].Data.PublicKey, i, v.PublicKey)
+		}
+		if v.ActivationEpoch != 0 {
+			t.Errorf("Wanted validator %d active at genesis, activation epoch %d", i, v.ActivationEpoch)
+		}
+	}
+}
+
+func TestGenesisBeaconState_NoDeposits(t *testing.T) {
+	if _, err := genesisBeaconState([]*ethpb.Deposit_Data{}, 0, params.BeaconConfig().ZeroHash[:]); err == nil {
+		t.Error("Expected error for genesis state without deposits")
+	}
+}
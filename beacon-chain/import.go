@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/segment"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var importSegmentFlag = cli.StringFlag{
+	Name:  "segment",
+	Usage: "Path to the directory of the chain segment written by export-chain, whose first block and its state are the starting point of the import",
+}
+
+// importCommand replays a chain segment into a fresh database.
+var importCommand = cli.Command{
+	Name:  "import-chain",
+	Usage: "replay a chain segment through the chain service and fork choice rule into a fresh database, verifying the signatures and state root of every block",
+	Flags: []cli.Flag{
+		cmd.DataDirFlag,
+		importSegmentFlag,
+	},
+	Action: runImport,
+}
+
+func runImport(ctx *cli.Context) error {
+	dir := ctx.String(importSegmentFlag.Name)
+	if dir == "" {
+		return fmt.Errorf("--%s is required", importSegmentFlag.Name)
+	}
+	dbPath := node.DBPath(ctx.String(cmd.DataDirFlag.Name))
+	if _, err := os.Stat(dbPath); err == nil {
+		return fmt.Errorf("database already exists at %s, import requires a fresh database", dbPath)
+	}
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not create database: %v", err)
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			logrus.WithError(err).Error("Could not close database")
+		}
+	}()
+	return importChain(context.Background(), beaconDB, dir)
+}
+
+// importChain replays the blocks of the segment directory on top of its first block and
+// the state of that block, through the chain service and fork choice rule of a node the
+// same way the benchmark command does, with block signature verification enabled. It
+// checks that the states recorded in the segment are the states resulting from their
+// blocks. The first block is saved as the justified and finalized checkpoint, so that a
+// node can be started from the database once the blocks are imported.
+func importChain(ctx context.Context, beaconDB *db.BeaconDB, dir string) error {
+	log := logrus.WithField("prefix", "import")
+	blocks, err := segment.ReadBlocks(dir)
+	if err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return fmt.Errorf("no blocks in segment %s", dir)
+	}
+	anchor := blocks[0]
+	anchorState, err := segment.ReadState(dir, anchor.Slot)
+	if err != nil {
+		return err
+	}
+	if anchorState == nil {
+		return fmt.Errorf("no state recorded for the first block of the segment at slot %d", anchor.Slot)
+	}
+	stateSlots, err := segment.StateSlots(dir)
+	if err != nil {
+		return err
+	}
+	recordedStates := make(map[uint64]bool, len(stateSlots))
+	for _, slot := range stateSlots {
+		recordedStates[slot] = true
+	}
+
+	// The chain service only verifies the signatures of blocks with the feature enabled.
+	prevFeatures := featureconfig.FeatureConfig()
+	features := *prevFeatures
+	features.EnableBlockSignatureVerification = true
+	featureconfig.InitFeatureConfig(&features)
+	defer featureconfig.InitFeatureConfig(prevFeatures)
+
+	importer, err := simulator.NewImporter(ctx, beaconDB, anchor, anchorState)
+	if err != nil {
+		return fmt.Errorf("could not save anchor block: %v", err)
+	}
+	defer func() {
+		if err := importer.Stop(); err != nil {
+			log.WithError(err).Error("Could not stop importer")
+		}
+	}()
+	beaconState := anchorState
+	for _, block := range blocks[1:] {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		prevEpoch := helpers.CurrentEpoch(beaconState)
+		beaconState, err = importer.Import(ctx, block)
+		if err != nil {
+			return fmt.Errorf("could not replay block at slot %d: %v", block.Slot, err)
+		}
+		if recordedStates[block.Slot] {
+			if err := checkRecordedState(dir, beaconState); err != nil {
+				return err
+			}
+		}
+		if epoch := helpers.CurrentEpoch(beaconState); epoch > prevEpoch {
+			log.WithFields(logrus.Fields{
+				"epoch":          epoch,
+				"slot":           block.Slot,
+				"justifiedEpoch": beaconState.CurrentJustifiedCheckpoint.Epoch,
+				"finalizedEpoch": beaconState.FinalizedCheckpoint.Epoch,
+			}).Info("Imported epoch")
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"blocks":         len(blocks),
+		"headSlot":       blocks[len(blocks)-1].Slot,
+		"finalizedEpoch": beaconState.FinalizedCheckpoint.Epoch,
+	}).Info("Imported chain segment")
+	return nil
+}
+
+// checkRecordedState checks that the state recorded in the segment at the slot of the
+// replayed state is the replayed state.
+func checkRecordedState(dir string, replayed *pb.BeaconState) error {
+	recorded, err := segment.ReadState(dir, replayed.Slot)
+	if err != nil {
+		return err
+	}
+	recordedRoot, err := ssz.HashTreeRoot(recorded)
+	if err != nil {
+		return fmt.Errorf("could not hash recorded state: %v", err)
+	}
+	replayedRoot, err := ssz.HashTreeRoot(replayed)
+	if err != nil {
+		return fmt.Errorf("could not hash replayed state: %v", err)
+	}
+	if recordedRoot != replayedRoot {
+		return fmt.Errorf("recorded state at slot %d has root %#x, replayed state %#x", replayed.Slot, recordedRoot, replayedRoot)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/segment"
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// exportSimulatedChain runs a single node simulation for the number of slots and exports
+// its whole chain along with the epoch states to a temporary directory.
+func exportSimulatedChain(t *testing.T, slots uint64) (string, [32]byte) {
+	ctx := context.Background()
+	sim, err := simulator.New(ctx, &simulator.Config{
+		NodeCount:      1,
+		ValidatorCount: 64,
+		DataDir:        path.Join(testutil.TempDir(), "import-test"),
+	})
+	if err != nil {
+		t.Fatalf("Could not start simulation: %v", err)
+	}
+	defer sim.Stop()
+	if err := sim.Run(slots); err != nil {
+		t.Fatal(err)
+	}
+	_, head, err := sim.Nodes()[0].Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, err := ioutil.TempDir("", "import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := exportChain(ctx, sim.Nodes()[0].DB(), 0, sim.Slot(), output, true); err != nil {
+		t.Fatal(err)
+	}
+	return output, head
+}
+
+func setupImportDB(t *testing.T) *db.BeaconDB {
+	dbPath := path.Join(testutil.TempDir(), "import-test-db")
+	if err := db.ClearDB(dbPath); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return beaconDB
+}
+
+func teardownImportDB(t *testing.T, beaconDB *db.BeaconDB) {
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.ClearDB(beaconDB.DatabasePath); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportChain_ReplaysExportedChain(t *testing.T) {
+	prevConfig := params.BeaconConfig()
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(prevConfig)
+
+	dir, head := exportSimulatedChain(t, params.BeaconConfig().SlotsPerEpoch+2)
+	defer os.RemoveAll(dir)
+	beaconDB := setupImportDB(t)
+	defer teardownImportDB(t, beaconDB)
+
+	if err := importChain(context.Background(), beaconDB, dir); err != nil {
+		t.Fatal(err)
+	}
+	importedHead, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedRoot, err := ssz.SigningRoot(importedHead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importedRoot != head {
+		t.Errorf("Expected imported head %#x, received %#x", head, importedRoot)
+	}
+}
+
+func TestImportChain_RejectsWrongStateRoot(t *testing.T) {
+	prevConfig := params.BeaconConfig()
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+	defer params.OverrideBeaconConfig(prevConfig)
+
+	dir, _ := exportSimulatedChain(t, 4)
+	defer os.RemoveAll(dir)
+	blocks, err := segment.ReadBlocks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := blocks[len(blocks)-1]
+	tampered.StateRoot = make([]byte, 32)
+	if err := segment.WriteBlock(dir, tampered); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB := setupImportDB(t)
+	defer teardownImportDB(t, beaconDB)
+
+	err = importChain(context.Background(), beaconDB, dir)
+	if err == nil || !strings.Contains(err.Error(), "could not replay block") {
+		t.Errorf("Expected the replay of the tampered block to fail, received %v", err)
+	}
+}
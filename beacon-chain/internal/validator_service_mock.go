@@ -81,6 +81,21 @@ func (mr *MockValidatorServiceServerMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceServer)(nil).ExitedValidators), arg0, arg1)
 }
 
+// MultipleValidatorStatus mocks base method
+func (m *MockValidatorServiceServer) MultipleValidatorStatus(arg0 context.Context, arg1 *v1.MultipleValidatorStatusRequest) (*v1.MultipleValidatorStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MultipleValidatorStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v1.MultipleValidatorStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MultipleValidatorStatus indicates an expected call of MultipleValidatorStatus
+func (mr *MockValidatorServiceServerMockRecorder) MultipleValidatorStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultipleValidatorStatus", reflect.TypeOf((*MockValidatorServiceServer)(nil).MultipleValidatorStatus), arg0, arg1)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -25,11 +25,38 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.Eth1LogBatchSizeFlag,
+	flags.Eth1RequestsPerSecondFlag,
+	flags.Eth1RequestBurstFlag,
+	flags.InteropEth1Flag,
+	flags.InteropEth1KeystoreFlag,
+	flags.InteropEth1PasswordFlag,
+	flags.InteropEth1GenesisTimeFlag,
 	flags.RPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
+	flags.ClientCAFlag,
+	flags.RPCAuthTokenFlag,
+	flags.RPCMaxConcurrentStreamsFlag,
+	flags.RPCMaxRecvMsgSizeFlag,
+	flags.RPCKeepaliveTimeFlag,
+	flags.RPCKeepaliveTimeoutFlag,
+	flags.RPCKeepaliveMinTimeFlag,
+	flags.RPCRateLimitFlag,
+	flags.RPCDefaultPageSizeFlag,
+	flags.RPCMaxPageSizeFlag,
 	flags.EnableDBCleanup,
 	flags.GRPCGatewayPort,
+	flags.GRPCGatewayHost,
+	flags.ReadinessSlotLagFlag,
+	flags.ReadinessMinPeersFlag,
+	flags.EnableSlasherFlag,
+	flags.SlasherHistoryEpochsFlag,
+	flags.ArchiveFlag,
+	flags.AttestationPoolMaxSizeFlag,
+	flags.AttestationPoolMaxAgeFlag,
+	flags.ServiceMaxRestartsFlag,
+	flags.ServiceRestartBackoffFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -39,24 +66,49 @@ var appFlags = []cli.Flag{
 	cmd.P2PMaxPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
+	cmd.P2PAllowList,
+	cmd.P2PDenyList,
+	cmd.P2PSecurity,
+	flags.P2PMaxChunkSize,
+	flags.P2PMaxRequestCount,
 	cmd.DataDirFlag,
+	cmd.ConfigFileFlag,
+	cmd.NetworkFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
+	cmd.TracingExporterFlag,
 	cmd.TracingEndpointFlag,
+	cmd.TracingUsernameFlag,
+	cmd.TracingPasswordFlag,
+	cmd.TracingHeadersFlag,
 	cmd.TraceSampleFractionFlag,
 	cmd.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
 	cmd.LogFormat,
 	cmd.MaxGoroutines,
+	cmd.MaxHeapSizeFlag,
+	cmd.MaxOpenFilesFlag,
+	cmd.NTPServersFlag,
+	cmd.ClockSkewThresholdFlag,
+	cmd.AdjustClockFlag,
+	cmd.ShutdownTimeoutFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
 	debug.PProfPortFlag,
 	debug.MemProfileRateFlag,
 	debug.CPUProfileFlag,
 	debug.TraceFlag,
+	debug.ProfileDurationFlag,
 	cmd.LogFileName,
+	cmd.LogMaxSizeFlag,
+	cmd.LogMaxBackupsFlag,
+	cmd.LogMaxAgeFlag,
+	cmd.LogCompressFlag,
+	cmd.LogSyslogFlag,
+	cmd.LogHTTPEndpointFlag,
+	cmd.LogHTTPLabelsFlag,
 	cmd.EnableUPnPFlag,
 }
 
@@ -71,10 +123,31 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	cli.VersionPrinter = func(ctx *cli.Context) {
+		fmt.Printf("%s\n%s\n", ctx.App.Name, version.GetBuildData())
+	}
 
-	app.Flags = appFlags
+	app.Flags = append(appFlags, cmd.DeprecatedFlags(featureconfig.DeprecatedBeaconChainFlags)...)
+	app.Commands = []cli.Command{
+		cmd.ConfigCommand(appFlags),
+		cmd.DBCommand(node.DBPath),
+		genesisCommand,
+		benchmarkCommand,
+		exportCommand,
+		importCommand,
+	}
 
 	app.Before = func(ctx *cli.Context) error {
+		if err := cmd.LoadEnvVars(ctx, app.Flags); err != nil {
+			return err
+		}
+		if err := cmd.LoadConfigFile(ctx, app.Flags); err != nil {
+			return err
+		}
+		if err := cmd.ApplyDeprecatedFlags(ctx, featureconfig.DeprecatedBeaconChainFlags); err != nil {
+			return err
+		}
+
 		format := ctx.GlobalString(cmd.LogFormat.Name)
 		switch format {
 		case "text":
@@ -98,10 +171,27 @@ func main() {
 
 		logFileName := ctx.GlobalString(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+			rotation := &logutil.RotationConfig{
+				MaxSizeMB:  ctx.GlobalInt(cmd.LogMaxSizeFlag.Name),
+				MaxBackups: ctx.GlobalInt(cmd.LogMaxBackupsFlag.Name),
+				MaxAgeDays: ctx.GlobalInt(cmd.LogMaxAgeFlag.Name),
+				Compress:   ctx.GlobalBool(cmd.LogCompressFlag.Name),
+			}
+			if err := logutil.ConfigurePersistentLogging(logFileName, rotation); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
+		if address := ctx.GlobalString(cmd.LogSyslogFlag.Name); address != "" {
+			if err := logutil.ConfigureSyslog(address, "beacon-chain"); err != nil {
+				log.WithError(err).Error("Failed to configure logging to syslog")
+			}
+		}
+		if endpoint := ctx.GlobalString(cmd.LogHTTPEndpointFlag.Name); endpoint != "" {
+			labels := ctx.GlobalStringSlice(cmd.LogHTTPLabelsFlag.Name)
+			if err := logutil.ConfigureHTTPLogging(endpoint, "beacon-chain", labels); err != nil {
+				log.WithError(err).Error("Failed to configure logging to HTTP endpoint")
+			}
+		}
 
 		runtime.GOMAXPROCS(runtime.NumCPU())
 		return debug.Setup(ctx)
@@ -1,18 +1,37 @@
 package node
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"sync"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli"
 )
 
 var cachedDepositAddress string
 var fetchLock sync.Mutex
 
+// depositContractAddress returns the deposit contract address given by flag, or else the
+// one of the network preset in use, fetched from its endpoint if it has one.
+func depositContractAddress(ctx *cli.Context) (string, error) {
+	if addr := ctx.GlobalString(flags.DepositContractFlag.Name); addr != "" {
+		return addr, nil
+	}
+	preset := params.ActivePreset()
+	if preset.DepositContract != "" {
+		return preset.DepositContract, nil
+	}
+	if preset.ContractEndpoint != "" {
+		return fetchDepositContract(preset.ContractEndpoint)
+	}
+	return "", errors.New("the network preset has no deposit contract, set one with --" + flags.DepositContractFlag.Name)
+}
+
 // fetchDepositContract from the cluster endpoint.
-func fetchDepositContract() (string, error) {
+func fetchDepositContract(endpoint string) (string, error) {
 	fetchLock.Lock()
 	defer fetchLock.Unlock()
 
@@ -22,9 +41,9 @@ func fetchDepositContract() (string, error) {
 
 	log.WithField(
 		"endpoint",
-		params.BeaconConfig().TestnetContractEndpoint,
+		endpoint,
 	).Info("Fetching testnet cluster address")
-	resp, err := http.Get(params.BeaconConfig().TestnetContractEndpoint)
+	resp, err := http.Get(endpoint)
 	if err != nil {
 		return "", err
 	}
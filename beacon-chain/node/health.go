@@ -0,0 +1,90 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/resourcemonitor"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/urfave/cli"
+)
+
+// registerHealthChecks adds the checks reported by the /healthz and /readyz routes of
+// the monitoring service. The node is alive as long as its database is open, and ready
+// once it follows the head of the chain, its eth1 client is healthy, it has enough
+// peers and its resource usage is within the limits.
+func (b *BeaconNode) registerHealthChecks(ctx *cli.Context, service *prometheus.Service) error {
+	var chainService *blockchain.ChainService
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
+	var web3Service *powchain.Web3Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return err
+	}
+
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	var monitor *resourcemonitor.Service
+	if err := b.services.FetchService(&monitor); err != nil {
+		return err
+	}
+
+	service.AddHealthCheck("beacondb", b.db.Ping)
+	service.AddReadinessCheck("resources", monitor.Status)
+	service.AddReadinessCheck("blockchain", chainService.Status)
+	service.AddReadinessCheck("sync", syncedWithin(b.db, syncService, ctx.GlobalUint64(flags.ReadinessSlotLagFlag.Name)))
+	service.AddReadinessCheck("eth1", web3Service.Status)
+	service.AddReadinessCheck("peers", minimumPeers(p2pService, ctx.GlobalInt(flags.ReadinessMinPeersFlag.Name)))
+	return nil
+}
+
+// syncedWithin returns a check which fails while the node is syncing, or while the
+// head of the chain is more than maxLag slots behind the current slot.
+func syncedWithin(beaconDB *db.BeaconDB, checker rbcsync.Checker, maxLag uint64) prometheus.Check {
+	return func() error {
+		if checker.Syncing() {
+			return errors.New("node is syncing")
+		}
+		headState, err := beaconDB.HeadState(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not retrieve head state: %v", err)
+		}
+		if headState == nil {
+			return errors.New("chain has not started")
+		}
+		currentSlot := slotutil.CurrentSlot(headState.GenesisTime)
+		if currentSlot > headState.Slot+maxLag {
+			return fmt.Errorf("head slot %d is %d slots behind current slot %d", headState.Slot, currentSlot-headState.Slot, currentSlot)
+		}
+		return nil
+	}
+}
+
+// minimumPeers returns a check which fails while the node is connected to fewer than
+// min peers.
+func minimumPeers(provider p2p.PeersProvider, min int) prometheus.Check {
+	return func() error {
+		if count := len(provider.Peers()); count < min {
+			return fmt.Errorf("connected to %d peers, need at least %d", count, min)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,32 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+type mockPeersProvider struct {
+	peers []p2p.PeerInfo
+}
+
+func (m *mockPeersProvider) HostInfo() p2p.PeerInfo {
+	return p2p.PeerInfo{}
+}
+
+func (m *mockPeersProvider) Peers() []p2p.PeerInfo {
+	return m.peers
+}
+
+func TestMinimumPeers(t *testing.T) {
+	provider := &mockPeersProvider{}
+	check := minimumPeers(provider, 2)
+
+	if err := check(); err == nil {
+		t.Error("Expected check to fail without peers")
+	}
+	provider.peers = []p2p.PeerInfo{{ID: "a"}, {ID: "b"}}
+	if err := check(); err != nil {
+		t.Errorf("Expected check to pass with enough peers, received %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -21,14 +22,18 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/resourcemonitor"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/sirupsen/logrus"
@@ -54,16 +59,21 @@ type BeaconNode struct {
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
 // every required service to the node.
 func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
-	if err := tracing.Setup(
-		"beacon-chain", // service name
-		ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
-		ctx.GlobalString(cmd.TracingEndpointFlag.Name),
-		ctx.GlobalFloat64(cmd.TraceSampleFractionFlag.Name),
-		ctx.GlobalBool(cmd.EnableTracingFlag.Name),
-	); err != nil {
+	if err := tracing.Setup(&tracing.Config{
+		ServiceName:    "beacon-chain",
+		ProcessName:    ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
+		Exporter:       ctx.GlobalString(cmd.TracingExporterFlag.Name),
+		Endpoint:       ctx.GlobalString(cmd.TracingEndpointFlag.Name),
+		Username:       ctx.GlobalString(cmd.TracingUsernameFlag.Name),
+		Password:       ctx.GlobalString(cmd.TracingPasswordFlag.Name),
+		Headers:        ctx.GlobalStringSlice(cmd.TracingHeadersFlag.Name),
+		SampleFraction: ctx.GlobalFloat64(cmd.TraceSampleFractionFlag.Name),
+		Enable:         ctx.GlobalBool(cmd.EnableTracingFlag.Name),
+	}); err != nil {
 		return nil, err
 	}
 	registry := shared.NewServiceRegistry()
+	registry.SetStopTimeout(ctx.GlobalDuration(cmd.ShutdownTimeoutFlag.Name))
 
 	beacon := &BeaconNode{
 		ctx:      ctx,
@@ -71,18 +81,39 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		stop:     make(chan struct{}),
 	}
 
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
-		log.Info("Using custom parameter configuration")
-		params.UseDemoBeaconConfig()
+	// Use the testnet preset unless a network is given or the --no-custom-config flag is set.
+	network := ctx.GlobalString(cmd.NetworkFlag.Name)
+	if network == "" {
+		network = params.TestnetPreset
+		if ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
+			network = params.MainnetPreset
+		}
+	}
+	if err := params.UsePreset(network); err != nil {
+		return nil, err
 	}
+	log.WithField("network", network).Info("Using network preset")
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
+	// Bound the size of list RPC responses.
+	c := params.BeaconConfig()
+	c.DefaultPageSize = ctx.GlobalInt(flags.RPCDefaultPageSizeFlag.Name)
+	c.MaxPageSize = ctx.GlobalInt(flags.RPCMaxPageSizeFlag.Name)
+	params.OverrideBeaconConfig(c)
+
 	if err := beacon.startDB(ctx); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.registerResourceMonitor(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerClockCheck(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(ctx); err != nil {
 		return nil, err
 	}
@@ -95,7 +126,7 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	if err := beacon.registerOperationService(); err != nil {
+	if err := beacon.registerOperationService(ctx); err != nil {
 		return nil, err
 	}
 
@@ -103,6 +134,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(flags.EnableSlasherFlag.Name) {
+		if err := beacon.registerSlasherService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerSyncService(ctx); err != nil {
 		return nil, err
 	}
@@ -137,6 +174,12 @@ func (b *BeaconNode) Start() {
 	stop := b.stop
 	b.lock.Unlock()
 
+	go b.reloadConfigOnHangup(stop)
+	go debug.CaptureProfilesOnSignal(
+		stop,
+		b.ctx.GlobalString(cmd.DataDirFlag.Name),
+		b.ctx.GlobalDuration(debug.ProfileDurationFlag.Name),
+	)
 	go func() {
 		sigc := make(chan os.Signal, 1)
 		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
@@ -158,6 +201,66 @@ func (b *BeaconNode) Start() {
 	<-stop
 }
 
+// reloadConfigOnHangup reloads the features which can be toggled at runtime and the
+// p2p denylist from the config file each time the node receives a SIGHUP, until the
+// node stops.
+func (b *BeaconNode) reloadConfigOnHangup(stop <-chan struct{}) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP)
+	defer signal.Stop(sigc)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-sigc:
+			path := b.ctx.GlobalString(cmd.ConfigFileFlag.Name)
+			if path == "" {
+				log.Warn("Got hangup without a config file to reload features from")
+				continue
+			}
+			log.WithField("configFile", path).Info("Got hangup, reloading features")
+			values, err := cmd.ReadConfigFile(path)
+			if err != nil {
+				log.Errorf("Could not reload features: %v", err)
+				continue
+			}
+			if err := featureconfig.ReloadFeatures(values); err != nil {
+				log.Errorf("Could not reload features: %v", err)
+			}
+			if err := b.reloadDenylist(values); err != nil {
+				log.Errorf("Could not reload p2p denylist: %v", err)
+			}
+		}
+	}
+}
+
+// reloadDenylist replaces the denied p2p subnets with the denylist of the config file, so
+// abusive ranges can be blocked without restarting the node. Subnets removed from the
+// denylist accept connections again.
+func (b *BeaconNode) reloadDenylist(values map[string]interface{}) error {
+	value, ok := values[cmd.P2PDenyList.Name]
+	if !ok {
+		return nil
+	}
+	subnets, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("invalid value %v for %q, expected a list of subnets", value, cmd.P2PDenyList.Name)
+	}
+	cidrs := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		cidr, ok := subnet.(string)
+		if !ok {
+			return fmt.Errorf("invalid subnet %v in %q, expected a string", subnet, cmd.P2PDenyList.Name)
+		}
+		cidrs[i] = cidr
+	}
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+	return p2pService.SetDeniedSubnets(cidrs)
+}
+
 // Close handles graceful shutdown of the system.
 func (b *BeaconNode) Close() {
 	b.lock.Lock()
@@ -165,15 +268,17 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
-	if err := b.db.Close(); err != nil {
-		log.Errorf("Failed to close database: %v", err)
-	}
+	tracing.Stop()
 	close(b.stop)
 }
 
+// DBPath returns the path of the beacon chain database of a node with the data directory.
+func DBPath(dataDir string) string {
+	return path.Join(dataDir, beaconChainDBName)
+}
+
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
-	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
-	dbPath := path.Join(baseDir, beaconChainDBName)
+	dbPath := DBPath(ctx.GlobalString(cmd.DataDirFlag.Name))
 	if b.ctx.GlobalBool(cmd.ClearDB.Name) {
 		if err := db.ClearDB(dbPath); err != nil {
 			return err
@@ -187,11 +292,13 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 
 	log.WithField("path", dbPath).Info("Checking db")
 	b.db = db
+	// The database is closed once every service using it is stopped.
+	b.services.RegisterCloser("database", db.Close)
 	return nil
 }
 
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
-	beaconp2p, err := configureP2P(ctx)
+	beaconp2p, err := configureP2P(ctx, b.db)
 	if err != nil {
 		return fmt.Errorf("could not register p2p service: %v", err)
 	}
@@ -216,15 +323,14 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 	if err := b.services.FetchService(&p2pService); err != nil {
 		return err
 	}
-	maxRoutines := ctx.GlobalInt64(cmd.MaxGoroutines.Name)
-
 	blockchainService, err := blockchain.NewChainService(context.Background(), &blockchain.Config{
 		BeaconDB:       b.db,
 		Web3Service:    web3Service,
 		OpsPoolService: opsService,
 		AttsService:    attsService,
 		P2p:            p2pService,
-		MaxRoutines:    maxRoutines,
+		Archive:        ctx.GlobalBool(flags.ArchiveFlag.Name),
+		RestartPolicy:  restartPolicy(ctx),
 	})
 	if err != nil {
 		return fmt.Errorf("could not register blockchain service: %v", err)
@@ -232,33 +338,80 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 	return b.services.RegisterService(blockchainService)
 }
 
-func (b *BeaconNode) registerOperationService() error {
+// restartPolicy returns the policy restarting the goroutines of the services after a panic.
+func restartPolicy(ctx *cli.Context) supervisor.Policy {
+	return supervisor.Policy{
+		MaxRestarts: ctx.GlobalInt(flags.ServiceMaxRestartsFlag.Name),
+		Backoff:     ctx.GlobalDuration(flags.ServiceRestartBackoffFlag.Name),
+		MaxBackoff:  supervisor.DefaultPolicy.MaxBackoff,
+	}
+}
+
+func (b *BeaconNode) registerResourceMonitor(ctx *cli.Context) error {
+	monitor := resourcemonitor.NewService(context.Background(), &resourcemonitor.Config{
+		MaxGoroutines: int(ctx.GlobalInt64(cmd.MaxGoroutines.Name)),
+		MaxHeapBytes:  ctx.GlobalUint64(cmd.MaxHeapSizeFlag.Name) * 1024 * 1024,
+		MaxOpenFiles:  ctx.GlobalInt(cmd.MaxOpenFilesFlag.Name),
+	})
+	return b.services.RegisterService(monitor)
+}
+
+func (b *BeaconNode) registerClockCheck(ctx *cli.Context) error {
+	servers := ctx.GlobalString(cmd.NTPServersFlag.Name)
+	if servers == "" {
+		return nil
+	}
+	clock := clockutil.NewService(context.Background(), &clockutil.Config{
+		Servers:   strings.Split(servers, ","),
+		Threshold: ctx.GlobalDuration(cmd.ClockSkewThresholdFlag.Name),
+		Adjust:    ctx.GlobalBool(cmd.AdjustClockFlag.Name),
+	})
+	return b.services.RegisterService(clock)
+}
+
+func (b *BeaconNode) registerOperationService(ctx *cli.Context) error {
 	var p2pService *p2p.Server
 	if err := b.services.FetchService(&p2pService); err != nil {
 		return err
 	}
 
 	operationService := operations.NewOpsPoolService(context.Background(), &operations.Config{
-		BeaconDB: b.db,
-		P2P:      p2pService,
+		BeaconDB:          b.db,
+		P2P:               p2pService,
+		MaxAttestations:   ctx.GlobalInt(flags.AttestationPoolMaxSizeFlag.Name),
+		AttestationMaxAge: ctx.GlobalUint64(flags.AttestationPoolMaxAgeFlag.Name),
+		RestartPolicy:     restartPolicy(ctx),
 	})
 
 	return b.services.RegisterService(operationService)
 }
 
+func (b *BeaconNode) registerSlasherService(ctx *cli.Context) error {
+	var opsService *operations.Service
+	if err := b.services.FetchService(&opsService); err != nil {
+		return err
+	}
+
+	slasherService := slasher.NewSlasherService(context.Background(), &slasher.Config{
+		BeaconDB:      b.db,
+		OpsPool:       opsService,
+		HistoryEpochs: ctx.GlobalUint64(flags.SlasherHistoryEpochsFlag.Name),
+	})
+
+	return b.services.RegisterService(slasherService)
+}
+
 func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	if cliCtx.GlobalBool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Web3Service{})
 	}
+	if cliCtx.GlobalBool(flags.InteropEth1Flag.Name) {
+		return b.registerSimulatedPOWChainService(cliCtx)
+	}
 
-	depAddress := cliCtx.GlobalString(flags.DepositContractFlag.Name)
-
-	if depAddress == "" {
-		var err error
-		depAddress, err = fetchDepositContract()
-		if err != nil {
-			log.WithError(err).Fatal("Cannot fetch deposit contract")
-		}
+	depAddress, err := depositContractAddress(cliCtx)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot fetch deposit contract")
 	}
 
 	if !common.IsHexAddress(depAddress) {
@@ -279,15 +432,19 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
-		Endpoint:        cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		Client:          httpClient,
-		Reader:          powClient,
-		Logger:          powClient,
-		HTTPLogger:      httpClient,
-		BlockFetcher:    httpClient,
-		ContractBackend: httpClient,
-		BeaconDB:        b.db,
+		Endpoint:          cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
+		DepositContract:   common.HexToAddress(depAddress),
+		Client:            httpClient,
+		Reader:            powClient,
+		Logger:            powClient,
+		HTTPLogger:        httpClient,
+		BlockFetcher:      httpClient,
+		ContractBackend:   httpClient,
+		BeaconDB:          b.db,
+		LogBatchSize:      cliCtx.GlobalUint64(flags.Eth1LogBatchSizeFlag.Name),
+		RequestsPerSecond: cliCtx.GlobalFloat64(flags.Eth1RequestsPerSecondFlag.Name),
+		RequestBurst:      cliCtx.GlobalInt(flags.Eth1RequestBurstFlag.Name),
+		RestartPolicy:     restartPolicy(cliCtx),
 	}
 	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
 	if err != nil {
@@ -301,7 +458,7 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(web3Service)
 }
 
-func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
+func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	var chainService *blockchain.ChainService
 	if err := b.services.FetchService(&chainService); err != nil {
 		return err
@@ -334,6 +491,8 @@ func (b *BeaconNode) registerSyncService(_ *cli.Context) error {
 		OperationService: operationService,
 		PowChainService:  web3Service,
 		AttsService:      attsService,
+		ForkTopics:       p2pService,
+		RestartPolicy:    restartPolicy(ctx),
 	}
 
 	syncService := rbcsync.NewSyncService(context.Background(), cfg)
@@ -369,16 +528,37 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	port := ctx.GlobalString(flags.RPCPort.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	key := ctx.GlobalString(flags.KeyFlag.Name)
+	clientCA := ctx.GlobalString(flags.ClientCAFlag.Name)
+	authToken := ctx.GlobalString(flags.RPCAuthTokenFlag.Name)
+	rateLimits, err := rpc.ParseRateLimits(ctx.GlobalStringSlice(flags.RPCRateLimitFlag.Name))
+	if err != nil {
+		return err
+	}
+	limits := rpc.ServerLimits{
+		MaxConcurrentStreams: uint32(ctx.GlobalUint64(flags.RPCMaxConcurrentStreamsFlag.Name)),
+		MaxRecvMsgSize:       ctx.GlobalInt(flags.RPCMaxRecvMsgSizeFlag.Name),
+		KeepaliveTime:        ctx.GlobalDuration(flags.RPCKeepaliveTimeFlag.Name),
+		KeepaliveTimeout:     ctx.GlobalDuration(flags.RPCKeepaliveTimeoutFlag.Name),
+		KeepaliveMinTime:     ctx.GlobalDuration(flags.RPCKeepaliveMinTimeFlag.Name),
+		RateLimits:           rateLimits,
+	}
 	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
 		Port:             port,
 		CertFlag:         cert,
 		KeyFlag:          key,
+		ClientCAFlag:     clientCA,
+		AuthToken:        authToken,
+		DataDir:          ctx.GlobalString(cmd.DataDirFlag.Name),
+		ProfileDuration:  ctx.GlobalDuration(debug.ProfileDurationFlag.Name),
+		Limits:           limits,
 		BeaconDB:         b.db,
 		Broadcaster:      p2pService,
+		PeersProvider:    p2pService,
 		ChainService:     chainService,
 		OperationService: operationService,
 		POWChainService:  web3Service,
 		SyncService:      syncService,
+		RestartPolicy:    restartPolicy(ctx),
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -389,6 +569,9 @@ func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
 		b.services,
 	)
+	if err := b.registerHealthChecks(ctx, service); err != nil {
+		return err
+	}
 	hook := prometheus.NewLogrusCollector()
 	logrus.AddHook(hook)
 	return b.services.RegisterService(service)
@@ -406,9 +589,19 @@ func (b *BeaconNode) registerAttestationService() error {
 func (b *BeaconNode) registerGRPCGateway(ctx *cli.Context) error {
 	gatewayPort := ctx.GlobalInt(flags.GRPCGatewayPort.Name)
 	if gatewayPort > 0 {
+		// The gateway connects to the RPC server like any other client, it cannot present a
+		// client certificate when mutual TLS is required.
+		if ctx.GlobalString(flags.ClientCAFlag.Name) != "" {
+			return fmt.Errorf("the gRPC gateway cannot be enabled along with --%s", flags.ClientCAFlag.Name)
+		}
 		selfAddress := fmt.Sprintf("127.0.0.1:%d", ctx.GlobalInt(flags.RPCPort.Name))
-		gatewayAddress := fmt.Sprintf("127.0.0.1:%d", gatewayPort)
-		return b.services.RegisterService(gateway.New(context.Background(), selfAddress, gatewayAddress, nil /*optional mux*/))
+		var selfCert string
+		if ctx.GlobalString(flags.KeyFlag.Name) != "" {
+			selfCert = ctx.GlobalString(flags.CertFlag.Name)
+		}
+		gatewayHost := ctx.GlobalString(flags.GRPCGatewayHost.Name)
+		gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
+		return b.services.RegisterService(gateway.New(context.Background(), selfAddress, selfCert, gatewayAddress, nil /*optional mux*/))
 	}
 	return nil
 }
@@ -1,11 +1,13 @@
 package node
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli"
@@ -39,3 +41,52 @@ func TestNodeClose_OK(t *testing.T) {
 
 	os.RemoveAll(tmp)
 }
+
+// Test that the beacon chain node boots with the config of each network preset.
+func TestNodeBoot_Presets(t *testing.T) {
+	defer params.UsePreset(params.MainnetPreset)
+
+	for _, name := range params.PresetNames() {
+		tmp := fmt.Sprintf("%s/datadirpreset-%s", testutil.TempDir(), name)
+		os.RemoveAll(tmp)
+
+		app := cli.NewApp()
+		set := flag.NewFlagSet("test", 0)
+		set.String("web3provider", "ws//127.0.0.1:8546", "web3 provider ws or IPC endpoint")
+		set.Bool("test-skip-pow", true, "skip pow dial")
+		set.String("datadir", tmp, "node data directory")
+		set.String("network", name, "network preset")
+		// The interop preset has its own deposit contract, the others need one given by flag.
+		if name != params.InteropPreset {
+			set.String("deposit-contract", "0x0000000000000000000000000000000000000000", "deposit contract address")
+		}
+		context := cli.NewContext(app, set, nil)
+
+		node, err := NewBeaconNode(context)
+		if err != nil {
+			t.Fatalf("Failed to create BeaconNode with preset %s: %v", name, err)
+		}
+		want := params.ActivePreset().Config()
+		if params.ActivePreset().Name != name {
+			t.Errorf("Expected preset %s to be active, got %s", name, params.ActivePreset().Name)
+		}
+		if !bytes.Equal(params.BeaconConfig().GenesisForkVersion, want.GenesisForkVersion) {
+			t.Errorf("Expected genesis fork version %#x for preset %s, got %#x", want.GenesisForkVersion, name, params.BeaconConfig().GenesisForkVersion)
+		}
+		if params.BeaconConfig().SlotsPerEpoch != want.SlotsPerEpoch {
+			t.Errorf("Expected %d slots per epoch for preset %s, got %d", want.SlotsPerEpoch, name, params.BeaconConfig().SlotsPerEpoch)
+		}
+		if name == params.InteropPreset {
+			addr, err := depositContractAddress(context)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if addr != params.InteropDepositContract {
+				t.Errorf("Expected interop deposit contract %s, got %s", params.InteropDepositContract, addr)
+			}
+		}
+
+		node.Close()
+		os.RemoveAll(tmp)
+	}
+}
@@ -1,14 +1,18 @@
 package node
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/p2p/adapter/metric"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli"
 )
 
@@ -27,22 +31,29 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_ATTESTATION_ANNOUNCE:                &pb.AttestationAnnounce{},
 	pb.Topic_ATTESTATION_REQUEST:                 &pb.AttestationRequest{},
 	pb.Topic_ATTESTATION_RESPONSE:                &pb.AttestationResponse{},
+	pb.Topic_VOLUNTARY_EXIT:                      &ethpb.VoluntaryExit{},
 }
 
-func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
-	contractAddress := ctx.GlobalString(flags.DepositContractFlag.Name)
-	if contractAddress == "" {
-		var err error
-		contractAddress, err = fetchDepositContract()
-		if err != nil {
-			return nil, err
-		}
+func configureP2P(ctx *cli.Context, beaconDB *db.BeaconDB) (*p2p.Server, error) {
+	contractAddress, err := depositContractAddress(ctx)
+	if err != nil {
+		return nil, err
 	}
 	staticPeers := []string{}
 	for _, entry := range ctx.GlobalStringSlice(cmd.StaticPeers.Name) {
 		peers := strings.Split(entry, ",")
 		staticPeers = append(staticPeers, peers...)
 	}
+	// The genesis validators root is only known once the chain has started. Until then the
+	// fork digests are derived from an empty root, and regular sync updates them later on.
+	genesisValidatorsRoot, err := beaconDB.GenesisValidatorsRoot(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	securityTransports, err := p2p.ParseSecurityTransports(ctx.GlobalString(cmd.P2PSecurity.Name))
+	if err != nil {
+		return nil, err
+	}
 
 	s, err := p2p.NewServer(&p2p.ServerConfig{
 		NoDiscovery:            ctx.GlobalBool(cmd.NoDiscovery.Name),
@@ -55,7 +66,16 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		PrvKey:                 ctx.GlobalString(cmd.P2PPrivKey.Name),
 		DepositContractAddress: contractAddress,
 		WhitelistCIDR:          ctx.GlobalString(cmd.P2PWhitelist.Name),
+		AllowlistCIDRs:         ctx.GlobalStringSlice(cmd.P2PAllowList.Name),
+		DenylistCIDRs:          ctx.GlobalStringSlice(cmd.P2PDenyList.Name),
 		EnableUPnP:             ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
+		ReputationStore:        beaconDB,
+		SecurityTransports:     securityTransports,
+		MaxChunkSize:           ctx.GlobalUint64(flags.P2PMaxChunkSize.Name),
+		MaxRequestCount:        ctx.GlobalInt(flags.P2PMaxRequestCount.Name),
+		ForkDigest:             p2p.ForkDigest(params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot),
+		NextForkDigest:         p2p.ForkDigest(params.BeaconConfig().NextForkVersion, genesisValidatorsRoot),
+		NextForkEpoch:          params.BeaconConfig().NextForkEpoch,
 	})
 	if err != nil {
 		return nil, err
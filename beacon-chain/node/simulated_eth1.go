@@ -0,0 +1,92 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli"
+)
+
+// simulatedEth1Endpoint is reported as the endpoint of the powchain service when it
+// follows the simulated eth1 chain.
+const simulatedEth1Endpoint = "ipc://simulated-eth1"
+
+// registerSimulatedPOWChainService registers a simulated eth1 chain including the
+// deposits of the keys in the interop keystore, along with the powchain service
+// following it, so that devnets can run without an eth1 node.
+func (b *BeaconNode) registerSimulatedPOWChainService(cliCtx *cli.Context) error {
+	deposits, err := interopDeposits(
+		cliCtx.GlobalString(flags.InteropEth1KeystoreFlag.Name),
+		cliCtx.GlobalString(flags.InteropEth1PasswordFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	genesisTime := cliCtx.GlobalUint64(flags.InteropEth1GenesisTimeFlag.Name)
+	if genesisTime == 0 {
+		genesisTime = uint64(time.Now().Unix())
+	}
+	addr, err := depositContractAddress(cliCtx)
+	if err != nil {
+		return err
+	}
+	depAddress := common.HexToAddress(addr)
+
+	ctx := context.Background()
+	chain, err := powchain.NewSimulatedChain(ctx, depAddress, genesisTime, deposits)
+	if err != nil {
+		return fmt.Errorf("could not create simulated eth1 chain: %v", err)
+	}
+	if err := b.services.RegisterService(chain); err != nil {
+		return err
+	}
+
+	cfg := &powchain.Web3ServiceConfig{
+		Endpoint:        simulatedEth1Endpoint,
+		DepositContract: depAddress,
+		Client:          chain,
+		Reader:          chain,
+		Logger:          chain,
+		HTTPLogger:      chain,
+		BlockFetcher:    chain,
+		ContractBackend: chain,
+		BeaconDB:        b.db,
+		LogBatchSize:    cliCtx.GlobalUint64(flags.Eth1LogBatchSizeFlag.Name),
+		RestartPolicy:   restartPolicy(cliCtx),
+	}
+	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("could not register proof-of-work chain web3Service: %v", err)
+	}
+	if err := b.db.VerifyContractAddress(ctx, cfg.DepositContract); err != nil {
+		return err
+	}
+	return b.services.RegisterService(web3Service)
+}
+
+// interopDeposits returns the deposits of the max effective balance for the keys in
+// the keystore, ordered by public key so that every node builds the same chain.
+func interopDeposits(keystorePath string, password string) ([]*ethpb.Deposit_Data, error) {
+	if keystorePath == "" {
+		return nil, fmt.Errorf("--%s is required with --%s", flags.InteropEth1KeystoreFlag.Name, flags.InteropEth1Flag.Name)
+	}
+	deposits, err := keystore.InteropDeposits(keystorePath, password)
+	if err != nil {
+		return nil, fmt.Errorf("could not load interop keystore: %v", err)
+	}
+	if uint64(len(deposits)) < params.BeaconConfig().MinGenesisActiveValidatorCount {
+		log.Warnf(
+			"The interop keystore holds %d keys, fewer than the %d validators required for the chain to start",
+			len(deposits),
+			params.BeaconConfig().MinGenesisActiveValidatorCount,
+		)
+	}
+	return deposits, nil
+}
@@ -0,0 +1,230 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Reasons of the attestation evictions counted by the evictions counter.
+const (
+	evictedExpired  = "expired"
+	evictedIncluded = "included"
+	evictedCapacity = "capacity"
+)
+
+var (
+	attestationPoolSize = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "operations_attestation_pool_size",
+		Help: "The number of attestations in the attestation pool",
+	})
+	attestationPoolEvictions = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "operations_attestation_pool_evictions_total",
+		Help: "The number of attestations evicted from the attestation pool, by reason",
+	}, []string{"reason"})
+)
+
+// isExpired returns true if the attestation slot is more than the maximum age of the pool
+// older than the head state, in which case it is not passed to proposers anymore.
+func (s *Service) isExpired(beaconState *pb.BeaconState, slot uint64) bool {
+	return slot+s.attestationMaxAge*params.BeaconConfig().SlotsPerEpoch <= beaconState.Slot
+}
+
+// evictExpiredAttestations removes the attestations older than the maximum age of the pool
+// from the pool.
+func (s *Service) evictExpiredAttestations(beaconState *pb.BeaconState) error {
+	attestations, err := s.beaconDB.Attestations()
+	if err != nil {
+		return err
+	}
+	for _, att := range attestations {
+		slot, err := helpers.AttestationDataSlot(beaconState, att.Data)
+		if err != nil {
+			return fmt.Errorf("could not get attestation slot: %v", err)
+		}
+		if s.isExpired(beaconState, slot) {
+			if err := s.evictAttestation(att, evictedExpired); err != nil {
+				return err
+			}
+		}
+	}
+	s.updatePoolSize()
+	return nil
+}
+
+// enforceAttestationLimit evicts the oldest attestations of the pool once it holds more
+// than the maximum number of attestations. A batch of attestations is evicted at once,
+// leaving room for a tenth of the maximum, so that the pool is not sorted for every new
+// attestation. Attestations whose slot cannot be computed from the head state yet are
+// kept until it can.
+func (s *Service) enforceAttestationLimit(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "operations.enforceAttestationLimit")
+	defer span.End()
+
+	count := s.attIndex.count()
+	if s.maxAttestations == 0 || count <= s.maxAttestations {
+		s.updatePoolSize()
+		return nil
+	}
+
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return errors.New("could not evict attestations without a head state")
+	}
+	s.attIndex.assignSlots(headState)
+	target := s.maxAttestations - s.maxAttestations/10
+	for _, att := range s.attIndex.oldest(count - target) {
+		if err := s.evictAttestation(att, evictedCapacity); err != nil {
+			return err
+		}
+	}
+	s.updatePoolSize()
+	return nil
+}
+
+// evictAttestation deletes the attestation from the pool and counts its eviction.
+func (s *Service) evictAttestation(att *ethpb.Attestation, reason string) error {
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		return err
+	}
+	if err := s.beaconDB.DeleteAttestation(att); err != nil {
+		return err
+	}
+	s.attIndex.remove(hash)
+	attestationPoolEvictions.WithLabelValues(reason).Inc()
+	return nil
+}
+
+// updatePoolSize sets the attestation pool size gauge to the number of attestations in
+// the pool.
+func (s *Service) updatePoolSize() {
+	attestationPoolSize.Set(float64(s.attIndex.count()))
+}
+
+// indexAttestations indexes the attestations persisted in the pool.
+func (s *Service) indexAttestations() error {
+	attestations, err := s.beaconDB.Attestations()
+	if err != nil {
+		return fmt.Errorf("could not retrieve attestations from DB: %v", err)
+	}
+	for _, att := range attestations {
+		hash, err := hashutil.HashProto(att)
+		if err != nil {
+			return err
+		}
+		s.attIndex.add(hash, att)
+	}
+	s.updatePoolSize()
+	return nil
+}
+
+// attestationIndex keeps track of the attestations in the pool by slot in memory, so that
+// the size of the pool is known and its oldest attestations are found without reading
+// the database. The slot of an attestation depends on the committees of its target epoch,
+// so it is computed from the head state when the oldest attestations are needed.
+type attestationIndex struct {
+	lock      sync.Mutex
+	unslotted map[[32]byte]*ethpb.Attestation
+	slots     map[[32]byte]uint64
+	bySlot    map[uint64]map[[32]byte]*ethpb.Attestation
+}
+
+func newAttestationIndex() *attestationIndex {
+	return &attestationIndex{
+		unslotted: make(map[[32]byte]*ethpb.Attestation),
+		slots:     make(map[[32]byte]uint64),
+		bySlot:    make(map[uint64]map[[32]byte]*ethpb.Attestation),
+	}
+}
+
+// add indexes the attestation with the hash, unless it is already indexed.
+func (i *attestationIndex) add(hash [32]byte, att *ethpb.Attestation) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if _, ok := i.slots[hash]; ok {
+		return
+	}
+	i.unslotted[hash] = att
+}
+
+// remove drops the attestation with the hash from the index.
+func (i *attestationIndex) remove(hash [32]byte) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	delete(i.unslotted, hash)
+	slot, ok := i.slots[hash]
+	if !ok {
+		return
+	}
+	delete(i.slots, hash)
+	delete(i.bySlot[slot], hash)
+	if len(i.bySlot[slot]) == 0 {
+		delete(i.bySlot, slot)
+	}
+}
+
+// count returns the number of indexed attestations.
+func (i *attestationIndex) count() int {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return len(i.unslotted) + len(i.slots)
+}
+
+// assignSlots computes the slots of the attestations indexed since the last call from the
+// state. Attestations whose slot cannot be computed are skipped and retried on the next
+// call.
+func (i *attestationIndex) assignSlots(beaconState *pb.BeaconState) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	for hash, att := range i.unslotted {
+		slot, err := helpers.AttestationDataSlot(beaconState, att.Data)
+		if err != nil {
+			log.WithError(err).Debug("Could not get slot of pooled attestation")
+			continue
+		}
+		delete(i.unslotted, hash)
+		i.slots[hash] = slot
+		if i.bySlot[slot] == nil {
+			i.bySlot[slot] = make(map[[32]byte]*ethpb.Attestation)
+		}
+		i.bySlot[slot][hash] = att
+	}
+}
+
+// oldest returns up to n attestations with a slot, lowest slots first.
+func (i *attestationIndex) oldest(n int) []*ethpb.Attestation {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	slots := make([]uint64, 0, len(i.bySlot))
+	for slot := range i.bySlot {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(a, b int) bool {
+		return slots[a] < slots[b]
+	})
+	var atts []*ethpb.Attestation
+	for _, slot := range slots {
+		for _, att := range i.bySlot[slot] {
+			if len(atts) == n {
+				return atts
+			}
+			atts = append(atts, att)
+		}
+	}
+	return atts
+}
@@ -0,0 +1,168 @@
+package operations
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func poolTestAttestation(shard uint64, targetEpoch uint64) *ethpb.Attestation {
+	return &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{
+				Shard: shard,
+			},
+			Source: &ethpb.Checkpoint{},
+			Target: &ethpb.Checkpoint{Epoch: targetEpoch},
+		},
+	}
+}
+
+func TestHandleAttestations_EvictsOldestBeyondLimit(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB, MaxAttestations: 3})
+
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{
+		Slot: 64,
+		CurrentCrosslinks: []*ethpb.Crosslink{{
+			StartEpoch: 0,
+			DataRoot:   params.BeaconConfig().ZeroHash[:]}}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, shard := range []uint64{30, 10, 50, 20, 40} {
+		if err := service.HandleAttestations(ctx, poolTestAttestation(shard, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shards []uint64
+	for _, att := range attestations {
+		shards = append(shards, att.Data.Crosslink.Shard)
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	if len(shards) != 3 || shards[0] != 30 || shards[1] != 40 || shards[2] != 50 {
+		t.Errorf("Expected the 3 most recent attestations to remain in the pool, received shards %v", shards)
+	}
+}
+
+func TestHandleAttestations_EvictsBatchBeyondLimit(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB, MaxAttestations: 10})
+
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{
+		Slot: 64,
+		CurrentCrosslinks: []*ethpb.Crosslink{{
+			StartEpoch: 0,
+			DataRoot:   params.BeaconConfig().ZeroHash[:]}}}); err != nil {
+		t.Fatal(err)
+	}
+	for shard := uint64(0); shard <= 10; shard++ {
+		if err := service.HandleAttestations(ctx, poolTestAttestation(shard, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Going beyond 10 attestations evicts the oldest down to 9, leaving room for 1 more.
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attestations) != 9 {
+		t.Fatalf("Expected 9 attestations to remain in the pool, received %d", len(attestations))
+	}
+	for _, att := range attestations {
+		if att.Data.Crosslink.Shard < 2 {
+			t.Errorf("Expected attestation of shard %d to be evicted", att.Data.Crosslink.Shard)
+		}
+	}
+	if service.attIndex.count() != 9 {
+		t.Errorf("Expected 9 indexed attestations, received %d", service.attIndex.count())
+	}
+}
+
+func TestHandleAttestations_SkipsAttestationsWithoutSlot(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB, MaxAttestations: 2})
+
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{
+		Slot: 64,
+		CurrentCrosslinks: []*ethpb.Crosslink{{
+			StartEpoch: 0,
+			DataRoot:   params.BeaconConfig().ZeroHash[:]}}}); err != nil {
+		t.Fatal(err)
+	}
+	// The slot of an attestation targeting an epoch this far ahead of the head state
+	// cannot be computed.
+	future := poolTestAttestation(5, 100)
+	for _, att := range []*ethpb.Attestation{future, poolTestAttestation(30, 0), poolTestAttestation(10, 0)} {
+		if err := service.HandleAttestations(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shards []uint64
+	for _, att := range attestations {
+		shards = append(shards, att.Data.Crosslink.Shard)
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	if len(shards) != 2 || shards[0] != 5 || shards[1] != 30 {
+		t.Errorf("Expected the future attestation and the most recent one to remain in the pool, received shards %v", shards)
+	}
+}
+
+func TestEvictExpiredAttestations_RespectsMaxAge(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB, AttestationMaxAge: 2})
+
+	expired := poolTestAttestation(1, 0)
+	kept := poolTestAttestation(1, 2)
+	for _, att := range []*ethpb.Attestation{expired, kept} {
+		if err := beaconDB.SaveAttestation(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// At slot 200 attestations before slot 72 are more than 2 epochs old.
+	beaconState := &pb.BeaconState{
+		Slot: 200,
+		CurrentCrosslinks: []*ethpb.Crosslink{{
+			StartEpoch: 2,
+			DataRoot:   params.BeaconConfig().ZeroHash[:]}}}
+	if err := service.evictExpiredAttestations(beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attestations) != 1 || attestations[0].Data.Target.Epoch != 2 {
+		t.Errorf("Expected only the attestation of epoch 2 to remain in the pool, received %v", attestations)
+	}
+}
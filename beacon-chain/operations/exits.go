@@ -0,0 +1,67 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// PendingExits returns the voluntary exits of the pool which can be included together in
+// a block on top of the head state, up to MaxVoluntaryExits. Exits which can no longer be
+// included, such as exits of validators which already exited, are deleted from the pool,
+// while exits which only become valid at a later epoch are kept.
+func (s *Service) PendingExits(ctx context.Context) ([]*ethpb.VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingExits")
+	defer span.End()
+
+	exits, err := s.beaconDB.Exits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	if len(exits) == 0 {
+		return nil, nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	beaconState := proto.Clone(headState).(*pb.BeaconState)
+	var pending []*ethpb.VoluntaryExit
+	for _, exit := range exits {
+		if uint64(len(pending)) == params.BeaconConfig().MaxVoluntaryExits {
+			break
+		}
+		if exit.Epoch > helpers.CurrentEpoch(beaconState) {
+			continue
+		}
+		body := &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.VoluntaryExit{exit}}
+		postState, err := blocks.ProcessVoluntaryExits(beaconState, body, true /* verify signatures */)
+		if err != nil {
+			log.WithError(err).WithField("validatorIndex", exit.ValidatorIndex).Debug("Removing exit which is no longer valid")
+			if err := s.beaconDB.DeleteExit(exit); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		beaconState = postState
+		pending = append(pending, exit)
+	}
+	return pending, nil
+}
+
+// removeIncludedExits removes the exits included in a processed block from the pool.
+func (s *Service) removeIncludedExits(body *ethpb.BeaconBlockBody) error {
+	for _, exit := range body.VoluntaryExits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
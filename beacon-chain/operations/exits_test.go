@@ -0,0 +1,79 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func signedExit(t *testing.T, beaconState *pb.BeaconState, privKey *bls.SecretKey, validatorIndex uint64, epoch uint64) *ethpb.VoluntaryExit {
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}
+	signingRoot, err := ssz.SigningRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, epoch, params.BeaconConfig().DomainVoluntaryExit)
+	exit.Signature = privKey.Sign(signingRoot[:], domain).Marshal()
+	return exit
+}
+
+func TestPendingExits_PrunesInvalidExits(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	keys := randKeys(t, 4)
+	beaconState := slashingTestState(keys)
+	// Validators can only exit once they were active long enough.
+	epoch := params.BeaconConfig().PersistentCommitteePeriod
+	beaconState.Slot = epoch * params.BeaconConfig().SlotsPerEpoch
+	beaconState.Validators[3].ExitEpoch = epoch
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := signedExit(t, beaconState, keys[1], 1, epoch)
+	future := signedExit(t, beaconState, keys[2], 2, epoch+1)
+	exited := signedExit(t, beaconState, keys[3], 3, epoch)
+	for _, exit := range []*ethpb.VoluntaryExit{valid, future, exited} {
+		if err := service.HandleValidatorExits(ctx, exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := service.PendingExits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].ValidatorIndex != 1 {
+		t.Errorf("Expected only the exit of validator 1 to be pending, received %v", pending)
+	}
+	exits, err := beaconDB.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The exit of the exited validator is removed, the exit valid at a later epoch is kept.
+	if len(exits) != 2 {
+		t.Errorf("Expected 2 exits in the pool, received %d", len(exits))
+	}
+
+	if err := service.removeIncludedExits(&ethpb.BeaconBlockBody{VoluntaryExits: pending}); err != nil {
+		t.Fatal(err)
+	}
+	exits, err = beaconDB.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exits) != 1 || exits[0].ValidatorIndex != 2 {
+		t.Errorf("Expected only the exit of validator 2 to be left, received %v", exits)
+	}
+}
@@ -0,0 +1,167 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// recoverPool re-validates the operations persisted in the pool by a previous run of the
+// node against the head state, deleting the ones which can no longer be included in a block,
+// so that a node restarted just before its proposal still has operations to propose.
+func (s *Service) recoverPool(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "operations.recoverPool")
+	defer span.End()
+
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	// Nothing can have been pooled before the chain started.
+	if headState == nil {
+		return nil
+	}
+
+	attestations, err := s.beaconDB.Attestations()
+	if err != nil {
+		return fmt.Errorf("could not retrieve attestations from DB: %v", err)
+	}
+	var keptAtts, droppedAtts int
+	for _, att := range attestations {
+		if err := s.validatePooledAttestation(headState, att); err != nil {
+			log.WithError(err).Debug("Dropping pooled attestation")
+			if err := s.evictAttestation(att, evictedExpired); err != nil {
+				return err
+			}
+			droppedAtts++
+			continue
+		}
+		keptAtts++
+	}
+
+	exits, err := s.beaconDB.Exits(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	var keptExits, droppedExits int
+	for _, exit := range exits {
+		body := &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.VoluntaryExit{exit}}
+		if _, err := blocks.ProcessVoluntaryExits(proto.Clone(headState).(*pb.BeaconState), body, true /* verify signatures */); err != nil {
+			log.WithError(err).WithField("validatorIndex", exit.ValidatorIndex).Debug("Dropping pooled exit")
+			if err := s.beaconDB.DeleteExit(exit); err != nil {
+				return err
+			}
+			droppedExits++
+			continue
+		}
+		keptExits++
+	}
+
+	proposerSlashings, err := s.beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	var keptSlashings, droppedSlashings int
+	for _, slashing := range proposerSlashings {
+		body := &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}}
+		if _, err := blocks.ProcessProposerSlashings(proto.Clone(headState).(*pb.BeaconState), body); err != nil {
+			log.WithError(err).WithField("proposerIndex", slashing.ProposerIndex).Debug("Dropping pooled proposer slashing")
+			if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+				return err
+			}
+			droppedSlashings++
+			continue
+		}
+		keptSlashings++
+	}
+
+	attesterSlashings, err := s.beaconDB.AttesterSlashings(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	for _, slashing := range attesterSlashings {
+		body := &ethpb.BeaconBlockBody{AttesterSlashings: []*ethpb.AttesterSlashing{slashing}}
+		if _, err := blocks.ProcessAttesterSlashings(proto.Clone(headState).(*pb.BeaconState), body, true /* verify signatures */); err != nil {
+			log.WithError(err).Debug("Dropping pooled attester slashing")
+			if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+				return err
+			}
+			droppedSlashings++
+			continue
+		}
+		keptSlashings++
+	}
+
+	s.updatePoolSize()
+	log.WithFields(logrus.Fields{
+		"headSlot":         headState.Slot,
+		"attestations":     keptAtts,
+		"exits":            keptExits,
+		"slashings":        keptSlashings,
+		"droppedAtts":      droppedAtts,
+		"droppedExits":     droppedExits,
+		"droppedSlashings": droppedSlashings,
+	}).Info("Recovered operations pool")
+	return nil
+}
+
+// validatePooledAttestation returns an error if the attestation can no longer be included
+// in a block on top of the head state, either because it is older than the maximum age of
+// the pool, it does not vote on the justified checkpoint of its target epoch or all of its
+// votes are already included in the head state.
+func (s *Service) validatePooledAttestation(headState *pb.BeaconState, att *ethpb.Attestation) error {
+	slot, err := helpers.AttestationDataSlot(headState, att.Data)
+	if err != nil {
+		return fmt.Errorf("could not get attestation slot: %v", err)
+	}
+	if s.isExpired(headState, slot) {
+		return fmt.Errorf("attestation slot %d is expired at head slot %d", slot, headState.Slot)
+	}
+
+	var source *ethpb.Checkpoint
+	var included []*pb.PendingAttestation
+	switch att.Data.Target.Epoch {
+	case helpers.CurrentEpoch(headState):
+		source = headState.CurrentJustifiedCheckpoint
+		included = headState.CurrentEpochAttestations
+	case helpers.PrevEpoch(headState):
+		source = headState.PreviousJustifiedCheckpoint
+		included = headState.PreviousEpochAttestations
+	default:
+		// Attestations for a future epoch are kept until the head state reaches it.
+		if att.Data.Target.Epoch > helpers.CurrentEpoch(headState) {
+			return nil
+		}
+		return fmt.Errorf("attestation target epoch %d is neither the current nor the previous epoch", att.Data.Target.Epoch)
+	}
+	if !proto.Equal(att.Data.Source, source) {
+		return fmt.Errorf("attestation source epoch %d is not the justified epoch %d", att.Data.Source.Epoch, source.GetEpoch())
+	}
+	for _, pending := range included {
+		if proto.Equal(pending.Data, att.Data) && coversBits(pending, att) {
+			return fmt.Errorf("attestation is already included in the head state")
+		}
+	}
+	return nil
+}
+
+// coversBits returns true if every vote of the attestation is among the votes of the
+// included attestation.
+func coversBits(included *pb.PendingAttestation, att *ethpb.Attestation) bool {
+	if included.AggregationBits.Len() != att.AggregationBits.Len() {
+		return false
+	}
+	for i := uint64(0); i < att.AggregationBits.Len(); i++ {
+		if att.AggregationBits.BitAt(i) && !included.AggregationBits.BitAt(i) {
+			return false
+		}
+	}
+	return true
+}
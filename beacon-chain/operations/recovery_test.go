@@ -0,0 +1,140 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestRecoverPool_DropsStaleOperations(t *testing.T) {
+	helpers.ClearAllCaches()
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	cfg := params.BeaconConfig()
+	cfg.PersistentCommitteePeriod = 0
+	params.OverrideBeaconConfig(cfg)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	keys := randKeys(t, 4)
+	beaconState := slashingTestState(keys)
+	beaconState.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+	beaconState.PreviousJustifiedCheckpoint = &ethpb.Checkpoint{Epoch: 1}
+	beaconState.CurrentJustifiedCheckpoint = &ethpb.Checkpoint{Epoch: 2}
+	beaconState.CurrentCrosslinks = make([]*ethpb.Crosslink, params.BeaconConfig().ShardCount)
+	for i := range beaconState.CurrentCrosslinks {
+		beaconState.CurrentCrosslinks[i] = &ethpb.Crosslink{}
+	}
+
+	newAtt := func(shard uint64, sourceEpoch uint64, targetEpoch uint64) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0x03},
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{Shard: shard},
+				Source:    &ethpb.Checkpoint{Epoch: sourceEpoch},
+				Target:    &ethpb.Checkpoint{Epoch: targetEpoch},
+			},
+		}
+	}
+	validAtt := newAtt(1, 2, 3)
+	staleAtt := newAtt(2, 1, 1)
+	wrongSourceAtt := newAtt(3, 1, 3)
+	includedAtt := newAtt(4, 2, 3)
+	beaconState.CurrentEpochAttestations = []*pb.PendingAttestation{
+		{AggregationBits: bitfield.Bitlist{0x03}, Data: includedAtt.Data},
+	}
+	for _, att := range []*ethpb.Attestation{validAtt, staleAtt, wrongSourceAtt, includedAtt} {
+		if err := beaconDB.SaveAttestation(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	validExit := &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 0}
+	root, err := ssz.SigningRoot(validExit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, validExit.Epoch, params.BeaconConfig().DomainVoluntaryExit)
+	validExit.Signature = keys[0].Sign(root[:], domain).Marshal()
+	// The validator index is not in the registry.
+	invalidExit := &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 10}
+	for _, exit := range []*ethpb.VoluntaryExit{validExit, invalidExit} {
+		if err := beaconDB.SaveExit(ctx, exit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	validSlashing := signedProposerSlashing(t, beaconState, keys[1], 1, "A", "B")
+	slashedSlashing := signedProposerSlashing(t, beaconState, keys[2], 2, "A", "B")
+	for _, slashing := range []*ethpb.ProposerSlashing{validSlashing, slashedSlashing} {
+		if err := beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Validator 2 was slashed after its slashing was pooled.
+	beaconState.Validators[2].Slashed = true
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.recoverPool(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attestations) != 1 || !proto.Equal(attestations[0], validAtt) {
+		t.Errorf("Expected only the valid attestation to be recovered, received %v", attestations)
+	}
+	exits, err := beaconDB.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exits) != 1 || !proto.Equal(exits[0], validExit) {
+		t.Errorf("Expected only the valid exit to be recovered, received %v", exits)
+	}
+	slashings, err := beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 || !proto.Equal(slashings[0], validSlashing) {
+		t.Errorf("Expected only the valid proposer slashing to be recovered, received %v", slashings)
+	}
+	testutil.AssertLogsContain(t, hook, "Recovered operations pool")
+}
+
+func TestRecoverPool_NoHeadState(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	if err := beaconDB.SaveExit(ctx, &ethpb.VoluntaryExit{Epoch: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.recoverPool(ctx); err != nil {
+		t.Fatal(err)
+	}
+	exits, err := beaconDB.Exits(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exits) != 1 {
+		t.Errorf("Expected the pool to be left untouched before chain start, received %d exits", len(exits))
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
-	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -19,6 +18,7 @@ import (
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -28,56 +28,92 @@ var log = logrus.WithField("prefix", "operation")
 // OperationFeeds inteface defines the informational feeds from the operations
 // service.
 type OperationFeeds interface {
-	IncomingAttFeed() *event.Feed
-	IncomingExitFeed() *event.Feed
-	IncomingProcessedBlockFeed() *event.Feed
+	IncomingAttFeed() *event.Topic
+	IncomingExitFeed() *event.Topic
+	IncomingProcessedBlockFeed() *event.Topic
 }
 
 // Service represents a service that handles the internal
 // logic of beacon block operations.
 type Service struct {
-	ctx                        context.Context
-	cancel                     context.CancelFunc
-	beaconDB                   *db.BeaconDB
-	incomingExitFeed           *event.Feed
-	incomingValidatorExits     chan *ethpb.VoluntaryExit
-	incomingAttFeed            *event.Feed
-	incomingAtt                chan *ethpb.Attestation
-	incomingProcessedBlockFeed *event.Feed
-	incomingProcessedBlock     chan *ethpb.BeaconBlock
-	p2p                        p2p.Broadcaster
-	error                      error
+	ctx                          context.Context
+	cancel                       context.CancelFunc
+	beaconDB                     *db.BeaconDB
+	incomingExitFeed             *event.Topic
+	incomingValidatorExits       chan *ethpb.VoluntaryExit
+	incomingAttFeed              *event.Topic
+	incomingAtt                  chan *ethpb.Attestation
+	incomingProcessedBlockFeed   *event.Topic
+	incomingProcessedBlock       chan *ethpb.BeaconBlock
+	acceptedAttFeed              *event.Topic
+	incomingProposerSlashingFeed *event.Topic
+	incomingProposerSlashing     chan *ethpb.ProposerSlashing
+	incomingAttesterSlashingFeed *event.Topic
+	incomingAttesterSlashing     chan *ethpb.AttesterSlashing
+	p2p                          p2p.Broadcaster
+	maxAttestations              int
+	attestationMaxAge            uint64
+	attIndex                     *attestationIndex
+	error                        error
+	supervisor                   *supervisor.Supervisor
 }
 
 // Config options for the service.
 type Config struct {
 	BeaconDB *db.BeaconDB
 	P2P      p2p.Broadcaster
+	// MaxAttestations is the number of attestations kept in the pool, beyond which the
+	// oldest attestations are evicted. The pool is not limited if it is 0.
+	MaxAttestations int
+	// AttestationMaxAge is the number of epochs an attestation is kept in the pool, one
+	// epoch if it is 0.
+	AttestationMaxAge uint64
+	// RestartPolicy defines how the goroutines of the service are restarted after a panic.
+	RestartPolicy supervisor.Policy
 }
 
 // NewOpsPoolService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	attestationMaxAge := cfg.AttestationMaxAge
+	if attestationMaxAge == 0 {
+		attestationMaxAge = 1
+	}
 	return &Service{
-		ctx:                        ctx,
-		cancel:                     cancel,
-		beaconDB:                   cfg.BeaconDB,
-		incomingExitFeed:           new(event.Feed),
-		incomingValidatorExits:     make(chan *ethpb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
-		incomingAttFeed:            new(event.Feed),
-		incomingAtt:                make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
-		incomingProcessedBlockFeed: new(event.Feed),
-		incomingProcessedBlock:     make(chan *ethpb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
-		p2p:                        cfg.P2P,
+		ctx:                          ctx,
+		cancel:                       cancel,
+		beaconDB:                     cfg.BeaconDB,
+		incomingExitFeed:             event.NewTopic("incoming_exits"),
+		incomingValidatorExits:       make(chan *ethpb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
+		incomingAttFeed:              event.NewTopic("incoming_attestations"),
+		incomingAtt:                  make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		incomingProcessedBlockFeed:   event.NewTopic("processed_blocks"),
+		incomingProcessedBlock:       make(chan *ethpb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
+		acceptedAttFeed:              event.NewTopic("accepted_attestations"),
+		incomingProposerSlashingFeed: event.NewTopic("incoming_proposer_slashings"),
+		incomingProposerSlashing:     make(chan *ethpb.ProposerSlashing, params.BeaconConfig().DefaultBufferSize),
+		incomingAttesterSlashingFeed: event.NewTopic("incoming_attester_slashings"),
+		incomingAttesterSlashing:     make(chan *ethpb.AttesterSlashing, params.BeaconConfig().DefaultBufferSize),
+		p2p:                          cfg.P2P,
+		maxAttestations:              cfg.MaxAttestations,
+		attestationMaxAge:            attestationMaxAge,
+		attIndex:                     newAttestationIndex(),
+		supervisor:                   supervisor.New(ctx, "operations", cfg.RestartPolicy),
 	}
 }
 
 // Start an beacon block operation pool service's main event loop.
 func (s *Service) Start() {
 	log.Info("Starting service")
-	go s.saveOperations()
-	go s.removeOperations()
+	if err := s.indexAttestations(); err != nil {
+		log.WithError(err).Error("Could not index attestation pool")
+	}
+	if err := s.recoverPool(s.ctx); err != nil {
+		log.WithError(err).Error("Could not recover operations pool")
+	}
+	s.supervisor.Go("saveOperations", s.saveOperations)
+	s.supervisor.Go("removeOperations", s.removeOperations)
 }
 
 // Stop the beacon block operation pool service's main event loop
@@ -93,27 +129,33 @@ func (s *Service) Status() error {
 	if s.error != nil {
 		return s.error
 	}
-	return nil
+	return s.supervisor.Status()
 }
 
 // IncomingExitFeed returns a feed that any service can send incoming p2p exits object into.
 // The beacon block operation pool service will subscribe to this feed in order to relay incoming exits.
-func (s *Service) IncomingExitFeed() *event.Feed {
+func (s *Service) IncomingExitFeed() *event.Topic {
 	return s.incomingExitFeed
 }
 
 // IncomingAttFeed returns a feed that any service can send incoming p2p attestations into.
 // The beacon block operation pool service will subscribe to this feed in order to relay incoming attestations.
-func (s *Service) IncomingAttFeed() *event.Feed {
+func (s *Service) IncomingAttFeed() *event.Topic {
 	return s.incomingAttFeed
 }
 
 // IncomingProcessedBlockFeed returns a feed that any service can send incoming p2p beacon blocks into.
 // The beacon block operation pool service will subscribe to this feed in order to receive incoming beacon blocks.
-func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
+func (s *Service) IncomingProcessedBlockFeed() *event.Topic {
 	return s.incomingProcessedBlockFeed
 }
 
+// AcceptedAttFeed returns a feed of every attestation accepted by the operation pool service,
+// whether it was received from the p2p network or included in a processed block.
+func (s *Service) AcceptedAttFeed() *event.Topic {
+	return s.acceptedAttFeed
+}
+
 // PendingAttestations returns the attestations that have not seen on the beacon chain, the attestations are
 // returns in slot ascending order and up to MaxAttestations capacity. The attestations get
 // deleted in DB after they have been retrieved.
@@ -138,10 +180,10 @@ func (s *Service) PendingAttestations(ctx context.Context) ([]*ethpb.Attestation
 		if err != nil {
 			return nil, fmt.Errorf("could not get attestation slot: %v", err)
 		}
-		// Delete the attestation if the attestation is one epoch older than head state,
+		// Delete the attestation if the attestation is older than the maximum age of the pool,
 		// we don't want to pass these attestations to RPC for proposer to include.
-		if slot+params.BeaconConfig().SlotsPerEpoch <= state.Slot {
-			if err := s.beaconDB.DeleteAttestation(att); err != nil {
+		if s.isExpired(state, slot) {
+			if err := s.evictAttestation(att, evictedExpired); err != nil {
 				return nil, err
 			}
 			continue
@@ -155,6 +197,7 @@ func (s *Service) PendingAttestations(ctx context.Context) ([]*ethpb.Attestation
 
 		attestations = append(attestations, att)
 	}
+	s.updatePoolSize()
 	return attestations, nil
 }
 
@@ -162,10 +205,17 @@ func (s *Service) PendingAttestations(ctx context.Context) ([]*ethpb.Attestation
 // that was received from sync service.
 func (s *Service) saveOperations() {
 	// TODO(1438): Add rest of operations (slashings, attestation, exists...etc)
-	incomingSub := s.incomingExitFeed.Subscribe(s.incomingValidatorExits)
+	// Gossiped operations are dropped rather than holding back sync while the pool is
+	// busy, as they are gossiped again or included in blocks by other nodes.
+	bufferSize := params.BeaconConfig().DefaultBufferSize
+	incomingSub := s.incomingExitFeed.SubscribeBuffered(s.incomingValidatorExits, bufferSize, event.DropNewest)
 	defer incomingSub.Unsubscribe()
-	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
+	incomingAttSub := s.incomingAttFeed.SubscribeBuffered(s.incomingAtt, bufferSize, event.DropNewest)
 	defer incomingAttSub.Unsubscribe()
+	proposerSlashingSub := s.incomingProposerSlashingFeed.SubscribeBuffered(s.incomingProposerSlashing, bufferSize, event.DropNewest)
+	defer proposerSlashingSub.Unsubscribe()
+	attesterSlashingSub := s.incomingAttesterSlashingFeed.SubscribeBuffered(s.incomingAttesterSlashing, bufferSize, event.DropNewest)
+	defer attesterSlashingSub.Unsubscribe()
 
 	for {
 		select {
@@ -180,6 +230,10 @@ func (s *Service) saveOperations() {
 			handler.SafelyHandleMessage(s.ctx, s.HandleValidatorExits, exit)
 		case attestation := <-s.incomingAtt:
 			handler.SafelyHandleMessage(s.ctx, s.HandleAttestations, attestation)
+		case slashing := <-s.incomingProposerSlashing:
+			handler.SafelyHandleMessage(s.ctx, s.HandleProposerSlashing, slashing)
+		case slashing := <-s.incomingAttesterSlashing:
+			handler.SafelyHandleMessage(s.ctx, s.HandleAttesterSlashing, slashing)
 		}
 	}
 }
@@ -217,6 +271,11 @@ func (s *Service) HandleAttestations(ctx context.Context, message proto.Message)
 	if err := s.beaconDB.SaveAttestation(ctx, attestation); err != nil {
 		return err
 	}
+	s.attIndex.add(hash, attestation)
+	if err := s.enforceAttestationLimit(ctx); err != nil {
+		return fmt.Errorf("could not enforce attestation pool limit: %v", err)
+	}
+	s.acceptedAttFeed.Send(attestation)
 	return nil
 }
 
@@ -271,8 +330,8 @@ func (s *Service) removeOperations() {
 				log.Errorf("could not retrieve attestations from DB")
 				continue
 			}
-			if err := s.removeEpochOldAttestations(state); err != nil {
-				log.Errorf("Could not remove old attestations from DB at slot %d: %v", block.Slot, err)
+			if err := s.evictExpiredAttestations(state); err != nil {
+				log.Errorf("Could not remove expired attestations from DB at slot %d: %v", block.Slot, err)
 				continue
 			}
 		}
@@ -285,6 +344,15 @@ func (s *Service) handleProcessedBlock(_ context.Context, message proto.Message)
 	if err := s.removePendingAttestations(block.Body.Attestations); err != nil {
 		return fmt.Errorf("could not remove processed attestations from DB: %v", err)
 	}
+	if err := s.removeIncludedSlashings(block.Body); err != nil {
+		return fmt.Errorf("could not remove processed slashings from DB: %v", err)
+	}
+	if err := s.removeIncludedExits(block.Body); err != nil {
+		return fmt.Errorf("could not remove processed exits from DB: %v", err)
+	}
+	for _, attestation := range block.Body.Attestations {
+		s.acceptedAttFeed.Send(attestation)
+	}
 	return nil
 }
 
@@ -296,7 +364,7 @@ func (s *Service) removePendingAttestations(attestations []*ethpb.Attestation) e
 			return err
 		}
 		if s.beaconDB.HasAttestation(hash) {
-			if err := s.beaconDB.DeleteAttestation(attestation); err != nil {
+			if err := s.evictAttestation(attestation, evictedIncluded); err != nil {
 				return err
 			}
 			log.WithField("root", fmt.Sprintf("%#x", hash)).Debug("Attestation removed")
@@ -304,24 +372,3 @@ func (s *Service) removePendingAttestations(attestations []*ethpb.Attestation) e
 	}
 	return nil
 }
-
-// removeEpochOldAttestations removes attestations that's older than one epoch length from current slot.
-func (s *Service) removeEpochOldAttestations(beaconState *pb.BeaconState) error {
-	attestations, err := s.beaconDB.Attestations()
-	if err != nil {
-		return err
-	}
-	for _, a := range attestations {
-		slot, err := helpers.AttestationDataSlot(beaconState, a.Data)
-		if err != nil {
-			return fmt.Errorf("could not get attestation slot: %v", err)
-		}
-		// Remove attestation from DB if it's one epoch older than slot.
-		if slot-params.BeaconConfig().SlotsPerEpoch >= slot {
-			if err := s.beaconDB.DeleteAttestation(a); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
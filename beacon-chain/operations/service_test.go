@@ -98,6 +98,48 @@ func TestIncomingAttestation_OK(t *testing.T) {
 	}
 }
 
+func TestIncomingAttestation_SentToAcceptedFeed(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	accepted := make(chan *ethpb.Attestation, 2)
+	sub := service.AcceptedAttFeed().Subscribe(accepted)
+	defer sub.Unsubscribe()
+
+	attestation := &ethpb.Attestation{
+		AggregationBits: []byte{'B'},
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{
+				Shard: 100,
+			}}}
+	if err := service.HandleAttestations(context.Background(), attestation); err != nil {
+		t.Fatal(err)
+	}
+	// Attestations already in the pool are not sent again.
+	if err := service.HandleAttestations(context.Background(), attestation); err != nil {
+		t.Fatal(err)
+	}
+	if len(accepted) != 1 {
+		t.Fatalf("Expected 1 accepted attestation, received %d", len(accepted))
+	}
+	if received := <-accepted; !proto.Equal(received, attestation) {
+		t.Errorf("Expected accepted attestation %v, received %v", attestation, received)
+	}
+
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			Attestations: []*ethpb.Attestation{attestation},
+		},
+	}
+	if err := service.handleProcessedBlock(context.Background(), block); err != nil {
+		t.Fatal(err)
+	}
+	if len(accepted) != 1 {
+		t.Fatal("Expected attestation included in a processed block to be accepted")
+	}
+}
+
 func TestRetrieveAttestations_OK(t *testing.T) {
 	helpers.ClearAllCaches()
 
@@ -0,0 +1,184 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// IncomingProposerSlashingFeed returns a feed that any service can send detected or received
+// proposer slashings into, to be validated and kept until they are included in a block.
+func (s *Service) IncomingProposerSlashingFeed() *event.Topic {
+	return s.incomingProposerSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send detected or received
+// attester slashings into, to be validated and kept until they are included in a block.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Topic {
+	return s.incomingAttesterSlashingFeed
+}
+
+// HandleProposerSlashing saves a proposer slashing in the pool if it is valid against the
+// head state.
+func (s *Service) HandleProposerSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleProposerSlashing")
+	defer span.End()
+
+	slashing := message.(*ethpb.ProposerSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasProposerSlashing(hash) {
+		return nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	body := &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}}
+	if _, err := blocks.ProcessProposerSlashings(proto.Clone(headState).(*pb.BeaconState), body); err != nil {
+		return fmt.Errorf("invalid proposer slashing: %v", err)
+	}
+	if err := s.beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"hash":          fmt.Sprintf("%#x", hash),
+		"proposerIndex": slashing.ProposerIndex,
+	}).Info("Proposer slashing saved in DB")
+	return nil
+}
+
+// HandleAttesterSlashing saves an attester slashing in the pool if it is valid against the
+// head state.
+func (s *Service) HandleAttesterSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleAttesterSlashing")
+	defer span.End()
+
+	slashing := message.(*ethpb.AttesterSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasAttesterSlashing(hash) {
+		return nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	body := &ethpb.BeaconBlockBody{AttesterSlashings: []*ethpb.AttesterSlashing{slashing}}
+	if _, err := blocks.ProcessAttesterSlashings(proto.Clone(headState).(*pb.BeaconState), body, true /* verify signatures */); err != nil {
+		return fmt.Errorf("invalid attester slashing: %v", err)
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithField("hash", fmt.Sprintf("%#x", hash)).Info("Attester slashing saved in DB")
+	return nil
+}
+
+// PendingProposerSlashings returns the proposer slashings of the pool which can be included
+// together in a block on top of the head state, up to MaxProposerSlashings. Slashings of
+// proposers which are no longer slashable are deleted from the pool.
+func (s *Service) PendingProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingProposerSlashings")
+	defer span.End()
+
+	slashings, err := s.beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	if len(slashings) == 0 {
+		return nil, nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	// Slashings are applied in turn to a copy of the head state, so that the slashings
+	// returned are valid together.
+	beaconState := proto.Clone(headState).(*pb.BeaconState)
+	var pending []*ethpb.ProposerSlashing
+	for _, slashing := range slashings {
+		if uint64(len(pending)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		body := &ethpb.BeaconBlockBody{ProposerSlashings: []*ethpb.ProposerSlashing{slashing}}
+		postState, err := blocks.ProcessProposerSlashings(beaconState, body)
+		if err != nil {
+			log.WithError(err).WithField("proposerIndex", slashing.ProposerIndex).Debug("Removing proposer slashing which is no longer valid")
+			if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		beaconState = postState
+		pending = append(pending, slashing)
+	}
+	return pending, nil
+}
+
+// PendingAttesterSlashings returns the attester slashings of the pool which can be included
+// together in a block on top of the head state, up to MaxAttesterSlashings. Slashings of
+// attesters which are no longer slashable are deleted from the pool.
+func (s *Service) PendingAttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "operations.PendingAttesterSlashings")
+	defer span.End()
+
+	slashings, err := s.beaconDB.AttesterSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	if len(slashings) == 0 {
+		return nil, nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	beaconState := proto.Clone(headState).(*pb.BeaconState)
+	var pending []*ethpb.AttesterSlashing
+	for _, slashing := range slashings {
+		if uint64(len(pending)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		body := &ethpb.BeaconBlockBody{AttesterSlashings: []*ethpb.AttesterSlashing{slashing}}
+		postState, err := blocks.ProcessAttesterSlashings(beaconState, body, true /* verify signatures */)
+		if err != nil {
+			log.WithError(err).Debug("Removing attester slashing which is no longer valid")
+			if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		beaconState = postState
+		pending = append(pending, slashing)
+	}
+	return pending, nil
+}
+
+// removeIncludedSlashings removes the slashings included in a processed block from the pool.
+func (s *Service) removeIncludedSlashings(body *ethpb.BeaconBlockBody) error {
+	for _, slashing := range body.ProposerSlashings {
+		if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+			return err
+		}
+	}
+	for _, slashing := range body.AttesterSlashings {
+		if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
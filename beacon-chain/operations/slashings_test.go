@@ -0,0 +1,223 @@
+package operations
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// slashingTestState returns a state with validators whose public keys are the given
+// private keys.
+func slashingTestState(privKeys []*bls.SecretKey) *pb.BeaconState {
+	validators := make([]*ethpb.Validator, len(privKeys))
+	balances := make([]uint64, len(privKeys))
+	for i, key := range privKeys {
+		validators[i] = &ethpb.Validator{
+			PublicKey:         key.PublicKey().Marshal(),
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	return &pb.BeaconState{
+		Validators: validators,
+		Balances:   balances,
+		Fork: &pb.Fork{
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		},
+		Slashings:        make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+}
+
+func signedProposerSlashing(t *testing.T, beaconState *pb.BeaconState, privKey *bls.SecretKey, proposerIndex uint64, stateRoots ...string) *ethpb.ProposerSlashing {
+	domain := helpers.Domain(beaconState, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainBeaconProposer)
+	headers := make([]*ethpb.BeaconBlockHeader, len(stateRoots))
+	for i, root := range stateRoots {
+		headers[i] = &ethpb.BeaconBlockHeader{StateRoot: []byte(root)}
+		signingRoot, err := ssz.SigningRoot(headers[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers[i].Signature = privKey.Sign(signingRoot[:], domain).Marshal()
+	}
+	return &ethpb.ProposerSlashing{
+		ProposerIndex: proposerIndex,
+		Header_1:      headers[0],
+		Header_2:      headers[1],
+	}
+}
+
+func randKeys(t *testing.T, n int) []*bls.SecretKey {
+	keys := make([]*bls.SecretKey, n)
+	for i := range keys {
+		key, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+	}
+	return keys
+}
+
+func TestHandleProposerSlashing_SavesValidSlashing(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	keys := randKeys(t, 4)
+	beaconState := slashingTestState(keys)
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := signedProposerSlashing(t, beaconState, keys[1], 1, "A", "B")
+	if err := service.HandleProposerSlashing(ctx, valid); err != nil {
+		t.Fatalf("Could not handle valid slashing: %v", err)
+	}
+	// Signed by a key other than the one of the proposer.
+	invalid := signedProposerSlashing(t, beaconState, keys[1], 2, "A", "B")
+	if err := service.HandleProposerSlashing(ctx, invalid); err == nil {
+		t.Error("Expected invalid slashing to be rejected")
+	}
+
+	slashings, err := beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 || !proto.Equal(slashings[0], valid) {
+		t.Errorf("Expected only the valid slashing to be saved, received %v", slashings)
+	}
+	if beaconState.Validators[1].Slashed {
+		t.Error("Expected the head state not to be modified by the validation")
+	}
+}
+
+func TestPendingProposerSlashings_PrunesUnslashable(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	keys := randKeys(t, 4)
+	beaconState := slashingTestState(keys)
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	for i := uint64(1); i <= 3; i++ {
+		if err := service.HandleProposerSlashing(ctx, signedProposerSlashing(t, beaconState, keys[i], i, "A", "B")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Validator 2 was slashed since the slashing was received.
+	slashedState := proto.Clone(beaconState).(*pb.BeaconState)
+	slashedState.Validators[2].Slashed = true
+	if err := beaconDB.SaveState(ctx, slashedState); err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := service.PendingProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("Expected 2 pending slashings, received %d", len(pending))
+	}
+	for _, slashing := range pending {
+		if slashing.ProposerIndex == 2 {
+			t.Error("Expected the slashing of a slashed validator to be pruned")
+		}
+	}
+	saved, err := beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 2 {
+		t.Errorf("Expected the pruned slashing to be deleted, %d slashings remain", len(saved))
+	}
+}
+
+func TestPendingProposerSlashings_RespectsBlockLimit(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	cfg := params.BeaconConfig()
+	cfg.MaxProposerSlashings = 2
+	params.OverrideBeaconConfig(cfg)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	keys := randKeys(t, 4)
+	beaconState := slashingTestState(keys)
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	for i := uint64(0); i < 4; i++ {
+		if err := service.HandleProposerSlashing(ctx, signedProposerSlashing(t, beaconState, keys[i], i, "A", "B")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pending, err := service.PendingProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 {
+		t.Errorf("Expected slashings up to the block limit, received %d", len(pending))
+	}
+}
+
+func TestHandleProcessedBlock_RemovesIncludedSlashings(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	service := NewOpsPoolService(ctx, &Config{BeaconDB: beaconDB})
+
+	proposerSlashing := &ethpb.ProposerSlashing{ProposerIndex: 1}
+	attesterSlashing := &ethpb.AttesterSlashing{Attestation_1: &ethpb.IndexedAttestation{CustodyBit_0Indices: []uint64{1}}}
+	if err := beaconDB.SaveProposerSlashing(ctx, proposerSlashing); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveAttesterSlashing(ctx, attesterSlashing); err != nil {
+		t.Fatal(err)
+	}
+
+	block := &ethpb.BeaconBlock{
+		Body: &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{proposerSlashing},
+			AttesterSlashings: []*ethpb.AttesterSlashing{attesterSlashing},
+		},
+	}
+	if err := service.handleProcessedBlock(ctx, block); err != nil {
+		t.Fatal(err)
+	}
+	proposerSlashings, err := beaconDB.ProposerSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attesterSlashings, err := beaconDB.AttesterSlashings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proposerSlashings) != 0 || len(attesterSlashings) != 0 {
+		t.Errorf("Expected the included slashings to be removed, %d proposer and %d attester slashings remain", len(proposerSlashings), len(attesterSlashings))
+	}
+}
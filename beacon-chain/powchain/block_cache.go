@@ -7,10 +7,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -24,15 +24,15 @@ var (
 	maxCacheSize = int(2 * params.BeaconConfig().Eth1FollowDistance)
 
 	// Metrics
-	blockCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+	blockCacheMiss = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_miss",
 		Help: "The number of block requests that aren't present in the cache.",
 	})
-	blockCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+	blockCacheHit = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_block_cache_hit",
 		Help: "The number of block requests that are present in the cache.",
 	})
-	blockCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+	blockCacheSize = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "powchain_block_cache_size",
 		Help: "The number of blocks in the block cache",
 	})
@@ -74,109 +74,107 @@ func heightKeyFn(obj interface{}) (string, error) {
 	return bInfo.Number.String(), nil
 }
 
-// blockCache struct with two queues for looking up by hash or by block height.
+// blockCache is an LRU cache of blockInfo, looked up by block hash or by block height.
+// The blocks added since the last call to takeUnpersisted are tracked, so that they can
+// be persisted to the DB and restored in the cache on restart.
 type blockCache struct {
-	hashCache   *cache.FIFO
-	heightCache *cache.FIFO
+	hashCache   *lru.Cache
+	heightCache *lru.Cache
+	unpersisted []*blockInfo
 	lock        sync.RWMutex
 }
 
 // newBlockCache creates a new block cache for storing/accessing blockInfo from
 // memory.
 func newBlockCache() *blockCache {
+	// #nosec G104 lru.New only errors on a non-positive size.
+	hashCache, _ := lru.New(maxCacheSize)
+	// #nosec G104 lru.New only errors on a non-positive size.
+	heightCache, _ := lru.New(maxCacheSize)
 	return &blockCache{
-		hashCache:   cache.NewFIFO(hashKeyFn),
-		heightCache: cache.NewFIFO(heightKeyFn),
+		hashCache:   hashCache,
+		heightCache: heightCache,
 	}
 }
 
 // BlockInfoByHash fetches blockInfo by its block hash. Returns true with a
 // reference to the block info, if exists. Otherwise returns false, nil.
 func (b *blockCache) BlockInfoByHash(hash common.Hash) (bool, *blockInfo, error) {
-	b.lock.RLock()
-	defer b.lock.RUnlock()
-
-	obj, exists, err := b.hashCache.GetByKey(hash.Hex())
-	if err != nil {
-		return false, nil, err
-	}
-
-	if exists {
-		blockCacheHit.Inc()
-	} else {
-		blockCacheMiss.Inc()
-		return false, nil, nil
-	}
-
-	bInfo, ok := obj.(*blockInfo)
-	if !ok {
-		return false, nil, ErrNotABlockInfo
-	}
-
-	return true, bInfo, nil
+	return b.get(b.hashCache, hash.Hex())
 }
 
 // BlockInfoByHeight fetches blockInfo by its block number. Returns true with a
 // reference to the block info, if exists. Otherwise returns false, nil.
 func (b *blockCache) BlockInfoByHeight(height *big.Int) (bool, *blockInfo, error) {
+	return b.get(b.heightCache, height.String())
+}
+
+func (b *blockCache) get(c *lru.Cache, key string) (bool, *blockInfo, error) {
 	b.lock.RLock()
 	defer b.lock.RUnlock()
 
-	obj, exists, err := b.heightCache.GetByKey(height.String())
-	if err != nil {
-		return false, nil, err
-	}
-
-	if exists {
-		blockCacheHit.Inc()
-	} else {
+	obj, exists := c.Get(key)
+	if !exists {
 		blockCacheMiss.Inc()
 		return false, nil, nil
 	}
+	blockCacheHit.Inc()
 
 	bInfo, ok := obj.(*blockInfo)
 	if !ok {
 		return false, nil, ErrNotABlockInfo
 	}
 
-	return exists, bInfo, nil
+	return true, bInfo, nil
 }
 
-// AddBlock adds a blockInfo object to the cache. This method also trims the
-// least recently added block info if the cache size has reached the max cache
-// size limit. This method should be called in sequential block number order if
-// the desired behavior is that the blocks with the highest block number should
-// be present in the cache.
+// AddBlock adds a blockInfo object to the cache, evicting the least recently used
+// block info if the cache size has reached the max cache size limit.
 func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
+	return b.add(blockToBlockInfo(blk), true)
+}
+
+// add the block info to the cache, tracking it to be persisted if it is not already
+// cached.
+func (b *blockCache) add(bInfo *blockInfo, persist bool) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	bInfo := blockToBlockInfo(blk)
-
-	if err := b.hashCache.AddIfNotPresent(bInfo); err != nil {
+	hashKey, err := hashKeyFn(bInfo)
+	if err != nil {
 		return err
 	}
-	if err := b.heightCache.AddIfNotPresent(bInfo); err != nil {
+	heightKey, err := heightKeyFn(bInfo)
+	if err != nil {
 		return err
 	}
 
-	trim(b.hashCache, maxCacheSize)
-	trim(b.heightCache, maxCacheSize)
+	if persist && !b.hashCache.Contains(hashKey) {
+		b.unpersisted = append(b.unpersisted, bInfo)
+	}
+	b.hashCache.Add(hashKey, bInfo)
+	b.heightCache.Add(heightKey, bInfo)
 
-	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
+	blockCacheSize.Set(float64(b.hashCache.Len()))
 
 	return nil
 }
 
-// trim the FIFO queue to the maxSize.
-func trim(queue *cache.FIFO, maxSize int) {
-	for s := len(queue.ListKeys()); s > maxSize; s-- {
-		// #nosec G104 popProcessNoopFunc never returns an error
-		_, _ = queue.Pop(popProcessNoopFunc)
-	}
-}
+// takeUnpersisted returns the blocks added since the last call which are at or below
+// the given height, and keeps tracking the others. Blocks above the height can still
+// be reorged and are not persisted yet.
+func (b *blockCache) takeUnpersisted(maxHeight *big.Int) []*blockInfo {
+	b.lock.Lock()
+	defer b.lock.Unlock()
 
-// popProcessNoopFunc is a no-op function that never returns an error.
-func popProcessNoopFunc(obj interface{}) error {
-	return nil
+	var taken, kept []*blockInfo
+	for _, bInfo := range b.unpersisted {
+		if bInfo.Number.Cmp(maxHeight) <= 0 {
+			taken = append(taken, bInfo)
+		} else {
+			kept = append(kept, bInfo)
+		}
+	}
+	b.unpersisted = kept
+	return taken
 }
@@ -2,6 +2,7 @@ package powchain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -61,10 +62,49 @@ func (w *Web3Service) BlockHashByHeight(ctx context.Context, height *big.Int) (c
 func (w *Web3Service) BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.BlockTimeByHeight")
 	defer span.End()
+
+	if exists, blkInfo, err := w.blockCache.BlockInfoByHeight(height); exists || err != nil {
+		if err != nil {
+			return 0, err
+		}
+		span.AddAttributes(trace.BoolAttribute("blockCacheHit", true))
+		return blkInfo.Time, nil
+	}
+	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
 	block, err := w.blockFetcher.BlockByNumber(w.ctx, height)
 	if err != nil {
 		return 0, fmt.Errorf("could not query block with given height: %v", err)
 	}
+	if err := w.blockCache.AddBlock(block); err != nil {
+		return 0, err
+	}
+	return block.Time(), nil
+}
+
+// blockTimeByHash fetches an eth1.0 block timestamp by its hash.
+func (w *Web3Service) blockTimeByHash(ctx context.Context, hash common.Hash) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.web3service.blockTimeByHash")
+	defer span.End()
+
+	if exists, blkInfo, err := w.blockCache.BlockInfoByHash(hash); exists || err != nil {
+		if err != nil {
+			return 0, err
+		}
+		span.AddAttributes(trace.BoolAttribute("blockCacheHit", true))
+		return blkInfo.Time, nil
+	}
+	span.AddAttributes(trace.BoolAttribute("blockCacheHit", false))
+	block, err := w.blockFetcher.BlockByHash(ctx, hash)
+	if err != nil {
+		reportRequestError("BlockByHash")
+		return 0, fmt.Errorf("could not query block with given hash: %v", err)
+	}
+	if block == nil {
+		return 0, errors.New("got empty block")
+	}
+	if err := w.blockCache.AddBlock(block); err != nil {
+		return 0, err
+	}
 	return block.Time(), nil
 }
 
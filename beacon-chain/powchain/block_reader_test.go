@@ -232,3 +232,29 @@ func TestBlockNumberByTimestamp(t *testing.T) {
 		t.Error("Returned a block with zero number, expected to be non zero")
 	}
 }
+
+func TestBlockTimeByHeight_UsesCachedBlockInfo(t *testing.T) {
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:     endpoint,
+		BlockFetcher: nil, // nil blockFetcher would panic if cached value not used
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	block := gethTypes.NewBlockWithHeader(&gethTypes.Header{
+		Number: big.NewInt(42),
+		Time:   1500,
+	})
+	if err := web3Service.blockCache.AddBlock(block); err != nil {
+		t.Fatal(err)
+	}
+
+	blockTime, err := web3Service.BlockTimeByHeight(context.Background(), big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blockTime != block.Time() {
+		t.Errorf("Expected block time %d, got %d", block.Time(), blockTime)
+	}
+}
@@ -2,6 +2,7 @@ package powchain
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -10,7 +11,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -24,6 +27,22 @@ var (
 	depositEventSignature = []byte("DepositEvent(bytes,bytes,bytes,bytes,bytes)")
 )
 
+// defaultLogBatchSize is the default max number of blocks per deposit log request,
+// which providers limiting the range of log queries accept.
+const defaultLogBatchSize = 1000
+
+// maxLogRequestAttempts is the number of times the deposit logs of a batch of blocks
+// are requested before giving up.
+const maxLogRequestAttempts = 3
+
+// noGenesisDelay is the number of seconds from the eth1 block triggering genesis to the
+// genesis time with the NoGenesisDelay feature.
+const noGenesisDelay = 30
+
+// logRequestRetryDelay is the delay before the first retry of a failed log request,
+// which grows linearly with each attempt.
+var logRequestRetryDelay = 1 * time.Second
+
 // ETH2GenesisTime retrieves the genesis time of the beacon chain
 // from the deposit contract.
 func (w *Web3Service) ETH2GenesisTime() uint64 {
@@ -43,20 +62,15 @@ func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) {
 				log.Error("Got empty blockhash from powchain service")
 				return
 			}
-			blk, err := w.blockFetcher.BlockByHash(w.ctx, depositLog.BlockHash)
+			blockTime, err := w.blockTimeByHash(w.ctx, depositLog.BlockHash)
 			if err != nil {
 				log.Errorf("Could not get eth1 block %v", err)
 				return
 			}
-			if blk == nil {
-				log.Errorf("Got empty block from powchain service %v", err)
-				return
-			}
-			timeStamp := blk.Time()
-			triggered := state.IsValidGenesisState(w.activeValidatorCount, timeStamp)
-			if triggered {
-				w.setGenesisTime(timeStamp)
-				w.ProcessChainStart(uint64(w.eth2GenesisTime), depositLog.BlockHash)
+			genesisTime := computeGenesisTime(blockTime)
+			if state.IsValidGenesisState(w.activeValidatorCount, genesisTime) {
+				w.eth2GenesisTime = genesisTime
+				w.ProcessChainStart(genesisTime, depositLog.BlockHash)
 			}
 		}
 		return
@@ -147,12 +161,14 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 		log.WithFields(logrus.Fields{
 			"publicKey":       fmt.Sprintf("%#x", depositData.PublicKey),
 			"merkleTreeIndex": index,
+			"eth1Block":       depositLog.BlockNumber,
 		}).Debug("Deposit registered from deposit contract")
 		validDepositsCount.Inc()
 	} else {
 		log.WithFields(logrus.Fields{
 			"merkleTreeIndex": index,
 		}).Info("Invalid deposit registered in deposit contract")
+		invalidDepositsCount.Inc()
 	}
 }
 
@@ -201,34 +217,42 @@ func (w *Web3Service) ProcessChainStart(genesisTime uint64, eth1BlockHash [32]by
 	w.chainStartFeed.Send(chainStartTime)
 }
 
-func (w *Web3Service) setGenesisTime(timeStamp uint64) {
+// computeGenesisTime returns the genesis time of the beacon chain triggered by the eth1
+// block with the timestamp: the start of the day of the block plus the genesis delay.
+// With the NoGenesisDelay feature, the chain starts shortly after the block instead.
+// As the genesis time only depends on the block, every node of a network agrees on it.
+//
+// Spec pseudocode definition:
+//   genesis_time=eth1_timestamp - eth1_timestamp % SECONDS_PER_DAY + 2 * SECONDS_PER_DAY
+func computeGenesisTime(eth1Timestamp uint64) uint64 {
 	if featureconfig.FeatureConfig().NoGenesisDelay {
-		w.eth2GenesisTime = uint64(time.Unix(int64(timeStamp), 0).Add(30 * time.Second).Unix())
-	} else {
-		timeStampRdDown := timeStamp - timeStamp%params.BeaconConfig().SecondsPerDay
-		// genesisTime will be set to the first second of the day, two days after it was triggered.
-		w.eth2GenesisTime = timeStampRdDown + 2*params.BeaconConfig().SecondsPerDay
+		return eth1Timestamp + noGenesisDelay
 	}
+	return eth1Timestamp - eth1Timestamp%params.BeaconConfig().SecondsPerDay + params.BeaconConfig().GenesisDelay
 }
 
 // processPastLogs processes all the past logs from the deposit contract and
-// updates the deposit trie with the data from each individual log.
+// updates the deposit trie with the data from each individual log. If the deposits
+// were restored from the DB, only the logs after the last processed block are requested.
 func (w *Web3Service) processPastLogs() error {
-	query := ethereum.FilterQuery{
-		Addresses: []common.Address{
-			w.depositContractAddress,
-		},
+	if err := w.restoreBlockInfos(); err != nil {
+		return err
 	}
-
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+	restored, err := w.restoreDepositData()
 	if err != nil {
 		return err
 	}
-
-	for _, log := range logs {
-		w.ProcessLog(log)
+	fromBlock := big.NewInt(0)
+	if restored {
+		fromBlock.Add(w.lastRequestedBlock, big.NewInt(1))
+	}
+	if err := w.processLogsInRange(fromBlock, w.blockHeight); err != nil {
+		return err
 	}
 	w.lastRequestedBlock.Set(w.blockHeight)
+	if err := w.saveDepositData(); err != nil {
+		return err
+	}
 
 	currentState, err := w.beaconDB.HeadState(w.ctx)
 	if err != nil {
@@ -248,29 +272,252 @@ func (w *Web3Service) requestBatchedLogs() error {
 	// We request for the nth block behind the current head, in order to have
 	// stabilized logs when we retrieve it from the 1.0 chain.
 	requestedBlock := big.NewInt(0).Sub(w.blockHeight, big.NewInt(params.BeaconConfig().LogBlockDelay))
+	fromBlock := big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(1))
+	if err := w.processLogsInRange(fromBlock, requestedBlock); err != nil {
+		return err
+	}
+	w.lastRequestedBlock.Set(requestedBlock)
+	return w.saveDepositData()
+}
+
+// processLogsInRange requests and processes the deposit contract logs of the given
+// range of blocks (inclusive) in batches of at most logBatchSize blocks, so that
+// providers rejecting log queries over large block ranges can be used. The last
+// requested block is updated after each batch, so that a failed request resumes
+// from the batch that failed.
+func (w *Web3Service) processLogsInRange(fromBlock *big.Int, toBlock *big.Int) error {
+	batchSize := big.NewInt(0).SetUint64(w.logBatchSize)
+	multipleBatches := big.NewInt(0).Sub(toBlock, fromBlock).Cmp(batchSize) >= 0
+	start := big.NewInt(0).Set(fromBlock)
+	for start.Cmp(toBlock) <= 0 {
+		end := big.NewInt(0).Add(start, batchSize)
+		end.Sub(end, big.NewInt(1))
+		if end.Cmp(toBlock) > 0 {
+			end.Set(toBlock)
+		}
+		logs, err := w.filterLogsWithRetries(start, end)
+		if err != nil {
+			return err
+		}
+		for _, depositLog := range logs {
+			w.ProcessLog(depositLog)
+		}
+		w.lastRequestedBlock.Set(end)
+
+		fields := logrus.Fields{
+			"fromBlock": start,
+			"toBlock":   end,
+			"logs":      len(logs),
+		}
+		if multipleBatches {
+			fields["remainingBlocks"] = big.NewInt(0).Sub(toBlock, end)
+			log.WithFields(fields).Info("Processed deposit logs")
+		} else {
+			log.WithFields(fields).Debug("Processed deposit logs")
+		}
+		start = big.NewInt(0).Add(end, big.NewInt(1))
+	}
+	return nil
+}
+
+// filterLogsWithRetries requests the deposit contract logs of the given range of blocks
+// (inclusive), retrying failed requests.
+func (w *Web3Service) filterLogsWithRetries(fromBlock *big.Int, toBlock *big.Int) ([]gethTypes.Log, error) {
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{
 			w.depositContractAddress,
 		},
-		FromBlock: w.lastRequestedBlock.Add(w.lastRequestedBlock, big.NewInt(1)),
-		ToBlock:   requestedBlock,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
 	}
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
-	if err != nil {
+	var err error
+	for attempt := 1; attempt <= maxLogRequestAttempts; attempt++ {
+		var logs []gethTypes.Log
+		logs, err = w.httpLogger.FilterLogs(w.ctx, query)
+		if err == nil {
+			return logs, nil
+		}
+		reportRequestError("FilterLogs")
+		if attempt == maxLogRequestAttempts {
+			break
+		}
+		log.WithError(err).WithFields(logrus.Fields{
+			"fromBlock": fromBlock,
+			"toBlock":   toBlock,
+			"attempt":   attempt,
+		}).Warn("Could not request deposit logs, retrying")
+		select {
+		case <-w.ctx.Done():
+			return nil, w.ctx.Err()
+		case <-time.After(logRequestRetryDelay * time.Duration(attempt)):
+		}
+	}
+	return nil, fmt.Errorf("could not request deposit logs of blocks %d to %d: %v", fromBlock, toBlock, err)
+}
+
+// saveDepositData persists the deposits received since the last save, the last eth1
+// block whose logs were processed and, once the chain started, the chain start event.
+func (w *Web3Service) saveDepositData() error {
+	if w.lastReceivedMerkleIndex > w.lastPersistedIndex {
+		if err := w.beaconDB.PersistDeposits(w.ctx, int(w.lastPersistedIndex+1)); err != nil {
+			return fmt.Errorf("could not persist deposits: %v", err)
+		}
+		w.lastPersistedIndex = w.lastReceivedMerkleIndex
+	}
+	if w.chainStarted && !w.chainStartPersisted {
+		if err := w.beaconDB.SaveChainStartData(w.ctx, &pb.ChainStartData{
+			GenesisTime: w.eth2GenesisTime,
+			Eth1Data:    w.chainStartETH1Data,
+		}); err != nil {
+			return fmt.Errorf("could not persist chain start data: %v", err)
+		}
+		w.chainStartPersisted = true
+	}
+	if err := w.beaconDB.SaveLastProcessedEth1Block(w.ctx, w.lastRequestedBlock); err != nil {
+		return fmt.Errorf("could not persist last processed eth1 block: %v", err)
+	}
+	if err := w.saveBlockInfos(); err != nil {
 		return err
 	}
+	w.reportProcessingMetrics()
+	return nil
+}
 
-	// Only process log slices which are larger than zero.
-	if len(logs) > 0 {
-		for _, log := range logs {
-			w.ProcessLog(log)
+// saveBlockInfos persists the blocks added to the block cache which are at least
+// Eth1FollowDistance behind the head, as the blocks used for eth1 data voting are
+// only looked up past the follow distance and are not expected to be reorged.
+func (w *Web3Service) saveBlockInfos() error {
+	if w.blockHeight == nil {
+		return nil
+	}
+	maxHeight := big.NewInt(0).Sub(w.blockHeight, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
+	infos := w.blockCache.takeUnpersisted(maxHeight)
+	if len(infos) == 0 {
+		return nil
+	}
+	dbInfos := make([]*db.Eth1BlockInfo, len(infos))
+	for i, info := range infos {
+		dbInfos[i] = &db.Eth1BlockInfo{
+			Hash:   info.Hash,
+			Number: info.Number,
+			Time:   info.Time,
 		}
 	}
+	if err := w.beaconDB.SaveEth1BlockInfos(w.ctx, dbInfos); err != nil {
+		return fmt.Errorf("could not persist eth1 block infos: %v", err)
+	}
+	return nil
+}
 
-	w.lastRequestedBlock.Set(requestedBlock)
+// restoreBlockInfos fills the block cache with the most recent block infos persisted by
+// a previous run, so that the blocks used for eth1 data voting are not requested again.
+func (w *Web3Service) restoreBlockInfos() error {
+	infos, err := w.beaconDB.RecentEth1BlockInfos(w.ctx, maxCacheSize)
+	if err != nil {
+		return fmt.Errorf("could not retrieve eth1 block infos: %v", err)
+	}
+	// The block infos are added from the oldest, so that the most recent ones are the
+	// last to be evicted.
+	for i := len(infos) - 1; i >= 0; i-- {
+		if err := w.blockCache.add(&blockInfo{
+			Hash:   infos[i].Hash,
+			Number: infos[i].Number,
+			Time:   infos[i].Time,
+		}, false); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// restoreDepositData restores the deposits, the deposit trie and the chain start event
+// persisted by a previous run, so that only the logs of the blocks after the last
+// processed block need to be requested. Before the chain start, the restored deposits
+// are processed again to count the genesis validators. It returns false if there is
+// nothing to restore.
+func (w *Web3Service) restoreDepositData() (bool, error) {
+	chainStartData, err := w.beaconDB.ChainStartData(w.ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not retrieve chain start data: %v", err)
+	}
+	lastBlock, err := w.beaconDB.LastProcessedEth1Block(w.ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not retrieve last processed eth1 block: %v", err)
+	}
+	if lastBlock == nil {
+		return false, nil
+	}
+	containers, err := w.beaconDB.PersistedDeposits(w.ctx)
+	if err != nil {
+		return false, fmt.Errorf("could not retrieve persisted deposits: %v", err)
+	}
+	if chainStartData != nil && chainStartData.Eth1Data.DepositCount > uint64(len(containers)) {
+		return false, errors.New("persisted deposits do not include all chain start deposits")
+	}
+
+	hashes := make([][]byte, len(containers))
+	deposits := make([]*ethpb.Deposit, len(containers))
+	for i, ctnr := range containers {
+		if ctnr.Index != i {
+			return false, fmt.Errorf("persisted deposits are missing deposit %d", i)
+		}
+		hash, err := hashutil.DepositHash(ctnr.Deposit.Data)
+		if err != nil {
+			return false, fmt.Errorf("could not hash deposit %d: %v", i, err)
+		}
+		hashes[i] = hash[:]
+		deposits[i] = ctnr.Deposit
+		w.beaconDB.InsertDeposit(w.ctx, ctnr.Deposit, ctnr.Block, ctnr.Index, ctnr.DepositRoot())
+		if chainStartData == nil {
+			w.restoreChainStartDeposit(ctnr)
+			continue
+		}
+		// Deposits already included in the beacon chain are pruned from the pending
+		// deposits once the head state is known.
+		if uint64(i) >= chainStartData.Eth1Data.DepositCount {
+			w.beaconDB.InsertPendingDeposit(w.ctx, ctnr.Deposit, ctnr.Block, ctnr.Index, ctnr.DepositRoot())
+		}
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems(hashes, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return false, fmt.Errorf("could not restore deposit trie: %v", err)
+	}
+
+	w.depositTrie = depositTrie
+	w.lastReceivedMerkleIndex = int64(len(containers)) - 1
+	w.lastPersistedIndex = w.lastReceivedMerkleIndex
+	w.chainStartDeposits = deposits
+	if chainStartData != nil {
+		w.chainStarted = true
+		w.chainStartPersisted = true
+		w.eth2GenesisTime = chainStartData.GenesisTime
+		w.chainStartETH1Data = chainStartData.Eth1Data
+		w.chainStartDeposits = deposits[:chainStartData.Eth1Data.DepositCount]
+	}
+	w.lastRequestedBlock.Set(lastBlock)
+
+	log.WithFields(logrus.Fields{
+		"deposits":           len(containers),
+		"lastProcessedBlock": lastBlock,
+		"chainStarted":       w.chainStarted,
+	}).Info("Restored deposits from the database")
+	return true, nil
+}
+
+// restoreChainStartDeposit processes a restored deposit towards the genesis validators,
+// the same way as a deposit received before the chain start.
+func (w *Web3Service) restoreChainStartDeposit(ctnr *db.DepositContainer) {
+	w.beaconDB.MarkPubkeyForChainstart(w.ctx, fmt.Sprintf("#%x", ctnr.Deposit.Data.PublicKey))
+	root := ctnr.DepositRoot()
+	eth1Data := &ethpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: uint64(ctnr.Index + 1),
+	}
+	if err := w.processDeposit(eth1Data, ctnr.Deposit); err != nil {
+		log.Errorf("Invalid deposit restored: %v", err)
+	}
+}
+
 // ChainStartDepositHashes returns the hashes of all the chainstart deposits
 // stored in memory.
 func (w *Web3Service) ChainStartDepositHashes() ([][]byte, error) {
@@ -4,15 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"io/ioutil"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -378,3 +383,334 @@ func TestProcessETH2GenesisLog(t *testing.T) {
 
 	hook.Reset()
 }
+
+func TestSaveAndRestoreDepositData(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		deposit := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}, Amount: 32}}
+		hash, err := hashutil.DepositHash(deposit.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := web3Service.depositTrie.InsertIntoTrie(hash[:], i); err != nil {
+			t.Fatal(err)
+		}
+		beaconDB.InsertDeposit(ctx, deposit, big.NewInt(int64(10+i)), i, web3Service.depositTrie.Root())
+		web3Service.lastReceivedMerkleIndex = int64(i)
+	}
+	web3Service.chainStarted = true
+	web3Service.eth2GenesisTime = 1000
+	web3Service.chainStartETH1Data = &ethpb.Eth1Data{DepositCount: 2}
+	web3Service.lastRequestedBlock.SetInt64(20)
+	if err := web3Service.saveDepositData(); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	restored, err := restarted.restoreDepositData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored {
+		t.Fatal("Expected deposit data to be restored")
+	}
+	if restarted.DepositRoot() != web3Service.DepositRoot() {
+		t.Errorf("Wanted restored deposit root %#x, received %#x", web3Service.DepositRoot(), restarted.DepositRoot())
+	}
+	if restarted.lastRequestedBlock.Int64() != 20 {
+		t.Errorf("Wanted to resume after block 20, received %d", restarted.lastRequestedBlock)
+	}
+	if !restarted.HasChainStarted() || restarted.ETH2GenesisTime() != 1000 {
+		t.Errorf("Expected chain start to be restored with genesis time 1000, received %d", restarted.ETH2GenesisTime())
+	}
+	if len(restarted.ChainStartDeposits()) != 2 {
+		t.Errorf("Wanted 2 chain start deposits, received %d", len(restarted.ChainStartDeposits()))
+	}
+	if restarted.lastReceivedMerkleIndex != 2 {
+		t.Errorf("Wanted last received index 2, received %d", restarted.lastReceivedMerkleIndex)
+	}
+}
+
+type flakyLogger struct {
+	goodLogger
+	failures int
+	queries  []ethereum.FilterQuery
+}
+
+func (f *flakyLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	f.queries = append(f.queries, q)
+	if f.failures > 0 {
+		f.failures--
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	return nil, nil
+}
+
+func TestProcessLogsInRange_RequestsBatchesWithRetries(t *testing.T) {
+	logRequestRetryDelay = time.Millisecond
+	defer func() {
+		logRequestRetryDelay = time.Second
+	}()
+
+	logger := &flakyLogger{failures: 1}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:     endpoint,
+		HTTPLogger:   logger,
+		LogBatchSize: 10,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	if err := web3Service.processLogsInRange(big.NewInt(5), big.NewInt(30)); err != nil {
+		t.Fatal(err)
+	}
+	wanted := [][2]int64{{5, 14}, {5, 14}, {15, 24}, {25, 30}}
+	if len(logger.queries) != len(wanted) {
+		t.Fatalf("Expected %d log queries, received %d", len(wanted), len(logger.queries))
+	}
+	for i, q := range logger.queries {
+		if q.FromBlock.Int64() != wanted[i][0] || q.ToBlock.Int64() != wanted[i][1] {
+			t.Errorf("Expected query %d for blocks %v, received %d to %d", i, wanted[i], q.FromBlock, q.ToBlock)
+		}
+	}
+	if web3Service.lastRequestedBlock.Int64() != 30 {
+		t.Errorf("Expected last requested block 30, received %d", web3Service.lastRequestedBlock)
+	}
+
+	logger.failures = maxLogRequestAttempts
+	if err := web3Service.processLogsInRange(big.NewInt(31), big.NewInt(35)); err == nil {
+		t.Error("Expected an error once all attempts failed")
+	}
+	if web3Service.lastRequestedBlock.Int64() != 30 {
+		t.Errorf("Expected last requested block to remain 30 after a failed batch, received %d", web3Service.lastRequestedBlock)
+	}
+}
+
+func TestRestoreDepositData_BeforeChainStart(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	initialDeposits, _ := testutil.SetupInitialDeposits(t, 4)
+	for i, d := range initialDeposits {
+		hash, err := hashutil.DepositHash(d.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := web3Service.depositTrie.InsertIntoTrie(hash[:], i); err != nil {
+			t.Fatal(err)
+		}
+		proof, err := web3Service.depositTrie.MerkleProof(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deposit := &ethpb.Deposit{Data: d.Data, Proof: proof}
+		beaconDB.InsertDeposit(ctx, deposit, big.NewInt(int64(i)), i, web3Service.depositTrie.Root())
+		web3Service.lastReceivedMerkleIndex = int64(i)
+	}
+	web3Service.lastRequestedBlock.SetInt64(10)
+	if err := web3Service.saveDepositData(); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	restored, err := restarted.restoreDepositData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored {
+		t.Fatal("Expected deposit data to be restored before the chain start")
+	}
+	if restarted.HasChainStarted() {
+		t.Error("Expected chain not to be started")
+	}
+	if restarted.activeValidatorCount != 4 {
+		t.Errorf("Wanted 4 genesis validators counted, received %d", restarted.activeValidatorCount)
+	}
+	if len(restarted.ChainStartDeposits()) != 4 {
+		t.Errorf("Wanted 4 chain start deposits, received %d", len(restarted.ChainStartDeposits()))
+	}
+	if restarted.lastRequestedBlock.Int64() != 10 {
+		t.Errorf("Wanted to resume after block 10, received %d", restarted.lastRequestedBlock)
+	}
+}
+
+func TestComputeGenesisTime(t *testing.T) {
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	// 1 hour and 40 minutes into the day.
+	timestamp := 10*params.BeaconConfig().SecondsPerDay + 6000
+
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	want := 10*params.BeaconConfig().SecondsPerDay + params.BeaconConfig().GenesisDelay
+	if got := computeGenesisTime(timestamp); got != want {
+		t.Errorf("Wanted genesis time %d, received %d", want, got)
+	}
+
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{NoGenesisDelay: true})
+	want = timestamp + noGenesisDelay
+	if got := computeGenesisTime(timestamp); got != want {
+		t.Errorf("Wanted genesis time %d with no genesis delay, received %d", want, got)
+	}
+}
+
+func TestProcessLog_ChecksMinGenesisTimeAgainstGenesisTime(t *testing.T) {
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{NoGenesisDelay: true})
+
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.ContractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      &goodLogger{},
+		ContractBackend: testAcc.Backend,
+		BeaconDB:        &db.BeaconDB{},
+		BlockFetcher:    &timestampFetcher{timestamp: 1000},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	cfg := *params.BeaconConfig()
+	cfg.MinGenesisActiveValidatorCount = 1
+	// The eth1 block is before the min genesis time, but the genesis time is not.
+	cfg.MinGenesisTime = 1000 + noGenesisDelay
+	params.OverrideBeaconConfig(&cfg)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 1)
+	data := deposits[0].Data
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature); err != nil {
+		t.Fatalf("Could not deposit to deposit contract %v", err)
+	}
+	testAcc.Backend.Commit()
+
+	logs, err := testAcc.Backend.FilterLogs(web3Service.ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{web3Service.depositContractAddress},
+	})
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+	for _, l := range logs {
+		web3Service.ProcessLog(l)
+	}
+
+	if !web3Service.HasChainStarted() {
+		t.Fatal("Expected chain to start once the genesis time reaches the min genesis time")
+	}
+	if web3Service.ETH2GenesisTime() != 1000+noGenesisDelay {
+		t.Errorf("Wanted genesis time %d, received %d", 1000+noGenesisDelay, web3Service.ETH2GenesisTime())
+	}
+}
+
+// timestampFetcher returns blocks with the same timestamp.
+type timestampFetcher struct {
+	goodFetcher
+	timestamp uint64
+}
+
+func (f *timestampFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	return gethTypes.NewBlockWithHeader(&gethTypes.Header{Number: big.NewInt(1), Time: f.timestamp}), nil
+}
+
+func TestSaveAndRestoreBlockInfos(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	followDistance := int64(params.BeaconConfig().Eth1FollowDistance)
+	web3Service.blockHeight = big.NewInt(followDistance + 10)
+	var blocks []*gethTypes.Block
+	for i := int64(0); i < 20; i++ {
+		blk := gethTypes.NewBlockWithHeader(&gethTypes.Header{
+			Number: big.NewInt(i),
+			Time:   uint64(1000 + i),
+		})
+		if err := web3Service.blockCache.AddBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, blk)
+	}
+	if err := web3Service.saveDepositData(); err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint: endpoint,
+		BeaconDB: beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if err := restarted.restoreBlockInfos(); err != nil {
+		t.Fatal(err)
+	}
+	for i, blk := range blocks {
+		exists, info, err := restarted.blockCache.BlockInfoByHash(blk.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Only the blocks past the follow distance are persisted.
+		if exists != (i <= 10) {
+			t.Fatalf("Expected block %d to be restored: %v, got %v", i, i <= 10, exists)
+		}
+		if exists && info.Time != blk.Time() {
+			t.Errorf("Expected block %d time %d, got %d", i, blk.Time(), info.Time)
+		}
+	}
+	if len(web3Service.blockCache.unpersisted) != 9 {
+		t.Errorf("Expected 9 blocks left to persist, got %d", len(web3Service.blockCache.unpersisted))
+	}
+}
@@ -0,0 +1,66 @@
+package powchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	lastProcessedBlockGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_last_processed_block",
+		Help: "The last block in the proof-of-work chain whose deposit logs were processed",
+	})
+	logProcessingLagGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_log_processing_lag",
+		Help: "The number of blocks between the latest block and the last block whose deposit logs were processed",
+	})
+	processedDepositsGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_processed_deposits",
+		Help: "The number of deposits processed from the deposit contract logs",
+	})
+	invalidDepositsCount = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_invalid_deposits_received",
+		Help: "The number of invalid deposits received in the deposit contract",
+	})
+	chainStartValidatorsGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_chainstart_validators",
+		Help: "The number of validators fully deposited before the chain start",
+	})
+	chainStartValidatorsRequiredGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_chainstart_validators_required",
+		Help: "The number of fully deposited validators required for the chain start",
+	})
+	requestErrorsCount = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "powchain_request_errors",
+		Help: "The number of failed requests to the proof-of-work chain endpoints",
+	}, []string{"request"})
+	depositTrieRootGauge = metrics.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_deposit_trie_root",
+		Help: "The number of deposits in the deposit trie, labeled with the root of the trie",
+	}, []string{"root"})
+)
+
+// reportRequestError counts a failed request to the proof-of-work chain endpoints.
+func reportRequestError(request string) {
+	requestErrorsCount.WithLabelValues(request).Inc()
+}
+
+// reportProcessingMetrics updates the metrics of the log and deposit processing.
+func (w *Web3Service) reportProcessingMetrics() {
+	lastProcessedBlockGauge.Set(float64(w.lastRequestedBlock.Uint64()))
+	if w.blockHeight != nil {
+		lag := big.NewInt(0).Sub(w.blockHeight, w.lastRequestedBlock)
+		logProcessingLagGauge.Set(float64(lag.Int64()))
+	}
+	depositCount := float64(w.lastReceivedMerkleIndex + 1)
+	processedDepositsGauge.Set(depositCount)
+	chainStartValidatorsGauge.Set(float64(w.activeValidatorCount))
+	chainStartValidatorsRequiredGauge.Set(float64(params.BeaconConfig().MinGenesisActiveValidatorCount))
+	root := w.depositTrie.Root()
+	depositTrieRootGauge.Reset()
+	depositTrieRootGauge.WithLabelValues(fmt.Sprintf("%#x", root)).Set(depositCount)
+}
@@ -0,0 +1,38 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestReportProcessingMetrics(t *testing.T) {
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint: endpoint,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.blockHeight = big.NewInt(120)
+	web3Service.lastRequestedBlock.SetInt64(100)
+	web3Service.lastReceivedMerkleIndex = 9
+	web3Service.activeValidatorCount = 8
+
+	web3Service.reportProcessingMetrics()
+	if lag := testutil.ToFloat64(logProcessingLagGauge); lag != 20 {
+		t.Errorf("Wanted log processing lag 20, received %v", lag)
+	}
+	if count := testutil.ToFloat64(processedDepositsGauge); count != 10 {
+		t.Errorf("Wanted 10 processed deposits, received %v", count)
+	}
+	if count := testutil.ToFloat64(chainStartValidatorsGauge); count != 8 {
+		t.Errorf("Wanted 8 chain start validators, received %v", count)
+	}
+	root := web3Service.DepositRoot()
+	if count := testutil.ToFloat64(depositTrieRootGauge.WithLabelValues(fmt.Sprintf("%#x", root))); count != 10 {
+		t.Errorf("Wanted deposit trie root gauge 10, received %v", count)
+	}
+}
@@ -0,0 +1,193 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"golang.org/x/time/rate"
+)
+
+// The backoff after a request is rejected by the provider for exceeding its rate limit
+// doubles with each consecutive rejection, up to the max.
+var (
+	initialRateLimitBackoff = 1 * time.Second
+	maxRateLimitBackoff     = 1 * time.Minute
+)
+
+var (
+	rateLimitedRequestsCount = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_rate_limited_requests",
+		Help: "The number of requests rejected by the proof-of-work chain endpoints for exceeding their rate limit",
+	})
+	requestWaitSeconds = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_request_wait_seconds",
+		Help: "The total time requests to the proof-of-work chain endpoints waited for the request budget",
+	})
+)
+
+// requestLimiter schedules the requests to the proof-of-work chain endpoints within a
+// budget of requests per second, so that hosted providers such as Infura do not throttle
+// the node. Once a request is rejected for exceeding the rate limit of the provider, all
+// requests are held back for a backoff which doubles until a request succeeds.
+type requestLimiter struct {
+	limiter      *rate.Limiter
+	lock         sync.Mutex
+	backoff      time.Duration
+	backoffUntil time.Time
+}
+
+// newRequestLimiter creates a request limiter allowing requestsPerSecond requests per
+// second on average with bursts of up to burst requests. Requests are not limited if
+// requestsPerSecond is 0, but still back off when rejected by the provider.
+func newRequestLimiter(requestsPerSecond float64, burst int) *requestLimiter {
+	limit := rate.Limit(requestsPerSecond)
+	if requestsPerSecond <= 0 {
+		limit = rate.Inf
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+	return &requestLimiter{
+		limiter: rate.NewLimiter(limit, burst),
+	}
+}
+
+// wait blocks until the request fits in the budget and any backoff has elapsed.
+func (r *requestLimiter) wait(ctx context.Context) error {
+	start := time.Now()
+	defer func() {
+		requestWaitSeconds.Add(time.Since(start).Seconds())
+	}()
+
+	r.lock.Lock()
+	backoffUntil := r.backoffUntil
+	r.lock.Unlock()
+	if delay := time.Until(backoffUntil); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return r.limiter.Wait(ctx)
+}
+
+// done records the outcome of a request, backing off if it was rejected for exceeding
+// the rate limit of the provider.
+func (r *requestLimiter) done(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !isRateLimitError(err) {
+		if err == nil {
+			r.backoff = 0
+		}
+		return
+	}
+	rateLimitedRequestsCount.Inc()
+	if r.backoff == 0 {
+		r.backoff = initialRateLimitBackoff
+	} else if r.backoff *= 2; r.backoff > maxRateLimitBackoff {
+		r.backoff = maxRateLimitBackoff
+	}
+	r.backoffUntil = time.Now().Add(r.backoff)
+	log.WithError(err).WithField("backoff", r.backoff).Warn("Request rate limited by the eth1 provider, backing off")
+}
+
+// isRateLimitError returns true if the error is a rejection for exceeding the rate
+// limit, either as an HTTP 429 status or as a JSON-RPC error of the provider.
+func isRateLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "429") ||
+		strings.Contains(msg, "too many requests") ||
+		strings.Contains(msg, "rate limit")
+}
+
+// rateLimitedFetcher is a POWBlockFetcher whose requests are scheduled by a request limiter.
+type rateLimitedFetcher struct {
+	fetcher POWBlockFetcher
+	limiter *requestLimiter
+}
+
+func (f *rateLimitedFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	if err := f.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	blk, err := f.fetcher.BlockByHash(ctx, hash)
+	f.limiter.done(err)
+	return blk, err
+}
+
+func (f *rateLimitedFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	if err := f.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	blk, err := f.fetcher.BlockByNumber(ctx, number)
+	f.limiter.done(err)
+	return blk, err
+}
+
+func (f *rateLimitedFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if err := f.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	header, err := f.fetcher.HeaderByNumber(ctx, number)
+	f.limiter.done(err)
+	return header, err
+}
+
+// rateLimitedFilterer is a bind.ContractFilterer whose log queries are scheduled by a
+// request limiter. Subscriptions are long lived and are not limited.
+type rateLimitedFilterer struct {
+	filterer bind.ContractFilterer
+	limiter  *requestLimiter
+}
+
+func (f *rateLimitedFilterer) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	if err := f.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	logs, err := f.filterer.FilterLogs(ctx, query)
+	f.limiter.done(err)
+	return logs, err
+}
+
+func (f *rateLimitedFilterer) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return f.filterer.SubscribeFilterLogs(ctx, query, ch)
+}
+
+// rateLimitedCaller is a bind.ContractCaller whose calls are scheduled by a request limiter.
+type rateLimitedCaller struct {
+	caller  bind.ContractCaller
+	limiter *requestLimiter
+}
+
+func (c *rateLimitedCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	code, err := c.caller.CodeAt(ctx, contract, blockNumber)
+	c.limiter.done(err)
+	return code, err
+}
+
+func (c *rateLimitedCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	res, err := c.caller.CallContract(ctx, call, blockNumber)
+	c.limiter.done(err)
+	return res, err
+}
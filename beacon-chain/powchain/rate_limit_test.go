@@ -0,0 +1,103 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+type rateLimitedBlockFetcher struct {
+	goodFetcher
+	rejections int
+	requests   int
+}
+
+func (f *rateLimitedBlockFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	f.requests++
+	if f.requests <= f.rejections {
+		return nil, errors.New("429 Too Many Requests")
+	}
+	return f.goodFetcher.BlockByNumber(ctx, number)
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: errors.New("429 Too Many Requests"), want: true},
+		{err: errors.New("daily request count exceeded, request rate limited"), want: true},
+		{err: errors.New("not found"), want: false},
+	}
+	for _, tt := range tests {
+		if got := isRateLimitError(tt.err); got != tt.want {
+			t.Errorf("isRateLimitError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRequestLimiter_LimitsRequestsPerSecond(t *testing.T) {
+	limiter := newRequestLimiter(20, 1)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The first request is sent immediately and the next 4 are spaced by 50ms.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected requests to be spread over at least 150ms, took %v", elapsed)
+	}
+}
+
+func TestRequestLimiter_BacksOffOnRateLimitErrors(t *testing.T) {
+	initialBackoff := initialRateLimitBackoff
+	initialRateLimitBackoff = 20 * time.Millisecond
+	defer func() {
+		initialRateLimitBackoff = initialBackoff
+	}()
+
+	fetcher := &rateLimitedBlockFetcher{rejections: 2}
+	limited := &rateLimitedFetcher{fetcher: fetcher, limiter: newRequestLimiter(0, 1)}
+	ctx := context.Background()
+
+	if _, err := limited.BlockByNumber(ctx, big.NewInt(1)); err == nil {
+		t.Fatal("Expected rate limit error")
+	}
+	if _, err := limited.BlockByNumber(ctx, big.NewInt(1)); err == nil {
+		t.Fatal("Expected rate limit error")
+	}
+	if limited.limiter.backoff != 2*initialRateLimitBackoff {
+		t.Errorf("Expected backoff to double to %v, got %v", 2*initialRateLimitBackoff, limited.limiter.backoff)
+	}
+
+	start := time.Now()
+	if _, err := limited.BlockByNumber(ctx, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < initialRateLimitBackoff {
+		t.Errorf("Expected request to wait for the backoff, took %v", elapsed)
+	}
+	if limited.limiter.backoff != 0 {
+		t.Errorf("Expected backoff to be reset after a successful request, got %v", limited.limiter.backoff)
+	}
+}
+
+func TestRequestLimiter_WaitCanceled(t *testing.T) {
+	limiter := newRequestLimiter(0, 1)
+	limiter.done(errors.New("429 Too Many Requests"))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.wait(ctx); err != context.Canceled {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if _, err := (&rateLimitedFetcher{fetcher: &goodFetcher{}, limiter: limiter}).BlockByHash(ctx, common.Hash{}); err != context.Canceled {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+}
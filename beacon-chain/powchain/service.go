@@ -16,13 +16,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 )
@@ -30,16 +31,23 @@ import (
 var log = logrus.WithField("prefix", "powchain")
 
 var (
-	validDepositsCount = promauto.NewCounter(prometheus.CounterOpts{
+	validDepositsCount = metrics.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_valid_deposits_received",
 		Help: "The number of valid deposits received in the deposit contract",
 	})
-	blockNumberGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	blockNumberGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "powchain_block_number",
 		Help: "The current block number in the proof-of-work chain",
 	})
 )
 
+// The backoff between attempts to resubscribe to eth1 headers doubles after each
+// failed attempt, up to the max.
+var (
+	initialReconnectBackoff = 1 * time.Second
+	maxReconnectBackoff     = 1 * time.Minute
+)
+
 // Reader defines a struct that can fetch latest header events from a web3 endpoint.
 type Reader interface {
 	SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error)
@@ -90,6 +98,8 @@ type Web3Service struct {
 	chainStarted            bool
 	beaconDB                *db.BeaconDB
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
+	lastPersistedIndex      int64 // Keeps track of the last deposit index persisted in the DB.
+	chainStartPersisted     bool
 	isRunning               bool
 	runError                error
 	lastRequestedBlock      *big.Int
@@ -97,20 +107,26 @@ type Web3Service struct {
 	activeValidatorCount    uint64
 	depositedPubkeys        map[[48]byte]uint64
 	eth2GenesisTime         uint64
+	logBatchSize            uint64
 	processingLock          sync.RWMutex
+	supervisor              *supervisor.Supervisor
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	Endpoint        string
-	DepositContract common.Address
-	Client          Client
-	Reader          Reader
-	Logger          bind.ContractFilterer
-	HTTPLogger      bind.ContractFilterer
-	BlockFetcher    POWBlockFetcher
-	ContractBackend bind.ContractBackend
-	BeaconDB        *db.BeaconDB
+	Endpoint          string
+	DepositContract   common.Address
+	Client            Client
+	Reader            Reader
+	Logger            bind.ContractFilterer
+	HTTPLogger        bind.ContractFilterer
+	BlockFetcher      POWBlockFetcher
+	ContractBackend   bind.ContractCaller
+	BeaconDB          *db.BeaconDB
+	LogBatchSize      uint64            // Max number of blocks per deposit log request, defaults to 1000.
+	RequestsPerSecond float64           // Max average number of requests per second to the endpoints, unlimited if 0.
+	RequestBurst      int               // Max number of requests sent at once within the requests per second.
+	RestartPolicy     supervisor.Policy // Restart policy of the service goroutines after a panic.
 }
 
 // NewWeb3Service sets up a new instance with an ethclient when
@@ -123,11 +139,30 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		)
 	}
 
-	depositContractCaller, err := contracts.NewDepositContractCaller(config.DepositContract, config.ContractBackend)
+	limiter := newRequestLimiter(config.RequestsPerSecond, config.RequestBurst)
+	blockFetcher := config.BlockFetcher
+	if blockFetcher != nil {
+		blockFetcher = &rateLimitedFetcher{fetcher: blockFetcher, limiter: limiter}
+	}
+	httpLogger := config.HTTPLogger
+	if httpLogger != nil {
+		httpLogger = &rateLimitedFilterer{filterer: httpLogger, limiter: limiter}
+	}
+	contractBackend := config.ContractBackend
+	if contractBackend != nil {
+		contractBackend = &rateLimitedCaller{caller: contractBackend, limiter: limiter}
+	}
+
+	depositContractCaller, err := contracts.NewDepositContractCaller(config.DepositContract, contractBackend)
 	if err != nil {
 		return nil, fmt.Errorf("could not create deposit contract caller %v", err)
 	}
 
+	logBatchSize := config.LogBatchSize
+	if logBatchSize == 0 {
+		logBatchSize = defaultLogBatchSize
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
 	if err != nil {
@@ -148,15 +183,18 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		depositTrie:             depositTrie,
 		reader:                  config.Reader,
 		logger:                  config.Logger,
-		httpLogger:              config.HTTPLogger,
-		blockFetcher:            config.BlockFetcher,
+		httpLogger:              httpLogger,
+		blockFetcher:            blockFetcher,
 		depositContractCaller:   depositContractCaller,
 		chainStartDeposits:      make([]*ethpb.Deposit, 0),
 		beaconDB:                config.BeaconDB,
 		lastReceivedMerkleIndex: -1,
+		lastPersistedIndex:      -1,
 		lastRequestedBlock:      big.NewInt(0),
 		chainStartETH1Data:      &ethpb.Eth1Data{},
 		depositedPubkeys:        make(map[[48]byte]uint64),
+		logBatchSize:            logBatchSize,
+		supervisor:              supervisor.New(ctx, "powchain", config.RestartPolicy),
 	}, nil
 }
 
@@ -165,7 +203,9 @@ func (w *Web3Service) Start() {
 	log.WithFields(logrus.Fields{
 		"endpoint": w.endpoint,
 	}).Info("Starting service")
-	go w.run(w.ctx.Done())
+	w.supervisor.Go("run", func() {
+		w.run(w.ctx.Done())
+	})
 }
 
 // Stop the web3 service's main event loop and associated goroutines.
@@ -214,6 +254,9 @@ func (w *Web3Service) Status() error {
 	if w.runError != nil {
 		return w.runError
 	}
+	if err := w.supervisor.Status(); err != nil {
+		return err
+	}
 	// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027)
 	// (analyzed the time of the block from 2018-09-01 to 2019-02-13)
 	fiveMinutesTimeout := time.Now().Add(-5 * time.Minute)
@@ -246,6 +289,11 @@ func (w *Web3Service) LatestBlockHash() common.Hash {
 	return w.blockHash
 }
 
+// LatestBlockTime in the ETH1.0 chain.
+func (w *Web3Service) LatestBlockTime() time.Time {
+	return w.blockTime
+}
+
 // Client for interacting with the ETH1.0 chain.
 func (w *Web3Service) Client() Client {
 	return w.client
@@ -258,6 +306,7 @@ func (w *Web3Service) AreAllDepositsProcessed() (bool, error) {
 	defer w.processingLock.RUnlock()
 	countByte, err := w.depositContractCaller.GetDepositCount(&bind.CallOpts{})
 	if err != nil {
+		reportRequestError("GetDepositCount")
 		return false, fmt.Errorf("could not get deposit count %v", err)
 	}
 	count := bytesutil.FromBytes8(countByte)
@@ -273,6 +322,7 @@ func (w *Web3Service) AreAllDepositsProcessed() (bool, error) {
 func (w *Web3Service) initDataFromContract() error {
 	root, err := w.depositContractCaller.GetHashTreeRoot(&bind.CallOpts{})
 	if err != nil {
+		reportRequestError("GetHashTreeRoot")
 		return fmt.Errorf("could not retrieve deposit root %v", err)
 	}
 	w.depositRoot = root[:]
@@ -335,6 +385,7 @@ func (w *Web3Service) run(done <-chan struct{}) {
 
 	headSub, err := w.reader.SubscribeNewHead(w.ctx, w.headerChan)
 	if err != nil {
+		reportRequestError("SubscribeNewHead")
 		log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
 		w.runError = err
 		return
@@ -342,6 +393,7 @@ func (w *Web3Service) run(done <-chan struct{}) {
 
 	header, err := w.blockFetcher.HeaderByNumber(w.ctx, nil)
 	if err != nil {
+		reportRequestError("HeaderByNumber")
 		log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 		w.runError = err
 		return
@@ -357,7 +409,11 @@ func (w *Web3Service) run(done <-chan struct{}) {
 	}
 
 	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
+	defer func() {
+		if headSub != nil {
+			headSub.Unsubscribe()
+		}
+	}()
 	defer ticker.Stop()
 
 	for {
@@ -368,8 +424,15 @@ func (w *Web3Service) run(done <-chan struct{}) {
 			log.Debug("ETH1.0 chain service context closed, exiting goroutine")
 			return
 		case w.runError = <-headSub.Err():
-			log.Debugf("Unsubscribed to head events, exiting goroutine: %v", w.runError)
-			return
+			reportRequestError("SubscribeNewHead")
+			log.Warnf("Subscription to ETH1.0 chain headers dropped, reconnecting: %v", w.runError)
+			headSub = w.resubscribeHeads(done)
+			if headSub == nil {
+				w.isRunning = false
+				w.runError = nil
+				log.Debug("ETH1.0 chain service context closed, exiting goroutine")
+				return
+			}
 		case header, ok := <-w.headerChan:
 			if ok {
 				w.processSubscribedHeaders(header)
@@ -379,3 +442,61 @@ func (w *Web3Service) run(done <-chan struct{}) {
 		}
 	}
 }
+
+// resubscribeHeads subscribes to new ETH1.0 chain headers again after the subscription
+// dropped, retrying with exponential backoff. Once subscribed, the headers the service
+// missed in the meantime are processed, after which the log polling requests the
+// deposit logs of the missed blocks. It returns nil if the service stops first.
+func (w *Web3Service) resubscribeHeads(done <-chan struct{}) ethereum.Subscription {
+	backoff := initialReconnectBackoff
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-time.After(backoff):
+		}
+		headSub, err := w.reader.SubscribeNewHead(w.ctx, w.headerChan)
+		if err != nil {
+			reportRequestError("SubscribeNewHead")
+		} else if err = w.catchUpHeaders(); err != nil {
+			headSub.Unsubscribe()
+		}
+		if err == nil {
+			w.runError = nil
+			log.Info("Reconnected to ETH1.0 chain headers")
+			return headSub
+		}
+		w.runError = err
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+		log.WithError(err).WithField("retryIn", backoff).Error("Could not reconnect to ETH1.0 chain headers")
+	}
+}
+
+// catchUpHeaders processes the headers from the last processed block up to the latest
+// block of the ETH1.0 chain.
+func (w *Web3Service) catchUpHeaders() error {
+	latest, err := w.blockFetcher.HeaderByNumber(w.ctx, nil)
+	if err != nil {
+		reportRequestError("HeaderByNumber")
+		return fmt.Errorf("could not retrieve latest ETH1.0 chain header: %v", err)
+	}
+	if w.blockHeight != nil && latest.Number.Cmp(w.blockHeight) <= 0 {
+		return nil
+	}
+	if w.blockHeight != nil {
+		next := big.NewInt(0).Add(w.blockHeight, big.NewInt(1))
+		for ; next.Cmp(latest.Number) < 0; next.Add(next, big.NewInt(1)) {
+			header, err := w.blockFetcher.HeaderByNumber(w.ctx, next)
+			if err != nil {
+				reportRequestError("HeaderByNumber")
+				return fmt.Errorf("could not retrieve ETH1.0 chain header %d: %v", next, err)
+			}
+			w.processSubscribedHeaders(header)
+		}
+	}
+	w.processSubscribedHeaders(latest)
+	return nil
+}
@@ -311,3 +311,79 @@ func TestHandlePanic_OK(t *testing.T) {
 	web3Service.processSubscribedHeaders(nil)
 	testutil.AssertLogsContain(t, hook, "Panicked when handling data from ETH 1.0 Chain!")
 }
+
+type flakyReader struct {
+	failures int
+}
+
+func (f *flakyReader) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	if f.failures > 0 {
+		f.failures--
+		return nil, errors.New("connection refused")
+	}
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+type numberedFetcher struct {
+	goodFetcher
+	latest int64
+}
+
+func (n *numberedFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		number = big.NewInt(n.latest)
+	}
+	return &gethTypes.Header{Number: number, Time: uint64(number.Int64())}, nil
+}
+
+func TestResubscribeHeads_CatchesUpMissedHeaders(t *testing.T) {
+	initialReconnectBackoff = time.Millisecond
+	defer func() {
+		initialReconnectBackoff = time.Second
+	}()
+
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:     endpoint,
+		Reader:       &flakyReader{failures: 2},
+		BlockFetcher: &numberedFetcher{latest: 15},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.blockHeight = big.NewInt(10)
+
+	headSub := web3Service.resubscribeHeads(web3Service.ctx.Done())
+	if headSub == nil {
+		t.Fatal("Expected to resubscribe to headers")
+	}
+	defer headSub.Unsubscribe()
+	if web3Service.runError != nil {
+		t.Errorf("Expected run error to be cleared, received %v", web3Service.runError)
+	}
+	if web3Service.blockHeight.Int64() != 15 {
+		t.Errorf("Wanted block height 15 after catching up, received %d", web3Service.blockHeight)
+	}
+	for i := int64(11); i <= 15; i++ {
+		exists, _, err := web3Service.blockCache.BlockInfoByHeight(big.NewInt(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !exists {
+			t.Errorf("Expected block %d to be cached after catching up", i)
+		}
+	}
+}
+
+func TestResubscribeHeads_StopsWhenServiceStops(t *testing.T) {
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint: endpoint,
+		Reader:   &badReader{},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.cancel()
+	if headSub := web3Service.resubscribeHeads(web3Service.ctx.Done()); headSub != nil {
+		t.Error("Expected no subscription after the service stopped")
+	}
+}
@@ -0,0 +1,299 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// SimulatedBlockTime is the time between the blocks of the simulated eth1 chain.
+var SimulatedBlockTime = 14 * time.Second
+
+// SimulatedChain is an in-memory eth1 chain for devnets, which lets a beacon node run
+// without an eth1 node. The deposits it is created with are included in its first block
+// and it produces a new empty block every SimulatedBlockTime. Blocks are derived only
+// from the genesis time and the deposits, so that nodes simulating the chain with the
+// same inputs agree on its blocks.
+type SimulatedChain struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	contractAddr common.Address
+	contractABI  abi.ABI
+	genesisTime  uint64
+	headFeed     *event.Feed
+	depositTrie  *trieutil.MerkleTrie
+	depositCount uint64
+	lock         sync.RWMutex
+	blocks       []*gethTypes.Block
+	blocksByHash map[common.Hash]*gethTypes.Block
+	logs         []gethTypes.Log
+}
+
+// NewSimulatedChain creates a simulated eth1 chain whose first block is produced at the
+// genesis time and includes the deposits to the deposit contract address.
+func NewSimulatedChain(ctx context.Context, contractAddr common.Address, genesisTime uint64, deposits []*ethpb.Deposit_Data) (*SimulatedChain, error) {
+	contractABI, err := abi.JSON(strings.NewReader(contracts.DepositContractABI))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse deposit contract abi: %v", err)
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, fmt.Errorf("could not setup deposit trie: %v", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &SimulatedChain{
+		ctx:          ctx,
+		cancel:       cancel,
+		contractAddr: contractAddr,
+		contractABI:  contractABI,
+		genesisTime:  genesisTime,
+		headFeed:     new(event.Feed),
+		depositTrie:  depositTrie,
+		blocksByHash: make(map[common.Hash]*gethTypes.Block),
+	}
+
+	s.addBlock()
+	depositBlock := s.addBlock()
+	for i, data := range deposits {
+		if err := s.addDeposit(depositBlock, uint(i), data); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	s.produceBlocks(time.Now())
+	return s, nil
+}
+
+// Start producing the blocks of the simulated chain.
+func (s *SimulatedChain) Start() {
+	log.WithFields(logrus.Fields{
+		"genesisTime":  s.genesisTime,
+		"depositCount": s.depositCount,
+	}).Info("Starting simulated eth1 chain")
+	go s.run()
+}
+
+// Stop producing blocks.
+func (s *SimulatedChain) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the simulated chain, which is always healthy.
+func (s *SimulatedChain) Status() error {
+	return nil
+}
+
+func (s *SimulatedChain) run() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.produceBlocks(now)
+		}
+	}
+}
+
+// produceBlocks adds the blocks whose time has passed, sending their headers to the
+// subscribers of new heads.
+func (s *SimulatedChain) produceBlocks(now time.Time) {
+	for {
+		s.lock.Lock()
+		next := s.blockTime(uint64(len(s.blocks)))
+		if next > uint64(now.Unix()) {
+			s.lock.Unlock()
+			return
+		}
+		blk := s.addBlock()
+		s.lock.Unlock()
+		s.headFeed.Send(blk.Header())
+	}
+}
+
+func (s *SimulatedChain) blockTime(number uint64) uint64 {
+	return s.genesisTime + number*uint64(SimulatedBlockTime.Seconds())
+}
+
+// addBlock appends the next block to the chain, the lock must be held by the caller
+// once the chain is created.
+func (s *SimulatedChain) addBlock() *gethTypes.Block {
+	number := uint64(len(s.blocks))
+	header := &gethTypes.Header{
+		Number:     big.NewInt(0).SetUint64(number),
+		Time:       s.blockTime(number),
+		Difficulty: big.NewInt(1),
+	}
+	if number > 0 {
+		header.ParentHash = s.blocks[number-1].Hash()
+	}
+	blk := gethTypes.NewBlockWithHeader(header)
+	s.blocks = append(s.blocks, blk)
+	s.blocksByHash[blk.Hash()] = blk
+	return blk
+}
+
+// addDeposit adds the deposit log of the deposit data to the block and the deposit to
+// the trie of the contract.
+func (s *SimulatedChain) addDeposit(blk *gethTypes.Block, logIndex uint, data *ethpb.Deposit_Data) error {
+	index := s.depositCount
+	logData, err := s.contractABI.Events["DepositEvent"].Inputs.Pack(
+		data.PublicKey,
+		data.WithdrawalCredentials,
+		bytesutil.Bytes8(data.Amount),
+		data.Signature,
+		bytesutil.Bytes8(index),
+	)
+	if err != nil {
+		return fmt.Errorf("could not pack deposit log: %v", err)
+	}
+	depositHash, err := hashutil.DepositHash(data)
+	if err != nil {
+		return fmt.Errorf("could not hash deposit data: %v", err)
+	}
+	if err := s.depositTrie.InsertIntoTrie(depositHash[:], int(index)); err != nil {
+		return fmt.Errorf("could not insert deposit into trie: %v", err)
+	}
+	s.logs = append(s.logs, gethTypes.Log{
+		Address:     s.contractAddr,
+		Topics:      []common.Hash{hashutil.HashKeccak256(depositEventSignature)},
+		Data:        logData,
+		BlockNumber: blk.NumberU64(),
+		BlockHash:   blk.Hash(),
+		TxHash:      common.BytesToHash(depositHash[:]),
+		Index:       logIndex,
+	})
+	s.depositCount++
+	return nil
+}
+
+// SubscribeNewHead subscribes to the headers of the blocks produced by the chain.
+func (s *SimulatedChain) SubscribeNewHead(_ context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return s.headFeed.Subscribe(ch), nil
+}
+
+// BlockByHash returns the block with the hash.
+func (s *SimulatedChain) BlockByHash(_ context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	blk, ok := s.blocksByHash[hash]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return blk, nil
+}
+
+// BlockByNumber returns the block at the height, or the latest block if the number is nil.
+func (s *SimulatedChain) BlockByNumber(_ context.Context, number *big.Int) (*gethTypes.Block, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if number == nil {
+		return s.blocks[len(s.blocks)-1], nil
+	}
+	if !number.IsUint64() || number.Uint64() >= uint64(len(s.blocks)) {
+		return nil, ethereum.NotFound
+	}
+	return s.blocks[number.Uint64()], nil
+}
+
+// HeaderByNumber returns the header at the height, or the latest header if the number
+// is nil.
+func (s *SimulatedChain) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	blk, err := s.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return blk.Header(), nil
+}
+
+// FilterLogs returns the deposit logs matching the query.
+func (s *SimulatedChain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	if len(q.Addresses) > 0 && !containsAddress(q.Addresses, s.contractAddr) {
+		return nil, nil
+	}
+	from := uint64(0)
+	if q.FromBlock != nil {
+		from = q.FromBlock.Uint64()
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	to := uint64(len(s.blocks) - 1)
+	if q.ToBlock != nil && q.ToBlock.Uint64() < to {
+		to = q.ToBlock.Uint64()
+	}
+	var logs []gethTypes.Log
+	for _, l := range s.logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+// SubscribeFilterLogs subscribes to new deposit logs. As all deposits are included in
+// the first block of the chain, no log is ever sent.
+func (s *SimulatedChain) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, _ chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+// CodeAt returns a placeholder code for the deposit contract address.
+func (s *SimulatedChain) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	if account != s.contractAddr {
+		return nil, nil
+	}
+	return []byte{0x1}, nil
+}
+
+// CallContract answers the deposit count and deposit root calls to the deposit contract.
+func (s *SimulatedChain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != s.contractAddr {
+		return nil, nil
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for method, result := range map[string]interface{}{
+		"get_deposit_count":  bytesutil.Bytes8(s.depositCount),
+		"get_hash_tree_root": s.depositTrie.HashTreeRoot(),
+	} {
+		input, err := s.contractABI.Pack(method)
+		if err != nil {
+			return nil, fmt.Errorf("could not pack %s call: %v", method, err)
+		}
+		if bytes.Equal(call.Data, input) {
+			return s.contractABI.Methods[method].Outputs.Pack(result)
+		}
+	}
+	return nil, errors.New("unsupported call to the simulated deposit contract")
+}
+
+func containsAddress(addresses []common.Address, addr common.Address) bool {
+	for _, a := range addresses {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
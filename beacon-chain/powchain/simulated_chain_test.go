@@ -0,0 +1,127 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestSimulatedChain_ProducesBlocksAndAnswersContractCalls(t *testing.T) {
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	depositData := make([]*ethpb.Deposit_Data, len(deposits))
+	for i, d := range deposits {
+		depositData[i] = d.Data
+	}
+	contractAddr := common.HexToAddress("0x1")
+	genesisTime := uint64(time.Now().Add(-10 * SimulatedBlockTime).Unix())
+	chain, err := NewSimulatedChain(context.Background(), contractAddr, genesisTime, depositData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head, err := chain.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Number.Uint64() != 10 {
+		t.Errorf("Expected head at block 10, received %d", head.Number.Uint64())
+	}
+	parent, err := chain.BlockByNumber(context.Background(), big.NewInt(0).Sub(head.Number, big.NewInt(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.ParentHash != parent.Hash() {
+		t.Error("Expected head to build on its parent")
+	}
+
+	caller, err := contracts.NewDepositContractCaller(contractAddr, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := caller.GetDepositCount(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytesutil.FromBytes8(count) != uint64(len(deposits)) {
+		t.Errorf("Expected deposit count %d, received %d", len(deposits), bytesutil.FromBytes8(count))
+	}
+	root, err := caller.GetHashTreeRoot(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != chain.depositTrie.HashTreeRoot() {
+		t.Errorf("Expected deposit root %#x, received %#x", chain.depositTrie.HashTreeRoot(), root)
+	}
+}
+
+func TestSimulatedChain_StartsBeaconChain(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+
+	cfg := *params.BeaconConfig()
+	cfg.MinGenesisTime = 0
+	cfg.MinGenesisActiveValidatorCount = uint64(depositsReqForChainStart)
+	params.OverrideBeaconConfig(&cfg)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	deposits, _ := testutil.SetupInitialDeposits(t, uint64(depositsReqForChainStart))
+	depositData := make([]*ethpb.Deposit_Data, len(deposits))
+	for i, d := range deposits {
+		depositData[i] = d.Data
+	}
+	contractAddr := common.HexToAddress("0x1")
+	chain, err := NewSimulatedChain(context.Background(), contractAddr, uint64(time.Now().Unix()), depositData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: contractAddr,
+		Client:          chain,
+		Reader:          chain,
+		Logger:          chain,
+		HTTPLogger:      chain,
+		BlockFetcher:    chain,
+		ContractBackend: chain,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	header, err := chain.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web3Service.blockHeight = header.Number
+
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatal(err)
+	}
+	if !web3Service.HasChainStarted() {
+		t.Fatal("Expected the deposits of the simulated chain to start the beacon chain")
+	}
+	if len(web3Service.ChainStartDeposits()) != depositsReqForChainStart {
+		t.Errorf("Expected %d chain start deposits, received %d", depositsReqForChainStart, len(web3Service.ChainStartDeposits()))
+	}
+	if err := web3Service.initDataFromContract(); err != nil {
+		t.Fatal(err)
+	}
+	trieRoot := web3Service.depositTrie.HashTreeRoot()
+	if !bytes.Equal(web3Service.depositRoot, trieRoot[:]) {
+		t.Errorf("Expected contract deposit root %#x to match the processed deposits %#x", web3Service.depositRoot, trieRoot)
+	}
+}
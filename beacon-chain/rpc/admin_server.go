@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminServer defines a server implementation of the gRPC Admin service, providing
+// RPC endpoints for operators to toggle features of the node at runtime, such as to
+// enable mitigations without restarting it, and to capture profiles of the node.
+type AdminServer struct {
+	dataDir         string
+	profileDuration time.Duration
+}
+
+// ListFeatures returns the features which can be toggled at runtime.
+func (as *AdminServer) ListFeatures(ctx context.Context, _ *ptypes.Empty) (*pb.FeaturesResponse, error) {
+	return toggleableFeatures()
+}
+
+// SetFeature enables or disables a feature which can be toggled at runtime.
+func (as *AdminServer) SetFeature(ctx context.Context, req *pb.SetFeatureRequest) (*pb.FeaturesResponse, error) {
+	if err := featureconfig.SetFeature(req.Name, req.Enabled); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not set feature: %v", err)
+	}
+	return toggleableFeatures()
+}
+
+// CaptureProfiles captures CPU, heap and goroutine profiles of the node to its data
+// directory, profiling the CPU for the requested duration.
+func (as *AdminServer) CaptureProfiles(ctx context.Context, req *pb.CaptureProfilesRequest) (*pb.CaptureProfilesResponse, error) {
+	duration := as.profileDuration
+	if req.DurationSeconds > 0 {
+		duration = time.Duration(req.DurationSeconds) * time.Second
+	}
+	files, err := debug.Handler.CaptureProfiles(ctx, as.dataDir, duration)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not capture profiles: %v", err)
+	}
+	return &pb.CaptureProfilesResponse{Files: files}, nil
+}
+
+func toggleableFeatures() (*pb.FeaturesResponse, error) {
+	names := featureconfig.ToggleableFeatures()
+	res := &pb.FeaturesResponse{
+		Features: make([]*pb.FeaturesResponse_Feature, len(names)),
+	}
+	for i, name := range names {
+		enabled, err := featureconfig.FeatureEnabled(name)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve feature: %v", err)
+		}
+		res.Features[i] = &pb.FeaturesResponse_Feature{
+			Name:    name,
+			Enabled: enabled,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminServer_SetFeature(t *testing.T) {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+	as := &AdminServer{}
+	name := featureconfig.DisableHistoricalStatePruningFlag.Name
+
+	res, err := as.SetFeature(context.Background(), &pb.SetFeatureRequest{Name: name, Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !featureconfig.FeatureConfig().DisableHistoricalStatePruning {
+		t.Error("Expected the feature to be enabled")
+	}
+	if len(res.Features) != 1 || res.Features[0].Name != name || !res.Features[0].Enabled {
+		t.Errorf("Unexpected features %v", res.Features)
+	}
+
+	res, err = as.ListFeatures(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Features) != 1 || !res.Features[0].Enabled {
+		t.Errorf("Unexpected features %v", res.Features)
+	}
+}
+
+func TestAdminServer_SetFeatureNotToggleable(t *testing.T) {
+	as := &AdminServer{}
+	_, err := as.SetFeature(context.Background(), &pb.SetFeatureRequest{
+		Name:    featureconfig.NoGenesisDelayFlag.Name,
+		Enabled: true,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error, received %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -17,6 +18,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AttesterServer defines a server implementation of the gRPC Attester service,
@@ -26,38 +30,46 @@ type AttesterServer struct {
 	beaconDB         *db.BeaconDB
 	operationService operationService
 	cache            *cache.AttestationCache
+	syncReporter     sync.StateReporter
 }
 
 // SubmitAttestation is a function called by an attester in a sharding validator to vote
 // on a block via an attestation object as defined in the Ethereum Serenity specification.
 func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Attestation) (*pb.AttestResponse, error) {
+	if err := checkSynced(as.syncReporter); err != nil {
+		return nil, err
+	}
 	h, err := hashutil.HashProto(att)
 	if err != nil {
-		return nil, fmt.Errorf("could not hash attestation: %v", err)
-	}
-
-	if err := as.operationService.HandleAttestations(ctx, att); err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "could not hash attestation: %v", err)
 	}
 
 	headState, err := as.beaconDB.HeadState(ctx)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
 	}
 	slot, err := helpers.AttestationDataSlot(headState, att.Data)
 	if err != nil {
-		return nil, fmt.Errorf("could not get attestation slot: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "could not get attestation slot: %v", err)
+	}
+	if err := checkNotFutureSlot(headState, slot); err != nil {
+		return nil, err
 	}
 
 	// Update attestation target for RPC server to run necessary fork choice.
 	// We need to retrieve the head block to get its parent root.
 	head, err := as.beaconDB.Block(bytesutil.ToBytes32(att.Data.BeaconBlockRoot))
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "could not retrieve head block: %v", err)
 	}
 	// If the head block is nil, we can't save the attestation target.
 	if head == nil {
-		return nil, fmt.Errorf("could not find head %#x in db", bytesutil.Trunc(att.Data.BeaconBlockRoot))
+		return nil, rpcerror.Errorf(codes.FailedPrecondition, rpcerror.ReasonUnknownBlockRoot,
+			"could not find head %#x in db", bytesutil.Trunc(att.Data.BeaconBlockRoot))
+	}
+
+	if err := as.operationService.HandleAttestations(ctx, att); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not handle attestation: %v", err)
 	}
 	attTarget := &pbp2p.AttestationTarget{
 		Slot:            slot,
@@ -78,6 +90,9 @@ func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Atte
 // RequestAttestation requests that the beacon node produce an IndexedAttestation,
 // with a blank signature field, which the validator will then sign.
 func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.AttestationRequest) (*ethpb.AttestationData, error) {
+	if err := checkSynced(as.syncReporter); err != nil {
+		return nil, err
+	}
 	res, err := as.cache.Get(ctx, req)
 	if err != nil {
 		return nil, err
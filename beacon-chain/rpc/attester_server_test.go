@@ -14,6 +14,9 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockBroadcaster struct{}
@@ -78,6 +81,49 @@ func TestSubmitAttestation_OK(t *testing.T) {
 	}
 }
 
+func TestSubmitAttestation_UnknownBlockRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	attesterServer := &AttesterServer{
+		operationService: &mockOperationService{},
+		p2p:              &mockBroadcaster{},
+		beaconDB:         db,
+		cache:            cache.NewAttestationCache(),
+	}
+	validators := make([]*ethpb.Validator, params.BeaconConfig().MinGenesisActiveValidatorCount/16)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	state := &pbp2p.BeaconState{
+		Slot:             params.BeaconConfig().SlotsPerEpoch + 1,
+		Validators:       validators,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+	if err := db.SaveState(context.Background(), state); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte("unknown"),
+			Crosslink:       &ethpb.Crosslink{},
+			Source:          &ethpb.Checkpoint{},
+			Target:          &ethpb.Checkpoint{},
+		},
+	}
+	_, err := attesterServer.SubmitAttestation(context.Background(), req)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition error, received %v", err)
+	}
+	if reason := rpcerror.ReasonOf(err); reason != rpcerror.ReasonUnknownBlockRoot {
+		t.Errorf("Expected reason %q, received %q", rpcerror.ReasonUnknownBlockRoot, reason)
+	}
+}
+
 func TestRequestAttestation_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
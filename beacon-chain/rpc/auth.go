@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationKey is the metadata key under which clients send their bearer token.
+const authorizationKey = "authorization"
+
+// authenticatedServices are the gRPC services used by validator clients and the admin
+// service, which require authentication when the node is configured with an auth token.
+// The remaining services only expose read access to the chain and are always public.
+var authenticatedServices = []string{
+	"/ethereum.beacon.rpc.v1.BeaconService/",
+	"/ethereum.beacon.rpc.v1.AttesterService/",
+	"/ethereum.beacon.rpc.v1.ProposerService/",
+	"/ethereum.beacon.rpc.v1.ValidatorService/",
+	"/ethereum.beacon.rpc.v1.AdminService/",
+}
+
+// authorize checks that a call to the given full method name carries the bearer
+// token if the method belongs to an authenticated service.
+func authorize(ctx context.Context, fullMethod string, token string) error {
+	if token == "" || !isAuthenticatedMethod(fullMethod) {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing authorization token")
+	}
+	for _, v := range md.Get(authorizationKey) {
+		received := strings.TrimPrefix(v, "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(received), []byte(token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid authorization token")
+}
+
+func isAuthenticatedMethod(fullMethod string) bool {
+	for _, prefix := range authenticatedServices {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authUnaryInterceptor rejects unary calls to authenticated services which do not carry
+// the bearer token.
+func authUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// authStreamInterceptor rejects streams to authenticated services which do not carry the
+// bearer token.
+func authStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod, token); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// serverCredentials loads the TLS certificate and key of the server. If a client CA
+// certificate is given, clients are required to present a certificate signed by it.
+func serverCredentials(certFile string, keyFile string, clientCAFile string) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load TLS keys: %v", err)
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+	if clientCAFile != "" {
+		caCert, err := ioutil.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client CA certificate: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("could not parse client CA certificate")
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(cfg), nil
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorize(t *testing.T) {
+	const proposeMethod = "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock"
+	const chainHeadMethod = "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead"
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, token))
+	}
+
+	tests := []struct {
+		ctx    context.Context
+		method string
+		token  string
+		code   codes.Code
+	}{
+		{ctx: context.Background(), method: proposeMethod, token: "", code: codes.OK},
+		{ctx: context.Background(), method: proposeMethod, token: "secret", code: codes.Unauthenticated},
+		{ctx: withToken("Bearer wrong"), method: proposeMethod, token: "secret", code: codes.Unauthenticated},
+		{ctx: withToken("Bearer secret"), method: proposeMethod, token: "secret", code: codes.OK},
+		{ctx: context.Background(), method: chainHeadMethod, token: "secret", code: codes.OK},
+	}
+	for i, tt := range tests {
+		if code := status.Code(authorize(tt.ctx, tt.method, tt.token)); code != tt.code {
+			t.Errorf("Test %d: expected code %v, received %v", i, tt.code, code)
+		}
+	}
+}
+
+func TestAuthUnaryInterceptor_RejectsBeforeHandler(t *testing.T) {
+	interceptor := authUnaryInterceptor("secret")
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation"}
+	if _, err := interceptor(context.Background(), nil, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Expected unauthenticated error, received %v", err)
+	}
+	if called {
+		t.Error("Expected handler not to be called for an unauthenticated request")
+	}
+}
@@ -2,11 +2,18 @@ package rpc
 
 import (
 	"context"
-	"strconv"
+	"errors"
+	"fmt"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,7 +23,10 @@ import (
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
 type BeaconChainServer struct {
-	beaconDB *db.BeaconDB
+	ctx          context.Context
+	beaconDB     *db.BeaconDB
+	chainService chainService
+	pool         operationService
 }
 
 // ListAttestations retrieves attestations by block root, slot, or epoch.
@@ -45,7 +55,33 @@ func (bs *BeaconChainServer) AttestationPool(
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
-// ListBlocks retrieves blocks by root, slot, or epoch.
+// StreamAttestations sends every attestation accepted into the operations pool to the
+// client as it arrives, whether it was received from gossip or included in a processed
+// block. The same attestation may therefore be sent more than once. A client too slow to
+// keep up misses the oldest attestations rather than holding back the pool.
+func (bs *BeaconChainServer) StreamAttestations(
+	_ *ptypes.Empty, stream ethpb.BeaconChain_StreamAttestationsServer,
+) error {
+	atts := make(chan *ethpb.Attestation)
+	sub := bs.pool.AcceptedAttFeed().SubscribeBuffered(atts, params.BeaconConfig().DefaultBufferSize, event.DropOldest)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case att := <-atts:
+			if err := stream.Send(att); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send attestation over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream context closed, exiting goroutine")
+		case <-bs.ctx.Done():
+			return status.Error(codes.Canceled, "rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// ListBlocks retrieves blocks by root, parent root, slot, slot range, or epoch.
 //
 // The server may return multiple blocks in the case that a slot or epoch is
 // provided as the filter criteria. The server may return an empty list when
@@ -54,41 +90,355 @@ func (bs *BeaconChainServer) AttestationPool(
 func (bs *BeaconChainServer) ListBlocks(
 	ctx context.Context, req *ethpb.ListBlocksRequest,
 ) (*ethpb.ListBlocksResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	var blocks []*ethpb.BeaconBlock
+	var err error
+	switch q := req.QueryFilter.(type) {
+	case *ethpb.ListBlocksRequest_Root:
+		block, err := bs.beaconDB.Block(bytesutil.ToBytes32(q.Root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block: %v", err)
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	case *ethpb.ListBlocksRequest_Slot:
+		blocks, err = bs.blocksInSlotRange(ctx, q.Slot, q.Slot)
+	case *ethpb.ListBlocksRequest_Epoch:
+		startSlot := helpers.StartSlot(q.Epoch)
+		blocks, err = bs.blocksInSlotRange(ctx, startSlot, startSlot+params.BeaconConfig().SlotsPerEpoch-1)
+	case *ethpb.ListBlocksRequest_SlotRange:
+		if q.SlotRange == nil || q.SlotRange.EndSlot < q.SlotRange.StartSlot {
+			return nil, status.Error(codes.InvalidArgument, "slot range end must not be before its start")
+		}
+		blocks, err = bs.blocksInSlotRange(ctx, q.SlotRange.StartSlot, q.SlotRange.EndSlot)
+	case *ethpb.ListBlocksRequest_ParentRoot:
+		blocks, err = bs.childBlocks(ctx, bytesutil.ToBytes32(q.ParentRoot))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "must specify a filter criteria for fetching blocks")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve blocks: %v", err)
+	}
+
+	totalSize := len(blocks)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]*ethpb.BeaconBlockContainer, 0, end-start)
+	for _, block := range blocks[start:end] {
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not determine block root: %v", err)
+		}
+		canonical, err := bs.isCanonicalBlock(ctx, block.Slot, root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not determine if block is canonical: %v", err)
+		}
+		containers = append(containers, &ethpb.BeaconBlockContainer{
+			Block:     block,
+			BlockRoot: root[:],
+			Canonical: canonical,
+		})
+	}
+
+	return &ethpb.ListBlocksResponse{
+		BlockContainers: containers,
+		TotalSize:       int32(totalSize),
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+// blocksInSlotRange returns every block in the DB from the start slot up to and including
+// the end slot, ordered by slot. Slots past the highest block seen are skipped.
+func (bs *BeaconChainServer) blocksInSlotRange(ctx context.Context, startSlot uint64, endSlot uint64) ([]*ethpb.BeaconBlock, error) {
+	if highest := bs.beaconDB.HighestBlockSlot(); endSlot > highest {
+		endSlot = highest
+	}
+	var blocks []*ethpb.BeaconBlock
+	for slot := startSlot; slot <= endSlot; slot++ {
+		slotBlocks, err := bs.beaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, slotBlocks...)
+		if slot == endSlot {
+			break
+		}
+	}
+	return blocks, nil
+}
+
+// childBlocks returns the blocks in the DB whose parent is the given block root, ordered
+// by slot, which are read from the children index of the DB.
+func (bs *BeaconChainServer) childBlocks(ctx context.Context, parentRoot [32]byte) ([]*ethpb.BeaconBlock, error) {
+	roots, err := bs.beaconDB.ChildrenOfBlock(parentRoot)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]*ethpb.BeaconBlock, 0, len(roots))
+	for _, root := range roots {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		block, err := bs.beaconDB.Block(root)
+		if err != nil {
+			return nil, err
+		}
+		if block != nil {
+			children = append(children, block)
+		}
+	}
+	return children, nil
+}
+
+// isCanonicalBlock returns true if the block with the given root is the block recorded
+// for its slot on the node's canonical chain.
+func (bs *BeaconChainServer) isCanonicalBlock(ctx context.Context, slot uint64, root [32]byte) (bool, error) {
+	canonical, err := bs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+	if err != nil {
+		return false, err
+	}
+	if canonical == nil {
+		return false, nil
+	}
+	canonicalRoot, err := ssz.SigningRoot(canonical)
+	if err != nil {
+		return false, err
+	}
+	return canonicalRoot == root, nil
 }
 
 // GetChainHead retrieves information about the head of the beacon chain from
 // the view of the beacon chain node.
 //
 // This includes the head block slot and root as well as information about
-// the most recent finalized and justified slots.
+// the most recent finalized and justified checkpoints.
 func (bs *BeaconChainServer) GetChainHead(
 	ctx context.Context, _ *ptypes.Empty,
 ) (*ethpb.ChainHead, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	head, err := bs.chainHead(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve chain head: %v", err)
+	}
+	return head, nil
+}
+
+// StreamBlocks sends every new head block of the canonical chain to the client as fork
+// choice selects it. A client too slow to keep up misses the oldest head blocks rather
+// than holding back fork choice.
+func (bs *BeaconChainServer) StreamBlocks(_ *ptypes.Empty, stream ethpb.BeaconChain_StreamBlocksServer) error {
+	heads := make(chan *ethpb.BeaconBlock)
+	sub := bs.chainService.HeadUpdatedFeed().SubscribeBuffered(heads, params.BeaconConfig().DefaultBufferSize, event.DropOldest)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case head := <-heads:
+			if err := stream.Send(head); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send block over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream context closed, exiting goroutine")
+		case <-bs.ctx.Done():
+			return status.Error(codes.Canceled, "rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// StreamChainHead sends the chain head information to the client whenever fork choice
+// updates the head of the chain. The head updates are received apart from the stream, so
+// that a client reading slowly never blocks fork choice: the updates received while a
+// chain head is being sent are coalesced, and the client receives the latest head once.
+func (bs *BeaconChainServer) StreamChainHead(_ *ptypes.Empty, stream ethpb.BeaconChain_StreamChainHeadServer) error {
+	heads := make(chan *ethpb.BeaconBlock)
+	sub := bs.chainService.HeadUpdatedFeed().SubscribeBuffered(heads, 1, event.DropOldest)
+	defer sub.Unsubscribe()
+
+	updated := make(chan struct{}, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-heads:
+				select {
+				case updated <- struct{}{}:
+				default:
+					// An update is already pending.
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-updated:
+			head, err := bs.chainHead(stream.Context())
+			if err != nil {
+				return status.Errorf(codes.Internal, "could not retrieve chain head: %v", err)
+			}
+			if err := stream.Send(head); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send chain head over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream context closed, exiting goroutine")
+		case <-bs.ctx.Done():
+			return status.Error(codes.Canceled, "rpc context closed, exiting goroutine")
+		}
+	}
 }
 
-// ListValidatorBalances retrieves the validator balances for a given set of public key at
-// a specific epoch in time.
+// StreamValidatorRegistryChanges sends the changes of the validator registry to the client
+// whenever the head of the chain enters a new epoch. The changes are relative to the head
+// state of the previous changes sent, or to the head state when the stream started, so
+// that a client misses no change even if the head skips epochs. A reorg to an earlier
+// epoch sends nothing until the head is past the epoch of the previous changes again.
+func (bs *BeaconChainServer) StreamValidatorRegistryChanges(
+	req *ethpb.StreamValidatorRegistryChangesRequest, stream ethpb.BeaconChain_StreamValidatorRegistryChangesServer,
+) error {
+	tracked := make(map[[48]byte]bool, len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		tracked[bytesutil.ToBytes48(pubKey)] = true
+	}
+	// The head state is read before subscribing, as the changes are relative to it.
+	prevState, err := bs.beaconDB.HeadState(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+
+	// Only the latest head update matters, as the changes are read from the head state.
+	heads := make(chan *ethpb.BeaconBlock)
+	sub := bs.chainService.HeadUpdatedFeed().SubscribeBuffered(heads, 1, event.DropOldest)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-heads:
+			headState, err := bs.beaconDB.HeadState(stream.Context())
+			if err != nil {
+				return status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+			}
+			if headState == nil {
+				continue
+			}
+			if prevState == nil {
+				prevState = headState
+				continue
+			}
+			if helpers.CurrentEpoch(headState) <= helpers.CurrentEpoch(prevState) {
+				continue
+			}
+			delta := validators.RegistryDelta(prevState, headState)
+			if len(tracked) > 0 {
+				changes := make([]*ethpb.ValidatorRegistryChanges_Change, 0, len(tracked))
+				for _, change := range delta.Changes {
+					if tracked[bytesutil.ToBytes48(change.PublicKey)] {
+						changes = append(changes, change)
+					}
+				}
+				delta.Changes = changes
+			}
+			if err := stream.Send(delta); err != nil {
+				return status.Errorf(codes.Unavailable, "could not send registry changes over stream: %v", err)
+			}
+			prevState = headState
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "subscriber closed, exiting goroutine")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream context closed, exiting goroutine")
+		case <-bs.ctx.Done():
+			return status.Error(codes.Canceled, "rpc context closed, exiting goroutine")
+		}
+	}
+}
+
+// chainHead builds the chain head information from the head block and the finalized
+// and justified checkpoints of the head state.
+func (bs *BeaconChainServer) chainHead(ctx context.Context) (*ethpb.ChainHead, error) {
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head block: %v", err)
+	}
+	if head == nil {
+		return nil, errors.New("no head block found")
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash head block: %v", err)
+	}
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, errors.New("no head state found")
+	}
+	finalized := headState.FinalizedCheckpoint
+	justified := headState.CurrentJustifiedCheckpoint
+	prevJustified := headState.PreviousJustifiedCheckpoint
+	return &ethpb.ChainHead{
+		BlockRoot:                  headRoot[:],
+		BlockSlot:                  head.Slot,
+		FinalizedSlot:              helpers.StartSlot(finalized.Epoch),
+		FinalizedBlockRoot:         finalized.Root,
+		JustifiedSlot:              helpers.StartSlot(justified.Epoch),
+		JustifiedBlockRoot:         justified.Root,
+		PreviousJustifiedSlot:      helpers.StartSlot(prevJustified.Epoch),
+		PreviousJustifiedBlockRoot: prevJustified.Root,
+		FinalizedEpoch:             finalized.Epoch,
+		JustifiedEpoch:             justified.Epoch,
+		PreviousJustifiedEpoch:     prevJustified.Epoch,
+	}, nil
+}
+
+// ListValidatorBalances retrieves the validator balances for a given set of public keys
+// or indices at a specific epoch. When no filter is given, the balances of every
+// validator are returned.
 //
-// TODO(#3045): Implement balances for a specific epoch. Current implementation returns latest balances,
-// this is blocked by DB refactor.
+// Omitting the epoch, or requesting the current epoch, returns the balances of the head
+// state. Balances of past epochs are served from the balances archived at the start of
+// every epoch, which are only retained by nodes running in archive mode. The response is
+// paginated by validator index.
 func (bs *BeaconChainServer) ListValidatorBalances(
 	ctx context.Context,
 	req *ethpb.GetValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
 
-	res := make([]*ethpb.ValidatorBalances_Balance, 0, len(req.PublicKeys)+len(req.Indices))
-	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
-
-	balances, err := bs.beaconDB.Balances(ctx)
+	headState, err := bs.beaconDB.HeadState(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validator balances: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
 	}
-	validators, err := bs.beaconDB.Validators(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validators: %v", err)
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "no head state found")
 	}
 
+	currentEpoch := helpers.CurrentEpoch(headState)
+	epoch := currentEpoch
+	balances := headState.Balances
+	if req.Epoch != 0 && req.Epoch != currentEpoch {
+		if req.Epoch > currentEpoch {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve balances for future epoch %d, current epoch %d",
+				req.Epoch, currentEpoch)
+		}
+		balances, err = bs.beaconDB.ArchivedBalances(ctx, req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve archived balances: %v", err)
+		}
+		if balances == nil {
+			return nil, status.Errorf(codes.NotFound, "no balances archived for epoch %d, which requires --archive", req.Epoch)
+		}
+		epoch = req.Epoch
+	}
+	validators := headState.Validators
+
+	res := make([]*ethpb.ValidatorBalances_Balance, 0, len(req.PublicKeys)+len(req.Indices))
+	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
+
 	for _, pubKey := range req.PublicKeys {
 		index, err := bs.beaconDB.ValidatorIndex(pubKey)
 		if err != nil {
@@ -115,6 +465,7 @@ func (bs *BeaconChainServer) ListValidatorBalances(
 		}
 
 		if !filtered[index] {
+			filtered[index] = true
 			res = append(res, &ethpb.ValidatorBalances_Balance{
 				PublicKey: validators[index].PublicKey,
 				Index:     index,
@@ -122,61 +473,139 @@ func (bs *BeaconChainServer) ListValidatorBalances(
 			})
 		}
 	}
-	return &ethpb.ValidatorBalances{Balances: res}, nil
+
+	if len(req.PublicKeys) == 0 && len(req.Indices) == 0 {
+		for i, balance := range balances {
+			res = append(res, &ethpb.ValidatorBalances_Balance{
+				PublicKey: validators[i].PublicKey,
+				Index:     uint64(i),
+				Balance:   balance,
+			})
+		}
+	}
+
+	totalSize := len(res)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.ValidatorBalances{
+		Epoch:         epoch,
+		Balances:      res[start:end],
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // GetValidators retrieves the current list of active validators with an optional historical epoch flag to
 // to retrieve validator set in time.
 //
-// TODO(#3045): Implement validator set for a specific epoch. Current implementation returns latest set,
-// this is blocked by DB refactor.
+// The validator set of a past epoch is served from the state archived at the start of
+// the epoch, which is only retained by nodes running in archive mode.
 func (bs *BeaconChainServer) GetValidators(
 	ctx context.Context,
 	req *ethpb.GetValidatorsRequest) (*ethpb.Validators, error) {
 
-	validators, err := bs.beaconDB.Validators(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validators: %v", err)
+	var epoch uint64
+	var validators []*ethpb.Validator
+	if q, ok := req.QueryFilter.(*ethpb.GetValidatorsRequest_Epoch); ok && q.Epoch != 0 {
+		archived, err := bs.beaconDB.ArchivedState(ctx, q.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve archived state: %v", err)
+		}
+		if archived == nil {
+			return nil, status.Errorf(codes.NotFound, "no state archived for epoch %d, which requires --archive", q.Epoch)
+		}
+		epoch = q.Epoch
+		validators = archived.Validators
+	} else {
+		var err error
+		validators, err = bs.beaconDB.Validators(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve validators: %v", err)
+		}
 	}
 
-	if req.PageToken == "" {
-		req.PageToken = "0"
-	}
-	if req.PageSize == 0 {
-		req.PageSize = int32(params.BeaconConfig().DefaultPageSize)
+	totalSize := len(validators)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
 	}
 
-	pageSize := int(req.PageSize)
-	// Input page size can't be greater than MaxPageSize.
-	if pageSize > params.BeaconConfig().MaxPageSize {
-		pageSize = params.BeaconConfig().MaxPageSize
+	res := &ethpb.Validators{
+		Epoch:         epoch,
+		Validators:    validators[start:end],
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
 	}
+	return res, nil
+}
 
-	pageToken, err := strconv.Atoi(req.PageToken)
+// ListBeaconCommittees retrieves a page of the crosslink committees of a given epoch,
+// along with the slot and shard of every committee. Committees are computed from the head
+// state, which makes use of the shuffled indices cache, unless the node archived the
+// committees of the past epoch in archive mode.
+func (bs *BeaconChainServer) ListBeaconCommittees(
+	ctx context.Context, req *ethpb.ListCommitteesRequest,
+) (*ethpb.BeaconCommittees, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "could not convert page token: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "no head state found")
 	}
 
-	// Start page can not be greater than validator size.
-	start := pageToken * pageSize
-	totalSize := len(validators)
-	if start >= totalSize {
-		return nil, status.Errorf(codes.InvalidArgument, "page start %d >= validator list %d",
-			start, totalSize)
+	epoch := req.Epoch
+	if epoch == 0 {
+		epoch = helpers.CurrentEpoch(headState)
+	}
+	if epoch > helpers.NextEpoch(headState) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve committees for epoch %d, next epoch %d",
+			epoch, helpers.NextEpoch(headState))
 	}
 
-	// End page can not go out of bound.
-	end := start + pageSize
-	if end > totalSize {
-		end = totalSize
+	if epoch < helpers.CurrentEpoch(headState) {
+		archived, err := bs.beaconDB.ArchivedCommittees(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve archived committees: %v", err)
+		}
+		if archived != nil {
+			return paginateCommittees(archived, req)
+		}
 	}
 
-	res := &ethpb.Validators{
-		Validators:    validators[start:end],
-		TotalSize:     int32(totalSize),
-		NextPageToken: strconv.Itoa(pageToken + 1),
+	activeCount, err := helpers.ActiveValidatorCount(headState, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve active validator count: %v", err)
 	}
-	return res, nil
+	committees, err := helpers.EpochCommittees(headState, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve committees: %v", err)
+	}
+
+	return paginateCommittees(&ethpb.BeaconCommittees{
+		Epoch:                epoch,
+		Committees:           committees,
+		ActiveValidatorCount: activeCount,
+	}, req)
+}
+
+// paginateCommittees returns the page of the committees of an epoch selected by the request.
+func paginateCommittees(committees *ethpb.BeaconCommittees, req *ethpb.ListCommitteesRequest) (*ethpb.BeaconCommittees, error) {
+	totalSize := len(committees.Committees)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.BeaconCommittees{
+		Epoch:                committees.Epoch,
+		Committees:           committees.Committees[start:end],
+		ActiveValidatorCount: committees.ActiveValidatorCount,
+		NextPageToken:        nextPageToken,
+		TotalSize:            int32(totalSize),
+	}, nil
 }
 
 // GetValidatorActiveSetChanges retrieves the active set changes for a given epoch.
@@ -199,11 +628,96 @@ func (bs *BeaconChainServer) GetValidatorQueue(
 // ListValidatorAssignments retrieves the validator assignments for a given epoch.
 //
 // This request may specify optional validator indices or public keys to
-// filter validator assignments.
+// filter validator assignments. Assignments of the current and next epochs are computed
+// from the head state, those of past epochs are served from the committees and proposers
+// archived by nodes running in archive mode.
 func (bs *BeaconChainServer) ListValidatorAssignments(
 	ctx context.Context, req *ethpb.ListValidatorAssignmentsRequest,
 ) (*ethpb.ValidatorAssignments, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "no head state found")
+	}
+
+	var committees []*ethpb.BeaconCommittees_CommitteeItem
+	var proposers []uint64
+	currentEpoch := helpers.CurrentEpoch(headState)
+	switch {
+	case req.Epoch > helpers.NextEpoch(headState):
+		return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve assignments for epoch %d, next epoch %d",
+			req.Epoch, helpers.NextEpoch(headState))
+	case req.Epoch < currentEpoch:
+		archived, err := bs.beaconDB.ArchivedCommittees(ctx, req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve archived committees: %v", err)
+		}
+		proposers, err = bs.beaconDB.ArchivedProposers(ctx, req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve archived proposers: %v", err)
+		}
+		if archived == nil || proposers == nil {
+			return nil, status.Errorf(codes.NotFound, "no assignments archived for epoch %d, which requires --archive", req.Epoch)
+		}
+		committees = archived.Committees
+	default:
+		committees, err = helpers.EpochCommittees(headState, req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve committees: %v", err)
+		}
+		proposers, err = helpers.EpochProposers(headState, req.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve proposers: %v", err)
+		}
+	}
+
+	filtered := make(map[uint64]bool, len(req.PublicKeys)+len(req.Indices))
+	for _, pubKey := range req.PublicKeys {
+		index, err := bs.beaconDB.ValidatorIndex(pubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve validator index: %v", err)
+		}
+		filtered[index] = true
+	}
+	for _, index := range req.Indices {
+		filtered[index] = true
+	}
+
+	startSlot := helpers.StartSlot(req.Epoch)
+	var assignments []*ethpb.ValidatorAssignments_CommitteeAssignment
+	for _, committee := range committees {
+		for _, index := range committee.ValidatorIndices {
+			if len(filtered) > 0 && !filtered[index] {
+				continue
+			}
+			if int(index) >= len(headState.Validators) {
+				return nil, status.Errorf(codes.Internal, "validator index %d >= validator count %d",
+					index, len(headState.Validators))
+			}
+			assignments = append(assignments, &ethpb.ValidatorAssignments_CommitteeAssignment{
+				CrosslinkCommittees: committee.ValidatorIndices,
+				Shard:               committee.Shard,
+				Slot:                committee.Slot,
+				Proposer:            proposers[committee.Slot-startSlot] == index,
+				PublicKey:           headState.Validators[index].PublicKey,
+			})
+		}
+	}
+
+	totalSize := len(assignments)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.ValidatorAssignments{
+		Epoch:         req.Epoch,
+		Assignments:   assignments[start:end],
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // GetValidatorParticipation retrieves the validator participation information for a given epoch.
@@ -213,5 +727,130 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 func (bs *BeaconChainServer) GetValidatorParticipation(
 	ctx context.Context, req *ethpb.GetValidatorParticipationRequest,
 ) (*ethpb.ValidatorParticipation, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "no head state found")
+	}
+
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.Epoch >= currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve participation for epoch %d before it ended, current epoch %d",
+			req.Epoch, currentEpoch)
+	}
+	participation, err := bs.beaconDB.ArchivedParticipation(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve archived participation: %v", err)
+	}
+	if participation == nil {
+		return nil, status.Errorf(codes.NotFound, "no participation archived for epoch %d", req.Epoch)
+	}
+	participation.Finalized = req.Epoch <= headState.FinalizedCheckpoint.Epoch
+	return participation, nil
+}
+
+// ListValidatorRewards retrieves the rewards and penalties validators received in a
+// given epoch, computed as the change of their archived balances over the epoch.
+//
+// This request may specify optional validator indices or public keys to filter the
+// rewards, otherwise the rewards of all validators are returned.
+func (bs *BeaconChainServer) ListValidatorRewards(
+	ctx context.Context, req *ethpb.ListValidatorRewardsRequest,
+) (*ethpb.ValidatorRewards, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Internal, "no head state found")
+	}
+
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.Epoch >= currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve rewards for epoch %d before it ended, current epoch %d",
+			req.Epoch, currentEpoch)
+	}
+	startBalances, err := bs.beaconDB.ArchivedBalances(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve archived balances: %v", err)
+	}
+	endBalances, err := bs.beaconDB.ArchivedBalances(ctx, req.Epoch+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve archived balances: %v", err)
+	}
+	if startBalances == nil || endBalances == nil {
+		return nil, status.Errorf(codes.NotFound, "no balances archived for epoch %d, which requires --archive", req.Epoch)
+	}
+	validators := headState.Validators
+
+	// Validators which were added to the registry during the epoch have no starting
+	// balance, so their deposit is not counted as a reward.
+	reward := func(index uint64) int64 {
+		if int(index) >= len(startBalances) {
+			return 0
+		}
+		return int64(endBalances[index]) - int64(startBalances[index])
+	}
+
+	res := make([]*ethpb.ValidatorRewards_Reward, 0, len(req.PublicKeys)+len(req.Indices))
+	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
+
+	for _, pubKey := range req.PublicKeys {
+		index, err := bs.beaconDB.ValidatorIndex(pubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve validator index: %v", err)
+		}
+		if int(index) >= len(endBalances) {
+			return nil, status.Errorf(codes.InvalidArgument, "validator index %d >= balance list %d",
+				index, len(endBalances))
+		}
+		if !filtered[index] {
+			filtered[index] = true
+			res = append(res, &ethpb.ValidatorRewards_Reward{
+				PublicKey: pubKey,
+				Index:     index,
+				Reward:    reward(index),
+			})
+		}
+	}
+
+	for _, index := range req.Indices {
+		if int(index) >= len(endBalances) {
+			return nil, status.Errorf(codes.InvalidArgument, "validator index %d >= balance list %d",
+				index, len(endBalances))
+		}
+		if !filtered[index] {
+			filtered[index] = true
+			res = append(res, &ethpb.ValidatorRewards_Reward{
+				PublicKey: validators[index].PublicKey,
+				Index:     index,
+				Reward:    reward(index),
+			})
+		}
+	}
+
+	if len(req.PublicKeys) == 0 && len(req.Indices) == 0 {
+		for i := range endBalances {
+			res = append(res, &ethpb.ValidatorRewards_Reward{
+				PublicKey: validators[i].PublicKey,
+				Index:     uint64(i),
+				Reward:    reward(uint64(i)),
+			})
+		}
+	}
+
+	totalSize := len(res)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.ValidatorRewards{
+		Epoch:         req.Epoch,
+		Rewards:       res[start:end],
+		TotalSize:     int32(totalSize),
+		NextPageToken: nextPageToken,
+	}, nil
 }
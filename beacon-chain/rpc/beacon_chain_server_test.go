@@ -1,18 +1,30 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	blk "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestBeaconChainServer_ListValidatorBalances(t *testing.T) {
@@ -47,24 +59,28 @@ func TestBeaconChainServer_ListValidatorBalances(t *testing.T) {
 		{req: &ethpb.GetValidatorBalancesRequest{PublicKeys: [][]byte{{99}}},
 			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{{
 				Index: 99, PublicKey: []byte{99}, Balance: 99}},
+				TotalSize: 1,
 			}},
 		{req: &ethpb.GetValidatorBalancesRequest{Indices: []uint64{1, 2, 3}},
 			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
 				{Index: 1, PublicKey: []byte{1}, Balance: 1},
 				{Index: 2, PublicKey: []byte{2}, Balance: 2},
 				{Index: 3, PublicKey: []byte{3}, Balance: 3}},
+				TotalSize: 3,
 			}},
 		{req: &ethpb.GetValidatorBalancesRequest{PublicKeys: [][]byte{{10}, {11}, {12}}},
 			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
 				{Index: 10, PublicKey: []byte{10}, Balance: 10},
 				{Index: 11, PublicKey: []byte{11}, Balance: 11},
 				{Index: 12, PublicKey: []byte{12}, Balance: 12}},
+				TotalSize: 3,
 			}},
 		{req: &ethpb.GetValidatorBalancesRequest{PublicKeys: [][]byte{{2}, {3}}, Indices: []uint64{3, 4}}, // Duplication
 			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
 				{Index: 2, PublicKey: []byte{2}, Balance: 2},
 				{Index: 3, PublicKey: []byte{3}, Balance: 3},
 				{Index: 4, PublicKey: []byte{4}, Balance: 4}},
+				TotalSize: 3,
 			}},
 	}
 
@@ -79,6 +95,243 @@ func TestBeaconChainServer_ListValidatorBalances(t *testing.T) {
 	}
 }
 
+func TestBeaconChainServer_ListValidatorBalancesPagination(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	count := 10
+	balances := make([]uint64, count)
+	validators := make([]*ethpb.Validator, 0, count)
+	for i := 0; i < count; i++ {
+		if err := db.SaveValidatorIndex([]byte{byte(i)}, i); err != nil {
+			t.Fatal(err)
+		}
+		balances[i] = uint64(i)
+		validators = append(validators, &ethpb.Validator{PublicKey: []byte{byte(i)}})
+	}
+
+	if err := db.SaveState(
+		context.Background(),
+		&pbp2p.BeaconState{Validators: validators, Balances: balances}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	tests := []struct {
+		req *ethpb.GetValidatorBalancesRequest
+		res *ethpb.ValidatorBalances
+	}{
+		{req: &ethpb.GetValidatorBalancesRequest{PageSize: 4},
+			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
+				{Index: 0, PublicKey: []byte{0}, Balance: 0},
+				{Index: 1, PublicKey: []byte{1}, Balance: 1},
+				{Index: 2, PublicKey: []byte{2}, Balance: 2},
+				{Index: 3, PublicKey: []byte{3}, Balance: 3}},
+				NextPageToken: strconv.Itoa(1),
+				TotalSize:     int32(count),
+			}},
+		{req: &ethpb.GetValidatorBalancesRequest{PageToken: strconv.Itoa(2), PageSize: 4},
+			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
+				{Index: 8, PublicKey: []byte{8}, Balance: 8},
+				{Index: 9, PublicKey: []byte{9}, Balance: 9}},
+				TotalSize: int32(count),
+			}},
+		{req: &ethpb.GetValidatorBalancesRequest{Indices: []uint64{5, 6, 7}, PageToken: strconv.Itoa(1), PageSize: 2},
+			res: &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
+				{Index: 7, PublicKey: []byte{7}, Balance: 7}},
+				TotalSize: 3,
+			}},
+	}
+
+	for _, test := range tests {
+		res, err := bs.ListValidatorBalances(context.Background(), test.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(res, test.res) {
+			t.Errorf("Expected %v, received %v", test.res, res)
+		}
+	}
+
+	req := &ethpb.GetValidatorBalancesRequest{PageToken: strconv.Itoa(3), PageSize: 5}
+	wanted := fmt.Sprintf("page token %d out of range for list of size %d", 3, count)
+	if _, err := bs.ListValidatorBalances(context.Background(), req); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
+func TestBeaconChainServer_ListValidatorBalancesArchivedEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	validators := []*ethpb.Validator{{PublicKey: []byte{0}}, {PublicKey: []byte{1}}}
+	for i, v := range validators {
+		if err := db.SaveValidatorIndex(v.PublicKey, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:       3 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: validators,
+		Balances:   []uint64{30, 31},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedBalances(ctx, 1, []uint64{10, 11}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.ListValidatorBalances(ctx, &ethpb.GetValidatorBalancesRequest{Epoch: 1, PublicKeys: [][]byte{{1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ValidatorBalances{
+		Epoch:     1,
+		Balances:  []*ethpb.ValidatorBalances_Balance{{Index: 1, PublicKey: []byte{1}, Balance: 11}},
+		TotalSize: 1,
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected %v, received %v", wanted, res)
+	}
+
+	res, err = bs.ListValidatorBalances(ctx, &ethpb.GetValidatorBalancesRequest{Indices: []uint64{0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Epoch != 3 || res.Balances[0].Balance != 30 {
+		t.Errorf("Expected head state balance at epoch 3, received %v", res)
+	}
+
+	if _, err := bs.ListValidatorBalances(ctx, &ethpb.GetValidatorBalancesRequest{Epoch: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error for epoch without archived balances, received %v", err)
+	}
+	if _, err := bs.ListValidatorBalances(ctx, &ethpb.GetValidatorBalancesRequest{Epoch: 4}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for future epoch, received %v", err)
+	}
+}
+
+func TestBeaconChainServer_GetValidatorParticipation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:                3 * params.BeaconConfig().SlotsPerEpoch,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	participation := &ethpb.ValidatorParticipation{
+		Epoch:                   1,
+		GlobalParticipationRate: 0.5,
+		VotedEther:              16,
+		EligibleEther:           32,
+	}
+	if err := db.SaveArchivedParticipation(ctx, 1, participation); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{Epoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := proto.Clone(participation).(*ethpb.ValidatorParticipation)
+	wanted.Finalized = true
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected %v, received %v", wanted, res)
+	}
+
+	if _, err := bs.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{Epoch: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error for epoch without archived participation, received %v", err)
+	}
+	if _, err := bs.GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{Epoch: 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for current epoch, received %v", err)
+	}
+}
+
+func TestBeaconChainServer_ListValidatorRewards(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	validators := []*ethpb.Validator{{PublicKey: []byte{0}}, {PublicKey: []byte{1}}, {PublicKey: []byte{2}}}
+	for i, v := range validators {
+		if err := db.SaveValidatorIndex(v.PublicKey, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:       3 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: validators,
+		Balances:   []uint64{30, 31, 32},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedBalances(ctx, 1, []uint64{10, 11}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedBalances(ctx, 2, []uint64{12, 9, 32}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.ListValidatorRewards(ctx, &ethpb.ListValidatorRewardsRequest{Epoch: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ValidatorRewards{
+		Epoch: 1,
+		Rewards: []*ethpb.ValidatorRewards_Reward{
+			{Index: 0, PublicKey: []byte{0}, Reward: 2},
+			{Index: 1, PublicKey: []byte{1}, Reward: -2},
+			{Index: 2, PublicKey: []byte{2}, Reward: 0},
+		},
+		TotalSize: 3,
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected %v, received %v", wanted, res)
+	}
+
+	res, err = bs.ListValidatorRewards(ctx, &ethpb.ListValidatorRewardsRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{{1}},
+		Indices:    []uint64{1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted = &ethpb.ValidatorRewards{
+		Epoch:     1,
+		Rewards:   []*ethpb.ValidatorRewards_Reward{{Index: 1, PublicKey: []byte{1}, Reward: -2}},
+		TotalSize: 1,
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected %v, received %v", wanted, res)
+	}
+
+	if _, err := bs.ListValidatorRewards(ctx, &ethpb.ListValidatorRewardsRequest{Epoch: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error for epoch without archived balances, received %v", err)
+	}
+	if _, err := bs.ListValidatorRewards(ctx, &ethpb.ListValidatorRewardsRequest{Epoch: 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for current epoch, received %v", err)
+	}
+}
+
 func TestBeaconChainServer_ListValidatorBalancesOutOfRange(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -196,7 +449,7 @@ func TestBeaconChainServer_GetValidatorsPagination(t *testing.T) {
 			res: &ethpb.Validators{
 				Validators: []*ethpb.Validator{
 					{PublicKey: []byte{99}}},
-				NextPageToken: strconv.Itoa(34),
+				NextPageToken: "",
 				TotalSize:     int32(count)}},
 		{req: &ethpb.GetValidatorsRequest{PageSize: 2},
 			res: &ethpb.Validators{
@@ -241,7 +494,7 @@ func TestBeaconChainServer_GetValidatorsPaginationOutOfRange(t *testing.T) {
 	}
 
 	req := &ethpb.GetValidatorsRequest{PageToken: strconv.Itoa(1), PageSize: 100}
-	wanted := fmt.Sprintf("page start %d >= validator list %d", req.PageSize, len(validators))
+	wanted := fmt.Sprintf("page token %d out of range for list of size %d", 1, len(validators))
 	if _, err := bs.GetValidators(context.Background(), req); !strings.Contains(err.Error(), wanted) {
 		t.Errorf("Expected error %v, received %v", wanted, err)
 	}
@@ -321,3 +574,733 @@ func TestBeaconChainServer_GetValidatorsDefaultPageSize(t *testing.T) {
 		t.Error("Incorrect respond of validators")
 	}
 }
+
+type mockAttestationStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.Attestation
+}
+
+func (m *mockAttestationStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockAttestationStream) Send(att *ethpb.Attestation) error {
+	m.sent <- att
+	return nil
+}
+
+func TestBeaconChainServer_ListBlocks(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// Canonical chain with one block per slot, plus a competing block at slot 3.
+	var chain []*ethpb.BeaconBlock
+	parentRoot := [32]byte{}
+	for slot := uint64(1); slot <= 5; slot++ {
+		block := &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, block, &pbp2p.BeaconState{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentRoot = root
+		chain = append(chain, block)
+	}
+	forkParent, err := ssz.SigningRoot(chain[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := &ethpb.BeaconBlock{Slot: 3, ParentRoot: forkParent[:], StateRoot: []byte("fork")}
+	if err := db.SaveBlock(fork); err != nil {
+		t.Fatal(err)
+	}
+	forkRoot, err := ssz.SigningRoot(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: forkRoot[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.BlockContainers) != 1 || !proto.Equal(res.BlockContainers[0].Block, fork) {
+		t.Fatalf("Expected the fork block, received %v", res.BlockContainers)
+	}
+	if res.BlockContainers[0].Canonical {
+		t.Error("Expected fork block not to be canonical")
+	}
+
+	res, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 2 {
+		t.Fatalf("Expected 2 blocks at slot 3, received %d", res.TotalSize)
+	}
+	canonicalCount := 0
+	for _, container := range res.BlockContainers {
+		if container.Canonical {
+			canonicalCount++
+			if !proto.Equal(container.Block, chain[2]) {
+				t.Errorf("Expected canonical block %v, received %v", chain[2], container.Block)
+			}
+		}
+	}
+	if canonicalCount != 1 {
+		t.Errorf("Expected exactly one canonical block at slot 3, received %d", canonicalCount)
+	}
+
+	res, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_ParentRoot{ParentRoot: forkParent[:]},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 2 {
+		t.Errorf("Expected both blocks at slot 3 as children, received %d", res.TotalSize)
+	}
+
+	res, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_SlotRange{SlotRange: &ethpb.SlotRange{StartSlot: 4, EndSlot: 100}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 2 || res.BlockContainers[0].Block.Slot != 4 || res.BlockContainers[1].Block.Slot != 5 {
+		t.Errorf("Expected blocks at slots 4 and 5, received %v", res.BlockContainers)
+	}
+
+	res, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 0},
+		PageSize:    2,
+		PageToken:   strconv.Itoa(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalSize != 6 || len(res.BlockContainers) != 2 || res.NextPageToken != strconv.Itoa(2) {
+		t.Errorf("Expected second page of 6 blocks in epoch 0, received %v", res)
+	}
+}
+
+func TestBeaconChainServer_ListBlocksErrors(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	if _, err := bs.ListBlocks(context.Background(), &ethpb.ListBlocksRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error without filter, received %v", err)
+	}
+	req := &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_SlotRange{SlotRange: &ethpb.SlotRange{StartSlot: 5, EndSlot: 4}},
+	}
+	if _, err := bs.ListBlocks(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for inverted slot range, received %v", err)
+	}
+	res, err := bs.ListBlocks(context.Background(), &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.BlockContainers) != 0 {
+		t.Errorf("Expected no blocks, received %v", res.BlockContainers)
+	}
+}
+
+func TestBeaconChainServer_ListBeaconCommittees(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	numValidators := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+	deposits, _ := testutil.SetupInitialDeposits(t, numValidators)
+	headState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	var committees []*ethpb.BeaconCommittees_CommitteeItem
+	req := &ethpb.ListCommitteesRequest{PageSize: 10}
+	for {
+		res, err := bs.ListBeaconCommittees(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.ActiveValidatorCount != numValidators {
+			t.Errorf("Expected %d active validators, received %d", numValidators, res.ActiveValidatorCount)
+		}
+		if len(res.Committees) > int(req.PageSize) {
+			t.Errorf("Expected at most %d committees per page, received %d", req.PageSize, len(res.Committees))
+		}
+		committees = append(committees, res.Committees...)
+		if res.NextPageToken == "" {
+			if int(res.TotalSize) != len(committees) {
+				t.Errorf("Expected total size %d, received %d", len(committees), res.TotalSize)
+			}
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	seen := make(map[uint64]bool)
+	for _, committee := range committees {
+		if committee.Slot >= params.BeaconConfig().SlotsPerEpoch {
+			t.Errorf("Expected committee slot within epoch 0, received %d", committee.Slot)
+		}
+		wanted, err := helpers.CrosslinkCommittee(headState, 0, committee.Shard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(committee.ValidatorIndices, wanted) {
+			t.Errorf("Expected committee %v for shard %d, received %v", wanted, committee.Shard, committee.ValidatorIndices)
+		}
+		for _, index := range committee.ValidatorIndices {
+			if seen[index] {
+				t.Errorf("Validator %d assigned to more than one committee", index)
+			}
+			seen[index] = true
+		}
+	}
+	if uint64(len(seen)) != numValidators {
+		t.Errorf("Expected every active validator in a committee, received %d", len(seen))
+	}
+
+	if _, err := bs.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{Epoch: 2}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for future epoch, received %v", err)
+	}
+}
+
+func TestBeaconChainServer_ListValidatorAssignments(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	numValidators := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+	deposits, _ := testutil.SetupInitialDeposits(t, numValidators)
+	headState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{
+		Indices:  []uint64{3},
+		PageSize: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Assignments) != 1 {
+		t.Fatalf("Expected 1 assignment, received %d", len(res.Assignments))
+	}
+	assignment := res.Assignments[0]
+	if !bytes.Equal(assignment.PublicKey, headState.Validators[3].PublicKey) {
+		t.Errorf("Expected public key of validator 3, received %#x", assignment.PublicKey)
+	}
+	wanted, err := helpers.CrosslinkCommittee(headState, 0, assignment.Shard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(assignment.CrosslinkCommittees, wanted) {
+		t.Errorf("Expected committee %v, received %v", wanted, assignment.CrosslinkCommittees)
+	}
+
+	if _, err := bs.ListValidatorAssignments(ctx, &ethpb.ListValidatorAssignmentsRequest{Epoch: 2}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for future epoch, received %v", err)
+	}
+}
+
+func TestBeaconChainServer_ListValidatorAssignmentsArchivedEpoch(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	numValidators := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+	deposits, _ := testutil.SetupInitialDeposits(t, numValidators)
+	headState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	headState.Slot = 3 * params.BeaconConfig().SlotsPerEpoch
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	req := &ethpb.ListValidatorAssignmentsRequest{Epoch: 1, Indices: []uint64{1}}
+	if _, err := bs.ListValidatorAssignments(ctx, req); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error for epoch without archive, received %v", err)
+	}
+
+	startSlot := helpers.StartSlot(1)
+	proposers := make([]uint64, params.BeaconConfig().SlotsPerEpoch)
+	proposers[1] = 1
+	if err := db.SaveArchivedProposers(ctx, 1, proposers); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveArchivedCommittees(ctx, &ethpb.BeaconCommittees{
+		Epoch: 1,
+		Committees: []*ethpb.BeaconCommittees_CommitteeItem{
+			{ValidatorIndices: []uint64{0, 1}, Slot: startSlot, Shard: 2},
+			{ValidatorIndices: []uint64{1, 2}, Slot: startSlot + 1, Shard: 3},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := bs.ListValidatorAssignments(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*ethpb.ValidatorAssignments_CommitteeAssignment{
+		{
+			CrosslinkCommittees: []uint64{0, 1},
+			Shard:               2,
+			Slot:                startSlot,
+			PublicKey:           headState.Validators[1].PublicKey,
+		},
+		{
+			CrosslinkCommittees: []uint64{1, 2},
+			Shard:               3,
+			Slot:                startSlot + 1,
+			Proposer:            true,
+			PublicKey:           headState.Validators[1].PublicKey,
+		},
+	}
+	if !reflect.DeepEqual(res.Assignments, want) {
+		t.Errorf("Wanted %v, received %v", want, res.Assignments)
+	}
+}
+
+func TestBeaconChainServer_StreamAttestations(t *testing.T) {
+	feed := new(event.Topic)
+	bs := &BeaconChainServer{
+		ctx:  context.Background(),
+		pool: &mockOperationService{acceptedAttFeed: feed},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockAttestationStream{ctx: ctx, sent: make(chan *ethpb.Attestation, 1)}
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- bs.StreamAttestations(&ptypes.Empty{}, stream)
+	}()
+
+	att := &ethpb.Attestation{AggregationBits: []byte{'A'}}
+	for feed.Send(att) == 0 {
+		// Wait for the stream to subscribe to the feed.
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case received := <-stream.sent:
+		if !proto.Equal(received, att) {
+			t.Errorf("Expected attestation %v, received %v", att, received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Attestation was not sent over the stream")
+	}
+
+	cancel()
+	if err := <-exitRoutine; err == nil || !strings.Contains(err.Error(), "stream context closed") {
+		t.Errorf("Expected stream context closed error, received %v", err)
+	}
+}
+
+type mockBlockStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.BeaconBlock
+}
+
+func (m *mockBlockStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockBlockStream) Send(block *ethpb.BeaconBlock) error {
+	m.sent <- block
+	return nil
+}
+
+type mockChainHeadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.ChainHead
+}
+
+func (m *mockChainHeadStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockChainHeadStream) Send(head *ethpb.ChainHead) error {
+	m.sent <- head
+	return nil
+}
+
+func TestBeaconChainServer_StreamBlocks(t *testing.T) {
+	feed := new(event.Topic)
+	bs := &BeaconChainServer{
+		ctx:          context.Background(),
+		chainService: &mockChainService{headUpdatedFeed: feed},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockBlockStream{ctx: ctx, sent: make(chan *ethpb.BeaconBlock, 1)}
+	go func() {
+		if err := bs.StreamBlocks(&ptypes.Empty{}, stream); err != nil && !strings.Contains(err.Error(), "context closed") {
+			t.Error(err)
+		}
+	}()
+
+	head := &ethpb.BeaconBlock{Slot: 5}
+	for feed.Send(head) == 0 {
+		// Wait for the stream to subscribe to the feed.
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case received := <-stream.sent:
+		if !proto.Equal(received, head) {
+			t.Errorf("Expected block %v, received %v", head, received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Block was not sent over the stream")
+	}
+}
+
+func TestBeaconChainServer_GetChainHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+	if _, err := bs.GetChainHead(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.Internal {
+		t.Errorf("Expected internal error without a chain head, received %v", err)
+	}
+
+	head := &ethpb.BeaconBlock{Slot: 5 * params.BeaconConfig().SlotsPerEpoch}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState := &pbp2p.BeaconState{
+		Slot:                        head.Slot,
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Epoch: 3, Root: []byte{'A'}},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 4, Root: []byte{'C'}},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 3, Root: []byte{'B'}},
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	received, err := bs.GetChainHead(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ethpb.ChainHead{
+		BlockRoot:                  headRoot[:],
+		BlockSlot:                  head.Slot,
+		FinalizedEpoch:             3,
+		FinalizedSlot:              3 * params.BeaconConfig().SlotsPerEpoch,
+		FinalizedBlockRoot:         []byte{'A'},
+		JustifiedEpoch:             4,
+		JustifiedSlot:              4 * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedBlockRoot:         []byte{'C'},
+		PreviousJustifiedEpoch:     3,
+		PreviousJustifiedSlot:      3 * params.BeaconConfig().SlotsPerEpoch,
+		PreviousJustifiedBlockRoot: []byte{'B'},
+	}
+	if !proto.Equal(received, want) {
+		t.Errorf("Expected chain head %v, received %v", want, received)
+	}
+}
+
+func TestBeaconChainServer_StreamChainHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	head := &ethpb.BeaconBlock{Slot: 3 * params.BeaconConfig().SlotsPerEpoch}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState := &pbp2p.BeaconState{
+		Slot:                        head.Slot,
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Epoch: 1, Root: []byte{'A'}},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 2, Root: []byte{'B'}},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: []byte{'A'}},
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	feed := new(event.Topic)
+	bs := &BeaconChainServer{
+		ctx:          context.Background(),
+		beaconDB:     db,
+		chainService: &mockChainService{headUpdatedFeed: feed},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockChainHeadStream{ctx: ctx, sent: make(chan *ethpb.ChainHead, 1)}
+	go func() {
+		if err := bs.StreamChainHead(&ptypes.Empty{}, stream); err != nil && !strings.Contains(err.Error(), "context closed") {
+			t.Error(err)
+		}
+	}()
+
+	for feed.Send(head) == 0 {
+		// Wait for the stream to subscribe to the feed.
+		time.Sleep(10 * time.Millisecond)
+	}
+	want := &ethpb.ChainHead{
+		BlockRoot:                  headRoot[:],
+		BlockSlot:                  head.Slot,
+		FinalizedSlot:              params.BeaconConfig().SlotsPerEpoch,
+		FinalizedBlockRoot:         []byte{'A'},
+		JustifiedSlot:              2 * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedBlockRoot:         []byte{'B'},
+		PreviousJustifiedSlot:      params.BeaconConfig().SlotsPerEpoch,
+		PreviousJustifiedBlockRoot: []byte{'A'},
+		FinalizedEpoch:             1,
+		JustifiedEpoch:             2,
+		PreviousJustifiedEpoch:     1,
+	}
+	select {
+	case received := <-stream.sent:
+		if !proto.Equal(received, want) {
+			t.Errorf("Expected chain head %v, received %v", want, received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Chain head was not sent over the stream")
+	}
+}
+
+func TestBeaconChainServer_StreamChainHeadDoesNotBlockFeed(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	head := &ethpb.BeaconBlock{Slot: 1}
+	headState := &pbp2p.BeaconState{
+		Slot:                        head.Slot,
+		FinalizedCheckpoint:         &ethpb.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{},
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	feed := new(event.Topic)
+	bs := &BeaconChainServer{
+		ctx:          context.Background(),
+		beaconDB:     db,
+		chainService: &mockChainService{headUpdatedFeed: feed},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// The client does not read the stream until every head was sent.
+	stream := &mockChainHeadStream{ctx: ctx, sent: make(chan *ethpb.ChainHead)}
+	go func() {
+		if err := bs.StreamChainHead(&ptypes.Empty{}, stream); err != nil && !strings.Contains(err.Error(), "context closed") {
+			t.Error(err)
+		}
+	}()
+
+	for feed.Send(head) == 0 {
+		// Wait for the stream to subscribe to the feed.
+		time.Sleep(10 * time.Millisecond)
+	}
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*params.BeaconConfig().DefaultBufferSize; i++ {
+			feed.Send(head)
+		}
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("Sending head updates blocked on a slow stream")
+	}
+
+	select {
+	case <-stream.sent:
+	case <-time.After(time.Second):
+		t.Fatal("Chain head was not sent over the stream")
+	}
+	// The updates received while the client stalled are sent at most once more.
+	received := 0
+	for {
+		select {
+		case <-stream.sent:
+			received++
+			continue
+		case <-time.After(50 * time.Millisecond):
+		}
+		break
+	}
+	if received > 1 {
+		t.Errorf("Expected pending head updates to be coalesced, received %d more chain heads", received)
+	}
+}
+
+type mockRegistryChangesStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *ethpb.ValidatorRegistryChanges
+}
+
+func (m *mockRegistryChangesStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockRegistryChangesStream) Send(changes *ethpb.ValidatorRegistryChanges) error {
+	m.sent <- changes
+	return nil
+}
+
+func TestBeaconChainServer_StreamValidatorRegistryChanges(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxBalance := params.BeaconConfig().MaxEffectiveBalance
+	head := &ethpb.BeaconBlock{Slot: params.BeaconConfig().SlotsPerEpoch}
+	headState := &pbp2p.BeaconState{
+		Slot: head.Slot,
+		Validators: []*ethpb.Validator{
+			{PublicKey: []byte{'A'}, ActivationEpoch: 2, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{'B'}, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+		},
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	feed := new(event.Topic)
+	bs := &BeaconChainServer{
+		ctx:          context.Background(),
+		beaconDB:     db,
+		chainService: &mockChainService{headUpdatedFeed: feed},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockRegistryChangesStream{ctx: ctx, sent: make(chan *ethpb.ValidatorRegistryChanges, 1)}
+	req := &ethpb.StreamValidatorRegistryChangesRequest{PublicKeys: [][]byte{{'A'}}}
+	go func() {
+		if err := bs.StreamValidatorRegistryChanges(req, stream); err != nil && !strings.Contains(err.Error(), "context closed") {
+			t.Error(err)
+		}
+	}()
+
+	// A head in the same epoch sends no changes.
+	for feed.Send(head) == 0 {
+		// Wait for the stream to subscribe to the feed.
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case received := <-stream.sent:
+		t.Fatalf("Expected no changes within an epoch, received %v", received)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Both validators change in the next epoch, but only the tracked one is sent.
+	head = &ethpb.BeaconBlock{Slot: 2 * params.BeaconConfig().SlotsPerEpoch}
+	headState = &pbp2p.BeaconState{
+		Slot: head.Slot,
+		Validators: []*ethpb.Validator{
+			{PublicKey: []byte{'A'}, ActivationEpoch: 2, ExitEpoch: farFuture, EffectiveBalance: maxBalance},
+			{PublicKey: []byte{'B'}, ExitEpoch: 2, Slashed: true, EffectiveBalance: maxBalance},
+		},
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), head, headState); err != nil {
+		t.Fatal(err)
+	}
+	feed.Send(head)
+	want := &ethpb.ValidatorRegistryChanges{
+		Epoch:         2,
+		PreviousEpoch: 1,
+		Changes: []*ethpb.ValidatorRegistryChanges_Change{
+			{PublicKey: []byte{'A'}, Index: 0, Activated: true, PreviousEffectiveBalance: maxBalance, EffectiveBalance: maxBalance},
+		},
+	}
+	select {
+	case received := <-stream.sent:
+		if !proto.Equal(received, want) {
+			t.Errorf("Expected registry changes %v, received %v", want, received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Registry changes were not sent over the stream")
+	}
+}
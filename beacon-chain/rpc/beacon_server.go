@@ -14,7 +14,6 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
 // BeaconServer defines a server implementation of the gRPC Beacon service,
@@ -205,19 +204,3 @@ func (bs *BeaconServer) BlockTreeBySlots(ctx context.Context, req *pb.TreeBlockS
 		Tree: tree,
 	}, nil
 }
-
-func constructMerkleProof(trie *trieutil.MerkleTrie, index int, deposit *ethpb.Deposit) (*ethpb.Deposit, error) {
-	proof, err := trie.MerkleProof(index)
-	if err != nil {
-		return nil, fmt.Errorf(
-			"could not generate merkle proof for deposit at index %d: %v",
-			index,
-			err,
-		)
-	}
-	// For every deposit, we construct a Merkle proof using the powchain service's
-	// in-memory deposits trie, which is updated only once the state's LatestETH1Data
-	// property changes during a state transition after a voting period.
-	deposit.Proof = proof
-	return deposit, nil
-}
@@ -49,6 +49,18 @@ func (f *faultyPOWChainService) LatestBlockHeight() *big.Int {
 	return big.NewInt(0)
 }
 
+func (f *faultyPOWChainService) LatestBlockHash() common.Hash {
+	return common.Hash{}
+}
+
+func (f *faultyPOWChainService) LatestBlockTime() time.Time {
+	return time.Time{}
+}
+
+func (f *faultyPOWChainService) Status() error {
+	return errors.New("eth1 client is not syncing")
+}
+
 func (f *faultyPOWChainService) BlockExists(_ context.Context, hash common.Hash) (bool, *big.Int, error) {
 	if f.hashesByHeight == nil {
 		return false, big.NewInt(1), errors.New("failed")
@@ -92,6 +104,8 @@ func (f *faultyPOWChainService) ChainStartETH1Data() *ethpb.Eth1Data {
 type mockPOWChainService struct {
 	chainStartFeed      *event.Feed
 	latestBlockNumber   *big.Int
+	latestBlockHash     common.Hash
+	latestBlockTime     time.Time
 	hashesByHeight      map[int][]byte
 	blockTimeByHeight   map[int]uint64
 	blockNumberByHeight map[uint64]*big.Int
@@ -112,6 +126,18 @@ func (m *mockPOWChainService) LatestBlockHeight() *big.Int {
 	return m.latestBlockNumber
 }
 
+func (m *mockPOWChainService) LatestBlockHash() common.Hash {
+	return m.latestBlockHash
+}
+
+func (m *mockPOWChainService) LatestBlockTime() time.Time {
+	return m.latestBlockTime
+}
+
+func (m *mockPOWChainService) Status() error {
+	return nil
+}
+
 func (m *mockPOWChainService) DepositTrie() *trieutil.MerkleTrie {
 	return &trieutil.MerkleTrie{}
 }
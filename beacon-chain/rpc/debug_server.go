@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/sszutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DebugServer defines a server implementation of the gRPC Debug service,
+// providing RPC endpoints for inspecting the raw beacon state of the node, such
+// as for bootstrapping a node from a checkpoint.
+type DebugServer struct {
+	beaconDB *db.BeaconDB
+}
+
+// GetBeaconState retrieves the SSZ encoded beacon state at head, at a slot of the
+// canonical chain, or after processing the block with the given root. States
+// older than the last finalized state are only available until they are pruned.
+func (ds *DebugServer) GetBeaconState(ctx context.Context, req *pb.BeaconStateRequest) (*pb.BeaconStateResponse, error) {
+	beaconState, err := ds.requestedState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := ssz.Marshal(beaconState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not encode state: %v", err)
+	}
+	return &pb.BeaconStateResponse{
+		EncodedState: enc,
+		Slot:         beaconState.Slot,
+	}, nil
+}
+
+// StreamBeaconState streams the SSZ encoded beacon state requested as for GetBeaconState
+// in chunks, so that states larger than the max gRPC message size can be retrieved.
+func (ds *DebugServer) StreamBeaconState(req *pb.BeaconStateRequest, stream pb.DebugService_StreamBeaconStateServer) error {
+	beaconState, err := ds.requestedState(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	send := sszutil.ChunkSender(func(chunk []byte) error {
+		return stream.Send(&pb.BeaconStateChunk{
+			Data: chunk,
+			Slot: beaconState.Slot,
+		})
+	})
+	if _, err := sszutil.EncodeChunks(send, beaconState); err != nil {
+		return status.Errorf(codes.Internal, "could not stream state: %v", err)
+	}
+	return nil
+}
+
+// requestedState retrieves the beacon state matching the query filter of the request.
+func (ds *DebugServer) requestedState(ctx context.Context, req *pb.BeaconStateRequest) (*pbp2p.BeaconState, error) {
+	headState, err := ds.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "head state is not available yet")
+	}
+
+	beaconState := headState
+	switch q := req.QueryFilter.(type) {
+	case *pb.BeaconStateRequest_Slot:
+		if q.Slot > headState.Slot {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"cannot retrieve state at slot %d later than head slot %d",
+				q.Slot,
+				headState.Slot,
+			)
+		}
+		if q.Slot == headState.Slot {
+			break
+		}
+		var root [32]byte
+		blk, err := ds.beaconDB.CanonicalBlockBySlot(ctx, q.Slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block at slot %d: %v", q.Slot, err)
+		}
+		if blk != nil {
+			root, err = ssz.SigningRoot(blk)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not compute block root: %v", err)
+			}
+		}
+		beaconState, err = ds.historicalState(ctx, q.Slot, root)
+		if err != nil {
+			return nil, err
+		}
+	case *pb.BeaconStateRequest_BlockRoot:
+		root := bytesutil.ToBytes32(q.BlockRoot)
+		blk, err := ds.beaconDB.Block(root)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block: %v", err)
+		}
+		if blk == nil {
+			return nil, status.Errorf(codes.NotFound, "no block found with root %#x", q.BlockRoot)
+		}
+		head, err := ds.beaconDB.ChainHead()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve chain head: %v", err)
+		}
+		headRoot, err := ssz.SigningRoot(head)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not compute head block root: %v", err)
+		}
+		if bytes.Equal(headRoot[:], root[:]) {
+			break
+		}
+		beaconState, err = ds.historicalState(ctx, blk.Slot, root)
+		if err != nil {
+			return nil, err
+		}
+		if beaconState.Slot != blk.Slot {
+			return nil, status.Errorf(codes.NotFound, "state after block %#x is no longer available", q.BlockRoot)
+		}
+	}
+	return beaconState, nil
+}
+
+// historicalState retrieves the archived state closest to and no later than the
+// slot, preferring the state of the block with the given root.
+func (ds *DebugServer) historicalState(ctx context.Context, slot uint64, root [32]byte) (*pbp2p.BeaconState, error) {
+	beaconState, err := ds.beaconDB.HistoricalStateFromSlot(ctx, slot, root)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no state available at slot %d: %v", slot, err)
+	}
+	return beaconState, nil
+}
@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDebugServer_GetBeaconState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	var roots [][32]byte
+	parentRoot := [32]byte{}
+	for slot := uint64(1); slot <= 4; slot++ {
+		block := &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState := &pbp2p.BeaconState{Slot: slot}
+		if err := db.SaveHistoricalState(ctx, beaconState, root); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, block, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		parentRoot = root
+		roots = append(roots, root)
+	}
+
+	debugServer := &DebugServer{beaconDB: db}
+	tests := []struct {
+		req  *pb.BeaconStateRequest
+		slot uint64
+	}{
+		{req: &pb.BeaconStateRequest{}, slot: 4},
+		{req: &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_Slot{Slot: 2}}, slot: 2},
+		{req: &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_Slot{Slot: 4}}, slot: 4},
+		{req: &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_BlockRoot{BlockRoot: roots[2][:]}}, slot: 3},
+		{req: &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_BlockRoot{BlockRoot: roots[3][:]}}, slot: 4},
+	}
+	for i, tt := range tests {
+		res, err := debugServer.GetBeaconState(ctx, tt.req)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i, err)
+		}
+		if res.Slot != tt.slot {
+			t.Errorf("Test %d: expected state at slot %d, received %d", i, tt.slot, res.Slot)
+		}
+		decoded := &pbp2p.BeaconState{}
+		if err := ssz.Unmarshal(res.EncodedState, decoded); err != nil {
+			t.Fatalf("Test %d: could not decode state: %v", i, err)
+		}
+		if decoded.Slot != tt.slot {
+			t.Errorf("Test %d: expected encoded state at slot %d, received %d", i, tt.slot, decoded.Slot)
+		}
+	}
+}
+
+func TestDebugServer_GetBeaconStateErrors(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	block := &ethpb.BeaconBlock{Slot: 2}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, block, &pbp2p.BeaconState{Slot: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	debugServer := &DebugServer{beaconDB: db}
+	tests := []struct {
+		req     *pb.BeaconStateRequest
+		code    codes.Code
+		message string
+	}{
+		{
+			req:     &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_Slot{Slot: 3}},
+			code:    codes.InvalidArgument,
+			message: "later than head slot",
+		},
+		{
+			req:     &pb.BeaconStateRequest{QueryFilter: &pb.BeaconStateRequest_BlockRoot{BlockRoot: []byte{'a'}}},
+			code:    codes.NotFound,
+			message: "no block found",
+		},
+	}
+	for i, tt := range tests {
+		_, err := debugServer.GetBeaconState(ctx, tt.req)
+		if status.Code(err) != tt.code {
+			t.Errorf("Test %d: expected code %v, received %v", i, tt.code, err)
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("Test %d: expected error containing %q, received %v", i, tt.message, err)
+		}
+	}
+}
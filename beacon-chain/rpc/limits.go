@@ -0,0 +1,126 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// ServerLimits protects the RPC server from misbehaving or overly chatty clients.
+// Zero values leave the gRPC defaults in place.
+type ServerLimits struct {
+	// MaxConcurrentStreams is the max number of concurrent streams per client connection.
+	MaxConcurrentStreams uint32
+	// MaxRecvMsgSize is the max size in bytes of a message the server accepts.
+	MaxRecvMsgSize int
+	// KeepaliveTime is the duration after which the server pings an idle client.
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is the duration the server waits for a ping to be acknowledged
+	// before closing the connection.
+	KeepaliveTimeout time.Duration
+	// KeepaliveMinTime is the min duration clients must wait between pings. Connections
+	// of clients which ping more often are closed.
+	KeepaliveMinTime time.Duration
+	// RateLimits maps full or short method names to the max number of requests per
+	// second the server handles for that method across all clients.
+	RateLimits map[string]float64
+}
+
+// serverOptions returns the gRPC server options applying the limits.
+func (l ServerLimits) serverOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if l.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(l.MaxConcurrentStreams))
+	}
+	if l.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(l.MaxRecvMsgSize))
+	}
+	if l.KeepaliveTime > 0 || l.KeepaliveTimeout > 0 {
+		opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    l.KeepaliveTime,
+			Timeout: l.KeepaliveTimeout,
+		}))
+	}
+	if l.KeepaliveMinTime > 0 {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             l.KeepaliveMinTime,
+			PermitWithoutStream: true,
+		}))
+	}
+	return opts
+}
+
+// ParseRateLimits parses rate limits of the form <method>=<requests per second>, where
+// the method is either a full gRPC method name such as
+// /ethereum.beacon.rpc.v1.ProposerService/ProposeBlock, or only its last element.
+func ParseRateLimits(specs []string) (map[string]float64, error) {
+	limits := make(map[string]float64, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid rate limit %q, expected <method>=<requests per second>", spec)
+		}
+		rps, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || rps <= 0 {
+			return nil, fmt.Errorf("invalid requests per second in rate limit %q", spec)
+		}
+		limits[parts[0]] = rps
+	}
+	return limits, nil
+}
+
+// methodRateLimiter limits the rate at which the server handles requests to each
+// method. Requests exceeding the limit are rejected rather than queued, so that
+// clients back off instead of piling up on the server.
+type methodRateLimiter struct {
+	limiters map[string]*rate.Limiter
+}
+
+func newMethodRateLimiter(limits map[string]float64) *methodRateLimiter {
+	limiters := make(map[string]*rate.Limiter, len(limits))
+	for method, rps := range limits {
+		burst := int(math.Ceil(rps))
+		limiters[method] = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+	return &methodRateLimiter{limiters: limiters}
+}
+
+// allow returns a ResourceExhausted error if the request to the full method name
+// exceeds its rate limit.
+func (m *methodRateLimiter) allow(fullMethod string) error {
+	limiter, ok := m.limiters[fullMethod]
+	if !ok {
+		limiter, ok = m.limiters[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]
+	}
+	if ok && !limiter.Allow() {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", fullMethod)
+	}
+	return nil
+}
+
+func (m *methodRateLimiter) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := m.allow(info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (m *methodRateLimiter) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := m.allow(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
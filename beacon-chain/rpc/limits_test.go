@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := ParseRateLimits([]string{"ProposeBlock=10", "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation=0.5"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limits["ProposeBlock"] != 10 {
+		t.Errorf("Expected limit of 10 for ProposeBlock, received %v", limits["ProposeBlock"])
+	}
+	if limits["/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation"] != 0.5 {
+		t.Errorf("Expected limit of 0.5 for SubmitAttestation, received %v", limits)
+	}
+
+	for _, spec := range []string{"ProposeBlock", "=10", "ProposeBlock=fast", "ProposeBlock=0"} {
+		if _, err := ParseRateLimits([]string{spec}); err == nil {
+			t.Errorf("Expected error parsing rate limit %q", spec)
+		}
+	}
+}
+
+func TestMethodRateLimiter_Allow(t *testing.T) {
+	limiter := newMethodRateLimiter(map[string]float64{"ProposeBlock": 2})
+	method := "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock"
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.allow(method); err != nil {
+			t.Fatalf("Expected request %d within burst to be allowed, received %v", i, err)
+		}
+	}
+	if err := limiter.allow(method); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected request exceeding rate limit to be rejected, received %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if err := limiter.allow("/ethereum.beacon.rpc.v1.ProposerService/RequestBlock"); err != nil {
+			t.Errorf("Expected requests to methods without a limit to be allowed, received %v", err)
+		}
+	}
+}
@@ -6,9 +6,14 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,11 +22,14 @@ import (
 
 // NodeServer defines a server implementation of the gRPC Node service,
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
-// version information, and services the node implements and runs.
+// version information, services the node implements and runs, its p2p host and
+// peers, its enabled features, and its view of the eth1 chain.
 type NodeServer struct {
-	syncChecker sync.Checker
-	server      *grpc.Server
-	beaconDB    *db.BeaconDB
+	syncChecker     sync.Checker
+	server          *grpc.Server
+	beaconDB        *db.BeaconDB
+	peersProvider   p2p.PeersProvider
+	powChainService powChainService
 }
 
 // GetSyncStatus checks the current network sync status of the node.
@@ -41,6 +49,10 @@ func (ns *NodeServer) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.G
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not retrieve deposit contract address: %v", err)
 	}
+	validatorsRoot, err := ns.beaconDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve genesis validators root: %v", err)
+	}
 	genesisTimestamp := time.Unix(int64(beaconState.GenesisTime), 0)
 	genesisProtoTimestamp, err := ptypes.TimestampProto(genesisTimestamp)
 	if err != nil {
@@ -49,13 +61,17 @@ func (ns *NodeServer) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.G
 	return &ethpb.Genesis{
 		DepositContractAddress: address,
 		GenesisTime:            genesisProtoTimestamp,
+		GenesisValidatorsRoot:  validatorsRoot,
+		GenesisForkVersion:     params.BeaconConfig().GenesisForkVersion,
 	}, nil
 }
 
-// GetVersion checks the version information of the beacon node.
+// GetVersion checks the version information of the beacon node, with the git commit,
+// build date and Go version of the build as metadata.
 func (ns *NodeServer) GetVersion(ctx context.Context, _ *ptypes.Empty) (*ethpb.Version, error) {
 	return &ethpb.Version{
-		Version: version.GetVersion(),
+		Version:  version.GetVersion(),
+		Metadata: version.Metadata(),
 	}, nil
 }
 
@@ -75,3 +91,123 @@ func (ns *NodeServer) ListImplementedServices(ctx context.Context, _ *ptypes.Emp
 		Services: serviceNames,
 	}, nil
 }
+
+// GetHost retrieves the peer ID and listening addresses of the node.
+func (ns *NodeServer) GetHost(ctx context.Context, _ *ptypes.Empty) (*ethpb.HostData, error) {
+	if ns.peersProvider == nil {
+		return nil, status.Error(codes.Unavailable, "p2p service is not available")
+	}
+	host := ns.peersProvider.HostInfo()
+	return &ethpb.HostData{
+		PeerId:    host.ID.Pretty(),
+		Addresses: multiaddrStrings(host.Addrs),
+	}, nil
+}
+
+// ListPeers retrieves the peers the node is connected to, along with their reputation.
+func (ns *NodeServer) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.Peers, error) {
+	if ns.peersProvider == nil {
+		return nil, status.Error(codes.Unavailable, "p2p service is not available")
+	}
+	peers := ns.peersProvider.Peers()
+	res := make([]*ethpb.Peer, 0, len(peers))
+	for _, p := range peers {
+		res = append(res, &ethpb.Peer{
+			PeerId:     p.ID.Pretty(),
+			Addresses:  multiaddrStrings(p.Addrs),
+			Reputation: int64(p.Reputation),
+		})
+	}
+	return &ethpb.Peers{
+		Peers: res,
+	}, nil
+}
+
+// ListFeatureFlags retrieves the feature flags enabled on the node.
+func (ns *NodeServer) ListFeatureFlags(ctx context.Context, _ *ptypes.Empty) (*ethpb.FeatureFlags, error) {
+	return &ethpb.FeatureFlags{
+		Enabled: featureconfig.EnabledFeatures(),
+	}, nil
+}
+
+func multiaddrStrings(addrs []ma.Multiaddr) []string {
+	res := make([]string, len(addrs))
+	for i, addr := range addrs {
+		res[i] = addr.String()
+	}
+	return res
+}
+
+// GetEth1Status reports whether the node follows the eth1 chain, the latest eth1 block it
+// received, and how many of the deposits it observed are included in the beacon chain.
+func (ns *NodeServer) GetEth1Status(ctx context.Context, _ *ptypes.Empty) (*ethpb.Eth1Status, error) {
+	res := &ethpb.Eth1Status{
+		Connected:           true,
+		LatestBlockHash:     ns.powChainService.LatestBlockHash().Bytes(),
+		DepositCount:        uint64(len(ns.beaconDB.AllDeposits(ctx, nil))),
+		PendingDepositCount: uint64(len(ns.beaconDB.PendingDeposits(ctx, nil))),
+	}
+	if err := ns.powChainService.Status(); err != nil {
+		res.Connected = false
+		res.ConnectionError = err.Error()
+	}
+	if height := ns.powChainService.LatestBlockHeight(); height != nil {
+		res.LatestBlockNumber = height.Uint64()
+	}
+	if blockTime := ns.powChainService.LatestBlockTime(); !blockTime.IsZero() {
+		timestamp, err := ptypes.TimestampProto(blockTime)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not convert block time to proto timestamp: %v", err)
+		}
+		res.LatestBlockTime = timestamp
+	}
+
+	headState, err := ns.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if headState != nil {
+		res.ProcessedDepositCount = headState.Eth1DepositIndex
+	}
+	return res, nil
+}
+
+// GetForkSchedule retrieves the fork schedule of the node's configuration, along with
+// the fork active at the epoch of the head of the chain.
+func (ns *NodeServer) GetForkSchedule(ctx context.Context, _ *ptypes.Empty) (*ethpb.ForkSchedule, error) {
+	headState, err := ns.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	var currentEpoch uint64
+	if headState != nil {
+		currentEpoch = helpers.CurrentEpoch(headState)
+	}
+	forks := forkSchedule()
+	res := &ethpb.ForkSchedule{
+		Forks:        forks,
+		CurrentEpoch: currentEpoch,
+	}
+	for _, fork := range forks {
+		if fork.Epoch <= currentEpoch {
+			res.CurrentFork = fork
+		}
+	}
+	return res, nil
+}
+
+// forkSchedule returns the genesis fork followed by the next fork, if one is scheduled.
+func forkSchedule() []*ethpb.ScheduledFork {
+	cfg := params.BeaconConfig()
+	forks := []*ethpb.ScheduledFork{{
+		Version: cfg.GenesisForkVersion,
+		Epoch:   0,
+	}}
+	if cfg.NextForkEpoch != cfg.FarFutureEpoch {
+		forks = append(forks, &ethpb.ScheduledFork{
+			Version: cfg.NextForkVersion,
+			Epoch:   cfg.NextForkEpoch,
+		})
+	}
+	return forks
+}
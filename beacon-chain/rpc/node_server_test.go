@@ -3,15 +3,21 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -79,6 +85,12 @@ func TestNodeServer_GetGenesis(t *testing.T) {
 	if !proto.Equal(res.GenesisTime, protoTimestamp) {
 		t.Errorf("Wanted GetGenesis().GenesisTime = %v, received %v", protoTimestamp, res.GenesisTime)
 	}
+	if !bytes.Equal(res.GenesisForkVersion, params.BeaconConfig().GenesisForkVersion) {
+		t.Errorf("Wanted GetGenesis().GenesisForkVersion = %#x, received %#x", params.BeaconConfig().GenesisForkVersion, res.GenesisForkVersion)
+	}
+	if len(res.GenesisValidatorsRoot) != 0 {
+		t.Errorf("Wanted empty GetGenesis().GenesisValidatorsRoot without a genesis state, received %#x", res.GenesisValidatorsRoot)
+	}
 }
 
 func TestNodeServer_GetVersion(t *testing.T) {
@@ -91,6 +103,9 @@ func TestNodeServer_GetVersion(t *testing.T) {
 	if res.Version != v {
 		t.Errorf("Wanted GetVersion() = %s, received %s", v, res.Version)
 	}
+	if res.Metadata != version.Metadata() {
+		t.Errorf("Wanted GetVersion().Metadata = %s, received %s", version.Metadata(), res.Metadata)
+	}
 }
 
 func TestNodeServer_GetImplementedServices(t *testing.T) {
@@ -110,3 +125,161 @@ func TestNodeServer_GetImplementedServices(t *testing.T) {
 		t.Errorf("Expected 2 services, received %d: %v", len(res.Services), res.Services)
 	}
 }
+
+type mockPeersProvider struct {
+	host  p2p.PeerInfo
+	peers []p2p.PeerInfo
+}
+
+func (m *mockPeersProvider) HostInfo() p2p.PeerInfo {
+	return m.host
+}
+
+func (m *mockPeersProvider) Peers() []p2p.PeerInfo {
+	return m.peers
+}
+
+func TestNodeServer_GetHostAndListPeers(t *testing.T) {
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostID := peer.ID("host")
+	remoteID := peer.ID("remote")
+	ns := &NodeServer{
+		peersProvider: &mockPeersProvider{
+			host:  p2p.PeerInfo{ID: hostID, Addrs: []ma.Multiaddr{addr}},
+			peers: []p2p.PeerInfo{{ID: remoteID, Addrs: []ma.Multiaddr{addr}, Reputation: -5}},
+		},
+	}
+
+	host, err := ns.GetHost(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedHost := &ethpb.HostData{PeerId: hostID.Pretty(), Addresses: []string{"/ip4/127.0.0.1/tcp/13000"}}
+	if !proto.Equal(host, wantedHost) {
+		t.Errorf("Wanted GetHost() = %v, received %v", wantedHost, host)
+	}
+
+	peers, err := ns.ListPeers(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedPeers := &ethpb.Peers{Peers: []*ethpb.Peer{{
+		PeerId:     remoteID.Pretty(),
+		Addresses:  []string{"/ip4/127.0.0.1/tcp/13000"},
+		Reputation: -5,
+	}}}
+	if !proto.Equal(peers, wantedPeers) {
+		t.Errorf("Wanted ListPeers() = %v, received %v", wantedPeers, peers)
+	}
+}
+
+func TestNodeServer_ListFeatureFlags(t *testing.T) {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{NoGenesisDelay: true})
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+
+	ns := &NodeServer{}
+	res, err := ns.ListFeatureFlags(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Enabled) != 1 || res.Enabled[0] != "NoGenesisDelay" {
+		t.Errorf("Wanted enabled features [NoGenesisDelay], received %v", res.Enabled)
+	}
+}
+
+func TestNodeServer_GetEth1Status(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		deposit := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte{byte(i)}}}
+		beaconDB.InsertDeposit(ctx, deposit, big.NewInt(int64(i)), i, [32]byte{})
+		if i > 0 {
+			beaconDB.InsertPendingDeposit(ctx, deposit, big.NewInt(int64(i)), i, [32]byte{})
+		}
+	}
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{Eth1DepositIndex: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	blockTime := time.Unix(1000, 0)
+	ns := &NodeServer{
+		beaconDB: beaconDB,
+		powChainService: &mockPOWChainService{
+			latestBlockNumber: big.NewInt(100),
+			latestBlockHash:   common.BytesToHash([]byte("hash")),
+			latestBlockTime:   blockTime,
+		},
+	}
+	res, err := ns.GetEth1Status(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Connected || res.ConnectionError != "" {
+		t.Errorf("Expected node to be connected, received %v", res)
+	}
+	if res.LatestBlockNumber != 100 {
+		t.Errorf("Wanted latest block number 100, received %d", res.LatestBlockNumber)
+	}
+	if !bytes.Equal(res.LatestBlockHash, common.BytesToHash([]byte("hash")).Bytes()) {
+		t.Errorf("Wanted latest block hash %#x, received %#x", common.BytesToHash([]byte("hash")), res.LatestBlockHash)
+	}
+	if res.LatestBlockTime.Seconds != blockTime.Unix() {
+		t.Errorf("Wanted latest block time %d, received %d", blockTime.Unix(), res.LatestBlockTime.Seconds)
+	}
+	if res.DepositCount != 3 || res.PendingDepositCount != 2 || res.ProcessedDepositCount != 1 {
+		t.Errorf("Wanted 3 deposits with 2 pending and 1 processed, received %v", res)
+	}
+
+	ns.powChainService = &faultyPOWChainService{}
+	res, err = ns.GetEth1Status(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Connected || res.ConnectionError == "" {
+		t.Errorf("Expected node to report the eth1 connection error, received %v", res)
+	}
+}
+
+func TestNodeServer_GetForkSchedule(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	cfg := *params.BeaconConfig()
+	cfg.NextForkVersion = []byte{1, 0, 0, 0}
+	cfg.NextForkEpoch = 2
+	params.OverrideBeaconConfig(&cfg)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	ns := &NodeServer{beaconDB: beaconDB}
+	for _, tt := range []struct {
+		epoch   uint64
+		version []byte
+	}{
+		{epoch: 1, version: cfg.GenesisForkVersion},
+		{epoch: 2, version: cfg.NextForkVersion},
+	} {
+		headState := &pb.BeaconState{Slot: tt.epoch * params.BeaconConfig().SlotsPerEpoch}
+		if err := beaconDB.SaveState(ctx, headState); err != nil {
+			t.Fatal(err)
+		}
+		res, err := ns.GetForkSchedule(ctx, &ptypes.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Forks) != 2 || res.Forks[1].Epoch != 2 {
+			t.Fatalf("Wanted genesis fork and next fork at epoch 2, received %v", res.Forks)
+		}
+		if res.CurrentEpoch != tt.epoch {
+			t.Errorf("Wanted current epoch %d, received %d", tt.epoch, res.CurrentEpoch)
+		}
+		if !bytes.Equal(res.CurrentFork.Version, tt.version) {
+			t.Errorf("Wanted current fork version %#x at epoch %d, received %#x", tt.version, tt.epoch, res.CurrentFork.Version)
+		}
+	}
+}
@@ -2,23 +2,26 @@ package rpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ProposerServer defines a server implementation of the gRPC Proposer service,
@@ -30,11 +33,15 @@ type ProposerServer struct {
 	powChainService    powChainService
 	operationService   operationService
 	canonicalStateChan chan *pbp2p.BeaconState
+	syncReporter       sync.StateReporter
 }
 
 // RequestBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot.
 func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest) (*ethpb.BeaconBlock, error) {
+	if err := checkSynced(ps.syncReporter); err != nil {
+		return nil, err
+	}
 
 	// Retrieve the parent block as the current head of the canonical chain
 	parent, err := ps.beaconDB.ChainHead()
@@ -66,6 +73,31 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 		return nil, fmt.Errorf("could not get pending attestations: %v", err)
 	}
 
+	// Pack the slashings detected or received by the node which are still slashable.
+	proposerSlashings, err := ps.operationService.PendingProposerSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending proposer slashings: %v", err)
+	}
+	if proposerSlashings == nil {
+		proposerSlashings = []*ethpb.ProposerSlashing{}
+	}
+	attesterSlashings, err := ps.operationService.PendingAttesterSlashings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending attester slashings: %v", err)
+	}
+	if attesterSlashings == nil {
+		attesterSlashings = []*ethpb.AttesterSlashing{}
+	}
+
+	// Pack the voluntary exits which can be processed on top of the head state.
+	exits, err := ps.operationService.PendingExits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pending exits: %v", err)
+	}
+	if exits == nil {
+		exits = []*ethpb.VoluntaryExit{}
+	}
+
 	// Use zero hash as stub for state root to compute later.
 	stateRoot := params.BeaconConfig().ZeroHash[:]
 
@@ -82,9 +114,9 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 			RandaoReveal: req.RandaoReveal,
 			// TODO(2766): Implement rest of the retrievals for beacon block operations
 			Transfers:         []*ethpb.Transfer{},
-			ProposerSlashings: []*ethpb.ProposerSlashing{},
-			AttesterSlashings: []*ethpb.AttesterSlashing{},
-			VoluntaryExits:    []*ethpb.VoluntaryExit{},
+			ProposerSlashings: proposerSlashings,
+			AttesterSlashings: attesterSlashings,
+			VoluntaryExits:    exits,
 			Graffiti:          []byte{},
 		},
 		Signature: emptySig,
@@ -103,20 +135,42 @@ func (ps *ProposerServer) RequestBlock(ctx context.Context, req *pb.BlockRequest
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
 // to get it processed by the beacon node as the canonical head.
 func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlock) (*pb.ProposeResponse, error) {
+	if err := checkSynced(ps.syncReporter); err != nil {
+		return nil, err
+	}
 	root, err := ssz.SigningRoot(blk)
 	if err != nil {
-		return nil, fmt.Errorf("could not tree hash block: %v", err)
+		return nil, status.Errorf(codes.Internal, "could not tree hash block: %v", err)
 	}
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).Debugf(
 		"Block proposal received via RPC")
 
+	headState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	if err := checkNotFutureSlot(headState, blk.Slot); err != nil {
+		return nil, err
+	}
+	if err := checkNotDoubleProposal(ctx, ps.beaconDB, blk, root); err != nil {
+		return nil, err
+	}
+
 	beaconState, err := ps.chainService.ReceiveBlock(ctx, blk)
 	if err != nil {
-		return nil, fmt.Errorf("could not process beacon block: %v", err)
+		if err == blockchain.ErrParentNotFound {
+			return nil, rpcerror.Errorf(codes.FailedPrecondition, rpcerror.ReasonUnknownParent,
+				"parent %#x of block is not known", blk.ParentRoot)
+		}
+		if _, ok := err.(*blockchain.BlockFailedProcessingErr); ok {
+			return nil, rpcerror.Errorf(codes.InvalidArgument, rpcerror.ReasonFailedStateTransition,
+				"could not process beacon block: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "could not process beacon block: %v", err)
 	}
 
 	if err := ps.beaconDB.UpdateChainHead(ctx, blk, beaconState); err != nil {
-		return nil, fmt.Errorf("failed to update chain: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update chain: %v", err)
 	}
 
 	ps.chainService.UpdateCanonicalRoots(blk, root)
@@ -128,6 +182,48 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlo
 	return &pb.ProposeResponse{BlockRoot: root[:]}, nil
 }
 
+// RequestUnsignedBlock is called by a proposer which signs its blocks outside of the
+// validator client. Besides the block, it returns the signing root of the block and the
+// proposer signature domain of its epoch, which is all a signer needs to sign the block.
+func (ps *ProposerServer) RequestUnsignedBlock(ctx context.Context, req *pb.BlockRequest) (*pb.UnsignedBlockResponse, error) {
+	blk, err := ps.RequestBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	blk.Signature = nil
+
+	root, err := ssz.SigningRoot(blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get block signing root: %v", err)
+	}
+	headState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	domain := helpers.Domain(headState, helpers.SlotToEpoch(req.Slot), params.BeaconConfig().DomainBeaconProposer)
+
+	return &pb.UnsignedBlockResponse{
+		Block:           blk,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+	}, nil
+}
+
+// PublishSignedBlock is called by a proposer to propose a block returned by
+// RequestUnsignedBlock once it has been signed.
+func (ps *ProposerServer) PublishSignedBlock(ctx context.Context, req *pb.SignedBlockRequest) (*pb.ProposeResponse, error) {
+	if req.Block == nil {
+		return nil, status.Error(codes.InvalidArgument, "no block to publish")
+	}
+	if sigLength := len(params.BeaconConfig().EmptySignature); len(req.Signature) != sigLength {
+		return nil, status.Errorf(codes.InvalidArgument, "expected signature of %d bytes, received %d bytes",
+			sigLength, len(req.Signature))
+	}
+	blk := proto.Clone(req.Block).(*ethpb.BeaconBlock)
+	blk.Signature = req.Signature
+	return ps.ProposeBlock(ctx, blk)
+}
+
 // attestations retrieves aggregated attestations kept in the beacon node's operations pool which have
 // not yet been included into the beacon chain. Proposers include these pending attestations in their
 // proposed blocks when performing their responsibility. If desired, callers can choose to filter pending
@@ -252,86 +348,40 @@ func (ps *ProposerServer) computeStateRoot(ctx context.Context, block *ethpb.Bea
 // enough support, then use that vote for basis of determining deposits, otherwise use current state
 // eth1data.
 func (ps *ProposerServer) deposits(ctx context.Context, currentVote *ethpb.Eth1Data) ([]*ethpb.Deposit, error) {
-	bNum := ps.powChainService.LatestBlockHeight()
-	if bNum == nil {
-		return nil, errors.New("latest PoW block number is unknown")
-	}
-	// Only request deposits that have passed the ETH1 follow distance window.
-	bNum = bNum.Sub(bNum, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
-	allDeps := ps.beaconDB.AllDeposits(ctx, bNum)
-	if len(allDeps) == 0 {
-		return nil, nil
-	}
-
-	// Need to fetch if the deposits up to the state's latest eth 1 data matches
-	// the number of all deposits in this RPC call. If not, then we return nil.
 	beaconState, err := ps.beaconDB.HeadState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
-	var eth1BlockHash [32]byte
-	hasSupport, err := blocks.Eth1DataHasEnoughSupport(beaconState, currentVote)
-	if err != nil {
-		return nil, fmt.Errorf("could not determine if current eth1data vote has enough support: %v", err)
-	}
-	if hasSupport {
-		eth1BlockHash = bytesutil.ToBytes32(currentVote.BlockHash)
-	} else {
-		eth1BlockHash = bytesutil.ToBytes32(beaconState.Eth1Data.BlockHash)
-	}
-	_, latestEth1DataHeight, err := ps.powChainService.BlockExists(ctx, eth1BlockHash)
-	if err != nil {
-		return nil, fmt.Errorf("could not fetch eth1data height: %v", err)
-	}
-	// If the state's latest eth1 data's block hash has a height of 100, we fetch all the deposits up to height 100.
-	// If this doesn't match the number of deposits stored in the cache, the generated trie will not be the same and
-	// root will fail to verify. This can happen in a scenario where we perhaps have a deposit from height 101,
-	// so we want to avoid any possible mismatches in these lengths.
-	upToEth1DataDeposits := ps.beaconDB.AllDeposits(ctx, latestEth1DataHeight)
-	if len(upToEth1DataDeposits) != len(allDeps) {
-		return nil, nil
-	}
-	depositData := [][]byte{}
-	for _, dep := range upToEth1DataDeposits {
-		depHash, err := hashutil.DepositHash(dep.Data)
-		if err != nil {
-			return nil, fmt.Errorf("coulf not hash deposit data %v", err)
+	// Deposits are verified against the eth1 data of the state after the block's eth1 data
+	// vote is processed, which is the current vote if it wins with this block. The votes are
+	// counted here rather than through the eth1 data vote cache, which block processing updates.
+	voteCount := uint64(1)
+	for _, vote := range beaconState.Eth1DataVotes {
+		if proto.Equal(vote, currentVote) {
+			voteCount++
 		}
-		depositData = append(depositData, depHash[:])
 	}
-
-	depositTrie, err := trieutil.GenerateTrieFromItems(depositData, int(params.BeaconConfig().DepositContractTreeDepth))
-	if err != nil {
-		return nil, fmt.Errorf("could not generate historical deposit trie from deposits: %v", err)
+	eth1Data := beaconState.Eth1Data
+	if voteCount*2 > params.BeaconConfig().SlotsPerEth1VotingPeriod {
+		eth1Data = currentVote
 	}
 
-	allPendingContainers := ps.beaconDB.PendingContainers(ctx, bNum)
-
-	// Deposits need to be received in order of merkle index root, so this has to make sure
-	// deposits are sorted from lowest to highest.
-	var pendingDeps []*db.DepositContainer
-	for _, dep := range allPendingContainers {
-		if uint64(dep.Index) >= beaconState.Eth1DepositIndex {
-			pendingDeps = append(pendingDeps, dep)
-		}
+	// Deposits need to be included in order of merkle index, starting from the state's
+	// deposit index, up to the deposit count of the eth1 data, and no more than the max
+	// deposits allowed in a block.
+	start := beaconState.Eth1DepositIndex
+	if start >= eth1Data.DepositCount {
+		return nil, nil
 	}
-
-	for i := range pendingDeps {
-		// Don't construct merkle proof if the number of deposits is more than max allowed in block.
-		if uint64(i) == params.BeaconConfig().MaxDeposits {
-			break
-		}
-		pendingDeps[i].Deposit, err = constructMerkleProof(depositTrie, pendingDeps[i].Index, pendingDeps[i].Deposit)
-		if err != nil {
-			return nil, err
-		}
+	end := eth1Data.DepositCount
+	if end-start > params.BeaconConfig().MaxDeposits {
+		end = start + params.BeaconConfig().MaxDeposits
 	}
-	// Limit the return of pending deposits to not be more than max deposits allowed in block.
-	var pendingDeposits []*ethpb.Deposit
-	for i := 0; i < len(pendingDeps) && i < int(params.BeaconConfig().MaxDeposits); i++ {
-		pendingDeposits = append(pendingDeposits, pendingDeps[i].Deposit)
+	deposits, err := ps.beaconDB.DepositsWithProofs(ctx, eth1Data, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch deposits with proofs: %v", err)
 	}
-	return pendingDeposits, nil
+	return deposits, nil
 }
 
 // in case no vote for new eth1data vote considered best vote we
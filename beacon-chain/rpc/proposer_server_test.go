@@ -1,26 +1,34 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"math/big"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -67,6 +75,122 @@ func TestProposeBlock_OK(t *testing.T) {
 	}
 }
 
+func TestProposeBlock_RejectionReasons(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	genesisTime := uint64(time.Now().Unix()) - 10*params.BeaconConfig().SecondsPerSlot
+	if err := db.UpdateChainHead(ctx, genesis, &pbp2p.BeaconState{GenesisTime: genesisTime}); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	existing := &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte("parent-hash")}
+	if err := db.SaveBlock(existing); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		receiveErr error
+		block      *ethpb.BeaconBlock
+		code       codes.Code
+		reason     rpcerror.Reason
+	}{
+		{
+			name:   "future slot",
+			block:  &ethpb.BeaconBlock{Slot: 100, ParentRoot: []byte("parent-hash")},
+			code:   codes.FailedPrecondition,
+			reason: rpcerror.ReasonFutureSlot,
+		},
+		{
+			name:   "double proposal",
+			block:  &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte("parent-hash"), StateRoot: []byte("other")},
+			code:   codes.PermissionDenied,
+			reason: rpcerror.ReasonSlashable,
+		},
+		{
+			name:       "unknown parent",
+			receiveErr: blockchain.ErrParentNotFound,
+			block:      &ethpb.BeaconBlock{Slot: 6, ParentRoot: []byte("unknown")},
+			code:       codes.FailedPrecondition,
+			reason:     rpcerror.ReasonUnknownParent,
+		},
+		{
+			name:       "failed state transition",
+			receiveErr: &blockchain.BlockFailedProcessingErr{},
+			block:      &ethpb.BeaconBlock{Slot: 7, ParentRoot: []byte("parent-hash")},
+			code:       codes.InvalidArgument,
+			reason:     rpcerror.ReasonFailedStateTransition,
+		},
+		{
+			name:       "internal failure",
+			receiveErr: errors.New("disk full"),
+			block:      &ethpb.BeaconBlock{Slot: 8, ParentRoot: []byte("parent-hash")},
+			code:       codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		proposerServer := &ProposerServer{
+			chainService:    &mockChainService{receiveBlockErr: tt.receiveErr},
+			beaconDB:        db,
+			powChainService: &mockPOWChainService{},
+		}
+		_, err := proposerServer.ProposeBlock(ctx, tt.block)
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: expected code %v, received %v", tt.name, tt.code, err)
+		}
+		if reason := rpcerror.ReasonOf(err); reason != tt.reason {
+			t.Errorf("%s: expected reason %q, received %q", tt.name, tt.reason, reason)
+		}
+	}
+}
+
+func TestPublishSignedBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	genesisTime := uint64(time.Now().Unix()) - 10*params.BeaconConfig().SecondsPerSlot
+	if err := db.UpdateChainHead(ctx, genesis, &pbp2p.BeaconState{GenesisTime: genesisTime}); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	proposerServer := &ProposerServer{
+		chainService:    &mockChainService{},
+		beaconDB:        db,
+		powChainService: &mockPOWChainService{},
+	}
+	blk := &ethpb.BeaconBlock{Slot: 5, ParentRoot: []byte("parent-hash")}
+	signature := make([]byte, 96)
+	signature[0] = 'a'
+
+	if _, err := proposerServer.PublishSignedBlock(ctx, &pb.SignedBlockRequest{Signature: signature}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for missing block, received %v", err)
+	}
+	if _, err := proposerServer.PublishSignedBlock(ctx, &pb.SignedBlockRequest{Block: blk, Signature: []byte{'a'}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for short signature, received %v", err)
+	}
+
+	if _, err := proposerServer.PublishSignedBlock(ctx, &pb.SignedBlockRequest{Block: blk, Signature: signature}); err != nil {
+		t.Fatalf("Could not publish signed block: %v", err)
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Slot != blk.Slot || !bytes.Equal(head.Signature, signature) {
+		t.Errorf("Expected signed block at slot %d as head, received %v", blk.Slot, head)
+	}
+}
+
 func TestComputeStateRoot_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -370,28 +494,9 @@ func TestPendingAttestations_FiltersExpiredAttestations(t *testing.T) {
 	}
 }
 
-func TestPendingDeposits_UnknownBlockNum(t *testing.T) {
-	p := &mockPOWChainService{
-		latestBlockNumber: nil,
-	}
-	ps := ProposerServer{powChainService: p}
-
-	_, err := ps.deposits(context.Background(), &ethpb.Eth1Data{})
-	if err.Error() != "latest PoW block number is unknown" {
-		t.Errorf("Received unexpected error: %v", err)
-	}
-}
-
-func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
+func TestPendingDeposits_UpToEth1DataDepositCount(t *testing.T) {
 	ctx := context.Background()
 
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	p := &mockPOWChainService{
-		latestBlockNumber: height,
-		hashesByHeight: map[int][]byte{
-			int(height.Int64()): []byte("0x0"),
-		},
-	}
 	d := internal.SetupDB(t)
 
 	beaconState := &pbp2p.BeaconState{
@@ -464,14 +569,23 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 		}
 
 		d.InsertDeposit(ctx, dp.Deposit, big.NewInt(int64(dp.Index)), dp.Index, depositTrie.Root())
+		if dp.Index == len(readyDeposits)-1 {
+			// The state's eth1 data only covers the ready deposits.
+			root := depositTrie.Root()
+			beaconState.Eth1Data.DepositCount = uint64(len(readyDeposits))
+			beaconState.Eth1Data.DepositRoot = root[:]
+		}
 	}
 	for _, dp := range recentDeposits {
 		d.InsertPendingDeposit(ctx, dp.Deposit, big.NewInt(int64(dp.Index)), dp.Index, depositTrie.Root())
 	}
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
 
 	bs := &ProposerServer{
 		beaconDB:        d,
-		powChainService: p,
+		powChainService: &mockPOWChainService{},
 		chainService:    newMockChainService(),
 	}
 
@@ -483,8 +597,37 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 		t.Errorf("Received unexpected list of deposits: %+v, wanted: 0", len(deposits))
 	}
 
-	// It should also return the recent deposits after their follow window.
-	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
+	// It should return the recent deposits if they are covered by a winning eth1 data vote.
+	root := depositTrie.Root()
+	vote := &ethpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: uint64(len(readyDeposits) + len(recentDeposits)),
+		BlockHash:    []byte("0x1"),
+	}
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerEth1VotingPeriod/2; i++ {
+		beaconState.Eth1DataVotes = append(beaconState.Eth1DataVotes, vote)
+	}
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	deposits, err = bs.deposits(ctx, vote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deposits) != len(recentDeposits) {
+		t.Errorf(
+			"Received unexpected number of pending deposits: %d, wanted: %d",
+			len(deposits),
+			len(recentDeposits),
+		)
+	}
+
+	// It should return the recent deposits once they are covered by the state's eth1 data.
+	beaconState.Eth1DataVotes = nil
+	beaconState.Eth1Data = vote
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
 	deposits, err = bs.deposits(ctx, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
@@ -501,13 +644,6 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 func TestPendingDeposits_CantReturnBelowStateEth1DepositIndex(t *testing.T) {
 	ctx := context.Background()
 
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	p := &mockPOWChainService{
-		latestBlockNumber: height,
-		hashesByHeight: map[int][]byte{
-			int(height.Int64()): []byte("0x0"),
-		},
-	}
 	d := internal.SetupDB(t)
 
 	beaconState := &pbp2p.BeaconState{
@@ -575,15 +711,19 @@ func TestPendingDeposits_CantReturnBelowStateEth1DepositIndex(t *testing.T) {
 	for _, dp := range recentDeposits {
 		d.InsertPendingDeposit(ctx, dp.Deposit, big.NewInt(int64(dp.Index)), dp.Index, depositTrie.Root())
 	}
+	root := depositTrie.Root()
+	beaconState.Eth1Data.DepositCount = uint64(len(readyDeposits) + len(recentDeposits))
+	beaconState.Eth1Data.DepositRoot = root[:]
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
 
 	bs := &ProposerServer{
 		beaconDB:        d,
-		powChainService: p,
+		powChainService: &mockPOWChainService{},
 		chainService:    newMockChainService(),
 	}
 
-	// It should also return the recent deposits after their follow window.
-	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
 	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
@@ -602,13 +742,6 @@ func TestPendingDeposits_CantReturnBelowStateEth1DepositIndex(t *testing.T) {
 func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	ctx := context.Background()
 
-	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
-	p := &mockPOWChainService{
-		latestBlockNumber: height,
-		hashesByHeight: map[int][]byte{
-			int(height.Int64()): []byte("0x0"),
-		},
-	}
 	d := internal.SetupDB(t)
 
 	beaconState := &pbp2p.BeaconState{
@@ -675,15 +808,19 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	for _, dp := range recentDeposits {
 		d.InsertPendingDeposit(ctx, dp.Deposit, big.NewInt(int64(dp.Index)), dp.Index, depositTrie.Root())
 	}
+	root := depositTrie.Root()
+	beaconState.Eth1Data.DepositCount = uint64(len(readyDeposits) + len(recentDeposits))
+	beaconState.Eth1Data.DepositRoot = root[:]
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
 
 	bs := &ProposerServer{
 		beaconDB:        d,
-		powChainService: p,
+		powChainService: &mockPOWChainService{},
 		chainService:    newMockChainService(),
 	}
 
-	// It should also return the recent deposits after their follow window.
-	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
 	deposits, err := bs.deposits(ctx, &ethpb.Eth1Data{})
 	if err != nil {
 		t.Fatal(err)
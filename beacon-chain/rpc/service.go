@@ -10,9 +10,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
-	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
-	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -21,13 +18,13 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/grpcutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
-	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -38,7 +35,8 @@ func init() {
 }
 
 type chainService interface {
-	StateInitializedFeed() *event.Feed
+	StateInitializedFeed() *event.Topic
+	HeadUpdatedFeed() *event.Topic
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 	blockchain.TargetsFetcher
@@ -46,9 +44,14 @@ type chainService interface {
 
 type operationService interface {
 	PendingAttestations(ctx context.Context) ([]*ethpb.Attestation, error)
+	PendingProposerSlashings(ctx context.Context) ([]*ethpb.ProposerSlashing, error)
+	PendingAttesterSlashings(ctx context.Context) ([]*ethpb.AttesterSlashing, error)
+	PendingExits(ctx context.Context) ([]*ethpb.VoluntaryExit, error)
 	IsAttCanonical(ctx context.Context, att *ethpb.Attestation) (bool, error)
 	HandleAttestations(context.Context, proto.Message) error
-	IncomingAttFeed() *event.Feed
+	HandleValidatorExits(context.Context, proto.Message) error
+	IncomingAttFeed() *event.Topic
+	AcceptedAttFeed() *event.Topic
 }
 
 type powChainService interface {
@@ -56,6 +59,9 @@ type powChainService interface {
 	ETH2GenesisTime() uint64
 	ChainStartFeed() *event.Feed
 	LatestBlockHeight() *big.Int
+	LatestBlockHash() common.Hash
+	LatestBlockTime() time.Time
+	Status() error
 	BlockExists(ctx context.Context, hash common.Hash) (bool, *big.Int, error)
 	BlockHashByHeight(ctx context.Context, height *big.Int) (common.Hash, error)
 	BlockTimeByHeight(ctx context.Context, height *big.Int) (uint64, error)
@@ -70,6 +76,7 @@ type powChainService interface {
 type syncService interface {
 	Status() error
 	sync.Checker
+	sync.StateReporter
 }
 
 // Service defining an RPC server for a beacon node.
@@ -85,11 +92,18 @@ type Service struct {
 	listener            net.Listener
 	withCert            string
 	withKey             string
+	withClientCA        string
+	authToken           string
+	dataDir             string
+	profileDuration     time.Duration
+	limits              ServerLimits
 	grpcServer          *grpc.Server
 	canonicalStateChan  chan *pbp2p.BeaconState
 	incomingAttestation chan *ethpb.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	peersProvider       p2p.PeersProvider
+	supervisor          *supervisor.Supervisor
 }
 
 // Config options for the beacon node RPC server.
@@ -97,12 +111,19 @@ type Config struct {
 	Port             string
 	CertFlag         string
 	KeyFlag          string
+	ClientCAFlag     string
+	AuthToken        string
+	DataDir          string
+	ProfileDuration  time.Duration
+	Limits           ServerLimits
 	BeaconDB         *db.BeaconDB
 	ChainService     chainService
 	POWChainService  powChainService
 	OperationService operationService
 	SyncService      syncService
 	Broadcaster      p2p.Broadcaster
+	PeersProvider    p2p.PeersProvider
+	RestartPolicy    supervisor.Policy
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -114,6 +135,7 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		cancel:              cancel,
 		beaconDB:            cfg.BeaconDB,
 		p2p:                 cfg.Broadcaster,
+		peersProvider:       cfg.PeersProvider,
 		chainService:        cfg.ChainService,
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
@@ -121,8 +143,14 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		port:                cfg.Port,
 		withCert:            cfg.CertFlag,
 		withKey:             cfg.KeyFlag,
+		withClientCA:        cfg.ClientCAFlag,
+		authToken:           cfg.AuthToken,
+		dataDir:             cfg.DataDir,
+		profileDuration:     cfg.ProfileDuration,
+		limits:              cfg.Limits,
 		canonicalStateChan:  make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
 		incomingAttestation: make(chan *ethpb.Attestation, params.BeaconConfig().DefaultBufferSize),
+		supervisor:          supervisor.New(ctx, "rpc", cfg.RestartPolicy),
 	}
 }
 
@@ -136,28 +164,30 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.WithField("port", s.port).Info("Listening on port")
 
-	opts := []grpc.ServerOption{
-		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.StreamInterceptor(middleware.ChainStreamServer(
-			recovery.StreamServerInterceptor(),
-			grpc_prometheus.StreamServerInterceptor,
-		)),
-		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
-			recovery.UnaryServerInterceptor(),
-			grpc_prometheus.UnaryServerInterceptor,
-		)),
-	}
-	// TODO(#791): Utilize a certificate for secure connections
-	// between beacon nodes and validator clients.
+	rateLimiter := newMethodRateLimiter(s.limits.RateLimits)
+	opts := grpcutil.ServerOptions(
+		[]grpc.UnaryServerInterceptor{
+			authUnaryInterceptor(s.authToken),
+			rateLimiter.unaryInterceptor(),
+		},
+		[]grpc.StreamServerInterceptor{
+			authStreamInterceptor(s.authToken),
+			rateLimiter.streamInterceptor(),
+		},
+	)
+	opts = append(opts, s.limits.serverOptions()...)
 	if s.withCert != "" && s.withKey != "" {
-		creds, err := credentials.NewServerTLSFromFile(s.withCert, s.withKey)
+		creds, err := serverCredentials(s.withCert, s.withKey, s.withClientCA)
 		if err != nil {
-			log.Errorf("Could not load TLS keys: %s", err)
+			log.Errorf("Could not load TLS credentials: %v", err)
 			s.credentialError = err
 		}
 		opts = append(opts, grpc.Creds(creds))
 	} else {
 		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
+		if s.authToken != "" {
+			log.Warn("The RPC auth token is sent unencrypted without a TLS certificate and key")
+		}
 	}
 	s.grpcServer = grpc.NewServer(opts...)
 
@@ -178,12 +208,14 @@ func (s *Service) Start() {
 		powChainService:    s.powChainService,
 		operationService:   s.operationService,
 		canonicalStateChan: s.canonicalStateChan,
+		syncReporter:       s.syncService,
 	}
 	attesterServer := &AttesterServer{
 		beaconDB:         s.beaconDB,
 		operationService: s.operationService,
 		p2p:              s.p2p,
 		cache:            cache.NewAttestationCache(),
+		syncReporter:     s.syncService,
 	}
 	validatorServer := &ValidatorServer{
 		ctx:                s.ctx,
@@ -191,26 +223,48 @@ func (s *Service) Start() {
 		chainService:       s.chainService,
 		canonicalStateChan: s.canonicalStateChan,
 		powChainService:    s.powChainService,
+		operationService:   s.operationService,
+		p2p:                s.p2p,
+		syncReporter:       s.syncService,
 	}
 	nodeServer := &NodeServer{
-		beaconDB:    s.beaconDB,
-		server:      s.grpcServer,
-		syncChecker: s.syncService,
+		beaconDB:        s.beaconDB,
+		server:          s.grpcServer,
+		syncChecker:     s.syncService,
+		peersProvider:   s.peersProvider,
+		powChainService: s.powChainService,
 	}
 	beaconChainServer := &BeaconChainServer{
+		ctx:          s.ctx,
+		beaconDB:     s.beaconDB,
+		chainService: s.chainService,
+		pool:         s.operationService,
+	}
+	debugServer := &DebugServer{
 		beaconDB: s.beaconDB,
 	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
 	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
 	pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
 	pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
+	pb.RegisterDebugServiceServer(s.grpcServer, debugServer)
+	// Toggling features is reserved to the operators, who are authenticated by the
+	// auth token.
+	if s.authToken != "" {
+		adminServer := &AdminServer{
+			dataDir:         s.dataDir,
+			profileDuration: s.profileDuration,
+		}
+		pb.RegisterAdminServiceServer(s.grpcServer, adminServer)
+	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	grpcutil.RegisterMetrics(s.grpcServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
 
-	go func() {
+	s.supervisor.Go("serve", func() {
 		for s.syncService.Status() != nil {
 			time.Sleep(time.Second * params.BeaconConfig().RPCSyncCheck)
 		}
@@ -219,7 +273,7 @@ func (s *Service) Start() {
 				log.Errorf("Could not serve gRPC: %v", err)
 			}
 		}
-	}()
+	})
 }
 
 // Stop the service.
@@ -233,10 +287,10 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// Status returns nil or credentialError
+// Status returns nil, credentialError or the error of a goroutine which panicked too many times.
 func (s *Service) Status() error {
 	if s.credentialError != nil {
 		return s.credentialError
 	}
-	return nil
+	return s.supervisor.Status()
 }
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -24,25 +26,52 @@ func init() {
 }
 
 type mockOperationService struct {
-	pendingAttestations []*ethpb.Attestation
+	pendingAttestations      []*ethpb.Attestation
+	pendingProposerSlashings []*ethpb.ProposerSlashing
+	pendingAttesterSlashings []*ethpb.AttesterSlashing
+	pendingExits             []*ethpb.VoluntaryExit
+	acceptedAttFeed          *event.Topic
 }
 
-func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockOperationService) IncomingAttFeed() *event.Topic {
+	return new(event.Topic)
 }
 
-func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockOperationService) AcceptedAttFeed() *event.Topic {
+	if ms.acceptedAttFeed == nil {
+		return new(event.Topic)
+	}
+	return ms.acceptedAttFeed
+}
+
+func (ms *mockOperationService) IncomingExitFeed() *event.Topic {
+	return new(event.Topic)
 }
 
 func (ms *mockOperationService) HandleAttestations(_ context.Context, _ proto.Message) error {
 	return nil
 }
 
+func (ms *mockOperationService) HandleValidatorExits(_ context.Context, _ proto.Message) error {
+	return nil
+}
+
 func (ms *mockOperationService) IsAttCanonical(_ context.Context, att *ethpb.Attestation) (bool, error) {
 	return true, nil
 }
 
+func (ms *mockOperationService) PendingProposerSlashings(_ context.Context) ([]*ethpb.ProposerSlashing, error) {
+	return ms.pendingProposerSlashings, nil
+}
+
+func (ms *mockOperationService) PendingAttesterSlashings(_ context.Context) ([]*ethpb.AttesterSlashing, error) {
+	return ms.pendingAttesterSlashings, nil
+}
+
+func (ms *mockOperationService) PendingExits(_ context.Context) ([]*ethpb.VoluntaryExit, error) {
+	return ms.pendingExits, nil
+}
+
 func (ms *mockOperationService) PendingAttestations(_ context.Context) ([]*ethpb.Attestation, error) {
 	if ms.pendingAttestations != nil {
 		return ms.pendingAttestations, nil
@@ -82,16 +111,28 @@ type mockChainService struct {
 	blockFeed            *event.Feed
 	stateFeed            *event.Feed
 	attestationFeed      *event.Feed
-	stateInitializedFeed *event.Feed
+	stateInitializedFeed *event.Topic
+	headUpdatedFeed      *event.Topic
+	receiveBlockErr      error
 	canonicalBlocks      map[uint64][]byte
 	targets              map[uint64]*pb.AttestationTarget
 }
 
-func (m *mockChainService) StateInitializedFeed() *event.Feed {
+func (m *mockChainService) StateInitializedFeed() *event.Topic {
 	return m.stateInitializedFeed
 }
 
+func (m *mockChainService) HeadUpdatedFeed() *event.Topic {
+	if m.headUpdatedFeed == nil {
+		return new(event.Topic)
+	}
+	return m.headUpdatedFeed
+}
+
 func (m *mockChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	if m.receiveBlockErr != nil {
+		return nil, m.receiveBlockErr
+	}
 	return &pb.BeaconState{}, nil
 }
 
@@ -99,8 +140,8 @@ func (m *mockChainService) ApplyForkChoiceRule(ctx context.Context, block *ethpb
 	return nil
 }
 
-func (m *mockChainService) CanonicalBlockFeed() *event.Feed {
-	return new(event.Feed)
+func (m *mockChainService) CanonicalBlockFeed() *event.Topic {
+	return new(event.Topic)
 }
 
 func (m *mockChainService) UpdateCanonicalRoots(block *ethpb.BeaconBlock, root [32]byte) {
@@ -124,7 +165,7 @@ func newMockChainService() *mockChainService {
 		blockFeed:            new(event.Feed),
 		stateFeed:            new(event.Feed),
 		attestationFeed:      new(event.Feed),
-		stateInitializedFeed: new(event.Feed),
+		stateInitializedFeed: new(event.Topic),
 	}
 }
 
@@ -139,6 +180,14 @@ func (ms *mockSyncService) Syncing() bool {
 	return false
 }
 
+func (ms *mockSyncService) SyncState() sync.State {
+	return sync.Synced
+}
+
+func (ms *mockSyncService) EstimatedSyncCompletion() time.Duration {
+	return 0
+}
+
 func TestLifecycle_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	rpcService := NewRPCService(context.Background(), &Config{
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkNotFutureSlot returns a FailedPrecondition error if the slot of a submission has
+// not started yet according to the wall clock and the genesis time of the head state.
+func checkNotFutureSlot(headState *pbp2p.BeaconState, slot uint64) error {
+	if headState == nil {
+		return nil
+	}
+	if untilStart := clockutil.Until(slotutil.SlotStartTime(headState.GenesisTime, slot)); untilStart > 0 {
+		return rpcerror.Errorf(codes.FailedPrecondition, rpcerror.ReasonFutureSlot,
+			"slot %d starts in %ds", slot, int64(untilStart.Seconds()+0.5))
+	}
+	return nil
+}
+
+// checkNotDoubleProposal returns a PermissionDenied error if the DB already has a different
+// block for the same slot and parent. Both blocks were then proposed by the same validator,
+// which would be slashed for the double proposal.
+func checkNotDoubleProposal(ctx context.Context, beaconDB *db.BeaconDB, blk *ethpb.BeaconBlock, root [32]byte) error {
+	blocks, err := beaconDB.BlocksBySlot(ctx, blk.Slot)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not retrieve blocks at slot %d: %v", blk.Slot, err)
+	}
+	for _, existing := range blocks {
+		if !bytes.Equal(existing.ParentRoot, blk.ParentRoot) {
+			continue
+		}
+		existingRoot, err := ssz.SigningRoot(existing)
+		if err != nil {
+			return status.Errorf(codes.Internal, "could not tree hash block: %v", err)
+		}
+		if existingRoot != root {
+			return rpcerror.Errorf(codes.PermissionDenied, rpcerror.ReasonSlashable,
+				"refusing to accept block %#x, conflicting block %#x already proposed at slot %d",
+				root, existingRoot, blk.Slot)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package rpc
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errNodeSyncing is the message prefix of the error returned by validator-facing RPCs while
+// the beacon node is syncing, so validators can distinguish it from other failures.
+const errNodeSyncing = "node is syncing"
+
+// checkSynced returns a gRPC Unavailable error if the node is not synced with the network,
+// including the current sync state and the estimated time until completion if known.
+// Validators should not perform duties based on the head of a syncing node as it is stale.
+func checkSynced(reporter sync.StateReporter) error {
+	if reporter == nil {
+		return nil
+	}
+	syncState := reporter.SyncState()
+	if syncState == sync.Synced {
+		return nil
+	}
+	if eta := reporter.EstimatedSyncCompletion(); eta > 0 {
+		return status.Errorf(codes.Unavailable, "%s (%s), estimated completion in %v", errNodeSyncing, syncState, eta)
+	}
+	return status.Errorf(codes.Unavailable, "%s (%s)", errNodeSyncing, syncState)
+}
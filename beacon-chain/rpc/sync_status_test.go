@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockSyncReporter struct {
+	state sync.State
+	eta   time.Duration
+}
+
+func (m *mockSyncReporter) SyncState() sync.State {
+	return m.state
+}
+
+func (m *mockSyncReporter) EstimatedSyncCompletion() time.Duration {
+	return m.eta
+}
+
+func TestCheckSynced_Synced(t *testing.T) {
+	if err := checkSynced(&mockSyncReporter{state: sync.Synced}); err != nil {
+		t.Errorf("Expected no error for a synced node, received %v", err)
+	}
+	if err := checkSynced(nil); err != nil {
+		t.Errorf("Expected no error without a sync reporter, received %v", err)
+	}
+}
+
+func TestCheckSynced_Syncing(t *testing.T) {
+	err := checkSynced(&mockSyncReporter{state: sync.CatchingUp, eta: time.Minute})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("Expected error code %v, received %v", codes.Unavailable, status.Code(err))
+	}
+	if !strings.Contains(err.Error(), errNodeSyncing) {
+		t.Errorf("Expected error to contain %q, received %v", errNodeSyncing, err)
+	}
+	if !strings.Contains(err.Error(), "estimated completion in 1m0s") {
+		t.Errorf("Expected error to contain the estimated completion, received %v", err)
+	}
+}
+
+func TestRequestBlock_NodeSyncing(t *testing.T) {
+	proposerServer := &ProposerServer{
+		syncReporter: &mockSyncReporter{state: sync.InitialSyncing},
+	}
+	_, err := proposerServer.RequestBlock(context.Background(), &pb.BlockRequest{Slot: 1})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected error code %v, received %v", codes.Unavailable, err)
+	}
+}
@@ -8,15 +8,23 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ValidatorServer defines a server implementation of the gRPC Validator service,
@@ -29,41 +37,54 @@ type ValidatorServer struct {
 	chainService       chainService
 	canonicalStateChan chan *pbp2p.BeaconState
 	powChainService    powChainService
+	operationService   operationService
+	p2p                p2p.Broadcaster
+	syncReporter       sync.StateReporter
 }
 
-// WaitForActivation checks if a validator public key exists in the active validator registry of the current
-// beacon state, if not, then it creates a stream which listens for canonical states which contain
-// the validator with the public key as an active validator record.
+// WaitForActivation streams the statuses of a batch of validator public keys. The first
+// response contains the status of every key, and later responses only contain the keys
+// whose status changed since they were last sent. The stream ends once every key has
+// been activated or has exited.
 func (vs *ValidatorServer) WaitForActivation(req *pb.ValidatorActivationRequest, stream pb.ValidatorService_WaitForActivationServer) error {
-	activeValidatorExists, validatorStatuses, err := vs.MultipleValidatorStatus(stream.Context(), req.PublicKeys)
+	_, validatorStatuses, err := vs.multipleValidatorStatus(stream.Context(), req.PublicKeys)
 	if err != nil {
 		return err
 	}
+	sent := make(map[string]*pb.ValidatorStatusResponse, len(req.PublicKeys))
+	statusChanges(sent, validatorStatuses)
 	res := &pb.ValidatorActivationResponse{
 		Statuses: validatorStatuses,
 	}
-	if activeValidatorExists {
-		return stream.Send(res)
-	}
 	if err := stream.Send(res); err != nil {
 		return err
 	}
+	if activationSettled(sent, req.PublicKeys) {
+		return nil
+	}
 
+	ticker, err := vs.slotTicker(stream.Context())
+	if err != nil {
+		return err
+	}
+	defer ticker.Done()
 	for {
 		select {
-		case <-time.After(6 * time.Second):
-			activeValidatorExists, validatorStatuses, err := vs.MultipleValidatorStatus(stream.Context(), req.PublicKeys)
+		case <-ticker.C():
+			_, validatorStatuses, err := vs.multipleValidatorStatus(stream.Context(), req.PublicKeys)
 			if err != nil {
 				return err
 			}
-			res := &pb.ValidatorActivationResponse{
-				Statuses: validatorStatuses,
-			}
-			if activeValidatorExists {
-				return stream.Send(res)
+			if changed := statusChanges(sent, validatorStatuses); len(changed) > 0 {
+				res := &pb.ValidatorActivationResponse{
+					Statuses: changed,
+				}
+				if err := stream.Send(res); err != nil {
+					return err
+				}
 			}
-			if err := stream.Send(res); err != nil {
-				return err
+			if activationSettled(sent, req.PublicKeys) {
+				return nil
 			}
 		case <-stream.Context().Done():
 			return errors.New("stream context closed,exiting gorutine")
@@ -73,6 +94,59 @@ func (vs *ValidatorServer) WaitForActivation(req *pb.ValidatorActivationRequest,
 	}
 }
 
+// slotTicker returns a ticker for the start of every slot, aligned with the genesis time
+// of the head state, or with the current time before the chain has started.
+func (vs *ValidatorServer) slotTicker(ctx context.Context) (*slotutil.SlotTicker, error) {
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	genesis := time.Now()
+	if headState != nil {
+		genesis = time.Unix(int64(headState.GenesisTime), 0)
+	}
+	return slotutil.GetSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot), nil
+}
+
+// statusChanges records the latest status of each public key in sent, returning the
+// statuses which differ from the previously recorded ones.
+func statusChanges(
+	sent map[string]*pb.ValidatorStatusResponse,
+	statuses []*pb.ValidatorActivationResponse_Status,
+) []*pb.ValidatorActivationResponse_Status {
+	var changed []*pb.ValidatorActivationResponse_Status
+	for _, s := range statuses {
+		if s == nil {
+			continue
+		}
+		key := string(s.PublicKey)
+		if prev, ok := sent[key]; ok && proto.Equal(prev, s.Status) {
+			continue
+		}
+		sent[key] = s.Status
+		changed = append(changed, s)
+	}
+	return changed
+}
+
+// activationSettled returns true once every public key has been activated or has
+// exited, after which waiting for activation no longer makes sense for any of them.
+func activationSettled(sent map[string]*pb.ValidatorStatusResponse, pubkeys [][]byte) bool {
+	for _, pk := range pubkeys {
+		s, ok := sent[string(pk)]
+		if !ok {
+			return false
+		}
+		switch s.Status {
+		case pb.ValidatorStatus_ACTIVE, pb.ValidatorStatus_INITIATED_EXIT, pb.ValidatorStatus_WITHDRAWABLE,
+			pb.ValidatorStatus_EXITED, pb.ValidatorStatus_EXITED_SLASHED:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // ValidatorIndex is called by a validator to get its index location that corresponds
 // to the attestation bit fields.
 func (vs *ValidatorServer) ValidatorIndex(ctx context.Context, req *pb.ValidatorIndexRequest) (*pb.ValidatorIndexResponse, error) {
@@ -134,6 +208,9 @@ func (vs *ValidatorServer) ValidatorPerformance(
 //	3.) The slot at which the committee is assigned.
 //	4.) The bool signaling if the validator is expected to propose a block at the assigned slot.
 func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.AssignmentRequest) (*pb.AssignmentResponse, error) {
+	if err := checkSynced(vs.syncReporter); err != nil {
+		return nil, err
+	}
 	s, err := vs.beaconDB.HeadState(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
@@ -218,11 +295,103 @@ func (vs *ValidatorServer) assignment(
 	}, nil
 }
 
+// GetDuties returns the attester committee assignments, proposal slots and aggregator
+// flags of a batch of validators for an epoch in a single response, so that validator
+// clients running many keys do not need to query each duty separately.
+func (vs *ValidatorServer) GetDuties(ctx context.Context, req *pb.DutiesRequest) (*pb.DutiesResponse, error) {
+	if err := checkSynced(vs.syncReporter); err != nil {
+		return nil, err
+	}
+	if len(req.SlotSignatures) != 0 && len(req.SlotSignatures) != len(req.PublicKeys) {
+		return nil, status.Errorf(codes.InvalidArgument, "received %d slot signatures for %d public keys",
+			len(req.SlotSignatures), len(req.PublicKeys))
+	}
+	s, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+
+	// Advance state with empty transitions if the head state slot is farther
+	// than 1 epoch away from the request.
+	if req.Epoch > 1 && s.Slot < helpers.StartSlot(req.Epoch-1) {
+		s, err = state.ProcessSlots(ctx, s, helpers.StartSlot(req.Epoch-1))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not process slots: %v", err)
+		}
+	}
+	if req.Epoch > helpers.NextEpoch(s) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot retrieve duties of epoch %d beyond next epoch %d",
+			req.Epoch, helpers.NextEpoch(s))
+	}
+
+	proposals, err := proposerSlots(s, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not compute proposer slots: %v", err)
+	}
+	// Computing committee assignments moves the slot of the state, so it is done
+	// on a copy to keep the statuses relative to the head.
+	assignmentState := *s
+
+	validatorIndexMap := stateutils.ValidatorIndexMap(s)
+	duties := make([]*pb.DutiesResponse_Duty, 0, len(req.PublicKeys))
+	for i, pk := range req.PublicKeys {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		duty := &pb.DutiesResponse_Duty{
+			PublicKey: pk,
+			Status:    pb.ValidatorStatus_UNKNOWN_STATUS,
+		}
+		idx, ok := validatorIndexMap[bytesutil.ToBytes32(pk)]
+		if !ok {
+			duties = append(duties, duty)
+			continue
+		}
+		duty.ValidatorIndex = uint64(idx)
+		duty.Status = vs.lookupValidatorStatus(uint64(idx), s)
+
+		if helpers.IsActiveValidator(s.Validators[idx], req.Epoch) {
+			committee, shard, slot, _, err := helpers.CommitteeAssignment(&assignmentState, req.Epoch, uint64(idx))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not compute committee assignment: %v", err)
+			}
+			duty.Committee = committee
+			duty.Shard = shard
+			duty.AttesterSlot = slot
+			duty.ProposerSlots = proposals[uint64(idx)]
+			if len(req.SlotSignatures) != 0 {
+				duty.IsAggregator = helpers.IsAggregator(uint64(len(committee)), req.SlotSignatures[i])
+			}
+		}
+		duties = append(duties, duty)
+	}
+
+	return &pb.DutiesResponse{
+		Duties: duties,
+	}, nil
+}
+
+// proposerSlots maps the index of each validator proposing a block in the given epoch
+// to the slots of its proposals.
+func proposerSlots(beaconState *pbp2p.BeaconState, epoch uint64) (map[uint64][]uint64, error) {
+	proposers, err := helpers.EpochProposers(beaconState, epoch)
+	if err != nil {
+		return nil, err
+	}
+	slots := make(map[uint64][]uint64)
+	for i, idx := range proposers {
+		slots[idx] = append(slots[idx], helpers.StartSlot(epoch)+uint64(i))
+	}
+	return slots, nil
+}
+
 // ValidatorStatus returns the validator status of the current epoch.
 // The status response can be one of the following:
+//	DEPOSITED - validator's deposit has been recognized by Ethereum 1, not yet recognized by Ethereum 2.
 //	PENDING_ACTIVE - validator is waiting to get activated.
 //	ACTIVE - validator is active.
 //	INITIATED_EXIT - validator has initiated an an exit request.
+//	SLASHING - validator has been slashed and is being forcefully exited.
 //	WITHDRAWABLE - validator's deposit can be withdrawn after lock up period.
 //	EXITED - validator has exited, means the deposit has been withdrawn.
 //	EXITED_SLASHED - validator was forcefully exited due to slashing.
@@ -239,9 +408,90 @@ func (vs *ValidatorServer) ValidatorStatus(
 	return vs.validatorStatus(ctx, req.PublicKey, chainStarted, chainStartKeys, validatorIndexMap, beaconState), nil
 }
 
-// MultipleValidatorStatus returns the validator status response for the set of validators
-// requested by their pubkeys.
+// DepositStatus returns the stage the deposit of the public key has reached on its way to
+// the activation of its validator:
+//	DEPOSIT_OBSERVED - the deposit log has been processed, no block of the head chain includes the deposit.
+//	DEPOSIT_INCLUDED - the deposit has been included in a block and the validator assigned an index.
+//	ELIGIBLE - the validator has the effective balance required to be activated.
+//	ACTIVATION_SCHEDULED - the validator has been dequeued from the activation queue.
+//	ACTIVE - the activation epoch of the validator has been reached.
+// Along with the stage, it returns the eth1 block of the deposit, the block which included
+// it and the activation epochs of the validator, as far as they are known.
+func (vs *ValidatorServer) DepositStatus(
+	ctx context.Context,
+	req *pb.ValidatorIndexRequest) (*pb.DepositStatusResponse, error) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	resp := &pb.DepositStatusResponse{
+		Stage:                      pb.DepositStatusResponse_UNKNOWN,
+		ActivationEligibilityEpoch: farFutureEpoch,
+		ActivationEpoch:            farFutureEpoch,
+	}
+	_, eth1BlockNum := vs.beaconDB.DepositByPubkey(ctx, req.PublicKey)
+	if eth1BlockNum == nil {
+		return resp, nil
+	}
+	resp.Stage = pb.DepositStatusResponse_DEPOSIT_OBSERVED
+	resp.Eth1DepositBlockNumber = eth1BlockNum.Uint64()
+
+	inclusion, err := vs.beaconDB.DepositInclusion(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch deposit inclusion: %v", err)
+	}
+	if inclusion != nil {
+		resp.DepositInclusionSlot = inclusion.Slot
+		resp.DepositInclusionBlockRoot = inclusion.BlockRoot[:]
+	}
+
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch beacon state: %v", err)
+	}
+	// Before the chain starts there is no head state, and deposits are only observed.
+	if headState == nil {
+		return resp, nil
+	}
+	// A deposit included in a block off the head chain has not assigned a validator index.
+	var validator *ethpb.Validator
+	for idx, val := range headState.Validators {
+		if bytes.Equal(val.PublicKey, req.PublicKey) {
+			validator = val
+			resp.ValidatorIndex = uint64(idx)
+			break
+		}
+	}
+	if validator == nil {
+		return resp, nil
+	}
+	resp.ActivationEligibilityEpoch = validator.ActivationEligibilityEpoch
+	resp.ActivationEpoch = validator.ActivationEpoch
+	switch {
+	case validator.ActivationEpoch <= helpers.CurrentEpoch(headState):
+		resp.Stage = pb.DepositStatusResponse_ACTIVE
+	case validator.ActivationEpoch != farFutureEpoch:
+		resp.Stage = pb.DepositStatusResponse_ACTIVATION_SCHEDULED
+	case validator.ActivationEligibilityEpoch != farFutureEpoch:
+		resp.Stage = pb.DepositStatusResponse_ELIGIBLE
+	default:
+		resp.Stage = pb.DepositStatusResponse_DEPOSIT_INCLUDED
+	}
+	return resp, nil
+}
+
+// MultipleValidatorStatus returns the validator status of each of the requested public keys,
+// in the order in which they were requested.
 func (vs *ValidatorServer) MultipleValidatorStatus(
+	ctx context.Context,
+	req *pb.MultipleValidatorStatusRequest) (*pb.MultipleValidatorStatusResponse, error) {
+	_, statuses, err := vs.multipleValidatorStatus(ctx, req.PublicKeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not fetch validator statuses: %v", err)
+	}
+	return &pb.MultipleValidatorStatusResponse{Statuses: statuses}, nil
+}
+
+// multipleValidatorStatus returns the validator status response for the set of validators
+// requested by their pubkeys.
+func (vs *ValidatorServer) multipleValidatorStatus(
 	ctx context.Context,
 	pubkeys [][]byte) (bool, []*pb.ValidatorActivationResponse_Status, error) {
 	activeValidatorExists := false
@@ -281,7 +531,7 @@ func (vs *ValidatorServer) ExitedValidators(
 	ctx context.Context,
 	req *pb.ExitedValidatorsRequest) (*pb.ExitedValidatorsResponse, error) {
 
-	_, statuses, err := vs.MultipleValidatorStatus(ctx, req.PublicKeys)
+	_, statuses, err := vs.multipleValidatorStatus(ctx, req.PublicKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -303,6 +553,33 @@ func (vs *ValidatorServer) ExitedValidators(
 	return resp, nil
 }
 
+// ProposeExit verifies a signed voluntary exit against the head state, saves it in the
+// operations pool to be included in a block and broadcasts it to the network.
+func (vs *ValidatorServer) ProposeExit(ctx context.Context, exit *ethpb.VoluntaryExit) (*pb.ProposeExitResponse, error) {
+	if err := checkSynced(vs.syncReporter); err != nil {
+		return nil, err
+	}
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve head state: %v", err)
+	}
+	// The exit is processed on a copy as processing it initiates the exit of the validator.
+	beaconState := proto.Clone(headState).(*pbp2p.BeaconState)
+	body := &ethpb.BeaconBlockBody{VoluntaryExits: []*ethpb.VoluntaryExit{exit}}
+	if _, err := blocks.ProcessVoluntaryExits(beaconState, body, true /* verify signatures */); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid voluntary exit: %v", err)
+	}
+	root, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash exit: %v", err)
+	}
+	if err := vs.operationService.HandleValidatorExits(ctx, exit); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save exit: %v", err)
+	}
+	vs.p2p.Broadcast(ctx, exit)
+	return &pb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
 func (vs *ValidatorServer) validatorStatus(
 	ctx context.Context, pubKey []byte, chainStarted bool,
 	chainStartKeys map[[96]byte]bool, idxMap map[[32]byte]int,
@@ -320,9 +597,11 @@ func (vs *ValidatorServer) validatorStatus(
 
 	if !ok {
 		return &pb.ValidatorStatusResponse{
-			Status:                 pb.ValidatorStatus_UNKNOWN_STATUS,
-			ActivationEpoch:        params.BeaconConfig().FarFutureEpoch,
-			Eth1DepositBlockNumber: eth1BlockNumBigInt.Uint64(),
+			Status:                   pb.ValidatorStatus_DEPOSITED,
+			ActivationEpoch:          params.BeaconConfig().FarFutureEpoch,
+			ExitEpoch:                params.BeaconConfig().FarFutureEpoch,
+			EstimatedActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+			Eth1DepositBlockNumber:   eth1BlockNumBigInt.Uint64(),
 		}
 	}
 
@@ -343,7 +622,7 @@ func (vs *ValidatorServer) validatorStatus(
 		}
 	}
 
-	depositBlockSlot, err := vs.depositBlockSlot(ctx, beaconState.Slot, eth1BlockNumBigInt, beaconState)
+	depositBlockSlot, err := vs.depositInclusionSlot(ctx, pubKey, eth1BlockNumBigInt, beaconState)
 	if err != nil {
 		return &pb.ValidatorStatusResponse{
 			Status:                 pb.ValidatorStatus_UNKNOWN_STATUS,
@@ -362,6 +641,7 @@ func (vs *ValidatorServer) validatorStatus(
 
 	currEpoch := helpers.CurrentEpoch(beaconState)
 	activationEpoch := params.BeaconConfig().FarFutureEpoch
+	exitEpoch := params.BeaconConfig().FarFutureEpoch
 	var validatorInState *ethpb.Validator
 	var validatorIndex uint64
 	for idx, val := range beaconState.Validators {
@@ -370,9 +650,8 @@ func (vs *ValidatorServer) validatorStatus(
 		}
 
 		if bytes.Equal(val.PublicKey, pubKey) {
-			if helpers.IsActiveValidator(val, currEpoch) {
-				activationEpoch = val.ActivationEpoch
-			}
+			activationEpoch = val.ActivationEpoch
+			exitEpoch = val.ExitEpoch
 			validatorInState = val
 			validatorIndex = uint64(idx)
 			break
@@ -394,13 +673,53 @@ func (vs *ValidatorServer) validatorStatus(
 	}
 
 	status := vs.lookupValidatorStatus(uint64(valIdx), beaconState)
-	return &pb.ValidatorStatusResponse{
+	resp := &pb.ValidatorStatusResponse{
 		Status:                    status,
 		Eth1DepositBlockNumber:    eth1BlockNumBigInt.Uint64(),
 		PositionInActivationQueue: positionInQueue,
 		DepositInclusionSlot:      depositBlockSlot,
 		ActivationEpoch:           activationEpoch,
+		ExitEpoch:                 exitEpoch,
+		EstimatedActivationEpoch:  activationEpoch,
 	}
+	if status == pb.ValidatorStatus_PENDING_ACTIVE {
+		estimatedEpoch, err := estimatedActivationEpoch(beaconState, activationEpoch, positionInQueue)
+		if err != nil {
+			log.WithError(err).Error("Could not estimate activation epoch")
+			estimatedEpoch = params.BeaconConfig().FarFutureEpoch
+		}
+		resp.EstimatedActivationEpoch = estimatedEpoch
+		resp.EstimatedActivationWaitSeconds = activationWaitSeconds(beaconState, estimatedEpoch)
+	}
+	return resp
+}
+
+// estimatedActivationEpoch returns the epoch at which a pending validator is expected to be
+// activated. Validators which have not been scheduled for activation yet are dequeued at the
+// rate of the churn limit, after the activation delay.
+func estimatedActivationEpoch(beaconState *pbp2p.BeaconState, activationEpoch uint64, positionInQueue uint64) (uint64, error) {
+	if activationEpoch != params.BeaconConfig().FarFutureEpoch {
+		return activationEpoch, nil
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(beaconState)
+	if err != nil {
+		return 0, fmt.Errorf("could not get churn limit: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	return helpers.DelayedActivationExitEpoch(currentEpoch) + positionInQueue/churnLimit, nil
+}
+
+// activationWaitSeconds returns the number of seconds from the slot of the beacon state until
+// the start of the activation epoch.
+func activationWaitSeconds(beaconState *pbp2p.BeaconState, activationEpoch uint64) uint64 {
+	if activationEpoch == params.BeaconConfig().FarFutureEpoch {
+		return 0
+	}
+	activationSlot := helpers.StartSlot(activationEpoch)
+	if activationSlot <= beaconState.Slot {
+		return 0
+	}
+	return (activationSlot - beaconState.Slot) * params.BeaconConfig().SecondsPerSlot
 }
 
 func (vs *ValidatorServer) lookupValidatorStatus(validatorIdx uint64, beaconState *pbp2p.BeaconState) pb.ValidatorStatus {
@@ -419,6 +738,8 @@ func (vs *ValidatorServer) lookupValidatorStatus(validatorIdx uint64, beaconStat
 		status = pb.ValidatorStatus_EXITED_SLASHED
 	} else if epoch >= v.ExitEpoch {
 		status = pb.ValidatorStatus_EXITED
+	} else if v.Slashed {
+		status = pb.ValidatorStatus_SLASHING
 	} else if v.ExitEpoch != farFutureEpoch {
 		status = pb.ValidatorStatus_INITIATED_EXIT
 	} else {
@@ -428,6 +749,21 @@ func (vs *ValidatorServer) lookupValidatorStatus(validatorIdx uint64, beaconStat
 	return status
 }
 
+// depositInclusionSlot returns the slot of the block which included the deposit of the
+// public key, as recorded by the chain service, falling back to an estimate based on the
+// time of the eth1 block of the deposit for deposits included before the record was kept.
+func (vs *ValidatorServer) depositInclusionSlot(ctx context.Context, pubKey []byte,
+	eth1BlockNumBigInt *big.Int, beaconState *pbp2p.BeaconState) (uint64, error) {
+	inclusion, err := vs.beaconDB.DepositInclusion(ctx, pubKey)
+	if err != nil {
+		return 0, err
+	}
+	if inclusion != nil {
+		return inclusion.Slot, nil
+	}
+	return vs.depositBlockSlot(ctx, beaconState.Slot, eth1BlockNumBigInt, beaconState)
+}
+
 func (vs *ValidatorServer) depositBlockSlot(ctx context.Context, currentSlot uint64,
 	eth1BlockNumBigInt *big.Int, beaconState *pbp2p.BeaconState) (uint64, error) {
 	blockTimeStamp, err := vs.powChainService.BlockTimeByHeight(ctx, eth1BlockNumBigInt)
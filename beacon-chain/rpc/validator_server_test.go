@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"fmt"
@@ -28,6 +29,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestValidatorIndex_OK(t *testing.T) {
@@ -237,6 +240,70 @@ func TestCommitteeAssignment_OK(t *testing.T) {
 	}
 }
 
+func TestGetDuties_OK(t *testing.T) {
+	helpers.ClearAllCaches()
+
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := blk.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount / 16
+	deposits, _ := testutil.SetupInitialDeposits(t, depChainStart)
+	state, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, state); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+
+	pubKeys := make([][]byte, len(deposits))
+	for i, d := range deposits {
+		pubKeys[i] = d.Data.PublicKey
+	}
+	res, err := vs.GetDuties(ctx, &pb.DutiesRequest{
+		Epoch:      0,
+		PublicKeys: append(pubKeys, []byte("unknown")),
+	})
+	if err != nil {
+		t.Fatalf("Could not get duties: %v", err)
+	}
+	if len(res.Duties) != len(pubKeys)+1 {
+		t.Fatalf("Expected %d duties, received %d", len(pubKeys)+1, len(res.Duties))
+	}
+	proposals := 0
+	for i, duty := range res.Duties[:len(pubKeys)] {
+		if duty.ValidatorIndex != uint64(i) || duty.Status != pb.ValidatorStatus_ACTIVE {
+			t.Errorf("Expected active validator %d, received %v", i, duty)
+		}
+		if len(duty.Committee) == 0 || duty.AttesterSlot >= params.BeaconConfig().SlotsPerEpoch {
+			t.Errorf("Expected committee assignment in epoch 0 for validator %d, received %v", i, duty)
+		}
+		proposals += len(duty.ProposerSlots)
+	}
+	if proposals != int(params.BeaconConfig().SlotsPerEpoch) {
+		t.Errorf("Expected one proposal per slot, received %d proposals", proposals)
+	}
+	if unknown := res.Duties[len(pubKeys)]; unknown.Status != pb.ValidatorStatus_UNKNOWN_STATUS {
+		t.Errorf("Expected unknown status for unknown key, received %v", unknown.Status)
+	}
+
+	if _, err := vs.GetDuties(ctx, &pb.DutiesRequest{
+		PublicKeys:     pubKeys[:2],
+		SlotSignatures: [][]byte{{'A'}},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for missing slot signatures, received %v", err)
+	}
+}
+
 func TestCommitteeAssignment_multipleKeys_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -813,9 +880,10 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 	if err := db.SaveValidatorIndex(pubKey2, 1); err != nil {
 		t.Fatalf("could not save validator index: %v", err)
 	}
+	ctx, cancel := context.WithCancel(context.Background())
 	vs := &ValidatorServer{
 		beaconDB:           db,
-		ctx:                context.Background(),
+		ctx:                ctx,
 		chainService:       newMockChainService(),
 		canonicalStateChan: make(chan *pbp2p.BeaconState, 1),
 		powChainService:    &mockPOWChainService{},
@@ -827,7 +895,7 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 
 	defer ctrl.Finish()
 	mockStream := internal.NewMockValidatorService_WaitForActivationServer(ctrl)
-	mockStream.EXPECT().Context().Return(context.Background())
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
 	mockStream.EXPECT().Send(
 		&pb.ValidatorActivationResponse{
 			Statuses: []*pb.ValidatorActivationResponse_Status{
@@ -846,10 +914,206 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 				},
 			},
 		},
-	).Return(nil)
+	).Do(func(interface{}) {
+		// The second validator is still pending, so the stream only ends once the
+		// server shuts down.
+		cancel()
+	}).Return(nil)
+
+	want := "context closed"
+	if err := vs.WaitForActivation(req, mockStream); err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestStatusChanges(t *testing.T) {
+	pending := &pb.ValidatorStatusResponse{Status: pb.ValidatorStatus_PENDING_ACTIVE}
+	active := &pb.ValidatorStatusResponse{Status: pb.ValidatorStatus_ACTIVE}
+	pubkeys := [][]byte{{'A'}, {'B'}}
+	sent := make(map[string]*pb.ValidatorStatusResponse)
+
+	changed := statusChanges(sent, []*pb.ValidatorActivationResponse_Status{
+		{PublicKey: pubkeys[0], Status: pending},
+		{PublicKey: pubkeys[1], Status: active},
+	})
+	if len(changed) != 2 {
+		t.Errorf("Expected the initial statuses of both keys, received %v", changed)
+	}
+	if activationSettled(sent, pubkeys) {
+		t.Error("Expected activation not to be settled while a key is pending")
+	}
+
+	changed = statusChanges(sent, []*pb.ValidatorActivationResponse_Status{
+		{PublicKey: pubkeys[0], Status: active},
+		{PublicKey: pubkeys[1], Status: active},
+	})
+	if len(changed) != 1 || !bytes.Equal(changed[0].PublicKey, pubkeys[0]) {
+		t.Errorf("Expected only the status of the activated key, received %v", changed)
+	}
+	if !activationSettled(sent, pubkeys) {
+		t.Error("Expected activation to be settled once all keys are active")
+	}
+}
+
+func TestValidatorStatus_Deposited(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubKey := []byte{'A'}
+	// The deposit has been processed by the deposit contract, but the validator is not part
+	// of the beacon state yet.
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: 5000}); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			Signature:             []byte("hi"),
+			WithdrawalCredentials: []byte("hey"),
+		},
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	db.InsertDeposit(ctx, deposit, big.NewInt(10) /*blockNum*/, 0, depositTrie.Root())
+
+	vs := &ValidatorServer{
+		beaconDB:        db,
+		powChainService: &mockPOWChainService{},
+	}
+	resp, err := vs.ValidatorStatus(context.Background(), &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		t.Fatalf("Could not get validator status %v", err)
+	}
+	if resp.Status != pb.ValidatorStatus_DEPOSITED {
+		t.Errorf("Wanted %v, got %v", pb.ValidatorStatus_DEPOSITED, resp.Status)
+	}
+	if resp.Eth1DepositBlockNumber != 10 {
+		t.Errorf("Wanted deposit block number 10, got %d", resp.Eth1DepositBlockNumber)
+	}
+	if resp.EstimatedActivationEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Expected no activation estimate, got epoch %d", resp.EstimatedActivationEpoch)
+	}
+}
+
+func TestValidatorStatus_PendingActiveEstimatesActivation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubKey := []byte{'A'}
+	if err := db.SaveValidatorIndex(pubKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
+	slot := uint64(5000)
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Validators: []*ethpb.Validator{
+		{
+			ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			PublicKey:       pubKey,
+		},
+	},
+		Slot: slot,
+	}); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			Signature:             []byte("hi"),
+			WithdrawalCredentials: []byte("hey"),
+		},
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	db.InsertDeposit(ctx, deposit, big.NewInt(0) /*blockNum*/, 0, depositTrie.Root())
+
+	height := time.Unix(int64(params.BeaconConfig().Eth1FollowDistance), 0).Unix()
+	vs := &ValidatorServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
+			blockTimeByHeight: map[int]uint64{
+				0: uint64(height),
+			},
+		},
+	}
+	resp, err := vs.ValidatorStatus(context.Background(), &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		t.Fatalf("Could not get validator status %v", err)
+	}
+	if resp.Status != pb.ValidatorStatus_PENDING_ACTIVE {
+		t.Fatalf("Wanted %v, got %v", pb.ValidatorStatus_PENDING_ACTIVE, resp.Status)
+	}
+	wantEpoch := helpers.DelayedActivationExitEpoch(helpers.SlotToEpoch(slot))
+	if resp.EstimatedActivationEpoch != wantEpoch {
+		t.Errorf("Wanted estimated activation epoch %d, got %d", wantEpoch, resp.EstimatedActivationEpoch)
+	}
+	wantWait := (helpers.StartSlot(wantEpoch) - slot) * params.BeaconConfig().SecondsPerSlot
+	if resp.EstimatedActivationWaitSeconds != wantWait {
+		t.Errorf("Wanted estimated activation wait of %d seconds, got %d", wantWait, resp.EstimatedActivationWaitSeconds)
+	}
+	if resp.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Wanted exit epoch %d, got %d", params.BeaconConfig().FarFutureEpoch, resp.ExitEpoch)
+	}
+}
+
+func TestValidatorStatus_Slashing(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	pubKey := []byte{'A'}
+	if err := db.SaveValidatorIndex(pubKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
 
-	if err := vs.WaitForActivation(req, mockStream); err != nil {
-		t.Fatalf("Could not setup wait for activation stream: %v", err)
+	// Slashing because slashed is true and the exit epoch is still in the future.
+	slot := uint64(10000)
+	epoch := helpers.SlotToEpoch(slot)
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot: slot,
+		Validators: []*ethpb.Validator{{
+			Slashed:           true,
+			PublicKey:         pubKey,
+			ExitEpoch:         epoch + 1,
+			WithdrawableEpoch: epoch + 2},
+		}}); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			Signature:             []byte("hi"),
+			WithdrawalCredentials: []byte("hey"),
+		},
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	db.InsertDeposit(ctx, deposit, big.NewInt(0) /*blockNum*/, 0, depositTrie.Root())
+	height := time.Unix(int64(params.BeaconConfig().Eth1FollowDistance), 0).Unix()
+	vs := &ValidatorServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
+			blockTimeByHeight: map[int]uint64{
+				0: uint64(height),
+			},
+		},
+	}
+	resp, err := vs.ValidatorStatus(context.Background(), &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		t.Fatalf("Could not get validator status %v", err)
+	}
+	if resp.Status != pb.ValidatorStatus_SLASHING {
+		t.Errorf("Wanted %v, got %v", pb.ValidatorStatus_SLASHING, resp.Status)
+	}
+	if resp.ExitEpoch != epoch+1 {
+		t.Errorf("Wanted exit epoch %d, got %d", epoch+1, resp.ExitEpoch)
 	}
 }
 
@@ -929,7 +1193,7 @@ func TestMultipleValidatorStatus_OK(t *testing.T) {
 		canonicalStateChan: make(chan *pbp2p.BeaconState, 1),
 		powChainService:    &mockPOWChainService{},
 	}
-	activeExists, response, err := vs.MultipleValidatorStatus(context.Background(), pubKeys)
+	activeExists, response, err := vs.multipleValidatorStatus(context.Background(), pubKeys)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -950,6 +1214,19 @@ func TestMultipleValidatorStatus_OK(t *testing.T) {
 		t.Errorf("Validator with pubkey %#x is not activated and instead has this status: %s",
 			response[2].PublicKey, response[2].Status.Status.String())
 	}
+
+	resp, err := vs.MultipleValidatorStatus(context.Background(), &pb.MultipleValidatorStatusRequest{PublicKeys: pubKeys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Statuses) != len(pubKeys) {
+		t.Fatalf("Wanted %d statuses, got %d", len(pubKeys), len(resp.Statuses))
+	}
+	for i, status := range resp.Statuses {
+		if !proto.Equal(status, response[i]) {
+			t.Errorf("Wanted status %v for validator %d, got %v", response[i], i, status)
+		}
+	}
 }
 
 func BenchmarkAssignment(b *testing.B) {
@@ -1038,3 +1315,213 @@ func genesisState(validators uint64) (*pbp2p.BeaconState, error) {
 	}
 	return state.GenesisBeaconState(deposits, uint64(genesisTime), &ethpb.Eth1Data{})
 }
+
+func TestDepositStatus_Stages(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+
+	// The head state is at epoch 10.
+	validators := []*ethpb.Validator{
+		{PublicKey: []byte{'A'}, ActivationEligibilityEpoch: 2, ActivationEpoch: 7},
+		{PublicKey: []byte{'B'}, ActivationEligibilityEpoch: 9, ActivationEpoch: 15},
+		{PublicKey: []byte{'C'}, ActivationEligibilityEpoch: 9, ActivationEpoch: farFutureEpoch},
+		{PublicKey: []byte{'D'}, ActivationEligibilityEpoch: farFutureEpoch, ActivationEpoch: farFutureEpoch},
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:       10 * params.BeaconConfig().SlotsPerEpoch,
+		Validators: validators,
+	}); err != nil {
+		t.Fatalf("could not save state: %v", err)
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	for i, pubKey := range [][]byte{{'A'}, {'B'}, {'C'}, {'D'}, {'E'}} {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             pubKey,
+				Signature:             []byte("hi"),
+				WithdrawalCredentials: []byte("hey"),
+			},
+		}
+		db.InsertDeposit(ctx, deposit, big.NewInt(int64(100+i)) /*blockNum*/, i, depositTrie.Root())
+		if i == 4 {
+			continue
+		}
+		if err := db.SaveDepositInclusion(ctx, pubKey, uint64(10+i), [32]byte{pubKey[0]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	tests := []struct {
+		pubKey []byte
+		want   *pb.DepositStatusResponse
+	}{
+		{
+			pubKey: []byte{'A'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_ACTIVE,
+				Eth1DepositBlockNumber:     100,
+				DepositInclusionSlot:       10,
+				DepositInclusionBlockRoot:  []byte{'A', 31: 0},
+				ValidatorIndex:             0,
+				ActivationEligibilityEpoch: 2,
+				ActivationEpoch:            7,
+			},
+		},
+		{
+			pubKey: []byte{'B'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_ACTIVATION_SCHEDULED,
+				Eth1DepositBlockNumber:     101,
+				DepositInclusionSlot:       11,
+				DepositInclusionBlockRoot:  []byte{'B', 31: 0},
+				ValidatorIndex:             1,
+				ActivationEligibilityEpoch: 9,
+				ActivationEpoch:            15,
+			},
+		},
+		{
+			pubKey: []byte{'C'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_ELIGIBLE,
+				Eth1DepositBlockNumber:     102,
+				DepositInclusionSlot:       12,
+				DepositInclusionBlockRoot:  []byte{'C', 31: 0},
+				ValidatorIndex:             2,
+				ActivationEligibilityEpoch: 9,
+				ActivationEpoch:            farFutureEpoch,
+			},
+		},
+		{
+			pubKey: []byte{'D'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_DEPOSIT_INCLUDED,
+				Eth1DepositBlockNumber:     103,
+				DepositInclusionSlot:       13,
+				DepositInclusionBlockRoot:  []byte{'D', 31: 0},
+				ValidatorIndex:             3,
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+			},
+		},
+		{
+			pubKey: []byte{'E'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_DEPOSIT_OBSERVED,
+				Eth1DepositBlockNumber:     104,
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+			},
+		},
+		{
+			pubKey: []byte{'F'},
+			want: &pb.DepositStatusResponse{
+				Stage:                      pb.DepositStatusResponse_UNKNOWN,
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+			},
+		},
+	}
+	for _, tt := range tests {
+		resp, err := vs.DepositStatus(ctx, &pb.ValidatorIndexRequest{PublicKey: tt.pubKey})
+		if err != nil {
+			t.Fatalf("Could not get deposit status: %v", err)
+		}
+		if !proto.Equal(resp, tt.want) {
+			t.Errorf("Deposit status of %#x: wanted %v, got %v", tt.pubKey, tt.want, resp)
+		}
+	}
+}
+
+func TestDepositStatus_NoHeadState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(fmt.Errorf("could not setup deposit trie: %v", err))
+	}
+	pubKey := []byte{'A'}
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			Signature:             []byte("hi"),
+			WithdrawalCredentials: []byte("hey"),
+		},
+	}
+	db.InsertDeposit(ctx, deposit, big.NewInt(100) /*blockNum*/, 0, depositTrie.Root())
+
+	vs := &ValidatorServer{beaconDB: db}
+	resp, err := vs.DepositStatus(ctx, &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		t.Fatalf("Could not get deposit status: %v", err)
+	}
+	want := &pb.DepositStatusResponse{
+		Stage:                      pb.DepositStatusResponse_DEPOSIT_OBSERVED,
+		Eth1DepositBlockNumber:     100,
+		ActivationEligibilityEpoch: farFutureEpoch,
+		ActivationEpoch:            farFutureEpoch,
+	}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v, got %v", want, resp)
+	}
+}
+
+func TestProposeExit_Verifies(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Validators can only exit once they were active long enough.
+	epoch := params.BeaconConfig().PersistentCommitteePeriod
+	beaconState.Slot = epoch * params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{},
+		p2p:              &mockBroadcaster{},
+	}
+
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: 2}
+	signingRoot, err := ssz.SigningRoot(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, epoch, params.BeaconConfig().DomainVoluntaryExit)
+	exit.Signature = privKeys[3].Sign(signingRoot[:], domain).Marshal()
+	if _, err := vs.ProposeExit(ctx, exit); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an exit signed by another validator, received %v", err)
+	}
+
+	exit.Signature = privKeys[2].Sign(signingRoot[:], domain).Marshal()
+	res, err := vs.ProposeExit(ctx, exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ExitRoot) != 32 {
+		t.Errorf("Expected a 32 byte exit root, received %#x", res.ExitRoot)
+	}
+	// The exit is verified on a copy of the head state.
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headState.Validators[2].ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Error("Expected the head state to be left unchanged")
+	}
+}
@@ -0,0 +1,121 @@
+// Package segment reads and writes recorded segments of the chain, which are directories
+// of ssz encoded blocks and states named by slot. A state recorded at a slot is the state
+// resulting from the block recorded at that slot, so that the first block of a segment
+// along with its state is the anchor the following blocks can be replayed from.
+package segment
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+const (
+	blockFilePrefix = "block-"
+	stateFilePrefix = "state-"
+	fileExtension   = ".ssz"
+)
+
+// WriteBlock writes the block to the segment directory, creating the directory if needed.
+func WriteBlock(dir string, block *ethpb.BeaconBlock) error {
+	return writeFile(dir, fileName(blockFilePrefix, block.Slot), block)
+}
+
+// WriteState writes the state resulting from the block of its slot to the segment
+// directory, creating the directory if needed.
+func WriteState(dir string, state *pb.BeaconState) error {
+	return writeFile(dir, fileName(stateFilePrefix, state.Slot), state)
+}
+
+// ReadBlocks reads the blocks of the segment directory, ordered by slot.
+func ReadBlocks(dir string) ([]*ethpb.BeaconBlock, error) {
+	slots, err := fileSlots(dir, blockFilePrefix)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*ethpb.BeaconBlock, len(slots))
+	for i, slot := range slots {
+		blocks[i] = &ethpb.BeaconBlock{}
+		if err := readFile(dir, fileName(blockFilePrefix, slot), blocks[i]); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+// ReadState reads the state of the segment directory at the slot, returning nil if no
+// state was recorded at the slot.
+func ReadState(dir string, slot uint64) (*pb.BeaconState, error) {
+	state := &pb.BeaconState{}
+	if err := readFile(dir, fileName(stateFilePrefix, slot), state); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return state, nil
+}
+
+// StateSlots returns the slots of the states of the segment directory, in order.
+func StateSlots(dir string) ([]uint64, error) {
+	return fileSlots(dir, stateFilePrefix)
+}
+
+func writeFile(dir string, name string, val interface{}) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("could not create segment directory: %v", err)
+	}
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("could not encode %s: %v", name, err)
+	}
+	return ioutil.WriteFile(filepath.Join(dir, name), enc, 0600)
+}
+
+func readFile(dir string, name string, val interface{}) error {
+	// #nosec - Inclusion of file via variable is OK for the files of a segment.
+	enc, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	if err := ssz.Unmarshal(enc, val); err != nil {
+		return fmt.Errorf("could not decode %s: %v", name, err)
+	}
+	return nil
+}
+
+// fileName returns the name of the file with the prefix at the slot, whose slot is padded
+// so that the files of a segment are listed in order.
+func fileName(prefix string, slot uint64) string {
+	return fmt.Sprintf("%s%010d%s", prefix, slot, fileExtension)
+}
+
+// fileSlots returns the slots of the files of the directory with the prefix, in order.
+func fileSlots(dir string, prefix string) ([]uint64, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read segment directory: %v", err)
+	}
+	var slots []uint64
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, fileExtension) {
+			continue
+		}
+		slot, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, prefix), fileExtension), 10, 64)
+		if err != nil {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	return slots, nil
+}
@@ -0,0 +1,97 @@
+package segment
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestSegment_WriteAndRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "segment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dir = filepath.Join(dir, "chain")
+
+	graffiti := bytes.Repeat([]byte{'g'}, 32)
+	var blocks []*ethpb.BeaconBlock
+	for _, slot := range []uint64{12, 3, 100} {
+		block := &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: bytes.Repeat([]byte{byte(slot)}, 32),
+			StateRoot:  make([]byte, 32),
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: graffiti,
+			},
+			Signature: make([]byte, 96),
+		}
+		if err := WriteBlock(dir, block); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, block)
+	}
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	beaconState, err := state.GenesisBeaconState(deposits, 10, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot = 3
+	if err := WriteState(dir, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "block-notes.txt"), []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadBlocks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != len(blocks) {
+		t.Fatalf("Wanted %d blocks, received %d", len(blocks), len(read))
+	}
+	for i, slot := range []uint64{3, 12, 100} {
+		if read[i].Slot != slot {
+			t.Errorf("Wanted block %d at slot %d, received slot %d", i, slot, read[i].Slot)
+		}
+		if !bytes.Equal(read[i].Body.Graffiti, graffiti) {
+			t.Errorf("Wanted graffiti of block %d to be read, received %q", i, read[i].Body.Graffiti)
+		}
+	}
+
+	slots, err := StateSlots(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(slots, []uint64{3}) {
+		t.Errorf("Wanted state slots [3], received %v", slots)
+	}
+	readState, err := ReadState(dir, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(readState, beaconState) {
+		t.Error("Wanted the state read to equal the state written")
+	}
+	readState, err = ReadState(dir, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readState != nil {
+		t.Errorf("Wanted no state at slot 12, received slot %d", readState.Slot)
+	}
+}
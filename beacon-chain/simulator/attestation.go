@@ -0,0 +1,172 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// attest signs an attestation to the node's head for each committee of the slot holding
+// any of the node's validators, aggregating the signatures of the node's validators in
+// the committee. The attestation data is built the same way the attester RPC server
+// builds it for validator clients.
+func (n *Node) attest(ctx context.Context, slot uint64) ([]*ethpb.Attestation, error) {
+	if len(n.keys) == 0 {
+		return nil, nil
+	}
+	_, headRoot, err := n.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	headState, err := n.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	slotState := proto.Clone(headState).(*pb.BeaconState)
+	if slotState.Slot < slot {
+		slotState, err = state.ProcessSlots(ctx, slotState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not process slots: %v", err)
+		}
+	}
+
+	epoch := helpers.SlotToEpoch(slot)
+	targetRoot := headRoot[:]
+	if epochStartSlot := helpers.StartSlot(epoch); epochStartSlot != slotState.Slot {
+		targetRoot, err = helpers.BlockRootAtSlot(slotState, epochStartSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get target block for slot %d: %v", epochStartSlot, err)
+		}
+	}
+	committeeCount, err := helpers.CommitteeCount(slotState, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get committee count: %v", err)
+	}
+	startShard, err := helpers.StartShard(slotState, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get start shard: %v", err)
+	}
+	committeesPerSlot := committeeCount / params.BeaconConfig().SlotsPerEpoch
+	slotStartShard := startShard + committeesPerSlot*(slot%params.BeaconConfig().SlotsPerEpoch)
+	domain := helpers.Domain(slotState, epoch, params.BeaconConfig().DomainAttestation)
+
+	var atts []*ethpb.Attestation
+	for i := uint64(0); i < committeesPerSlot; i++ {
+		shard := (slotStartShard + i) % params.BeaconConfig().ShardCount
+		committee, err := helpers.CrosslinkCommittee(slotState, epoch, shard)
+		if err != nil {
+			return nil, fmt.Errorf("could not get crosslink committee of shard %d: %v", shard, err)
+		}
+		var members []int
+		for j, idx := range committee {
+			if _, ok := n.keys[idx]; ok {
+				members = append(members, j)
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+
+		parentCrosslink := slotState.CurrentCrosslinks[shard]
+		endEpoch := parentCrosslink.EndEpoch + params.BeaconConfig().MaxEpochsPerCrosslink
+		if endEpoch > epoch {
+			endEpoch = epoch
+		}
+		parentCrosslinkRoot, err := ssz.HashTreeRoot(parentCrosslink)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash crosslink of shard %d: %v", shard, err)
+		}
+		data := &ethpb.AttestationData{
+			BeaconBlockRoot: headRoot[:],
+			Source:          slotState.CurrentJustifiedCheckpoint,
+			Target: &ethpb.Checkpoint{
+				Epoch: epoch,
+				Root:  targetRoot,
+			},
+			Crosslink: &ethpb.Crosslink{
+				Shard:      shard,
+				StartEpoch: parentCrosslink.EndEpoch,
+				EndEpoch:   endEpoch,
+				ParentRoot: parentCrosslinkRoot[:],
+				DataRoot:   params.BeaconConfig().ZeroHash[:],
+			},
+		}
+		root, err := ssz.HashTreeRoot(&pb.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+		if err != nil {
+			return nil, fmt.Errorf("could not hash attestation data: %v", err)
+		}
+
+		aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+		sigs := make([]*bls.Signature, len(members))
+		for j, member := range members {
+			aggregationBits.SetBitAt(uint64(member), true)
+			sigs[j] = n.keys[committee[member]].Sign(root[:], domain)
+		}
+		atts = append(atts, &ethpb.Attestation{
+			Data:            data,
+			AggregationBits: aggregationBits,
+			CustodyBits:     bitfield.NewBitlist(uint64(len(committee))),
+			Signature:       bls.AggregateSignatures(sigs).Marshal(),
+		})
+		log.WithFields(logrus.Fields{
+			"node":      n.index,
+			"slot":      slot,
+			"shard":     shard,
+			"attesters": len(members),
+		}).Debug("Attesting to head")
+	}
+	return atts, nil
+}
+
+// ReceiveAttestation adds an attestation to the node's pool of pending attestations and
+// to the latest attestations of its fork choice rule, the same way the attester RPC
+// server does for attestations submitted by validator clients. Attestations to blocks the
+// node has not processed are dropped.
+func (n *Node) ReceiveAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	if !n.beaconDB.HasBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
+		log.WithField("node", n.index).Debug("Dropping attestation to unknown block")
+		return nil
+	}
+	if err := n.opsService.HandleAttestations(ctx, att); err != nil {
+		return fmt.Errorf("could not save attestation: %v", err)
+	}
+	if err := n.attsService.UpdateLatestAttestation(ctx, att); err != nil {
+		return fmt.Errorf("could not update latest attestation: %v", err)
+	}
+	return nil
+}
+
+// includedAttestations returns the pending attestations of the node which are valid for
+// inclusion in a block on top of the state at the block's slot.
+func (n *Node) includedAttestations(ctx context.Context, slotState *pb.BeaconState) ([]*ethpb.Attestation, error) {
+	pending, err := n.opsService.PendingAttestations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations: %v", err)
+	}
+	st := proto.Clone(slotState).(*pb.BeaconState)
+	var atts []*ethpb.Attestation
+	for _, att := range pending {
+		if uint64(len(atts)) == params.BeaconConfig().MaxAttestations {
+			break
+		}
+		postState, err := blocks.ProcessAttestation(proto.Clone(st).(*pb.BeaconState), att, false)
+		if err != nil {
+			continue
+		}
+		st = postState
+		atts = append(atts, att)
+	}
+	return atts, nil
+}
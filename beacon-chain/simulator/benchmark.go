@@ -0,0 +1,99 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// BenchmarkResult reports the throughput of importing a chain segment.
+type BenchmarkResult struct {
+	// Blocks is the number of blocks imported.
+	Blocks int
+	// Elapsed is the total time spent importing the blocks.
+	Elapsed time.Duration
+	// Stages is the total time spent in each stage of the block processing, keyed by stage.
+	Stages map[string]time.Duration
+	// Allocs and AllocBytes are the number of heap objects and bytes allocated while
+	// importing the blocks, and GCCycles the number of garbage collections.
+	Allocs     uint64
+	AllocBytes uint64
+	GCCycles   uint32
+}
+
+// BlocksPerSecond returns the number of blocks imported per second.
+func (r *BenchmarkResult) BlocksPerSecond() float64 {
+	if r.Elapsed == 0 {
+		return 0
+	}
+	return float64(r.Blocks) / r.Elapsed.Seconds()
+}
+
+// Benchmark imports the blocks following the anchor block into a node with a fresh
+// database under dataDir, through the same chain service and fork choice pipeline as the
+// blocks received from peers, and reports the time spent in each stage of processing
+// along with the allocations. Blocks at or before the slot of the anchor are skipped.
+func Benchmark(
+	ctx context.Context,
+	dataDir string,
+	anchor *ethpb.BeaconBlock,
+	anchorState *pb.BeaconState,
+	blocks []*ethpb.BeaconBlock,
+) (*BenchmarkResult, error) {
+	if anchor == nil || anchorState == nil {
+		return nil, errors.New("benchmark requires an anchor block and state")
+	}
+	res := &BenchmarkResult{
+		Stages: make(map[string]time.Duration),
+	}
+	var lock sync.Mutex
+	stageTimer := func(stage string, elapsed time.Duration) {
+		lock.Lock()
+		res.Stages[stage] += elapsed
+		lock.Unlock()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	node, err := newNode(ctx, 0, dataDir, anchor, anchorState, nil, stageTimer)
+	if err != nil {
+		return nil, fmt.Errorf("could not start node: %v", err)
+	}
+	defer func() {
+		if err := node.stop(); err != nil {
+			log.WithError(err).Error("Could not stop benchmark node")
+		}
+	}()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for _, block := range blocks {
+		if block.Slot <= anchor.Slot {
+			continue
+		}
+		if err := node.ReceiveBlock(ctx, block); err != nil {
+			return nil, fmt.Errorf("could not import block at slot %d: %v", block.Slot, err)
+		}
+		res.Blocks++
+	}
+	res.Elapsed = time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	res.Allocs = after.Mallocs - before.Mallocs
+	res.AllocBytes = after.TotalAlloc - before.TotalAlloc
+	res.GCCycles = after.NumGC - before.NumGC
+	log.WithFields(logrus.Fields{
+		"blocks":          res.Blocks,
+		"elapsed":         res.Elapsed,
+		"blocksPerSecond": res.BlocksPerSecond(),
+	}).Info("Finished benchmark")
+	return res, nil
+}
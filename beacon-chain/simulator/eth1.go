@@ -0,0 +1,71 @@
+package simulator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// simulatedEth1 is a stand-in for the ETH1.0 chain. The simulated beacon chain starts
+// from an interop genesis, so the deposit contract is never queried and every
+// referenced ETH1.0 block is assumed to exist.
+type simulatedEth1 struct{}
+
+var _ = powchain.Client(&simulatedEth1{})
+
+func (s *simulatedEth1) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (s *simulatedEth1) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	return gethTypes.NewBlockWithHeader(s.header()), nil
+}
+
+func (s *simulatedEth1) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	return gethTypes.NewBlockWithHeader(s.header()), nil
+}
+
+func (s *simulatedEth1) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	return s.header(), nil
+}
+
+func (s *simulatedEth1) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (s *simulatedEth1) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return nil, nil
+}
+
+func (s *simulatedEth1) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (s *simulatedEth1) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (s *simulatedEth1) header() *gethTypes.Header {
+	return &gethTypes.Header{Number: big.NewInt(0), Difficulty: big.NewInt(1)}
+}
+
+// newWeb3Service creates a powchain service following the ETH1.0 chain of the client, or
+// the simulated ETH1.0 chain if the client is nil.
+func newWeb3Service(ctx context.Context, client powchain.Client) (*powchain.Web3Service, error) {
+	if client == nil {
+		client = &simulatedEth1{}
+	}
+	return powchain.NewWeb3Service(ctx, &powchain.Web3ServiceConfig{
+		Endpoint:        "ws://simulated",
+		DepositContract: common.Address{},
+		Client:          client,
+		Reader:          client,
+		Logger:          client,
+		BlockFetcher:    client,
+	})
+}
@@ -0,0 +1,77 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// InteropKeys deterministically derives count validator secret keys, where the key of
+// validator i is derived from the hash of its little-endian encoded index. Every run of
+// a simulation, and every node within it, therefore agrees on the same validator set.
+func InteropKeys(count uint64) ([]*bls.SecretKey, error) {
+	keys := make([]*bls.SecretKey, count)
+	for i := uint64(0); i < count; i++ {
+		seed := hashutil.Hash(bytesutil.Bytes32(i))
+		key, err := bls.SecretKeyFromBytes(seed[:])
+		if err != nil {
+			return nil, fmt.Errorf("could not derive key for validator %d: %v", i, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// InteropDeposits creates a max effective balance deposit for each key, along with
+// the deposit proofs and the eth1 data needed to start the chain from them.
+func InteropDeposits(keys []*bls.SecretKey) ([]*ethpb.Deposit, *ethpb.Eth1Data, error) {
+	var withdrawalCreds [32]byte
+	copy(withdrawalCreds[:], []byte("simulator"))
+	domain := bls.Domain(params.BeaconConfig().DomainDeposit, params.BeaconConfig().GenesisForkVersion)
+
+	deposits := make([]*ethpb.Deposit, len(keys))
+	leaves := make([][]byte, len(keys))
+	for i, key := range keys {
+		data := &ethpb.Deposit_Data{
+			PublicKey:             key.PublicKey().Marshal(),
+			WithdrawalCredentials: withdrawalCreds[:],
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+		}
+		root, err := ssz.SigningRoot(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get signing root of deposit data: %v", err)
+		}
+		data.Signature = key.Sign(root[:], domain).Marshal()
+		leaf, err := hashutil.DepositHash(data)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not hash deposit data: %v", err)
+		}
+		deposits[i] = &ethpb.Deposit{Data: data}
+		leaves[i] = leaf[:]
+	}
+
+	depositTrie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not generate deposit trie: %v", err)
+	}
+	for i := range deposits {
+		proof, err := depositTrie.MerkleProof(i)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not generate proof for deposit %d: %v", i, err)
+		}
+		deposits[i].Proof = proof
+	}
+	root := depositTrie.Root()
+	eth1Data := &ethpb.Eth1Data{
+		DepositRoot:  root[:],
+		DepositCount: uint64(len(deposits)),
+		BlockHash:    root[:],
+	}
+	return deposits, eth1Data, nil
+}
@@ -0,0 +1,64 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// Importer replays blocks into a database through the same chain service and fork choice
+// pipeline as the blocks received from peers, the way Benchmark does. Unlike Benchmark, the
+// database belongs to the caller and is kept once the importer is stopped, so that a
+// beacon node can be started from it.
+type Importer struct {
+	node *Node
+}
+
+// NewImporter initializes the empty database with the anchor block and its state as the
+// head, justified and finalized checkpoints, and starts the services replaying the blocks
+// following the anchor on top of it. Referenced ETH1.0 blocks are assumed to exist.
+func NewImporter(
+	ctx context.Context,
+	beaconDB *db.BeaconDB,
+	anchor *ethpb.BeaconBlock,
+	anchorState *pb.BeaconState,
+) (*Importer, error) {
+	if anchor == nil || anchorState == nil {
+		return nil, errors.New("import requires an anchor block and state")
+	}
+	node, err := startNode(ctx, 0, beaconDB, anchor, anchorState, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not start node: %v", err)
+	}
+	return &Importer{node: node}, nil
+}
+
+// Import processes the block and applies the fork choice rule, returning the post state
+// of the block. The block must be a child of the anchor or of an imported block.
+func (i *Importer) Import(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	if err := i.node.ReceiveBlock(ctx, block); err != nil {
+		return nil, err
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	postState, err := i.node.beaconDB.StateByBlockRoot(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve post state: %v", err)
+	}
+	if postState == nil {
+		return nil, fmt.Errorf("no post state saved for block %#x", root)
+	}
+	return postState, nil
+}
+
+// Stop shuts down the services of the importer, leaving the database open.
+func (i *Importer) Stop() error {
+	return i.node.stopServices()
+}
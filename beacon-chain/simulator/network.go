@@ -0,0 +1,137 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Network is an in-memory stand-in for the p2p network connecting the nodes of a simulation.
+// Blocks and attestations are delivered synchronously and in node order, so that a simulation run is fully
+// deterministic. Nodes may be split into partitions which cannot reach each other, to
+// reproduce forks and the reorgs which follow once the network heals.
+type Network struct {
+	lock       sync.RWMutex
+	nodes      []*Node
+	partitions map[int]int
+}
+
+func newNetwork(nodes []*Node) *Network {
+	return &Network{
+		nodes:      nodes,
+		partitions: make(map[int]int),
+	}
+}
+
+// Partition splits the network into the given groups of node indices. Nodes can only
+// exchange blocks and attestations with nodes in the same group. Nodes which are not part of any group
+// are placed together in a group of their own.
+func (n *Network) Partition(groups ...[]int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.partitions = make(map[int]int)
+	for i, group := range groups {
+		for _, idx := range group {
+			n.partitions[idx] = i + 1
+		}
+	}
+}
+
+// Heal removes any partitions, connecting all nodes to each other again.
+func (n *Network) Heal() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.partitions = make(map[int]int)
+}
+
+// Connected returns true if the two nodes are able to exchange blocks.
+func (n *Network) Connected(a int, b int) bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.partitions[a] == n.partitions[b]
+}
+
+// Gossip spreads the head block of every node and the attestations in its pool to the
+// nodes it is connected to. It hands on the blocks and attestations which reached the
+// nodes from outside the simulation, such as those of validator clients connected to the
+// nodes over RPC.
+func (n *Network) Gossip(ctx context.Context) error {
+	for _, sender := range n.nodes {
+		head, _, err := sender.Head()
+		if err != nil {
+			return fmt.Errorf("could not retrieve chain head of node %d: %v", sender.index, err)
+		}
+		if err := n.broadcastBlock(ctx, sender, head); err != nil {
+			return err
+		}
+		atts, err := sender.opsService.PendingAttestations(ctx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve pending attestations of node %d: %v", sender.index, err)
+		}
+		for _, att := range atts {
+			if err := n.broadcastAttestation(ctx, sender, att); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// broadcastBlock gossips a block processed by the sender to every node it is connected to.
+func (n *Network) broadcastBlock(ctx context.Context, sender *Node, block *ethpb.BeaconBlock) error {
+	for _, peer := range n.nodes {
+		if peer == sender || !n.Connected(sender.index, peer.index) {
+			continue
+		}
+		if err := n.deliverBlock(ctx, sender, peer, block); err != nil {
+			return fmt.Errorf("node %d could not receive block from node %d: %v", peer.index, sender.index, err)
+		}
+	}
+	return nil
+}
+
+// broadcastAttestation gossips an attestation of the sender's validators to every node it
+// is connected to, the sender included.
+func (n *Network) broadcastAttestation(ctx context.Context, sender *Node, att *ethpb.Attestation) error {
+	for _, peer := range n.nodes {
+		if !n.Connected(sender.index, peer.index) {
+			continue
+		}
+		if err := peer.ReceiveAttestation(ctx, att); err != nil {
+			return fmt.Errorf("node %d could not receive attestation from node %d: %v", peer.index, sender.index, err)
+		}
+	}
+	return nil
+}
+
+// deliverBlock hands a block to the receiving node. If the receiver is missing any of the
+// block's ancestors, for instance after a partition has healed, they are requested from
+// the sender's database and processed in order first.
+func (n *Network) deliverBlock(ctx context.Context, sender *Node, receiver *Node, block *ethpb.BeaconBlock) error {
+	err := receiver.ReceiveBlock(ctx, block)
+	if err != errMissingParent {
+		return err
+	}
+	missing := []*ethpb.BeaconBlock{block}
+	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+	for !receiver.beaconDB.HasBlock(parentRoot) {
+		parent, err := sender.beaconDB.Block(parentRoot)
+		if err != nil {
+			return fmt.Errorf("could not retrieve ancestor %#x: %v", parentRoot, err)
+		}
+		if parent == nil {
+			return fmt.Errorf("sender is missing ancestor %#x", parentRoot)
+		}
+		missing = append(missing, parent)
+		parentRoot = bytesutil.ToBytes32(parent.ParentRoot)
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		if err := receiver.ReceiveBlock(ctx, missing[i]); err != nil {
+			return fmt.Errorf("could not process ancestor at slot %d: %v", missing[i].Slot, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// errMissingParent is returned when a node receives a block whose parent it has not processed.
+var errMissingParent = errors.New("parent block has not been processed")
+
+// Node is a single in-process beacon node taking part in a simulation, along with the
+// validators it hosts. Each node has its own database and chain service, and only
+// learns about blocks proposed by other nodes through the simulated network.
+type Node struct {
+	index       int
+	dbPath      string
+	beaconDB    *db.BeaconDB
+	chain       *blockchain.ChainService
+	attsService *attestation.Service
+	opsService  *operations.Service
+	keys        map[uint64]*bls.SecretKey
+	stageTimer  func(stage string, elapsed time.Duration)
+}
+
+// newNode creates a node with a fresh database at dataDir, initialized with the anchor
+// block and a copy of its state, and starts its services. The node follows the ETH1.0
+// chain of the client, or the simulated ETH1.0 chain if the client is nil. The stage
+// timer, if any, is called with the time spent in each stage of the node's block processing.
+func newNode(
+	ctx context.Context,
+	index int,
+	dataDir string,
+	anchor *ethpb.BeaconBlock,
+	anchorState *pb.BeaconState,
+	eth1 powchain.Client,
+	stageTimer func(stage string, elapsed time.Duration),
+) (*Node, error) {
+	dbPath := path.Join(dataDir, fmt.Sprintf("node-%d", index))
+	if err := db.ClearDB(dbPath); err != nil {
+		return nil, fmt.Errorf("could not clear database: %v", err)
+	}
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not create database: %v", err)
+	}
+	n, err := startNode(ctx, index, beaconDB, anchor, anchorState, eth1, stageTimer)
+	if err != nil {
+		return nil, err
+	}
+	n.dbPath = dbPath
+	return n, nil
+}
+
+// startNode creates a node with the database, which must be empty, initializes it with
+// the anchor block and a copy of its state, and starts its services.
+func startNode(
+	ctx context.Context,
+	index int,
+	beaconDB *db.BeaconDB,
+	anchor *ethpb.BeaconBlock,
+	anchorState *pb.BeaconState,
+	eth1 powchain.Client,
+	stageTimer func(stage string, elapsed time.Duration),
+) (*Node, error) {
+	n := &Node{
+		index:      index,
+		beaconDB:   beaconDB,
+		keys:       make(map[uint64]*bls.SecretKey),
+		stageTimer: stageTimer,
+	}
+	if err := n.initializeAnchor(ctx, anchor, proto.Clone(anchorState).(*pb.BeaconState)); err != nil {
+		return nil, fmt.Errorf("could not initialize anchor: %v", err)
+	}
+
+	web3Service, err := newWeb3Service(ctx, eth1)
+	if err != nil {
+		return nil, fmt.Errorf("could not create web3 service: %v", err)
+	}
+	n.attsService = attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	n.opsService = operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB, P2P: n})
+	n.chain, err = blockchain.NewChainService(ctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		Web3Service:    web3Service,
+		AttsService:    n.attsService,
+		OpsPoolService: n.opsService,
+		P2p:            n,
+		StageTimer:     stageTimer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create chain service: %v", err)
+	}
+	n.attsService.Start()
+	n.opsService.Start()
+	n.chain.Start()
+	return n, nil
+}
+
+// genesisAnchor returns the genesis block of the genesis state, along with a copy of the
+// state whose latest block header is the header of the genesis block.
+func genesisAnchor(genesisState *pb.BeaconState) (*ethpb.BeaconBlock, *pb.BeaconState, error) {
+	genesis := &ethpb.BeaconBlock{
+		ParentRoot: params.BeaconConfig().ZeroHash[:],
+		StateRoot:  []byte{},
+		Body:       &ethpb.BeaconBlockBody{},
+		Signature:  params.BeaconConfig().EmptySignature[:],
+	}
+	bodyRoot, err := ssz.HashTreeRoot(genesis.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	anchorState := proto.Clone(genesisState).(*pb.BeaconState)
+	anchorState.LatestBlockHeader = &ethpb.BeaconBlockHeader{
+		Slot:       genesis.Slot,
+		ParentRoot: genesis.ParentRoot,
+		BodyRoot:   bodyRoot[:],
+	}
+	return genesis, anchorState, nil
+}
+
+// initializeAnchor saves the anchor block and its state as the node's head, justified and
+// finalized checkpoints.
+func (n *Node) initializeAnchor(ctx context.Context, anchor *ethpb.BeaconBlock, anchorState *pb.BeaconState) error {
+	anchorRoot, err := ssz.SigningRoot(anchor)
+	if err != nil {
+		return err
+	}
+	if err := n.beaconDB.SaveBlock(anchor); err != nil {
+		return err
+	}
+	if err := n.beaconDB.SaveStateByBlockRoot(ctx, anchorRoot, anchorState); err != nil {
+		return err
+	}
+	if err := n.beaconDB.UpdateChainHead(ctx, anchor, anchorState); err != nil {
+		return err
+	}
+	if err := n.beaconDB.SaveJustifiedBlock(anchor); err != nil {
+		return err
+	}
+	if err := n.beaconDB.SaveJustifiedState(anchorState); err != nil {
+		return err
+	}
+	if err := n.beaconDB.SaveFinalizedBlock(anchor); err != nil {
+		return err
+	}
+	return n.beaconDB.SaveFinalizedState(anchorState)
+}
+
+// Index returns the position of the node in the simulation.
+func (n *Node) Index() int {
+	return n.index
+}
+
+// DB returns the node's beacon chain database.
+func (n *Node) DB() *db.BeaconDB {
+	return n.beaconDB
+}
+
+// Chain returns the chain service of the node.
+func (n *Node) Chain() *blockchain.ChainService {
+	return n.chain
+}
+
+// Operations returns the operations pool service of the node.
+func (n *Node) Operations() *operations.Service {
+	return n.opsService
+}
+
+// Head returns the node's current canonical head block and its signing root.
+func (n *Node) Head() (*ethpb.BeaconBlock, [32]byte, error) {
+	head, err := n.beaconDB.ChainHead()
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	root, err := ssz.SigningRoot(head)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	return head, root, nil
+}
+
+// Broadcast satisfies the p2p.Broadcaster interface for the node's services. Block
+// announcements are dropped, as full blocks are gossiped by the simulated network
+// once they have been processed.
+func (n *Node) Broadcast(_ context.Context, _ proto.Message) {}
+
+// ReceiveBlock runs a block through the node's chain service and fork choice rule, the
+// same way regular sync does for blocks received from peers. Blocks which were already
+// processed are ignored and blocks with an unknown parent return errMissingParent.
+func (n *Node) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) error {
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	if n.beaconDB.HasBlock(root) {
+		return nil
+	}
+	if !n.beaconDB.HasBlock(bytesutil.ToBytes32(block.ParentRoot)) {
+		return errMissingParent
+	}
+	postState, err := n.chain.ReceiveBlock(ctx, block)
+	if err != nil {
+		return fmt.Errorf("could not process block: %v", err)
+	}
+	_, headRoot, err := n.Head()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	if headRoot != bytesutil.ToBytes32(block.ParentRoot) {
+		if err := n.beaconDB.SaveStateByBlockRoot(ctx, root, postState); err != nil {
+			return fmt.Errorf("could not save historical state: %v", err)
+		}
+	}
+	start := time.Now()
+	if err := n.chain.ApplyForkChoiceRule(ctx, block, postState); err != nil {
+		return fmt.Errorf("could not apply fork choice rule: %v", err)
+	}
+	if n.stageTimer != nil {
+		n.stageTimer("fork_choice", time.Since(start))
+	}
+	return nil
+}
+
+// proposeBlock builds, signs and processes a block for the given slot on top of the node's
+// head, including the node's pending attestations, if the slot's proposer is one of the
+// node's validators. It returns nil if the node has no duty for the slot.
+func (n *Node) proposeBlock(ctx context.Context, slot uint64) (*ethpb.BeaconBlock, error) {
+	head, headRoot, err := n.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	if head.Slot >= slot {
+		return nil, nil
+	}
+	headState, err := n.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	slotState, err := state.ProcessSlots(ctx, proto.Clone(headState).(*pb.BeaconState), slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not process slots: %v", err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(slotState)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer index: %v", err)
+	}
+	key, ok := n.keys[proposerIdx]
+	if !ok {
+		return nil, nil
+	}
+
+	atts, err := n.includedAttestations(ctx, slotState)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := helpers.SlotToEpoch(slot)
+	randaoDomain := helpers.Domain(slotState, epoch, params.BeaconConfig().DomainRandao)
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: headRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: key.Sign(bytesutil.Bytes32(epoch), randaoDomain).Marshal(),
+			Eth1Data:     headState.Eth1Data,
+			Attestations: atts,
+		},
+	}
+	postState, err := state.ExecuteStateTransition(
+		ctx,
+		proto.Clone(headState).(*pb.BeaconState),
+		block,
+		state.DefaultConfig(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute state transition: %v", err)
+	}
+	stateRoot, err := ssz.HashTreeRoot(postState)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash post state: %v", err)
+	}
+	block.StateRoot = stateRoot[:]
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	proposerDomain := helpers.Domain(slotState, epoch, params.BeaconConfig().DomainBeaconProposer)
+	block.Signature = key.Sign(blockRoot[:], proposerDomain).Marshal()
+
+	log.WithFields(logrus.Fields{
+		"node":     n.index,
+		"slot":     slot,
+		"proposer": proposerIdx,
+	}).Debug("Proposing block")
+	if err := n.ReceiveBlock(ctx, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// stop shuts down the node's services and removes its database.
+func (n *Node) stop() error {
+	if err := n.stopServices(); err != nil {
+		return err
+	}
+	if err := n.beaconDB.Close(); err != nil {
+		return err
+	}
+	return db.ClearDB(n.dbPath)
+}
+
+// stopServices stops the services of the node, leaving its database open.
+func (n *Node) stopServices() error {
+	if err := n.chain.Stop(); err != nil {
+		return err
+	}
+	if err := n.opsService.Stop(); err != nil {
+		return err
+	}
+	return n.attsService.Stop()
+}
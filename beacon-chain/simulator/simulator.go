@@ -0,0 +1,226 @@
+// Package simulator runs several in-process beacon nodes and their validators on a
+// simulated network, starting from a deterministic interop genesis. Slots are advanced
+// explicitly rather than by the wall clock, which makes a simulation reproducible and
+// suitable for sync and fork choice integration tests, as well as for replaying
+// consensus incidents.
+package simulator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "simulator")
+
+// Config options for a simulation.
+type Config struct {
+	// NodeCount is the number of beacon nodes in the simulation.
+	NodeCount int
+	// ValidatorCount is the number of genesis validators, which are assigned to the
+	// nodes in a round robin fashion.
+	ValidatorCount uint64
+	// Genesis is the state the simulation starts from instead of the interop genesis of
+	// ValidatorCount validators. Its validators must hold the interop keys in order.
+	Genesis *pb.BeaconState
+	// Eth1 is the ETH1.0 chain the nodes follow. It defaults to a stand-in assuming that
+	// every referenced ETH1.0 block exists.
+	Eth1 powchain.Client
+	// GenesisTime is the unix timestamp of the genesis state. It defaults to the unix
+	// epoch so that every simulated slot is already valid by the wall clock.
+	GenesisTime uint64
+	// DataDir is the directory under which each node's database is created. It defaults
+	// to a temporary directory.
+	DataDir string
+	// ExternalValidators leaves the genesis validators to validator clients connected to
+	// the nodes, so that the nodes neither propose nor attest when a slot is advanced.
+	ExternalValidators bool
+}
+
+// Simulator drives a simulation slot by slot.
+type Simulator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	nodes   []*Node
+	network *Network
+	slot    uint64
+}
+
+// New creates the interop genesis and starts the simulation's nodes.
+func New(ctx context.Context, cfg *Config) (*Simulator, error) {
+	if cfg.NodeCount <= 0 {
+		return nil, errors.New("simulation requires at least one node")
+	}
+	validatorCount := cfg.ValidatorCount
+	if cfg.Genesis != nil {
+		validatorCount = uint64(len(cfg.Genesis.Validators))
+	}
+	if validatorCount == 0 {
+		return nil, errors.New("simulation requires at least one validator")
+	}
+	dataDir := cfg.DataDir
+	if dataDir == "" {
+		dataDir = path.Join(os.TempDir(), "simulator")
+	}
+
+	keys, err := InteropKeys(validatorCount)
+	if err != nil {
+		return nil, err
+	}
+	genesisState := cfg.Genesis
+	if genesisState == nil {
+		deposits, eth1Data, err := InteropDeposits(keys)
+		if err != nil {
+			return nil, err
+		}
+		genesisState, err = state.GenesisBeaconState(deposits, cfg.GenesisTime, eth1Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not create genesis state: %v", err)
+		}
+	}
+
+	genesis, anchorState, err := genesisAnchor(genesisState)
+	if err != nil {
+		return nil, fmt.Errorf("could not create genesis block: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Simulator{
+		ctx:    ctx,
+		cancel: cancel,
+		nodes:  make([]*Node, cfg.NodeCount),
+	}
+	for i := range s.nodes {
+		node, err := newNode(ctx, i, dataDir, genesis, anchorState, cfg.Eth1, nil)
+		if err != nil {
+			s.Stop()
+			return nil, fmt.Errorf("could not start node %d: %v", i, err)
+		}
+		s.nodes[i] = node
+	}
+	if !cfg.ExternalValidators {
+		assignValidators(s.nodes, keys)
+	}
+	s.network = newNetwork(s.nodes)
+
+	log.WithFields(logrus.Fields{
+		"nodes":      cfg.NodeCount,
+		"validators": validatorCount,
+	}).Info("Started simulation")
+	return s, nil
+}
+
+// assignValidators hands out the validator keys to the nodes in a round robin fashion.
+func assignValidators(nodes []*Node, keys []*bls.SecretKey) {
+	for i, key := range keys {
+		nodes[i%len(nodes)].keys[uint64(i)] = key
+	}
+}
+
+// Nodes returns the nodes taking part in the simulation.
+func (s *Simulator) Nodes() []*Node {
+	return s.nodes
+}
+
+// Network returns the simulated network connecting the nodes.
+func (s *Simulator) Network() *Network {
+	return s.network
+}
+
+// Slot returns the last slot the simulation advanced to.
+func (s *Simulator) Slot() uint64 {
+	return s.slot
+}
+
+// AdvanceSlot moves the simulation to the next slot. Every node checks whether the slot's
+// proposer, as computed from its own head, is one of its validators, in which case it
+// proposes a block and gossips it to the nodes it is connected to. Once the blocks of the
+// slot are gossiped, the validators of every node assigned to the slot attest to the
+// node's head and the attestations are gossiped as well. The blocks proposed in the slot
+// are returned, which is more than one if the network is partitioned.
+func (s *Simulator) AdvanceSlot() ([]*ethpb.BeaconBlock, error) {
+	s.slot++
+	var proposed []*ethpb.BeaconBlock
+	for _, node := range s.nodes {
+		block, err := node.proposeBlock(s.ctx, s.slot)
+		if err != nil {
+			return nil, fmt.Errorf("node %d could not propose block at slot %d: %v", node.index, s.slot, err)
+		}
+		if block == nil {
+			continue
+		}
+		if err := s.network.broadcastBlock(s.ctx, node, block); err != nil {
+			return nil, err
+		}
+		proposed = append(proposed, block)
+	}
+	if len(proposed) == 0 {
+		log.WithField("slot", s.slot).Debug("No block proposed in slot")
+	}
+	for _, node := range s.nodes {
+		atts, err := node.attest(s.ctx, s.slot)
+		if err != nil {
+			return nil, fmt.Errorf("node %d could not attest at slot %d: %v", node.index, s.slot, err)
+		}
+		for _, att := range atts {
+			if err := s.network.broadcastAttestation(s.ctx, node, att); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return proposed, nil
+}
+
+// Run advances the simulation by the given number of slots.
+func (s *Simulator) Run(slots uint64) error {
+	for i := uint64(0); i < slots; i++ {
+		if s.ctx.Err() != nil {
+			return s.ctx.Err()
+		}
+		if _, err := s.AdvanceSlot(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HeadsAgree returns true if every node has the same canonical head.
+func (s *Simulator) HeadsAgree() (bool, error) {
+	var first [32]byte
+	for i, node := range s.nodes {
+		_, root, err := node.Head()
+		if err != nil {
+			return false, err
+		}
+		if i == 0 {
+			first = root
+			continue
+		}
+		if root != first {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Stop shuts down every node and removes their databases.
+func (s *Simulator) Stop() {
+	defer s.cancel()
+	for _, node := range s.nodes {
+		if node == nil {
+			continue
+		}
+		if err := node.stop(); err != nil {
+			log.WithError(err).Errorf("Could not stop node %d", node.index)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	logrus.SetOutput(ioutil.Discard)
+}
+
+func setupSimulator(t *testing.T, nodes int) *Simulator {
+	sim, err := New(context.Background(), &Config{
+		NodeCount:      nodes,
+		ValidatorCount: 64,
+		DataDir:        path.Join(testutil.TempDir(), "simulator-test"),
+	})
+	if err != nil {
+		t.Fatalf("Could not start simulation: %v", err)
+	}
+	return sim
+}
+
+func TestInteropKeys_Deterministic(t *testing.T) {
+	a, err := InteropKeys(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := InteropKeys(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].PublicKey().Marshal(), b[i].PublicKey().Marshal()) {
+			t.Errorf("Expected key %d to be the same across runs", i)
+		}
+	}
+	if bytes.Equal(a[0].PublicKey().Marshal(), a[1].PublicKey().Marshal()) {
+		t.Error("Expected validators to have distinct keys")
+	}
+}
+
+func TestSimulator_NodesAgreeOnHead(t *testing.T) {
+	sim := setupSimulator(t, 3)
+	defer sim.Stop()
+
+	if err := sim.Run(4); err != nil {
+		t.Fatal(err)
+	}
+	agree, err := sim.HeadsAgree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !agree {
+		t.Error("Expected all nodes to have the same head")
+	}
+	head, _, err := sim.Nodes()[0].Head()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Slot != sim.Slot() {
+		t.Errorf("Expected head at slot %d, received %d", sim.Slot(), head.Slot)
+	}
+}
+
+func TestSimulator_BlocksIncludeAttestations(t *testing.T) {
+	sim := setupSimulator(t, 2)
+	defer sim.Stop()
+
+	var included int
+	for i := 0; i < 4; i++ {
+		proposed, err := sim.AdvanceSlot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, block := range proposed {
+			included += len(block.Body.Attestations)
+		}
+	}
+	if included == 0 {
+		t.Error("Expected the attestations of earlier slots to be included in blocks")
+	}
+}
+
+func TestSimulator_PartitionedNodesSyncAfterHeal(t *testing.T) {
+	sim := setupSimulator(t, 2)
+	defer sim.Stop()
+
+	sim.Network().Partition([]int{0}, []int{1})
+	if err := sim.Run(4); err != nil {
+		t.Fatal(err)
+	}
+	agree, err := sim.HeadsAgree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if agree {
+		t.Fatal("Expected partitioned nodes to build separate chains")
+	}
+
+	sim.Network().Heal()
+	proposed, err := sim.AdvanceSlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range proposed {
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, node := range sim.Nodes() {
+			if !node.DB().HasBlock(root) {
+				t.Errorf("Expected node %d to have processed block at slot %d", node.Index(), block.Slot)
+			}
+		}
+	}
+}
+
+func TestBenchmark_ImportsSimulatedBlocks(t *testing.T) {
+	sim := setupSimulator(t, 1)
+	defer sim.Stop()
+
+	var blocks []*ethpb.BeaconBlock
+	for i := 0; i < 4; i++ {
+		proposed, err := sim.AdvanceSlot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, proposed...)
+	}
+	beaconDB := sim.Nodes()[0].DB()
+	anchor, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchorState, err := beaconDB.FinalizedState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Benchmark(context.Background(), path.Join(testutil.TempDir(), "benchmark-test"), anchor, anchorState, blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Blocks != len(blocks) {
+		t.Errorf("Expected %d blocks imported, received %d", len(blocks), res.Blocks)
+	}
+	for _, stage := range []string{"verify", "state_transition", "fork_choice"} {
+		if _, ok := res.Stages[stage]; !ok {
+			t.Errorf("Expected time spent in stage %s to be reported", stage)
+		}
+	}
+	if res.Allocs == 0 {
+		t.Error("Expected allocations to be reported")
+	}
+}
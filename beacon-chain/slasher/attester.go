@@ -0,0 +1,110 @@
+package slasher
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// attesterDetector indexes the attestations of every validator by their source and
+// target epochs to detect double and surround votes.
+type attesterDetector struct {
+	lock sync.Mutex
+	// history of the distinct attestations of each validator index.
+	history map[uint64][]*ethpb.IndexedAttestation
+	// found records the pairs of attestations already reported, keyed by the roots of
+	// their data.
+	found map[[64]byte]bool
+}
+
+func newAttesterDetector() *attesterDetector {
+	return &attesterDetector{
+		history: make(map[uint64][]*ethpb.IndexedAttestation),
+		found:   make(map[[64]byte]bool),
+	}
+}
+
+// detect records the attestation in the history of its attesters, returning a slashing
+// for every earlier attestation of one of its attesters it conflicts with. A pair of
+// conflicting attestations is only reported once, as its slashing covers all of the
+// validators who signed both.
+func (d *attesterDetector) detect(att *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error) {
+	root, err := ssz.HashTreeRoot(att.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var slashings []*ethpb.AttesterSlashing
+	for _, index := range attesters(att) {
+		recorded := false
+		for _, prev := range d.history[index] {
+			slashing := conflict(prev, att)
+			if slashing == nil {
+				// Attestations with the same target which do not conflict have the same data.
+				if prev.Data.Target.Epoch == att.Data.Target.Epoch {
+					recorded = true
+				}
+				continue
+			}
+			prevRoot, err := ssz.HashTreeRoot(prev.Data)
+			if err != nil {
+				return nil, err
+			}
+			var key [64]byte
+			copy(key[:32], prevRoot[:])
+			copy(key[32:], root[:])
+			if d.found[key] {
+				continue
+			}
+			d.found[key] = true
+			slashings = append(slashings, slashing)
+		}
+		if !recorded {
+			d.history[index] = append(d.history[index], att)
+		}
+	}
+	return slashings, nil
+}
+
+// prune drops the attestations targeting an epoch before the min target epoch.
+func (d *attesterDetector) prune(minTargetEpoch uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for index, atts := range d.history {
+		kept := atts[:0]
+		for _, att := range atts {
+			if att.Data.Target.Epoch >= minTargetEpoch {
+				kept = append(kept, att)
+			}
+		}
+		if len(kept) == 0 {
+			delete(d.history, index)
+			continue
+		}
+		d.history[index] = kept
+	}
+}
+
+// conflict returns the slashing for the two attestations if they are a double vote or
+// one surrounds the other, ordered as expected by the state transition.
+func conflict(prev *ethpb.IndexedAttestation, att *ethpb.IndexedAttestation) *ethpb.AttesterSlashing {
+	switch {
+	case blocks.IsSlashableAttestationData(prev.Data, att.Data):
+		return &ethpb.AttesterSlashing{Attestation_1: prev, Attestation_2: att}
+	case blocks.IsSlashableAttestationData(att.Data, prev.Data):
+		return &ethpb.AttesterSlashing{Attestation_1: att, Attestation_2: prev}
+	default:
+		return nil
+	}
+}
+
+// attesters returns the validator indices which signed the attestation.
+func attesters(att *ethpb.IndexedAttestation) []uint64 {
+	indices := make([]uint64, 0, len(att.CustodyBit_0Indices)+len(att.CustodyBit_1Indices))
+	indices = append(indices, att.CustodyBit_0Indices...)
+	return append(indices, att.CustodyBit_1Indices...)
+}
@@ -0,0 +1,132 @@
+package slasher
+
+import (
+	"bytes"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func indexedAtt(source uint64, target uint64, root byte, indices ...uint64) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		CustodyBit_0Indices: indices,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytes.Repeat([]byte{root}, 32),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, 32)},
+			Crosslink: &ethpb.Crosslink{
+				ParentRoot: make([]byte, 32),
+				DataRoot:   make([]byte, 32),
+			},
+		},
+	}
+}
+
+func TestAttesterDetector_DoubleVote(t *testing.T) {
+	d := newAttesterDetector()
+	first := indexedAtt(1, 2, 'a', 1, 2, 3)
+	if slashings, err := d.detect(first); err != nil || len(slashings) != 0 {
+		t.Fatalf("Expected no slashing for the first attestation, received %v, %v", slashings, err)
+	}
+	second := indexedAtt(1, 2, 'b', 3, 4)
+	slashings, err := d.detect(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].Attestation_1 != first || slashings[0].Attestation_2 != second {
+		t.Errorf("Unexpected slashing %v", slashings[0])
+	}
+}
+
+func TestAttesterDetector_SurroundVote(t *testing.T) {
+	d := newAttesterDetector()
+	surrounded := indexedAtt(2, 3, 'a', 1)
+	if _, err := d.detect(surrounded); err != nil {
+		t.Fatal(err)
+	}
+	surrounding := indexedAtt(1, 4, 'b', 1)
+	slashings, err := d.detect(surrounding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	// The surrounding attestation must come first for the slashing to be valid.
+	if slashings[0].Attestation_1 != surrounding || slashings[0].Attestation_2 != surrounded {
+		t.Errorf("Unexpected slashing order %v", slashings[0])
+	}
+}
+
+func TestAttesterDetector_NoConflict(t *testing.T) {
+	d := newAttesterDetector()
+	for _, att := range []*ethpb.IndexedAttestation{
+		indexedAtt(0, 1, 'a', 1),
+		indexedAtt(1, 2, 'a', 1),
+		indexedAtt(1, 2, 'a', 1),
+		indexedAtt(2, 3, 'b', 1),
+	} {
+		slashings, err := d.detect(att)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(slashings) != 0 {
+			t.Errorf("Expected no slashing, received %v", slashings)
+		}
+	}
+	if len(d.history[1]) != 3 {
+		t.Errorf("Expected the repeated attestation to be recorded once, received %d attestations", len(d.history[1]))
+	}
+}
+
+func TestAttesterDetector_ReportsPairOnce(t *testing.T) {
+	d := newAttesterDetector()
+	if _, err := d.detect(indexedAtt(1, 2, 'a', 1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	slashings, err := d.detect(indexedAtt(1, 2, 'b', 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Expected the pair to be reported once for both validators, received %d slashings", len(slashings))
+	}
+	slashings, err = d.detect(indexedAtt(1, 2, 'b', 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 0 {
+		t.Errorf("Expected the pair not to be reported again, received %d slashings", len(slashings))
+	}
+}
+
+func TestAttesterDetector_Prune(t *testing.T) {
+	d := newAttesterDetector()
+	for _, att := range []*ethpb.IndexedAttestation{
+		indexedAtt(0, 1, 'a', 1),
+		indexedAtt(1, 2, 'a', 1, 2),
+		indexedAtt(2, 3, 'a', 2),
+	} {
+		if _, err := d.detect(att); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d.prune(3)
+	if _, ok := d.history[1]; ok {
+		t.Error("Expected the history of validator 1 to be pruned")
+	}
+	if len(d.history[2]) != 1 || d.history[2][0].Data.Target.Epoch != 3 {
+		t.Errorf("Expected only the attestation targeting epoch 3 to be kept, received %v", d.history[2])
+	}
+	// A double vote against a pruned attestation is no longer detected.
+	slashings, err := d.detect(indexedAtt(1, 2, 'b', 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 0 {
+		t.Errorf("Expected no slashing against pruned attestations, received %d", len(slashings))
+	}
+}
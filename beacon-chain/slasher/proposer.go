@@ -0,0 +1,58 @@
+package slasher
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// proposalStore persists the signed block headers of every proposer and slot, so that
+// conflicting proposals are detected across restarts.
+type proposalStore interface {
+	ProposalHeaders(ctx context.Context, proposerIndex uint64, slot uint64) (map[[32]byte]*ethpb.BeaconBlockHeader, error)
+	SaveProposalHeader(ctx context.Context, proposerIndex uint64, signingRoot [32]byte, header *ethpb.BeaconBlockHeader) error
+}
+
+// proposerDetector detects proposers signing more than one block header for a slot.
+type proposerDetector struct {
+	lock  sync.Mutex
+	store proposalStore
+}
+
+func newProposerDetector(store proposalStore) *proposerDetector {
+	return &proposerDetector{store: store}
+}
+
+// detect records the signed block header of the proposer, returning a slashing for every
+// different header it signed earlier for the same slot. Headers already recorded are
+// ignored, so a pair of conflicting headers is only reported once.
+func (d *proposerDetector) detect(ctx context.Context, proposerIndex uint64, header *ethpb.BeaconBlockHeader) ([]*ethpb.ProposerSlashing, error) {
+	root, err := ssz.SigningRoot(header)
+	if err != nil {
+		return nil, err
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	headers, err := d.store.ProposalHeaders(ctx, proposerIndex, header.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := headers[root]; ok {
+		return nil, nil
+	}
+	var slashings []*ethpb.ProposerSlashing
+	for _, prev := range headers {
+		slashings = append(slashings, &ethpb.ProposerSlashing{
+			ProposerIndex: proposerIndex,
+			Header_1:      prev,
+			Header_2:      header,
+		})
+	}
+	if err := d.store.SaveProposalHeader(ctx, proposerIndex, root, header); err != nil {
+		return nil, err
+	}
+	return slashings, nil
+}
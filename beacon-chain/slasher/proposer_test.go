@@ -0,0 +1,111 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+type proposalKey struct {
+	proposerIndex uint64
+	slot          uint64
+}
+
+type mockProposalStore struct {
+	headers map[proposalKey]map[[32]byte]*ethpb.BeaconBlockHeader
+}
+
+func (m *mockProposalStore) ProposalHeaders(_ context.Context, proposerIndex uint64, slot uint64) (map[[32]byte]*ethpb.BeaconBlockHeader, error) {
+	headers := make(map[[32]byte]*ethpb.BeaconBlockHeader)
+	for root, header := range m.headers[proposalKey{proposerIndex, slot}] {
+		headers[root] = header
+	}
+	return headers, nil
+}
+
+func (m *mockProposalStore) SaveProposalHeader(_ context.Context, proposerIndex uint64, signingRoot [32]byte, header *ethpb.BeaconBlockHeader) error {
+	key := proposalKey{proposerIndex, header.Slot}
+	if m.headers[key] == nil {
+		m.headers[key] = make(map[[32]byte]*ethpb.BeaconBlockHeader)
+	}
+	m.headers[key][signingRoot] = header
+	return nil
+}
+
+func newMockProposalStore() *mockProposalStore {
+	return &mockProposalStore{headers: make(map[proposalKey]map[[32]byte]*ethpb.BeaconBlockHeader)}
+}
+
+func blockHeader(slot uint64, stateRoot byte) *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  bytes.Repeat([]byte{stateRoot}, 32),
+		BodyRoot:   make([]byte, 32),
+		Signature:  bytes.Repeat([]byte{stateRoot}, 96),
+	}
+}
+
+func TestProposerDetector_ConflictingProposals(t *testing.T) {
+	ctx := context.Background()
+	d := newProposerDetector(newMockProposalStore())
+	first := blockHeader(4, 'a')
+	if slashings, err := d.detect(ctx, 1, first); err != nil || len(slashings) != 0 {
+		t.Fatalf("Expected no slashing for the first proposal, received %v, %v", slashings, err)
+	}
+	second := blockHeader(4, 'b')
+	slashings, err := d.detect(ctx, 1, second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Fatalf("Expected 1 slashing, received %d", len(slashings))
+	}
+	if slashings[0].ProposerIndex != 1 || slashings[0].Header_1 != first || slashings[0].Header_2 != second {
+		t.Errorf("Unexpected slashing %v", slashings[0])
+	}
+
+	// Seeing either proposal again reports nothing new.
+	if slashings, err := d.detect(ctx, 1, blockHeader(4, 'b')); err != nil || len(slashings) != 0 {
+		t.Errorf("Expected no slashing for a known proposal, received %v, %v", slashings, err)
+	}
+}
+
+func TestProposerDetector_DistinctProposals(t *testing.T) {
+	ctx := context.Background()
+	d := newProposerDetector(newMockProposalStore())
+	for _, tt := range []struct {
+		proposerIndex uint64
+		header        *ethpb.BeaconBlockHeader
+	}{
+		{1, blockHeader(4, 'a')},
+		{1, blockHeader(5, 'b')},
+		{2, blockHeader(6, 'c')},
+		{3, blockHeader(6, 'd')},
+	} {
+		slashings, err := d.detect(ctx, tt.proposerIndex, tt.header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(slashings) != 0 {
+			t.Errorf("Expected no slashing, received %v", slashings)
+		}
+	}
+}
+
+func TestProposerDetector_SurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	store := newMockProposalStore()
+	if _, err := newProposerDetector(store).detect(ctx, 1, blockHeader(4, 'a')); err != nil {
+		t.Fatal(err)
+	}
+	slashings, err := newProposerDetector(store).detect(ctx, 1, blockHeader(4, 'b'))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slashings) != 1 {
+		t.Errorf("Expected the proposal recorded before the restart to be detected, received %d slashings", len(slashings))
+	}
+}
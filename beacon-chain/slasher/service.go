@@ -0,0 +1,275 @@
+// Package slasher detects the slashable offenses of validators from the operations seen
+// by the node, producing the slashings to be included in blocks.
+package slasher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+var (
+	attesterSlashingsDetected = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attester_slashings_detected",
+		Help: "The number of conflicting attestation pairs detected",
+	})
+	proposerSlashingsDetected = metrics.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_proposer_slashings_detected",
+		Help: "The number of conflicting block header pairs detected",
+	})
+)
+
+// OperationFeeds are the feeds of attestations and blocks checked for slashable offenses,
+// and the feeds of the slashing pool the detected slashings are sent to.
+type OperationFeeds interface {
+	AcceptedAttFeed() *event.Topic
+	IncomingProcessedBlockFeed() *event.Topic
+	IncomingProposerSlashingFeed() *event.Topic
+	IncomingAttesterSlashingFeed() *event.Topic
+}
+
+// Config options for the slasher service.
+type Config struct {
+	BeaconDB *db.BeaconDB
+	OpsPool  OperationFeeds
+	// HistoryEpochs is the number of epochs attestations and block headers are kept for,
+	// which bounds how far apart in target epoch two conflicting attestations can be
+	// detected.
+	HistoryEpochs uint64
+}
+
+// Service checks every attestation accepted by the node against the earlier attestations
+// of its attesters, and every block processed by the node against the earlier block
+// headers of its proposer, sending the slashings it detects on its feeds. Block headers
+// are persisted in the database, so conflicting proposals are detected across restarts.
+// The slashings are also sent to the operations pool, to be included in blocks.
+type Service struct {
+	ctx                  context.Context
+	cancel               context.CancelFunc
+	beaconDB             *db.BeaconDB
+	opsPool              OperationFeeds
+	historyEpochs        uint64
+	attesters            *attesterDetector
+	proposers            *proposerDetector
+	attesterSlashingFeed *event.Topic
+	proposerSlashingFeed *event.Topic
+	headState            *pb.BeaconState
+	prunedEpoch          uint64
+}
+
+// NewSlasherService creates a slasher service for the node.
+func NewSlasherService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	historyEpochs := cfg.HistoryEpochs
+	if historyEpochs == 0 {
+		historyEpochs = params.BeaconConfig().EpochsPerHistoricalVector
+	}
+	return &Service{
+		ctx:                  ctx,
+		cancel:               cancel,
+		beaconDB:             cfg.BeaconDB,
+		opsPool:              cfg.OpsPool,
+		historyEpochs:        historyEpochs,
+		attesters:            newAttesterDetector(),
+		proposers:            newProposerDetector(cfg.BeaconDB),
+		attesterSlashingFeed: event.NewTopic("attester_slashings"),
+		proposerSlashingFeed: event.NewTopic("proposer_slashings"),
+	}
+}
+
+// Start checking the accepted attestations.
+func (s *Service) Start() {
+	log.WithField("historyEpochs", s.historyEpochs).Info("Starting service")
+	go s.run()
+}
+
+// Stop the slasher service.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+func (s *Service) Status() error {
+	return nil
+}
+
+// AttesterSlashingFeed returns a feed of the attester slashings detected by the service.
+func (s *Service) AttesterSlashingFeed() *event.Topic {
+	return s.attesterSlashingFeed
+}
+
+// ProposerSlashingFeed returns a feed of the proposer slashings detected by the service.
+func (s *Service) ProposerSlashingFeed() *event.Topic {
+	return s.proposerSlashingFeed
+}
+
+func (s *Service) run() {
+	atts := make(chan *ethpb.Attestation)
+	attSub := s.opsPool.AcceptedAttFeed().SubscribeBuffered(atts, params.BeaconConfig().DefaultBufferSize, event.DropNewest)
+	defer attSub.Unsubscribe()
+	processedBlocks := make(chan *ethpb.BeaconBlock)
+	blockSub := s.opsPool.IncomingProcessedBlockFeed().SubscribeBuffered(processedBlocks, params.BeaconConfig().DefaultBufferSize, event.DropNewest)
+	defer blockSub.Unsubscribe()
+	for {
+		select {
+		case att := <-atts:
+			if err := s.checkAttestation(s.ctx, att); err != nil {
+				log.WithError(err).Debug("Could not check attestation for slashable offenses")
+			}
+		case block := <-processedBlocks:
+			if err := s.checkBlock(s.ctx, block); err != nil {
+				log.WithError(err).Debug("Could not check block for slashable offenses")
+			}
+		case <-attSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-blockSub.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return
+		case <-s.ctx.Done():
+			log.Debug("Slasher context closed, exiting goroutine")
+			return
+		}
+	}
+}
+
+// checkAttestation records the attestation, sending a slashing for every earlier
+// attestation it conflicts with.
+func (s *Service) checkAttestation(ctx context.Context, att *ethpb.Attestation) error {
+	beaconState, err := s.stateFor(ctx, att.Data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	indexed, err := blocks.ConvertToIndexed(beaconState, att)
+	if err != nil {
+		return fmt.Errorf("could not convert attestation to indexed attestation: %v", err)
+	}
+	slashings, err := s.attesters.detect(indexed)
+	if err != nil {
+		return fmt.Errorf("could not detect slashable attestations: %v", err)
+	}
+	for _, slashing := range slashings {
+		attesterSlashingsDetected.Inc()
+		log.WithFields(logrus.Fields{
+			"sourceEpoch1": slashing.Attestation_1.Data.Source.Epoch,
+			"targetEpoch1": slashing.Attestation_1.Data.Target.Epoch,
+			"sourceEpoch2": slashing.Attestation_2.Data.Source.Epoch,
+			"targetEpoch2": slashing.Attestation_2.Data.Target.Epoch,
+		}).Warn("Detected slashable attestations")
+		s.attesterSlashingFeed.Send(slashing)
+		s.opsPool.IncomingAttesterSlashingFeed().Send(slashing)
+	}
+
+	return s.prune(ctx, helpers.CurrentEpoch(beaconState))
+}
+
+// checkBlock records the signed header of the block, sending a slashing for every
+// different header its proposer signed earlier for the slot.
+func (s *Service) checkBlock(ctx context.Context, block *ethpb.BeaconBlock) error {
+	beaconState, err := s.proposerState(ctx, block)
+	if err != nil {
+		return err
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return fmt.Errorf("could not get proposer index: %v", err)
+	}
+	header, err := blocks.HeaderFromBlock(block)
+	if err != nil {
+		return err
+	}
+	slashings, err := s.proposers.detect(ctx, proposerIndex, header)
+	if err != nil {
+		return fmt.Errorf("could not detect conflicting proposals: %v", err)
+	}
+	for _, slashing := range slashings {
+		proposerSlashingsDetected.Inc()
+		log.WithFields(logrus.Fields{
+			"proposerIndex": proposerIndex,
+			"slot":          block.Slot,
+		}).Warn("Detected conflicting block proposals")
+		s.proposerSlashingFeed.Send(slashing)
+		s.opsPool.IncomingProposerSlashingFeed().Send(slashing)
+	}
+	return s.prune(ctx, helpers.SlotToEpoch(block.Slot))
+}
+
+// prune drops the attestations and block headers older than the history epochs, once
+// per epoch.
+func (s *Service) prune(ctx context.Context, epoch uint64) error {
+	if epoch <= s.prunedEpoch || epoch <= s.historyEpochs {
+		return nil
+	}
+	s.prunedEpoch = epoch
+	s.attesters.prune(epoch - s.historyEpochs)
+	if err := s.beaconDB.DeleteProposalHeadersBefore(ctx, helpers.StartSlot(epoch-s.historyEpochs)); err != nil {
+		return fmt.Errorf("could not prune block headers: %v", err)
+	}
+	return nil
+}
+
+// proposerState returns a state at the slot of the block, from which its proposer is
+// computed. This is the post state of the block, or if it was not saved, the post state
+// of its parent processed up to the slot of the block.
+func (s *Service) proposerState(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	beaconState, err := s.beaconDB.StateByBlockRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve state of block: %v", err)
+	}
+	if beaconState != nil && beaconState.Slot == block.Slot {
+		return beaconState, nil
+	}
+	parentState, err := s.beaconDB.StateByBlockRoot(ctx, bytesutil.ToBytes32(block.ParentRoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve state of parent block: %v", err)
+	}
+	if parentState == nil {
+		return nil, fmt.Errorf("no state of block at slot %d or of its parent", block.Slot)
+	}
+	if parentState.Slot >= block.Slot {
+		return nil, fmt.Errorf("parent state at slot %d is not before block at slot %d", parentState.Slot, block.Slot)
+	}
+	beaconState, err = state.ProcessSlots(ctx, parentState, block.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not process slots up to block at slot %d: %v", block.Slot, err)
+	}
+	return beaconState, nil
+}
+
+// stateFor returns the head state used to compute the committees of an attestation
+// targeting the epoch, reading the head state again once the epoch is past it.
+func (s *Service) stateFor(ctx context.Context, targetEpoch uint64) (*pb.BeaconState, error) {
+	if s.headState != nil && helpers.CurrentEpoch(s.headState) >= targetEpoch {
+		return s.headState, nil
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, fmt.Errorf("no head state")
+	}
+	s.headState = headState
+	return headState, nil
+}
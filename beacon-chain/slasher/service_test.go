@@ -0,0 +1,93 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+type mockOpsPool struct {
+	acceptedAtts      *event.Topic
+	processedBlocks   *event.Topic
+	proposerSlashings *event.Topic
+	attesterSlashings *event.Topic
+}
+
+func (m *mockOpsPool) AcceptedAttFeed() *event.Topic {
+	return m.acceptedAtts
+}
+
+func (m *mockOpsPool) IncomingProcessedBlockFeed() *event.Topic {
+	return m.processedBlocks
+}
+
+func (m *mockOpsPool) IncomingProposerSlashingFeed() *event.Topic {
+	return m.proposerSlashings
+}
+
+func (m *mockOpsPool) IncomingAttesterSlashingFeed() *event.Topic {
+	return m.attesterSlashings
+}
+
+func TestCheckBlock_BlockInLaterEpochThanHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 64)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := &ethpb.BeaconBlock{Body: &ethpb.BeaconBlockBody{}}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The head is still in the genesis epoch.
+	if err := db.SaveState(ctx, genesisState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveStateByBlockRoot(ctx, genesisRoot, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewSlasherService(ctx, &Config{
+		BeaconDB: db,
+		OpsPool: &mockOpsPool{
+			acceptedAtts:      new(event.Topic),
+			processedBlocks:   new(event.Topic),
+			proposerSlashings: new(event.Topic),
+			attesterSlashings: new(event.Topic),
+		},
+	})
+	slashings := make(chan *ethpb.ProposerSlashing, 1)
+	sub := s.ProposerSlashingFeed().SubscribeBuffered(slashings, 1, event.DropNewest)
+	defer sub.Unsubscribe()
+
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	for _, stateRoot := range []byte{'a', 'b'} {
+		block := &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: genesisRoot[:],
+			StateRoot:  bytes.Repeat([]byte{stateRoot}, 32),
+			Body:       &ethpb.BeaconBlockBody{},
+		}
+		if err := s.checkBlock(ctx, block); err != nil {
+			t.Fatalf("Could not check block: %v", err)
+		}
+	}
+
+	slashing := <-slashings
+	if slashing.Header_1.Slot != slot || slashing.Header_2.Slot != slot {
+		t.Errorf("Expected slashing for proposals at slot %d, got %v", slot, slashing)
+	}
+}
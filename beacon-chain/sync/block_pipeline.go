@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// defaultBlockQueueSize is the capacity of each block pipeline queue when no
+// explicit size is configured.
+const defaultBlockQueueSize = 256
+
+var (
+	blockPipelineDropped = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_block_pipeline_dropped",
+		Help: "The number of received blocks dropped because the pipeline queue was full",
+	}, []string{"queue"})
+	blockPipelineQueued = metrics.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "regsync_block_pipeline_queued",
+		Help: "The number of received blocks waiting in the pipeline queue",
+	}, []string{"queue"})
+)
+
+// blockPipeline is the path a block takes from the p2p layer to the chain service. Blocks
+// are placed in one of two bounded queues and handed to ReceiveBlock by a single worker,
+// which always drains the priority queue of blocks for the current slot first. When a queue
+// is full, incoming blocks are dropped instead of spawning an unbounded number of goroutines
+// which would all contend on the block processing lock.
+type blockPipeline struct {
+	priority   chan p2p.Message
+	normal     chan p2p.Message
+	isPriority func(msg p2p.Message) bool
+	process    func(msg p2p.Message) error
+}
+
+// newBlockPipeline creates a block pipeline with queues holding at most size blocks each.
+// A non-positive size falls back to defaultBlockQueueSize.
+func newBlockPipeline(size int, isPriority func(p2p.Message) bool, process func(p2p.Message) error) *blockPipeline {
+	if size <= 0 {
+		size = defaultBlockQueueSize
+	}
+	return &blockPipeline{
+		priority:   make(chan p2p.Message, size),
+		normal:     make(chan p2p.Message, size),
+		isPriority: isPriority,
+		process:    process,
+	}
+}
+
+// enqueue places the block message in the appropriate queue without blocking. It returns
+// false if the queue was full and the block was dropped.
+func (bp *blockPipeline) enqueue(msg p2p.Message) bool {
+	queue, name := bp.normal, "normal"
+	if bp.isPriority(msg) {
+		queue, name = bp.priority, "priority"
+	}
+	select {
+	case queue <- msg:
+		blockPipelineQueued.WithLabelValues(name).Set(float64(len(queue)))
+		return true
+	default:
+		blockPipelineDropped.WithLabelValues(name).Inc()
+		log.WithField("queue", name).Debug("Block pipeline queue is full, dropping block")
+		return false
+	}
+}
+
+// run processes queued blocks until the context is canceled.
+func (bp *blockPipeline) run(ctx context.Context) {
+	for {
+		// Always drain the priority queue before looking at other blocks.
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-bp.priority:
+			bp.handle(msg, "priority", len(bp.priority))
+			continue
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-bp.priority:
+			bp.handle(msg, "priority", len(bp.priority))
+		case msg := <-bp.normal:
+			bp.handle(msg, "normal", len(bp.normal))
+		}
+	}
+}
+
+func (bp *blockPipeline) handle(msg p2p.Message, name string, remaining int) {
+	blockPipelineQueued.WithLabelValues(name).Set(float64(remaining))
+	safelyHandleMessage(bp.process, msg)
+}
+
+// isCurrentSlotBlock returns true if the block message contains a block for the
+// current slot, or a later one, which should be processed ahead of older blocks.
+func (rs *RegularSync) isCurrentSlotBlock(msg p2p.Message) bool {
+	resp, ok := msg.Data.(*pb.BeaconBlockResponse)
+	if !ok || resp.Block == nil {
+		return false
+	}
+	return resp.Block.Slot >= rs.currentSlot()
+}
+
+// currentSlot returns the slot for the current wall clock time, based on the genesis
+// time of the head state. If the genesis time is not known yet, the highest slot
+// observed from peers is used instead.
+func (rs *RegularSync) currentSlot() uint64 {
+	rs.genesisTimeLock.Lock()
+	defer rs.genesisTimeLock.Unlock()
+	genesis, ok := rs.genesisUnixTime()
+	if !ok {
+		return rs.highestSlot()
+	}
+	return slotutil.CurrentSlot(genesis)
+}
+
+// genesisUnixTime returns the genesis time of the head state, which is cached after it
+// was first read from the database. The genesis time lock must be held.
+func (rs *RegularSync) genesisUnixTime() (uint64, bool) {
+	if rs.genesisTime == 0 {
+		headState, err := rs.db.HeadState(rs.ctx)
+		if err != nil || headState == nil {
+			return 0, false
+		}
+		rs.genesisTime = headState.GenesisTime
+	}
+	return rs.genesisTime, true
+}
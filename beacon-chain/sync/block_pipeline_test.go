@@ -0,0 +1,69 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+)
+
+func blockMsg(slot uint64) p2p.Message {
+	return p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.BeaconBlockResponse{Block: &ethpb.BeaconBlock{Slot: slot}},
+	}
+}
+
+func TestBlockPipeline_DropsWhenFull(t *testing.T) {
+	isPriority := func(msg p2p.Message) bool { return false }
+	process := func(msg p2p.Message) error { return nil }
+	bp := newBlockPipeline(2, isPriority, process)
+
+	if !bp.enqueue(blockMsg(1)) || !bp.enqueue(blockMsg(2)) {
+		t.Fatal("Expected blocks to be queued")
+	}
+	if bp.enqueue(blockMsg(3)) {
+		t.Error("Expected block to be dropped when the queue is full")
+	}
+	if len(bp.normal) != 2 {
+		t.Errorf("Expected 2 queued blocks, received %d", len(bp.normal))
+	}
+}
+
+func TestBlockPipeline_ProcessesPriorityFirst(t *testing.T) {
+	processed := make(chan uint64, 4)
+	isPriority := func(msg p2p.Message) bool {
+		return msg.Data.(*pb.BeaconBlockResponse).Block.Slot >= 10
+	}
+	process := func(msg p2p.Message) error {
+		processed <- msg.Data.(*pb.BeaconBlockResponse).Block.Slot
+		return nil
+	}
+	bp := newBlockPipeline(4, isPriority, process)
+
+	// Queue all blocks before the worker starts, so the order of processing
+	// only depends on the queue priority.
+	bp.enqueue(blockMsg(1))
+	bp.enqueue(blockMsg(2))
+	bp.enqueue(blockMsg(10))
+	bp.enqueue(blockMsg(11))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go bp.run(ctx)
+
+	want := []uint64{10, 11, 1, 2}
+	for _, slot := range want {
+		select {
+		case received := <-processed:
+			if received != slot {
+				t.Errorf("Expected block with slot %d to be processed, received %d", slot, received)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for block to be processed")
+		}
+	}
+}
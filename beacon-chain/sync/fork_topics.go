@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// updateForkTopics reports the current epoch to the p2p layer once per slot, so that
+// gossip topics are switched over to the new fork digest around a scheduled fork. The
+// ticks are aligned with the start of the slots once the genesis time is known. Until the
+// fork digests are derived from the genesis validators root, the root is looked up each slot.
+func (rs *RegularSync) updateForkTopics(ctx context.Context) {
+	rs.genesisTimeLock.Lock()
+	genesis, ok := rs.genesisUnixTime()
+	rs.genesisTimeLock.Unlock()
+	genesisTime := time.Now()
+	if ok {
+		genesisTime = time.Unix(int64(genesis), 0)
+	}
+	ticker := slotutil.GetSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	digestsUpdated := false
+	for {
+		if !digestsUpdated {
+			digestsUpdated = rs.updateForkDigests(ctx)
+		}
+		rs.forkTopics.UpdateEpoch(helpers.SlotToEpoch(rs.currentSlot()))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+// updateForkDigests derives the fork digests from the genesis validators root and passes
+// them to the p2p layer. It reports whether the root was available.
+func (rs *RegularSync) updateForkDigests(ctx context.Context) bool {
+	root, err := rs.db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		log.Errorf("Could not get genesis validators root: %v", err)
+		return false
+	}
+	if root == nil {
+		return false
+	}
+	rs.forkTopics.UpdateForkDigests(
+		p2p.ForkDigest(params.BeaconConfig().GenesisForkVersion, root),
+		p2p.ForkDigest(params.BeaconConfig().NextForkVersion, root),
+	)
+	return true
+}
@@ -0,0 +1,75 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type mockForkTopics struct {
+	epochs  chan uint64
+	digests [][4]byte
+}
+
+func (m *mockForkTopics) UpdateEpoch(epoch uint64) {
+	m.epochs <- epoch
+}
+
+func (m *mockForkTopics) UpdateForkDigests(current [4]byte, next [4]byte) {
+	m.digests = append(m.digests, current, next)
+}
+
+func TestUpdateForkTopics_ReportsCurrentEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	rs := setupService(db)
+	updater := &mockForkTopics{epochs: make(chan uint64, 1)}
+	rs.forkTopics = updater
+	rs.highestObservedSlot = 3 * params.BeaconConfig().SlotsPerEpoch
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go rs.updateForkTopics(ctx)
+
+	select {
+	case epoch := <-updater.epochs:
+		if epoch != 3 {
+			t.Errorf("Expected epoch 3, received %d", epoch)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Epoch was not reported to the p2p layer")
+	}
+}
+
+func TestUpdateForkDigests_UsesGenesisValidatorsRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	rs := setupService(db)
+	updater := &mockForkTopics{}
+	rs.forkTopics = updater
+
+	if rs.updateForkDigests(context.Background()) {
+		t.Fatal("Expected fork digests not to be updated before chain start")
+	}
+	if err := db.InitializeState(context.Background(), 0, []*ethpb.Deposit{}, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	if !rs.updateForkDigests(context.Background()) {
+		t.Fatal("Expected fork digests to be updated after chain start")
+	}
+	root, err := db.GenesisValidatorsRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := p2p.ForkDigest(params.BeaconConfig().GenesisForkVersion, root)
+	if len(updater.digests) != 2 || updater.digests[0] != want {
+		t.Errorf("Expected current fork digest %#x, received %v", want, updater.digests)
+	}
+}
@@ -104,6 +104,7 @@ type InitialSync struct {
 	syncedFeed          *event.Feed
 	stateReceived       bool
 	mutex               *sync.Mutex
+	syncedLock          sync.RWMutex // Guards nodeIsSynced, read by the sync state reporting.
 	nodeIsSynced        bool
 }
 
@@ -148,11 +149,13 @@ func (s *InitialSync) Stop() error {
 
 // NodeIsSynced checks that the node has been caught up with the network.
 func (s *InitialSync) NodeIsSynced() bool {
+	s.syncedLock.RLock()
+	defer s.syncedLock.RUnlock()
 	return s.nodeIsSynced
 }
 
 func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBlock, chainHead *pb.ChainHeadResponse) error {
-	if s.nodeIsSynced {
+	if s.NodeIsSynced() {
 		return nil
 	}
 	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
@@ -201,6 +204,9 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBl
 	if err := s.db.UpdateChainHead(ctx, block, state); err != nil {
 		return err
 	}
+	if err := s.chainService.ArchiveHead(ctx, block, state); err != nil {
+		return err
+	}
 
 	stateRoot := s.db.HeadStateRoot()
 
@@ -216,7 +222,9 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBl
 	log.WithField("canonicalStateSlot", state.Slot).Info("Exiting init sync and starting regular sync")
 	s.syncService.ResumeSync()
 	s.cancel()
+	s.syncedLock.Lock()
 	s.nodeIsSynced = true
+	s.syncedLock.Unlock()
 	return nil
 }
 
@@ -255,7 +263,7 @@ func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse)
 		break
 	}
 
-	if !s.nodeIsSynced {
+	if !s.NodeIsSynced() {
 		log.Fatal("Failed to sync with anyone...")
 	}
 }
@@ -294,7 +302,7 @@ func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.Chai
 				s.p2p.Reputation(msg.Peer, p2p.RepPenalityInitialSyncFailure)
 				continue
 			}
-			if !s.nodeIsSynced {
+			if !s.NodeIsSynced() {
 				return errors.New("node still not in sync after receiving batch blocks")
 			}
 			s.p2p.Reputation(msg.Peer, p2p.RepRewardValidBlock)
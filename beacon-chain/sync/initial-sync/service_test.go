@@ -56,8 +56,8 @@ func (ms *mockSyncService) ResumeSync() {
 
 type mockChainService struct{}
 
-func (ms *mockChainService) CanonicalBlockFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockChainService) CanonicalBlockFeed() *event.Topic {
+	return new(event.Topic)
 }
 
 func (ms *mockChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
@@ -88,6 +88,10 @@ func (ms *mockChainService) CleanupBlockOperations(ctx context.Context, block *e
 	return nil
 }
 
+func (ms *mockChainService) ArchiveHead(ctx context.Context, head *ethpb.BeaconBlock, headState *pb.BeaconState) error {
+	return nil
+}
+
 func setUpGenesisStateAndBlock(beaconDB *db.BeaconDB, t *testing.T) {
 	ctx := context.Background()
 	genesisTime := time.Now()
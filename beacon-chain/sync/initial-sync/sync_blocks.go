@@ -150,5 +150,8 @@ func (s *InitialSync) validateAndSaveNextBlock(ctx context.Context, block *ethpb
 	if err := s.chainService.CleanupBlockOperations(ctx, block); err != nil {
 		return err
 	}
-	return s.db.UpdateChainHead(ctx, block, state)
+	if err := s.db.UpdateChainHead(ctx, block, state); err != nil {
+		return err
+	}
+	return s.chainService.ArchiveHead(ctx, block, state)
 }
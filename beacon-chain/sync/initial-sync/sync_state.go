@@ -42,7 +42,7 @@ func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadRespo
 	}
 	log.Infof("finalized block root %#x", finalizedBlockRoot)
 
-	if err := s.db.SaveHistoricalState(ctx, finalizedState, finalizedBlockRoot); err != nil {
+	if err := s.db.SaveStateByBlockRoot(ctx, finalizedBlockRoot, finalizedState); err != nil {
 		log.Errorf("Could not save new historical state: %v", err)
 		return nil
 	}
@@ -0,0 +1,123 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	blockTopic       = "beacon_block"
+	attestationTopic = "attestation"
+)
+
+var gossipArrivalDelay = metrics.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "regsync_gossip_arrival_delay_seconds",
+	Help:    "The delay between the start of a message's slot and its arrival from gossip",
+	Buckets: prometheus.ExponentialBuckets(0.125, 2, 10),
+}, []string{"topic"})
+
+type arrivalTimeKey struct{}
+
+type replayedKey struct{}
+
+// withArrivalTime records the time a message was received from the p2p layer in its
+// context, so that time spent waiting in the node's own queues is not attributed to
+// the network.
+func withArrivalTime(ctx context.Context, t time.Time) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, arrivalTimeKey{}, t)
+}
+
+// arrivalTime returns the time a message was received, or the current time if it was
+// not recorded.
+func arrivalTime(ctx context.Context) time.Time {
+	if ctx != nil {
+		if t, ok := ctx.Value(arrivalTimeKey{}).(time.Time); ok {
+			return t
+		}
+	}
+	return time.Now()
+}
+
+// withReplayed marks a message which is processed again after being held back, such as a
+// block which was waiting for its parent. Its propagation was already observed on arrival.
+func withReplayed(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, replayedKey{}, true)
+}
+
+// isUnsolicitedBlock reports whether a block was gossiped to the node, as opposed to being
+// sent in response to the node's own request for a missing ancestor or replayed from the
+// pending blocks. Blocks are requested after their announcement, so a block counts as
+// gossiped if it is the block announced for its slot and no pending block waits on it.
+func (rs *RegularSync) isUnsolicitedBlock(msg p2p.Message, slot uint64, blockRoot [32]byte) bool {
+	if msg.Ctx != nil && msg.Ctx.Value(replayedKey{}) != nil {
+		return false
+	}
+	if rs.pendingDepth(blockRoot) > 0 {
+		return false
+	}
+	rs.blockAnnouncementsLock.RLock()
+	defer rs.blockAnnouncementsLock.RUnlock()
+	return bytes.Equal(rs.blockAnnouncements[slot], blockRoot[:])
+}
+
+// slotStartTime returns the wall clock time at which the slot started. It returns false
+// if the genesis time is not known yet.
+func (rs *RegularSync) slotStartTime(slot uint64) (time.Time, bool) {
+	rs.genesisTimeLock.Lock()
+	defer rs.genesisTimeLock.Unlock()
+	genesis, ok := rs.genesisUnixTime()
+	if !ok {
+		return time.Time{}, false
+	}
+	return slotutil.SlotStartTime(genesis, slot), true
+}
+
+// observePropagation records how long after the start of its slot a gossiped message
+// arrived and adjusts the reputation of the peer which relayed it. Only unsolicited
+// messages must be observed, as responses to the node's own requests for past blocks
+// say nothing about how fast the peer relays gossip. Messages which arrive
+// within their slot are rewarded, while messages which arrive after the following slot
+// has ended are penalized, as the peer is either slow or replaying stale messages.
+func (rs *RegularSync) observePropagation(topic string, slot uint64, pid peer.ID, arrival time.Time) {
+	start, ok := rs.slotStartTime(slot)
+	if !ok {
+		return
+	}
+	delay := arrival.Sub(start)
+	if delay < 0 {
+		// Messages for a future slot are attributed to clock disparity between peers.
+		delay = 0
+	}
+	gossipArrivalDelay.WithLabelValues(topic).Observe(delay.Seconds())
+
+	if pid == "" {
+		return
+	}
+	slotDuration := slotutil.SlotDuration()
+	switch {
+	case delay < slotDuration:
+		rs.p2p.Reputation(pid, p2p.RepRewardTimelyMessage)
+	case delay >= 2*slotDuration:
+		log.WithFields(logrus.Fields{
+			"topic": topic,
+			"slot":  slot,
+			"delay": delay,
+			"peer":  pid.Pretty(),
+		}).Debug("Received late message from peer")
+		rs.p2p.Reputation(pid, p2p.RepPenalityLateMessage)
+	}
+}
@@ -0,0 +1,102 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type reputationP2P struct {
+	mockP2P
+	rewards []int
+}
+
+func (rp *reputationP2P) Reputation(_ peer.ID, val int) {
+	rp.rewards = append(rp.rewards, val)
+}
+
+func TestArrivalTime_FallsBackToNow(t *testing.T) {
+	recorded := time.Unix(1000, 0)
+	if got := arrivalTime(withArrivalTime(context.Background(), recorded)); !got.Equal(recorded) {
+		t.Errorf("Expected recorded arrival time %v, received %v", recorded, got)
+	}
+	if got := arrivalTime(context.Background()); time.Since(got) > time.Second {
+		t.Errorf("Expected arrival time to default to now, received %v", got)
+	}
+}
+
+func TestObservePropagation_ScoresPeerByDelay(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	rs := setupService(db)
+	p2pService := &reputationP2P{}
+	rs.p2p = p2pService
+	rs.genesisTime = 1000
+
+	slot := uint64(10)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	start := time.Unix(int64(1000+slot*params.BeaconConfig().SecondsPerSlot), 0)
+
+	tests := []struct {
+		arrival time.Time
+		rewards []int
+	}{
+		{arrival: start.Add(-time.Second), rewards: []int{p2p.RepRewardTimelyMessage}},
+		{arrival: start.Add(slotDuration / 2), rewards: []int{p2p.RepRewardTimelyMessage}},
+		{arrival: start.Add(slotDuration + slotDuration/2), rewards: nil},
+		{arrival: start.Add(3 * slotDuration), rewards: []int{p2p.RepPenalityLateMessage}},
+	}
+	for i, tt := range tests {
+		p2pService.rewards = nil
+		rs.observePropagation(blockTopic, slot, peer.ID("peer"), tt.arrival)
+		if len(p2pService.rewards) != len(tt.rewards) {
+			t.Fatalf("Test %d: expected rewards %v, received %v", i, tt.rewards, p2pService.rewards)
+		}
+		for j := range tt.rewards {
+			if p2pService.rewards[j] != tt.rewards[j] {
+				t.Errorf("Test %d: expected rewards %v, received %v", i, tt.rewards, p2pService.rewards)
+			}
+		}
+	}
+
+	p2pService.rewards = nil
+	rs.observePropagation(attestationTopic, slot, "", start)
+	if len(p2pService.rewards) != 0 {
+		t.Errorf("Expected locally produced messages not to affect reputation, received %v", p2pService.rewards)
+	}
+}
+
+func TestIsUnsolicitedBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	rs := setupService(db)
+	announced := [32]byte{'a'}
+	requested := [32]byte{'b'}
+	rs.blockAnnouncements[10] = announced[:]
+	rs.blockAnnouncements[11] = requested[:]
+	rs.insertPendingBlock(context.Background(), requested, p2p.Message{}, 1)
+
+	tests := []struct {
+		msg         p2p.Message
+		slot        uint64
+		root        [32]byte
+		unsolicited bool
+	}{
+		{msg: p2p.Message{Ctx: context.Background()}, slot: 10, root: announced, unsolicited: true},
+		{msg: p2p.Message{Ctx: withReplayed(context.Background())}, slot: 10, root: announced, unsolicited: false},
+		{msg: p2p.Message{Ctx: context.Background()}, slot: 11, root: requested, unsolicited: false},
+		{msg: p2p.Message{Ctx: context.Background()}, slot: 12, root: [32]byte{'c'}, unsolicited: false},
+	}
+	for i, tt := range tests {
+		if got := rs.isUnsolicitedBlock(tt.msg, tt.slot, tt.root); got != tt.unsolicited {
+			t.Errorf("Test %d: expected unsolicited %v, received %v", i, tt.unsolicited, got)
+		}
+	}
+}
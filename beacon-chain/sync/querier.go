@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -52,6 +53,7 @@ type Querier struct {
 	p2p                       p2pAPI
 	db                        *db.BeaconDB
 	chainService              chainService
+	lock                      sync.RWMutex // Guards currentHeadSlot, chainStarted and atGenesis.
 	currentHeadSlot           uint64
 	currentStateRoot          []byte
 	currentFinalizedStateRoot [32]byte
@@ -96,8 +98,10 @@ func (q *Querier) Start() {
 	q.waitForAllDepositsToBeProcessed()
 	hasChainStarted := q.powchain.HasChainStarted()
 
+	q.lock.Lock()
 	q.chainStarted = hasChainStarted
 	q.atGenesis = !hasChainStarted
+	q.lock.Unlock()
 
 	bState, err := q.db.HeadState(q.ctx)
 	if err != nil {
@@ -112,7 +116,7 @@ func (q *Querier) Start() {
 		q.listenForStateInitialization()
 
 		// Return, if the node is at genesis.
-		if q.atGenesis {
+		if q.isAtGenesis() {
 			return
 		}
 	}
@@ -133,7 +137,9 @@ func (q *Querier) listenForStateInitialization() {
 		select {
 		case <-q.chainStartBuf:
 			queryLog.Info("State has been initialized")
+			q.lock.Lock()
 			q.chainStarted = true
+			q.lock.Unlock()
 			return
 		case <-sub.Err():
 			log.Fatal("Subscriber closed, unable to continue on with sync")
@@ -171,7 +177,7 @@ func (q *Querier) run() {
 			queryLog.WithField("peerID", q.bestPeer.Pretty()).Info("Peer with highest canonical head")
 			queryLog.Infof(
 				"Latest chain head is at slot: %d and state root: %#x",
-				q.currentHeadSlot, q.currentStateRoot,
+				q.headSlot(), q.currentStateRoot,
 			)
 			ticker.Stop()
 			responseSub.Unsubscribe()
@@ -192,9 +198,11 @@ func (q *Querier) run() {
 				}).Info("Received chain head from peer")
 				q.chainHeadResponses[msg.Peer] = response
 			}
-			if response.CanonicalSlot > q.currentHeadSlot {
+			if response.CanonicalSlot > q.headSlot() {
 				q.bestPeer = msg.Peer
+				q.lock.Lock()
 				q.currentHeadSlot = response.CanonicalSlot
+				q.lock.Unlock()
 				q.currentStateRoot = response.CanonicalStateRootHash32
 				q.currentFinalizedStateRoot = bytesutil.ToBytes32(response.FinalizedStateRootHash32S)
 				q.canonicalBlockRoot = response.CanonicalBlockRoot
@@ -228,10 +236,10 @@ func (q *Querier) RequestLatestHead() {
 // IsSynced checks if the node is currently synced with the
 // rest of the network.
 func (q *Querier) IsSynced() (bool, error) {
-	if !q.chainStarted {
+	if !q.hasChainStarted() {
 		return true, nil
 	}
-	if q.atGenesis {
+	if q.isAtGenesis() {
 		return true, nil
 	}
 	block, err := q.db.ChainHead()
@@ -243,9 +251,30 @@ func (q *Querier) IsSynced() (bool, error) {
 		return false, nil
 	}
 
-	if block.Slot >= q.currentHeadSlot {
+	if block.Slot >= q.headSlot() {
 		return true, nil
 	}
 
 	return false, err
 }
+
+// headSlot returns the highest chain head slot received from peers.
+func (q *Querier) headSlot() uint64 {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.currentHeadSlot
+}
+
+// hasChainStarted returns true once the chain has started.
+func (q *Querier) hasChainStarted() bool {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.chainStarted
+}
+
+// isAtGenesis returns true if the chain had not started when the querier was started.
+func (q *Querier) isAtGenesis() bool {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.atGenesis
+}
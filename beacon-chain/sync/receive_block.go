@@ -34,6 +34,11 @@ func (rs *RegularSync) receiveBlockAnnounce(msg p2p.Message) error {
 		return nil
 	}
 
+	if rs.seenBlocks.seen(h) {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(h[:]))).Debug("Block recently processed")
+		return nil
+	}
+
 	// This prevents us from processing a block announcement we have already received.
 	// TODO(#2072): If the peer failed to give the block, broadcast request to the whole network.
 	rs.blockAnnouncementsLock.Lock()
@@ -102,6 +107,7 @@ func (rs *RegularSync) processBlockAndFetchAncestors(ctx context.Context, msg p2
 	if child, ok := rs.hasChild(blockRoot); ok {
 		// We clear the block root from the pending processing map.
 		rs.clearPendingBlock(blockRoot)
+		child.Ctx = withReplayed(child.Ctx)
 		return rs.processBlockAndFetchAncestors(ctx, child)
 	}
 	return nil
@@ -124,6 +130,11 @@ func (rs *RegularSync) validateAndProcessBlock(
 
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))).
 		Debug("Processing response to block request")
+	if rs.seenBlocks.seen(blockRoot) {
+		log.Debug("Received a block that was recently processed. Exiting...")
+		span.AddAttributes(trace.BoolAttribute("invalidBlock", true))
+		return nil, nil, false, nil
+	}
 	hasBlock := rs.db.HasBlock(blockRoot)
 	if hasBlock {
 		log.Debug("Received a block that already exists. Exiting...")
@@ -148,6 +159,9 @@ func (rs *RegularSync) validateAndProcessBlock(
 		span.AddAttributes(trace.BoolAttribute("invalidBlock", true))
 		return nil, nil, false, err
 	}
+	if rs.isUnsolicitedBlock(blockMsg, block.Slot, blockRoot) {
+		rs.observePropagation(blockTopic, block.Slot, blockMsg.Peer, arrivalTime(blockMsg.Ctx))
+	}
 
 	// We check if we have the block's parents saved locally.
 	parentRoot := bytesutil.ToBytes32(block.ParentRoot)
@@ -155,12 +169,22 @@ func (rs *RegularSync) validateAndProcessBlock(
 	span.AddAttributes(trace.BoolAttribute("hasParent", hasParent))
 
 	if !hasParent {
-		// If we do not have the parent, we insert it into a pending block's map.
-		rs.insertPendingBlock(ctx, parentRoot, blockMsg)
-		// We update the last observed slot to the received canonical block's slot.
-		if block.Slot > rs.highestObservedSlot {
-			rs.highestObservedSlot = block.Slot
+		// If we do not have the parent, we insert it into a pending block's map and
+		// walk backwards by requesting the missing parent by root, as long as we have
+		// not gone further back than the maximum ancestor request depth.
+		depth := rs.pendingDepth(blockRoot) + 1
+		if rs.maxAncestorRequestDepth > 0 && depth > rs.maxAncestorRequestDepth {
+			log.WithFields(logrus.Fields{
+				"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:])),
+				"depth":     depth,
+			}).Debug("Exceeded max ancestor request depth, dropping pending blocks")
+			rs.dropPendingChain(blockRoot)
+			droppedAncestorChains.Inc()
+			return nil, nil, false, nil
 		}
+		rs.insertPendingBlock(ctx, parentRoot, blockMsg, depth)
+		// We update the last observed slot to the received canonical block's slot.
+		rs.observeSlot(block.Slot)
 		return nil, nil, false, nil
 	}
 
@@ -174,6 +198,7 @@ func (rs *RegularSync) validateAndProcessBlock(
 		span.AddAttributes(trace.BoolAttribute("invalidBlock", true))
 		return nil, nil, false, err
 	}
+	rs.seenBlocks.markSeen(blockRoot)
 
 	head, err := rs.db.ChainHead()
 	if err != nil {
@@ -194,7 +219,7 @@ func (rs *RegularSync) validateAndProcessBlock(
 			"slot": block.Slot,
 			"root": fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))},
 		).Warn("Received Block from a forked chain")
-		if err := rs.db.SaveHistoricalState(ctx, beaconState, blockRoot); err != nil {
+		if err := rs.db.SaveStateByBlockRoot(ctx, blockRoot, beaconState); err != nil {
 			log.Errorf("Could not save historical state %v", err)
 			return nil, nil, false, err
 		}
@@ -208,14 +233,15 @@ func (rs *RegularSync) validateAndProcessBlock(
 	rs.p2p.Reputation(blockMsg.Peer, p2p.RepRewardValidBlock)
 	sentBlocks.Inc()
 	// We update the last observed slot to the received canonical block's slot.
-	if block.Slot > rs.highestObservedSlot {
-		rs.highestObservedSlot = block.Slot
-	}
-	span.AddAttributes(trace.Int64Attribute("highestObservedSlot", int64(rs.highestObservedSlot)))
+	rs.observeSlot(block.Slot)
+	span.AddAttributes(trace.Int64Attribute("highestObservedSlot", int64(rs.highestSlot())))
 	return block, beaconState, true, nil
 }
 
-func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byte, blockMsg p2p.Message) {
+// insertPendingBlock stores a block whose parent, blockRoot, is missing and requests the
+// parent by root from the peer which sent us the block as well as from the rest of the network.
+// The depth is the number of missing ancestors walked so far, starting at 1 for a gossiped block.
+func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byte, blockMsg p2p.Message, depth uint64) {
 	rs.blocksAwaitingProcessingLock.Lock()
 	defer rs.blocksAwaitingProcessingLock.Unlock()
 	// Do not reinsert into the map if block root was previously added.
@@ -223,20 +249,76 @@ func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byt
 		return
 	}
 	rs.blocksAwaitingProcessing[blockRoot] = blockMsg
+	rs.blocksAwaitingDepth[blockRoot] = depth
 	blocksAwaitingProcessingGauge.Inc()
-	rs.p2p.Broadcast(ctx, &pb.BeaconBlockRequest{Hash: blockRoot[:]})
+	ancestorRequests.Inc()
+	req := &pb.BeaconBlockRequest{Hash: blockRoot[:]}
+	if blockMsg.Peer != "" {
+		if err := rs.p2p.Send(ctx, req, blockMsg.Peer); err != nil {
+			log.WithError(err).Debug("Could not request missing parent from peer")
+		}
+	}
+	rs.p2p.Broadcast(ctx, req)
 }
 
 func (rs *RegularSync) clearPendingBlock(blockRoot [32]byte) {
 	rs.blocksAwaitingProcessingLock.Lock()
 	defer rs.blocksAwaitingProcessingLock.Unlock()
 	delete(rs.blocksAwaitingProcessing, blockRoot)
+	delete(rs.blocksAwaitingDepth, blockRoot)
 	blocksAwaitingProcessingGauge.Dec()
 }
 
+// pendingDepth returns how many missing ancestors have been walked to reach the block
+// with the given root, or 0 if no pending block is waiting on it.
+func (rs *RegularSync) pendingDepth(blockRoot [32]byte) uint64 {
+	rs.blocksAwaitingProcessingLock.RLock()
+	defer rs.blocksAwaitingProcessingLock.RUnlock()
+	return rs.blocksAwaitingDepth[blockRoot]
+}
+
+// dropPendingChain removes every pending descendant of the block with the given root. It is
+// used once a chain of missing ancestors becomes too long to recover via by-root requests, at
+// which point initial sync is a better fit for catching up.
+func (rs *RegularSync) dropPendingChain(blockRoot [32]byte) {
+	for {
+		child, ok := rs.hasChild(blockRoot)
+		if !ok {
+			return
+		}
+		rs.clearPendingBlock(blockRoot)
+		resp, ok := child.Data.(*pb.BeaconBlockResponse)
+		if !ok {
+			return
+		}
+		childRoot, err := ssz.SigningRoot(resp.Block)
+		if err != nil {
+			return
+		}
+		blockRoot = childRoot
+	}
+}
+
 func (rs *RegularSync) hasChild(blockRoot [32]byte) (p2p.Message, bool) {
 	rs.blocksAwaitingProcessingLock.Lock()
 	defer rs.blocksAwaitingProcessingLock.Unlock()
 	child, ok := rs.blocksAwaitingProcessing[blockRoot]
 	return child, ok
 }
+
+// observeSlot records the slot of a block received from a peer, if it is the highest slot
+// observed so far.
+func (rs *RegularSync) observeSlot(slot uint64) {
+	rs.highestObservedSlotLock.Lock()
+	defer rs.highestObservedSlotLock.Unlock()
+	if slot > rs.highestObservedSlot {
+		rs.highestObservedSlot = slot
+	}
+}
+
+// highestSlot returns the highest slot of the blocks received from peers.
+func (rs *RegularSync) highestSlot() uint64 {
+	rs.highestObservedSlotLock.RLock()
+	defer rs.highestObservedSlotLock.RUnlock()
+	return rs.highestObservedSlot
+}
@@ -159,3 +159,77 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 		t.Errorf("Expected blocks awaiting processing map to be empty, received len = %d", len(rs.blocksAwaitingProcessing))
 	}
 }
+
+func TestReceiveBlock_WalksBackMissingAncestors(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	rsCfg := DefaultRegularSyncConfig()
+	rsCfg.ChainService = &mockChainService{
+		db: db,
+	}
+	rsCfg.BeaconDB = db
+	rsCfg.P2P = &mockP2P{}
+	rsCfg.MaxAncestorRequestDepth = 2
+	rs := NewRegularSyncService(context.Background(), rsCfg)
+	genesisBlock := &ethpb.BeaconBlock{
+		Slot: 0,
+	}
+	genesisState := &pb.BeaconState{
+		Slot:                0,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 0},
+	}
+	if err := db.SaveBlock(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, genesisState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesisBlock, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	// Build a chain of blocks whose oldest ancestor's parent is unknown to the node.
+	unknownRoot := bytesutil.ToBytes32([]byte("unknown-parent"))
+	chain := make([]*ethpb.BeaconBlock, 3)
+	parentRoot := unknownRoot
+	for i := range chain {
+		chain[i] = &ethpb.BeaconBlock{Slot: uint64(i + 1), ParentRoot: parentRoot[:]}
+		root, err := ssz.SigningRoot(chain[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentRoot = root
+	}
+
+	// Receive the chain head first and then walk backwards, the way the node
+	// receives responses to its by-root requests for missing ancestors.
+	for i := len(chain) - 1; i >= 1; i-- {
+		msg := p2p.Message{
+			Data: &pb.BeaconBlockResponse{Block: chain[i]},
+			Ctx:  context.Background(),
+		}
+		if err := rs.receiveBlock(msg); err != nil {
+			t.Fatalf("Could not receive block: %v", err)
+		}
+	}
+	if len(rs.blocksAwaitingProcessing) != 2 {
+		t.Fatalf("Expected 2 blocks awaiting processing, received %d", len(rs.blocksAwaitingProcessing))
+	}
+
+	// The oldest ancestor exceeds the max depth, so the whole pending chain is dropped.
+	msg := p2p.Message{
+		Data: &pb.BeaconBlockResponse{Block: chain[0]},
+		Ctx:  context.Background(),
+	}
+	if err := rs.receiveBlock(msg); err != nil {
+		t.Fatalf("Could not receive block: %v", err)
+	}
+	if len(rs.blocksAwaitingProcessing) != 0 {
+		t.Errorf("Expected pending chain to be dropped, received len = %d", len(rs.blocksAwaitingProcessing))
+	}
+	if len(rs.blocksAwaitingDepth) != 0 {
+		t.Errorf("Expected pending depths to be dropped, received len = %d", len(rs.blocksAwaitingDepth))
+	}
+}
@@ -12,9 +12,9 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
@@ -24,15 +24,17 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
 var (
 	log                           = logrus.WithField("prefix", "regular-sync")
-	blocksAwaitingProcessingGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	blocksAwaitingProcessingGauge = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "regsync_blocks_awaiting_processing",
 		Help: "Number of blocks which do not have a parent and are awaiting processing by the chain service",
 	})
@@ -52,6 +54,7 @@ type attsService interface {
 type p2pAPI interface {
 	p2p.Broadcaster
 	p2p.Sender
+	p2p.ChunkedSender
 	p2p.Subscriber
 	p2p.ReputationManager
 }
@@ -89,11 +92,23 @@ type RegularSync struct {
 	exitBuf                      chan p2p.Message
 	canonicalBuf                 chan *pb.BeaconBlockAnnounce
 	highestObservedSlot          uint64
+	highestObservedSlotLock      sync.RWMutex
 	blocksAwaitingProcessing     map[[32]byte]p2p.Message
+	blocksAwaitingDepth          map[[32]byte]uint64
 	blocksAwaitingProcessingLock sync.RWMutex
+	maxAncestorRequestDepth      uint64
 	blockProcessingLock          sync.RWMutex
 	blockAnnouncements           map[uint64][]byte
 	blockAnnouncementsLock       sync.RWMutex
+	seenBlocks                   *seenCache
+	seenAttestations             *seenCache
+	checkpointStates             *cache.CheckpointStateCache
+	announcedBlocks              *seenCache
+	blockPipeline                *blockPipeline
+	genesisTime                  uint64
+	genesisTimeLock              sync.Mutex
+	forkTopics                   p2p.ForkTopicUpdater
+	supervisor                   *supervisor.Supervisor
 }
 
 // RegularSyncConfig allows the channel's buffer sizes to be changed.
@@ -109,11 +124,16 @@ type RegularSyncConfig struct {
 	ExitBufferSize              int
 	ChainHeadReqBufferSize      int
 	CanonicalBufferSize         int
+	SeenCacheSize               int
+	MaxAncestorRequestDepth     uint64
+	BlockQueueSize              int
+	ForkTopics                  p2p.ForkTopicUpdater
 	ChainService                chainService
 	OperationService            operations.OperationFeeds
 	AttsService                 attsService
 	BeaconDB                    *db.BeaconDB
 	P2P                         p2pAPI
+	RestartPolicy               supervisor.Policy
 }
 
 // DefaultRegularSyncConfig provides the default configuration for a sync service.
@@ -130,13 +150,16 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationsAnnounceBufSize: params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:              params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:         params.BeaconConfig().DefaultBufferSize,
+		SeenCacheSize:               defaultSeenCacheSize,
+		MaxAncestorRequestDepth:     2 * params.BeaconConfig().SlotsPerEpoch,
+		BlockQueueSize:              defaultBlockQueueSize,
 	}
 }
 
 // NewRegularSyncService accepts a context and returns a new Service.
 func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *RegularSync {
 	ctx, cancel := context.WithCancel(ctx)
-	return &RegularSync{
+	rs := &RegularSync{
 		ctx:                      ctx,
 		cancel:                   cancel,
 		p2p:                      cfg.P2P,
@@ -157,18 +180,42 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
 		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
 		blocksAwaitingProcessing: make(map[[32]byte]p2p.Message),
+		blocksAwaitingDepth:      make(map[[32]byte]uint64),
+		maxAncestorRequestDepth:  cfg.MaxAncestorRequestDepth,
 		blockAnnouncements:       make(map[uint64][]byte),
-	}
+		seenBlocks:               newSeenCache("block", cfg.SeenCacheSize),
+		seenAttestations:         newSeenCache("attestation", cfg.SeenCacheSize),
+		checkpointStates:         cache.NewCheckpointStateCache(),
+		announcedBlocks:          newSeenCache("block_announce", cfg.SeenCacheSize),
+		forkTopics:               cfg.ForkTopics,
+		supervisor:               supervisor.New(ctx, "regular-sync", cfg.RestartPolicy),
+	}
+	rs.blockPipeline = newBlockPipeline(cfg.BlockQueueSize, rs.isCurrentSlotBlock, rs.receiveBlock)
+	return rs
 }
 
 // Start begins the block processing goroutine.
 func (rs *RegularSync) Start() {
-	go rs.run()
+	rs.startRoutines()
 }
 
 // ResumeSync resumes normal sync after initial sync is complete.
 func (rs *RegularSync) ResumeSync() {
-	go rs.run()
+	rs.startRoutines()
+}
+
+// startRoutines starts the goroutines of regular sync, which are restarted separately
+// if they panic.
+func (rs *RegularSync) startRoutines() {
+	rs.supervisor.Go("run", rs.run)
+	rs.supervisor.Go("blockPipeline", func() {
+		rs.blockPipeline.run(rs.ctx)
+	})
+	if rs.forkTopics != nil {
+		rs.supervisor.Go("updateForkTopics", func() {
+			rs.updateForkTopics(rs.ctx)
+		})
+	}
 }
 
 // Stop kills the block processing goroutine, but does not wait until the goroutine exits.
@@ -228,7 +275,8 @@ func (rs *RegularSync) run() {
 		case msg := <-rs.exitBuf:
 			go safelyHandleMessage(rs.receiveExitRequest, msg)
 		case msg := <-rs.blockBuf:
-			go safelyHandleMessage(rs.receiveBlock, msg)
+			msg.Ctx = withArrivalTime(msg.Ctx, time.Now())
+			rs.blockPipeline.enqueue(msg)
 		case msg := <-rs.blockRequestByHash:
 			go safelyHandleMessage(rs.handleBlockRequestByHash, msg)
 		case msg := <-rs.batchedRequestBuf:
@@ -325,11 +373,14 @@ func (rs *RegularSync) handleStateRequest(msg p2p.Message) error {
 		"beaconState", fmt.Sprintf("%#x", root),
 	).Debug("Sending finalized state and block to peer")
 	defer sentState.Inc()
-	resp := &pb.BeaconStateResponse{
-		FinalizedState: fState,
-		FinalizedBlock: finalizedBlk,
-	}
-	if err := rs.p2p.Send(ctx, resp, msg.Peer); err != nil {
+	// The state is the largest response sent to peers, so it is framed as a chunk of its
+	// own which the peer checks against its max chunk size before reading it, followed by
+	// the block.
+	chunks := []proto.Message{
+		&pb.BeaconStateResponse{FinalizedState: fState},
+		&pb.BeaconStateResponse{FinalizedBlock: finalizedBlk},
+	}
+	if err := rs.p2p.SendChunked(ctx, chunks, msg.Peer); err != nil {
 		log.Error(err)
 		return err
 	}
@@ -400,6 +451,7 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAttestation")
 	defer span.End()
 	recAttestation.Inc()
+	arrival := arrivalTime(msg.Ctx)
 
 	resp := msg.Data.(*pb.AttestationResponse)
 	attestation := resp.Attestation
@@ -413,6 +465,14 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 		"justifiedEpoch": attestation.Data.Source.Epoch,
 	}).Debug("Received an attestation")
 
+	// Skip if attestation was recently processed, which prevents it from looping
+	// between peers when it is gossiped back to us.
+	if rs.seenAttestations.seen(attestationRoot) {
+		log.WithField("attestationRoot", fmt.Sprintf("%#x", bytesutil.Trunc(attestationRoot[:]))).
+			Debug("Attestation recently processed, skipping")
+		return nil
+	}
+
 	// Skip if attestation has been seen before.
 	hasAttestation := rs.db.HasAttestation(attestationRoot)
 	span.AddAttributes(trace.BoolAttribute("hasAttestation", hasAttestation))
@@ -422,30 +482,40 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 		return nil
 	}
 
-	// Skip if attestation slot is older than last finalized slot in state.
+	// Skip if attestation slot is older than one epoch before the chain head.
 	head, err := rs.db.ChainHead()
 	if err != nil {
 		return err
 	}
 	highestSlot := head.Slot
+	oneEpochAgo := uint64(0)
+	if highestSlot > params.BeaconConfig().SlotsPerEpoch {
+		oneEpochAgo = highestSlot - params.BeaconConfig().SlotsPerEpoch
+	}
+	// The attestation slot is in its target epoch, so attestations whose target epoch
+	// ends before then are skipped without retrieving the target state.
+	if helpers.StartSlot(attestation.Data.Target.Epoch+1) <= oneEpochAgo {
+		log.WithFields(logrus.Fields{
+			"targetEpoch": attestation.Data.Target.Epoch,
+			"epochSlot":   oneEpochAgo},
+		).Debug("Skipping received attestation with slot smaller than one epoch ago")
+		return nil
+	}
 
-	headState, err := rs.db.HeadState(rs.ctx)
+	targetState, err := rs.checkpointState(ctx, attestation.Data.Target)
 	if err != nil {
-		return err
+		log.WithError(err).Debug("Could not retrieve attestation target state, skipping attestation")
+		return nil
 	}
-	slot, err := helpers.AttestationDataSlot(headState, attestation.Data)
+	slot, err := helpers.AttestationDataSlot(targetState, attestation.Data)
 	if err != nil {
 		return fmt.Errorf("could not get attestation slot: %v", err)
 	}
 
 	span.AddAttributes(
 		trace.Int64Attribute("attestation.Data.Slot", int64(slot)),
-		trace.Int64Attribute("finalized state slot", int64(highestSlot-params.BeaconConfig().SlotsPerEpoch)),
+		trace.Int64Attribute("finalized state slot", int64(oneEpochAgo)),
 	)
-	oneEpochAgo := uint64(0)
-	if highestSlot > params.BeaconConfig().SlotsPerEpoch {
-		oneEpochAgo = highestSlot - params.BeaconConfig().SlotsPerEpoch
-	}
 	if slot < oneEpochAgo {
 		log.WithFields(logrus.Fields{
 			"receivedSlot": slot,
@@ -453,11 +523,19 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 		).Debug("Skipping received attestation with slot smaller than one epoch ago")
 		return nil
 	}
+	if err := rs.validateAttestation(ctx, attestation, targetState); err != nil {
+		log.WithError(err).Debug("Received invalid attestation")
+		invalidAttestation.Inc()
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidAttestation)
+		return nil
+	}
+	rs.observePropagation(attestationTopic, slot, msg.Peer, arrival)
 
 	_, sendAttestationSpan := trace.StartSpan(ctx, "beacon-chain.sync.sendAttestation")
 	log.Debug("Sending newly received attestation to subscribers")
 	rs.operationsService.IncomingAttFeed().Send(attestation)
 	rs.attsService.IncomingAttestationFeed().Send(attestation)
+	rs.seenAttestations.markSeen(attestationRoot)
 	rs.p2p.Reputation(msg.Peer, p2p.RepRewardValidAttestation)
 	sentAttestation.Inc()
 	sendAttestationSpan.End()
@@ -536,9 +614,23 @@ func (rs *RegularSync) handleBatchedBlockRequest(msg p2p.Message) error {
 	log.WithField("peer", msg.Peer).Debug("Sending response for batch blocks")
 
 	defer sentBatchedBlocks.Inc()
-	if err := rs.p2p.Send(ctx, &pb.BatchedBeaconBlockResponse{
-		BatchedBlocks: response,
-	}, msg.Peer); err != nil {
+	// Each block is sent as a chunk, up to the max number of chunks of a response. The
+	// peer requests the following blocks once it has processed these.
+	if max := rs.p2p.MaxRequestCount(); len(response) > max {
+		log.WithFields(logrus.Fields{
+			"blocks": len(response),
+			"max":    max,
+		}).Debug("Truncating batched blocks response")
+		response = response[:max]
+	}
+	chunks := []proto.Message{&pb.BatchedBeaconBlockResponse{}}
+	if len(response) > 0 {
+		chunks = make([]proto.Message, len(response))
+		for i, block := range response {
+			chunks[i] = &pb.BatchedBeaconBlockResponse{BatchedBlocks: []*ethpb.BeaconBlock{block}}
+		}
+	}
+	if err := rs.p2p.SendChunked(ctx, chunks, msg.Peer); err != nil {
 		log.Error(err)
 		return err
 	}
@@ -612,6 +704,11 @@ func (rs *RegularSync) handleAttestationAnnouncement(msg p2p.Message) error {
 func (rs *RegularSync) broadcastCanonicalBlock(ctx context.Context, announce *pb.BeaconBlockAnnounce) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.broadcastCanonicalBlock")
 	defer span.End()
+	root := bytesutil.ToBytes32(announce.Hash)
+	if rs.announcedBlocks.seen(root) {
+		return
+	}
+	rs.announcedBlocks.markSeen(root)
 	log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(announce.Hash))).
 		Debug("Announcing canonical block")
 	rs.p2p.Broadcast(ctx, announce)
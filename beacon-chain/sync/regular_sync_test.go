@@ -34,7 +34,9 @@ func init() {
 }
 
 type mockP2P struct {
-	sentMsg proto.Message
+	sentMsg         proto.Message
+	sentChunks      []proto.Message
+	maxRequestCount int
 }
 
 func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription {
@@ -49,26 +51,39 @@ func (mp *mockP2P) Send(ctx context.Context, msg proto.Message, peerID peer.ID)
 	return nil
 }
 
+func (mp *mockP2P) SendChunked(ctx context.Context, msgs []proto.Message, peerID peer.ID) error {
+	mp.sentMsg = msgs[len(msgs)-1]
+	mp.sentChunks = msgs
+	return nil
+}
+
+func (mp *mockP2P) MaxRequestCount() int {
+	if mp.maxRequestCount == 0 {
+		return p2p.DefaultMaxRequestCount
+	}
+	return mp.maxRequestCount
+}
+
 func (mp *mockP2P) Reputation(_ peer.ID, val int) {
 
 }
 
 type mockChainService struct {
-	sFeed *event.Feed
-	cFeed *event.Feed
+	sFeed *event.Topic
+	cFeed *event.Topic
 	db    *db.BeaconDB
 }
 
-func (ms *mockChainService) StateInitializedFeed() *event.Feed {
+func (ms *mockChainService) StateInitializedFeed() *event.Topic {
 	if ms.sFeed == nil {
-		return new(event.Feed)
+		return new(event.Topic)
 	}
 	return ms.sFeed
 }
 
-func (ms *mockChainService) CanonicalBlockFeed() *event.Feed {
+func (ms *mockChainService) CanonicalBlockFeed() *event.Topic {
 	if ms.cFeed == nil {
-		return new(event.Feed)
+		return new(event.Topic)
 	}
 	return ms.cFeed
 }
@@ -98,6 +113,10 @@ func (ms *mockChainService) CleanupBlockOperations(ctx context.Context, block *e
 	return nil
 }
 
+func (ms *mockChainService) ArchiveHead(ctx context.Context, head *ethpb.BeaconBlock, headState *pb.BeaconState) error {
+	return nil
+}
+
 func (ms *mockChainService) IsCanonical(slot uint64, hash []byte) bool {
 	return true
 }
@@ -107,16 +126,16 @@ func (ms *mockChainService) UpdateCanonicalRoots(block *ethpb.BeaconBlock, root
 
 type mockOperationService struct{}
 
-func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
+func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Topic {
 	return nil
 }
 
-func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockOperationService) IncomingAttFeed() *event.Topic {
+	return new(event.Topic)
 }
 
-func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
-	return new(event.Feed)
+func (ms *mockOperationService) IncomingExitFeed() *event.Topic {
+	return new(event.Topic)
 }
 
 type mockAttestationService struct{}
@@ -362,25 +381,9 @@ func TestReceiveAttestation_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ms := &mockChainService{}
 	os := &mockOperationService{}
-	ctx := context.Background()
 
-	db := internal.SetupDB(t)
+	db, attestation := setupAttestationTest(t)
 	defer internal.TeardownDB(t, db)
-	beaconState := &pb.BeaconState{
-		Slot: 2,
-	}
-	if err := db.SaveState(ctx, beaconState); err != nil {
-		t.Fatalf("Could not save state: %v", err)
-	}
-	beaconBlock := &ethpb.BeaconBlock{
-		Slot: beaconState.Slot,
-	}
-	if err := db.SaveBlock(beaconBlock); err != nil {
-		t.Fatal(err)
-	}
-	if err := db.UpdateChainHead(ctx, beaconBlock, beaconState); err != nil {
-		t.Fatal(err)
-	}
 	cfg := &RegularSyncConfig{
 		ChainService:     ms,
 		AttsService:      &mockAttestationService{},
@@ -391,15 +394,7 @@ func TestReceiveAttestation_OK(t *testing.T) {
 	ss := NewRegularSyncService(context.Background(), cfg)
 
 	request1 := &pb.AttestationResponse{
-		Attestation: &ethpb.Attestation{
-			Data: &ethpb.AttestationData{
-				Crosslink: &ethpb.Crosslink{
-					Shard: 1,
-				},
-				Source: &ethpb.Checkpoint{},
-				Target: &ethpb.Checkpoint{},
-			},
-		},
+		Attestation: attestation,
 	}
 
 	msg1 := p2p.Message{
@@ -727,6 +722,64 @@ func TestHandleStateReq_OK(t *testing.T) {
 		t.Error(err)
 	}
 	testutil.AssertLogsContain(t, hook, "Sending finalized state and block to peer")
+	if _, ok := ss.p2p.(*mockP2P).sentMsg.(*pb.BeaconStateResponse); !ok {
+		t.Errorf("Expected a chunked state response, received %v", ss.p2p.(*mockP2P).sentMsg)
+	}
+	if len(ss.p2p.(*mockP2P).sentChunks) != 2 {
+		t.Errorf("Expected the state and block in separate chunks, received %d chunks", len(ss.p2p.(*mockP2P).sentChunks))
+	}
+}
+
+func TestHandleBatchedBlockRequest_OneChunkPerBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := setupService(db)
+	mp := &mockP2P{maxRequestCount: 2}
+	ss.p2p = mp
+
+	// Construct the chain B1 - B2 - B3 - B4.
+	var blocks []*ethpb.BeaconBlock
+	parentRoot := []byte{'A'}
+	var roots [][32]byte
+	for i := uint64(1); i <= 4; i++ {
+		block := &ethpb.BeaconBlock{Slot: i, ParentRoot: parentRoot}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatalf("Could not hash block: %v", err)
+		}
+		if err := ss.db.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		blocks = append(blocks, block)
+		roots = append(roots, root)
+		parentRoot = root[:]
+	}
+
+	msg := p2p.Message{
+		Ctx: context.Background(),
+		Data: &pb.BatchedBeaconBlockRequest{
+			FinalizedRoot: roots[0][:],
+			CanonicalRoot: roots[3][:],
+		},
+	}
+	if err := ss.handleBatchedBlockRequest(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	// B2, B3 and B4 are requested but the response is capped at 2 chunks.
+	if len(mp.sentChunks) != 2 {
+		t.Fatalf("Expected 2 chunks, received %d", len(mp.sentChunks))
+	}
+	for i, chunk := range mp.sentChunks {
+		res, ok := chunk.(*pb.BatchedBeaconBlockResponse)
+		if !ok {
+			t.Fatalf("Expected a batched blocks response, received %v", chunk)
+		}
+		want := []*ethpb.BeaconBlock{blocks[i+1]}
+		if !reflect.DeepEqual(res.BatchedBlocks, want) {
+			t.Errorf("Chunk %d: wanted %v, received %v", i, want, res.BatchedBlocks)
+		}
+	}
 }
 
 func TestCanonicalBlockList_CanRetrieveCanonical(t *testing.T) {
@@ -0,0 +1,61 @@
+package sync
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+)
+
+// defaultSeenCacheSize is the number of recently processed message roots kept
+// in memory when no explicit size is configured.
+const defaultSeenCacheSize = 1024
+
+var (
+	seenCacheHit = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_seen_cache_hit",
+		Help: "The number of received messages skipped because their root was recently processed",
+	}, []string{"type"})
+	seenCacheMiss = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "regsync_seen_cache_miss",
+		Help: "The number of received messages whose root was not recently processed",
+	}, []string{"type"})
+)
+
+// seenCache is a bounded, least-recently-used set of message roots the node has
+// already processed. It is consulted before a block or attestation is passed on to
+// the rest of the beacon node, or re-broadcast to peers, so that the same
+// message gossiped back to us by several peers does not cause a p2p loop.
+type seenCache struct {
+	name  string
+	cache *lru.Cache
+}
+
+// newSeenCache creates a seen cache holding at most size roots. A non-positive size
+// falls back to defaultSeenCacheSize.
+func newSeenCache(name string, size int) *seenCache {
+	if size <= 0 {
+		size = defaultSeenCacheSize
+	}
+	// #nosec G104 lru.New only errors on a non-positive size, which is guarded above.
+	c, _ := lru.New(size)
+	return &seenCache{
+		name:  name,
+		cache: c,
+	}
+}
+
+// seen returns true if the root was recently marked as processed.
+func (s *seenCache) seen(root [32]byte) bool {
+	if s.cache.Contains(root) {
+		seenCacheHit.WithLabelValues(s.name).Inc()
+		return true
+	}
+	seenCacheMiss.WithLabelValues(s.name).Inc()
+	return false
+}
+
+// markSeen records the root as processed, evicting the least recently used root
+// if the cache is full.
+func (s *seenCache) markSeen(root [32]byte) {
+	s.cache.Add(root, true)
+}
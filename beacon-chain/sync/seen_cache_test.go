@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestSeenCache_MarkSeen(t *testing.T) {
+	c := newSeenCache("test", 2)
+	root := hashutil.Hash([]byte("a"))
+	if c.seen(root) {
+		t.Fatal("Expected root to not be seen before it is marked")
+	}
+	c.markSeen(root)
+	if !c.seen(root) {
+		t.Error("Expected root to be seen after it is marked")
+	}
+}
+
+func TestSeenCache_EvictsOldestRoot(t *testing.T) {
+	c := newSeenCache("test", 2)
+	a := hashutil.Hash([]byte("a"))
+	b := hashutil.Hash([]byte("b"))
+	d := hashutil.Hash([]byte("d"))
+	c.markSeen(a)
+	c.markSeen(b)
+	c.markSeen(d)
+	if c.seen(a) {
+		t.Error("Expected oldest root to be evicted")
+	}
+	if !c.seen(b) || !c.seen(d) {
+		t.Error("Expected most recent roots to remain in the cache")
+	}
+}
+
+func TestSeenCache_DefaultSize(t *testing.T) {
+	c := newSeenCache("test", 0)
+	for i := 0; i < defaultSeenCacheSize+1; i++ {
+		c.markSeen(hashutil.Hash([]byte{byte(i), byte(i >> 8)}))
+	}
+	if c.cache.Len() != defaultSeenCacheSize {
+		t.Errorf("Expected cache length %d, received %d", defaultSeenCacheSize, c.cache.Len())
+	}
+}
+
+func TestReceiveBlockAnnounce_SkipsRecentlyProcessedBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	rs := setupService(db)
+	root := hashutil.Hash([]byte("processed-block"))
+	rs.seenBlocks.markSeen(root)
+
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.BeaconBlockAnnounce{Hash: root[:]},
+	}
+	if err := rs.receiveBlockAnnounce(msg); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Block recently processed")
+	testutil.AssertLogsDoNotContain(t, hook, "requesting full block data from sender")
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/supervisor"
 	"github.com/sirupsen/logrus"
 )
 
@@ -21,10 +24,13 @@ type Checker interface {
 
 // Service defines the main routines used in the sync service.
 type Service struct {
-	RegularSync     *RegularSync
-	InitialSync     *initialsync.InitialSync
-	Querier         *Querier
-	querierFinished bool
+	RegularSync        *RegularSync
+	InitialSync        *initialsync.InitialSync
+	Querier            *Querier
+	stateLock          sync.RWMutex // Guards querierFinished and initialSyncStarted.
+	querierFinished    bool
+	initialSyncStarted bool
+	progress           syncProgress
 }
 
 // Config defines the configured services required for sync to work.
@@ -35,6 +41,8 @@ type Config struct {
 	AttsService      attsService
 	OperationService operations.OperationFeeds
 	PowChainService  powChainService
+	ForkTopics       p2p.ForkTopicUpdater
+	RestartPolicy    supervisor.Policy
 }
 
 // NewSyncService creates a new instance of SyncService using the config
@@ -58,6 +66,8 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.P2P = cfg.P2P
 	rsCfg.AttsService = cfg.AttsService
 	rsCfg.OperationService = cfg.OperationService
+	rsCfg.ForkTopics = cfg.ForkTopics
+	rsCfg.RestartPolicy = cfg.RestartPolicy
 
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
@@ -98,15 +108,18 @@ func (ss *Service) Stop() error {
 // Status checks the status of the node. It returns nil if it's synced
 // with the rest of the network and no errors occurred. Otherwise, it returns an error.
 func (ss *Service) Status() error {
-	if !ss.querierFinished && !ss.Querier.atGenesis {
+	if err := ss.RegularSync.supervisor.Status(); err != nil {
+		return err
+	}
+	if !ss.hasQuerierFinished() && !ss.Querier.isAtGenesis() {
 		return errors.New("querier is still running")
 	}
 
-	if !ss.Querier.chainStarted {
+	if !ss.Querier.hasChainStarted() {
 		return nil
 	}
 
-	if ss.Querier.atGenesis {
+	if ss.Querier.isAtGenesis() {
 		return nil
 	}
 
@@ -141,7 +154,10 @@ func (ss *Service) run() {
 	if err != nil {
 		slog.Fatalf("Unable to retrieve result from sync querier %v", err)
 	}
+	ss.stateLock.Lock()
 	ss.querierFinished = true
+	ss.initialSyncStarted = !synced
+	ss.stateLock.Unlock()
 
 	if synced {
 		ss.RegularSync.Start()
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 )
 
 var _ = Checker(&Service{})
+var _ = StateReporter(&Service{})
 
 func NotSyncQuerierConfig() *QuerierConfig {
 	return &QuerierConfig{
@@ -74,3 +76,60 @@ func TestStatus_NotSynced(t *testing.T) {
 		t.Error("Wanted false, but got true")
 	}
 }
+
+func TestSyncProgress_EstimatesCompletion(t *testing.T) {
+	p := &syncProgress{}
+	now := time.Now()
+	if eta := p.observe(now, 10, 110); eta != 0 {
+		t.Errorf("Expected no estimate before progress is observed, received %v", eta)
+	}
+	// 10 slots processed in 10 seconds leaves 90 slots, or 90 seconds.
+	if eta := p.observe(now.Add(10*time.Second), 20, 110); eta != 90*time.Second {
+		t.Errorf("Expected estimate of %v, received %v", 90*time.Second, eta)
+	}
+	if eta := p.observe(now.Add(20*time.Second), 110, 110); eta != 0 {
+		t.Errorf("Expected no estimate once synced, received %v", eta)
+	}
+}
+
+func TestSyncState_ConcurrentWithSync(t *testing.T) {
+	ss, db := setupTestSyncService(t, true)
+	defer internal.TeardownDB(t, db)
+
+	// The sync goroutines update the progress of the node while the RPC server reports the
+	// sync state, which the race detector checks.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for slot := uint64(0); slot < 100; slot++ {
+			ss.RegularSync.observeSlot(slot)
+			ss.Querier.lock.Lock()
+			ss.Querier.currentHeadSlot = slot
+			ss.Querier.atGenesis = false
+			ss.Querier.lock.Unlock()
+			ss.stateLock.Lock()
+			ss.querierFinished = slot%2 == 0
+			ss.initialSyncStarted = slot%3 == 0
+			ss.stateLock.Unlock()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			ss.SyncState()
+			ss.EstimatedSyncCompletion()
+		}
+	}()
+	wg.Wait()
+
+	if ss.RegularSync.highestSlot() != 99 {
+		t.Errorf("Expected highest observed slot 99, received %d", ss.RegularSync.highestSlot())
+	}
+}
+
+func TestState_String(t *testing.T) {
+	if CatchingUp.String() != "catching up" {
+		t.Errorf("Unexpected string for state: %s", CatchingUp)
+	}
+}
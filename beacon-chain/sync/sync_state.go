@@ -0,0 +1,143 @@
+package sync
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// State describes where the node is in the process of synchronizing its
+// chain with the rest of the network.
+type State int
+
+const (
+	// InitialSyncing means the node is still querying peers or running initial sync
+	// from the finalized state up to the network's chain head.
+	InitialSyncing State = iota
+	// CatchingUp means initial sync is complete, but regular sync has observed blocks
+	// from peers which are far ahead of the node's local chain head.
+	CatchingUp
+	// Synced means the node's chain head is up to date with the network.
+	Synced
+)
+
+// catchingUpSlotThreshold is the number of slots the local chain head may lag behind
+// the highest observed slot before the node is no longer considered synced.
+var catchingUpSlotThreshold = params.BeaconConfig().SlotsPerEpoch
+
+func (s State) String() string {
+	switch s {
+	case InitialSyncing:
+		return "initial sync"
+	case CatchingUp:
+		return "catching up"
+	case Synced:
+		return "synced"
+	default:
+		return "unknown"
+	}
+}
+
+// StateReporter defines a struct which can report the node-wide sync state and
+// an estimate of how long it will take for the node to be synced.
+type StateReporter interface {
+	SyncState() State
+	EstimatedSyncCompletion() time.Duration
+}
+
+// syncProgress tracks the local chain head slot from the moment the node was last
+// observed to be behind the network, to estimate the rate at which it is catching up.
+type syncProgress struct {
+	lock      sync.Mutex
+	startTime time.Time
+	startSlot uint64
+	tracking  bool
+}
+
+// observe records the local head slot when the node is behind and returns the
+// estimated time until the target slot is reached. A zero duration is returned
+// when no progress has been observed yet.
+func (p *syncProgress) observe(now time.Time, headSlot uint64, targetSlot uint64) time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if headSlot >= targetSlot {
+		p.tracking = false
+		return 0
+	}
+	if !p.tracking || headSlot < p.startSlot {
+		p.tracking = true
+		p.startTime = now
+		p.startSlot = headSlot
+		return 0
+	}
+	elapsed := now.Sub(p.startTime)
+	processed := headSlot - p.startSlot
+	if processed == 0 || elapsed <= 0 {
+		return 0
+	}
+	remaining := targetSlot - headSlot
+	return time.Duration(float64(elapsed) * float64(remaining) / float64(processed))
+}
+
+// SyncState returns the node-wide sync state, combining the results of the
+// querier, initial sync, and the highest slot observed by regular sync.
+func (ss *Service) SyncState() State {
+	if !ss.hasQuerierFinished() && !ss.Querier.isAtGenesis() {
+		return InitialSyncing
+	}
+	if ss.hasInitialSyncStarted() && !ss.InitialSync.NodeIsSynced() {
+		return InitialSyncing
+	}
+	headSlot, targetSlot, err := ss.headAndTargetSlots()
+	if err != nil {
+		return InitialSyncing
+	}
+	if targetSlot > headSlot+catchingUpSlotThreshold {
+		return CatchingUp
+	}
+	return Synced
+}
+
+// EstimatedSyncCompletion returns an estimate of the time remaining until the
+// node is synced, based on the rate at which the local chain head has advanced.
+// A zero duration means the node is synced or no estimate is available yet.
+func (ss *Service) EstimatedSyncCompletion() time.Duration {
+	headSlot, targetSlot, err := ss.headAndTargetSlots()
+	if err != nil {
+		return 0
+	}
+	return ss.progress.observe(time.Now(), headSlot, targetSlot)
+}
+
+// headAndTargetSlots returns the slot of the local chain head and the highest
+// slot known to exist in the network.
+func (ss *Service) headAndTargetSlots() (uint64, uint64, error) {
+	head, err := ss.Querier.db.ChainHead()
+	if err != nil {
+		return 0, 0, err
+	}
+	if head == nil {
+		return 0, 0, nil
+	}
+	targetSlot := ss.Querier.headSlot()
+	if observed := ss.RegularSync.highestSlot(); observed > targetSlot {
+		targetSlot = observed
+	}
+	return head.Slot, targetSlot, nil
+}
+
+// hasQuerierFinished returns true once the querier has found the chain head of the network.
+func (ss *Service) hasQuerierFinished() bool {
+	ss.stateLock.RLock()
+	defer ss.stateLock.RUnlock()
+	return ss.querierFinished
+}
+
+// hasInitialSyncStarted returns true if the node was behind the network once the querier
+// finished, and started initial sync.
+func (ss *Service) hasInitialSyncStarted() bool {
+	ss.stateLock.RLock()
+	defer ss.stateLock.RUnlock()
+	return ss.initialSyncStarted
+}
@@ -0,0 +1,125 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// validateAttestation checks that the aggregation bits of the attestation designate
+// members of its crosslink committee and verifies their aggregate signature, against the
+// state of the attestation's target checkpoint. The state is shared by every attestation
+// of the checkpoint, and the committees are read from the shuffling caches, so that no
+// state is copied or advanced for each attestation.
+func (rs *RegularSync) validateAttestation(ctx context.Context, att *ethpb.Attestation, targetState *pb.BeaconState) error {
+	_, span := trace.StartSpan(ctx, "beacon-chain.sync.validateAttestation")
+	defer span.End()
+
+	indexedAtt, err := blocks.ConvertToIndexed(targetState, att)
+	if err != nil {
+		return fmt.Errorf("could not convert to indexed attestation: %v", err)
+	}
+	if err := blocks.VerifyIndexedAttestation(targetState, indexedAtt, true /* verify signatures */); err != nil {
+		return fmt.Errorf("could not verify indexed attestation: %v", err)
+	}
+	return nil
+}
+
+// checkpointState returns the state of the checkpoint, which is the post state of its block
+// advanced to the start slot of its epoch. Only checkpoints descending from the finalized
+// block, whose block is at most an epoch before the start slot, are accepted, so that
+// attestations for stale or distant forks cannot make the node replay or advance states.
+// The state is cached, and must not be modified.
+func (rs *RegularSync) checkpointState(ctx context.Context, checkpoint *ethpb.Checkpoint) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.checkpointState")
+	defer span.End()
+
+	cached, err := rs.checkpointStates.StateByCheckpoint(checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve checkpoint state from cache: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	block, err := rs.db.Block(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve target block: %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("unknown target block %#x", bytesutil.Trunc(checkpoint.Root))
+	}
+	startSlot := helpers.StartSlot(checkpoint.Epoch)
+	if block.Slot > startSlot {
+		return nil, fmt.Errorf("target block slot %d is after the start slot %d of the target epoch", block.Slot, startSlot)
+	}
+	if block.Slot+params.BeaconConfig().SlotsPerEpoch < startSlot {
+		return nil, fmt.Errorf("target block slot %d is more than an epoch before the start slot %d of the target epoch", block.Slot, startSlot)
+	}
+	descends, err := rs.descendsFromFinalized(block, root)
+	if err != nil {
+		return nil, fmt.Errorf("could not check target block ancestry: %v", err)
+	}
+	if !descends {
+		return nil, fmt.Errorf("target block %#x does not descend from the finalized block", bytesutil.Trunc(checkpoint.Root))
+	}
+	checkpointState, err := rs.db.StateByBlockRoot(ctx, root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve target block state: %v", err)
+	}
+	if checkpointState == nil {
+		return nil, fmt.Errorf("no state saved for target block %#x", bytesutil.Trunc(checkpoint.Root))
+	}
+	if checkpointState.Slot < startSlot {
+		// The state is only advanced once per checkpoint, as it is cached below.
+		checkpointState, err = state.ProcessSlots(ctx, checkpointState, startSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not process slots up to %d: %v", startSlot, err)
+		}
+	}
+	if err := rs.checkpointStates.AddCheckpointState(&cache.CheckpointState{
+		Checkpoint: checkpoint,
+		State:      checkpointState,
+	}); err != nil {
+		return nil, fmt.Errorf("could not save checkpoint state to cache: %v", err)
+	}
+	return checkpointState, nil
+}
+
+// descendsFromFinalized returns true if the block with the root is the finalized block or
+// one of its descendants.
+func (rs *RegularSync) descendsFromFinalized(block *ethpb.BeaconBlock, root [32]byte) (bool, error) {
+	finalized, err := rs.db.FinalizedBlock()
+	if err != nil {
+		return false, fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	finalizedRoot, err := ssz.SigningRoot(finalized)
+	if err != nil {
+		return false, fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	for root != finalizedRoot {
+		if block.Slot <= finalized.Slot {
+			return false, nil
+		}
+		root = bytesutil.ToBytes32(block.ParentRoot)
+		block, err = rs.db.Block(root)
+		if err != nil {
+			return false, err
+		}
+		if block == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,173 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// setupAttestationTest initializes the database with a genesis state and returns an
+// attestation of the first committee of the genesis slot, signed by all its members,
+// which targets the genesis block.
+func setupAttestationTest(t *testing.T) (*db.BeaconDB, *ethpb.Attestation) {
+	helpers.ClearAllCaches()
+	ctx := context.Background()
+	beaconDB := internal.SetupDB(t)
+	deposits, privKeys := testutil.SetupInitialDeposits(t, params.BeaconConfig().SlotsPerEpoch)
+	if err := beaconDB.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize state: %v", err)
+	}
+	beaconState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+
+	shard, err := helpers.StartShard(beaconState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee, err := helpers.CrosslinkCommittee(beaconState, 0, shard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: genesisRoot[:],
+		Source:          &ethpb.Checkpoint{},
+		Target:          &ethpb.Checkpoint{Epoch: 0, Root: genesisRoot[:]},
+		Crosslink:       &ethpb.Crosslink{Shard: shard},
+	}
+	root, err := ssz.HashTreeRoot(&pb.AttestationDataAndCustodyBit{Data: data, CustodyBit: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := helpers.Domain(beaconState, 0, params.BeaconConfig().DomainAttestation)
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	sigs := make([]*bls.Signature, len(committee))
+	for i, idx := range committee {
+		aggregationBits.SetBitAt(uint64(i), true)
+		sigs[i] = privKeys[idx].Sign(root[:], domain)
+	}
+	return beaconDB, &ethpb.Attestation{
+		Data:            data,
+		AggregationBits: aggregationBits,
+		CustodyBits:     bitfield.NewBitlist(uint64(len(committee))),
+		Signature:       bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func TestReceiveAttestation_InvalidSignature(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB, att := setupAttestationTest(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		ChainService:     &mockChainService{},
+		AttsService:      &mockAttestationService{},
+		OperationService: &mockOperationService{},
+		P2P:              &mockP2P{},
+		BeaconDB:         beaconDB,
+	})
+
+	// The signature is no longer the signature of the attestation data.
+	att.Data.BeaconBlockRoot = []byte("another block")
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.AttestationResponse{Attestation: att},
+	}
+	if err := ss.receiveAttestation(msg); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Received invalid attestation")
+	testutil.AssertLogsDoNotContain(t, hook, "Sending newly received attestation to subscribers")
+}
+
+func TestCheckpointState_AdvancesOncePerCheckpoint(t *testing.T) {
+	beaconDB, att := setupAttestationTest(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		BeaconDB: beaconDB,
+	})
+
+	// A checkpoint of the next epoch whose block is the genesis block, as no block was
+	// proposed in the first epoch.
+	checkpoint := &ethpb.Checkpoint{Epoch: 1, Root: att.Data.Target.Root}
+	first, err := ss.checkpointState(context.Background(), checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Expected the checkpoint state at slot %d, received %d", params.BeaconConfig().SlotsPerEpoch, first.Slot)
+	}
+	second, err := ss.checkpointState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: att.Data.Target.Root})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("Expected the cached checkpoint state to be shared")
+	}
+
+	if _, err := ss.checkpointState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: []byte("unknown")}); err == nil {
+		t.Error("Expected an error for the checkpoint of an unknown block")
+	}
+}
+
+func TestCheckpointState_RejectsDistantTarget(t *testing.T) {
+	beaconDB, att := setupAttestationTest(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		BeaconDB: beaconDB,
+	})
+
+	// The genesis block is two epochs before the start slot of epoch 2.
+	if _, err := ss.checkpointState(context.Background(), &ethpb.Checkpoint{Epoch: 2, Root: att.Data.Target.Root}); err == nil {
+		t.Error("Expected an error for a target block more than an epoch before the target epoch")
+	}
+}
+
+func TestCheckpointState_RejectsTargetNotDescendingFromFinalized(t *testing.T) {
+	beaconDB, att := setupAttestationTest(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ss := NewRegularSyncService(context.Background(), &RegularSyncConfig{
+		BeaconDB: beaconDB,
+	})
+
+	// A block of a fork which does not build on the genesis block.
+	fork := &ethpb.BeaconBlock{Slot: 1, ParentRoot: []byte("fork")}
+	if err := beaconDB.SaveBlock(fork); err != nil {
+		t.Fatal(err)
+	}
+	forkRoot, err := ssz.SigningRoot(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ss.checkpointState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: forkRoot[:]}); err == nil {
+		t.Error("Expected an error for a target block which does not descend from the finalized block")
+	}
+
+	if _, err := ss.checkpointState(context.Background(), &ethpb.Checkpoint{Epoch: 1, Root: att.Data.Target.Root}); err != nil {
+		t.Errorf("Expected the finalized block to be a valid target: %v", err)
+	}
+}
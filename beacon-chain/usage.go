@@ -49,14 +49,26 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PPort,
 			cmd.DataDirFlag,
+			cmd.ConfigFileFlag,
+			cmd.NetworkFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
+			cmd.TracingExporterFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingUsernameFlag,
+			cmd.TracingPasswordFlag,
+			cmd.TracingHeadersFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
 			cmd.MaxGoroutines,
+			cmd.MaxHeapSizeFlag,
+			cmd.MaxOpenFilesFlag,
+			cmd.NTPServersFlag,
+			cmd.ClockSkewThresholdFlag,
+			cmd.AdjustClockFlag,
+			cmd.ShutdownTimeoutFlag,
 			cmd.ClearDB,
 		},
 	},
@@ -69,6 +81,7 @@ var appHelpFlagGroups = []flagGroup{
 			debug.MemProfileRateFlag,
 			debug.CPUProfileFlag,
 			debug.TraceFlag,
+			debug.ProfileDurationFlag,
 		},
 	},
 	{
@@ -80,9 +93,36 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCPort,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.ClientCAFlag,
+			flags.RPCAuthTokenFlag,
+			flags.RPCMaxConcurrentStreamsFlag,
+			flags.RPCMaxRecvMsgSizeFlag,
+			flags.RPCKeepaliveTimeFlag,
+			flags.RPCKeepaliveTimeoutFlag,
+			flags.RPCKeepaliveMinTimeFlag,
+			flags.RPCRateLimitFlag,
+			flags.RPCDefaultPageSizeFlag,
+			flags.RPCMaxPageSizeFlag,
 			flags.EnableDBCleanup,
 			flags.GRPCGatewayPort,
+			flags.GRPCGatewayHost,
 			flags.HTTPWeb3ProviderFlag,
+			flags.Eth1LogBatchSizeFlag,
+			flags.Eth1RequestsPerSecondFlag,
+			flags.Eth1RequestBurstFlag,
+			flags.InteropEth1Flag,
+			flags.InteropEth1KeystoreFlag,
+			flags.InteropEth1PasswordFlag,
+			flags.InteropEth1GenesisTimeFlag,
+			flags.ReadinessSlotLagFlag,
+			flags.ReadinessMinPeersFlag,
+			flags.EnableSlasherFlag,
+			flags.SlasherHistoryEpochsFlag,
+			flags.ArchiveFlag,
+			flags.AttestationPoolMaxSizeFlag,
+			flags.AttestationPoolMaxAgeFlag,
+			flags.ServiceMaxRestartsFlag,
+			flags.ServiceRestartBackoffFlag,
 		},
 	},
 	{
@@ -92,6 +132,11 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PMaxPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
+			cmd.P2PAllowList,
+			cmd.P2PDenyList,
+			cmd.P2PSecurity,
+			flags.P2PMaxChunkSize,
+			flags.P2PMaxRequestCount,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 		},
@@ -101,6 +146,13 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.LogFormat,
 			cmd.LogFileName,
+			cmd.LogMaxSizeFlag,
+			cmd.LogMaxBackupsFlag,
+			cmd.LogMaxAgeFlag,
+			cmd.LogCompressFlag,
+			cmd.LogSyslogFlag,
+			cmd.LogHTTPEndpointFlag,
+			cmd.LogHTTPLabelsFlag,
 		},
 	},
 	{
@@ -32,6 +32,7 @@ func main() {
 	var passwordFile string
 	var httpPath string
 	var privKeyString string
+	var chainID int64
 	var k8sConfigMapName string
 	var depositsForChainStart int64
 	var minDepositAmount int64
@@ -83,6 +84,11 @@ func main() {
 			Usage:       "Private key to unlock account",
 			Destination: &privKeyString,
 		},
+		cli.Int64Flag{
+			Name:        "chain-id",
+			Usage:       "Chain ID of the network, used to sign transactions with EIP-155 replay protection. Transactions are signed without replay protection if not set",
+			Destination: &chainID,
+		},
 		cli.StringFlag{
 			Name:        "k8sConfig",
 			Usage:       "Name of kubernetes config map to update with the contract address",
@@ -138,7 +144,7 @@ func main() {
 			if err != nil {
 				log.Fatal(err)
 			}
-			txOps = bind.NewKeyedTransactor(privKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(0)
 			txOps.GasLimit = 4000000
 			// User inputs keystore json file, sign tx with keystore json
@@ -164,7 +170,7 @@ func main() {
 				log.Fatal(err)
 			}
 
-			txOps = bind.NewKeyedTransactor(privKey.PrivateKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey.PrivateKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(0)
 			txOps.GasLimit = 4000000
 		}
@@ -29,6 +29,7 @@ func main() {
 	var passwordFile string
 	var httpPath string
 	var privKeyString string
+	var chainID int64
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -62,6 +63,11 @@ func main() {
 			Usage:       "Private key to send ETH transaction",
 			Destination: &privKeyString,
 		},
+		cli.Int64Flag{
+			Name:        "chain-id",
+			Usage:       "Chain ID of the network, used to sign transactions with EIP-155 replay protection. Transactions are signed without replay protection if not set",
+			Destination: &chainID,
+		},
 	}
 
 	app.Action = func(c *cli.Context) {
@@ -84,7 +90,7 @@ func main() {
 			if err != nil {
 				log.Fatal(err)
 			}
-			txOps = bind.NewKeyedTransactor(privKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(0)
 			txOps.GasLimit = 4000000
 			nonce, err := client.NonceAt(context.Background(), crypto.PubkeyToAddress(privKey.PublicKey), nil)
@@ -108,7 +114,7 @@ func main() {
 				log.Fatal(err)
 			}
 
-			txOps = bind.NewKeyedTransactor(privKey.PrivateKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey.PrivateKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(0)
 			txOps.GasLimit = 4000000
 			nonce, err := client.NonceAt(context.Background(), privKey.Address, nil)
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	prefixed "github.com/x-cray/logrus-prefixed-formatter"
+)
+
+var (
+	log = logrus.WithField("prefix", "main")
+)
+
+func main() {
+	var ipcPath string
+	var httpPath string
+	var depositContractAddr string
+	var recentBlocks uint64
+
+	customFormatter := new(prefixed.TextFormatter)
+	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
+	customFormatter.FullTimestamp = true
+	logrus.SetFormatter(customFormatter)
+
+	app := cli.NewApp()
+	app.Name = "queryContract"
+	app.Usage = "this is a util to query the state of the deposit contract"
+	app.Version = version.GetVersion()
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:        "ipcPath",
+			Usage:       "Filename for IPC socket/pipe within the datadir",
+			Destination: &ipcPath,
+		},
+		cli.StringFlag{
+			Name:        "httpPath",
+			Value:       "http://localhost:8545/",
+			Usage:       "HTTP-RPC server listening interface",
+			Destination: &httpPath,
+		},
+		cli.StringFlag{
+			Name:        "depositContract",
+			Usage:       "Address of the deposit contract",
+			Destination: &depositContractAddr,
+		},
+		cli.Uint64Flag{
+			Name:        "recentBlocks",
+			Value:       1000,
+			Usage:       "Number of most recent blocks to list the deposit events of. Events are not listed if 0",
+			Destination: &recentBlocks,
+		},
+	}
+
+	app.Action = func(c *cli.Context) {
+		var rpcClient *rpc.Client
+		var err error
+
+		// Uses HTTP-RPC if IPC is not set
+		if ipcPath == "" {
+			rpcClient, err = rpc.Dial(httpPath)
+		} else {
+			rpcClient, err = rpc.Dial(ipcPath)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		client := ethclient.NewClient(rpcClient)
+
+		depositContract, err := contracts.NewDepositContract(common.HexToAddress(depositContractAddr), client)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx := context.Background()
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			log.Fatalf("Could not retrieve latest block: %v", err)
+		}
+		// Query the contract at a fixed block so that the count and root are consistent.
+		callOpts := &bind.CallOpts{BlockNumber: header.Number, Context: ctx}
+		countBytes, err := depositContract.GetDepositCount(callOpts)
+		if err != nil {
+			log.Fatalf("Could not retrieve deposit count: %v", err)
+		}
+		root, err := depositContract.GetHashTreeRoot(callOpts)
+		if err != nil {
+			log.Fatalf("Could not retrieve deposit root: %v", err)
+		}
+		log.WithFields(logrus.Fields{
+			"blockNumber":  header.Number,
+			"blockHash":    fmt.Sprintf("%#x", header.Hash()),
+			"depositCount": bytesutil.FromBytes8(countBytes),
+			"depositRoot":  fmt.Sprintf("%#x", root),
+		}).Info("Deposit contract state")
+
+		if recentBlocks == 0 {
+			return
+		}
+		latest := header.Number.Uint64()
+		start := uint64(0)
+		if latest > recentBlocks {
+			start = latest - recentBlocks
+		}
+		if err := logRecentDeposits(ctx, depositContract, start, latest); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// logRecentDeposits logs the deposit events emitted by the contract between the start
+// and end blocks.
+func logRecentDeposits(ctx context.Context, depositContract *contracts.DepositContract, start uint64, end uint64) error {
+	iter, err := depositContract.FilterDepositEvent(&bind.FilterOpts{Start: start, End: &end, Context: ctx})
+	if err != nil {
+		return fmt.Errorf("could not retrieve deposit events: %v", err)
+	}
+	defer iter.Close()
+
+	count := 0
+	for iter.Next() {
+		event := iter.Event
+		log.WithFields(logrus.Fields{
+			"index":       bytesutil.FromBytes8(event.Index),
+			"blockNumber": event.Raw.BlockNumber,
+			"txHash":      fmt.Sprintf("%#x", event.Raw.TxHash),
+			"amount":      bytesutil.FromBytes8(event.Amount),
+			"publicKey":   fmt.Sprintf("%#x", event.Pubkey),
+		}).Info("Deposit event")
+		count++
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("could not iterate deposit events: %v", err)
+	}
+	log.Infof("%d deposit events found between blocks %d and %d", count, start, end)
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// depositDataJSON is the deposit data of a validator in the deposit_data.json format of
+// the deposit launchpad.
+type depositDataJSON struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+func newDepositDataJSON(data *ethpb.Deposit_Data) (*depositDataJSON, error) {
+	messageRoot, err := ssz.SigningRoot(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute deposit message root: %v", err)
+	}
+	dataRoot, err := hashutil.DepositHash(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute deposit data root: %v", err)
+	}
+	return &depositDataJSON{
+		PublicKey:             hex.EncodeToString(data.PublicKey),
+		WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials),
+		Amount:                data.Amount,
+		Signature:             hex.EncodeToString(data.Signature),
+		DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+		ForkVersion:           hex.EncodeToString(params.BeaconConfig().GenesisForkVersion),
+	}, nil
+}
+
+// writeDepositDataJSON writes the deposit data to the file in the deposit_data.json
+// format of the deposit launchpad.
+func writeDepositDataJSON(path string, deposits []*ethpb.Deposit_Data) error {
+	entries := make([]*depositDataJSON, len(deposits))
+	for i, data := range deposits {
+		entry, err := newDepositDataJSON(data)
+		if err != nil {
+			return err
+		}
+		entries[i] = entry
+	}
+	enc, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode deposit data: %v", err)
+	}
+	return ioutil.WriteFile(path, enc, 0600)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+)
+
+// depositedPublicKeys returns the public keys of the validators which already have a
+// deposit in the logs of the deposit contract, keyed by their raw bytes.
+func depositedPublicKeys(ctx context.Context, client *ethclient.Client, contractAddr common.Address) (map[string]bool, error) {
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{contractAddr},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve deposit logs: %v", err)
+	}
+	deposited := make(map[string]bool, len(logs))
+	for _, depositLog := range logs {
+		pubkey, _, _, _, _, err := contracts.UnpackDepositLogData(depositLog.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack deposit log: %v", err)
+		}
+		deposited[string(pubkey)] = true
+	}
+	return deposited, nil
+}
+
+// loadStateFile reads the public keys recorded in the state file, one hex encoded key
+// per line. A missing state file is treated as empty, as it is created on the first run.
+func loadStateFile(path string) (map[string]bool, error) {
+	deposited := make(map[string]bool)
+	// #nosec - Inclusion of file via variable is OK for this tool.
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return deposited, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open state file: %v", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		pubkey, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q in state file: %v", line, err)
+		}
+		deposited[string(pubkey)] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read state file: %v", err)
+	}
+	return deposited, nil
+}
+
+// recordDeposit appends the public key of a validator whose deposit was sent to the
+// state file, so that it is skipped if the run is executed again.
+func recordDeposit(path string, pubkey []byte) error {
+	// #nosec - Inclusion of file via variable is OK for this tool.
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("could not open state file: %v", err)
+	}
+	if _, err := fmt.Fprintf(file, "%#x\n", pubkey); err != nil {
+		file.Close()
+		return fmt.Errorf("could not write state file: %v", err)
+	}
+	return file.Close()
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	prysmKeyStore "github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -39,6 +41,7 @@ func main() {
 	var passwordFile string
 	var httpPath string
 	var privKeyString string
+	var chainID int64
 	var depositContractAddr string
 	var numberOfDeposits int64
 	var depositAmount int64
@@ -46,6 +49,13 @@ func main() {
 	var variableTx bool
 	var txDeviation int64
 	var randomKey bool
+	var gasPrice int64
+	var maxGasPrice int64
+	var confirmations uint64
+	var outputJSON string
+	var stateFile string
+	var allowDuplicates bool
+	var dryRun bool
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -89,6 +99,11 @@ func main() {
 			Usage:       "Private key to send ETH transaction",
 			Destination: &privKeyString,
 		},
+		cli.Int64Flag{
+			Name:        "chain-id",
+			Usage:       "Chain ID of the network, used to sign transactions with EIP-155 replay protection. Transactions are signed without replay protection if not set",
+			Destination: &chainID,
+		},
 		cli.StringFlag{
 			Name:        "depositContract",
 			Usage:       "Address of the deposit contract",
@@ -128,25 +143,139 @@ func main() {
 			Usage:       "Use a randomly generated keystore key",
 			Destination: &randomKey,
 		},
+		cli.Int64Flag{
+			Name:        "gasPrice",
+			Usage:       "Gas price of the deposit transactions(in gwei). The price suggested by the node is used if not set",
+			Destination: &gasPrice,
+		},
+		cli.Int64Flag{
+			Name:        "maxGasPrice",
+			Usage:       "Max gas price of the deposit transactions when using the suggested gas price(in gwei)",
+			Destination: &maxGasPrice,
+		},
+		cli.Uint64Flag{
+			Name:        "confirmations",
+			Usage:       "Number of blocks to wait for each deposit transaction to be confirmed. Transactions are not awaited if 0",
+			Destination: &confirmations,
+		},
+		cli.StringFlag{
+			Name:        "output-json",
+			Usage:       "Write the deposit data of every key to the file in the deposit_data.json format of the deposit launchpad instead of sending transactions",
+			Destination: &outputJSON,
+		},
+		cli.StringFlag{
+			Name:        "stateFile",
+			Usage:       "File recording the public keys of the sent deposits, which are skipped when the utility is run again",
+			Destination: &stateFile,
+		},
+		cli.BoolFlag{
+			Name:        "allowDuplicates",
+			Usage:       "Send deposits for public keys which already deposited to the contract",
+			Destination: &allowDuplicates,
+		},
+		cli.BoolFlag{
+			Name:        "dry-run",
+			Usage:       "Validate the deposit data of every key and compute the resulting deposit root without sending transactions",
+			Destination: &dryRun,
+		},
 	}
 
 	app.Action = func(c *cli.Context) {
-		// Set up RPC client
-		var rpcClient *rpc.Client
 		var err error
-		var txOps *bind.TransactOpts
-
-		// Uses HTTP-RPC if IPC is not set
-		if ipcPath == "" {
-			rpcClient, err = rpc.Dial(httpPath)
+		validatorKeys := make(map[string]*prysmKeyStore.Key)
+		if randomKey {
+			validatorKey, err := prysmKeyStore.NewKey(rand.Reader)
+			validatorKeys[hex.EncodeToString(validatorKey.PublicKey.Marshal())] = validatorKey
+			if err != nil {
+				log.Errorf("Could not generate random key: %v", err)
+			}
 		} else {
-			rpcClient, err = rpc.Dial(ipcPath)
+			// Load from keystore
+			store := prysmKeyStore.NewKeystore(prysmKeystorePath)
+			rawPassword := loadTextFromFile(passwordFile)
+			prefix := params.BeaconConfig().ValidatorPrivkeyFileName
+			validatorKeys, err = store.GetKeys(prysmKeystorePath, prefix, rawPassword)
+			if err != nil {
+				log.WithField("path", prysmKeystorePath).WithField("password", rawPassword).Errorf("Could not get keys: %v", err)
+			}
+		}
+
+		if outputJSON != "" {
+			deposits := make([]*ethpb.Deposit_Data, 0, len(validatorKeys))
+			for _, validatorKey := range validatorKeys {
+				data, err := prysmKeyStore.DepositInput(validatorKey, validatorKey, uint64(depositAmount))
+				if err != nil {
+					log.Fatalf("Could not generate deposit input data: %v", err)
+				}
+				deposits = append(deposits, data)
+			}
+			if err := writeDepositDataJSON(outputJSON, deposits); err != nil {
+				log.Fatalf("Could not write deposit data: %v", err)
+			}
+			log.WithField("path", outputJSON).Infof("Wrote deposit data of %d validators", len(deposits))
+			return
 		}
+
+		if dryRun {
+			deposits := make([]*ethpb.Deposit_Data, 0, len(validatorKeys))
+			invalid := 0
+			for _, validatorKey := range validatorKeys {
+				data, err := prysmKeyStore.DepositInput(validatorKey, validatorKey, uint64(depositAmount))
+				if err != nil {
+					log.Fatalf("Could not generate deposit input data: %v", err)
+				}
+				if err := validateDepositData(data); err != nil {
+					log.WithField("publicKey", fmt.Sprintf("%#x", data.PublicKey)).Errorf("Invalid deposit: %v", err)
+					invalid++
+					continue
+				}
+				deposits = append(deposits, data)
+			}
+			leaves, err := depositLeaves(deposits)
+			if err != nil {
+				log.Fatal(err)
+			}
+			// Without a deposit contract the deposits are assumed to be the first ones of a
+			// new contract.
+			if depositContractAddr != "" {
+				client, err := dialEth1(ipcPath, httpPath)
+				if err != nil {
+					log.Fatal(err)
+				}
+				ctx := context.Background()
+				header, err := client.HeaderByNumber(ctx, nil)
+				if err != nil {
+					log.Fatalf("Could not retrieve latest block: %v", err)
+				}
+				existing, err := contractDepositLeaves(ctx, client, common.HexToAddress(depositContractAddr), header.Number)
+				if err != nil {
+					log.Fatalf("Could not verify deposit contract state: %v", err)
+				}
+				log.WithFields(logrus.Fields{
+					"blockNumber":  header.Number,
+					"depositCount": len(existing),
+				}).Info("Verified deposit logs against the deposit contract")
+				leaves = append(existing, leaves...)
+			}
+			root, err := depositTrieRoot(leaves)
+			if err != nil {
+				log.Fatalf("Could not compute deposit root: %v", err)
+			}
+			log.WithFields(logrus.Fields{
+				"depositRoot":  fmt.Sprintf("%#x", root),
+				"depositCount": len(leaves),
+			}).Infof("Validated deposit data of %d validators", len(deposits))
+			if invalid > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
+		var txOps *bind.TransactOpts
+		client, err := dialEth1(ipcPath, httpPath)
 		if err != nil {
 			log.Fatal(err)
 		}
-
-		client := ethclient.NewClient(rpcClient)
 		depositAmountInGwei := uint64(depositAmount)
 		depositAmount = depositAmount * 1e9
 
@@ -156,7 +285,7 @@ func main() {
 			if err != nil {
 				log.Fatal(err)
 			}
-			txOps = bind.NewKeyedTransactor(privKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(depositAmount)
 			txOps.GasLimit = 4000000
 			// User inputs keystore json file, sign tx with keystore json
@@ -173,7 +302,7 @@ func main() {
 				log.Fatal(err)
 			}
 
-			txOps = bind.NewKeyedTransactor(privKey.PrivateKey)
+			txOps = contracts.NewKeyedTransactorWithChainID(privKey.PrivateKey, big.NewInt(chainID))
 			txOps.Value = big.NewInt(depositAmount)
 			txOps.GasLimit = 4000000
 		}
@@ -183,42 +312,70 @@ func main() {
 			log.Fatal(err)
 		}
 
-		statDist := buildStatisticalDist(depositDelay, numberOfDeposits, txDeviation)
+		ctx := context.Background()
+		txOps.GasPrice, err = txGasPrice(ctx, client, gasPrice, maxGasPrice)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// The nonce is managed locally so that deposits can be sent without waiting for
+		// the previous ones to be mined.
+		nonce, err := client.PendingNonceAt(ctx, txOps.From)
+		if err != nil {
+			log.Fatalf("Could not retrieve account nonce: %v", err)
+		}
 
-		validatorKeys := make(map[string]*prysmKeyStore.Key)
-		if randomKey {
-			validatorKey, err := prysmKeyStore.NewKey(rand.Reader)
-			validatorKeys[hex.EncodeToString(validatorKey.PublicKey.Marshal())] = validatorKey
+		// Skip the keys which already deposited, so that an interrupted run can be
+		// executed again without sending duplicate deposits.
+		deposited := make(map[string]bool)
+		if !allowDuplicates {
+			deposited, err = depositedPublicKeys(ctx, client, common.HexToAddress(depositContractAddr))
 			if err != nil {
-				log.Errorf("Could not generate random key: %v", err)
+				log.Fatal(err)
 			}
-		} else {
-			// Load from keystore
-			store := prysmKeyStore.NewKeystore(prysmKeystorePath)
-			rawPassword := loadTextFromFile(passwordFile)
-			prefix := params.BeaconConfig().ValidatorPrivkeyFileName
-			validatorKeys, err = store.GetKeys(prysmKeystorePath, prefix, rawPassword)
-			if err != nil {
-				log.WithField("path", prysmKeystorePath).WithField("password", rawPassword).Errorf("Could not get keys: %v", err)
+			if stateFile != "" {
+				recorded, err := loadStateFile(stateFile)
+				if err != nil {
+					log.Fatal(err)
+				}
+				for pubkey := range recorded {
+					deposited[pubkey] = true
+				}
 			}
 		}
 
+		statDist := buildStatisticalDist(depositDelay, numberOfDeposits, txDeviation)
+
+		var txs []*depositTx
 		for _, validatorKey := range validatorKeys {
 			data, err := prysmKeyStore.DepositInput(validatorKey, validatorKey, depositAmountInGwei)
 			if err != nil {
 				log.Errorf("Could not generate deposit input data: %v", err)
 				continue
 			}
+			if deposited[string(data.PublicKey)] {
+				log.Infof("Skipping validator with a public key %#x which already deposited", validatorKey.PublicKey.Marshal())
+				continue
+			}
 
 			for i := int64(0); i < numberOfDeposits; i++ {
+				txOps.Nonce = big.NewInt(0).SetUint64(nonce)
 				//TODO(#2658): Use actual compressed pubkeys in G1 here
 				tx, err := depositContract.Deposit(txOps, data.PublicKey, data.WithdrawalCredentials, data.Signature)
+				txs = append(txs, &depositTx{publicKey: data.PublicKey, tx: tx, err: err})
 				if err != nil {
-					log.Error("unable to send transaction to contract")
+					log.Errorf("Unable to send transaction to contract: %v", err)
+					continue
+				}
+				nonce++
+				if stateFile != "" {
+					if err := recordDeposit(stateFile, data.PublicKey); err != nil {
+						log.Errorf("Could not record deposit: %v", err)
+					}
 				}
 
 				log.WithFields(logrus.Fields{
 					"Transaction Hash": fmt.Sprintf("%#x", tx.Hash()),
+					"Nonce":            tx.Nonce(),
 				}).Infof("Deposit %d sent to contract address %v for validator with a public key %#x", i, depositContractAddr, validatorKey.PublicKey.Marshal())
 
 				// If flag is enabled make transaction times variable
@@ -230,6 +387,13 @@ func main() {
 				time.Sleep(time.Duration(depositDelay) * time.Second)
 			}
 		}
+
+		if confirmations > 0 {
+			waitForConfirmations(ctx, client, txs, confirmations)
+		}
+		if failures := reportFailures(txs); failures > 0 {
+			os.Exit(1)
+		}
 	}
 
 	err := app.Run(os.Args)
@@ -238,6 +402,19 @@ func main() {
 	}
 }
 
+// dialEth1 connects to the eth1 node over IPC, or over HTTP-RPC if IPC is not set.
+func dialEth1(ipcPath string, httpPath string) (*ethclient.Client, error) {
+	endpoint := ipcPath
+	if endpoint == "" {
+		endpoint = httpPath
+	}
+	rpcClient, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
 func buildStatisticalDist(depositDelay int64, numberOfDeposits int64, txDeviation int64) *distuv.StudentsT {
 	src := rand2.NewSource(uint64(time.Now().Unix()))
 	dist := &distuv.StudentsT{
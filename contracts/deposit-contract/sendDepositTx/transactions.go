@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/sirupsen/logrus"
+)
+
+// depositTx tracks a deposit transaction sent to the deposit contract, or the error
+// which prevented it from being sent or confirmed.
+type depositTx struct {
+	publicKey []byte
+	tx        *types.Transaction
+	err       error
+}
+
+// txGasPrice returns the gas price of the deposit transactions in wei. If no gas price
+// is given, the price suggested by the node is used, capped at the max gas price if one
+// is given.
+func txGasPrice(ctx context.Context, client *ethclient.Client, gasPriceGwei int64, maxGasPriceGwei int64) (*big.Int, error) {
+	if gasPriceGwei > 0 {
+		return gweiToWei(gasPriceGwei), nil
+	}
+	suggested, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve suggested gas price: %v", err)
+	}
+	if maxGasPriceGwei > 0 && suggested.Cmp(gweiToWei(maxGasPriceGwei)) > 0 {
+		log.WithField("suggestedGasPrice", suggested).Warn("Suggested gas price exceeds the max gas price, using the max")
+		return gweiToWei(maxGasPriceGwei), nil
+	}
+	return suggested, nil
+}
+
+func gweiToWei(gwei int64) *big.Int {
+	return big.NewInt(0).Mul(big.NewInt(gwei), big.NewInt(1e9))
+}
+
+// waitForConfirmations waits until every sent transaction is included in a block followed
+// by the given number of blocks, recording an error for transactions which failed.
+func waitForConfirmations(ctx context.Context, client *ethclient.Client, txs []*depositTx, confirmations uint64) {
+	for _, d := range txs {
+		if d.err != nil {
+			continue
+		}
+		receipt, err := bind.WaitMined(ctx, client, d.tx)
+		if err != nil {
+			d.err = fmt.Errorf("could not retrieve receipt: %v", err)
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			d.err = errors.New("transaction reverted")
+			continue
+		}
+		if err := waitForBlock(ctx, client, receipt.BlockNumber.Uint64()+confirmations-1); err != nil {
+			d.err = err
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"txHash":      d.tx.Hash().Hex(),
+			"blockNumber": receipt.BlockNumber,
+		}).Infof("Deposit for validator with public key %#x confirmed", d.publicKey)
+	}
+}
+
+// waitForBlock waits until the chain reaches the given block number.
+func waitForBlock(ctx context.Context, client *ethclient.Client, number uint64) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		head, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("could not retrieve latest block: %v", err)
+		}
+		if head.Number.Uint64() >= number {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// reportFailures logs the deposits which failed and returns their number.
+func reportFailures(txs []*depositTx) int {
+	failures := 0
+	for _, d := range txs {
+		if d.err == nil {
+			continue
+		}
+		failures++
+		fields := logrus.Fields{"publicKey": fmt.Sprintf("%#x", d.publicKey)}
+		if d.tx != nil {
+			fields["txHash"] = d.tx.Hash().Hex()
+		}
+		log.WithFields(fields).Errorf("Deposit failed: %v", d.err)
+	}
+	log.WithFields(logrus.Fields{
+		"succeeded": len(txs) - failures,
+		"failed":    failures,
+	}).Info("Finished sending deposits")
+	return failures
+}
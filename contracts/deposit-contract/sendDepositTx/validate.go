@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/go-ssz"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// Sizes of the deposit fields expected by the deposit contract.
+const (
+	publicKeyLength             = 48
+	withdrawalCredentialsLength = 32
+	signatureLength             = 96
+)
+
+// validateDepositData checks the deposit data against the expectations of the deposit
+// contract and the beacon chain: the size of its fields, the format of the withdrawal
+// credentials, the bounds of the amount and the signature over the deposit message.
+func validateDepositData(data *ethpb.Deposit_Data) error {
+	if len(data.PublicKey) != publicKeyLength {
+		return fmt.Errorf("public key has length %d, expected %d", len(data.PublicKey), publicKeyLength)
+	}
+	if len(data.WithdrawalCredentials) != withdrawalCredentialsLength {
+		return fmt.Errorf("withdrawal credentials have length %d, expected %d", len(data.WithdrawalCredentials), withdrawalCredentialsLength)
+	}
+	if data.WithdrawalCredentials[0] != params.BeaconConfig().BLSWithdrawalPrefixByte {
+		return fmt.Errorf("withdrawal credentials have prefix %#x, expected %#x", data.WithdrawalCredentials[0], params.BeaconConfig().BLSWithdrawalPrefixByte)
+	}
+	if len(data.Signature) != signatureLength {
+		return fmt.Errorf("signature has length %d, expected %d", len(data.Signature), signatureLength)
+	}
+
+	minAmount := params.ContractConfig().MinDepositAmount.Uint64()
+	if data.Amount < minAmount {
+		return fmt.Errorf("amount %d is below the min deposit amount %d", data.Amount, minAmount)
+	}
+	if data.Amount > params.BeaconConfig().MaxEffectiveBalance {
+		return fmt.Errorf("amount %d exceeds the max effective balance %d", data.Amount, params.BeaconConfig().MaxEffectiveBalance)
+	}
+
+	pubKey, err := bls.PublicKeyFromBytes(data.PublicKey)
+	if err != nil {
+		return fmt.Errorf("could not decode public key: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(data.Signature)
+	if err != nil {
+		return fmt.Errorf("could not decode signature: %v", err)
+	}
+	sr, err := ssz.SigningRoot(data)
+	if err != nil {
+		return fmt.Errorf("could not compute deposit message root: %v", err)
+	}
+	domain := bls.Domain(params.BeaconConfig().DomainDeposit, params.BeaconConfig().GenesisForkVersion)
+	if !sig.Verify(sr[:], pubKey, domain) {
+		return errors.New("signature does not verify over the deposit message")
+	}
+	return nil
+}
+
+// depositContractBackend is the part of an eth1 client needed to read the state of the
+// deposit contract.
+type depositContractBackend interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+}
+
+// contractDepositLeaves returns the deposit data roots of the deposits in the logs of the
+// deposit contract up to the given block. They are checked against the deposit count and
+// deposit root reported by the contract at that block, so that the deposit root expected
+// after sending new deposits is derived from the actual state of the contract.
+func contractDepositLeaves(ctx context.Context, backend depositContractBackend, contractAddr common.Address, blockNumber *big.Int) ([][]byte, error) {
+	logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{contractAddr},
+		ToBlock:   blockNumber,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve deposit logs: %v", err)
+	}
+	deposits := make([]*ethpb.Deposit_Data, len(logs))
+	for i, depositLog := range logs {
+		pubkey, withdrawalCredentials, amount, signature, _, err := contracts.UnpackDepositLogData(depositLog.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack deposit log: %v", err)
+		}
+		deposits[i] = &ethpb.Deposit_Data{
+			PublicKey:             pubkey,
+			WithdrawalCredentials: withdrawalCredentials,
+			Amount:                bytesutil.FromBytes8(amount),
+			Signature:             signature,
+		}
+	}
+	leaves, err := depositLeaves(deposits)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := contracts.NewDepositContractCaller(contractAddr, backend)
+	if err != nil {
+		return nil, fmt.Errorf("could not create deposit contract caller: %v", err)
+	}
+	callOpts := &bind.CallOpts{BlockNumber: blockNumber, Context: ctx}
+	countBytes, err := caller.GetDepositCount(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve deposit count: %v", err)
+	}
+	if count := bytesutil.FromBytes8(countBytes); count != uint64(len(leaves)) {
+		return nil, fmt.Errorf("contract reports %d deposits, found %d deposit logs", count, len(leaves))
+	}
+	contractRoot, err := caller.GetHashTreeRoot(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve deposit root: %v", err)
+	}
+	root, err := depositTrieRoot(leaves)
+	if err != nil {
+		return nil, err
+	}
+	if root != contractRoot {
+		return nil, fmt.Errorf("deposit root %#x of the deposit logs does not match the deposit root %#x of the contract", root, contractRoot)
+	}
+	return leaves, nil
+}
+
+// depositLeaves returns the deposit data roots of the deposits, which are the leaves of
+// the deposit trie.
+func depositLeaves(deposits []*ethpb.Deposit_Data) ([][]byte, error) {
+	leaves := make([][]byte, len(deposits))
+	for i, data := range deposits {
+		leaf, err := hashutil.DepositHash(data)
+		if err != nil {
+			return nil, fmt.Errorf("could not compute deposit data root: %v", err)
+		}
+		leaves[i] = leaf[:]
+	}
+	return leaves, nil
+}
+
+// depositTrieRoot computes the deposit root the contract reports for a deposit trie with
+// the given leaves.
+func depositTrieRoot(leaves [][]byte) ([32]byte, error) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	if len(leaves) == 0 {
+		trie, err := trieutil.NewTrie(depth)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("could not generate deposit trie: %v", err)
+		}
+		return trie.HashTreeRoot(), nil
+	}
+	trie, err := trieutil.GenerateTrieFromItems(leaves, depth)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not generate deposit trie: %v", err)
+	}
+	return trie.HashTreeRoot(), nil
+}
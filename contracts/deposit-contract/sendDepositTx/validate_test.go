@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core/types"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func depositData(t *testing.T) *ethpb.Deposit_Data {
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := keystore.DepositInput(key, key, params.BeaconConfig().MaxEffectiveBalance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func sendDeposit(t *testing.T, testAcc *contracts.TestAccount, data *ethpb.Deposit_Data) {
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature); err != nil {
+		t.Fatalf("Could not deposit to deposit contract: %v", err)
+	}
+	testAcc.Backend.Commit()
+}
+
+func TestValidateDepositData(t *testing.T) {
+	if err := validateDepositData(depositData(t)); err != nil {
+		t.Errorf("Expected valid deposit data, received %v", err)
+	}
+
+	data := depositData(t)
+	data.Amount--
+	if err := validateDepositData(data); err == nil || !strings.Contains(err.Error(), "signature") {
+		t.Errorf("Expected signature error for modified deposit data, received %v", err)
+	}
+
+	data = depositData(t)
+	data.WithdrawalCredentials = data.WithdrawalCredentials[1:]
+	if err := validateDepositData(data); err == nil {
+		t.Error("Expected error for short withdrawal credentials")
+	}
+}
+
+func TestDepositTrieRoot_MatchesContract(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Could not set up simulated backend: %v", err)
+	}
+	ctx := context.Background()
+
+	// The root of an empty contract.
+	leaves, err := contractDepositLeaves(ctx, testAcc.Backend, testAcc.ContractAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaves) != 0 {
+		t.Fatalf("Expected no deposits, received %d", len(leaves))
+	}
+
+	sendDeposit(t, testAcc, depositData(t))
+	sendDeposit(t, testAcc, depositData(t))
+	leaves, err = contractDepositLeaves(ctx, testAcc.Backend, testAcc.ContractAddr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leaves) != 2 {
+		t.Fatalf("Expected 2 deposits, received %d", len(leaves))
+	}
+
+	// The root predicted for a new deposit is the root the contract reports once it is sent.
+	data := depositData(t)
+	newLeaves, err := depositLeaves([]*ethpb.Deposit_Data{data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := depositTrieRoot(append(leaves, newLeaves...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendDeposit(t, testAcc, data)
+	caller, err := contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := caller.GetHashTreeRoot(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expected {
+		t.Errorf("Expected deposit root %#x, contract reports %#x", expected, root)
+	}
+}
+
+// missingLogBackend drops the last deposit log of the contract.
+type missingLogBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (b *missingLogBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := b.SimulatedBackend.FilterLogs(ctx, query)
+	if err != nil || len(logs) == 0 {
+		return logs, err
+	}
+	return logs[:len(logs)-1], nil
+}
+
+func TestContractDepositLeaves_MissingLogs(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Could not set up simulated backend: %v", err)
+	}
+	sendDeposit(t, testAcc, depositData(t))
+	sendDeposit(t, testAcc, depositData(t))
+
+	backend := &missingLogBackend{testAcc.Backend}
+	_, err = contractDepositLeaves(context.Background(), backend, testAcc.ContractAddr, nil)
+	if err == nil || !strings.Contains(err.Error(), "contract reports 2 deposits") {
+		t.Errorf("Expected deposit count mismatch, received %v", err)
+	}
+}
@@ -0,0 +1,37 @@
+package depositcontract
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// NewKeyedTransactorWithChainID creates transaction options signing transactions with the
+// private key. If a chain ID is given, transactions are signed with EIP-155 replay
+// protection bound to that chain, which networks rejecting unprotected transactions
+// require. Otherwise the default signer of bind.NewKeyedTransactor is used.
+func NewKeyedTransactorWithChainID(key *ecdsa.PrivateKey, chainID *big.Int) *bind.TransactOpts {
+	if chainID == nil || chainID.Sign() == 0 {
+		return bind.NewKeyedTransactor(key)
+	}
+	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
+	signer := types.NewEIP155Signer(chainID)
+	return &bind.TransactOpts{
+		From: keyAddr,
+		Signer: func(_ types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != keyAddr {
+				return nil, errors.New("not authorized to sign this account")
+			}
+			signature, err := crypto.Sign(signer.Hash(tx).Bytes(), key)
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(signer, signature)
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package depositcontract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestNewKeyedTransactorWithChainID_SignsWithEIP155(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(5)
+	txOpts := NewKeyedTransactorWithChainID(privKey, chainID)
+
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	signed, err := txOpts.Signer(types.HomesteadSigner{}, txOpts.From, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signed.Protected() {
+		t.Error("Expected transaction to be replay protected")
+	}
+	if signed.ChainId().Cmp(chainID) != 0 {
+		t.Errorf("Expected chain ID %v, received %v", chainID, signed.ChainId())
+	}
+	sender, err := types.Sender(types.NewEIP155Signer(chainID), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != txOpts.From {
+		t.Errorf("Expected sender %#x, received %#x", txOpts.From, sender)
+	}
+
+	if _, err := txOpts.Signer(types.HomesteadSigner{}, common.Address{}, tx); err == nil {
+		t.Error("Expected error signing for another account")
+	}
+}
+
+func TestNewKeyedTransactorWithChainID_NoChainID(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txOpts := NewKeyedTransactorWithChainID(privKey, nil)
+
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil)
+	signed, err := txOpts.Signer(types.HomesteadSigner{}, txOpts.From, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.Protected() {
+		t.Error("Expected transaction without chain ID not to be replay protected")
+	}
+}
@@ -42,6 +42,7 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_VOLUNTARY_EXIT                      Topic = 15
 )
 
 var Topic_name = map[int32]string{
@@ -60,6 +61,7 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "VOLUNTARY_EXIT",
 }
 
 var Topic_value = map[string]int32{
@@ -78,6 +80,7 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"VOLUNTARY_EXIT":                      15,
 }
 
 func (x Topic) String() string {
@@ -1633,78 +1636,79 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x52, 0xe3, 0xc6,
-	0x13, 0xfe, 0xc9, 0xc0, 0x82, 0xdb, 0xc6, 0xeb, 0x1d, 0xf8, 0x81, 0x21, 0x8b, 0x01, 0x2d, 0xd4,
-	0x92, 0x54, 0xad, 0xbc, 0xb0, 0x17, 0x2e, 0xa9, 0x94, 0x6c, 0x94, 0x32, 0x81, 0xc8, 0x1b, 0xd9,
-	0x24, 0x95, 0x93, 0x6a, 0x6c, 0xcf, 0x62, 0x67, 0x8d, 0x46, 0xd1, 0x8c, 0x5d, 0x90, 0x5b, 0xaa,
-	0xf2, 0x0a, 0xb9, 0xe6, 0x96, 0x53, 0x2e, 0x79, 0x8c, 0x1c, 0xf3, 0x08, 0x29, 0x9e, 0x24, 0xa5,
-	0x99, 0x91, 0x2c, 0xff, 0x41, 0x70, 0xc8, 0xcd, 0xea, 0xfe, 0xfa, 0xeb, 0xfe, 0xbe, 0x99, 0x9e,
-	0x32, 0xe8, 0x7e, 0x40, 0x39, 0xad, 0xb4, 0x09, 0xee, 0x50, 0xaf, 0xe2, 0x9f, 0xf8, 0x95, 0xd1,
-	0x71, 0xe5, 0x86, 0x30, 0x86, 0xaf, 0x09, 0x33, 0x44, 0x12, 0x6d, 0x10, 0xde, 0x23, 0x01, 0x19,
-	0xde, 0x18, 0x12, 0x66, 0xf8, 0x27, 0xbe, 0x31, 0x3a, 0xde, 0xde, 0x9d, 0x57, 0xcb, 0xef, 0xfc,
-	0xa8, 0x70, 0xfb, 0x40, 0x02, 0x08, 0xef, 0x55, 0x46, 0xc7, 0x78, 0xe0, 0xf7, 0xf0, 0x71, 0x05,
-	0x73, 0x4e, 0x18, 0xc7, 0xbc, 0x1f, 0xf2, 0x08, 0xd4, 0xe1, 0x1c, 0x94, 0xe4, 0x74, 0xdb, 0x03,
-	0xda, 0xf9, 0xa8, 0x60, 0xfa, 0x1c, 0xd8, 0x08, 0x0f, 0xfa, 0x5d, 0xcc, 0x69, 0xa0, 0x30, 0xbb,
-	0xd7, 0x94, 0x5e, 0x0f, 0x48, 0x45, 0x7c, 0xb5, 0x87, 0x1f, 0x2a, 0xbc, 0x7f, 0x13, 0x76, 0xbb,
-	0xf1, 0x25, 0x40, 0xff, 0x59, 0x83, 0x15, 0xcb, 0x1b, 0x91, 0x01, 0xf5, 0x09, 0xda, 0x87, 0x3c,
-	0xf3, 0xb1, 0xe7, 0x76, 0xa8, 0xc7, 0xc9, 0x2d, 0x2f, 0x69, 0x7b, 0xda, 0x51, 0xde, 0xc9, 0x85,
-	0xb1, 0x9a, 0x0c, 0xa1, 0x12, 0x2c, 0xfb, 0xf8, 0x6e, 0x40, 0x71, 0xb7, 0x94, 0x11, 0xd9, 0xe8,
-	0x13, 0x9d, 0x42, 0x36, 0x26, 0x2f, 0x2d, 0xec, 0x69, 0x47, 0xb9, 0x93, 0x6d, 0x43, 0xb6, 0x37,
-	0xa2, 0xf6, 0x46, 0x2b, 0x42, 0x38, 0x63, 0xb0, 0xfe, 0x15, 0xac, 0x55, 0x85, 0xbc, 0x6a, 0xa8,
-	0xce, 0xf4, 0x3c, 0x3a, 0xf4, 0x3a, 0x04, 0x21, 0x58, 0xec, 0x61, 0xd6, 0x53, 0x53, 0x88, 0xdf,
-	0x68, 0x17, 0x72, 0x6c, 0x40, 0xb9, 0xeb, 0x0d, 0x6f, 0xda, 0x24, 0x10, 0x23, 0x2c, 0x3a, 0x10,
-	0x86, 0x6c, 0x11, 0xd1, 0x8f, 0x00, 0x25, 0xb8, 0x1c, 0xf2, 0xe3, 0x90, 0x30, 0x3e, 0x8f, 0x4a,
-	0x37, 0xa1, 0x3c, 0x8b, 0xac, 0xde, 0x35, 0x63, 0xae, 0xe9, 0x66, 0xda, 0x4c, 0xb3, 0x5f, 0xb5,
-	0x89, 0xc9, 0x1d, 0xc2, 0x7c, 0xea, 0x31, 0x82, 0x4e, 0x61, 0x49, 0x1c, 0x94, 0x28, 0xc9, 0x9d,
-	0xe8, 0x46, 0x7c, 0x5f, 0x08, 0xef, 0x19, 0xd1, 0x61, 0x19, 0xc9, 0x52, 0x59, 0x80, 0xce, 0x20,
-	0x97, 0xb8, 0x0f, 0x42, 0xdf, 0xc3, 0xf5, 0xe6, 0x18, 0xe9, 0x24, 0xcb, 0xf4, 0x3f, 0x34, 0xd8,
-	0xaa, 0x62, 0xde, 0xe9, 0x91, 0xee, 0x1c, 0x33, 0xf6, 0x01, 0x18, 0xc7, 0x01, 0x77, 0x43, 0x25,
-	0x52, 0x55, 0x35, 0x53, 0xd2, 0x9c, 0xac, 0x88, 0x86, 0xfa, 0xd1, 0x0e, 0xac, 0x10, 0xaf, 0x2b,
-	0x01, 0x99, 0x18, 0xb0, 0x4c, 0xbc, 0xae, 0x48, 0x1f, 0x42, 0xe1, 0x43, 0xdf, 0xc3, 0x83, 0xfe,
-	0x4f, 0xa4, 0xeb, 0x06, 0x94, 0x72, 0x71, 0xde, 0x79, 0x67, 0x35, 0x8e, 0x3a, 0x54, 0xc2, 0x3a,
-	0xd8, 0xa3, 0x5e, 0xbf, 0x83, 0x07, 0x12, 0xb6, 0x28, 0x61, 0x71, 0x34, 0x84, 0xe9, 0xd7, 0xb0,
-	0x3d, 0x6f, 0x58, 0xe5, 0xe5, 0x39, 0x14, 0xda, 0x32, 0x2b, 0x2f, 0x3f, 0x2b, 0x69, 0x7b, 0x0b,
-	0x4f, 0x34, 0x75, 0x55, 0x55, 0x8a, 0x2f, 0xa6, 0x23, 0x28, 0xd6, 0x7a, 0xb8, 0xef, 0xd5, 0x09,
-	0xee, 0x2a, 0x33, 0xf4, 0xdf, 0x32, 0xf0, 0x22, 0x11, 0x54, 0x4d, 0x27, 0x26, 0x1f, 0xdb, 0x94,
-	0x98, 0x5c, 0xf8, 0xf0, 0x39, 0x7c, 0x92, 0x80, 0x71, 0xcc, 0x89, 0x90, 0xe9, 0x86, 0xf7, 0xeb,
-	0xdd, 0x89, 0x5a, 0x90, 0xd2, 0xb8, 0x26, 0x44, 0x84, 0x92, 0xeb, 0x22, 0x8f, 0xbe, 0x80, 0x97,
-	0x63, 0x1b, 0x67, 0xca, 0x99, 0x32, 0x75, 0x2b, 0xc6, 0x4c, 0xd5, 0x33, 0xf4, 0x16, 0xd6, 0xc7,
-	0xfd, 0x85, 0x3b, 0x49, 0x9b, 0x51, 0x9c, 0x93, 0x6e, 0x84, 0x47, 0xf2, 0x16, 0xd6, 0xc7, 0x2d,
-	0x13, 0x15, 0x4b, 0xb2, 0x22, 0xce, 0xc5, 0x15, 0xfa, 0x1b, 0xd8, 0x94, 0x96, 0x8a, 0xee, 0x61,
-	0xe7, 0xb4, 0x05, 0xd5, 0xaf, 0xa2, 0xfd, 0x93, 0xc3, 0xaa, 0x2b, 0xf7, 0x98, 0x52, 0xed, 0x11,
-	0xa5, 0xfa, 0x9f, 0xf1, 0xa6, 0x29, 0x5e, 0x75, 0x50, 0x97, 0xf0, 0x7c, 0x8a, 0x58, 0xed, 0xdc,
-	0x2b, 0x63, 0xfe, 0x1b, 0x6d, 0x24, 0x59, 0x0a, 0x93, 0x0d, 0xd1, 0x45, 0x92, 0x4d, 0x6e, 0x70,
-	0xe6, 0xc9, 0x1b, 0x5c, 0x98, 0x34, 0x4f, 0xff, 0x14, 0xd6, 0x12, 0x0b, 0x9a, 0x6a, 0xda, 0x11,
-	0xa0, 0xe4, 0x2e, 0xa7, 0x3c, 0x5a, 0x74, 0x82, 0x34, 0xb6, 0x61, 0xde, 0x53, 0xf9, 0xdf, 0x3c,
-	0x25, 0x3f, 0xc0, 0xc6, 0x97, 0x13, 0x26, 0xc5, 0x42, 0x76, 0x00, 0x12, 0x17, 0x48, 0x76, 0xce,
-	0xb6, 0xe3, 0x9b, 0xb6, 0x23, 0x5e, 0x19, 0x75, 0xd0, 0x6a, 0x15, 0xb2, 0x2c, 0x3a, 0xd7, 0x70,
-	0x62, 0xb1, 0x57, 0x0b, 0x62, 0xaf, 0xc4, 0x6f, 0xdd, 0x80, 0xd2, 0xfb, 0x80, 0xfa, 0x94, 0x91,
-	0xa0, 0x39, 0xc0, 0xac, 0xd7, 0xf7, 0xae, 0x53, 0x6d, 0x7b, 0x03, 0x9b, 0xd3, 0xf8, 0x34, 0xef,
-	0x7e, 0xd1, 0x66, 0xf9, 0x53, 0x1d, 0x6c, 0xc1, 0x0b, 0x5f, 0xe1, 0x5d, 0xa6, 0x0a, 0x94, 0x8f,
-	0xaf, 0x1f, 0xf0, 0x71, 0x86, 0xbf, 0xe8, 0x4f, 0x45, 0x42, 0x95, 0xd2, 0xed, 0xa7, 0xab, 0x9c,
-	0xc6, 0x3f, 0xa6, 0x72, 0x16, 0x9f, 0xae, 0x32, 0xc2, 0x3f, 0x55, 0xe5, 0x0c, 0x7f, 0x71, 0x3a,
-	0xa2, 0x1f, 0xc2, 0xf3, 0x33, 0xe2, 0x53, 0xd6, 0xe7, 0xa9, 0xe2, 0x0e, 0xa0, 0xa0, 0x60, 0x69,
-	0x9a, 0xdc, 0x98, 0x2c, 0x55, 0xc9, 0x29, 0x2c, 0x77, 0x25, 0x4c, 0xcd, 0x5f, 0x7e, 0x60, 0xfe,
-	0x88, 0x2c, 0x82, 0xeb, 0x3a, 0xe4, 0xad, 0xdb, 0x47, 0x46, 0xdd, 0x87, 0x5c, 0x88, 0x49, 0xdf,
-	0xce, 0xbc, 0x84, 0xa4, 0x0c, 0x79, 0x01, 0x85, 0x11, 0x1d, 0x0c, 0x3d, 0x8e, 0x83, 0x3b, 0x97,
-	0xdc, 0xc6, 0xb3, 0x1e, 0x3c, 0x30, 0xeb, 0xb7, 0x11, 0x58, 0x30, 0xaf, 0x8e, 0x92, 0x9f, 0xba,
-	0x05, 0xd9, 0x3a, 0xf6, 0xba, 0xac, 0x87, 0x3f, 0x86, 0xff, 0x3a, 0x4a, 0x4a, 0x8f, 0xf8, 0x03,
-	0x17, 0xe0, 0x0e, 0x77, 0x71, 0xb7, 0x1b, 0x10, 0x26, 0x1f, 0xd8, 0xac, 0xb3, 0xa1, 0xf2, 0x35,
-	0x95, 0x36, 0x65, 0xf6, 0xb3, 0xdf, 0x17, 0x60, 0xa9, 0x45, 0xfd, 0x7e, 0x07, 0xe5, 0x60, 0xf9,
-	0xca, 0xbe, 0xb0, 0x1b, 0xdf, 0xd9, 0xc5, 0xff, 0xa1, 0x2d, 0xf8, 0x7f, 0xd5, 0x32, 0x6b, 0x0d,
-	0xdb, 0xad, 0x5e, 0x36, 0x6a, 0x17, 0xae, 0x69, 0xdb, 0x8d, 0x2b, 0xbb, 0x66, 0x15, 0x35, 0x54,
-	0x82, 0xf5, 0x89, 0x94, 0x63, 0x7d, 0x73, 0x65, 0x35, 0x5b, 0xc5, 0x0c, 0x7a, 0x0d, 0xaf, 0xe6,
-	0x65, 0xdc, 0xea, 0xf7, 0x6e, 0xf3, 0xb2, 0xd1, 0x72, 0xed, 0xab, 0xaf, 0xab, 0x96, 0x53, 0x5c,
-	0x98, 0x61, 0x77, 0xac, 0xe6, 0xfb, 0x86, 0xdd, 0xb4, 0x8a, 0x8b, 0x68, 0x0f, 0x5e, 0x56, 0xcd,
-	0x56, 0xad, 0x6e, 0x9d, 0xb9, 0x73, 0xbb, 0x2c, 0xa1, 0x7d, 0xd8, 0x79, 0x00, 0xa1, 0x48, 0x9e,
-	0xa1, 0x0d, 0x40, 0xb5, 0xba, 0x79, 0x6e, 0xbb, 0x75, 0xcb, 0x3c, 0x8b, 0x4b, 0x97, 0xd1, 0x26,
-	0xac, 0x4d, 0xc4, 0x55, 0xc1, 0x0a, 0x2a, 0xc3, 0xb6, 0xe2, 0x6a, 0xb6, 0xcc, 0x96, 0xe5, 0xd6,
-	0xcd, 0x66, 0x7d, 0xac, 0x39, 0x9b, 0xd0, 0x2c, 0xf3, 0x11, 0x25, 0x24, 0xa4, 0x44, 0x19, 0x45,
-	0x9a, 0x0b, 0x8b, 0xcc, 0x56, 0xcb, 0x0a, 0xe3, 0xe7, 0x0d, 0x7b, 0x4c, 0x97, 0x0f, 0xe7, 0x48,
-	0x66, 0x22, 0xb6, 0xd5, 0xe9, 0x92, 0x98, 0xac, 0x50, 0xcd, 0xff, 0x75, 0x5f, 0xd6, 0xfe, 0xbe,
-	0x2f, 0x6b, 0xff, 0xdc, 0x97, 0xb5, 0xf6, 0x33, 0xf1, 0xaf, 0xfa, 0xdd, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0xb0, 0x5f, 0xa2, 0xb2, 0x0c, 0x00, 0x00,
+	// 1147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x52, 0xe3, 0x46,
+	0x10, 0x8e, 0x0c, 0x2c, 0xeb, 0xb6, 0x31, 0xde, 0x81, 0x80, 0x21, 0x8b, 0x01, 0x2d, 0xd4, 0x92,
+	0x54, 0xad, 0xbc, 0xb0, 0x17, 0x2e, 0xa9, 0x94, 0x6c, 0x94, 0x32, 0x81, 0xc8, 0x1b, 0x59, 0xde,
+	0x64, 0x4f, 0xaa, 0xb1, 0x3d, 0x8b, 0x9d, 0x35, 0x1a, 0x45, 0x1a, 0xbb, 0x20, 0xb7, 0x54, 0xe5,
+	0x15, 0x72, 0xcd, 0x0b, 0xe4, 0x92, 0x6b, 0xde, 0x20, 0xc7, 0x3c, 0x42, 0x8a, 0x27, 0x49, 0x69,
+	0x66, 0x24, 0xcb, 0x3f, 0x08, 0x0e, 0xb9, 0x59, 0xdd, 0x5f, 0x7f, 0xdd, 0xdf, 0x37, 0xd3, 0x53,
+	0x06, 0xd5, 0xf3, 0x29, 0xa3, 0x95, 0x36, 0xc1, 0x1d, 0xea, 0x56, 0xbc, 0x13, 0xaf, 0x32, 0x3a,
+	0xae, 0x5c, 0x93, 0x20, 0xc0, 0x57, 0x24, 0xd0, 0x78, 0x12, 0x6d, 0x10, 0xd6, 0x23, 0x3e, 0x19,
+	0x5e, 0x6b, 0x02, 0xa6, 0x79, 0x27, 0x9e, 0x36, 0x3a, 0xde, 0xde, 0x9d, 0x57, 0xcb, 0x6e, 0xbd,
+	0xa8, 0x70, 0xfb, 0x40, 0x00, 0x08, 0xeb, 0x55, 0x46, 0xc7, 0x78, 0xe0, 0xf5, 0xf0, 0x71, 0x05,
+	0x33, 0x46, 0x02, 0x86, 0x59, 0x3f, 0xe4, 0xe1, 0xa8, 0xc3, 0x39, 0x28, 0xc1, 0xe9, 0xb4, 0x07,
+	0xb4, 0xf3, 0x51, 0xc2, 0xd4, 0x39, 0xb0, 0x11, 0x1e, 0xf4, 0xbb, 0x98, 0x51, 0x5f, 0x62, 0x76,
+	0xaf, 0x28, 0xbd, 0x1a, 0x90, 0x0a, 0xff, 0x6a, 0x0f, 0x3f, 0x54, 0x58, 0xff, 0x3a, 0xec, 0x76,
+	0xed, 0x09, 0x80, 0xfa, 0x8b, 0x02, 0x4f, 0x0d, 0x77, 0x44, 0x06, 0xd4, 0x23, 0x68, 0x1f, 0xf2,
+	0x81, 0x87, 0x5d, 0xa7, 0x43, 0x5d, 0x46, 0x6e, 0x58, 0x49, 0xd9, 0x53, 0x8e, 0xf2, 0x56, 0x2e,
+	0x8c, 0xd5, 0x44, 0x08, 0x95, 0x60, 0xd9, 0xc3, 0xb7, 0x03, 0x8a, 0xbb, 0xa5, 0x0c, 0xcf, 0x46,
+	0x9f, 0xe8, 0x14, 0xb2, 0x31, 0x79, 0x69, 0x61, 0x4f, 0x39, 0xca, 0x9d, 0x6c, 0x6b, 0xa2, 0xbd,
+	0x16, 0xb5, 0xd7, 0xec, 0x08, 0x61, 0x8d, 0xc1, 0xea, 0x37, 0xb0, 0x56, 0xe5, 0xf2, 0xaa, 0xa1,
+	0x3a, 0xdd, 0x75, 0xe9, 0xd0, 0xed, 0x10, 0x84, 0x60, 0xb1, 0x87, 0x83, 0x9e, 0x9c, 0x82, 0xff,
+	0x46, 0xbb, 0x90, 0x0b, 0x06, 0x94, 0x39, 0xee, 0xf0, 0xba, 0x4d, 0x7c, 0x3e, 0xc2, 0xa2, 0x05,
+	0x61, 0xc8, 0xe4, 0x11, 0xf5, 0x08, 0x50, 0x82, 0xcb, 0x22, 0x3f, 0x0d, 0x49, 0xc0, 0xe6, 0x51,
+	0xa9, 0x3a, 0x94, 0x67, 0x91, 0xd5, 0xdb, 0x66, 0xcc, 0x35, 0xdd, 0x4c, 0x99, 0x69, 0xf6, 0x9b,
+	0x32, 0x31, 0xb9, 0x45, 0x02, 0x8f, 0xba, 0x01, 0x41, 0xa7, 0xb0, 0xc4, 0x0f, 0x8a, 0x97, 0xe4,
+	0x4e, 0x54, 0x2d, 0xbe, 0x2f, 0x84, 0xf5, 0xb4, 0xe8, 0xb0, 0xb4, 0x64, 0xa9, 0x28, 0x40, 0x67,
+	0x90, 0x4b, 0xdc, 0x07, 0xae, 0xef, 0xfe, 0x7a, 0x7d, 0x8c, 0xb4, 0x92, 0x65, 0xea, 0x1f, 0x0a,
+	0x6c, 0x55, 0x31, 0xeb, 0xf4, 0x48, 0x77, 0x8e, 0x19, 0xfb, 0x00, 0x01, 0xc3, 0x3e, 0x73, 0x42,
+	0x25, 0x42, 0x55, 0x35, 0x53, 0x52, 0xac, 0x2c, 0x8f, 0x86, 0xfa, 0xd1, 0x0e, 0x3c, 0x25, 0x6e,
+	0x57, 0x00, 0x32, 0x31, 0x60, 0x99, 0xb8, 0x5d, 0x9e, 0x3e, 0x84, 0xc2, 0x87, 0xbe, 0x8b, 0x07,
+	0xfd, 0x9f, 0x49, 0xd7, 0xf1, 0x29, 0x65, 0xfc, 0xbc, 0xf3, 0xd6, 0x4a, 0x1c, 0xb5, 0xa8, 0x80,
+	0x75, 0xb0, 0x4b, 0xdd, 0x7e, 0x07, 0x0f, 0x04, 0x6c, 0x51, 0xc0, 0xe2, 0x68, 0x08, 0x53, 0xaf,
+	0x60, 0x7b, 0xde, 0xb0, 0xd2, 0xcb, 0x73, 0x28, 0xb4, 0x45, 0x56, 0x5c, 0xfe, 0xa0, 0xa4, 0xec,
+	0x2d, 0x3c, 0xd2, 0xd4, 0x15, 0x59, 0xc9, 0xbf, 0x02, 0x15, 0x41, 0xb1, 0xd6, 0xc3, 0x7d, 0xb7,
+	0x4e, 0x70, 0x57, 0x9a, 0xa1, 0xfe, 0x9e, 0x81, 0x67, 0x89, 0xa0, 0x6c, 0x3a, 0x31, 0xf9, 0xd8,
+	0xa6, 0xc4, 0xe4, 0xdc, 0x87, 0x2f, 0xe1, 0xb3, 0x04, 0x8c, 0x61, 0x46, 0xb8, 0x4c, 0x27, 0xbc,
+	0x5f, 0x6f, 0x4e, 0xe4, 0x82, 0x94, 0xc6, 0x35, 0x21, 0x22, 0x94, 0x5c, 0xe7, 0x79, 0xf4, 0x15,
+	0x3c, 0x1f, 0xdb, 0x38, 0x53, 0x1e, 0x48, 0x53, 0xb7, 0x62, 0xcc, 0x54, 0x7d, 0x80, 0x5e, 0xc3,
+	0xfa, 0xb8, 0x3f, 0x77, 0x27, 0x69, 0x33, 0x8a, 0x73, 0xc2, 0x8d, 0xf0, 0x48, 0x5e, 0xc3, 0xfa,
+	0xb8, 0x65, 0xa2, 0x62, 0x49, 0x54, 0xc4, 0xb9, 0xb8, 0x42, 0x7d, 0x05, 0x9b, 0xc2, 0x52, 0xde,
+	0x3d, 0xec, 0x9c, 0xb6, 0xa0, 0x6a, 0x2b, 0xda, 0x3f, 0x31, 0xac, 0xbc, 0x72, 0x0f, 0x29, 0x55,
+	0x1e, 0x50, 0xaa, 0xfe, 0x19, 0x6f, 0x9a, 0xe4, 0x95, 0x07, 0x75, 0x09, 0xab, 0x53, 0xc4, 0x72,
+	0xe7, 0x5e, 0x68, 0xf3, 0xdf, 0x68, 0x2d, 0xc9, 0x52, 0x98, 0x6c, 0x88, 0x2e, 0x92, 0x6c, 0x62,
+	0x83, 0x33, 0x8f, 0xde, 0xe0, 0xc2, 0xa4, 0x79, 0xea, 0xe7, 0xb0, 0x96, 0x58, 0xd0, 0x54, 0xd3,
+	0x8e, 0x00, 0x25, 0x77, 0x39, 0xe5, 0xd1, 0xa2, 0x13, 0xa4, 0xb1, 0x0d, 0xf3, 0x9e, 0xca, 0xff,
+	0xe7, 0x29, 0xf9, 0x11, 0x36, 0xbe, 0x9e, 0x30, 0x29, 0x16, 0xb2, 0x03, 0x90, 0xb8, 0x40, 0xa2,
+	0x73, 0xb6, 0x1d, 0xdf, 0xb4, 0x1d, 0xfe, 0xca, 0xc8, 0x83, 0x96, 0xab, 0x90, 0x0d, 0xa2, 0x73,
+	0x0d, 0x27, 0xe6, 0x7b, 0xb5, 0xc0, 0xf7, 0x8a, 0xff, 0x56, 0x35, 0x28, 0xbd, 0xf5, 0xa9, 0x47,
+	0x03, 0xe2, 0x37, 0x07, 0x38, 0xe8, 0xf5, 0xdd, 0xab, 0x54, 0xdb, 0x5e, 0xc1, 0xe6, 0x34, 0x3e,
+	0xcd, 0xbb, 0x5f, 0x95, 0x59, 0xfe, 0x54, 0x07, 0x6d, 0x78, 0xe6, 0x49, 0xbc, 0x13, 0xc8, 0x02,
+	0xe9, 0xe3, 0xcb, 0x7b, 0x7c, 0x9c, 0xe1, 0x2f, 0x7a, 0x53, 0x91, 0x50, 0xa5, 0x70, 0xfb, 0xf1,
+	0x2a, 0xa7, 0xf1, 0x0f, 0xa9, 0x9c, 0xc5, 0xa7, 0xab, 0x8c, 0xf0, 0x8f, 0x55, 0x39, 0xc3, 0x5f,
+	0x9c, 0x8e, 0xa8, 0x87, 0xb0, 0x7a, 0x46, 0x3c, 0x1a, 0xf4, 0x59, 0xaa, 0xb8, 0x03, 0x28, 0x48,
+	0x58, 0x9a, 0x26, 0x27, 0x26, 0x4b, 0x55, 0x72, 0x0a, 0xcb, 0x5d, 0x01, 0x93, 0xf3, 0x97, 0xef,
+	0x99, 0x3f, 0x22, 0x8b, 0xe0, 0xaa, 0x0a, 0x79, 0xe3, 0xe6, 0x81, 0x51, 0xf7, 0x21, 0x17, 0x62,
+	0xd2, 0xb7, 0x33, 0x2f, 0x20, 0x29, 0x43, 0x5e, 0x40, 0x61, 0x44, 0x07, 0x43, 0x97, 0x61, 0xff,
+	0xd6, 0x21, 0x37, 0xf1, 0xac, 0x07, 0xf7, 0xcc, 0xfa, 0x2e, 0x02, 0x73, 0xe6, 0x95, 0x51, 0xf2,
+	0x53, 0x35, 0x20, 0x5b, 0xc7, 0x6e, 0x37, 0xe8, 0xe1, 0x8f, 0xe1, 0xbf, 0x8e, 0x92, 0xd4, 0xc3,
+	0xff, 0xc0, 0xf9, 0xb8, 0xc3, 0x1c, 0xdc, 0xed, 0xfa, 0x24, 0x10, 0x0f, 0x6c, 0xd6, 0xda, 0x90,
+	0xf9, 0x9a, 0x4c, 0xeb, 0x22, 0xfb, 0xc5, 0x5f, 0x0b, 0xb0, 0x64, 0x53, 0xaf, 0xdf, 0x41, 0x39,
+	0x58, 0x6e, 0x99, 0x17, 0x66, 0xe3, 0x7b, 0xb3, 0xf8, 0x09, 0xda, 0x82, 0x4f, 0xab, 0x86, 0x5e,
+	0x6b, 0x98, 0x4e, 0xf5, 0xb2, 0x51, 0xbb, 0x70, 0x74, 0xd3, 0x6c, 0xb4, 0xcc, 0x9a, 0x51, 0x54,
+	0x50, 0x09, 0xd6, 0x27, 0x52, 0x96, 0xf1, 0x5d, 0xcb, 0x68, 0xda, 0xc5, 0x0c, 0x7a, 0x09, 0x2f,
+	0xe6, 0x65, 0x9c, 0xea, 0x7b, 0xa7, 0x79, 0xd9, 0xb0, 0x1d, 0xb3, 0xf5, 0x6d, 0xd5, 0xb0, 0x8a,
+	0x0b, 0x33, 0xec, 0x96, 0xd1, 0x7c, 0xdb, 0x30, 0x9b, 0x46, 0x71, 0x11, 0xed, 0xc1, 0xf3, 0xaa,
+	0x6e, 0xd7, 0xea, 0xc6, 0x99, 0x33, 0xb7, 0xcb, 0x12, 0xda, 0x87, 0x9d, 0x7b, 0x10, 0x92, 0xe4,
+	0x09, 0xda, 0x00, 0x54, 0xab, 0xeb, 0xe7, 0xa6, 0x53, 0x37, 0xf4, 0xb3, 0xb8, 0x74, 0x19, 0x6d,
+	0xc2, 0xda, 0x44, 0x5c, 0x16, 0x3c, 0x45, 0x65, 0xd8, 0x96, 0x5c, 0x4d, 0x5b, 0xb7, 0x0d, 0xa7,
+	0xae, 0x37, 0xeb, 0x63, 0xcd, 0xd9, 0x84, 0x66, 0x91, 0x8f, 0x28, 0x21, 0x21, 0x25, 0xca, 0x48,
+	0xd2, 0x5c, 0x58, 0xa4, 0xdb, 0xb6, 0x11, 0xc6, 0xcf, 0x1b, 0xe6, 0x98, 0x2e, 0x1f, 0xce, 0x91,
+	0xcc, 0x44, 0x6c, 0x2b, 0xd3, 0x25, 0x31, 0x59, 0x01, 0x21, 0x28, 0xbc, 0x6b, 0x5c, 0xb6, 0x4c,
+	0x5b, 0xb7, 0xde, 0x3b, 0xc6, 0x0f, 0xe7, 0x76, 0x71, 0xb5, 0x9a, 0xff, 0xfb, 0xae, 0xac, 0xfc,
+	0x73, 0x57, 0x56, 0xfe, 0xbd, 0x2b, 0x2b, 0xed, 0x27, 0xfc, 0x9f, 0xf6, 0x9b, 0xff, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0x4c, 0x14, 0x2a, 0x91, 0xc6, 0x0c, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
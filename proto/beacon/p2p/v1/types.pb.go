@@ -625,6 +625,132 @@ func (m *CompactCommittee) GetCompactValidators() []uint64 {
 	return nil
 }
 
+type StoredDeposit struct {
+	Deposit              *v1alpha1.Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Eth1BlockHeight      uint64            `protobuf:"varint,2,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	Index                uint64            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	DepositRoot          []byte            `protobuf:"bytes,4,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StoredDeposit) Reset()         { *m = StoredDeposit{} }
+func (m *StoredDeposit) String() string { return proto.CompactTextString(m) }
+func (*StoredDeposit) ProtoMessage()    {}
+func (*StoredDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{7}
+}
+func (m *StoredDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoredDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredDeposit.Merge(m, src)
+}
+func (m *StoredDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredDeposit proto.InternalMessageInfo
+
+func (m *StoredDeposit) GetDeposit() *v1alpha1.Deposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *StoredDeposit) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
+func (m *StoredDeposit) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *StoredDeposit) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+type ChainStartData struct {
+	GenesisTime          uint64             `protobuf:"varint,1,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	Eth1Data             *v1alpha1.Eth1Data `protobuf:"bytes,2,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ChainStartData) Reset()         { *m = ChainStartData{} }
+func (m *ChainStartData) String() string { return proto.CompactTextString(m) }
+func (*ChainStartData) ProtoMessage()    {}
+func (*ChainStartData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{8}
+}
+func (m *ChainStartData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStartData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStartData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStartData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStartData.Merge(m, src)
+}
+func (m *ChainStartData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStartData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStartData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStartData proto.InternalMessageInfo
+
+func (m *ChainStartData) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
+}
+
+func (m *ChainStartData) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BeaconState)(nil), "ethereum.beacon.p2p.v1.BeaconState")
 	proto.RegisterType((*Fork)(nil), "ethereum.beacon.p2p.v1.Fork")
@@ -633,94 +759,103 @@ func init() {
 	proto.RegisterType((*AttestationDataAndCustodyBit)(nil), "ethereum.beacon.p2p.v1.AttestationDataAndCustodyBit")
 	proto.RegisterType((*HistoricalBatch)(nil), "ethereum.beacon.p2p.v1.HistoricalBatch")
 	proto.RegisterType((*CompactCommittee)(nil), "ethereum.beacon.p2p.v1.CompactCommittee")
+	proto.RegisterType((*StoredDeposit)(nil), "ethereum.beacon.p2p.v1.StoredDeposit")
+	proto.RegisterType((*ChainStartData)(nil), "ethereum.beacon.p2p.v1.ChainStartData")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_e719e7d82cfa7b0d) }
 
 var fileDescriptor_e719e7d82cfa7b0d = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x8f, 0xdb, 0xc4,
-	0x1b, 0x96, 0x77, 0xf3, 0xfb, 0xb5, 0x9d, 0x6c, 0x37, 0xc9, 0x6c, 0xd5, 0x35, 0x6d, 0x59, 0x07,
-	0x8b, 0xb6, 0x2b, 0xd4, 0x4d, 0x9a, 0x74, 0x9b, 0xec, 0xb6, 0x14, 0xd4, 0x6c, 0xbb, 0x2a, 0x48,
-	0x48, 0xc8, 0x2d, 0x95, 0x90, 0x10, 0xd6, 0xc4, 0x9e, 0xb5, 0x87, 0x75, 0x3c, 0x96, 0x67, 0x12,
-	0x75, 0x8b, 0x10, 0x07, 0x4e, 0x7c, 0x48, 0x1c, 0xe0, 0x04, 0x27, 0xb8, 0xf1, 0xf1, 0x0f, 0x00,
-	0x27, 0x38, 0x71, 0xe4, 0xeb, 0x02, 0x87, 0x08, 0xf5, 0x06, 0x9c, 0xc8, 0x91, 0x13, 0x9a, 0x19,
-	0xdb, 0x71, 0xb6, 0x9b, 0x36, 0x02, 0x6e, 0xf6, 0x3b, 0xcf, 0xf3, 0xbc, 0xf3, 0x7e, 0xcc, 0xcc,
-	0x0b, 0x8c, 0x28, 0xa6, 0x9c, 0xd6, 0xbb, 0x18, 0x39, 0x34, 0xac, 0x47, 0xcd, 0xa8, 0x3e, 0x68,
-	0xd4, 0xf9, 0x5e, 0x84, 0x59, 0x4d, 0xae, 0xc0, 0xe3, 0x98, 0xfb, 0x38, 0xc6, 0xfd, 0x5e, 0x4d,
-	0x61, 0x6a, 0x51, 0x33, 0xaa, 0x0d, 0x1a, 0x27, 0x1e, 0x57, 0x44, 0xcc, 0xfd, 0xfa, 0xa0, 0x81,
-	0x82, 0xc8, 0x47, 0x8d, 0x3a, 0xe2, 0x1c, 0x33, 0x8e, 0x38, 0x11, 0x30, 0xb1, 0x7c, 0xe2, 0xf4,
-	0x01, 0x28, 0xa5, 0x63, 0x77, 0x03, 0xea, 0xec, 0x26, 0x30, 0xf3, 0x00, 0xd8, 0x00, 0x05, 0xc4,
-	0x45, 0x9c, 0xc6, 0x09, 0x66, 0xcd, 0x23, 0xdc, 0xef, 0x77, 0x6b, 0x0e, 0xed, 0xd5, 0x3d, 0xea,
-	0xd1, 0xba, 0x34, 0x77, 0xfb, 0x3b, 0xf2, 0x4f, 0x09, 0x88, 0x2f, 0x05, 0x37, 0x3f, 0x2c, 0x81,
-	0x62, 0x47, 0x7a, 0xba, 0xc9, 0x11, 0xc7, 0xd0, 0x04, 0x0b, 0x1e, 0x0e, 0x31, 0x23, 0xcc, 0xe6,
-	0xa4, 0x87, 0xf5, 0xdf, 0x0e, 0x55, 0xb5, 0xd5, 0x82, 0x55, 0x4c, 0x8c, 0xb7, 0x48, 0x0f, 0xc3,
-	0x25, 0x50, 0x60, 0x01, 0xe5, 0xfa, 0xef, 0x6a, 0x4d, 0xfe, 0xc0, 0x06, 0x28, 0xec, 0xd0, 0x78,
-	0x57, 0xff, 0x43, 0x18, 0x8b, 0xcd, 0x53, 0xb5, 0x83, 0x13, 0x52, 0xdb, 0xa6, 0xf1, 0xae, 0x25,
-	0xa1, 0xf0, 0x45, 0xb0, 0x14, 0x20, 0x91, 0x0a, 0x15, 0xa4, 0xed, 0x63, 0xe4, 0xe2, 0x58, 0xff,
-	0xbe, 0x24, 0x15, 0x56, 0xc7, 0x0a, 0x98, 0xfb, 0xb5, 0x34, 0xe0, 0x9a, 0xda, 0x6d, 0x47, 0x30,
-	0x6e, 0x48, 0x82, 0x55, 0x51, 0x2a, 0x39, 0x13, 0xdc, 0x00, 0x45, 0xa5, 0x19, 0x53, 0xca, 0x99,
-	0xfe, 0x43, 0xa9, 0x3a, 0xbf, 0xba, 0xd0, 0x39, 0x3e, 0x1a, 0x1a, 0x90, 0xb1, 0xbb, 0x6b, 0x8c,
-	0xdc, 0xc5, 0x97, 0xcc, 0x8d, 0xc6, 0x66, 0xf3, 0xdc, 0x85, 0xa6, 0x69, 0x01, 0x89, 0xb5, 0x04,
-	0x54, 0x30, 0x45, 0x6d, 0x70, 0xc2, 0xfc, 0xf1, 0x21, 0x4c, 0x89, 0x55, 0x4c, 0x0b, 0x94, 0x7d,
-	0xc2, 0x38, 0x8d, 0x89, 0x83, 0x82, 0x84, 0xfe, 0x93, 0xa2, 0x9f, 0x19, 0x0d, 0x0d, 0x73, 0x4c,
-	0x7f, 0x5a, 0x70, 0xab, 0xe2, 0xbf, 0x87, 0xee, 0x5c, 0x32, 0x1b, 0xad, 0x76, 0xbb, 0xdd, 0x6c,
-	0xb4, 0x4c, 0xab, 0x34, 0x16, 0x50, 0x9a, 0x57, 0xc0, 0x11, 0xcc, 0xfd, 0x86, 0xed, 0x22, 0x8e,
-	0xf4, 0x2f, 0x96, 0x65, 0x62, 0x8c, 0x29, 0x89, 0xb9, 0xce, 0xfd, 0xc6, 0x35, 0xc4, 0x91, 0x75,
-	0x18, 0x27, 0x5f, 0xf0, 0x25, 0x50, 0xca, 0xe8, 0xf6, 0x80, 0x72, 0xcc, 0xf4, 0x2f, 0x97, 0xab,
-	0xf3, 0x33, 0x88, 0x74, 0xe0, 0x68, 0x68, 0x2c, 0x8e, 0xb7, 0x78, 0xbe, 0xb9, 0x6e, 0x5a, 0x47,
-	0x53, 0xe1, 0xdb, 0x42, 0x0a, 0xae, 0x01, 0xa8, 0xd4, 0x71, 0x44, 0x19, 0xe1, 0x36, 0x09, 0x5d,
-	0x7c, 0x47, 0xff, 0x6a, 0x59, 0x76, 0x45, 0x59, 0x62, 0xd5, 0xca, 0x33, 0x62, 0x01, 0xbe, 0x0c,
-	0x40, 0xd6, 0xac, 0x4c, 0xff, 0xc8, 0x90, 0xfb, 0xa8, 0x4e, 0xd9, 0xc7, 0xed, 0x14, 0xd9, 0x39,
-	0x39, 0x1a, 0x1a, 0xcb, 0xb9, 0x8d, 0x6c, 0x6e, 0x5e, 0x6c, 0x34, 0x5a, 0xcd, 0x76, 0xbb, 0xdd,
-	0x32, 0xad, 0x9c, 0x22, 0xdc, 0x00, 0x87, 0xbb, 0x28, 0x40, 0xa1, 0x83, 0x99, 0xfe, 0xb1, 0x50,
-	0x2f, 0x3c, 0x98, 0x9b, 0xa1, 0x61, 0x55, 0xd6, 0x3c, 0xe6, 0x36, 0xf3, 0x51, 0xec, 0xea, 0x6f,
-	0x9e, 0x95, 0x11, 0x00, 0x69, 0xbb, 0x29, 0x4c, 0xf0, 0x32, 0x58, 0x88, 0x51, 0xe8, 0x22, 0x6a,
-	0xf7, 0xc8, 0x1d, 0xcc, 0xf4, 0xb7, 0xce, 0xca, 0xba, 0x2e, 0x8f, 0x86, 0xc6, 0xd2, 0xb8, 0xae,
-	0xad, 0x8b, 0x17, 0x2f, 0xb4, 0x64, 0x5f, 0x14, 0x15, 0xfa, 0x39, 0x01, 0x86, 0xdb, 0x00, 0x22,
-	0x87, 0x93, 0x01, 0x56, 0x19, 0x4a, 0x5a, 0xe3, 0xed, 0x87, 0x48, 0x94, 0x15, 0x47, 0xe6, 0x2e,
-	0x6d, 0x30, 0xdd, 0xa1, 0xbd, 0x08, 0x39, 0xdc, 0x76, 0x68, 0xaf, 0x47, 0x38, 0xc7, 0x98, 0x25,
-	0x6a, 0xef, 0x3c, 0x44, 0xed, 0x78, 0xc2, 0xdc, 0xca, 0x88, 0x4a, 0xb3, 0x09, 0x8e, 0xb0, 0x00,
-	0x31, 0x9f, 0x84, 0x1e, 0xd3, 0xff, 0xac, 0xc9, 0xac, 0x2d, 0x8d, 0x86, 0x46, 0x69, 0xb2, 0xd9,
-	0x4d, 0x6b, 0x0c, 0x83, 0xaf, 0x83, 0x93, 0x51, 0x8c, 0x07, 0x84, 0xf6, 0x99, 0x8d, 0x23, 0xea,
-	0xf8, 0x76, 0xee, 0x46, 0x63, 0xfa, 0xcf, 0x2d, 0x59, 0xd9, 0x27, 0xa6, 0xdd, 0x00, 0xcf, 0xe3,
-	0xd0, 0x25, 0xa1, 0x77, 0x75, 0xcc, 0xd9, 0xd7, 0x6c, 0xca, 0xe1, 0x23, 0xa9, 0x8f, 0xeb, 0xc2,
-	0x45, 0x0e, 0xcd, 0xe0, 0x6b, 0xe0, 0x84, 0xd3, 0x8f, 0x63, 0x1c, 0xf2, 0x83, 0xfc, 0xff, 0xf2,
-	0xdf, 0xf8, 0xd7, 0x13, 0x17, 0xf7, 0xbb, 0xf7, 0xc0, 0x52, 0x16, 0xbf, 0x13, 0x53, 0xc6, 0x02,
-	0x12, 0xee, 0x32, 0xfd, 0xeb, 0xa7, 0x1e, 0xd8, 0xd1, 0x5b, 0x29, 0x72, 0x7f, 0x7e, 0xd5, 0xd9,
-	0x82, 0xa9, 0x64, 0x86, 0x63, 0x10, 0x03, 0x98, 0xc6, 0x99, 0xf3, 0xf3, 0xcd, 0xbf, 0xf2, 0x53,
-	0x49, 0x14, 0x73, 0x6e, 0x18, 0x80, 0xaf, 0xf4, 0x19, 0x27, 0x3b, 0xc4, 0x91, 0x11, 0xda, 0x5d,
-	0xc2, 0x99, 0xfe, 0xc9, 0x76, 0x55, 0x5b, 0x5d, 0xe8, 0x6c, 0x8d, 0x86, 0xc6, 0x42, 0x4e, 0xc4,
-	0xfc, 0x6b, 0x68, 0xd4, 0x73, 0x6f, 0x4c, 0x14, 0xef, 0xb1, 0x1e, 0xe2, 0xc4, 0x09, 0x50, 0x97,
-	0xd5, 0x3d, 0xba, 0xd6, 0x25, 0x7c, 0x87, 0xe0, 0xc0, 0xad, 0x75, 0x08, 0x1f, 0x60, 0x87, 0xd3,
-	0x78, 0xdd, 0xaa, 0x4c, 0xe8, 0x77, 0x08, 0x67, 0x70, 0x07, 0x3c, 0x9a, 0x25, 0x31, 0x59, 0xc5,
-	0xae, 0xed, 0xf8, 0xd8, 0xd9, 0x8d, 0x28, 0x09, 0xb9, 0xfe, 0xe9, 0xb6, 0xbc, 0xed, 0x1e, 0x9b,
-	0x16, 0x66, 0x86, 0xb4, 0xb2, 0x6e, 0x7c, 0x36, 0xd5, 0x19, 0x2f, 0x42, 0x17, 0x9c, 0x4a, 0x73,
-	0x78, 0xa0, 0x9b, 0xcf, 0x66, 0x76, 0x93, 0xf6, 0xdc, 0x41, 0x5e, 0x5e, 0x00, 0xc7, 0x76, 0x48,
-	0x88, 0x02, 0x72, 0x77, 0x52, 0xfd, 0xf3, 0x99, 0xd5, 0x97, 0x32, 0xfe, 0xd8, 0x68, 0xbe, 0xaf,
-	0x81, 0x82, 0x78, 0x30, 0xe1, 0x65, 0x50, 0xce, 0xb2, 0x35, 0xc0, 0x31, 0x23, 0x34, 0xd4, 0x35,
-	0x59, 0x9f, 0xf2, 0x64, 0x7d, 0xd6, 0x4d, 0xab, 0x94, 0x22, 0x6f, 0x2b, 0x20, 0xdc, 0x04, 0xa5,
-	0x34, 0x05, 0x29, 0x77, 0x6e, 0x0a, 0x77, 0x31, 0x01, 0xa6, 0xd4, 0x63, 0xe0, 0x7f, 0xf2, 0x84,
-	0xe9, 0xf3, 0xf2, 0x4a, 0x54, 0x3f, 0xe6, 0xbb, 0x73, 0x00, 0xde, 0x7f, 0x8a, 0x60, 0x0f, 0x94,
-	0x91, 0xe7, 0xc5, 0xd8, 0xcb, 0x75, 0x91, 0xda, 0x64, 0x67, 0xe2, 0x7c, 0xad, 0x9f, 0xdf, 0x6c,
-	0x89, 0x36, 0x3a, 0x37, 0x6b, 0x1b, 0x05, 0x84, 0x71, 0xab, 0x94, 0xd3, 0x96, 0x1d, 0x74, 0x09,
-	0x14, 0xe4, 0xb3, 0x38, 0x27, 0x53, 0x7c, 0x66, 0x4a, 0x8a, 0x73, 0x1b, 0x94, 0x8f, 0xa3, 0xe4,
-	0xc0, 0xb3, 0xa0, 0x44, 0x42, 0x27, 0xe8, 0x8b, 0x20, 0x6d, 0x17, 0x07, 0x68, 0x2f, 0x89, 0x70,
-	0x31, 0x33, 0x5f, 0x13, 0x56, 0x78, 0x1a, 0x2c, 0x46, 0x31, 0x8d, 0x28, 0xc3, 0x71, 0xf2, 0xbe,
-	0x15, 0x24, 0xee, 0x68, 0x6a, 0x95, 0xf7, 0xb3, 0xf9, 0x81, 0x06, 0x2a, 0x39, 0x4f, 0xb7, 0x50,
-	0xec, 0x61, 0x0e, 0x61, 0x32, 0x28, 0x69, 0xb9, 0x39, 0xe9, 0x0a, 0xa8, 0xe4, 0x27, 0x3b, 0x79,
-	0x7d, 0x27, 0xe5, 0xa8, 0x8c, 0x86, 0xc6, 0xd1, 0x71, 0x39, 0xc4, 0xb5, 0x5d, 0xea, 0x8e, 0xa7,
-	0x1d, 0x71, 0x61, 0xc3, 0x26, 0x28, 0x46, 0x48, 0x96, 0x52, 0x12, 0xe7, 0xa7, 0x11, 0x81, 0x42,
-	0x09, 0x8e, 0xf9, 0x2a, 0x38, 0xb5, 0x2f, 0x0b, 0x57, 0x43, 0x77, 0xab, 0xcf, 0x38, 0x75, 0xf7,
-	0x3a, 0x84, 0x67, 0x89, 0xd4, 0xfe, 0x41, 0x22, 0x0d, 0x50, 0x74, 0x94, 0x92, 0xa8, 0xb7, 0x0c,
-	0xe4, 0xb0, 0x05, 0x9c, 0x4c, 0xdc, 0x7c, 0x43, 0x03, 0xa5, 0x1b, 0xd9, 0x54, 0xd3, 0x41, 0xdc,
-	0xf1, 0x61, 0x7b, 0x72, 0x3a, 0xd3, 0x66, 0x1e, 0xce, 0xda, 0x93, 0xc3, 0xd9, 0xdc, 0xac, 0xb3,
-	0x99, 0xf9, 0x9e, 0x06, 0xca, 0x5b, 0xfb, 0x5e, 0x40, 0xf8, 0x24, 0x38, 0x14, 0xf5, 0xbb, 0xbb,
-	0x78, 0x2f, 0xdd, 0x82, 0x39, 0x1a, 0x1a, 0x2b, 0xf9, 0x31, 0x6d, 0x7d, 0x23, 0x37, 0xa6, 0xc9,
-	0xb6, 0xb5, 0x52, 0x0a, 0xbc, 0x0a, 0x60, 0xfa, 0x1a, 0xe7, 0xc6, 0x9a, 0x39, 0xf9, 0x82, 0xc2,
-	0xfb, 0xfb, 0xdd, 0xaa, 0x24, 0xe8, 0x6c, 0xb2, 0x61, 0x9d, 0x85, 0x6f, 0xef, 0xad, 0x68, 0xdf,
-	0xdd, 0x5b, 0xd1, 0x7e, 0xbd, 0xb7, 0xa2, 0x75, 0xff, 0x2f, 0x27, 0xf2, 0x0b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x70, 0x1e, 0x6a, 0xd1, 0x6c, 0x0c, 0x00, 0x00,
+	// 1412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x1b, 0xd6, 0x26, 0xfe, 0xbe, 0xb6, 0x63, 0x27, 0xb6, 0x27, 0x55, 0xb3, 0x5f, 0xdb, 0x2f, 0xeb,
+	0xae, 0xbe, 0xb6, 0x51, 0xd5, 0xd8, 0xb5, 0x9b, 0xda, 0x49, 0x7f, 0x3e, 0x54, 0xa7, 0x8d, 0x0a,
+	0x12, 0x12, 0xda, 0x96, 0x4a, 0x48, 0x88, 0xd5, 0x78, 0x77, 0xe2, 0x1d, 0xb2, 0xde, 0x59, 0x76,
+	0xc6, 0x56, 0x53, 0x84, 0x38, 0x70, 0xe2, 0x47, 0xe2, 0x00, 0x27, 0x38, 0xc1, 0x8d, 0x1f, 0x71,
+	0x07, 0x4e, 0x70, 0xe2, 0xc8, 0xdf, 0x05, 0x0e, 0x16, 0xea, 0x0d, 0x38, 0xe1, 0x23, 0x27, 0x34,
+	0x33, 0xbb, 0xeb, 0x75, 0x12, 0xb7, 0xe1, 0xe7, 0xe6, 0x9d, 0x79, 0x9e, 0xe7, 0x9d, 0x79, 0xdf,
+	0x77, 0x66, 0x1e, 0x03, 0x23, 0x8c, 0x28, 0xa7, 0xb5, 0x0e, 0x46, 0x0e, 0x0d, 0x6a, 0x61, 0x23,
+	0xac, 0x0d, 0xea, 0x35, 0xbe, 0x13, 0x62, 0x56, 0x95, 0x33, 0xf0, 0x18, 0xe6, 0x1e, 0x8e, 0x70,
+	0xbf, 0x57, 0x55, 0x98, 0x6a, 0xd8, 0x08, 0xab, 0x83, 0xfa, 0xf1, 0xff, 0x29, 0x22, 0xe6, 0x5e,
+	0x6d, 0x50, 0x47, 0x7e, 0xe8, 0xa1, 0x7a, 0x0d, 0x71, 0x8e, 0x19, 0x47, 0x9c, 0x08, 0x98, 0x98,
+	0x3e, 0x7e, 0x7a, 0x1f, 0x94, 0xd2, 0xb1, 0x3b, 0x3e, 0x75, 0xb6, 0x63, 0x98, 0xb9, 0x0f, 0x6c,
+	0x80, 0x7c, 0xe2, 0x22, 0x4e, 0xa3, 0x18, 0xb3, 0xd2, 0x25, 0xdc, 0xeb, 0x77, 0xaa, 0x0e, 0xed,
+	0xd5, 0xba, 0xb4, 0x4b, 0x6b, 0x72, 0xb8, 0xd3, 0xdf, 0x92, 0x5f, 0x4a, 0x40, 0xfc, 0x52, 0x70,
+	0xf3, 0xdd, 0x22, 0xc8, 0xb7, 0x65, 0xa4, 0xdb, 0x1c, 0x71, 0x0c, 0x4d, 0x50, 0xe8, 0xe2, 0x00,
+	0x33, 0xc2, 0x6c, 0x4e, 0x7a, 0x58, 0xff, 0xf9, 0x50, 0x45, 0x5b, 0xce, 0x59, 0xf9, 0x78, 0xf0,
+	0x0e, 0xe9, 0x61, 0xb8, 0x00, 0x72, 0xcc, 0xa7, 0x5c, 0xff, 0x45, 0xcd, 0xc9, 0x0f, 0x58, 0x07,
+	0xb9, 0x2d, 0x1a, 0x6d, 0xeb, 0xbf, 0x8a, 0xc1, 0x7c, 0xe3, 0x64, 0x75, 0xff, 0x84, 0x54, 0x37,
+	0x69, 0xb4, 0x6d, 0x49, 0x28, 0x7c, 0x06, 0x2c, 0xf8, 0x48, 0xa4, 0x42, 0x6d, 0xd2, 0xf6, 0x30,
+	0x72, 0x71, 0xa4, 0x7f, 0x53, 0x94, 0x0a, 0xcb, 0x63, 0x05, 0xcc, 0xbd, 0x6a, 0xb2, 0xe1, 0xaa,
+	0x5a, 0x6d, 0x5b, 0x30, 0x6e, 0x49, 0x82, 0x55, 0x56, 0x2a, 0x99, 0x21, 0xb8, 0x06, 0xf2, 0x4a,
+	0x33, 0xa2, 0x94, 0x33, 0xfd, 0xdb, 0x62, 0x65, 0x76, 0xb9, 0xd0, 0x3e, 0x36, 0x1a, 0x1a, 0x90,
+	0xb1, 0xfb, 0x2b, 0x8c, 0xdc, 0xc7, 0x97, 0xcd, 0xb5, 0xfa, 0x7a, 0xe3, 0xfc, 0xc5, 0x86, 0x69,
+	0x01, 0x89, 0xb5, 0x04, 0x54, 0x30, 0x45, 0x6d, 0x70, 0xcc, 0xfc, 0xee, 0x11, 0x4c, 0x89, 0x55,
+	0x4c, 0x0b, 0x94, 0x3c, 0xc2, 0x38, 0x8d, 0x88, 0x83, 0xfc, 0x98, 0xfe, 0xbd, 0xa2, 0x9f, 0x19,
+	0x0d, 0x0d, 0x73, 0x4c, 0x7f, 0x4c, 0x70, 0x2b, 0xe2, 0xbb, 0x87, 0xee, 0x5d, 0x36, 0xeb, 0xcd,
+	0x56, 0xab, 0xd5, 0xa8, 0x37, 0x4d, 0xab, 0x38, 0x16, 0x50, 0x9a, 0xd7, 0xc0, 0x11, 0xcc, 0xbd,
+	0xba, 0xed, 0x22, 0x8e, 0xf4, 0x4f, 0x17, 0x65, 0x62, 0x8c, 0x29, 0x89, 0xb9, 0xc9, 0xbd, 0xfa,
+	0x0d, 0xc4, 0x91, 0x75, 0x18, 0xc7, 0xbf, 0xe0, 0xb3, 0xa0, 0x98, 0xd2, 0xed, 0x01, 0xe5, 0x98,
+	0xe9, 0x9f, 0x2d, 0x56, 0x66, 0x0f, 0x20, 0xd2, 0x86, 0xa3, 0xa1, 0x31, 0x3f, 0x5e, 0xe2, 0x85,
+	0xc6, 0xaa, 0x69, 0xcd, 0x25, 0xc2, 0x77, 0x85, 0x14, 0x5c, 0x01, 0x50, 0xa9, 0xe3, 0x90, 0x32,
+	0xc2, 0x6d, 0x12, 0xb8, 0xf8, 0x9e, 0xfe, 0xf9, 0xa2, 0xec, 0x8a, 0x92, 0xc4, 0xaa, 0x99, 0xc7,
+	0xc5, 0x04, 0x7c, 0x0e, 0x80, 0xb4, 0x59, 0x99, 0xfe, 0x9e, 0x21, 0xd7, 0x51, 0x99, 0xb2, 0x8e,
+	0xbb, 0x09, 0xb2, 0x7d, 0x62, 0x34, 0x34, 0x16, 0x33, 0x0b, 0x59, 0x5f, 0xbf, 0x54, 0xaf, 0x37,
+	0x1b, 0xad, 0x56, 0xab, 0x69, 0x5a, 0x19, 0x45, 0xb8, 0x06, 0x0e, 0x77, 0x90, 0x8f, 0x02, 0x07,
+	0x33, 0xfd, 0x7d, 0xa1, 0x9e, 0x7b, 0x38, 0x37, 0x45, 0xc3, 0x8a, 0xac, 0x79, 0xc4, 0x6d, 0xe6,
+	0xa1, 0xc8, 0xd5, 0x5f, 0x3d, 0x2b, 0x77, 0x00, 0xe4, 0xd8, 0x6d, 0x31, 0x04, 0xaf, 0x80, 0x42,
+	0x84, 0x02, 0x17, 0x51, 0xbb, 0x47, 0xee, 0x61, 0xa6, 0xbf, 0x76, 0x56, 0xd6, 0x75, 0x71, 0x34,
+	0x34, 0x16, 0xc6, 0x75, 0x6d, 0x5e, 0xba, 0x74, 0xb1, 0x29, 0xfb, 0x22, 0xaf, 0xd0, 0x4f, 0x0a,
+	0x30, 0xdc, 0x04, 0x10, 0x39, 0x9c, 0x0c, 0xb0, 0xca, 0x50, 0xdc, 0x1a, 0xaf, 0x3f, 0x42, 0xa2,
+	0xa4, 0x38, 0x32, 0x77, 0x49, 0x83, 0xe9, 0x0e, 0xed, 0x85, 0xc8, 0xe1, 0xb6, 0x43, 0x7b, 0x3d,
+	0xc2, 0x39, 0xc6, 0x2c, 0x56, 0x7b, 0xe3, 0x11, 0x6a, 0xc7, 0x62, 0xe6, 0x46, 0x4a, 0x54, 0x9a,
+	0x0d, 0x70, 0x84, 0xf9, 0x88, 0x79, 0x24, 0xe8, 0x32, 0xfd, 0xb7, 0xaa, 0xcc, 0xda, 0xc2, 0x68,
+	0x68, 0x14, 0x27, 0x9b, 0xdd, 0xb4, 0xc6, 0x30, 0xf8, 0x32, 0x38, 0x11, 0x46, 0x78, 0x40, 0x68,
+	0x9f, 0xd9, 0x38, 0xa4, 0x8e, 0x67, 0x67, 0x6e, 0x34, 0xa6, 0xff, 0xd0, 0x94, 0x95, 0x3d, 0x37,
+	0xed, 0x06, 0x78, 0x0a, 0x07, 0x2e, 0x09, 0xba, 0xd7, 0xc7, 0x9c, 0x5d, 0xcd, 0xa6, 0x02, 0xfe,
+	0x27, 0x89, 0x71, 0x53, 0x84, 0xc8, 0xa0, 0x19, 0x7c, 0x09, 0x1c, 0x77, 0xfa, 0x51, 0x84, 0x03,
+	0xbe, 0x5f, 0xfc, 0x1f, 0xff, 0x99, 0xf8, 0x7a, 0x1c, 0x62, 0x6f, 0xf8, 0x2e, 0x58, 0x48, 0xf7,
+	0xef, 0x44, 0x94, 0x31, 0x9f, 0x04, 0xdb, 0x4c, 0xff, 0xe2, 0xff, 0x0f, 0xed, 0xe8, 0x8d, 0x04,
+	0xb9, 0x3b, 0xbf, 0xea, 0x6c, 0xc1, 0x44, 0x32, 0xc5, 0x31, 0x88, 0x01, 0x4c, 0xf6, 0x99, 0x89,
+	0xf3, 0xe5, 0xdf, 0x8a, 0x53, 0x8e, 0x15, 0x33, 0x61, 0x18, 0x80, 0xcf, 0xf7, 0x19, 0x27, 0x5b,
+	0xc4, 0x91, 0x3b, 0xb4, 0x3b, 0x84, 0x33, 0xfd, 0x83, 0xcd, 0x8a, 0xb6, 0x5c, 0x68, 0x6f, 0x8c,
+	0x86, 0x46, 0x21, 0x23, 0x62, 0xfe, 0x3e, 0x34, 0x6a, 0x99, 0x37, 0x26, 0x8c, 0x76, 0x58, 0x0f,
+	0x71, 0xe2, 0xf8, 0xa8, 0xc3, 0x6a, 0x5d, 0xba, 0xd2, 0x21, 0x7c, 0x8b, 0x60, 0xdf, 0xad, 0xb6,
+	0x09, 0x1f, 0x60, 0x87, 0xd3, 0x68, 0xd5, 0x2a, 0x4f, 0xe8, 0xb7, 0x09, 0x67, 0x70, 0x0b, 0xfc,
+	0x37, 0x4d, 0x62, 0x3c, 0x8b, 0x5d, 0xdb, 0xf1, 0xb0, 0xb3, 0x1d, 0x52, 0x12, 0x70, 0xfd, 0xc3,
+	0x4d, 0x79, 0xdb, 0x9d, 0x9a, 0xb6, 0xcd, 0x14, 0x69, 0xa5, 0xdd, 0xf8, 0x44, 0xa2, 0x33, 0x9e,
+	0x84, 0x2e, 0x38, 0x99, 0xe4, 0x70, 0xdf, 0x30, 0x1f, 0x1d, 0x38, 0x4c, 0xd2, 0x73, 0xfb, 0x45,
+	0x79, 0x1a, 0x1c, 0xdd, 0x22, 0x01, 0xf2, 0xc9, 0xfd, 0x49, 0xf5, 0x8f, 0x0f, 0xac, 0xbe, 0x90,
+	0xf2, 0xc7, 0x83, 0xe6, 0xdb, 0x1a, 0xc8, 0x89, 0x07, 0x13, 0x5e, 0x01, 0xa5, 0x34, 0x5b, 0x03,
+	0x1c, 0x31, 0x42, 0x03, 0x5d, 0x93, 0xf5, 0x29, 0x4d, 0xd6, 0x67, 0xd5, 0xb4, 0x8a, 0x09, 0xf2,
+	0xae, 0x02, 0xc2, 0x75, 0x50, 0x4c, 0x52, 0x90, 0x70, 0x67, 0xa6, 0x70, 0xe7, 0x63, 0x60, 0x42,
+	0x3d, 0x0a, 0xfe, 0x25, 0x4f, 0x98, 0x3e, 0x2b, 0xaf, 0x44, 0xf5, 0x61, 0xbe, 0x39, 0x03, 0xe0,
+	0xde, 0x53, 0x04, 0x7b, 0xa0, 0x84, 0xba, 0xdd, 0x08, 0x77, 0x33, 0x5d, 0xa4, 0x16, 0xd9, 0x9e,
+	0x38, 0x5f, 0xab, 0x17, 0xd6, 0x9b, 0xa2, 0x8d, 0xce, 0x1f, 0xb4, 0x8d, 0x7c, 0xc2, 0xb8, 0x55,
+	0xcc, 0x68, 0xcb, 0x0e, 0xba, 0x0c, 0x72, 0xf2, 0x59, 0x9c, 0x91, 0x29, 0x3e, 0x33, 0x25, 0xc5,
+	0x99, 0x05, 0xca, 0xc7, 0x51, 0x72, 0xe0, 0x59, 0x50, 0x24, 0x81, 0xe3, 0xf7, 0xc5, 0x26, 0x6d,
+	0x17, 0xfb, 0x68, 0x27, 0xde, 0xe1, 0x7c, 0x3a, 0x7c, 0x43, 0x8c, 0xc2, 0xd3, 0x60, 0x3e, 0x8c,
+	0x68, 0x48, 0x19, 0x8e, 0xe2, 0xf7, 0x2d, 0x27, 0x71, 0x73, 0xc9, 0xa8, 0xbc, 0x9f, 0xcd, 0x77,
+	0x34, 0x50, 0xce, 0x44, 0xba, 0x83, 0xa2, 0x2e, 0xe6, 0x10, 0xc6, 0x46, 0x49, 0xcb, 0xf8, 0xa4,
+	0x6b, 0xa0, 0x9c, 0x75, 0x76, 0xf2, 0xfa, 0x8e, 0xcb, 0x51, 0x1e, 0x0d, 0x8d, 0xb9, 0x71, 0x39,
+	0xc4, 0xb5, 0x5d, 0xec, 0x8c, 0xdd, 0x8e, 0xb8, 0xb0, 0x61, 0x03, 0xe4, 0x43, 0x24, 0x4b, 0x29,
+	0x89, 0xb3, 0xd3, 0x88, 0x40, 0xa1, 0x04, 0xc7, 0x7c, 0x11, 0x9c, 0xdc, 0x95, 0x85, 0xeb, 0x81,
+	0xbb, 0xd1, 0x67, 0x9c, 0xba, 0x3b, 0x6d, 0xc2, 0xd3, 0x44, 0x6a, 0x7f, 0x21, 0x91, 0x06, 0xc8,
+	0x3b, 0x4a, 0x49, 0xd4, 0x5b, 0x6e, 0xe4, 0xb0, 0x05, 0x9c, 0x54, 0xdc, 0x7c, 0x45, 0x03, 0xc5,
+	0x5b, 0xa9, 0xab, 0x69, 0x23, 0xee, 0x78, 0xb0, 0x35, 0xe9, 0xce, 0xb4, 0x03, 0x9b, 0xb3, 0xd6,
+	0xa4, 0x39, 0x9b, 0x39, 0xa8, 0x37, 0x33, 0xdf, 0xd2, 0x40, 0x69, 0x63, 0xd7, 0x0b, 0x08, 0xaf,
+	0x82, 0x43, 0x61, 0xbf, 0xb3, 0x8d, 0x77, 0x92, 0x25, 0x98, 0xa3, 0xa1, 0xb1, 0x94, 0xb5, 0x69,
+	0xab, 0x6b, 0x19, 0x9b, 0x26, 0xdb, 0xd6, 0x4a, 0x28, 0xf0, 0x3a, 0x80, 0xc9, 0x6b, 0x9c, 0xb1,
+	0x35, 0x33, 0xf2, 0x05, 0x85, 0x7b, 0xfb, 0xdd, 0x2a, 0xc7, 0xe8, 0xd4, 0xd9, 0x30, 0xf3, 0x13,
+	0x0d, 0xcc, 0xdd, 0xe6, 0x34, 0xc2, 0x6e, 0x6c, 0x94, 0xe0, 0x1a, 0x38, 0x14, 0xbb, 0xa9, 0xb8,
+	0x1a, 0x4b, 0x53, 0xaa, 0x11, 0x13, 0xac, 0x04, 0x0e, 0xcf, 0x81, 0xb2, 0x34, 0x63, 0x89, 0x95,
+	0x26, 0x5d, 0x4f, 0x95, 0x23, 0x67, 0x49, 0x0f, 0x18, 0xbb, 0x63, 0x31, 0x2c, 0x4e, 0xb5, 0xea,
+	0xe5, 0xf8, 0x54, 0xcb, 0x0f, 0x78, 0x0a, 0x14, 0x12, 0x27, 0x27, 0x7b, 0x4b, 0x34, 0x7a, 0xc1,
+	0xca, 0xc7, 0x63, 0xb2, 0x93, 0x5e, 0x00, 0xf3, 0x1b, 0x1e, 0x22, 0xe2, 0xbf, 0x42, 0xc4, 0xa5,
+	0xc3, 0x3c, 0xb5, 0xeb, 0xff, 0x82, 0xb6, 0xf7, 0xef, 0xc2, 0xd5, 0xac, 0x87, 0x9d, 0xf9, 0x93,
+	0x16, 0xb6, 0x5d, 0xf8, 0xea, 0xc1, 0x92, 0xf6, 0xf5, 0x83, 0x25, 0xed, 0xa7, 0x07, 0x4b, 0x5a,
+	0xe7, 0xdf, 0xf2, 0x5f, 0xcb, 0xc5, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcd, 0x8a, 0x6b,
+	0x90, 0x0d, 0x00, 0x00,
 }
 
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
@@ -1317,6 +1452,89 @@ func (m *CompactCommittee) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *StoredDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredDeposit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit.Size()))
+		n15, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Eth1BlockHeight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Eth1BlockHeight))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+	}
+	if len(m.DepositRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DepositRoot)))
+		i += copy(dAtA[i:], m.DepositRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ChainStartData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStartData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.GenesisTime != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.GenesisTime))
+	}
+	if m.Eth1Data != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Eth1Data.Size()))
+		n16, err := m.Eth1Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1604,6 +1822,51 @@ func (m *CompactCommittee) Size() (n int) {
 	return n
 }
 
+func (m *StoredDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.Eth1BlockHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisTime != 0 {
+		n += 1 + sovTypes(uint64(m.GenesisTime))
+	}
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -3379,6 +3642,277 @@ func (m *CompactCommittee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StoredDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoredDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoredDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &v1alpha1.Deposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
+			}
+			m.Eth1BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStartData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStartData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStartData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,7 +12,7 @@ import (
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	v1alpha1_gateway "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1_gateway"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 )
@@ -66,6 +66,8 @@ const (
 	ValidatorStatus_WITHDRAWABLE   ValidatorStatus = 4
 	ValidatorStatus_EXITED         ValidatorStatus = 5
 	ValidatorStatus_EXITED_SLASHED ValidatorStatus = 6
+	ValidatorStatus_DEPOSITED      ValidatorStatus = 7
+	ValidatorStatus_SLASHING       ValidatorStatus = 8
 )
 
 var ValidatorStatus_name = map[int32]string{
@@ -76,6 +78,8 @@ var ValidatorStatus_name = map[int32]string{
 	4: "WITHDRAWABLE",
 	5: "EXITED",
 	6: "EXITED_SLASHED",
+	7: "DEPOSITED",
+	8: "SLASHING",
 }
 
 var ValidatorStatus_value = map[string]int32{
@@ -86,6 +90,8 @@ var ValidatorStatus_value = map[string]int32{
 	"WITHDRAWABLE":   4,
 	"EXITED":         5,
 	"EXITED_SLASHED": 6,
+	"DEPOSITED":      7,
+	"SLASHING":       8,
 }
 
 func (x ValidatorStatus) String() string {
@@ -96,6 +102,43 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type DepositStatusResponse_Stage int32
+
+const (
+	DepositStatusResponse_UNKNOWN              DepositStatusResponse_Stage = 0
+	DepositStatusResponse_DEPOSIT_OBSERVED     DepositStatusResponse_Stage = 1
+	DepositStatusResponse_DEPOSIT_INCLUDED     DepositStatusResponse_Stage = 2
+	DepositStatusResponse_ELIGIBLE             DepositStatusResponse_Stage = 3
+	DepositStatusResponse_ACTIVATION_SCHEDULED DepositStatusResponse_Stage = 4
+	DepositStatusResponse_ACTIVE               DepositStatusResponse_Stage = 5
+)
+
+var DepositStatusResponse_Stage_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "DEPOSIT_OBSERVED",
+	2: "DEPOSIT_INCLUDED",
+	3: "ELIGIBLE",
+	4: "ACTIVATION_SCHEDULED",
+	5: "ACTIVE",
+}
+
+var DepositStatusResponse_Stage_value = map[string]int32{
+	"UNKNOWN":              0,
+	"DEPOSIT_OBSERVED":     1,
+	"DEPOSIT_INCLUDED":     2,
+	"ELIGIBLE":             3,
+	"ACTIVATION_SCHEDULED": 4,
+	"ACTIVE":               5,
+}
+
+func (x DepositStatusResponse_Stage) String() string {
+	return proto.EnumName(DepositStatusResponse_Stage_name, int32(x))
+}
+
+func (DepositStatusResponse_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21, 0}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
@@ -182,6 +225,108 @@ func (m *ProposeResponse) GetBlockRoot() []byte {
 	return nil
 }
 
+type UnsignedBlockResponse struct {
+	Block                *v1alpha1_gateway.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	SigningRoot          []byte                        `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
+	SignatureDomain      uint64                        `protobuf:"varint,3,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *UnsignedBlockResponse) Reset()         { *m = UnsignedBlockResponse{} }
+func (m *UnsignedBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsignedBlockResponse) ProtoMessage()    {}
+func (*UnsignedBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{2}
+}
+
+func (m *UnsignedBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsignedBlockResponse.Unmarshal(m, b)
+}
+func (m *UnsignedBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsignedBlockResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsignedBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsignedBlockResponse.Merge(m, src)
+}
+func (m *UnsignedBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsignedBlockResponse.Size(m)
+}
+func (m *UnsignedBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsignedBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsignedBlockResponse proto.InternalMessageInfo
+
+func (m *UnsignedBlockResponse) GetBlock() *v1alpha1_gateway.BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *UnsignedBlockResponse) GetSigningRoot() []byte {
+	if m != nil {
+		return m.SigningRoot
+	}
+	return nil
+}
+
+func (m *UnsignedBlockResponse) GetSignatureDomain() uint64 {
+	if m != nil {
+		return m.SignatureDomain
+	}
+	return 0
+}
+
+type SignedBlockRequest struct {
+	Block                *v1alpha1_gateway.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Signature            []byte                        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *SignedBlockRequest) Reset()         { *m = SignedBlockRequest{} }
+func (m *SignedBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*SignedBlockRequest) ProtoMessage()    {}
+func (*SignedBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{3}
+}
+
+func (m *SignedBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedBlockRequest.Unmarshal(m, b)
+}
+func (m *SignedBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *SignedBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedBlockRequest.Merge(m, src)
+}
+func (m *SignedBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_SignedBlockRequest.Size(m)
+}
+func (m *SignedBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedBlockRequest proto.InternalMessageInfo
+
+func (m *SignedBlockRequest) GetBlock() *v1alpha1_gateway.BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *SignedBlockRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type AttestationRequest struct {
 	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	PocBit               []byte   `protobuf:"bytes,2,opt,name=poc_bit,json=pocBit,proto3" json:"poc_bit,omitempty"`
@@ -196,7 +341,7 @@ func (m *AttestationRequest) Reset()         { *m = AttestationRequest{} }
 func (m *AttestationRequest) String() string { return proto.CompactTextString(m) }
 func (*AttestationRequest) ProtoMessage()    {}
 func (*AttestationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{2}
+	return fileDescriptor_9eb4e94b85965285, []int{4}
 }
 
 func (m *AttestationRequest) XXX_Unmarshal(b []byte) error {
@@ -256,7 +401,7 @@ func (m *AttestResponse) Reset()         { *m = AttestResponse{} }
 func (m *AttestResponse) String() string { return proto.CompactTextString(m) }
 func (*AttestResponse) ProtoMessage()    {}
 func (*AttestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{3}
+	return fileDescriptor_9eb4e94b85965285, []int{5}
 }
 
 func (m *AttestResponse) XXX_Unmarshal(b []byte) error {
@@ -296,7 +441,7 @@ func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanc
 func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPerformanceRequest) ProtoMessage()    {}
 func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{4}
+	return fileDescriptor_9eb4e94b85965285, []int{6}
 }
 
 func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
@@ -345,7 +490,7 @@ func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerforman
 func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPerformanceResponse) ProtoMessage()    {}
 func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{5}
+	return fileDescriptor_9eb4e94b85965285, []int{7}
 }
 
 func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
@@ -405,7 +550,7 @@ func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationR
 func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationRequest) ProtoMessage()    {}
 func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{6}
+	return fileDescriptor_9eb4e94b85965285, []int{8}
 }
 
 func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
@@ -445,7 +590,7 @@ func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivation
 func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationResponse) ProtoMessage()    {}
 func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{7}
+	return fileDescriptor_9eb4e94b85965285, []int{9}
 }
 
 func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
@@ -493,7 +638,7 @@ func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorAct
 func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
 func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{7, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{9, 0}
 }
 
 func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
@@ -539,7 +684,7 @@ func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest
 func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*ExitedValidatorsRequest) ProtoMessage()    {}
 func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{8}
+	return fileDescriptor_9eb4e94b85965285, []int{10}
 }
 
 func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
@@ -578,7 +723,7 @@ func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsRespon
 func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*ExitedValidatorsResponse) ProtoMessage()    {}
 func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9}
+	return fileDescriptor_9eb4e94b85965285, []int{11}
 }
 
 func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
@@ -606,6 +751,45 @@ func (m *ExitedValidatorsResponse) GetPublicKeys() [][]byte {
 	return nil
 }
 
+type ProposeExitResponse struct {
+	ExitRoot             []byte   `protobuf:"bytes,1,opt,name=exit_root,json=exitRoot,proto3" json:"exit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposeExitResponse) Reset()         { *m = ProposeExitResponse{} }
+func (m *ProposeExitResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposeExitResponse) ProtoMessage()    {}
+func (*ProposeExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{12}
+}
+
+func (m *ProposeExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposeExitResponse.Unmarshal(m, b)
+}
+func (m *ProposeExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposeExitResponse.Marshal(b, m, deterministic)
+}
+func (m *ProposeExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeExitResponse.Merge(m, src)
+}
+func (m *ProposeExitResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposeExitResponse.Size(m)
+}
+func (m *ProposeExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeExitResponse proto.InternalMessageInfo
+
+func (m *ProposeExitResponse) GetExitRoot() []byte {
+	if m != nil {
+		return m.ExitRoot
+	}
+	return nil
+}
+
 type ChainStartResponse struct {
 	Started              bool     `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
 	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
@@ -618,7 +802,7 @@ func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
 func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainStartResponse) ProtoMessage()    {}
 func (*ChainStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{10}
+	return fileDescriptor_9eb4e94b85965285, []int{13}
 }
 
 func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
@@ -664,7 +848,7 @@ func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
 func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexRequest) ProtoMessage()    {}
 func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{11}
+	return fileDescriptor_9eb4e94b85965285, []int{14}
 }
 
 func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -703,7 +887,7 @@ func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{}
 func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexResponse) ProtoMessage()    {}
 func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{12}
+	return fileDescriptor_9eb4e94b85965285, []int{15}
 }
 
 func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -743,7 +927,7 @@ func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
 func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AssignmentRequest) ProtoMessage()    {}
 func (*AssignmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{13}
+	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
 
 func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
@@ -789,7 +973,7 @@ func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
 func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AssignmentResponse) ProtoMessage()    {}
 func (*AssignmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{14}
+	return fileDescriptor_9eb4e94b85965285, []int{17}
 }
 
 func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
@@ -835,7 +1019,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Reset() {
 func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
 func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
 func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{14, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{17, 0}
 }
 
 func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
@@ -898,22 +1082,214 @@ func (m *AssignmentResponse_ValidatorAssignment) GetStatus() ValidatorStatus {
 	return ValidatorStatus_UNKNOWN_STATUS
 }
 
+type DutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	SlotSignatures       [][]byte `protobuf:"bytes,3,rep,name=slot_signatures,json=slotSignatures,proto3" json:"slot_signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DutiesRequest) Reset()         { *m = DutiesRequest{} }
+func (m *DutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*DutiesRequest) ProtoMessage()    {}
+func (*DutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{18}
+}
+
+func (m *DutiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesRequest.Unmarshal(m, b)
+}
+func (m *DutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesRequest.Marshal(b, m, deterministic)
+}
+func (m *DutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesRequest.Merge(m, src)
+}
+func (m *DutiesRequest) XXX_Size() int {
+	return xxx_messageInfo_DutiesRequest.Size(m)
+}
+func (m *DutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesRequest proto.InternalMessageInfo
+
+func (m *DutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DutiesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *DutiesRequest) GetSlotSignatures() [][]byte {
+	if m != nil {
+		return m.SlotSignatures
+	}
+	return nil
+}
+
+type DutiesResponse struct {
+	Duties               []*DutiesResponse_Duty `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DutiesResponse) Reset()         { *m = DutiesResponse{} }
+func (m *DutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*DutiesResponse) ProtoMessage()    {}
+func (*DutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{19}
+}
+
+func (m *DutiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesResponse.Unmarshal(m, b)
+}
+func (m *DutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesResponse.Marshal(b, m, deterministic)
+}
+func (m *DutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesResponse.Merge(m, src)
+}
+func (m *DutiesResponse) XXX_Size() int {
+	return xxx_messageInfo_DutiesResponse.Size(m)
+}
+func (m *DutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesResponse proto.InternalMessageInfo
+
+func (m *DutiesResponse) GetDuties() []*DutiesResponse_Duty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type DutiesResponse_Duty struct {
+	PublicKey            []byte          `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               ValidatorStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	ValidatorIndex       uint64          `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Committee            []uint64        `protobuf:"varint,4,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	Shard                uint64          `protobuf:"varint,5,opt,name=shard,proto3" json:"shard,omitempty"`
+	AttesterSlot         uint64          `protobuf:"varint,6,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	ProposerSlots        []uint64        `protobuf:"varint,7,rep,packed,name=proposer_slots,json=proposerSlots,proto3" json:"proposer_slots,omitempty"`
+	IsAggregator         bool            `protobuf:"varint,8,opt,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DutiesResponse_Duty) Reset()         { *m = DutiesResponse_Duty{} }
+func (m *DutiesResponse_Duty) String() string { return proto.CompactTextString(m) }
+func (*DutiesResponse_Duty) ProtoMessage()    {}
+func (*DutiesResponse_Duty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{19, 0}
+}
+
+func (m *DutiesResponse_Duty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesResponse_Duty.Unmarshal(m, b)
+}
+func (m *DutiesResponse_Duty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesResponse_Duty.Marshal(b, m, deterministic)
+}
+func (m *DutiesResponse_Duty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesResponse_Duty.Merge(m, src)
+}
+func (m *DutiesResponse_Duty) XXX_Size() int {
+	return xxx_messageInfo_DutiesResponse_Duty.Size(m)
+}
+func (m *DutiesResponse_Duty) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesResponse_Duty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesResponse_Duty proto.InternalMessageInfo
+
+func (m *DutiesResponse_Duty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DutiesResponse_Duty) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ValidatorStatus_UNKNOWN_STATUS
+}
+
+func (m *DutiesResponse_Duty) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DutiesResponse_Duty) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
+	}
+	return nil
+}
+
+func (m *DutiesResponse_Duty) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *DutiesResponse_Duty) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *DutiesResponse_Duty) GetProposerSlots() []uint64 {
+	if m != nil {
+		return m.ProposerSlots
+	}
+	return nil
+}
+
+func (m *DutiesResponse_Duty) GetIsAggregator() bool {
+	if m != nil {
+		return m.IsAggregator
+	}
+	return false
+}
+
 type ValidatorStatusResponse struct {
-	Status                    ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	Eth1DepositBlockNumber    uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
-	DepositInclusionSlot      uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
-	ActivationEpoch           uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
-	PositionInActivationQueue uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}        `json:"-"`
-	XXX_unrecognized          []byte          `json:"-"`
-	XXX_sizecache             int32           `json:"-"`
+	Status                         ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	Eth1DepositBlockNumber         uint64          `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot           uint64          `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	ActivationEpoch                uint64          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	PositionInActivationQueue      uint64          `protobuf:"varint,5,opt,name=position_in_activation_queue,json=positionInActivationQueue,proto3" json:"position_in_activation_queue,omitempty"`
+	ExitEpoch                      uint64          `protobuf:"varint,6,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	EstimatedActivationEpoch       uint64          `protobuf:"varint,7,opt,name=estimated_activation_epoch,json=estimatedActivationEpoch,proto3" json:"estimated_activation_epoch,omitempty"`
+	EstimatedActivationWaitSeconds uint64          `protobuf:"varint,8,opt,name=estimated_activation_wait_seconds,json=estimatedActivationWaitSeconds,proto3" json:"estimated_activation_wait_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}        `json:"-"`
+	XXX_unrecognized               []byte          `json:"-"`
+	XXX_sizecache                  int32           `json:"-"`
 }
 
 func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
 func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatusResponse) ProtoMessage()    {}
 func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{15}
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
 
 func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -969,31 +1345,217 @@ func (m *ValidatorStatusResponse) GetPositionInActivationQueue() uint64 {
 	return 0
 }
 
-type DomainRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ValidatorStatusResponse) GetExitEpoch() uint64 {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
 }
 
-func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
-func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
-func (*DomainRequest) ProtoMessage()    {}
-func (*DomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16}
+func (m *ValidatorStatusResponse) GetEstimatedActivationEpoch() uint64 {
+	if m != nil {
+		return m.EstimatedActivationEpoch
+	}
+	return 0
 }
 
-func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DomainRequest.Unmarshal(m, b)
+func (m *ValidatorStatusResponse) GetEstimatedActivationWaitSeconds() uint64 {
+	if m != nil {
+		return m.EstimatedActivationWaitSeconds
+	}
+	return 0
 }
-func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+
+type DepositStatusResponse struct {
+	Stage                      DepositStatusResponse_Stage `protobuf:"varint,1,opt,name=stage,proto3,enum=ethereum.beacon.rpc.v1.DepositStatusResponse_Stage" json:"stage,omitempty"`
+	Eth1DepositBlockNumber     uint64                      `protobuf:"varint,2,opt,name=eth1_deposit_block_number,json=eth1DepositBlockNumber,proto3" json:"eth1_deposit_block_number,omitempty"`
+	DepositInclusionSlot       uint64                      `protobuf:"varint,3,opt,name=deposit_inclusion_slot,json=depositInclusionSlot,proto3" json:"deposit_inclusion_slot,omitempty"`
+	DepositInclusionBlockRoot  []byte                      `protobuf:"bytes,4,opt,name=deposit_inclusion_block_root,json=depositInclusionBlockRoot,proto3" json:"deposit_inclusion_block_root,omitempty"`
+	ValidatorIndex             uint64                      `protobuf:"varint,5,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ActivationEligibilityEpoch uint64                      `protobuf:"varint,6,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            uint64                      `protobuf:"varint,7,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                    `json:"-"`
+	XXX_unrecognized           []byte                      `json:"-"`
+	XXX_sizecache              int32                       `json:"-"`
 }
-func (m *DomainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DomainRequest.Merge(m, src)
+
+func (m *DepositStatusResponse) Reset()         { *m = DepositStatusResponse{} }
+func (m *DepositStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositStatusResponse) ProtoMessage()    {}
+func (*DepositStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
 }
-func (m *DomainRequest) XXX_Size() int {
+
+func (m *DepositStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositStatusResponse.Unmarshal(m, b)
+}
+func (m *DepositStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *DepositStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositStatusResponse.Merge(m, src)
+}
+func (m *DepositStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_DepositStatusResponse.Size(m)
+}
+func (m *DepositStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositStatusResponse proto.InternalMessageInfo
+
+func (m *DepositStatusResponse) GetStage() DepositStatusResponse_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return DepositStatusResponse_UNKNOWN
+}
+
+func (m *DepositStatusResponse) GetEth1DepositBlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1DepositBlockNumber
+	}
+	return 0
+}
+
+func (m *DepositStatusResponse) GetDepositInclusionSlot() uint64 {
+	if m != nil {
+		return m.DepositInclusionSlot
+	}
+	return 0
+}
+
+func (m *DepositStatusResponse) GetDepositInclusionBlockRoot() []byte {
+	if m != nil {
+		return m.DepositInclusionBlockRoot
+	}
+	return nil
+}
+
+func (m *DepositStatusResponse) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DepositStatusResponse) GetActivationEligibilityEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (m *DepositStatusResponse) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+type MultipleValidatorStatusRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultipleValidatorStatusRequest) Reset()         { *m = MultipleValidatorStatusRequest{} }
+func (m *MultipleValidatorStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MultipleValidatorStatusRequest) ProtoMessage()    {}
+func (*MultipleValidatorStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+
+func (m *MultipleValidatorStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultipleValidatorStatusRequest.Unmarshal(m, b)
+}
+func (m *MultipleValidatorStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultipleValidatorStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *MultipleValidatorStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleValidatorStatusRequest.Merge(m, src)
+}
+func (m *MultipleValidatorStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_MultipleValidatorStatusRequest.Size(m)
+}
+func (m *MultipleValidatorStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleValidatorStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleValidatorStatusRequest proto.InternalMessageInfo
+
+func (m *MultipleValidatorStatusRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type MultipleValidatorStatusResponse struct {
+	Statuses             []*ValidatorActivationResponse_Status `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *MultipleValidatorStatusResponse) Reset()         { *m = MultipleValidatorStatusResponse{} }
+func (m *MultipleValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MultipleValidatorStatusResponse) ProtoMessage()    {}
+func (*MultipleValidatorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+
+func (m *MultipleValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultipleValidatorStatusResponse.Unmarshal(m, b)
+}
+func (m *MultipleValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultipleValidatorStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *MultipleValidatorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleValidatorStatusResponse.Merge(m, src)
+}
+func (m *MultipleValidatorStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_MultipleValidatorStatusResponse.Size(m)
+}
+func (m *MultipleValidatorStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleValidatorStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleValidatorStatusResponse proto.InternalMessageInfo
+
+func (m *MultipleValidatorStatusResponse) GetStatuses() []*ValidatorActivationResponse_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type DomainRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Domain               []byte   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
+func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
+func (*DomainRequest) ProtoMessage()    {}
+func (*DomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
+}
+
+func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DomainRequest.Unmarshal(m, b)
+}
+func (m *DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DomainRequest.Marshal(b, m, deterministic)
+}
+func (m *DomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainRequest.Merge(m, src)
+}
+func (m *DomainRequest) XXX_Size() int {
 	return xxx_messageInfo_DomainRequest.Size(m)
 }
 func (m *DomainRequest) XXX_DiscardUnknown() {
@@ -1027,7 +1589,7 @@ func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
 func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
 func (*DomainResponse) ProtoMessage()    {}
 func (*DomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+	return fileDescriptor_9eb4e94b85965285, []int{25}
 }
 
 func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
@@ -1066,7 +1628,7 @@ func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
 func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse) ProtoMessage()    {}
 func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+	return fileDescriptor_9eb4e94b85965285, []int{26}
 }
 
 func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
@@ -1095,20 +1657,20 @@ func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
 }
 
 type BlockTreeResponse_TreeNode struct {
-	Block                *v1alpha1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	BlockRoot            []byte                `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	ParticipatedVotes    uint64                `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
-	TotalVotes           uint64                `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	Block                *v1alpha1_gateway.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte                        `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParticipatedVotes    uint64                        `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
+	TotalVotes           uint64                        `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
 func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
 func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{26, 0}
 }
 
 func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
@@ -1129,7 +1691,7 @@ func (m *BlockTreeResponse_TreeNode) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlockTreeResponse_TreeNode proto.InternalMessageInfo
 
-func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1.BeaconBlock {
+func (m *BlockTreeResponse_TreeNode) GetBlock() *v1alpha1_gateway.BeaconBlock {
 	if m != nil {
 		return m.Block
 	}
@@ -1157,272 +1719,956 @@ func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
 	return 0
 }
 
-type TreeBlockSlotRequest struct {
-	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
-	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type BeaconStateRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*BeaconStateRequest_Slot
+	//	*BeaconStateRequest_BlockRoot
+	QueryFilter          isBeaconStateRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
-func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
-func (*TreeBlockSlotRequest) ProtoMessage()    {}
-func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
+func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateRequest) ProtoMessage()    {}
+func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
 }
 
-func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TreeBlockSlotRequest.Unmarshal(m, b)
+func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconStateRequest.Unmarshal(m, b)
 }
-func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconStateRequest.Marshal(b, m, deterministic)
 }
-func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+func (m *BeaconStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateRequest.Merge(m, src)
 }
-func (m *TreeBlockSlotRequest) XXX_Size() int {
-	return xxx_messageInfo_TreeBlockSlotRequest.Size(m)
+func (m *BeaconStateRequest) XXX_Size() int {
+	return xxx_messageInfo_BeaconStateRequest.Size(m)
 }
-func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
+func (m *BeaconStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+var xxx_messageInfo_BeaconStateRequest proto.InternalMessageInfo
 
-func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+type isBeaconStateRequest_QueryFilter interface {
+	isBeaconStateRequest_QueryFilter()
+}
+
+type BeaconStateRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3,oneof"`
+}
+
+type BeaconStateRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3,oneof"`
+}
+
+func (*BeaconStateRequest_Slot) isBeaconStateRequest_QueryFilter() {}
+
+func (*BeaconStateRequest_BlockRoot) isBeaconStateRequest_QueryFilter() {}
+
+func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
 	if m != nil {
-		return m.SlotFrom
+		return m.QueryFilter
 	}
-	return 0
+	return nil
 }
 
-func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
-	if m != nil {
-		return m.SlotTo
+func (m *BeaconStateRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_Slot); ok {
+		return x.Slot
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+func (m *BeaconStateRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
-
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x0f, 0x28, 0x89, 0x91, 0x57, 0xb4, 0x44, 0x9f, 0x65, 0x59, 0xa6, 0xed, 0x31, 0x8a, 0x3a,
-	0xa9, 0xa4, 0x89, 0x40, 0x89, 0xce, 0x78, 0x52, 0x65, 0xdc, 0x94, 0x92, 0x68, 0x99, 0xb5, 0x86,
-	0x52, 0x40, 0xda, 0x4e, 0x27, 0x0f, 0xe8, 0x11, 0x3c, 0x93, 0x57, 0x13, 0x38, 0x18, 0x38, 0x32,
-	0x56, 0x1f, 0x3a, 0xd3, 0xbe, 0xf6, 0xa9, 0xe9, 0x07, 0xc8, 0x87, 0xe8, 0x43, 0x67, 0x3a, 0x9d,
-	0x3e, 0x76, 0xfa, 0xde, 0xc7, 0x76, 0xfa, 0x94, 0x87, 0x7e, 0x8c, 0xce, 0xfd, 0x01, 0x08, 0x91,
-	0xa2, 0x45, 0xe5, 0x89, 0xb8, 0xdd, 0xfd, 0xed, 0xee, 0xed, 0xed, 0xed, 0xee, 0x11, 0xac, 0x30,
-	0x62, 0x9c, 0x95, 0xdb, 0x04, 0x7b, 0x2c, 0x28, 0x47, 0xa1, 0x57, 0x1e, 0xee, 0x96, 0x63, 0x12,
-	0x0d, 0xa9, 0x47, 0x62, 0x5b, 0x32, 0xd1, 0x1a, 0xe1, 0x3d, 0x12, 0x91, 0x81, 0x6f, 0x2b, 0x31,
-	0x3b, 0x0a, 0x3d, 0x7b, 0xb8, 0x5b, 0xba, 0xdb, 0x65, 0xac, 0xdb, 0x27, 0x65, 0x29, 0xd5, 0x1e,
-	0xbc, 0x2e, 0x13, 0x3f, 0xe4, 0x67, 0x0a, 0x54, 0x7a, 0x70, 0x4e, 0x71, 0x58, 0x09, 0x85, 0x62,
-	0x7e, 0x16, 0x26, 0x5a, 0x4b, 0x1f, 0x29, 0x01, 0xc2, 0x7b, 0xe5, 0xe1, 0x2e, 0xee, 0x87, 0x3d,
-	0xbc, 0xab, 0xa5, 0xdd, 0x76, 0x9f, 0x79, 0x6f, 0xb4, 0xd8, 0xc3, 0x0b, 0xc4, 0x30, 0xe7, 0x24,
-	0xe6, 0x98, 0x53, 0x16, 0x68, 0xa9, 0x7b, 0xda, 0x15, 0x1c, 0xd2, 0x32, 0x0e, 0x02, 0xa6, 0x98,
-	0x89, 0xa9, 0x4f, 0xe4, 0x8f, 0xb7, 0xdd, 0x25, 0xc1, 0x76, 0xfc, 0x0d, 0xee, 0x76, 0x49, 0x54,
-	0x66, 0xa1, 0x94, 0x98, 0x94, 0xb6, 0x8e, 0xa0, 0xb0, 0x2f, 0x1c, 0x70, 0xc8, 0xdb, 0x01, 0x89,
-	0x39, 0x42, 0x30, 0x1f, 0xf7, 0x19, 0x5f, 0x37, 0x4c, 0x63, 0x63, 0xde, 0x91, 0xdf, 0xe8, 0xc7,
-	0x70, 0x3d, 0xc2, 0x41, 0x07, 0x33, 0x37, 0x22, 0x43, 0x82, 0xfb, 0xeb, 0x39, 0xd3, 0xd8, 0x28,
-	0x38, 0x05, 0x45, 0x74, 0x24, 0xcd, 0xda, 0x81, 0x95, 0xd3, 0x88, 0x85, 0x2c, 0x26, 0x0e, 0x89,
-	0x43, 0x16, 0xc4, 0x04, 0xdd, 0x07, 0x90, 0x9b, 0x73, 0x23, 0xa6, 0x35, 0x16, 0x9c, 0x6b, 0x92,
-	0xe2, 0x30, 0xc6, 0xad, 0x21, 0xa0, 0xea, 0x68, 0x6f, 0x89, 0x03, 0xf7, 0x01, 0xc2, 0x41, 0xbb,
-	0x4f, 0x3d, 0xf7, 0x0d, 0x39, 0x4b, 0x40, 0x8a, 0xf2, 0x9c, 0x9c, 0xa1, 0xdb, 0xf0, 0x61, 0xc8,
-	0x3c, 0xb7, 0x4d, 0xb9, 0xf6, 0x22, 0x1f, 0x32, 0x6f, 0x9f, 0x8e, 0x1c, 0x9f, 0xcb, 0x38, 0xbe,
-	0x0a, 0x0b, 0x71, 0x0f, 0x47, 0x9d, 0xf5, 0x79, 0x49, 0x54, 0x0b, 0xeb, 0x21, 0x2c, 0x2b, 0xbb,
-	0xa9, 0xa3, 0x08, 0xe6, 0x33, 0x2e, 0xca, 0x6f, 0xeb, 0x14, 0xee, 0xbe, 0xc4, 0x7d, 0xda, 0xc1,
-	0x9c, 0x45, 0xa7, 0x24, 0x7a, 0xcd, 0x22, 0x1f, 0x07, 0x1e, 0x79, 0x5f, 0x9c, 0xce, 0xbb, 0x9e,
-	0x1b, 0x73, 0xdd, 0xfa, 0xde, 0x80, 0x7b, 0x17, 0xab, 0xd4, 0x6e, 0xac, 0xc3, 0x87, 0x6d, 0xdc,
-	0x17, 0x24, 0xad, 0x36, 0x59, 0xa2, 0x4d, 0x28, 0x72, 0xc6, 0x71, 0xdf, 0x1d, 0x26, 0xf8, 0x58,
-	0xea, 0x9f, 0x77, 0x56, 0x24, 0x3d, 0x55, 0x1b, 0xa3, 0xc7, 0x70, 0x5b, 0x89, 0x62, 0x8f, 0xd3,
-	0x21, 0xc9, 0x22, 0x54, 0x68, 0x6e, 0x49, 0x76, 0x55, 0x72, 0x33, 0xb8, 0x23, 0x30, 0xf1, 0x90,
-	0x44, 0xb8, 0x4b, 0x26, 0x90, 0x6e, 0xe2, 0x95, 0x08, 0x63, 0xce, 0xb9, 0xaf, 0xe5, 0xc6, 0x54,
-	0xec, 0x2b, 0x21, 0xeb, 0x09, 0x94, 0x52, 0x9a, 0x14, 0x39, 0x77, 0xbc, 0x0f, 0x60, 0x69, 0x14,
-	0xa3, 0x78, 0xdd, 0x30, 0xe7, 0x36, 0x0a, 0x0e, 0xa4, 0x41, 0x8a, 0xad, 0xef, 0x72, 0x99, 0xc0,
-	0x67, 0xf1, 0x3a, 0x48, 0x8f, 0xe1, 0x16, 0x56, 0x54, 0xd2, 0x71, 0x27, 0x54, 0xed, 0xe7, 0xd6,
-	0x0d, 0xe7, 0x66, 0x2a, 0x70, 0x9a, 0xea, 0x45, 0x2f, 0x61, 0x51, 0x64, 0xda, 0x20, 0x26, 0x22,
-	0x74, 0x73, 0x1b, 0x4b, 0x95, 0x3d, 0xfb, 0xe2, 0xab, 0x6e, 0xbf, 0xc7, 0xbc, 0xdd, 0x94, 0x3a,
-	0x9c, 0x54, 0x57, 0x29, 0x84, 0xbc, 0xa2, 0x5d, 0x96, 0xb9, 0x47, 0x90, 0x57, 0x20, 0x79, 0x72,
-	0x4b, 0x95, 0xf2, 0xa5, 0xe6, 0xb5, 0x2d, 0x6d, 0xda, 0xd1, 0x70, 0x6b, 0x0f, 0x6e, 0xd7, 0xde,
-	0x51, 0x4e, 0x3a, 0xa3, 0xd3, 0x9b, 0x39, 0xba, 0x9f, 0xc3, 0xfa, 0x24, 0x56, 0x47, 0xf6, 0x52,
-	0xf0, 0x97, 0x80, 0x0e, 0x7a, 0x98, 0x06, 0x4d, 0x8e, 0x23, 0x9e, 0xcd, 0xda, 0x58, 0x10, 0x48,
-	0x47, 0xee, 0x79, 0xd1, 0x49, 0x96, 0xe8, 0x47, 0x50, 0xe8, 0x92, 0x80, 0xc4, 0x34, 0x76, 0x39,
-	0xf5, 0x89, 0xce, 0xd8, 0x25, 0x4d, 0x6b, 0x51, 0x9f, 0x58, 0x8f, 0xe1, 0x56, 0xea, 0x49, 0x3d,
-	0xe8, 0x90, 0x77, 0xb3, 0x95, 0x01, 0xcb, 0x86, 0xb5, 0x71, 0x9c, 0x76, 0x67, 0x15, 0x16, 0xa8,
-	0x20, 0xe8, 0x2b, 0xa4, 0x16, 0xd6, 0x0b, 0xb8, 0x51, 0x8d, 0x63, 0xda, 0x0d, 0x7c, 0x12, 0xf0,
-	0x4c, 0xb4, 0x48, 0xc8, 0xbc, 0x9e, 0x2b, 0x1d, 0xd6, 0x00, 0x90, 0x24, 0xb9, 0xc5, 0xf1, 0x88,
-	0xe4, 0x26, 0x22, 0xf2, 0xbf, 0x1c, 0xa0, 0xac, 0x5e, 0xed, 0xc3, 0x5b, 0x58, 0x1d, 0x5d, 0x1e,
-	0x9c, 0xf2, 0x65, 0x48, 0x97, 0x2a, 0x3f, 0x9b, 0x76, 0xf0, 0x93, 0x9a, 0x32, 0xa9, 0x38, 0xe2,
-	0xdd, 0x1c, 0x4e, 0x12, 0x4b, 0xff, 0x35, 0xe0, 0xe6, 0x05, 0xc2, 0xe8, 0x1e, 0x5c, 0xf3, 0x98,
-	0xef, 0x53, 0xce, 0x09, 0x91, 0xf6, 0xe7, 0x9d, 0x11, 0x61, 0x54, 0x20, 0x73, 0x99, 0x02, 0x79,
-	0x61, 0x29, 0x7d, 0x00, 0x4b, 0x34, 0x76, 0x43, 0x55, 0xe1, 0x23, 0x59, 0x09, 0x16, 0x1d, 0xa0,
-	0xb1, 0xae, 0xf9, 0xd1, 0xd8, 0x81, 0x2d, 0x8c, 0x67, 0xff, 0x17, 0x69, 0xf6, 0xe7, 0x4d, 0x63,
-	0x63, 0xb9, 0xf2, 0x93, 0x59, 0xb3, 0x3f, 0xc9, 0xfa, 0xbf, 0xe4, 0xe0, 0xf6, 0x94, 0x9b, 0x91,
-	0x51, 0x6e, 0xfc, 0x20, 0xe5, 0xe8, 0xa7, 0x70, 0x87, 0xf0, 0xde, 0xae, 0xdb, 0x21, 0x21, 0x8b,
-	0x29, 0x57, 0x3d, 0xd9, 0x0d, 0x06, 0x7e, 0x9b, 0x44, 0x3a, 0x36, 0x62, 0x2e, 0xd8, 0x3d, 0x54,
-	0x7c, 0xd9, 0x31, 0x1b, 0x92, 0x8b, 0x3e, 0x85, 0xb5, 0x04, 0x45, 0x03, 0xaf, 0x3f, 0x88, 0x29,
-	0x0b, 0xdc, 0x4c, 0xf8, 0x56, 0x35, 0xb7, 0x9e, 0x30, 0x9b, 0x22, 0x9c, 0x9b, 0x50, 0xc4, 0x69,
-	0x71, 0x71, 0x65, 0xca, 0xe9, 0x26, 0xb5, 0x32, 0xa2, 0xd7, 0x04, 0x19, 0x7d, 0x01, 0xf7, 0xa4,
-	0x02, 0x21, 0x48, 0x03, 0x37, 0x03, 0x7b, 0x3b, 0x20, 0x03, 0x22, 0x43, 0x3d, 0xef, 0xdc, 0x49,
-	0x64, 0xea, 0xc1, 0xa8, 0x6a, 0x7d, 0x29, 0x04, 0xac, 0x27, 0x70, 0xfd, 0x90, 0xf9, 0x98, 0xa6,
-	0x35, 0x78, 0x15, 0x16, 0x94, 0x45, 0x7d, 0x45, 0xe4, 0x02, 0xad, 0x41, 0xbe, 0x23, 0xc5, 0x92,
-	0xc6, 0xaa, 0x56, 0xd6, 0xe7, 0xb0, 0x9c, 0xc0, 0x75, 0xb8, 0x37, 0xa1, 0x28, 0xf2, 0x0b, 0xf3,
-	0x41, 0x44, 0x5c, 0x8d, 0x51, 0xaa, 0x56, 0x52, 0xba, 0x82, 0x58, 0x7f, 0xcc, 0xc1, 0x0d, 0x19,
-	0xad, 0x56, 0x44, 0x46, 0x8d, 0xee, 0x29, 0xcc, 0xf3, 0x48, 0xe7, 0xe3, 0x52, 0xa5, 0x32, 0xed,
-	0xb4, 0x26, 0x80, 0xb6, 0x58, 0x34, 0x58, 0x87, 0x38, 0x12, 0x5f, 0xfa, 0xb3, 0x01, 0x8b, 0x09,
-	0x09, 0x7d, 0x06, 0x0b, 0xf2, 0xd8, 0xa4, 0x2b, 0x4b, 0x15, 0x6b, 0xa4, 0x95, 0xf0, 0x9e, 0x9d,
-	0x8c, 0x53, 0xf6, 0xbe, 0x34, 0xa1, 0x66, 0x1e, 0x05, 0x18, 0x9b, 0x53, 0x72, 0x63, 0x73, 0x0a,
-	0xda, 0x06, 0x14, 0xe2, 0x88, 0x53, 0x8f, 0x86, 0xb2, 0xe9, 0x0c, 0x19, 0x27, 0x49, 0x33, 0xbd,
-	0x91, 0xe5, 0xbc, 0x14, 0x0c, 0x71, 0x53, 0x74, 0xaf, 0x96, 0x72, 0xea, 0x54, 0x41, 0xb5, 0x69,
-	0x41, 0xb1, 0x8e, 0x61, 0x55, 0x38, 0x2d, 0x5d, 0x10, 0xc9, 0x90, 0x1c, 0xcb, 0x5d, 0xb8, 0x26,
-	0xf2, 0xc6, 0x7d, 0x1d, 0x31, 0x5f, 0xc7, 0x73, 0x51, 0x10, 0x9e, 0x46, 0xcc, 0x17, 0x73, 0x8f,
-	0x64, 0x72, 0xa6, 0xf3, 0x31, 0x2f, 0x96, 0x2d, 0xb6, 0xf5, 0x19, 0x5c, 0x4f, 0xb3, 0xda, 0x61,
-	0x7d, 0x82, 0x96, 0xe0, 0xc3, 0x17, 0x8d, 0xe7, 0x8d, 0x93, 0x57, 0x8d, 0xe2, 0x07, 0xa8, 0x00,
-	0x8b, 0xd5, 0x56, 0xab, 0xd6, 0x6c, 0xd5, 0x9c, 0xa2, 0x21, 0x56, 0xa7, 0xce, 0xc9, 0xe9, 0x49,
-	0xb3, 0xe6, 0x14, 0x73, 0x5b, 0x7f, 0x30, 0x60, 0x65, 0xec, 0x42, 0x20, 0x04, 0xcb, 0x1a, 0xec,
-	0x36, 0x5b, 0xd5, 0xd6, 0x8b, 0x66, 0xf1, 0x03, 0x41, 0x3b, 0xad, 0x35, 0x0e, 0xeb, 0x8d, 0x23,
-	0xb7, 0x7a, 0xd0, 0xaa, 0xbf, 0xac, 0x15, 0x0d, 0x04, 0x90, 0xd7, 0xdf, 0x39, 0xc1, 0xaf, 0x37,
-	0xea, 0xad, 0x7a, 0xb5, 0x55, 0x3b, 0x74, 0x6b, 0x5f, 0xd5, 0x5b, 0xc5, 0x39, 0x54, 0x84, 0xc2,
-	0xab, 0x7a, 0xeb, 0xd9, 0xa1, 0x53, 0x7d, 0x55, 0xdd, 0x3f, 0xae, 0x15, 0xe7, 0x05, 0x42, 0xf0,
-	0x6a, 0x87, 0xc5, 0x05, 0x81, 0x50, 0xdf, 0x6e, 0xf3, 0xb8, 0xda, 0x7c, 0x56, 0x3b, 0x2c, 0xe6,
-	0x2b, 0xff, 0x98, 0x83, 0xeb, 0xea, 0x6c, 0x9a, 0x6a, 0x20, 0x47, 0xbf, 0x84, 0x1b, 0xaf, 0x30,
-	0xe5, 0x4f, 0x59, 0x34, 0xea, 0x3a, 0x68, 0xcd, 0x56, 0xc3, 0xaf, 0x9d, 0xcc, 0xe1, 0x76, 0x4d,
-	0xcc, 0xe1, 0xa5, 0xad, 0x69, 0x49, 0x34, 0xd9, 0xb1, 0x76, 0x0c, 0xf4, 0x1c, 0xae, 0x1f, 0xe0,
-	0x80, 0x05, 0xd4, 0xc3, 0xfd, 0x67, 0x04, 0x77, 0xa6, 0xaa, 0x9d, 0x21, 0x8b, 0xd0, 0x77, 0x06,
-	0x5c, 0x4b, 0x53, 0x75, 0xaa, 0xa6, 0xcd, 0x99, 0xb3, 0xdc, 0x3a, 0xf9, 0xb6, 0xba, 0x83, 0xec,
-	0xa7, 0x84, 0x7b, 0x3d, 0x12, 0x9b, 0x32, 0x11, 0x4d, 0x91, 0xef, 0x66, 0x4c, 0x03, 0x8f, 0x98,
-	0x7d, 0x1c, 0x73, 0xf3, 0x35, 0x0d, 0x70, 0x9f, 0xfe, 0x86, 0x74, 0x14, 0xdf, 0xfe, 0xfd, 0xbf,
-	0xbe, 0xff, 0x53, 0x6e, 0x0d, 0xad, 0x8a, 0x87, 0x87, 0x7e, 0x86, 0x48, 0x86, 0xc0, 0xa1, 0x37,
-	0x50, 0x4c, 0xad, 0xec, 0x9f, 0x89, 0x9c, 0x8b, 0xd1, 0x27, 0xd3, 0xfc, 0xb9, 0x28, 0x37, 0xaf,
-	0xe0, 0x7d, 0xe5, 0x3f, 0x06, 0xac, 0xa8, 0xf9, 0x9a, 0x44, 0xc9, 0x51, 0xf6, 0x00, 0x69, 0x4d,
-	0x99, 0x89, 0x1f, 0x4d, 0x3d, 0xb3, 0xc9, 0x67, 0x41, 0xe9, 0xe3, 0x29, 0x07, 0x91, 0x11, 0x3d,
-	0xc4, 0x1c, 0x23, 0x17, 0x6e, 0x34, 0x07, 0x6d, 0x9f, 0x9e, 0x33, 0x64, 0x5d, 0x0e, 0xce, 0x1a,
-	0xb8, 0xc8, 0x99, 0x74, 0x7b, 0xff, 0x34, 0xd2, 0x87, 0x4e, 0xba, 0xbd, 0xaf, 0xa0, 0xa0, 0xfd,
-	0x54, 0x19, 0xf1, 0xf0, 0xbd, 0xd1, 0x4a, 0xb6, 0x34, 0x4b, 0x6e, 0x7d, 0x0d, 0x05, 0x6d, 0x4c,
-	0xad, 0x67, 0xc0, 0x94, 0xa6, 0x76, 0xbf, 0xb1, 0xf7, 0x59, 0xe5, 0x6f, 0x79, 0x28, 0x8e, 0x0a,
-	0x80, 0xde, 0xcb, 0xd7, 0x00, 0xaa, 0x76, 0xcb, 0x70, 0x7e, 0x34, 0x4d, 0xd7, 0xb9, 0x8e, 0x32,
-	0x3d, 0x78, 0x63, 0x9d, 0xe3, 0xb7, 0xe9, 0x95, 0x1e, 0x35, 0x29, 0x54, 0xb9, 0xd2, 0x1c, 0xae,
-	0x0c, 0x3e, 0xfa, 0x01, 0xb3, 0xfb, 0x8e, 0x81, 0x18, 0x2c, 0x9f, 0x1f, 0x1b, 0xd1, 0xf6, 0xa5,
-	0x8a, 0xb2, 0x63, 0x69, 0xc9, 0x9e, 0x55, 0x5c, 0x6f, 0xb8, 0x0f, 0x37, 0x0f, 0x92, 0x69, 0x2b,
-	0x33, 0x95, 0x6d, 0xce, 0x32, 0x02, 0x2a, 0x8b, 0x5b, 0xb3, 0x4f, 0x8b, 0xe8, 0xed, 0x64, 0x41,
-	0xbf, 0xe2, 0xfe, 0xae, 0xfa, 0x28, 0x41, 0xbf, 0x33, 0x60, 0xf5, 0xa2, 0x47, 0x2d, 0xba, 0xfc,
-	0x84, 0x26, 0x5f, 0xd5, 0xa5, 0x4f, 0xaf, 0x06, 0xd2, 0x3e, 0x0c, 0xa0, 0x38, 0xfe, 0xa8, 0x41,
-	0x53, 0x37, 0x32, 0xe5, 0xe9, 0x54, 0xda, 0x99, 0x1d, 0xa0, 0xcc, 0xee, 0xff, 0x7d, 0xee, 0xdb,
-	0xea, 0x5f, 0xe7, 0xd0, 0xbf, 0x0d, 0x58, 0x38, 0x8d, 0xce, 0x62, 0x1f, 0x3d, 0xfc, 0x45, 0xf3,
-	0xa4, 0x61, 0x3a, 0xa7, 0x07, 0x66, 0xf2, 0x9f, 0x92, 0x19, 0x46, 0x6c, 0x48, 0x3b, 0xa2, 0x4a,
-	0x9f, 0x99, 0x52, 0xc8, 0xb6, 0x0e, 0x60, 0x59, 0x7e, 0x61, 0x4e, 0x3d, 0xf3, 0x18, 0xb7, 0x63,
-	0x74, 0xa7, 0xc7, 0x79, 0x18, 0xef, 0x95, 0xcb, 0x61, 0x42, 0xef, 0xe3, 0x76, 0x6c, 0x7b, 0xcc,
-	0x2f, 0xad, 0x71, 0x82, 0xfd, 0x9f, 0x4f, 0xd0, 0xb7, 0x7e, 0x05, 0x0f, 0x8e, 0x1a, 0x2f, 0xcc,
-	0x23, 0x12, 0x90, 0x08, 0xf7, 0x4d, 0xf5, 0xce, 0x35, 0x8f, 0xa9, 0x47, 0x82, 0x98, 0x98, 0xc3,
-	0x47, 0xf6, 0x0e, 0x7a, 0x92, 0x68, 0xed, 0x52, 0xde, 0x1b, 0xb4, 0x05, 0xec, 0xbc, 0x01, 0xb5,
-	0x12, 0x6d, 0xa2, 0x5d, 0xf6, 0xb1, 0x28, 0xd7, 0xe5, 0xe3, 0xfa, 0x41, 0xad, 0xd1, 0xac, 0xd9,
-	0x7e, 0xa7, 0xb2, 0xb0, 0x63, 0xef, 0xd8, 0x3b, 0xa5, 0x15, 0x1c, 0x52, 0x3b, 0x8c, 0xce, 0xa4,
-	0xe5, 0x80, 0xf0, 0x2d, 0x23, 0x57, 0x29, 0xe2, 0x30, 0xec, 0x53, 0x4f, 0x5e, 0xae, 0xf2, 0xaf,
-	0x63, 0x16, 0x54, 0xee, 0x64, 0x29, 0xdd, 0x28, 0xf4, 0xb6, 0xbf, 0x21, 0xed, 0x6d, 0x4e, 0xde,
-	0xf1, 0x29, 0xac, 0xf7, 0xa0, 0x04, 0x6b, 0x6f, 0xc2, 0xc4, 0xde, 0x74, 0x13, 0xd1, 0x63, 0x51,
-	0x24, 0xcf, 0x62, 0xdf, 0x3c, 0x92, 0x3b, 0x45, 0x1f, 0xcf, 0xb6, 0xf3, 0x76, 0x5e, 0xb6, 0xe8,
-	0x47, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xdb, 0x85, 0xd9, 0x17, 0x14, 0x00, 0x00,
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BeaconStateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BeaconStateRequest_Slot)(nil),
+		(*BeaconStateRequest_BlockRoot)(nil),
+	}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// BeaconServiceClient is the client API for BeaconService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconServiceClient interface {
-	WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
-	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+type BeaconStateResponse struct {
+	EncodedState         []byte   `protobuf:"bytes,1,opt,name=encoded_state,json=encodedState,proto3" json:"encoded_state,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type beaconServiceClient struct {
-	cc *grpc.ClientConn
+func (m *BeaconStateResponse) Reset()         { *m = BeaconStateResponse{} }
+func (m *BeaconStateResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateResponse) ProtoMessage()    {}
+func (*BeaconStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
 }
 
-func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
-	return &beaconServiceClient{cc}
+func (m *BeaconStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconStateResponse.Unmarshal(m, b)
+}
+func (m *BeaconStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconStateResponse.Marshal(b, m, deterministic)
+}
+func (m *BeaconStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateResponse.Merge(m, src)
+}
+func (m *BeaconStateResponse) XXX_Size() int {
+	return xxx_messageInfo_BeaconStateResponse.Size(m)
+}
+func (m *BeaconStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateResponse.DiscardUnknown(m)
 }
 
-func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &beaconServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+var xxx_messageInfo_BeaconStateResponse proto.InternalMessageInfo
+
+func (m *BeaconStateResponse) GetEncodedState() []byte {
+	if m != nil {
+		return m.EncodedState
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *BeaconStateResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return x, nil
+	return 0
 }
 
-type BeaconService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+type BeaconStateChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type beaconServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *BeaconStateChunk) Reset()         { *m = BeaconStateChunk{} }
+func (m *BeaconStateChunk) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateChunk) ProtoMessage()    {}
+func (*BeaconStateChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{29}
 }
 
-func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *BeaconStateChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconStateChunk.Unmarshal(m, b)
+}
+func (m *BeaconStateChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconStateChunk.Marshal(b, m, deterministic)
+}
+func (m *BeaconStateChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateChunk.Merge(m, src)
+}
+func (m *BeaconStateChunk) XXX_Size() int {
+	return xxx_messageInfo_BeaconStateChunk.Size(m)
+}
+func (m *BeaconStateChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateChunk.DiscardUnknown(m)
 }
 
-func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BeaconStateChunk proto.InternalMessageInfo
+
+func (m *BeaconStateChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconServiceClient) BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
+func (m *BeaconStateChunk) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type SetFeatureRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFeatureRequest) Reset()         { *m = SetFeatureRequest{} }
+func (m *SetFeatureRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFeatureRequest) ProtoMessage()    {}
+func (*SetFeatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{30}
+}
+
+func (m *SetFeatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFeatureRequest.Unmarshal(m, b)
+}
+func (m *SetFeatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFeatureRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFeatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeatureRequest.Merge(m, src)
+}
+func (m *SetFeatureRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFeatureRequest.Size(m)
+}
+func (m *SetFeatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeatureRequest proto.InternalMessageInfo
+
+func (m *SetFeatureRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetFeatureRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type FeaturesResponse struct {
+	Features             []*FeaturesResponse_Feature `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *FeaturesResponse) Reset()         { *m = FeaturesResponse{} }
+func (m *FeaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*FeaturesResponse) ProtoMessage()    {}
+func (*FeaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{31}
+}
+
+func (m *FeaturesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeaturesResponse.Unmarshal(m, b)
+}
+func (m *FeaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeaturesResponse.Marshal(b, m, deterministic)
+}
+func (m *FeaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeaturesResponse.Merge(m, src)
+}
+func (m *FeaturesResponse) XXX_Size() int {
+	return xxx_messageInfo_FeaturesResponse.Size(m)
+}
+func (m *FeaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeaturesResponse proto.InternalMessageInfo
+
+func (m *FeaturesResponse) GetFeatures() []*FeaturesResponse_Feature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type FeaturesResponse_Feature struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeaturesResponse_Feature) Reset()         { *m = FeaturesResponse_Feature{} }
+func (m *FeaturesResponse_Feature) String() string { return proto.CompactTextString(m) }
+func (*FeaturesResponse_Feature) ProtoMessage()    {}
+func (*FeaturesResponse_Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{31, 0}
+}
+
+func (m *FeaturesResponse_Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeaturesResponse_Feature.Unmarshal(m, b)
+}
+func (m *FeaturesResponse_Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeaturesResponse_Feature.Marshal(b, m, deterministic)
+}
+func (m *FeaturesResponse_Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeaturesResponse_Feature.Merge(m, src)
+}
+func (m *FeaturesResponse_Feature) XXX_Size() int {
+	return xxx_messageInfo_FeaturesResponse_Feature.Size(m)
+}
+func (m *FeaturesResponse_Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeaturesResponse_Feature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeaturesResponse_Feature proto.InternalMessageInfo
+
+func (m *FeaturesResponse_Feature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeaturesResponse_Feature) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type CaptureProfilesRequest struct {
+	DurationSeconds      uint64   `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CaptureProfilesRequest) Reset()         { *m = CaptureProfilesRequest{} }
+func (m *CaptureProfilesRequest) String() string { return proto.CompactTextString(m) }
+func (*CaptureProfilesRequest) ProtoMessage()    {}
+func (*CaptureProfilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{32}
+}
+
+func (m *CaptureProfilesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaptureProfilesRequest.Unmarshal(m, b)
+}
+func (m *CaptureProfilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaptureProfilesRequest.Marshal(b, m, deterministic)
+}
+func (m *CaptureProfilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureProfilesRequest.Merge(m, src)
+}
+func (m *CaptureProfilesRequest) XXX_Size() int {
+	return xxx_messageInfo_CaptureProfilesRequest.Size(m)
+}
+func (m *CaptureProfilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureProfilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureProfilesRequest proto.InternalMessageInfo
+
+func (m *CaptureProfilesRequest) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+type CaptureProfilesResponse struct {
+	Files                []string `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CaptureProfilesResponse) Reset()         { *m = CaptureProfilesResponse{} }
+func (m *CaptureProfilesResponse) String() string { return proto.CompactTextString(m) }
+func (*CaptureProfilesResponse) ProtoMessage()    {}
+func (*CaptureProfilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{33}
+}
+
+func (m *CaptureProfilesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaptureProfilesResponse.Unmarshal(m, b)
+}
+func (m *CaptureProfilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaptureProfilesResponse.Marshal(b, m, deterministic)
+}
+func (m *CaptureProfilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureProfilesResponse.Merge(m, src)
+}
+func (m *CaptureProfilesResponse) XXX_Size() int {
+	return xxx_messageInfo_CaptureProfilesResponse.Size(m)
+}
+func (m *CaptureProfilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureProfilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureProfilesResponse proto.InternalMessageInfo
+
+func (m *CaptureProfilesResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type TreeBlockSlotRequest struct {
+	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
+	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
+func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*TreeBlockSlotRequest) ProtoMessage()    {}
+func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{34}
+}
+
+func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreeBlockSlotRequest.Unmarshal(m, b)
+}
+func (m *TreeBlockSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreeBlockSlotRequest.Marshal(b, m, deterministic)
+}
+func (m *TreeBlockSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeBlockSlotRequest.Merge(m, src)
+}
+func (m *TreeBlockSlotRequest) XXX_Size() int {
+	return xxx_messageInfo_TreeBlockSlotRequest.Size(m)
+}
+func (m *TreeBlockSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeBlockSlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeBlockSlotRequest proto.InternalMessageInfo
+
+func (m *TreeBlockSlotRequest) GetSlotFrom() uint64 {
+	if m != nil {
+		return m.SlotFrom
+	}
+	return 0
+}
+
+func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
+	if m != nil {
+		return m.SlotTo
+	}
+	return 0
+}
+
+type ListPublicKeysResponse struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPublicKeysResponse) Reset()         { *m = ListPublicKeysResponse{} }
+func (m *ListPublicKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPublicKeysResponse) ProtoMessage()    {}
+func (*ListPublicKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{35}
+}
+
+func (m *ListPublicKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPublicKeysResponse.Unmarshal(m, b)
+}
+func (m *ListPublicKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPublicKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPublicKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPublicKeysResponse.Merge(m, src)
+}
+func (m *ListPublicKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPublicKeysResponse.Size(m)
+}
+func (m *ListPublicKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPublicKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPublicKeysResponse proto.InternalMessageInfo
+
+func (m *ListPublicKeysResponse) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type SignRequest struct {
+	PublicKey       []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	SigningRoot     []byte `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
+	SignatureDomain uint64 `protobuf:"varint,3,opt,name=signature_domain,json=signatureDomain,proto3" json:"signature_domain,omitempty"`
+	// Types that are valid to be assigned to Object:
+	//	*SignRequest_Epoch
+	//	*SignRequest_Block
+	//	*SignRequest_AttestationData
+	Object               isSignRequest_Object `protobuf_oneof:"object"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{36}
+}
+
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRequest.Unmarshal(m, b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+}
+func (m *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(m, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return xxx_messageInfo_SignRequest.Size(m)
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SignRequest) GetSigningRoot() []byte {
+	if m != nil {
+		return m.SigningRoot
+	}
+	return nil
+}
+
+func (m *SignRequest) GetSignatureDomain() uint64 {
+	if m != nil {
+		return m.SignatureDomain
+	}
+	return 0
+}
+
+type isSignRequest_Object interface {
+	isSignRequest_Object()
+}
+
+type SignRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3,oneof"`
+}
+
+type SignRequest_Block struct {
+	Block *v1alpha1_gateway.BeaconBlock `protobuf:"bytes,5,opt,name=block,proto3,oneof"`
+}
+
+type SignRequest_AttestationData struct {
+	AttestationData *v1alpha1_gateway.AttestationData `protobuf:"bytes,6,opt,name=attestation_data,json=attestationData,proto3,oneof"`
+}
+
+func (*SignRequest_Epoch) isSignRequest_Object() {}
+
+func (*SignRequest_Block) isSignRequest_Object() {}
+
+func (*SignRequest_AttestationData) isSignRequest_Object() {}
+
+func (m *SignRequest) GetObject() isSignRequest_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *SignRequest) GetEpoch() uint64 {
+	if x, ok := m.GetObject().(*SignRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (m *SignRequest) GetBlock() *v1alpha1_gateway.BeaconBlock {
+	if x, ok := m.GetObject().(*SignRequest_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (m *SignRequest) GetAttestationData() *v1alpha1_gateway.AttestationData {
+	if x, ok := m.GetObject().(*SignRequest_AttestationData); ok {
+		return x.AttestationData
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SignRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SignRequest_Epoch)(nil),
+		(*SignRequest_Block)(nil),
+		(*SignRequest_AttestationData)(nil),
+	}
+}
+
+type SignResponse struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{37}
+}
+
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositStatusResponse_Stage", DepositStatusResponse_Stage_name, DepositStatusResponse_Stage_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*UnsignedBlockResponse)(nil), "ethereum.beacon.rpc.v1.UnsignedBlockResponse")
+	proto.RegisterType((*SignedBlockRequest)(nil), "ethereum.beacon.rpc.v1.SignedBlockRequest")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ProposeExitResponse)(nil), "ethereum.beacon.rpc.v1.ProposeExitResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*DutiesRequest)(nil), "ethereum.beacon.rpc.v1.DutiesRequest")
+	proto.RegisterType((*DutiesResponse)(nil), "ethereum.beacon.rpc.v1.DutiesResponse")
+	proto.RegisterType((*DutiesResponse_Duty)(nil), "ethereum.beacon.rpc.v1.DutiesResponse.Duty")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DepositStatusResponse)(nil), "ethereum.beacon.rpc.v1.DepositStatusResponse")
+	proto.RegisterType((*MultipleValidatorStatusRequest)(nil), "ethereum.beacon.rpc.v1.MultipleValidatorStatusRequest")
+	proto.RegisterType((*MultipleValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.MultipleValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BeaconStateResponse)(nil), "ethereum.beacon.rpc.v1.BeaconStateResponse")
+	proto.RegisterType((*BeaconStateChunk)(nil), "ethereum.beacon.rpc.v1.BeaconStateChunk")
+	proto.RegisterType((*SetFeatureRequest)(nil), "ethereum.beacon.rpc.v1.SetFeatureRequest")
+	proto.RegisterType((*FeaturesResponse)(nil), "ethereum.beacon.rpc.v1.FeaturesResponse")
+	proto.RegisterType((*FeaturesResponse_Feature)(nil), "ethereum.beacon.rpc.v1.FeaturesResponse.Feature")
+	proto.RegisterType((*CaptureProfilesRequest)(nil), "ethereum.beacon.rpc.v1.CaptureProfilesRequest")
+	proto.RegisterType((*CaptureProfilesResponse)(nil), "ethereum.beacon.rpc.v1.CaptureProfilesResponse")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*ListPublicKeysResponse)(nil), "ethereum.beacon.rpc.v1.ListPublicKeysResponse")
+	proto.RegisterType((*SignRequest)(nil), "ethereum.beacon.rpc.v1.SignRequest")
+	proto.RegisterType((*SignResponse)(nil), "ethereum.beacon.rpc.v1.SignResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 3146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3a, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xd5, 0x1e, 0x8a, 0x7a, 0x1d, 0x51, 0x12, 0x75, 0x2d, 0x4b, 0x32, 0x2d, 0xdb, 0x93, 0xb1, 0x9d,
+	0xd8, 0x8a, 0x45, 0xca, 0x74, 0xe0, 0x24, 0xca, 0x97, 0xcf, 0xa1, 0x44, 0x5a, 0xe6, 0x17, 0x7d,
+	0x94, 0x32, 0xa4, 0xed, 0xa0, 0x5d, 0x4c, 0x2f, 0xc9, 0x6b, 0x72, 0x62, 0x72, 0x66, 0x3c, 0x73,
+	0xc9, 0x98, 0xed, 0xa2, 0x40, 0x81, 0xae, 0x1a, 0x34, 0x4d, 0xb2, 0xea, 0x2a, 0x01, 0x5a, 0xa0,
+	0x45, 0xd1, 0xae, 0x5a, 0xa0, 0x40, 0x0b, 0xf4, 0x07, 0x14, 0xdd, 0x15, 0xe8, 0xa6, 0x40, 0xbb,
+	0xc9, 0xa2, 0x3f, 0xa3, 0xb8, 0x8f, 0x19, 0x0e, 0x1f, 0x23, 0x51, 0x6e, 0xd0, 0x95, 0x38, 0xe7,
+	0x9e, 0xd7, 0x9c, 0x73, 0xee, 0x79, 0x8d, 0x40, 0x73, 0x5c, 0x9b, 0xda, 0x99, 0x2a, 0xc1, 0x35,
+	0xdb, 0xca, 0xb8, 0x4e, 0x2d, 0xd3, 0xbd, 0x93, 0xf1, 0x88, 0xdb, 0x35, 0x6b, 0xc4, 0x4b, 0xf3,
+	0x43, 0xb4, 0x46, 0x68, 0x93, 0xb8, 0xa4, 0xd3, 0x4e, 0x0b, 0xb4, 0xb4, 0xeb, 0xd4, 0xd2, 0xdd,
+	0x3b, 0xa9, 0x4b, 0x0d, 0xdb, 0x6e, 0xb4, 0x48, 0x86, 0x63, 0x55, 0x3b, 0x4f, 0x33, 0xa4, 0xed,
+	0xd0, 0x9e, 0x20, 0x4a, 0x5d, 0x1d, 0x60, 0xec, 0x64, 0x1d, 0xc6, 0x98, 0xf6, 0x1c, 0x9f, 0x6b,
+	0xea, 0x86, 0x40, 0x20, 0xb4, 0x99, 0xe9, 0xde, 0xc1, 0x2d, 0xa7, 0x89, 0xef, 0x48, 0x6c, 0xa3,
+	0xda, 0xb2, 0x6b, 0xcf, 0x24, 0xda, 0xf5, 0x31, 0x68, 0x98, 0x52, 0xe2, 0x51, 0x4c, 0x4d, 0xdb,
+	0x92, 0x58, 0x9b, 0x52, 0x15, 0xec, 0x98, 0x19, 0x6c, 0x59, 0xb6, 0x38, 0xf4, 0x45, 0xdd, 0xe6,
+	0x7f, 0x6a, 0xdb, 0x0d, 0x62, 0x6d, 0x7b, 0x1f, 0xe3, 0x46, 0x83, 0xb8, 0x19, 0xdb, 0xe1, 0x18,
+	0xa3, 0xd8, 0xda, 0x01, 0x24, 0xf6, 0x98, 0x02, 0x3a, 0x79, 0xde, 0x21, 0x1e, 0x45, 0x08, 0xe2,
+	0x5e, 0xcb, 0xa6, 0x1b, 0x8a, 0xaa, 0xdc, 0x8c, 0xeb, 0xfc, 0x37, 0xba, 0x06, 0x8b, 0x2e, 0xb6,
+	0xea, 0xd8, 0x36, 0x5c, 0xd2, 0x25, 0xb8, 0xb5, 0x11, 0x53, 0x95, 0x9b, 0x09, 0x3d, 0x21, 0x80,
+	0x3a, 0x87, 0x69, 0x3b, 0xb0, 0x7c, 0xec, 0xda, 0x8e, 0xed, 0x11, 0x9d, 0x78, 0x8e, 0x6d, 0x79,
+	0x04, 0x5d, 0x06, 0xe0, 0x2f, 0x67, 0xb8, 0xb6, 0xe4, 0x98, 0xd0, 0xe7, 0x39, 0x44, 0xb7, 0x6d,
+	0xaa, 0x7d, 0xa9, 0xc0, 0x85, 0x47, 0x96, 0x67, 0x36, 0x2c, 0x52, 0x97, 0x3a, 0x48, 0xc2, 0xb7,
+	0x60, 0x9a, 0xa3, 0x71, 0x9a, 0x85, 0xac, 0x96, 0x0e, 0x7c, 0x42, 0x68, 0x33, 0xed, 0x5b, 0x26,
+	0xbd, 0xc7, 0x0d, 0x28, 0x48, 0x05, 0x01, 0x7a, 0x05, 0x12, 0x8c, 0xa1, 0x69, 0x35, 0x84, 0x50,
+	0xa1, 0xe9, 0x82, 0x84, 0x31, 0xb1, 0xe8, 0x16, 0x24, 0xd9, 0x23, 0xa6, 0x1d, 0x97, 0x18, 0x75,
+	0xbb, 0x8d, 0x4d, 0x6b, 0x63, 0x8a, 0xbf, 0xed, 0x72, 0x00, 0xcf, 0x73, 0xb0, 0xd6, 0x02, 0x54,
+	0x0e, 0xab, 0x27, 0x4c, 0xf4, 0xf2, 0xda, 0x6d, 0xc2, 0x7c, 0x20, 0x42, 0xaa, 0xd6, 0x07, 0x68,
+	0x5d, 0x40, 0xb9, 0xbe, 0xaf, 0x7d, 0x69, 0x97, 0x01, 0x9c, 0x4e, 0xb5, 0x65, 0xd6, 0x8c, 0x67,
+	0xa4, 0xe7, 0x1b, 0x51, 0x40, 0xde, 0x27, 0x3d, 0xb4, 0x0e, 0xb3, 0x8e, 0x5d, 0x33, 0xaa, 0xa6,
+	0xff, 0xae, 0x33, 0x8e, 0x5d, 0xdb, 0x33, 0xfb, 0x8e, 0x9c, 0x0a, 0x39, 0x72, 0x15, 0xa6, 0xbd,
+	0x26, 0x76, 0xeb, 0x1b, 0x71, 0x0e, 0x14, 0x0f, 0xda, 0x75, 0x58, 0x12, 0x72, 0x03, 0xfb, 0x23,
+	0x88, 0x87, 0x5c, 0xc6, 0x7f, 0x6b, 0xc7, 0x70, 0xe9, 0x31, 0x6e, 0x99, 0x75, 0x4c, 0x6d, 0xf7,
+	0x98, 0xb8, 0x4f, 0x6d, 0xb7, 0x8d, 0xad, 0x1a, 0x39, 0x29, 0x6e, 0x06, 0x55, 0x8f, 0x0d, 0xa9,
+	0xae, 0x7d, 0xad, 0xc0, 0xe6, 0x78, 0x96, 0x52, 0x8d, 0x0d, 0x98, 0xad, 0xe2, 0x16, 0x03, 0x49,
+	0xb6, 0xfe, 0x23, 0xf3, 0x21, 0xb5, 0x29, 0x6e, 0x19, 0x5d, 0x9f, 0xde, 0xe3, 0xfc, 0xe3, 0xfa,
+	0x32, 0x87, 0x07, 0x6c, 0x3d, 0x74, 0x0f, 0xd6, 0x05, 0x2a, 0xae, 0x51, 0xb3, 0x4b, 0xc2, 0x14,
+	0xc2, 0x34, 0x17, 0xf8, 0x71, 0x8e, 0x9f, 0x86, 0xe8, 0x0e, 0x40, 0xc5, 0x5d, 0xe2, 0xe2, 0x06,
+	0x19, 0xa1, 0x34, 0x7c, 0xad, 0x98, 0x19, 0x63, 0xfa, 0x65, 0x89, 0x37, 0xc4, 0x62, 0x4f, 0x20,
+	0x69, 0xef, 0x42, 0x2a, 0x80, 0x71, 0x94, 0x01, 0xf7, 0x5e, 0x85, 0x85, 0xbe, 0x8d, 0xbc, 0x0d,
+	0x45, 0x9d, 0xba, 0x99, 0xd0, 0x21, 0x30, 0x92, 0xa7, 0x7d, 0x19, 0x0b, 0x19, 0x3e, 0x4c, 0x2f,
+	0x8d, 0x74, 0x0f, 0x2e, 0x60, 0x01, 0x25, 0x75, 0x63, 0x84, 0xd5, 0x5e, 0x6c, 0x43, 0xd1, 0xcf,
+	0x07, 0x08, 0xc7, 0x01, 0x5f, 0xf4, 0x18, 0xe6, 0x58, 0xa4, 0x75, 0x3c, 0xc2, 0x4c, 0x37, 0x75,
+	0x73, 0x21, 0xbb, 0x9b, 0x1e, 0x9f, 0xfa, 0xd2, 0x27, 0x88, 0x4f, 0x97, 0x39, 0x0f, 0x3d, 0xe0,
+	0x95, 0x72, 0x60, 0x46, 0xc0, 0x4e, 0x8b, 0xdc, 0x03, 0x98, 0x11, 0x44, 0xdc, 0x73, 0x0b, 0xd9,
+	0xcc, 0xa9, 0xe2, 0xa5, 0x2c, 0x29, 0x5a, 0x97, 0xe4, 0xda, 0x2e, 0xac, 0x17, 0x5e, 0x98, 0x94,
+	0xd4, 0xfb, 0xde, 0x9b, 0xd8, 0xba, 0xef, 0xc0, 0xc6, 0x28, 0xad, 0xb4, 0xec, 0xa9, 0xc4, 0x59,
+	0x38, 0x2f, 0x53, 0x1e, 0xe3, 0x11, 0xd0, 0x5d, 0x82, 0x79, 0xf2, 0xc2, 0xa4, 0xe1, 0xac, 0x37,
+	0xc7, 0x00, 0x3c, 0xe9, 0x7d, 0x00, 0x68, 0xbf, 0x89, 0x4d, 0xab, 0x4c, 0xb1, 0x4b, 0xc3, 0x91,
+	0xee, 0x31, 0x00, 0xa9, 0x73, 0x82, 0x39, 0xdd, 0x7f, 0x64, 0x09, 0xad, 0x41, 0x2c, 0xe2, 0x99,
+	0x9e, 0x41, 0xcd, 0x36, 0x91, 0x51, 0xbe, 0x20, 0x61, 0x15, 0xb3, 0x4d, 0xb4, 0x7b, 0x70, 0x21,
+	0xd0, 0xbe, 0x68, 0xd5, 0xc9, 0x8b, 0xc9, 0x52, 0x87, 0x96, 0x86, 0xb5, 0x61, 0x3a, 0xa9, 0xce,
+	0x2a, 0x4c, 0x9b, 0x0c, 0x20, 0xaf, 0x9d, 0x78, 0xd0, 0x1e, 0xc1, 0x4a, 0xce, 0x63, 0xe9, 0xaa,
+	0x4d, 0x2c, 0x1a, 0xb2, 0x30, 0x71, 0xec, 0x5a, 0xd3, 0xe0, 0x0a, 0x4b, 0x02, 0xe0, 0x20, 0xfe,
+	0x8a, 0xc3, 0x56, 0x8c, 0x8d, 0x58, 0xf1, 0x5f, 0x31, 0x40, 0x61, 0xbe, 0x52, 0x87, 0xe7, 0xb0,
+	0xda, 0xbf, 0x70, 0x38, 0x38, 0xe7, 0x6e, 0x58, 0xc8, 0xfe, 0x6f, 0x54, 0xb0, 0x8c, 0x72, 0x0a,
+	0x85, 0x6f, 0xff, 0xec, 0x7c, 0x77, 0x14, 0x98, 0xfa, 0x87, 0x02, 0xe7, 0xc7, 0x20, 0xb3, 0xb4,
+	0x5d, 0xb3, 0xdb, 0x6d, 0x93, 0x52, 0x42, 0xb8, 0xfc, 0xb8, 0xde, 0x07, 0xf4, 0x93, 0x6a, 0x2c,
+	0x94, 0x54, 0xc7, 0xa6, 0xdf, 0xab, 0xb0, 0x60, 0x7a, 0x86, 0x23, 0x42, 0xc6, 0xe5, 0xd9, 0x63,
+	0x4e, 0x07, 0xd3, 0x93, 0x41, 0xe4, 0x0e, 0x39, 0x6c, 0x7a, 0xf8, 0xc6, 0xdc, 0x0f, 0x6e, 0xcc,
+	0x8c, 0xaa, 0xdc, 0x5c, 0xca, 0xbe, 0x36, 0xe9, 0x8d, 0xf1, 0x6f, 0x8a, 0x0d, 0x8b, 0xf9, 0x0e,
+	0x35, 0x49, 0x70, 0x3f, 0x56, 0x61, 0x9a, 0xbb, 0xca, 0x77, 0x34, 0x7f, 0x38, 0xd5, 0x65, 0xe8,
+	0x35, 0x58, 0x66, 0x2f, 0x64, 0x04, 0xb5, 0x8b, 0xe5, 0x52, 0x86, 0xb4, 0xc4, 0xc0, 0xe5, 0x00,
+	0xaa, 0x7d, 0x32, 0x05, 0x4b, 0xbe, 0x44, 0xe9, 0xd7, 0x7d, 0x98, 0xa9, 0x73, 0x88, 0xf4, 0xe4,
+	0xeb, 0x51, 0x2f, 0x31, 0x48, 0xc7, 0x1e, 0x7b, 0xba, 0x24, 0x4d, 0xfd, 0x2e, 0x06, 0x71, 0x06,
+	0x38, 0x2d, 0xc7, 0xdc, 0x1f, 0xc8, 0x31, 0x67, 0xb7, 0x18, 0x7b, 0xd3, 0x7e, 0x14, 0x8a, 0x3b,
+	0x21, 0x3c, 0xba, 0xd4, 0x1d, 0xb8, 0x3a, 0x83, 0x31, 0x12, 0x8f, 0x8c, 0x91, 0xe9, 0x70, 0x8c,
+	0x5c, 0x83, 0x45, 0xd1, 0xdc, 0x11, 0xd7, 0xe0, 0xc1, 0x32, 0xc3, 0x4f, 0x13, 0x3e, 0xb0, 0xcc,
+	0x82, 0xe6, 0x06, 0x2c, 0xf9, 0x11, 0xc3, 0x91, 0xbc, 0x8d, 0x59, 0xce, 0x7d, 0xd1, 0x87, 0x32,
+	0x2c, 0x8f, 0xf1, 0x32, 0x3d, 0x03, 0x37, 0x1a, 0x2e, 0x69, 0x30, 0xad, 0x36, 0xe6, 0x78, 0x74,
+	0x25, 0x4c, 0x2f, 0x17, 0xc0, 0xb4, 0x7f, 0x4e, 0xc1, 0x7a, 0x44, 0x36, 0x0d, 0x99, 0x4a, 0x79,
+	0x39, 0x53, 0xbd, 0x0d, 0x17, 0x09, 0x6d, 0xde, 0x31, 0xea, 0xc4, 0xb1, 0x3d, 0x93, 0x8a, 0xbe,
+	0xd6, 0xb0, 0x3a, 0xed, 0x2a, 0x71, 0xe5, 0xdd, 0x60, 0xbd, 0xf5, 0x9d, 0xbc, 0x38, 0xe7, 0x8d,
+	0x51, 0x89, 0x9f, 0xa2, 0x37, 0x60, 0xcd, 0xa7, 0x32, 0xad, 0x5a, 0xab, 0xe3, 0x99, 0xb6, 0x65,
+	0x84, 0xae, 0xcf, 0xaa, 0x3c, 0x2d, 0xfa, 0x87, 0xdc, 0x32, 0xb7, 0x20, 0x89, 0x83, 0x82, 0x64,
+	0x88, 0x38, 0x16, 0x8d, 0xcd, 0x72, 0x1f, 0x5e, 0xe0, 0x11, 0x7d, 0x1f, 0x36, 0x39, 0x03, 0x86,
+	0x68, 0x5a, 0x46, 0x88, 0xec, 0x79, 0x87, 0x74, 0x88, 0x74, 0xcb, 0x45, 0x1f, 0xa7, 0x68, 0xf5,
+	0x2b, 0xdd, 0x07, 0x0c, 0x81, 0xc5, 0x19, 0xcf, 0xe9, 0x42, 0x8a, 0xf0, 0x13, 0xcf, 0xf2, 0x82,
+	0xff, 0xff, 0x40, 0x8a, 0x78, 0xd4, 0x6c, 0xf3, 0x22, 0x3c, 0xa2, 0xd4, 0x2c, 0x47, 0xdf, 0x08,
+	0x30, 0x72, 0x43, 0xda, 0x15, 0xe1, 0x95, 0xb1, 0xd4, 0x1f, 0x63, 0x93, 0x1a, 0x1e, 0xa9, 0xd9,
+	0x56, 0xdd, 0xe3, 0xfe, 0x8c, 0xeb, 0x57, 0xc6, 0x30, 0x79, 0x82, 0x4d, 0x5a, 0x16, 0x58, 0xda,
+	0x57, 0x71, 0xb8, 0x20, 0x0d, 0x3c, 0xe4, 0xdf, 0x22, 0x4c, 0x7b, 0x14, 0x37, 0x88, 0x74, 0xef,
+	0xdd, 0xc8, 0x6b, 0x37, 0x8e, 0x9a, 0x95, 0xf9, 0x06, 0xd1, 0x05, 0x87, 0xff, 0xbe, 0xa7, 0xef,
+	0xc3, 0xe6, 0x28, 0x55, 0x68, 0xb4, 0x88, 0xf3, 0x7b, 0x7f, 0x71, 0x98, 0x76, 0xcf, 0x1f, 0x35,
+	0xc6, 0x5d, 0xe3, 0xe9, 0xb1, 0xd7, 0xf8, 0x3d, 0xd8, 0x0c, 0xbb, 0xaf, 0x65, 0x36, 0xcc, 0xaa,
+	0xd9, 0x32, 0x69, 0x6f, 0xc0, 0xf3, 0xa9, 0x50, 0x7c, 0xf5, 0x51, 0x84, 0x33, 0xc7, 0x45, 0xe5,
+	0xec, 0xd8, 0xa8, 0xd4, 0x28, 0x4c, 0x73, 0xbb, 0xa2, 0x05, 0x98, 0x7d, 0x54, 0x7a, 0xbf, 0x74,
+	0xf4, 0xa4, 0x94, 0x3c, 0x87, 0x56, 0x21, 0x99, 0x2f, 0x1c, 0x1f, 0x95, 0x8b, 0x15, 0xe3, 0x68,
+	0xaf, 0x5c, 0xd0, 0x1f, 0x17, 0xf2, 0x49, 0x25, 0x0c, 0x2d, 0x96, 0xf6, 0x0f, 0x1f, 0xe5, 0x0b,
+	0xf9, 0x64, 0x0c, 0x25, 0x60, 0xae, 0x70, 0x58, 0x3c, 0x28, 0xee, 0x1d, 0x16, 0x92, 0x53, 0x68,
+	0x03, 0x56, 0x73, 0xfb, 0x95, 0xe2, 0xe3, 0x5c, 0xa5, 0x78, 0x54, 0x32, 0xca, 0xfb, 0x0f, 0x0b,
+	0xf9, 0x47, 0x87, 0x85, 0x7c, 0x32, 0x8e, 0x00, 0x66, 0xf8, 0x49, 0x21, 0x39, 0xad, 0xe5, 0xe0,
+	0xca, 0xff, 0x77, 0x5a, 0xd4, 0x74, 0x5a, 0x64, 0x24, 0x17, 0x4c, 0xd8, 0x35, 0xf5, 0xe0, 0x6a,
+	0x24, 0x0b, 0x19, 0x6e, 0xe1, 0xf6, 0x52, 0xf9, 0xe6, 0xda, 0x4b, 0xed, 0x5d, 0x58, 0x14, 0xc3,
+	0xd9, 0xc9, 0x25, 0x6c, 0x0d, 0x66, 0xe4, 0x68, 0x27, 0xa7, 0x22, 0xf1, 0xa4, 0xbd, 0x03, 0x4b,
+	0x3e, 0xb9, 0x54, 0x74, 0xdc, 0x38, 0xa8, 0x8c, 0x1f, 0x07, 0x3f, 0x8b, 0xc1, 0x0a, 0x8f, 0xa9,
+	0x8a, 0x4b, 0xfa, 0x53, 0xca, 0x03, 0x88, 0x53, 0x57, 0x36, 0x06, 0x0b, 0xd9, 0x6c, 0xd4, 0x5b,
+	0x8e, 0x10, 0xa6, 0xd9, 0x43, 0xc9, 0xae, 0x13, 0x9d, 0xd3, 0xa7, 0x7e, 0xab, 0xc0, 0x9c, 0x0f,
+	0xfa, 0x0f, 0x66, 0xcc, 0xc1, 0xa1, 0x3b, 0x36, 0x34, 0x74, 0xa3, 0x6d, 0x40, 0x0e, 0x76, 0xa9,
+	0x59, 0x33, 0x1d, 0x9e, 0x6e, 0xba, 0x36, 0x25, 0xfe, 0x24, 0xb4, 0x12, 0x3e, 0x79, 0xcc, 0x0e,
+	0x58, 0x28, 0xc8, 0x41, 0x8b, 0xe3, 0x89, 0xf4, 0x0a, 0x62, 0xc6, 0x62, 0x10, 0xed, 0xdb, 0x80,
+	0x84, 0x12, 0xcc, 0x53, 0xa4, 0xef, 0x94, 0xd0, 0x34, 0xf8, 0xf0, 0x5c, 0xd0, 0xff, 0x8c, 0xa8,
+	0xf6, 0xf0, 0x5c, 0x48, 0xb9, 0xbd, 0x25, 0x48, 0x3c, 0xef, 0x10, 0xb7, 0x67, 0x3c, 0x35, 0x5b,
+	0x94, 0xb8, 0x5a, 0x09, 0xce, 0x0f, 0x30, 0x97, 0x16, 0xbf, 0x06, 0x8b, 0xc4, 0xaa, 0xd9, 0x75,
+	0x52, 0x67, 0x5d, 0x27, 0x25, 0xb2, 0xee, 0x27, 0x24, 0x90, 0x23, 0x07, 0x0d, 0x58, 0xac, 0xdf,
+	0x80, 0x69, 0xbb, 0x90, 0x0c, 0xf1, 0xdb, 0x6f, 0x76, 0xac, 0x67, 0x0c, 0xaf, 0x8e, 0x29, 0xf6,
+	0x67, 0x5d, 0xf6, 0x7b, 0x2c, 0x6d, 0x0e, 0x56, 0xca, 0x84, 0x3e, 0x20, 0x3c, 0x20, 0x42, 0x53,
+	0xaf, 0x85, 0xdb, 0x42, 0x81, 0x79, 0x9d, 0xff, 0x66, 0xbd, 0x3c, 0xb1, 0x70, 0xb5, 0x45, 0x44,
+	0x47, 0x38, 0xa7, 0xfb, 0x8f, 0xda, 0x4f, 0x15, 0x48, 0x4a, 0x06, 0xfd, 0x8b, 0x72, 0x08, 0x73,
+	0x4f, 0x25, 0x4c, 0x86, 0xd0, 0x4e, 0x54, 0x08, 0x0d, 0xd3, 0xfa, 0x00, 0x3d, 0xe0, 0x90, 0x7a,
+	0x13, 0x66, 0x25, 0xf0, 0x8c, 0xba, 0xed, 0xc3, 0xda, 0x3e, 0x76, 0x18, 0xe1, 0xb1, 0x6b, 0x3f,
+	0x35, 0x5b, 0xfd, 0x1e, 0xf1, 0x16, 0x24, 0xeb, 0x1d, 0x57, 0xa4, 0x33, 0xbf, 0x18, 0xc9, 0x0b,
+	0xe2, 0xc3, 0xfd, 0xea, 0x93, 0x81, 0xf5, 0x11, 0x26, 0xfd, 0x91, 0x82, 0x03, 0xf8, 0x3b, 0xce,
+	0xeb, 0xe2, 0x41, 0x3b, 0x84, 0x55, 0x16, 0xf2, 0x3c, 0x80, 0x59, 0xa6, 0xf7, 0x65, 0x5e, 0x82,
+	0x79, 0xde, 0x60, 0x3e, 0x75, 0xed, 0xb6, 0x14, 0x36, 0xc7, 0x00, 0x0f, 0x5c, 0xbb, 0x8d, 0xd6,
+	0x61, 0x96, 0x1f, 0x52, 0x5b, 0x3a, 0x68, 0x86, 0x3d, 0x56, 0x6c, 0xed, 0x6d, 0x58, 0x3b, 0x34,
+	0x3d, 0xda, 0x1f, 0x72, 0x27, 0x1f, 0xe5, 0x7e, 0x13, 0x83, 0x05, 0xd6, 0xb7, 0x4e, 0xb8, 0x75,
+	0xf9, 0x46, 0xd7, 0x4c, 0x68, 0xcd, 0x4f, 0x61, 0x71, 0x79, 0x5d, 0x64, 0x12, 0xdb, 0xf5, 0x93,
+	0xc0, 0xf4, 0xa4, 0x49, 0x80, 0xd1, 0x8a, 0x34, 0x50, 0x86, 0x64, 0x68, 0x71, 0x68, 0xf0, 0x10,
+	0x9f, 0xe1, 0x6c, 0x5e, 0x8d, 0x60, 0x13, 0xda, 0x3d, 0xe5, 0x31, 0xc5, 0x0f, 0xcf, 0xe9, 0xcb,
+	0x78, 0x10, 0xb4, 0x37, 0x07, 0x33, 0x76, 0xf5, 0x23, 0x52, 0xa3, 0xda, 0x6d, 0x48, 0x08, 0x73,
+	0x49, 0x03, 0x0f, 0x6c, 0xb6, 0x94, 0xa1, 0xcd, 0xd6, 0xd6, 0x5b, 0xb0, 0x18, 0x24, 0x79, 0xdd,
+	0x6e, 0x0d, 0x15, 0xbc, 0x04, 0xcc, 0xe5, 0x2a, 0x95, 0x42, 0xb9, 0x52, 0xd0, 0x93, 0x0a, 0x7b,
+	0x3a, 0xd6, 0x8f, 0x8e, 0x8f, 0xca, 0x05, 0x3d, 0x19, 0xdb, 0xfa, 0xa5, 0x02, 0xcb, 0x43, 0x25,
+	0x06, 0x21, 0x58, 0x92, 0xc4, 0x46, 0xb9, 0x92, 0xab, 0x3c, 0x2a, 0x27, 0xcf, 0x31, 0xd8, 0x71,
+	0xa1, 0x94, 0x2f, 0x96, 0x0e, 0x0c, 0x59, 0xe8, 0x94, 0x50, 0xd1, 0x8b, 0xb1, 0xf3, 0x62, 0xa9,
+	0x58, 0x29, 0xe6, 0x2a, 0x85, 0xbc, 0x51, 0xf8, 0xb0, 0x58, 0x49, 0x4e, 0xa1, 0x24, 0x24, 0x9e,
+	0x14, 0x2b, 0x0f, 0xf3, 0x7a, 0xee, 0x49, 0x8e, 0x15, 0x50, 0x5e, 0x26, 0xd9, 0x59, 0x21, 0x9f,
+	0x9c, 0x66, 0x14, 0xe2, 0xb7, 0x51, 0x3e, 0xcc, 0x95, 0x1f, 0x16, 0xf2, 0xc9, 0x19, 0xb4, 0x08,
+	0xf3, 0xb2, 0x08, 0x17, 0xf2, 0xc9, 0x59, 0xa6, 0x2a, 0x3f, 0x2b, 0x96, 0x0e, 0x92, 0x73, 0xd9,
+	0x9f, 0xc5, 0x61, 0x51, 0x66, 0x17, 0xb1, 0x51, 0x46, 0x2f, 0x60, 0x85, 0xf5, 0x66, 0x0f, 0x6c,
+	0xb7, 0x3f, 0xf2, 0xa3, 0xb5, 0xb4, 0xd8, 0xde, 0xa6, 0xfd, 0x45, 0x72, 0xba, 0xd0, 0x76, 0x68,
+	0x2f, 0xb5, 0x15, 0x75, 0xeb, 0x47, 0xd7, 0x05, 0xda, 0xe5, 0x1f, 0xfc, 0xf5, 0xeb, 0x2f, 0x62,
+	0xeb, 0xe8, 0x42, 0xa6, 0xeb, 0xaf, 0x91, 0x33, 0x35, 0x86, 0xc6, 0x87, 0xf0, 0x1d, 0x05, 0xd5,
+	0x61, 0x71, 0x1f, 0x5b, 0xb6, 0x65, 0xd6, 0x70, 0xeb, 0x21, 0xc1, 0xf5, 0x48, 0xa9, 0x13, 0xc4,
+	0x94, 0xb6, 0xce, 0xa5, 0xad, 0xa0, 0xe5, 0x90, 0xb4, 0x26, 0x63, 0xfa, 0xa5, 0x02, 0xf3, 0x41,
+	0x59, 0x8b, 0x14, 0x71, 0x6b, 0xe2, 0x8a, 0xa8, 0x1d, 0x7d, 0x9e, 0xdb, 0x41, 0xe9, 0x07, 0x84,
+	0xd6, 0x9a, 0xc4, 0x53, 0x79, 0x20, 0xab, 0xac, 0x36, 0xaa, 0x9e, 0x69, 0xd5, 0x88, 0xda, 0xc2,
+	0x1e, 0x55, 0x9f, 0x9a, 0x16, 0x6e, 0x99, 0xdf, 0x25, 0x75, 0x71, 0x9e, 0xe6, 0xca, 0xad, 0xa1,
+	0xd5, 0x90, 0x72, 0xfc, 0x80, 0xd1, 0xa1, 0x4f, 0x15, 0x48, 0x06, 0x62, 0xf6, 0x7a, 0x62, 0x54,
+	0xba, 0x1d, 0xa5, 0xd0, 0xb8, 0x54, 0x74, 0x16, 0xf5, 0x35, 0xae, 0xcb, 0x26, 0x4a, 0x8d, 0xd3,
+	0x25, 0xc3, 0x87, 0xb7, 0xec, 0x2f, 0x62, 0xb0, 0x9c, 0xf3, 0xe7, 0x3b, 0x19, 0x27, 0x3f, 0x52,
+	0x00, 0x49, 0x71, 0xa1, 0x4b, 0x88, 0x22, 0x23, 0x62, 0x74, 0x4b, 0x9c, 0x9a, 0xf0, 0x52, 0x6b,
+	0xaf, 0x70, 0x15, 0x2f, 0xa1, 0x8b, 0x4c, 0xc5, 0xa0, 0xf7, 0x0d, 0x7f, 0x63, 0x40, 0x3f, 0x54,
+	0x60, 0xa5, 0xdc, 0xa9, 0xb6, 0xcd, 0x01, 0x65, 0xb4, 0xd3, 0x05, 0x84, 0x95, 0x18, 0xa7, 0x70,
+	0x60, 0xa7, 0xeb, 0x5c, 0x89, 0x2b, 0x5a, 0xb4, 0x12, 0xbb, 0xca, 0x56, 0xf6, 0xd7, 0xf1, 0xe0,
+	0x8b, 0x42, 0x60, 0xa9, 0x0e, 0x24, 0xe4, 0x1b, 0x73, 0xeb, 0xa3, 0xeb, 0x27, 0x3a, 0xc7, 0x37,
+	0xce, 0x24, 0x41, 0x7e, 0x89, 0xeb, 0x74, 0x01, 0x9d, 0x1f, 0xd4, 0x49, 0x24, 0xd3, 0xef, 0x41,
+	0x42, 0x6a, 0x22, 0xc4, 0x4e, 0xc0, 0x30, 0x15, 0x39, 0x3f, 0x0f, 0x7d, 0x25, 0xd1, 0xae, 0x70,
+	0xc9, 0x1b, 0xda, 0x38, 0xc9, 0xbb, 0xca, 0x16, 0xfa, 0x4c, 0x81, 0x55, 0xf9, 0x26, 0x03, 0x5f,
+	0x4b, 0x26, 0x7c, 0xf9, 0xed, 0x28, 0xac, 0xb1, 0x9f, 0x5e, 0x7c, 0xdf, 0xa0, 0xcd, 0x31, 0xda,
+	0x64, 0x3a, 0x92, 0x04, 0xfd, 0x44, 0x01, 0xc4, 0xcb, 0xac, 0xd7, 0x0c, 0x7d, 0x20, 0x89, 0x8e,
+	0xd8, 0xd1, 0xaf, 0x28, 0x93, 0xdb, 0xe7, 0x06, 0xd7, 0xe8, 0xaa, 0x96, 0x1a, 0xa7, 0x91, 0xd0,
+	0x87, 0x85, 0xcb, 0xdf, 0x12, 0x90, 0xec, 0x57, 0x0a, 0x19, 0x2f, 0x3d, 0x00, 0x51, 0x63, 0x59,
+	0xf0, 0xa3, 0x1b, 0x91, 0x33, 0x6f, 0x78, 0xa2, 0x88, 0x0e, 0xe3, 0xc1, 0xc9, 0x41, 0xdb, 0x0c,
+	0xa7, 0x9e, 0xbe, 0x62, 0xa2, 0xd6, 0xa3, 0xaf, 0x94, 0x20, 0xfb, 0xf7, 0xe7, 0x1a, 0x94, 0x3d,
+	0xd3, 0x10, 0x24, 0xf4, 0xb9, 0xfb, 0x12, 0x83, 0x93, 0xa6, 0x72, 0xe5, 0x52, 0x68, 0x63, 0xe8,
+	0x8e, 0x05, 0x98, 0x3b, 0x0a, 0xfa, 0x44, 0x81, 0xa5, 0xc1, 0x0d, 0x30, 0xda, 0x3e, 0x55, 0x56,
+	0x78, 0xc3, 0x9c, 0x4a, 0x4f, 0x8a, 0x2e, 0xb5, 0x8a, 0xb8, 0x65, 0x7c, 0x22, 0x47, 0x3f, 0x56,
+	0xe0, 0xfc, 0xbe, 0xbf, 0x32, 0x0b, 0xad, 0x5f, 0x6f, 0x4d, 0xb2, 0xeb, 0x15, 0xfa, 0x6c, 0x4d,
+	0xbe, 0x16, 0x8e, 0xb4, 0x50, 0x5f, 0xf0, 0x0b, 0x98, 0x3f, 0x20, 0x54, 0xec, 0x21, 0x4f, 0x08,
+	0x9e, 0xf0, 0x46, 0xf5, 0x84, 0xe0, 0x19, 0x58, 0x67, 0x46, 0x06, 0x8f, 0x10, 0xf6, 0xe9, 0x98,
+	0xb6, 0xe7, 0x8c, 0xae, 0x39, 0xeb, 0xe7, 0x94, 0x28, 0x8d, 0xe4, 0x76, 0xef, 0x13, 0x05, 0x16,
+	0x07, 0x56, 0x43, 0x67, 0xd5, 0x67, 0xfb, 0x4c, 0x0b, 0xa7, 0xc1, 0x16, 0x27, 0x64, 0x1f, 0x81,
+	0x8c, 0x7e, 0xa5, 0xc0, 0x7a, 0xc4, 0x0a, 0x02, 0xdd, 0x8b, 0x92, 0x74, 0xf2, 0xda, 0x23, 0xf5,
+	0xe6, 0x99, 0xe9, 0x06, 0x33, 0x38, 0x5a, 0x1b, 0x67, 0x39, 0xe2, 0xa1, 0x9f, 0x2b, 0xb0, 0x3a,
+	0xee, 0x43, 0x27, 0x3a, 0xfd, 0x66, 0x8f, 0x7e, 0x69, 0x4d, 0xbd, 0x71, 0x36, 0x22, 0xa9, 0x63,
+	0x44, 0xe1, 0x77, 0x42, 0xda, 0x7c, 0xa1, 0x40, 0x72, 0xf8, 0x63, 0x18, 0x8a, 0x0c, 0xa3, 0x88,
+	0x4f, 0x6e, 0xa9, 0x9d, 0xc9, 0x09, 0x4e, 0x0e, 0x3c, 0xc2, 0xf1, 0xd1, 0xf7, 0x61, 0x21, 0xf4,
+	0x91, 0x2d, 0x5c, 0xf4, 0x06, 0x4a, 0xef, 0x63, 0xbb, 0xd5, 0xb1, 0x28, 0x76, 0x7b, 0x0c, 0x2b,
+	0xf5, 0xfa, 0x29, 0xc5, 0x25, 0xfc, 0xbd, 0xce, 0x0f, 0x35, 0x0d, 0x8d, 0xca, 0x67, 0x85, 0xe5,
+	0xcf, 0x31, 0x48, 0xe4, 0x49, 0xb5, 0xd3, 0xf0, 0x8b, 0xca, 0x5f, 0x14, 0x58, 0x3a, 0x20, 0x34,
+	0xb4, 0x49, 0x88, 0x2e, 0x7c, 0xa3, 0xbb, 0x91, 0x68, 0xdd, 0xc6, 0xac, 0x3a, 0x34, 0xfc, 0x79,
+	0xee, 0x00, 0x15, 0xfc, 0x8e, 0x98, 0x36, 0x89, 0x5a, 0x2e, 0x7f, 0x4b, 0x95, 0x8b, 0x0e, 0x55,
+	0xd0, 0xab, 0x7c, 0x09, 0xa2, 0x62, 0xaa, 0xb2, 0xae, 0xfc, 0xb6, 0x8a, 0x55, 0xd6, 0x6a, 0xaa,
+	0xb6, 0xab, 0x62, 0xd9, 0x43, 0xb3, 0x19, 0x35, 0x1d, 0xee, 0xe2, 0xeb, 0xec, 0x7d, 0x78, 0x80,
+	0x12, 0xf4, 0x0c, 0x56, 0xca, 0xd4, 0x25, 0xb8, 0xfd, 0xb2, 0x2f, 0x74, 0x73, 0x02, 0x5c, 0xbe,
+	0x6b, 0xd9, 0x51, 0xb2, 0xbf, 0x8f, 0x41, 0x22, 0x57, 0x6f, 0x9b, 0xc1, 0x8c, 0x74, 0x0c, 0x09,
+	0x36, 0xb3, 0xfb, 0xab, 0x8d, 0xc8, 0x29, 0xe2, 0xe6, 0xa4, 0x4b, 0x11, 0x84, 0x01, 0xfa, 0x8b,
+	0x9a, 0xe8, 0xe2, 0x31, 0xb2, 0xcc, 0x39, 0x83, 0x08, 0x17, 0x96, 0x87, 0xf6, 0x1c, 0x28, 0xb2,
+	0x12, 0x8e, 0xdf, 0xaa, 0x44, 0xa7, 0xe7, 0x88, 0x05, 0x4a, 0xf6, 0x8f, 0x0a, 0xeb, 0x7d, 0xdb,
+	0x36, 0x25, 0xbc, 0x99, 0x72, 0xd1, 0x87, 0xb0, 0x34, 0xb8, 0xed, 0x88, 0xb4, 0x5d, 0xa4, 0x6e,
+	0x11, 0xdb, 0x92, 0x0f, 0x20, 0xce, 0x64, 0xa0, 0x6b, 0x27, 0xb5, 0x73, 0xfe, 0x8b, 0x5c, 0x3f,
+	0x19, 0x49, 0xb0, 0xdc, 0xfb, 0xd3, 0xd4, 0xe7, 0xb9, 0x3f, 0x4c, 0xa1, 0xbf, 0x2b, 0x30, 0x7d,
+	0xec, 0xf6, 0xbc, 0x36, 0xba, 0xfe, 0x7f, 0xe5, 0xa3, 0x92, 0xaa, 0x1f, 0xef, 0xab, 0xfe, 0xff,
+	0x5f, 0xa9, 0x8e, 0x6b, 0x77, 0x4d, 0x1e, 0xd6, 0x3d, 0x95, 0x23, 0xa5, 0xb5, 0x7d, 0x58, 0xe2,
+	0xbf, 0x30, 0x35, 0x6b, 0xea, 0x21, 0xae, 0x7a, 0xe8, 0x62, 0x93, 0x52, 0xc7, 0xdb, 0xcd, 0x64,
+	0x1c, 0x1f, 0xde, 0xc2, 0x55, 0x2f, 0x5d, 0xb3, 0xdb, 0xa9, 0x35, 0x4a, 0x70, 0xfb, 0xbd, 0x11,
+	0xf8, 0xd6, 0x77, 0xe0, 0xea, 0x41, 0xe9, 0x91, 0x7a, 0x40, 0x2c, 0xe2, 0xe2, 0x96, 0x2a, 0x5e,
+	0x58, 0x3d, 0x34, 0x6b, 0xc4, 0xf2, 0x88, 0xda, 0xbd, 0x9b, 0xde, 0x41, 0xef, 0xfa, 0x5c, 0x1b,
+	0x26, 0x6d, 0x76, 0xaa, 0x8c, 0x6c, 0x50, 0x80, 0x78, 0x62, 0x4d, 0x67, 0x35, 0xd3, 0xc6, 0x6c,
+	0x78, 0xcb, 0x1c, 0x16, 0xf7, 0x0b, 0xa5, 0x72, 0x21, 0xdd, 0xae, 0x67, 0xa7, 0x77, 0xd2, 0x3b,
+	0xe9, 0x9d, 0xd4, 0x32, 0x76, 0xcc, 0xb4, 0xe3, 0xf6, 0xb8, 0x64, 0x8b, 0xd0, 0x2d, 0x25, 0x96,
+	0x4d, 0x62, 0xc7, 0x69, 0x99, 0x35, 0xde, 0x72, 0x65, 0x3e, 0xf2, 0x6c, 0x2b, 0x7b, 0x31, 0x0c,
+	0x69, 0xb8, 0x4e, 0x6d, 0xfb, 0x63, 0x52, 0xdd, 0xa6, 0xe4, 0x05, 0x8d, 0x38, 0x3a, 0x81, 0x8a,
+	0x1d, 0xed, 0x8e, 0x88, 0xd8, 0x8d, 0x16, 0xe1, 0xde, 0x63, 0xa3, 0x4c, 0xcf, 0x6b, 0xab, 0x07,
+	0xfc, 0x4d, 0xd1, 0xab, 0x93, 0xbd, 0x79, 0x75, 0x86, 0xc7, 0xd4, 0xdd, 0x7f, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0x2c, 0x2b, 0x44, 0x4e, 0x43, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconServiceClient is the client API for BeaconService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconServiceClient interface {
+	WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1_gateway.BeaconBlock, error)
+	BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+}
+
+type beaconServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
+	return &beaconServiceClient{cc}
+}
+
+func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
+}
+
+type beaconServiceWaitForChainStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1_gateway.BeaconBlock, error) {
+	out := new(v1alpha1_gateway.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
 	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1430,359 +2676,842 @@ func (c *beaconServiceClient) BlockTree(ctx context.Context, in *empty.Empty, op
 	return out, nil
 }
 
-func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
+func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconServiceServer is the server API for BeaconService service.
+type BeaconServiceServer interface {
+	WaitForChainStart(*empty.Empty, BeaconService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *empty.Empty) (*v1alpha1_gateway.BeaconBlock, error)
+	BlockTree(context.Context, *empty.Empty) (*BlockTreeResponse, error)
+	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+}
+
+func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
+	s.RegisterService(&_BeaconService_serviceDesc, srv)
+}
+
+func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+}
+
+type BeaconService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
+}
+
+type beaconServiceWaitForChainStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeBlockSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
+	HandlerType: (*BeaconServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CanonicalHead",
+			Handler:    _BeaconService_CanonicalHead_Handler,
+		},
+		{
+			MethodName: "BlockTree",
+			Handler:    _BeaconService_BlockTree_Handler,
+		},
+		{
+			MethodName: "BlockTreeBySlots",
+			Handler:    _BeaconService_BlockTreeBySlots_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForChainStart",
+			Handler:       _BeaconService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1_gateway.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1_gateway.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+}
+
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
+}
+
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1_gateway.AttestationData, error) {
+	out := new(v1alpha1_gateway.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1_gateway.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1_gateway.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1_gateway.Attestation) (*AttestResponse, error)
+}
+
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
+}
+
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1_gateway.Attestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1_gateway.Attestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1_gateway.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1_gateway.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+	RequestUnsignedBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*UnsignedBlockResponse, error)
+	PublishSignedBlock(ctx context.Context, in *SignedBlockRequest, opts ...grpc.CallOption) (*ProposeResponse, error)
+}
+
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
+}
+
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1_gateway.BeaconBlock, error) {
+	out := new(v1alpha1_gateway.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1_gateway.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposerServiceClient) RequestUnsignedBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*UnsignedBlockResponse, error) {
+	out := new(UnsignedBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestUnsignedBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposerServiceClient) PublishSignedBlock(ctx context.Context, in *SignedBlockRequest, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/PublishSignedBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1_gateway.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1_gateway.BeaconBlock) (*ProposeResponse, error)
+	RequestUnsignedBlock(context.Context, *BlockRequest) (*UnsignedBlockResponse, error)
+	PublishSignedBlock(context.Context, *SignedBlockRequest) (*ProposeResponse, error)
+}
+
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
+}
+
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1_gateway.BeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1_gateway.BeaconBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposerService_RequestUnsignedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).RequestUnsignedBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestUnsignedBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).RequestUnsignedBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposerService_PublishSignedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).PublishSignedBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/PublishSignedBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).PublishSignedBlock(ctx, req.(*SignedBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
+		},
+		{
+			MethodName: "RequestUnsignedBlock",
+			Handler:    _ProposerService_RequestUnsignedBlock_Handler,
+		},
+		{
+			MethodName: "PublishSignedBlock",
+			Handler:    _ProposerService_PublishSignedBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	GetDuties(ctx context.Context, in *DutiesRequest, opts ...grpc.CallOption) (*DutiesResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	DepositStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*DepositStatusResponse, error)
+	MultipleValidatorStatus(ctx context.Context, in *MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*MultipleValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	ProposeExit(ctx context.Context, in *v1alpha1_gateway.VoluntaryExit, opts ...grpc.CallOption) (*ProposeExitResponse, error)
+}
+
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) GetDuties(ctx context.Context, in *DutiesRequest, opts ...grpc.CallOption) (*DutiesResponse, error) {
+	out := new(DutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/GetDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) DepositStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*DepositStatusResponse, error) {
+	out := new(DepositStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DepositStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) MultipleValidatorStatus(ctx context.Context, in *MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*MultipleValidatorStatusResponse, error) {
+	out := new(MultipleValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/MultipleValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BeaconServiceServer is the server API for BeaconService service.
-type BeaconServiceServer interface {
-	WaitForChainStart(*empty.Empty, BeaconService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *empty.Empty) (*v1alpha1.BeaconBlock, error)
-	BlockTree(context.Context, *empty.Empty) (*BlockTreeResponse, error)
-	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1alpha1_gateway.VoluntaryExit, opts ...grpc.CallOption) (*ProposeExitResponse, error) {
+	out := new(ProposeExitResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
-	s.RegisterService(&_BeaconService_serviceDesc, srv)
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	GetDuties(context.Context, *DutiesRequest) (*DutiesResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	DepositStatus(context.Context, *ValidatorIndexRequest) (*DepositStatusResponse, error)
+	MultipleValidatorStatus(context.Context, *MultipleValidatorStatusRequest) (*MultipleValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	ProposeExit(context.Context, *v1alpha1_gateway.VoluntaryExit) (*ProposeExitResponse, error)
 }
 
-func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+}
+
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
 }
 
-type BeaconService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
 	grpc.ServerStream
 }
 
-type beaconServiceWaitForChainStartServer struct {
+type validatorServiceWaitForActivationServer struct {
 	grpc.ServerStream
 }
 
-func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*empty.Empty))
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTree(ctx, in)
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*empty.Empty))
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TreeBlockSlotRequest)
+func _ValidatorService_GetDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DutiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
+		return srv.(ValidatorServiceServer).GetDuties(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/GetDuties",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+		return srv.(ValidatorServiceServer).GetDuties(ctx, req.(*DutiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _BeaconService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
-	HandlerType: (*BeaconServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _BeaconService_CanonicalHead_Handler,
-		},
-		{
-			MethodName: "BlockTree",
-			Handler:    _BeaconService_BlockTree_Handler,
-		},
-		{
-			MethodName: "BlockTreeBySlots",
-			Handler:    _BeaconService_BlockTreeBySlots_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _BeaconService_WaitForChainStart_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
-}
-
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
-}
-
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
-}
-
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
 	}
-	return out, nil
-}
-
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
-}
-
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
+func _ValidatorService_DepositStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
+		return srv.(ValidatorServiceServer).DepositStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DepositStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
+		return srv.(ValidatorServiceServer).DepositStatus(ctx, req.(*ValidatorIndexRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
+func _ValidatorService_MultipleValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultipleValidatorStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
+		return srv.(ValidatorServiceServer).MultipleValidatorStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/MultipleValidatorStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+		return srv.(ValidatorServiceServer).MultipleValidatorStatus(ctx, req.(*MultipleValidatorStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
-}
-
-// ProposerServiceClient is the client API for ProposerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
-}
-
-type proposerServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
-}
-
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
-	if err != nil {
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
 	}
-	return out, nil
-}
-
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
-}
-
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
+func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1_gateway.VoluntaryExit)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1alpha1_gateway.VoluntaryExit))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
 		},
 		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
+			MethodName: "GetDuties",
+			Handler:    _ValidatorService_GetDuties_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "DepositStatus",
+			Handler:    _ValidatorService_DepositStatus_Handler,
+		},
+		{
+			MethodName: "MultipleValidatorStatus",
+			Handler:    _ValidatorService_MultipleValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _ValidatorService_ProposeExit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-// ValidatorServiceClient is the client API for ValidatorService service.
+// DebugServiceClient is the client API for DebugService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+type DebugServiceClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*BeaconStateResponse, error)
+	StreamBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (DebugService_StreamBeaconStateClient, error)
 }
 
-type validatorServiceClient struct {
+type debugServiceClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
+func NewDebugServiceClient(cc *grpc.ClientConn) DebugServiceClient {
+	return &debugServiceClient{cc}
 }
 
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
+func (c *debugServiceClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*BeaconStateResponse, error) {
+	out := new(BeaconStateResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DebugService/GetBeaconState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+func (c *debugServiceClient) StreamBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (DebugService_StreamBeaconStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DebugService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.DebugService/StreamBeaconState", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &validatorServiceWaitForActivationClient{stream}
+	x := &debugServiceStreamBeaconStateClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1792,247 +3521,314 @@ func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *Vali
 	return x, nil
 }
 
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
+type DebugService_StreamBeaconStateClient interface {
+	Recv() (*BeaconStateChunk, error)
 	grpc.ClientStream
 }
 
-type validatorServiceWaitForActivationClient struct {
+type debugServiceStreamBeaconStateClient struct {
 	grpc.ClientStream
 }
 
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
+func (x *debugServiceStreamBeaconStateClient) Recv() (*BeaconStateChunk, error) {
+	m := new(BeaconStateChunk)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
-	if err != nil {
+// DebugServiceServer is the server API for DebugService service.
+type DebugServiceServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*BeaconStateResponse, error)
+	StreamBeaconState(*BeaconStateRequest, DebugService_StreamBeaconStateServer) error
+}
+
+func RegisterDebugServiceServer(s *grpc.Server, srv DebugServiceServer) {
+	s.RegisterService(&_DebugService_serviceDesc, srv)
+}
+
+func _DebugService_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DebugServiceServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DebugService/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _DebugService_StreamBeaconState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeaconStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(DebugServiceServer).StreamBeaconState(m, &debugServiceStreamBeaconStateServer{stream})
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+type DebugService_StreamBeaconStateServer interface {
+	Send(*BeaconStateChunk) error
+	grpc.ServerStream
+}
+
+type debugServiceStreamBeaconStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugServiceStreamBeaconStateServer) Send(m *BeaconStateChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _DebugService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DebugService",
+	HandlerType: (*DebugServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _DebugService_GetBeaconState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBeaconState",
+			Handler:       _DebugService_StreamBeaconState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	ListFeatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeaturesResponse, error)
+	SetFeature(ctx context.Context, in *SetFeatureRequest, opts ...grpc.CallOption) (*FeaturesResponse, error)
+	CaptureProfiles(ctx context.Context, in *CaptureProfilesRequest, opts ...grpc.CallOption) (*CaptureProfilesResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListFeatures(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeaturesResponse, error) {
+	out := new(FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AdminService/ListFeatures", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+func (c *adminServiceClient) SetFeature(ctx context.Context, in *SetFeatureRequest, opts ...grpc.CallOption) (*FeaturesResponse, error) {
+	out := new(FeaturesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AdminService/SetFeature", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+func (c *adminServiceClient) CaptureProfiles(ctx context.Context, in *CaptureProfilesRequest, opts ...grpc.CallOption) (*CaptureProfilesResponse, error) {
+	out := new(CaptureProfilesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AdminService/CaptureProfiles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	ListFeatures(context.Context, *empty.Empty) (*FeaturesResponse, error)
+	SetFeature(context.Context, *SetFeatureRequest) (*FeaturesResponse, error)
+	CaptureProfiles(context.Context, *CaptureProfilesRequest) (*CaptureProfilesResponse, error)
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
 }
 
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
+func _AdminService_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+		return srv.(AdminServiceServer).ListFeatures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+		FullMethod: "/ethereum.beacon.rpc.v1.AdminService/ListFeatures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+		return srv.(AdminServiceServer).ListFeatures(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
-}
-
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
-	grpc.ServerStream
-}
-
-type validatorServiceWaitForActivationServer struct {
-	grpc.ServerStream
-}
-
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
+func _AdminService_SetFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
+		return srv.(AdminServiceServer).SetFeature(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+		FullMethod: "/ethereum.beacon.rpc.v1.AdminService/SetFeature",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+		return srv.(AdminServiceServer).SetFeature(ctx, req.(*SetFeatureRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+func _AdminService_CaptureProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureProfilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
+		return srv.(AdminServiceServer).CaptureProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
+		FullMethod: "/ethereum.beacon.rpc.v1.AdminService/CaptureProfiles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+		return srv.(AdminServiceServer).CaptureProfiles(ctx, req.(*CaptureProfilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
-	if err := dec(in); err != nil {
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFeatures",
+			Handler:    _AdminService_ListFeatures_Handler,
+		},
+		{
+			MethodName: "SetFeature",
+			Handler:    _AdminService_SetFeature_Handler,
+		},
+		{
+			MethodName: "CaptureProfiles",
+			Handler:    _AdminService_CaptureProfiles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	ListPublicKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPublicKeysResponse, error)
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) ListPublicKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListPublicKeysResponse, error) {
+	out := new(ListPublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.RemoteSigner/ListPublicKeys", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.RemoteSigner/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	ListPublicKeys(context.Context, *empty.Empty) (*ListPublicKeysResponse, error)
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+}
+
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_ListPublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+		return srv.(RemoteSignerServer).ListPublicKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+		FullMethod: "/ethereum.beacon.rpc.v1.RemoteSigner/ListPublicKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+		return srv.(RemoteSignerServer).ListPublicKeys(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
+func _RemoteSigner_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+		return srv.(RemoteSignerServer).Sign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+		FullMethod: "/ethereum.beacon.rpc.v1.RemoteSigner/Sign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*SignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
-		},
-		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
-		},
-		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
-		},
-		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
+			MethodName: "ListPublicKeys",
+			Handler:    _RemoteSigner_ListPublicKeys_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
-			ServerStreams: true,
+			MethodName: "Sign",
+			Handler:    _RemoteSigner_Sign_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
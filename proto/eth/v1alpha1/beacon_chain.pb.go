@@ -250,7 +250,11 @@ type ListBlocksRequest struct {
 	//	*ListBlocksRequest_Root
 	//	*ListBlocksRequest_Slot
 	//	*ListBlocksRequest_Epoch
+	//	*ListBlocksRequest_SlotRange
+	//	*ListBlocksRequest_ParentRoot
 	QueryFilter          isListBlocksRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PageSize             int32                           `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                          `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -304,10 +308,18 @@ type ListBlocksRequest_Slot struct {
 type ListBlocksRequest_Epoch struct {
 	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3,oneof"`
 }
+type ListBlocksRequest_SlotRange struct {
+	SlotRange *SlotRange `protobuf:"bytes,4,opt,name=slot_range,json=slotRange,proto3,oneof"`
+}
+type ListBlocksRequest_ParentRoot struct {
+	ParentRoot []byte `protobuf:"bytes,5,opt,name=parent_root,json=parentRoot,proto3,oneof"`
+}
 
-func (*ListBlocksRequest_Root) isListBlocksRequest_QueryFilter()  {}
-func (*ListBlocksRequest_Slot) isListBlocksRequest_QueryFilter()  {}
-func (*ListBlocksRequest_Epoch) isListBlocksRequest_QueryFilter() {}
+func (*ListBlocksRequest_Root) isListBlocksRequest_QueryFilter()       {}
+func (*ListBlocksRequest_Slot) isListBlocksRequest_QueryFilter()       {}
+func (*ListBlocksRequest_Epoch) isListBlocksRequest_QueryFilter()      {}
+func (*ListBlocksRequest_SlotRange) isListBlocksRequest_QueryFilter()  {}
+func (*ListBlocksRequest_ParentRoot) isListBlocksRequest_QueryFilter() {}
 
 func (m *ListBlocksRequest) GetQueryFilter() isListBlocksRequest_QueryFilter {
 	if m != nil {
@@ -337,12 +349,42 @@ func (m *ListBlocksRequest) GetEpoch() uint64 {
 	return 0
 }
 
+func (m *ListBlocksRequest) GetSlotRange() *SlotRange {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_SlotRange); ok {
+		return x.SlotRange
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetParentRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_ParentRoot); ok {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBlocksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ListBlocksRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ListBlocksRequest_OneofMarshaler, _ListBlocksRequest_OneofUnmarshaler, _ListBlocksRequest_OneofSizer, []interface{}{
 		(*ListBlocksRequest_Root)(nil),
 		(*ListBlocksRequest_Slot)(nil),
 		(*ListBlocksRequest_Epoch)(nil),
+		(*ListBlocksRequest_SlotRange)(nil),
+		(*ListBlocksRequest_ParentRoot)(nil),
 	}
 }
 
@@ -359,6 +401,14 @@ func _ListBlocksRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error
 	case *ListBlocksRequest_Epoch:
 		_ = b.EncodeVarint(3<<3 | proto.WireVarint)
 		_ = b.EncodeVarint(uint64(x.Epoch))
+	case *ListBlocksRequest_SlotRange:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlotRange); err != nil {
+			return err
+		}
+	case *ListBlocksRequest_ParentRoot:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.ParentRoot)
 	case nil:
 	default:
 		return fmt.Errorf("ListBlocksRequest.QueryFilter has unexpected type %T", x)
@@ -390,6 +440,21 @@ func _ListBlocksRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *pr
 		x, err := b.DecodeVarint()
 		m.QueryFilter = &ListBlocksRequest_Epoch{x}
 		return true, err
+	case 4: // query_filter.slot_range
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SlotRange)
+		err := b.DecodeMessage(msg)
+		m.QueryFilter = &ListBlocksRequest_SlotRange{msg}
+		return true, err
+	case 5: // query_filter.parent_root
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.QueryFilter = &ListBlocksRequest_ParentRoot{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -409,6 +474,15 @@ func _ListBlocksRequest_OneofSizer(msg proto.Message) (n int) {
 	case *ListBlocksRequest_Epoch:
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(x.Epoch))
+	case *ListBlocksRequest_SlotRange:
+		s := proto.Size(x.SlotRange)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ListBlocksRequest_ParentRoot:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.ParentRoot)))
+		n += len(x.ParentRoot)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -416,18 +490,75 @@ func _ListBlocksRequest_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type SlotRange struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotRange) Reset()         { *m = SlotRange{} }
+func (m *SlotRange) String() string { return proto.CompactTextString(m) }
+func (*SlotRange) ProtoMessage()    {}
+func (*SlotRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{3}
+}
+func (m *SlotRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlotRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlotRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlotRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotRange.Merge(m, src)
+}
+func (m *SlotRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlotRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotRange proto.InternalMessageInfo
+
+func (m *SlotRange) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *SlotRange) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
 type ListBlocksResponse struct {
-	Blocks               []*BeaconBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	BlockContainers      []*BeaconBlockContainer `protobuf:"bytes,1,rep,name=block_containers,json=blockContainers,proto3" json:"block_containers,omitempty"`
+	NextPageToken        string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ListBlocksResponse) Reset()         { *m = ListBlocksResponse{} }
 func (m *ListBlocksResponse) String() string { return proto.CompactTextString(m) }
 func (*ListBlocksResponse) ProtoMessage()    {}
 func (*ListBlocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{3}
+	return fileDescriptor_678c88b69c3c78d4, []int{4}
 }
 func (m *ListBlocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,13 +587,90 @@ func (m *ListBlocksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListBlocksResponse proto.InternalMessageInfo
 
-func (m *ListBlocksResponse) GetBlocks() []*BeaconBlock {
+func (m *ListBlocksResponse) GetBlockContainers() []*BeaconBlockContainer {
+	if m != nil {
+		return m.BlockContainers
+	}
+	return nil
+}
+
+func (m *ListBlocksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListBlocksResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type BeaconBlockContainer struct {
+	Block                *BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte       `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Canonical            bool         `protobuf:"varint,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BeaconBlockContainer) Reset()         { *m = BeaconBlockContainer{} }
+func (m *BeaconBlockContainer) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockContainer) ProtoMessage()    {}
+func (*BeaconBlockContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{5}
+}
+func (m *BeaconBlockContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconBlockContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconBlockContainer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconBlockContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockContainer.Merge(m, src)
+}
+func (m *BeaconBlockContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconBlockContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockContainer proto.InternalMessageInfo
+
+func (m *BeaconBlockContainer) GetBlock() *BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BeaconBlockContainer) GetBlockRoot() []byte {
 	if m != nil {
-		return m.Blocks
+		return m.BlockRoot
 	}
 	return nil
 }
 
+func (m *BeaconBlockContainer) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
 type ChainHead struct {
 	BlockRoot                  []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
 	BlockSlot                  uint64   `protobuf:"varint,2,opt,name=block_slot,json=blockSlot,proto3" json:"block_slot,omitempty"`
@@ -472,6 +680,9 @@ type ChainHead struct {
 	JustifiedBlockRoot         []byte   `protobuf:"bytes,6,opt,name=justified_block_root,json=justifiedBlockRoot,proto3" json:"justified_block_root,omitempty" ssz-size:"32"`
 	PreviousJustifiedSlot      uint64   `protobuf:"varint,7,opt,name=previous_justified_slot,json=previousJustifiedSlot,proto3" json:"previous_justified_slot,omitempty"`
 	PreviousJustifiedBlockRoot []byte   `protobuf:"bytes,8,opt,name=previous_justified_block_root,json=previousJustifiedBlockRoot,proto3" json:"previous_justified_block_root,omitempty" ssz-size:"32"`
+	FinalizedEpoch             uint64   `protobuf:"varint,9,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	JustifiedEpoch             uint64   `protobuf:"varint,10,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	PreviousJustifiedEpoch     uint64   `protobuf:"varint,11,opt,name=previous_justified_epoch,json=previousJustifiedEpoch,proto3" json:"previous_justified_epoch,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
 	XXX_unrecognized           []byte   `json:"-"`
 	XXX_sizecache              int32    `json:"-"`
@@ -481,7 +692,7 @@ func (m *ChainHead) Reset()         { *m = ChainHead{} }
 func (m *ChainHead) String() string { return proto.CompactTextString(m) }
 func (*ChainHead) ProtoMessage()    {}
 func (*ChainHead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{4}
+	return fileDescriptor_678c88b69c3c78d4, []int{6}
 }
 func (m *ChainHead) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -566,27 +777,46 @@ func (m *ChainHead) GetPreviousJustifiedBlockRoot() []byte {
 	return nil
 }
 
-type GetValidatorBalancesRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
-	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+func (m *ChainHead) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHead) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHead) GetPreviousJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.PreviousJustifiedEpoch
+	}
+	return 0
+}
+
+type StreamValidatorRegistryChangesRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorBalancesRequest) Reset()         { *m = GetValidatorBalancesRequest{} }
-func (m *GetValidatorBalancesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetValidatorBalancesRequest) ProtoMessage()    {}
-func (*GetValidatorBalancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{5}
+func (m *StreamValidatorRegistryChangesRequest) Reset()         { *m = StreamValidatorRegistryChangesRequest{} }
+func (m *StreamValidatorRegistryChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamValidatorRegistryChangesRequest) ProtoMessage()    {}
+func (*StreamValidatorRegistryChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{7}
 }
-func (m *GetValidatorBalancesRequest) XXX_Unmarshal(b []byte) error {
+func (m *StreamValidatorRegistryChangesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetValidatorBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamValidatorRegistryChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetValidatorBalancesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamValidatorRegistryChangesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -596,58 +826,46 @@ func (m *GetValidatorBalancesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetValidatorBalancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValidatorBalancesRequest.Merge(m, src)
+func (m *StreamValidatorRegistryChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamValidatorRegistryChangesRequest.Merge(m, src)
 }
-func (m *GetValidatorBalancesRequest) XXX_Size() int {
+func (m *StreamValidatorRegistryChangesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetValidatorBalancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetValidatorBalancesRequest.DiscardUnknown(m)
+func (m *StreamValidatorRegistryChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamValidatorRegistryChangesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetValidatorBalancesRequest proto.InternalMessageInfo
-
-func (m *GetValidatorBalancesRequest) GetEpoch() uint64 {
-	if m != nil {
-		return m.Epoch
-	}
-	return 0
-}
+var xxx_messageInfo_StreamValidatorRegistryChangesRequest proto.InternalMessageInfo
 
-func (m *GetValidatorBalancesRequest) GetPublicKeys() [][]byte {
+func (m *StreamValidatorRegistryChangesRequest) GetPublicKeys() [][]byte {
 	if m != nil {
 		return m.PublicKeys
 	}
 	return nil
 }
 
-func (m *GetValidatorBalancesRequest) GetIndices() []uint64 {
-	if m != nil {
-		return m.Indices
-	}
-	return nil
-}
-
-type ValidatorBalances struct {
-	Balances             []*ValidatorBalances_Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type ValidatorRegistryChanges struct {
+	Epoch                uint64                             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PreviousEpoch        uint64                             `protobuf:"varint,2,opt,name=previous_epoch,json=previousEpoch,proto3" json:"previous_epoch,omitempty"`
+	Changes              []*ValidatorRegistryChanges_Change `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func (m *ValidatorBalances) Reset()         { *m = ValidatorBalances{} }
-func (m *ValidatorBalances) String() string { return proto.CompactTextString(m) }
-func (*ValidatorBalances) ProtoMessage()    {}
-func (*ValidatorBalances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{6}
+func (m *ValidatorRegistryChanges) Reset()         { *m = ValidatorRegistryChanges{} }
+func (m *ValidatorRegistryChanges) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryChanges) ProtoMessage()    {}
+func (*ValidatorRegistryChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{8}
 }
-func (m *ValidatorBalances) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorRegistryChanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorRegistryChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorBalances.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorRegistryChanges.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -657,46 +875,64 @@ func (m *ValidatorBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ValidatorBalances) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorBalances.Merge(m, src)
+func (m *ValidatorRegistryChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryChanges.Merge(m, src)
 }
-func (m *ValidatorBalances) XXX_Size() int {
+func (m *ValidatorRegistryChanges) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorBalances) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorBalances.DiscardUnknown(m)
+func (m *ValidatorRegistryChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryChanges.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorBalances proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorRegistryChanges proto.InternalMessageInfo
 
-func (m *ValidatorBalances) GetBalances() []*ValidatorBalances_Balance {
+func (m *ValidatorRegistryChanges) GetEpoch() uint64 {
 	if m != nil {
-		return m.Balances
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges) GetPreviousEpoch() uint64 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges) GetChanges() []*ValidatorRegistryChanges_Change {
+	if m != nil {
+		return m.Changes
 	}
 	return nil
 }
 
-type ValidatorBalances_Balance struct {
-	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
-	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
-	Balance              uint64   `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ValidatorRegistryChanges_Change struct {
+	PublicKey                []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                    uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Activated                bool     `protobuf:"varint,3,opt,name=activated,proto3" json:"activated,omitempty"`
+	Exited                   bool     `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	Slashed                  bool     `protobuf:"varint,5,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	PreviousEffectiveBalance uint64   `protobuf:"varint,6,opt,name=previous_effective_balance,json=previousEffectiveBalance,proto3" json:"previous_effective_balance,omitempty"`
+	EffectiveBalance         uint64   `protobuf:"varint,7,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-func (m *ValidatorBalances_Balance) Reset()         { *m = ValidatorBalances_Balance{} }
-func (m *ValidatorBalances_Balance) String() string { return proto.CompactTextString(m) }
-func (*ValidatorBalances_Balance) ProtoMessage()    {}
-func (*ValidatorBalances_Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{6, 0}
+func (m *ValidatorRegistryChanges_Change) Reset()         { *m = ValidatorRegistryChanges_Change{} }
+func (m *ValidatorRegistryChanges_Change) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryChanges_Change) ProtoMessage()    {}
+func (*ValidatorRegistryChanges_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{8, 0}
 }
-func (m *ValidatorBalances_Balance) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorRegistryChanges_Change) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorBalances_Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorRegistryChanges_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorBalances_Balance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorRegistryChanges_Change.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -706,56 +942,297 @@ func (m *ValidatorBalances_Balance) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ValidatorBalances_Balance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorBalances_Balance.Merge(m, src)
+func (m *ValidatorRegistryChanges_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryChanges_Change.Merge(m, src)
 }
-func (m *ValidatorBalances_Balance) XXX_Size() int {
+func (m *ValidatorRegistryChanges_Change) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorBalances_Balance) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorBalances_Balance.DiscardUnknown(m)
+func (m *ValidatorRegistryChanges_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryChanges_Change.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorBalances_Balance proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorRegistryChanges_Change proto.InternalMessageInfo
 
-func (m *ValidatorBalances_Balance) GetPublicKey() []byte {
+func (m *ValidatorRegistryChanges_Change) GetPublicKey() []byte {
 	if m != nil {
 		return m.PublicKey
 	}
 	return nil
 }
 
-func (m *ValidatorBalances_Balance) GetIndex() uint64 {
+func (m *ValidatorRegistryChanges_Change) GetIndex() uint64 {
 	if m != nil {
 		return m.Index
 	}
 	return 0
 }
 
-func (m *ValidatorBalances_Balance) GetBalance() uint64 {
+func (m *ValidatorRegistryChanges_Change) GetActivated() bool {
 	if m != nil {
-		return m.Balance
+		return m.Activated
 	}
-	return 0
+	return false
 }
 
-type GetValidatorsRequest struct {
-	// Types that are valid to be assigned to QueryFilter:
-	//	*GetValidatorsRequest_Epoch
-	//	*GetValidatorsRequest_Genesis
-	QueryFilter          isGetValidatorsRequest_QueryFilter `protobuf_oneof:"query_filter"`
-	PageSize             int32                              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken            string                             `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
-	XXX_unrecognized     []byte                             `json:"-"`
-	XXX_sizecache        int32                              `json:"-"`
+func (m *ValidatorRegistryChanges_Change) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
 }
 
-func (m *GetValidatorsRequest) Reset()         { *m = GetValidatorsRequest{} }
-func (m *GetValidatorsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetValidatorsRequest) ProtoMessage()    {}
-func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{7}
+func (m *ValidatorRegistryChanges_Change) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
+func (m *ValidatorRegistryChanges_Change) GetPreviousEffectiveBalance() uint64 {
+	if m != nil {
+		return m.PreviousEffectiveBalance
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges_Change) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+type GetValidatorBalancesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorBalancesRequest) Reset()         { *m = GetValidatorBalancesRequest{} }
+func (m *GetValidatorBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorBalancesRequest) ProtoMessage()    {}
+func (*GetValidatorBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{9}
+}
+func (m *GetValidatorBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorBalancesRequest.Merge(m, src)
+}
+func (m *GetValidatorBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorBalancesRequest proto.InternalMessageInfo
+
+func (m *GetValidatorBalancesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GetValidatorBalancesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *GetValidatorBalancesRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *GetValidatorBalancesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetValidatorBalancesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorBalances struct {
+	Balances             []*ValidatorBalances_Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	Epoch                uint64                       `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NextPageToken        string                       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                        `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ValidatorBalances) Reset()         { *m = ValidatorBalances{} }
+func (m *ValidatorBalances) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalances) ProtoMessage()    {}
+func (*ValidatorBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{10}
+}
+func (m *ValidatorBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalances.Merge(m, src)
+}
+func (m *ValidatorBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalances proto.InternalMessageInfo
+
+func (m *ValidatorBalances) GetBalances() []*ValidatorBalances_Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *ValidatorBalances) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorBalances) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorBalances) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorBalances_Balance struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Balance              uint64   `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalances_Balance) Reset()         { *m = ValidatorBalances_Balance{} }
+func (m *ValidatorBalances_Balance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalances_Balance) ProtoMessage()    {}
+func (*ValidatorBalances_Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{10, 0}
+}
+func (m *ValidatorBalances_Balance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBalances_Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBalances_Balance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBalances_Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalances_Balance.Merge(m, src)
+}
+func (m *ValidatorBalances_Balance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBalances_Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalances_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalances_Balance proto.InternalMessageInfo
+
+func (m *ValidatorBalances_Balance) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorBalances_Balance) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorBalances_Balance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type GetValidatorsRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*GetValidatorsRequest_Epoch
+	//	*GetValidatorsRequest_Genesis
+	QueryFilter          isGetValidatorsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PageSize             int32                              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                             `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *GetValidatorsRequest) Reset()         { *m = GetValidatorsRequest{} }
+func (m *GetValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorsRequest) ProtoMessage()    {}
+func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{11}
 }
 func (m *GetValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1394,7 @@ func (m *Validators) Reset()         { *m = Validators{} }
 func (m *Validators) String() string { return proto.CompactTextString(m) }
 func (*Validators) ProtoMessage()    {}
 func (*Validators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{8}
+	return fileDescriptor_678c88b69c3c78d4, []int{12}
 }
 func (m *Validators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,25 +1451,27 @@ func (m *Validators) GetTotalSize() int32 {
 	return 0
 }
 
-type GetValidatorActiveSetChangesRequest struct {
+type ListCommitteesRequest struct {
 	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Reset()         { *m = GetValidatorActiveSetChangesRequest{} }
-func (m *GetValidatorActiveSetChangesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetValidatorActiveSetChangesRequest) ProtoMessage()    {}
-func (*GetValidatorActiveSetChangesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{9}
+func (m *ListCommitteesRequest) Reset()         { *m = ListCommitteesRequest{} }
+func (m *ListCommitteesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitteesRequest) ProtoMessage()    {}
+func (*ListCommitteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{13}
 }
-func (m *GetValidatorActiveSetChangesRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListCommitteesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetValidatorActiveSetChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListCommitteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetValidatorActiveSetChangesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListCommitteesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1002,19 +1481,222 @@ func (m *GetValidatorActiveSetChangesRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *GetValidatorActiveSetChangesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValidatorActiveSetChangesRequest.Merge(m, src)
+func (m *ListCommitteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitteesRequest.Merge(m, src)
 }
-func (m *GetValidatorActiveSetChangesRequest) XXX_Size() int {
+func (m *ListCommitteesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetValidatorActiveSetChangesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetValidatorActiveSetChangesRequest.DiscardUnknown(m)
+func (m *ListCommitteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitteesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetValidatorActiveSetChangesRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListCommitteesRequest proto.InternalMessageInfo
 
-func (m *GetValidatorActiveSetChangesRequest) GetEpoch() uint64 {
+func (m *ListCommitteesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListCommitteesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCommitteesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type BeaconCommittees struct {
+	Epoch                uint64                            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Committees           []*BeaconCommittees_CommitteeItem `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	ActiveValidatorCount uint64                            `protobuf:"varint,3,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	NextPageToken        string                            `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                             `protobuf:"varint,5,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *BeaconCommittees) Reset()         { *m = BeaconCommittees{} }
+func (m *BeaconCommittees) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommittees) ProtoMessage()    {}
+func (*BeaconCommittees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{14}
+}
+func (m *BeaconCommittees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconCommittees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconCommittees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconCommittees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommittees.Merge(m, src)
+}
+func (m *BeaconCommittees) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconCommittees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommittees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommittees proto.InternalMessageInfo
+
+func (m *BeaconCommittees) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BeaconCommittees) GetCommittees() []*BeaconCommittees_CommitteeItem {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+func (m *BeaconCommittees) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *BeaconCommittees) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *BeaconCommittees) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type BeaconCommittees_CommitteeItem struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Shard                uint64   `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	ValidatorIndices     []uint64 `protobuf:"varint,3,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconCommittees_CommitteeItem) Reset()         { *m = BeaconCommittees_CommitteeItem{} }
+func (m *BeaconCommittees_CommitteeItem) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommittees_CommitteeItem) ProtoMessage()    {}
+func (*BeaconCommittees_CommitteeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{14, 0}
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconCommittees_CommitteeItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommittees_CommitteeItem.Merge(m, src)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommittees_CommitteeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommittees_CommitteeItem proto.InternalMessageInfo
+
+func (m *BeaconCommittees_CommitteeItem) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconCommittees_CommitteeItem) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *BeaconCommittees_CommitteeItem) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+type GetValidatorActiveSetChangesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorActiveSetChangesRequest) Reset()         { *m = GetValidatorActiveSetChangesRequest{} }
+func (m *GetValidatorActiveSetChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorActiveSetChangesRequest) ProtoMessage()    {}
+func (*GetValidatorActiveSetChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{15}
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorActiveSetChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorActiveSetChangesRequest.Merge(m, src)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorActiveSetChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorActiveSetChangesRequest proto.InternalMessageInfo
+
+func (m *GetValidatorActiveSetChangesRequest) GetEpoch() uint64 {
 	if m != nil {
 		return m.Epoch
 	}
@@ -1035,7 +1717,7 @@ func (m *ActiveSetChanges) Reset()         { *m = ActiveSetChanges{} }
 func (m *ActiveSetChanges) String() string { return proto.CompactTextString(m) }
 func (*ActiveSetChanges) ProtoMessage()    {}
 func (*ActiveSetChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{10}
+	return fileDescriptor_678c88b69c3c78d4, []int{16}
 }
 func (m *ActiveSetChanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1787,7 @@ func (m *ValidatorQueue) Reset()         { *m = ValidatorQueue{} }
 func (m *ValidatorQueue) String() string { return proto.CompactTextString(m) }
 func (*ValidatorQueue) ProtoMessage()    {}
 func (*ValidatorQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{11}
+	return fileDescriptor_678c88b69c3c78d4, []int{17}
 }
 func (m *ValidatorQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1852,7 @@ func (m *ListValidatorAssignmentsRequest) Reset()         { *m = ListValidatorAs
 func (m *ListValidatorAssignmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListValidatorAssignmentsRequest) ProtoMessage()    {}
 func (*ListValidatorAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{12}
+	return fileDescriptor_678c88b69c3c78d4, []int{18}
 }
 func (m *ListValidatorAssignmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1930,7 @@ func (m *ValidatorAssignments) Reset()         { *m = ValidatorAssignments{} }
 func (m *ValidatorAssignments) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAssignments) ProtoMessage()    {}
 func (*ValidatorAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{13}
+	return fileDescriptor_678c88b69c3c78d4, []int{19}
 }
 func (m *ValidatorAssignments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +2004,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Reset() {
 func (m *ValidatorAssignments_CommitteeAssignment) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAssignments_CommitteeAssignment) ProtoMessage()    {}
 func (*ValidatorAssignments_CommitteeAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{13, 0}
+	return fileDescriptor_678c88b69c3c78d4, []int{19, 0}
 }
 func (m *ValidatorAssignments_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +2079,7 @@ func (m *GetValidatorParticipationRequest) Reset()         { *m = GetValidatorPa
 func (m *GetValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorParticipationRequest) ProtoMessage()    {}
 func (*GetValidatorParticipationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{14}
+	return fileDescriptor_678c88b69c3c78d4, []int{20}
 }
 func (m *GetValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1448,7 +2130,7 @@ func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{}
 func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
 func (*ValidatorParticipation) ProtoMessage()    {}
 func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{15}
+	return fileDescriptor_678c88b69c3c78d4, []int{21}
 }
 func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,25 +2194,29 @@ func (m *ValidatorParticipation) GetEligibleEther() uint64 {
 	return 0
 }
 
-type AttestationPoolResponse struct {
-	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type ListValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
-func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*AttestationPoolResponse) ProtoMessage()    {}
-func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{16}
+func (m *ListValidatorRewardsRequest) Reset()         { *m = ListValidatorRewardsRequest{} }
+func (m *ListValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsRequest) ProtoMessage()    {}
+func (*ListValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{22}
 }
-func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListValidatorRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1540,181 +2226,450 @@ func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+func (m *ListValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsRequest.Merge(m, src)
 }
-func (m *AttestationPoolResponse) XXX_Size() int {
+func (m *ListValidatorRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
+func (m *ListValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListValidatorRewardsRequest proto.InternalMessageInfo
 
-func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
+func (m *ListValidatorRewardsRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.Attestations
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
-	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
-	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
-	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
-	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
-	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
-	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
-	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
-	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
-	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
-	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
-	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
-	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
-	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
-	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
-	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
-	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
-	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
-	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+func (m *ListValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+func (m *ListValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
 }
 
-var fileDescriptor_678c88b69c3c78d4 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xc6, 0x4e, 0x13, 0x3f, 0x27, 0x69, 0x33, 0x71, 0x12, 0x77, 0xd3, 0x26, 0xee, 0xb6,
-	0xc9, 0xd7, 0x55, 0xdb, 0x75, 0x93, 0xf6, 0x5b, 0xaa, 0x54, 0xa8, 0xd4, 0x51, 0x69, 0x80, 0x1e,
-	0xc2, 0xb6, 0xe2, 0xc0, 0xc5, 0x1a, 0xaf, 0x27, 0xf6, 0x34, 0xeb, 0x9d, 0xed, 0xce, 0x38, 0x4a,
-	0x72, 0x03, 0x24, 0x24, 0xce, 0x48, 0x48, 0x5c, 0x10, 0x77, 0xc4, 0x09, 0x89, 0x0b, 0x17, 0x04,
-	0x17, 0x4e, 0xa8, 0x12, 0xf7, 0x0a, 0x55, 0xfc, 0x05, 0xbd, 0x20, 0x6e, 0x68, 0x67, 0x7f, 0xfa,
-	0xc7, 0xda, 0x46, 0x54, 0xdc, 0x3c, 0x6f, 0xdf, 0x8f, 0xcf, 0x7b, 0xf3, 0xe6, 0xcd, 0x67, 0x0c,
-	0xeb, 0x8e, 0xcb, 0x04, 0xab, 0x10, 0xd1, 0xaa, 0x1c, 0x6e, 0x62, 0xcb, 0x69, 0xe1, 0xcd, 0x4a,
-	0x9d, 0x60, 0x93, 0xd9, 0x35, 0xb3, 0x85, 0xa9, 0xad, 0xcb, 0xef, 0x68, 0x91, 0x88, 0x16, 0x71,
-	0x49, 0xa7, 0xad, 0x13, 0xd1, 0xd2, 0x43, 0x4d, 0xf5, 0x7a, 0x93, 0x8a, 0x56, 0xa7, 0xae, 0x9b,
-	0xac, 0x5d, 0x69, 0xb2, 0x26, 0xab, 0x48, 0xed, 0x7a, 0x67, 0x5f, 0xae, 0x7c, 0xd7, 0xde, 0x2f,
-	0xdf, 0x8b, 0x7a, 0xbe, 0xc9, 0x58, 0xd3, 0x22, 0x15, 0xec, 0xd0, 0x0a, 0xb6, 0x6d, 0x26, 0xb0,
-	0xa0, 0xcc, 0xe6, 0xc1, 0xd7, 0x95, 0xe0, 0x6b, 0xe4, 0x83, 0xb4, 0x1d, 0x71, 0x1c, 0x7c, 0xbc,
-	0x3c, 0x00, 0x27, 0x16, 0x82, 0x70, 0xdf, 0x47, 0xa0, 0x35, 0x24, 0x9b, 0xba, 0xc5, 0xcc, 0x83,
-	0x40, 0x4d, 0x1b, 0xa0, 0x76, 0x88, 0x2d, 0xda, 0xc0, 0x82, 0xb9, 0xbe, 0x8e, 0x76, 0x04, 0xcb,
-	0x8f, 0x28, 0x17, 0xf7, 0xe3, 0x18, 0xdc, 0x20, 0xcf, 0x3a, 0x84, 0x0b, 0xb4, 0x06, 0x20, 0xbd,
-	0xd5, 0x5c, 0xc6, 0x44, 0x51, 0x29, 0x29, 0xe5, 0x99, 0xdd, 0x53, 0x46, 0x4e, 0xca, 0x0c, 0xc6,
-	0x04, 0x2a, 0x40, 0x96, 0x5b, 0x4c, 0x14, 0x27, 0x4a, 0x4a, 0x39, 0xbb, 0x7b, 0xca, 0x90, 0x2b,
-	0xb4, 0x04, 0x93, 0xc4, 0x61, 0x66, 0xab, 0x98, 0x09, 0xc4, 0xfe, 0xb2, 0x3a, 0x07, 0x33, 0xcf,
-	0x3a, 0xc4, 0x3d, 0xae, 0xed, 0x53, 0x4b, 0x10, 0x57, 0xab, 0x43, 0xb1, 0x3f, 0x32, 0x77, 0x98,
-	0xcd, 0x09, 0x7a, 0x1b, 0x66, 0x12, 0x59, 0xf3, 0xa2, 0x52, 0xca, 0x94, 0xf3, 0x5b, 0x9a, 0x3e,
-	0x70, 0x7b, 0xf4, 0x84, 0x0b, 0xa3, 0xcb, 0x4e, 0x6b, 0xc2, 0xbc, 0x17, 0xa3, 0xea, 0x41, 0x8e,
-	0xf2, 0x2a, 0x40, 0xb6, 0x2b, 0x23, 0xb9, 0xfa, 0x97, 0xc9, 0xec, 0x01, 0x4a, 0x06, 0x0a, 0xd2,
-	0xd8, 0x86, 0xd3, 0xb2, 0x5a, 0xa3, 0x12, 0xa8, 0xca, 0xbd, 0x93, 0xc6, 0x46, 0x60, 0xa1, 0xfd,
-	0x94, 0x81, 0xdc, 0x8e, 0xd7, 0x9a, 0xbb, 0x04, 0x37, 0xd0, 0x8d, 0xfe, 0xbd, 0xa8, 0xce, 0xbf,
-	0x7a, 0xb1, 0x36, 0xcb, 0xf9, 0xc9, 0x75, 0x4e, 0x4f, 0xc8, 0xb6, 0x76, 0x73, 0x4b, 0x4b, 0x6e,
-	0xce, 0x85, 0xd0, 0x22, 0xce, 0x2a, 0xf8, 0xfc, 0xd8, 0x4b, 0x6c, 0x1d, 0xe6, 0xf6, 0xa9, 0x8d,
-	0x2d, 0x7a, 0x42, 0x1a, 0xbe, 0x8a, 0xcc, 0xd0, 0x98, 0x8d, 0xa4, 0x52, 0x6d, 0x07, 0x0a, 0xb1,
-	0x5a, 0x02, 0x41, 0x36, 0x0d, 0x01, 0x8a, 0xd4, 0xab, 0x11, 0x94, 0x75, 0x98, 0x7b, 0xda, 0xe1,
-	0x82, 0xee, 0xd3, 0x30, 0xd6, 0xa4, 0x1f, 0x2b, 0x92, 0x86, 0xb1, 0x62, 0xb5, 0x44, 0xac, 0xd3,
-	0xa9, 0xb1, 0x22, 0xf5, 0x38, 0xd6, 0x6d, 0x58, 0x76, 0x5c, 0x72, 0x48, 0x59, 0x87, 0xd7, 0x7a,
-	0x82, 0x4e, 0xc9, 0xa0, 0x8b, 0xe1, 0xe7, 0x77, 0xbb, 0x82, 0x3f, 0x81, 0x0b, 0x03, 0xec, 0x12,
-	0x28, 0xa6, 0xd3, 0x50, 0xa8, 0x7d, 0x0e, 0x23, 0x34, 0xda, 0x27, 0x0a, 0xac, 0x3c, 0x24, 0xe2,
-	0x83, 0xf0, 0xd0, 0x55, 0xb1, 0x85, 0x6d, 0x93, 0x24, 0x5a, 0x31, 0x68, 0x2f, 0x45, 0x62, 0xf3,
-	0x17, 0xe8, 0x16, 0xe4, 0x9d, 0x4e, 0xdd, 0xa2, 0x66, 0xed, 0x80, 0x1c, 0xf3, 0xe2, 0x44, 0x29,
-	0x53, 0x9e, 0xa9, 0x2e, 0xbc, 0x7a, 0xb1, 0x76, 0x26, 0x8e, 0x7c, 0xef, 0xda, 0xad, 0x3b, 0x9a,
-	0x01, 0xbe, 0xde, 0x7b, 0xe4, 0x98, 0xa3, 0x22, 0x4c, 0x51, 0xbb, 0x41, 0x4d, 0xc2, 0x8b, 0x99,
-	0x52, 0xa6, 0x9c, 0x35, 0xc2, 0xa5, 0xf6, 0xab, 0x02, 0xf3, 0x7d, 0x10, 0xd0, 0x23, 0x98, 0xae,
-	0x07, 0xbf, 0x83, 0xf6, 0xbc, 0x91, 0xd2, 0x9e, 0x7d, 0xb6, 0x7a, 0xf0, 0xc3, 0x88, 0x3c, 0xa8,
-	0x07, 0x30, 0x15, 0x08, 0xbd, 0x5e, 0x8d, 0xe1, 0x0f, 0xee, 0x55, 0x0f, 0x7b, 0x2e, 0xc2, 0xee,
-	0x95, 0x81, 0xda, 0x0d, 0x72, 0x14, 0xb4, 0xa9, 0xbf, 0xf0, 0x12, 0x0a, 0xdc, 0x07, 0xbd, 0x19,
-	0x2e, 0xb5, 0x2f, 0x14, 0x28, 0x24, 0xcb, 0x1a, 0xd5, 0x73, 0xa9, 0xab, 0x9e, 0xd1, 0x71, 0x45,
-	0x2a, 0x4c, 0x35, 0x89, 0x4d, 0x38, 0xe5, 0x32, 0xc4, 0xf4, 0xee, 0x29, 0x23, 0x14, 0xa0, 0x15,
-	0xc8, 0x39, 0xb8, 0x49, 0x6a, 0x1e, 0x32, 0x19, 0x68, 0xd2, 0x98, 0xf6, 0x04, 0x8f, 0xe9, 0x09,
-	0xf1, 0x4e, 0x91, 0xfc, 0x28, 0xd8, 0x01, 0xb1, 0x65, 0xd7, 0xe7, 0x0c, 0xa9, 0xfe, 0xc4, 0x13,
-	0xf4, 0x8d, 0x81, 0x6f, 0x14, 0x80, 0x18, 0x55, 0xca, 0xf6, 0xbe, 0x05, 0x10, 0x4d, 0x61, 0x7f,
-	0x77, 0xf3, 0x5b, 0xa5, 0x51, 0xa5, 0x37, 0x12, 0x36, 0x68, 0x03, 0xce, 0xd8, 0xe4, 0x48, 0xd4,
-	0x12, 0xd0, 0x32, 0x12, 0xda, 0xac, 0x27, 0xde, 0x0b, 0xe1, 0x79, 0xe8, 0x05, 0x13, 0xd8, 0xf2,
-	0x73, 0xcb, 0xca, 0xdc, 0x72, 0x52, 0xe2, 0x25, 0xa7, 0xdd, 0x85, 0x4b, 0xc9, 0x2a, 0xde, 0x37,
-	0x05, 0x3d, 0x24, 0x8f, 0x89, 0xd8, 0x69, 0x61, 0xbb, 0x39, 0xa2, 0x49, 0xb5, 0xbf, 0x14, 0x38,
-	0xdb, 0x6b, 0x91, 0x92, 0xf0, 0x43, 0x58, 0xc4, 0x9e, 0x26, 0x16, 0xa4, 0x51, 0x1b, 0xb3, 0xb3,
-	0x17, 0x22, 0x8b, 0xbd, 0xb8, 0xc5, 0xef, 0x03, 0x22, 0x47, 0xb4, 0xd7, 0x4b, 0x26, 0xdd, 0xcb,
-	0x59, 0x5f, 0x3d, 0xe1, 0x62, 0x07, 0x16, 0xc8, 0x53, 0x62, 0xf6, 0xfa, 0xc8, 0xa6, 0xfb, 0x98,
-	0x0f, 0xf4, 0x63, 0x27, 0xda, 0x0f, 0x0a, 0xcc, 0x45, 0x65, 0x7b, 0xbf, 0x43, 0x3a, 0x04, 0xad,
-	0x41, 0xde, 0x6c, 0x75, 0x5c, 0xbb, 0x66, 0xd1, 0x36, 0x15, 0x41, 0xfe, 0x20, 0x45, 0x8f, 0x3c,
-	0x09, 0x7a, 0x07, 0x96, 0x82, 0x94, 0x28, 0xb3, 0xc7, 0xad, 0x42, 0x21, 0x36, 0x49, 0xe4, 0xf0,
-	0x26, 0xc8, 0xbc, 0xc6, 0x2d, 0xc2, 0x9c, 0xa7, 0x9c, 0x40, 0xff, 0xb3, 0x02, 0x6b, 0xde, 0x65,
-	0x15, 0x6f, 0x3c, 0xe7, 0xb4, 0x69, 0xb7, 0x89, 0x2d, 0xfe, 0xdb, 0xc1, 0xd4, 0x7d, 0xf4, 0xb2,
-	0x43, 0x8f, 0xde, 0x64, 0xcf, 0xd1, 0xd3, 0xbe, 0xcc, 0x40, 0x61, 0x50, 0x06, 0x29, 0xd0, 0x31,
-	0xe4, 0x71, 0xac, 0x14, 0x9c, 0xba, 0x7b, 0xa3, 0x4e, 0x5d, 0xc2, 0xaf, 0xbe, 0xc3, 0xda, 0x6d,
-	0x2a, 0x04, 0x21, 0xb1, 0xd0, 0x48, 0xfa, 0x7c, 0x4d, 0xa7, 0x52, 0xfd, 0x51, 0x81, 0x85, 0x01,
-	0xb1, 0xd0, 0x26, 0x14, 0x4c, 0x97, 0x71, 0x6e, 0x51, 0xfb, 0xa0, 0x66, 0x86, 0x0a, 0xfe, 0xec,
-	0xce, 0x1a, 0x0b, 0xd1, 0xb7, 0xc8, 0x56, 0x96, 0x82, 0xb7, 0xb0, 0xdb, 0x08, 0xe7, 0xaa, 0x5c,
-	0x20, 0x14, 0x30, 0x1d, 0x7f, 0xa8, 0xfa, 0x3c, 0x47, 0x85, 0x69, 0xc7, 0x65, 0x0e, 0xe3, 0xc4,
-	0x95, 0x88, 0xa6, 0x8d, 0x68, 0xdd, 0x33, 0xcf, 0x27, 0x47, 0xcf, 0x73, 0xed, 0x0e, 0x94, 0x92,
-	0x83, 0x65, 0x0f, 0xbb, 0x82, 0x9a, 0xd4, 0xf1, 0x19, 0xda, 0xd0, 0xa9, 0xf2, 0x5c, 0x81, 0xa5,
-	0xc1, 0x76, 0x29, 0xfb, 0x7a, 0x1e, 0x72, 0x11, 0xe3, 0xf0, 0x67, 0xbb, 0x11, 0x0b, 0xd0, 0x36,
-	0x9c, 0x6b, 0x5a, 0xac, 0x8e, 0xad, 0x9a, 0x93, 0xf4, 0x55, 0x73, 0xb1, 0xf0, 0x67, 0xfd, 0x84,
-	0xb1, 0xec, 0x2b, 0x74, 0x63, 0xc4, 0x42, 0x9e, 0xe8, 0x43, 0xe6, 0xcd, 0x09, 0xd9, 0x23, 0xb2,
-	0x2a, 0x59, 0x03, 0xa4, 0xe8, 0x81, 0x27, 0xf1, 0x68, 0x0d, 0xb1, 0x68, 0x93, 0xd6, 0x2d, 0x12,
-	0xe8, 0x04, 0xb4, 0x26, 0x94, 0x4a, 0x35, 0x0d, 0xc3, 0x72, 0x82, 0xa0, 0xee, 0x31, 0x66, 0xbd,
-	0x6e, 0x9a, 0xbb, 0xf5, 0x67, 0x1e, 0xf2, 0x3e, 0x87, 0x94, 0x8c, 0x11, 0x7d, 0xa5, 0xc0, 0xd9,
-	0x5e, 0x6e, 0x8d, 0xf4, 0x14, 0xb7, 0x29, 0xf4, 0x5f, 0xad, 0x8c, 0xad, 0xef, 0x67, 0xa3, 0x5d,
-	0xf9, 0xf8, 0xb7, 0x3f, 0x3e, 0x9f, 0xb8, 0x84, 0x2e, 0x0e, 0x7a, 0x98, 0x24, 0x5f, 0x31, 0x1c,
-	0x7d, 0xa6, 0xc0, 0x99, 0x9e, 0xa2, 0xa0, 0x25, 0xdd, 0x7f, 0x18, 0xe9, 0xe1, 0xc3, 0x48, 0x7f,
-	0xe0, 0x3d, 0x8c, 0x54, 0x7d, 0x74, 0x39, 0x92, 0x45, 0xd5, 0x74, 0x09, 0xa3, 0x8c, 0x36, 0x46,
-	0xc2, 0xa8, 0x38, 0x5e, 0xdc, 0x4f, 0x15, 0x80, 0x98, 0xbb, 0xa3, 0xf2, 0x90, 0xb4, 0xbb, 0xde,
-	0x11, 0xea, 0x95, 0x31, 0x34, 0x03, 0x4c, 0x97, 0x24, 0xa6, 0x0b, 0x68, 0x65, 0x20, 0x26, 0x9f,
-	0xf1, 0x23, 0x07, 0x66, 0x1e, 0xca, 0xab, 0x34, 0xe0, 0xfc, 0x69, 0x05, 0x49, 0xe3, 0x0a, 0x91,
-	0xa5, 0xb6, 0x21, 0xc3, 0x95, 0xd0, 0xea, 0xc0, 0x70, 0xf2, 0xc1, 0xdb, 0xf2, 0x22, 0x7c, 0xad,
-	0xc0, 0x62, 0xd7, 0x4d, 0x10, 0x91, 0xc3, 0xad, 0x94, 0x18, 0x43, 0xc8, 0xac, 0x5a, 0x1e, 0x97,
-	0x3e, 0xa6, 0x75, 0x4a, 0xcc, 0x70, 0x2a, 0x21, 0xaf, 0x44, 0x1f, 0x29, 0x30, 0xdb, 0x45, 0xf5,
-	0xd0, 0xd5, 0x31, 0xa0, 0x45, 0x98, 0x2e, 0x8e, 0xc2, 0xc4, 0xb5, 0x92, 0x04, 0xa3, 0xa2, 0x62,
-	0x1a, 0x18, 0xf4, 0xbd, 0x02, 0xe7, 0x87, 0x11, 0x25, 0xb4, 0x3d, 0x06, 0xa4, 0x14, 0x76, 0xa5,
-	0xfe, 0x2f, 0xad, 0xbd, 0x7b, 0xf4, 0xb5, 0x4d, 0x89, 0xf3, 0x2a, 0xba, 0x92, 0x5a, 0x34, 0x49,
-	0x16, 0x08, 0x27, 0xc2, 0x0c, 0x70, 0x9d, 0xc0, 0x7c, 0x12, 0x82, 0xcf, 0x54, 0xd2, 0xda, 0x6a,
-	0x7d, 0x54, 0xa9, 0xa4, 0x79, 0x5a, 0x6f, 0x25, 0x60, 0x3c, 0x93, 0x61, 0xbe, 0x55, 0xfc, 0xf7,
-	0xfd, 0xc0, 0x3b, 0xfa, 0xf6, 0x90, 0xa3, 0x33, 0x84, 0x96, 0xa8, 0x57, 0xff, 0xc1, 0x85, 0xad,
-	0x5d, 0x93, 0x48, 0x37, 0xd0, 0xe5, 0xf4, 0x82, 0x25, 0x20, 0x7d, 0xa7, 0xc0, 0xb9, 0xd4, 0x4b,
-	0x0b, 0xbd, 0x31, 0xc6, 0x0e, 0x0f, 0xba, 0xe6, 0xd4, 0xeb, 0xa3, 0x10, 0x77, 0x59, 0xa5, 0x0d,
-	0xaf, 0x04, 0xe6, 0xae, 0x8b, 0xac, 0xba, 0xf3, 0xcb, 0xcb, 0x55, 0xe5, 0xf9, 0xcb, 0x55, 0xe5,
-	0xf7, 0x97, 0xab, 0xca, 0x87, 0xff, 0x4f, 0xfc, 0x4f, 0xe5, 0xb8, 0xc7, 0xbc, 0x8d, 0x05, 0x35,
-	0x2d, 0x5c, 0xe7, 0xfe, 0xaa, 0xd2, 0xff, 0x7f, 0xd0, 0x5d, 0x22, 0x5a, 0xf5, 0xd3, 0x52, 0x7e,
-	0xf3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb2, 0xa9, 0x6f, 0x25, 0x13, 0x00, 0x00,
+func (m *ListValidatorRewardsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// BeaconChainClient is the client API for BeaconChain service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconChainClient interface {
-	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
-	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
-	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
-	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
-	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
-	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
-	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
-	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
-	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
-	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+func (m *ListValidatorRewardsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
 }
 
-type beaconChainClient struct {
-	cc *grpc.ClientConn
+type ValidatorRewards struct {
+	Epoch                uint64                     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewards_Reward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextPageToken        string                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                      `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
-	return &beaconChainClient{cc}
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{23}
 }
-
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetRewards() []*ValidatorRewards_Reward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ValidatorRewards) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorRewards) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorRewards_Reward struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Reward               int64    `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewards_Reward) Reset()         { *m = ValidatorRewards_Reward{} }
+func (m *ValidatorRewards_Reward) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards_Reward) ProtoMessage()    {}
+func (*ValidatorRewards_Reward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{23, 0}
+}
+func (m *ValidatorRewards_Reward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards_Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards_Reward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards_Reward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards_Reward.Merge(m, src)
+}
+func (m *ValidatorRewards_Reward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards_Reward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards_Reward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards_Reward proto.InternalMessageInfo
+
+func (m *ValidatorRewards_Reward) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorRewards_Reward) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorRewards_Reward) GetReward() int64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+type AttestationPoolResponse struct {
+	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
+func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationPoolResponse) ProtoMessage()    {}
+func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{24}
+}
+func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+}
+func (m *AttestationPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+
+func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
+	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
+	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
+	proto.RegisterType((*SlotRange)(nil), "ethereum.eth.v1alpha1.SlotRange")
+	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
+	proto.RegisterType((*BeaconBlockContainer)(nil), "ethereum.eth.v1alpha1.BeaconBlockContainer")
+	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
+	proto.RegisterType((*StreamValidatorRegistryChangesRequest)(nil), "ethereum.eth.v1alpha1.StreamValidatorRegistryChangesRequest")
+	proto.RegisterType((*ValidatorRegistryChanges)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryChanges")
+	proto.RegisterType((*ValidatorRegistryChanges_Change)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryChanges.Change")
+	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
+	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
+	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
+	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
+	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
+	proto.RegisterType((*ListCommitteesRequest)(nil), "ethereum.eth.v1alpha1.ListCommitteesRequest")
+	proto.RegisterType((*BeaconCommittees)(nil), "ethereum.eth.v1alpha1.BeaconCommittees")
+	proto.RegisterType((*BeaconCommittees_CommitteeItem)(nil), "ethereum.eth.v1alpha1.BeaconCommittees.CommitteeItem")
+	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
+	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
+	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
+	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
+	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
+	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
+	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
+	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
+	proto.RegisterType((*ListValidatorRewardsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.eth.v1alpha1.ValidatorRewards")
+	proto.RegisterType((*ValidatorRewards_Reward)(nil), "ethereum.eth.v1alpha1.ValidatorRewards.Reward")
+	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+}
+
+var fileDescriptor_678c88b69c3c78d4 = []byte{
+	// 2187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xd4, 0x07, 0x9f, 0xbe, 0x47, 0xb4, 0x4c, 0xd3, 0xb6, 0x44, 0xaf, 0x2d, 0x8b,
+	0x8e, 0x6c, 0xd2, 0x56, 0x1c, 0xd7, 0x70, 0x52, 0xa4, 0x96, 0xa0, 0x5a, 0x6e, 0x7d, 0x50, 0xd7,
+	0x69, 0x0e, 0x05, 0x0a, 0x62, 0xb8, 0x1c, 0x91, 0x13, 0x2f, 0x77, 0xe9, 0xdd, 0xa1, 0x2a, 0x09,
+	0xbd, 0xb4, 0x28, 0x0a, 0x04, 0x3d, 0x16, 0x08, 0xd0, 0x43, 0x8b, 0x00, 0x3d, 0x06, 0x3d, 0x05,
+	0x68, 0x0f, 0x3d, 0xb4, 0x40, 0x2e, 0x3d, 0x15, 0x01, 0x7a, 0x0f, 0x0a, 0x23, 0x7f, 0x41, 0x80,
+	0x1e, 0x7a, 0x0b, 0x66, 0x66, 0xbf, 0xb9, 0x43, 0xd2, 0x80, 0x2e, 0x39, 0x91, 0xf3, 0xe6, 0x7d,
+	0xfc, 0xde, 0x7b, 0xf3, 0xde, 0x7c, 0x2c, 0x6c, 0xf6, 0x5d, 0x87, 0x39, 0x0d, 0xc2, 0xba, 0x8d,
+	0xe3, 0xfb, 0xd8, 0xea, 0x77, 0xf1, 0xfd, 0x46, 0x8b, 0x60, 0xd3, 0xb1, 0x9b, 0x66, 0x17, 0x53,
+	0xbb, 0x2e, 0xe6, 0xd1, 0x45, 0xc2, 0xba, 0xc4, 0x25, 0x83, 0x5e, 0x9d, 0xb0, 0x6e, 0x3d, 0xe0,
+	0xac, 0xdc, 0xed, 0x50, 0xd6, 0x1d, 0xb4, 0xea, 0xa6, 0xd3, 0x6b, 0x74, 0x9c, 0x8e, 0xd3, 0x10,
+	0xdc, 0xad, 0xc1, 0x91, 0x18, 0x49, 0xd5, 0xfc, 0x9f, 0xd4, 0x52, 0xb9, 0xda, 0x71, 0x9c, 0x8e,
+	0x45, 0x1a, 0xb8, 0x4f, 0x1b, 0xd8, 0xb6, 0x1d, 0x86, 0x19, 0x75, 0x6c, 0xcf, 0x9f, 0xbd, 0xe2,
+	0xcf, 0x86, 0x3a, 0x48, 0xaf, 0xcf, 0x4e, 0xfd, 0xc9, 0x9b, 0x19, 0x38, 0x31, 0x63, 0xc4, 0x93,
+	0x3a, 0x7c, 0xae, 0x11, 0xde, 0xb4, 0x2c, 0xc7, 0x7c, 0xe9, 0xb3, 0xe9, 0x19, 0x6c, 0xc7, 0xd8,
+	0xa2, 0x6d, 0xcc, 0x1c, 0x57, 0xf2, 0xe8, 0x27, 0x70, 0xe9, 0x39, 0xf5, 0xd8, 0x93, 0xc8, 0x86,
+	0x67, 0x90, 0x57, 0x03, 0xe2, 0x31, 0xb4, 0x01, 0x20, 0xb4, 0x35, 0x5d, 0xc7, 0x61, 0x65, 0xad,
+	0xaa, 0xd5, 0xe6, 0x0f, 0x2e, 0x18, 0x45, 0x41, 0x33, 0x1c, 0x87, 0xa1, 0x12, 0x14, 0x3c, 0xcb,
+	0x61, 0xe5, 0x5c, 0x55, 0xab, 0x15, 0x0e, 0x2e, 0x18, 0x62, 0x84, 0xd6, 0x60, 0x8a, 0xf4, 0x1d,
+	0xb3, 0x5b, 0xce, 0xfb, 0x64, 0x39, 0xdc, 0x5d, 0x84, 0xf9, 0x57, 0x03, 0xe2, 0x9e, 0x36, 0x8f,
+	0xa8, 0xc5, 0x88, 0xab, 0xb7, 0xa0, 0x3c, 0x6c, 0xd9, 0xeb, 0x3b, 0xb6, 0x47, 0xd0, 0x0f, 0x61,
+	0x3e, 0xe6, 0xb5, 0x57, 0xd6, 0xaa, 0xf9, 0xda, 0xdc, 0x8e, 0x5e, 0xcf, 0x4c, 0x4f, 0x3d, 0xa6,
+	0xc2, 0x48, 0xc8, 0xe9, 0x1f, 0xe7, 0x60, 0x85, 0x1b, 0xd9, 0xe5, 0x98, 0x43, 0xc7, 0x4a, 0x50,
+	0x48, 0xb8, 0x24, 0x46, 0x6f, 0xe6, 0x0d, 0x7a, 0x02, 0xc0, 0xe7, 0x9b, 0x2e, 0xb6, 0x3b, 0xa4,
+	0x5c, 0xa8, 0x6a, 0xb5, 0xb9, 0x9d, 0xaa, 0x02, 0xdf, 0x0b, 0xcb, 0x61, 0x06, 0xe7, 0xe3, 0xe1,
+	0xf3, 0x82, 0x01, 0xba, 0x0e, 0x73, 0x7d, 0xec, 0x12, 0x9b, 0xc9, 0x00, 0x4f, 0xf9, 0x68, 0x40,
+	0x12, 0x45, 0x84, 0xaf, 0x40, 0xb1, 0x8f, 0x3b, 0xa4, 0xe9, 0xd1, 0x33, 0x52, 0x9e, 0xae, 0x6a,
+	0xb5, 0x29, 0x63, 0x96, 0x13, 0x5e, 0xd0, 0x33, 0x82, 0xae, 0x01, 0x88, 0x49, 0xe6, 0xbc, 0x24,
+	0x76, 0x79, 0xa6, 0xaa, 0xd5, 0x8a, 0x86, 0x60, 0xff, 0x80, 0x13, 0x86, 0xe2, 0xbd, 0x0f, 0xc5,
+	0x10, 0x08, 0x97, 0xf5, 0x18, 0x76, 0x59, 0x53, 0xb8, 0xcc, 0x03, 0x51, 0x30, 0x8a, 0x82, 0xc2,
+	0x79, 0xd0, 0x65, 0x98, 0x25, 0x76, 0xbb, 0x19, 0xc5, 0xc3, 0x98, 0x21, 0x76, 0x9b, 0x4f, 0xe9,
+	0x9f, 0x6b, 0x80, 0xe2, 0x21, 0xf5, 0x33, 0xf6, 0x21, 0x2c, 0xcb, 0xc5, 0x62, 0x3a, 0x36, 0xc3,
+	0xd4, 0x26, 0x6e, 0x90, 0xb5, 0x6d, 0x45, 0x54, 0x76, 0xc5, 0x82, 0x15, 0x6a, 0xf6, 0x02, 0x19,
+	0x63, 0xa9, 0x95, 0x18, 0x7b, 0xe8, 0x16, 0x2c, 0xd9, 0xe4, 0x84, 0x35, 0x63, 0x9e, 0xe6, 0x84,
+	0xa7, 0x0b, 0x9c, 0x7c, 0x18, 0x78, 0xcb, 0x1d, 0x62, 0x0e, 0xc3, 0x96, 0x0c, 0x55, 0x5e, 0x84,
+	0xaa, 0x28, 0x28, 0x3c, 0x56, 0xfa, 0xa7, 0x1a, 0x94, 0xb2, 0x0c, 0xa2, 0x47, 0x30, 0x25, 0x4c,
+	0x8a, 0x18, 0xa8, 0x97, 0x58, 0x4c, 0xd6, 0x90, 0x02, 0xe8, 0x5e, 0xa2, 0x3c, 0x38, 0xa8, 0xf9,
+	0xdd, 0x95, 0x6f, 0xbe, 0xda, 0x58, 0xf0, 0xbc, 0xb3, 0xbb, 0x1c, 0xc5, 0x63, 0xfd, 0xed, 0x1d,
+	0x3d, 0x5e, 0x2f, 0x57, 0xa1, 0x68, 0x62, 0xdb, 0xb1, 0xa9, 0x89, 0x2d, 0x01, 0x71, 0xd6, 0x88,
+	0x08, 0xfa, 0xbf, 0x0b, 0x50, 0xdc, 0xe3, 0xbd, 0xe8, 0x80, 0xe0, 0x76, 0x4a, 0xbb, 0x36, 0x81,
+	0xf6, 0x6b, 0x81, 0x44, 0x2c, 0x6b, 0x72, 0x5a, 0xa4, 0x74, 0x13, 0x16, 0x8f, 0xa8, 0x8d, 0x2d,
+	0x7a, 0x46, 0xfc, 0xc4, 0x8a, 0x15, 0x6d, 0x2c, 0x84, 0x54, 0xc1, 0xb6, 0x07, 0xa5, 0x88, 0x2d,
+	0x86, 0xa0, 0xa0, 0x42, 0x80, 0x42, 0xf6, 0xdd, 0x10, 0xca, 0x26, 0x2c, 0x7e, 0x34, 0xf0, 0x18,
+	0x3d, 0xa2, 0x81, 0xad, 0x29, 0x69, 0x2b, 0xa4, 0x06, 0xb6, 0x22, 0xb6, 0x98, 0xad, 0x69, 0xa5,
+	0xad, 0x90, 0x3d, 0xb2, 0xf5, 0x10, 0x2e, 0xf5, 0x5d, 0x72, 0x4c, 0x9d, 0x81, 0xd7, 0x4c, 0x19,
+	0x9d, 0x11, 0x46, 0x2f, 0x06, 0xd3, 0x3f, 0x4a, 0x18, 0xff, 0x00, 0xae, 0x65, 0xc8, 0xc5, 0x50,
+	0xcc, 0xaa, 0x50, 0x54, 0x86, 0x14, 0x46, 0x68, 0xb6, 0x60, 0x29, 0x0a, 0x9f, 0x6c, 0x1c, 0x45,
+	0x81, 0x22, 0x0a, 0xfe, 0xbe, 0xe8, 0x1f, 0x5b, 0xb0, 0x14, 0x59, 0x95, 0x8c, 0x20, 0x19, 0x43,
+	0xb2, 0x64, 0x7c, 0x04, 0xe5, 0x0c, 0x9c, 0x52, 0x62, 0x4e, 0x48, 0xac, 0x0d, 0xe1, 0x11, 0x92,
+	0xfa, 0xcf, 0x61, 0xf3, 0x05, 0x73, 0x09, 0xee, 0x7d, 0x18, 0xf4, 0x7c, 0x83, 0x74, 0xa8, 0xc7,
+	0xdc, 0xd3, 0xbd, 0x2e, 0x6f, 0x02, 0x61, 0x3f, 0x7c, 0x00, 0x73, 0xfd, 0x41, 0xcb, 0xa2, 0x66,
+	0xf3, 0x25, 0x39, 0x95, 0x65, 0x3b, 0xbf, 0xbb, 0xfa, 0xcd, 0x57, 0x1b, 0x4b, 0x91, 0xe3, 0xef,
+	0xdf, 0x79, 0xf0, 0x48, 0x37, 0x40, 0xf2, 0xfd, 0x98, 0x9c, 0x7a, 0xfa, 0xdf, 0xf2, 0x50, 0x56,
+	0x69, 0x46, 0xa5, 0xa0, 0x6d, 0xca, 0xd6, 0xe2, 0x37, 0xcd, 0x4d, 0x58, 0x0c, 0x7d, 0x91, 0xd3,
+	0x72, 0x99, 0x2e, 0x04, 0x54, 0xe9, 0xf2, 0x21, 0xcc, 0x98, 0x52, 0x4f, 0x39, 0x2f, 0x5a, 0xc8,
+	0x43, 0x45, 0x55, 0xaa, 0xcc, 0xd7, 0xe5, 0xaf, 0x11, 0xa8, 0xa9, 0xfc, 0x2e, 0x07, 0xd3, 0x92,
+	0xc6, 0x0b, 0x2b, 0x72, 0x36, 0xbb, 0xb0, 0xb8, 0xa7, 0xc5, 0xd0, 0x53, 0xee, 0x0b, 0xb5, 0xdb,
+	0xe4, 0xc4, 0x07, 0x2b, 0x07, 0xbc, 0x98, 0xb1, 0xc9, 0xe8, 0x31, 0x66, 0xa4, 0x1d, 0x14, 0x73,
+	0x48, 0x40, 0x6b, 0x30, 0x4d, 0x4e, 0x28, 0x9f, 0x2a, 0x88, 0x29, 0x7f, 0x84, 0xca, 0x30, 0xe3,
+	0x59, 0xd8, 0xeb, 0x92, 0xb6, 0x28, 0x89, 0x59, 0x23, 0x18, 0xa2, 0xf7, 0xa0, 0x12, 0xc5, 0xe6,
+	0xe8, 0x88, 0x70, 0x55, 0xa4, 0xd9, 0xc2, 0x16, 0xb6, 0x4d, 0xd9, 0xfb, 0x0b, 0x46, 0xb8, 0x12,
+	0xf6, 0x03, 0x86, 0x5d, 0x39, 0x8f, 0xb6, 0x61, 0x65, 0x58, 0x48, 0xae, 0xff, 0x65, 0x92, 0x62,
+	0xd6, 0xff, 0xa1, 0xc1, 0x95, 0xa7, 0x84, 0x85, 0xd1, 0xf3, 0xe9, 0xb1, 0xfd, 0x31, 0x2b, 0x79,
+	0xa9, 0x55, 0x92, 0x9b, 0x68, 0x95, 0x70, 0x87, 0xa9, 0xdd, 0xa6, 0xa6, 0x9f, 0xcb, 0x82, 0x11,
+	0x0c, 0x93, 0x7b, 0x5b, 0x61, 0xe4, 0xde, 0x36, 0x95, 0xda, 0xdb, 0xf4, 0xcf, 0x72, 0xb0, 0x32,
+	0x04, 0x1f, 0x3d, 0x87, 0x59, 0xdf, 0xf5, 0x60, 0xef, 0xb9, 0x37, 0x6e, 0xe1, 0x04, 0xb2, 0x75,
+	0xff, 0x8f, 0x11, 0x6a, 0x88, 0xa2, 0x90, 0x8b, 0x47, 0x21, 0x63, 0x3f, 0xca, 0x8f, 0xdf, 0x8f,
+	0x0a, 0xa9, 0xfd, 0xa8, 0xf2, 0x12, 0x66, 0x82, 0xd4, 0x9d, 0xd7, 0x82, 0x2c, 0xc3, 0x4c, 0x90,
+	0x78, 0xd9, 0xd9, 0x83, 0xa1, 0xfe, 0x89, 0x06, 0xa5, 0x78, 0xbe, 0xc3, 0x44, 0xaf, 0x25, 0x12,
+	0x1d, 0x1d, 0x6e, 0x2a, 0x30, 0xd3, 0x21, 0x36, 0xf1, 0xa8, 0x27, 0x4c, 0xcc, 0x1e, 0x5c, 0x30,
+	0x02, 0x42, 0x32, 0x6d, 0xf9, 0x91, 0x69, 0x2b, 0x8c, 0x3b, 0x92, 0x7c, 0xa6, 0x01, 0x44, 0xa8,
+	0x14, 0xeb, 0xee, 0x07, 0x00, 0xe1, 0xa1, 0x55, 0x2e, 0x3b, 0xf5, 0x49, 0x2b, 0x6a, 0x08, 0x31,
+	0x99, 0x73, 0xca, 0x99, 0x4e, 0xe1, 0x22, 0x3f, 0xf8, 0xec, 0x39, 0xbd, 0x1e, 0x65, 0x8c, 0x8c,
+	0xab, 0x97, 0x44, 0xa0, 0x72, 0x23, 0x03, 0x95, 0x4f, 0xaf, 0xef, 0xaf, 0x73, 0xb0, 0x2c, 0x8f,
+	0x1c, 0x91, 0x35, 0x85, 0x99, 0x9f, 0x02, 0x98, 0x21, 0x8f, 0x1f, 0x9e, 0x77, 0x46, 0x9e, 0x62,
+	0x22, 0x95, 0xf5, 0xf0, 0xef, 0x33, 0x46, 0x7a, 0x46, 0x4c, 0x11, 0x7a, 0x00, 0x6b, 0x58, 0x76,
+	0x93, 0x30, 0x90, 0x4d, 0xd3, 0x19, 0xd8, 0xc1, 0xb1, 0xa1, 0x24, 0x67, 0xc3, 0x80, 0xef, 0xf1,
+	0xb9, 0xac, 0x48, 0x17, 0xc6, 0x47, 0x7a, 0x2a, 0x5d, 0x1d, 0x47, 0xb0, 0x90, 0x40, 0x86, 0x90,
+	0x7f, 0x36, 0x97, 0x9e, 0xcb, 0x93, 0x79, 0x09, 0xa6, 0xbc, 0x2e, 0x76, 0xdb, 0x41, 0x15, 0x88,
+	0x01, 0x6f, 0x84, 0x11, 0xe0, 0x64, 0xe7, 0x59, 0x0e, 0x27, 0x9e, 0x49, 0xba, 0xfe, 0x2e, 0xdc,
+	0x88, 0xd7, 0xc5, 0x13, 0xe1, 0xd2, 0x0b, 0xc2, 0x52, 0xfb, 0x63, 0x66, 0xe0, 0xf5, 0xff, 0x6b,
+	0xb0, 0x9c, 0x96, 0x50, 0xe4, 0xe8, 0x29, 0x5c, 0x0c, 0xb7, 0x86, 0xe6, 0x84, 0x4d, 0x74, 0x35,
+	0x94, 0x38, 0x8c, 0xba, 0xe9, 0x13, 0x40, 0x72, 0x23, 0x49, 0x68, 0xc9, 0xab, 0xb5, 0x2c, 0x4b,
+	0xf6, 0x98, 0x8a, 0x3d, 0x58, 0x25, 0x1f, 0x11, 0x33, 0xad, 0xa3, 0xa0, 0xd6, 0xb1, 0xe2, 0xf3,
+	0x47, 0x4a, 0xf4, 0xbf, 0x6b, 0xb0, 0x18, 0x86, 0xed, 0x27, 0x03, 0x32, 0x20, 0x68, 0x03, 0xe6,
+	0xcc, 0xee, 0xc0, 0xb5, 0x9b, 0x16, 0xed, 0xd1, 0x20, 0x53, 0x20, 0x48, 0xcf, 0x39, 0x05, 0x3d,
+	0xf3, 0x57, 0x94, 0xb8, 0x9a, 0x4d, 0x1a, 0x85, 0x52, 0x24, 0x12, 0xf3, 0xe1, 0xfb, 0x20, 0xfc,
+	0x9a, 0x34, 0x08, 0x8b, 0x9c, 0x39, 0x86, 0xfe, 0x0b, 0x0d, 0x36, 0x78, 0x25, 0x47, 0x89, 0xf7,
+	0x3c, 0xda, 0xb1, 0x7b, 0xc4, 0x66, 0xdf, 0xa1, 0x3d, 0xf0, 0x0f, 0x79, 0x28, 0x65, 0x79, 0xa0,
+	0x80, 0x8e, 0x61, 0x0e, 0x47, 0x4c, 0x7e, 0xa3, 0x78, 0x7f, 0x5c, 0x1f, 0x8d, 0xe9, 0x8d, 0x9a,
+	0x45, 0x44, 0x34, 0xe2, 0x3a, 0xcf, 0x6b, 0x6f, 0xfc, 0xa7, 0x06, 0xab, 0x19, 0xb6, 0xd0, 0x7d,
+	0x28, 0x99, 0xae, 0xe3, 0x79, 0x16, 0xb5, 0xf9, 0x35, 0x33, 0xec, 0x79, 0x9a, 0x88, 0xe9, 0x6a,
+	0x38, 0x97, 0x6c, 0x99, 0x19, 0x3d, 0x22, 0xe8, 0x26, 0xf9, 0x58, 0x37, 0xa9, 0xc0, 0x6c, 0xdf,
+	0x75, 0xfa, 0x8e, 0x47, 0x5c, 0xff, 0xc8, 0x16, 0x8e, 0x53, 0x3b, 0xf4, 0xd4, 0xf8, 0x1d, 0x5a,
+	0x7f, 0x04, 0xd5, 0x78, 0x63, 0x39, 0xc4, 0x2e, 0xa3, 0x26, 0xed, 0xcb, 0x27, 0x8a, 0x91, 0x5d,
+	0xe5, 0x4b, 0x0d, 0xd6, 0xb2, 0xe5, 0x14, 0x79, 0xbd, 0x0a, 0xc5, 0xf0, 0x6a, 0x21, 0x77, 0x6b,
+	0x23, 0x22, 0xa0, 0xc7, 0x70, 0xb9, 0x63, 0x39, 0x2d, 0x6c, 0x35, 0xfb, 0x71, 0x5d, 0x4d, 0x17,
+	0x33, 0xb9, 0x7b, 0xe7, 0x8c, 0x4b, 0x92, 0x21, 0x89, 0x11, 0x33, 0x51, 0xd1, 0xc7, 0x0e, 0xef,
+	0x13, 0x62, 0x8d, 0x88, 0xa8, 0x14, 0x0c, 0x10, 0xa4, 0x7d, 0x4e, 0xe1, 0xc7, 0x79, 0x62, 0xd1,
+	0x0e, 0x6d, 0x59, 0xc4, 0xe7, 0xf1, 0xaf, 0x79, 0x01, 0x55, 0xb0, 0x89, 0xe3, 0x66, 0xa2, 0xdc,
+	0x0c, 0xf2, 0x0b, 0xec, 0xb6, 0xbf, 0x43, 0xa5, 0xf6, 0xe7, 0x1c, 0x2c, 0xa7, 0xd1, 0x2b, 0x60,
+	0x1f, 0xc0, 0x8c, 0x2b, 0x19, 0xfc, 0x12, 0xab, 0x8f, 0xbf, 0xbb, 0x08, 0xf6, 0xba, 0xfc, 0x35,
+	0x02, 0xf1, 0xf3, 0xaa, 0xa6, 0x2e, 0x4c, 0x4b, 0xcd, 0xe7, 0x76, 0xd0, 0x5c, 0x83, 0x69, 0x89,
+	0x51, 0xe0, 0xc9, 0x1b, 0xfe, 0x48, 0xc7, 0x70, 0x29, 0xf6, 0x12, 0x77, 0xe8, 0x38, 0xd6, 0x79,
+	0xbf, 0xe7, 0xed, 0xfc, 0x0f, 0xc1, 0x9c, 0x7f, 0x88, 0xe9, 0x62, 0x6a, 0xa3, 0x3f, 0x69, 0xb0,
+	0x9c, 0x7e, 0x44, 0x44, 0xaa, 0x88, 0x2b, 0xde, 0x39, 0x2b, 0x8d, 0x89, 0xf9, 0xa5, 0x37, 0xfa,
+	0xed, 0x5f, 0xff, 0xe7, 0xeb, 0xdf, 0xe7, 0x6e, 0xa0, 0xeb, 0x59, 0x2f, 0xb0, 0xf1, 0xe7, 0x5a,
+	0x0f, 0x7d, 0xac, 0xc1, 0x52, 0x2a, 0x28, 0x68, 0xad, 0x2e, 0x5f, 0x80, 0xeb, 0xc1, 0x0b, 0x70,
+	0x7d, 0xbf, 0xd7, 0x67, 0xa7, 0x95, 0xfa, 0xf8, 0x70, 0xc4, 0x83, 0xaa, 0xd7, 0x05, 0x8c, 0x1a,
+	0xba, 0x35, 0x16, 0x46, 0xa3, 0xcf, 0xed, 0xfe, 0x46, 0x03, 0x24, 0x1f, 0x04, 0x12, 0xe1, 0x52,
+	0xc1, 0x99, 0x20, 0x3b, 0xfa, 0x3d, 0x01, 0xe1, 0x2d, 0x54, 0x1b, 0x0f, 0xc1, 0x13, 0x96, 0xef,
+	0x69, 0xe8, 0xb7, 0x1a, 0x40, 0xf4, 0x80, 0x88, 0x6a, 0x23, 0xa2, 0x9f, 0x78, 0xb6, 0xad, 0xdc,
+	0x9e, 0x80, 0xd3, 0x0f, 0xcd, 0x0d, 0x81, 0xeb, 0x1a, 0xba, 0x92, 0x89, 0xab, 0x25, 0x2d, 0xf7,
+	0x61, 0xfe, 0xa9, 0x38, 0xb9, 0xf9, 0x4f, 0x6e, 0xaa, 0x40, 0xa8, 0x2e, 0x1b, 0xa1, 0xa4, 0x7e,
+	0x4b, 0x98, 0xab, 0xa2, 0xf5, 0x4c, 0x73, 0xe2, 0x03, 0x43, 0x97, 0x5b, 0x38, 0x81, 0x79, 0x99,
+	0x00, 0xdf, 0xf7, 0x37, 0x0d, 0x7d, 0xec, 0x15, 0x52, 0x7f, 0x4b, 0xd8, 0xbc, 0x89, 0xf4, 0x11,
+	0x2e, 0x46, 0x41, 0xff, 0x25, 0x2c, 0x49, 0xcb, 0xe7, 0xe1, 0xee, 0x5d, 0x61, 0x7a, 0x0b, 0x6d,
+	0x8e, 0x76, 0x37, 0xb2, 0xfe, 0x85, 0x06, 0xeb, 0xa3, 0x9f, 0xa2, 0xd0, 0x7b, 0xaa, 0xb7, 0xf3,
+	0x49, 0x5e, 0xb0, 0x94, 0x25, 0xac, 0x92, 0x53, 0x2d, 0xdc, 0xe8, 0xd2, 0xd8, 0x70, 0x7d, 0x89,
+	0xc8, 0x8b, 0x4f, 0x35, 0x79, 0x01, 0x1c, 0x7e, 0x78, 0xd8, 0x51, 0x98, 0x1f, 0xf1, 0xc8, 0x52,
+	0xa9, 0x4d, 0xfa, 0x34, 0xa1, 0x6a, 0x37, 0x31, 0xac, 0xe1, 0x9b, 0xc5, 0xaf, 0x34, 0x58, 0x48,
+	0xdc, 0xf4, 0xd1, 0xf6, 0x04, 0xd0, 0x42, 0x4c, 0xd7, 0xc7, 0x61, 0xf2, 0xf4, 0xaa, 0x00, 0x53,
+	0x41, 0x65, 0x15, 0x18, 0xf4, 0x89, 0x06, 0x25, 0x51, 0x92, 0xe9, 0xfb, 0xeb, 0x9d, 0x11, 0xf5,
+	0x3b, 0x74, 0xa9, 0xae, 0x6c, 0x4d, 0x78, 0x87, 0xd5, 0xb7, 0x04, 0xa2, 0xeb, 0x68, 0x23, 0x7b,
+	0x35, 0x46, 0xf6, 0xff, 0xaa, 0xc1, 0xd5, 0x51, 0xd7, 0x3d, 0xf4, 0x78, 0x82, 0x58, 0x29, 0xee,
+	0x88, 0x4a, 0xb8, 0x69, 0x7e, 0xfd, 0xbe, 0x80, 0xbb, 0x8d, 0x6e, 0x2b, 0xb3, 0x29, 0x6f, 0xd6,
+	0x1e, 0x61, 0xfe, 0xeb, 0x25, 0x3a, 0x83, 0x95, 0x38, 0x04, 0x79, 0xdf, 0x52, 0x95, 0xef, 0xe6,
+	0xb8, 0x1c, 0x0a, 0x71, 0x55, 0xcb, 0x8a, 0xc1, 0x78, 0x25, 0xcc, 0xfc, 0x45, 0x93, 0x9f, 0xe9,
+	0x32, 0x6f, 0x1a, 0x0f, 0x47, 0x64, 0x74, 0xc4, 0xe5, 0xaa, 0xb2, 0xfd, 0x06, 0xd7, 0x0e, 0xfd,
+	0x8e, 0x40, 0x7a, 0x0b, 0xdd, 0x54, 0x07, 0x2c, 0x06, 0xe9, 0x73, 0x0d, 0x2e, 0x2b, 0x8f, 0xde,
+	0xe8, 0x7b, 0x13, 0x64, 0x38, 0xeb, 0xb0, 0x5e, 0xb9, 0x3b, 0x0e, 0x71, 0x42, 0x4a, 0xb5, 0x35,
+	0xc7, 0x30, 0x27, 0x8e, 0xe3, 0xe8, 0x8f, 0x7e, 0xcd, 0x0c, 0x1d, 0x32, 0x77, 0x26, 0x89, 0x70,
+	0xf2, 0x3c, 0xad, 0x5c, 0x8a, 0x69, 0x7e, 0xbd, 0x26, 0x50, 0xea, 0xa8, 0x3a, 0xa2, 0x09, 0x0a,
+	0xce, 0xdd, 0xbd, 0x7f, 0xbd, 0x5e, 0xd7, 0xbe, 0x7c, 0xbd, 0xae, 0xfd, 0xf7, 0xf5, 0xba, 0xf6,
+	0xb3, 0x77, 0x62, 0x9f, 0xc3, 0xfb, 0xee, 0xa9, 0xd7, 0xc3, 0x8c, 0x9a, 0x16, 0x6e, 0x79, 0x72,
+	0xd4, 0x18, 0xfe, 0xec, 0xfc, 0x2e, 0x61, 0xdd, 0xd6, 0xb4, 0xa0, 0xbf, 0xfd, 0x6d, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x54, 0xa3, 0x22, 0x27, 0x8c, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconChainClient is the client API for BeaconChain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainClient interface {
+	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
+	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
+	StreamAttestations(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamAttestationsClient, error)
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
+	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
+	StreamBlocks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamBlocksClient, error)
+	StreamChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamChainHeadClient, error)
+	StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryChangesClient, error)
+	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
+	ListBeaconCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*BeaconCommittees, error)
+	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
+	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
+	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
+	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+	ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error)
+}
+
+type beaconChainClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
+	return &beaconChainClient{cc}
+}
+
 func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
 	out := new(ListAttestationsResponse)
 	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
@@ -1733,6 +2688,38 @@ func (c *beaconChainClient) AttestationPool(ctx context.Context, in *types.Empty
 	return out, nil
 }
 
+func (c *beaconChainClient) StreamAttestations(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[0], "/ethereum.eth.v1alpha1.BeaconChain/StreamAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamAttestationsClient interface {
+	Recv() (*Attestation, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamAttestationsClient) Recv() (*Attestation, error) {
+	m := new(Attestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
 	out := new(ListBlocksResponse)
 	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
@@ -1751,72 +2738,192 @@ func (c *beaconChainClient) GetChainHead(ctx context.Context, in *types.Empty, o
 	return out, nil
 }
 
-func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
-	out := new(ValidatorBalances)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
+func (c *beaconChainClient) StreamBlocks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[1], "/ethereum.eth.v1alpha1.BeaconChain/StreamBlocks", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
-	out := new(Validators)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
-	if err != nil {
+	x := &beaconChainStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
-	out := new(ActiveSetChanges)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
-	if err != nil {
+type BeaconChain_StreamBlocksClient interface {
+	Recv() (*BeaconBlock, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamBlocksClient) Recv() (*BeaconBlock, error) {
+	m := new(BeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
-	out := new(ValidatorQueue)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
+func (c *beaconChainClient) StreamChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconChain_StreamChainHeadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[2], "/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &beaconChainStreamChainHeadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
-	out := new(ValidatorAssignments)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
-	if err != nil {
+type BeaconChain_StreamChainHeadClient interface {
+	Recv() (*ChainHead, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamChainHeadClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamChainHeadClient) Recv() (*ChainHead, error) {
+	m := new(ChainHead)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
-	out := new(ValidatorParticipation)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
+func (c *beaconChainClient) StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[3], "/ethereum.eth.v1alpha1.BeaconChain/StreamValidatorRegistryChanges", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &beaconChainStreamValidatorRegistryChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// BeaconChainServer is the server API for BeaconChain service.
+type BeaconChain_StreamValidatorRegistryChangesClient interface {
+	Recv() (*ValidatorRegistryChanges, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamValidatorRegistryChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamValidatorRegistryChangesClient) Recv() (*ValidatorRegistryChanges, error) {
+	m := new(ValidatorRegistryChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
+	out := new(ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
+	out := new(Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListBeaconCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*BeaconCommittees, error) {
+	out := new(BeaconCommittees)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
+	out := new(ActiveSetChanges)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
+	out := new(ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
+	out := new(ValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
+	out := new(ValidatorParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error) {
+	out := new(ValidatorRewards)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconChainServer is the server API for BeaconChain service.
 type BeaconChainServer interface {
 	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
 	AttestationPool(context.Context, *types.Empty) (*AttestationPoolResponse, error)
+	StreamAttestations(*types.Empty, BeaconChain_StreamAttestationsServer) error
 	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
 	GetChainHead(context.Context, *types.Empty) (*ChainHead, error)
+	StreamBlocks(*types.Empty, BeaconChain_StreamBlocksServer) error
+	StreamChainHead(*types.Empty, BeaconChain_StreamChainHeadServer) error
+	StreamValidatorRegistryChanges(*StreamValidatorRegistryChangesRequest, BeaconChain_StreamValidatorRegistryChangesServer) error
 	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
 	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
+	ListBeaconCommittees(context.Context, *ListCommitteesRequest) (*BeaconCommittees, error)
 	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
 	GetValidatorQueue(context.Context, *types.Empty) (*ValidatorQueue, error)
 	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
 	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+	ListValidatorRewards(context.Context, *ListValidatorRewardsRequest) (*ValidatorRewards, error)
 }
 
 func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
@@ -1859,6 +2966,27 @@ func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconChain_StreamAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamAttestations(m, &beaconChainStreamAttestationsServer{stream})
+}
+
+type BeaconChain_StreamAttestationsServer interface {
+	Send(*Attestation) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamAttestationsServer) Send(m *Attestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBlocksRequest)
 	if err := dec(in); err != nil {
@@ -1895,6 +3023,69 @@ func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconChain_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamBlocks(m, &beaconChainStreamBlocksServer{stream})
+}
+
+type BeaconChain_StreamBlocksServer interface {
+	Send(*BeaconBlock) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamBlocksServer) Send(m *BeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_StreamChainHead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamChainHead(m, &beaconChainStreamChainHeadServer{stream})
+}
+
+type BeaconChain_StreamChainHeadServer interface {
+	Send(*ChainHead) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamChainHeadServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamChainHeadServer) Send(m *ChainHead) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_StreamValidatorRegistryChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamValidatorRegistryChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamValidatorRegistryChanges(m, &beaconChainStreamValidatorRegistryChangesServer{stream})
+}
+
+type BeaconChain_StreamValidatorRegistryChangesServer interface {
+	Send(*ValidatorRegistryChanges) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamValidatorRegistryChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamValidatorRegistryChangesServer) Send(m *ValidatorRegistryChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorBalancesRequest)
 	if err := dec(in); err != nil {
@@ -1931,6 +3122,24 @@ func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconChain_ListBeaconCommittees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListBeaconCommittees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListBeaconCommittees(ctx, req.(*ListCommitteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorActiveSetChangesRequest)
 	if err := dec(in); err != nil {
@@ -2003,6 +3212,24 @@ func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconChain_ListValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorRewards(ctx, req.(*ListValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BeaconChain_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
 	HandlerType: (*BeaconChainServer)(nil),
@@ -2031,6 +3258,10 @@ var _BeaconChain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidators",
 			Handler:    _BeaconChain_GetValidators_Handler,
 		},
+		{
+			MethodName: "ListBeaconCommittees",
+			Handler:    _BeaconChain_ListBeaconCommittees_Handler,
+		},
 		{
 			MethodName: "GetValidatorActiveSetChanges",
 			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
@@ -2047,8 +3278,33 @@ var _BeaconChain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetValidatorParticipation",
 			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
 		},
+		{
+			MethodName: "ListValidatorRewards",
+			Handler:    _BeaconChain_ListValidatorRewards_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAttestations",
+			Handler:       _BeaconChain_StreamAttestations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _BeaconChain_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamChainHead",
+			Handler:       _BeaconChain_StreamChainHead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamValidatorRegistryChanges",
+			Handler:       _BeaconChain_StreamValidatorRegistryChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
 }
 
@@ -2159,6 +3415,17 @@ func (m *ListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += nn2
 	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2189,6 +3456,61 @@ func (m *ListBlocksRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
 	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	return i, nil
 }
+func (m *ListBlocksRequest_SlotRange) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SlotRange != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SlotRange.Size()))
+		n3, err := m.SlotRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *ListBlocksRequest_ParentRoot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ParentRoot != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.ParentRoot)))
+		i += copy(dAtA[i:], m.ParentRoot)
+	}
+	return i, nil
+}
+func (m *SlotRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ListBlocksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2204,8 +3526,8 @@ func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Blocks) > 0 {
-		for _, msg := range m.Blocks {
+	if len(m.BlockContainers) > 0 {
+		for _, msg := range m.BlockContainers {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
@@ -2216,6 +3538,64 @@ func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BeaconBlockContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconBlockContainer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Block.Size()))
+		n4, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	if m.Canonical {
+		dAtA[i] = 0x18
+		i++
+		if m.Canonical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2281,13 +3661,28 @@ func (m *ChainHead) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PreviousJustifiedBlockRoot)))
 		i += copy(dAtA[i:], m.PreviousJustifiedBlockRoot)
 	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if m.JustifiedEpoch != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedEpoch))
+	}
+	if m.PreviousJustifiedEpoch != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousJustifiedEpoch))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
+func (m *StreamValidatorRegistryChangesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2297,12 +3692,156 @@ func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StreamValidatorRegistryChangesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorRegistryChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRegistryChanges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if m.PreviousEpoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousEpoch))
+	}
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorRegistryChanges_Change) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRegistryChanges_Change) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if m.Activated {
+		dAtA[i] = 0x18
+		i++
+		if m.Activated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Exited {
+		dAtA[i] = 0x20
+		i++
+		if m.Exited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Slashed {
+		dAtA[i] = 0x28
+		i++
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PreviousEffectiveBalance != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousEffectiveBalance))
+	}
+	if m.EffectiveBalance != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EffectiveBalance))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
 		dAtA[i] = 0x8
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
@@ -2316,21 +3855,32 @@ func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Indices) > 0 {
-		dAtA4 := make([]byte, len(m.Indices)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.Indices)*10)
+		var j5 int
 		for _, num := range m.Indices {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2365,6 +3915,22 @@ func (m *ValidatorBalances) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Epoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2424,11 +3990,11 @@ func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.QueryFilter != nil {
-		nn5, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		nn7, err := m.QueryFilter.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn5
+		i += nn7
 	}
 	if m.PageSize != 0 {
 		dAtA[i] = 0x18
@@ -2515,7 +4081,7 @@ func (m *Validators) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListCommitteesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2525,7 +4091,7 @@ func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListCommitteesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2535,13 +4101,24 @@ func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
+func (m *BeaconCommittees) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2551,7 +4128,7 @@ func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
+func (m *BeaconCommittees) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2561,29 +4138,33 @@ func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
+	if len(m.Committees) > 0 {
+		for _, msg := range m.Committees {
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if m.ActiveValidatorCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ActiveValidatorCount))
 	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2591,7 +4172,7 @@ func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
+func (m *BeaconCommittees_CommitteeItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2601,31 +4182,37 @@ func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
+func (m *BeaconCommittees_CommitteeItem) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ChurnLimit != 0 {
+	if m.Slot != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
 	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
 	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	if len(m.ValidatorIndices) > 0 {
+		dAtA9 := make([]byte, len(m.ValidatorIndices)*10)
+		var j8 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2633,7 +4220,7 @@ func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2643,7 +4230,7 @@ func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2653,30 +4240,148 @@ func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Indices) > 0 {
-		dAtA7 := make([]byte, len(m.Indices)*10)
-		var j6 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
+	return i, nil
+}
+
+func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA11 := make([]byte, len(m.Indices)*10)
+		var j10 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j10))
+		i += copy(dAtA[i:], dAtA11[:j10])
 	}
 	if m.PageSize != 0 {
 		dAtA[i] = 0x20
@@ -2760,21 +4465,21 @@ func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.CrosslinkCommittees) > 0 {
-		dAtA9 := make([]byte, len(m.CrosslinkCommittees)*10)
-		var j8 int
+		dAtA13 := make([]byte, len(m.CrosslinkCommittees)*10)
+		var j12 int
 		for _, num := range m.CrosslinkCommittees {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j12++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j8))
-		i += copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
 	}
 	if m.Shard != 0 {
 		dAtA[i] = 0x10
@@ -2886,7 +4591,7 @@ func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2896,14 +4601,81 @@ func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attestations) > 0 {
-		for _, msg := range m.Attestations {
-			dAtA[i] = 0xa
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA15 := make([]byte, len(m.Indices)*10)
+		var j14 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	}
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, msg := range m.Rewards {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -2913,39 +4685,120 @@ func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorRewards_Reward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *ListAttestationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorRewards_Reward) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	}
+	if m.Reward != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Reward))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -3000,6 +4853,13 @@ func (m *ListBlocksRequest) Size() (n int) {
 	if m.QueryFilter != nil {
 		n += m.QueryFilter.Size()
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3036,18 +4896,90 @@ func (m *ListBlocksRequest_Epoch) Size() (n int) {
 	n += 1 + sovBeaconChain(uint64(m.Epoch))
 	return n
 }
+func (m *ListBlocksRequest_SlotRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotRange != nil {
+		l = m.SlotRange.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *ListBlocksRequest_ParentRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentRoot != nil {
+		l = len(m.ParentRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *SlotRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EndSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ListBlocksResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
+	if len(m.BlockContainers) > 0 {
+		for _, e := range m.BlockContainers {
 			l = e.Size()
 			n += 1 + l + sovBeaconChain(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconBlockContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Canonical {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3088,6 +5020,91 @@ func (m *ChainHead) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBeaconChain(uint64(l))
 	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.FinalizedEpoch))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.JustifiedEpoch))
+	}
+	if m.PreviousJustifiedEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PreviousJustifiedEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamValidatorRegistryChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRegistryChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.PreviousEpoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PreviousEpoch))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRegistryChanges_Change) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Activated {
+		n += 2
+	}
+	if m.Exited {
+		n += 2
+	}
+	if m.Slashed {
+		n += 2
+	}
+	if m.PreviousEffectiveBalance != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PreviousEffectiveBalance))
+	}
+	if m.EffectiveBalance != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EffectiveBalance))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3116,6 +5133,13 @@ func (m *GetValidatorBalancesRequest) Size() (n int) {
 		}
 		n += 1 + sovBeaconChain(uint64(l)) + l
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3134,6 +5158,16 @@ func (m *ValidatorBalances) Size() (n int) {
 			n += 1 + l + sovBeaconChain(uint64(l))
 		}
 	}
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3230,7 +5264,7 @@ func (m *Validators) Size() (n int) {
 	return n
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
+func (m *ListCommitteesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3239,13 +5273,20 @@ func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
 	if m.Epoch != 0 {
 		n += 1 + sovBeaconChain(uint64(m.Epoch))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ActiveSetChanges) Size() (n int) {
+func (m *BeaconCommittees) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3254,23 +5295,21 @@ func (m *ActiveSetChanges) Size() (n int) {
 	if m.Epoch != 0 {
 		n += 1 + sovBeaconChain(uint64(m.Epoch))
 	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
 			n += 1 + l + sovBeaconChain(uint64(l))
 		}
 	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ActiveValidatorCount))
 	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3278,26 +5317,24 @@ func (m *ActiveSetChanges) Size() (n int) {
 	return n
 }
 
-func (m *ValidatorQueue) Size() (n int) {
+func (m *BeaconCommittees_CommitteeItem) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChurnLimit != 0 {
-		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
 	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	if m.Shard != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Shard))
 	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovBeaconChain(uint64(e))
 		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3305,7 +5342,7 @@ func (m *ValidatorQueue) Size() (n int) {
 	return n
 }
 
-func (m *ListValidatorAssignmentsRequest) Size() (n int) {
+func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3314,12 +5351,87 @@ func (m *ListValidatorAssignmentsRequest) Size() (n int) {
 	if m.Epoch != 0 {
 		n += 1 + sovBeaconChain(uint64(m.Epoch))
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
-	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActiveSetChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
 	if len(m.Indices) > 0 {
 		l = 0
 		for _, e := range m.Indices {
@@ -3442,6 +5554,91 @@ func (m *ValidatorParticipation) Size() (n int) {
 	return n
 }
 
+func (m *ListValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards_Reward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Reward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Reward))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AttestationPoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3511,35 +5708,1705 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListBlocksRequest_Root{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Epoch{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SlotRange{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.QueryFilter = &ListBlocksRequest_SlotRange{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListBlocksRequest_ParentRoot{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlotRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlotRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlotRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockContainers = append(m.BlockContainers, &BeaconBlockContainer{})
+			if err := m.BlockContainers[len(m.BlockContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconBlockContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconBlockContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconBlockContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canonical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canonical = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+			}
+			m.BlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedBlockRoot == nil {
+				m.FinalizedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+			}
+			m.JustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedBlockRoot == nil {
+				m.JustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+			}
+			m.PreviousJustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousJustifiedBlockRoot == nil {
+				m.PreviousJustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedEpoch", wireType)
+			}
+			m.PreviousJustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousJustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamValidatorRegistryChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamValidatorRegistryChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamValidatorRegistryChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRegistryChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRegistryChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRegistryChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpoch", wireType)
+			}
+			m.PreviousEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &ValidatorRegistryChanges_Change{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRegistryChanges_Change) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Change: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Change: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Activated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exited = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slashed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEffectiveBalance", wireType)
+			}
+			m.PreviousEffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			m.EffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var v uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3549,17 +7416,16 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Slot{v}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3569,12 +7435,24 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -3600,7 +7478,7 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3623,15 +7501,15 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3658,70 +7536,16 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBeaconChain(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBeaconChain
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3731,30 +7555,16 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListBlocksRequest_Root{v}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3764,17 +7574,29 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListBlocksRequest_Slot{v}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			var v uint64
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3784,12 +7606,11 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.TotalSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListBlocksRequest_Epoch{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -3815,7 +7636,7 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3838,17 +7659,51 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3858,26 +7713,30 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.Blocks = append(m.Blocks, &BeaconBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -3903,7 +7762,7 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainHead) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,17 +7785,17 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3946,31 +7805,17 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
-			}
-			iNdEx = postIndex
+			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
 			}
-			m.BlockSlot = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3980,16 +7825,18 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockSlot |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			b := bool(v != 0)
+			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.FinalizedSlot = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3999,16 +7846,16 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4018,31 +7865,83 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.FinalizedBlockRoot == nil {
-				m.FinalizedBlockRoot = []byte{}
-			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.JustifiedSlot = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4052,16 +7951,16 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4071,31 +7970,31 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.JustifiedBlockRoot == nil {
-				m.JustifiedBlockRoot = []byte{}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.PreviousJustifiedSlot = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4105,16 +8004,29 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			var byteLen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4124,26 +8036,11 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.PreviousJustifiedBlockRoot == nil {
-				m.PreviousJustifiedBlockRoot = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4169,7 +8066,7 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListCommitteesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4192,10 +8089,10 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitteesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4218,10 +8115,29 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4231,100 +8147,24 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Indices = append(m.Indices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Indices = append(m.Indices, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4350,7 +8190,7 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
+func (m *BeaconCommittees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4373,15 +8213,34 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconCommittees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconCommittees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4408,11 +8267,81 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Committees = append(m.Committees, &BeaconCommittees_CommitteeItem{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4438,7 +8367,7 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
+func (m *BeaconCommittees_CommitteeItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4461,17 +8390,17 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			var byteLen int
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4481,63 +8410,105 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Index = 0
+			m.Shard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			m.Balance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4564,7 +8535,7 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,77 +8558,17 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
-			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4667,24 +8578,11 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4710,7 +8608,7 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validators) Unmarshal(dAtA []byte) error {
+func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4733,10 +8631,10 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4760,9 +8658,9 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4772,31 +8670,29 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4806,29 +8702,29 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
 			}
-			m.TotalSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4838,11 +8734,24 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4868,7 +8777,7 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4891,17 +8800,17 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
 			}
-			m.Epoch = 0
+			m.ChurnLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4911,11 +8820,75 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.ChurnLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4941,7 +8914,7 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4964,10 +8937,10 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4991,7 +8964,7 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5018,14 +8991,90 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-			var byteLen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5035,29 +9084,16 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5067,23 +9103,23 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5110,7 +9146,7 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5133,17 +9169,17 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.ChurnLimit = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5153,16 +9189,16 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChurnLimit |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5172,29 +9208,31 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5204,24 +9242,43 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5247,7 +9304,7 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5270,64 +9327,13 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5344,7 +9350,7 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Indices = append(m.Indices, v)
+				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5379,8 +9385,8 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
+					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -5398,16 +9404,54 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Indices = append(m.Indices, v)
+					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			m.PageSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5417,16 +9461,17 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Proposer = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5436,23 +9481,25 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5479,7 +9526,7 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5502,10 +9549,10 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5527,11 +9574,65 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5541,31 +9642,16 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
-			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5575,29 +9661,28 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.Finalized = bool(v != 0)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GlobalParticipationRate = float32(math.Float32frombits(v))
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
 			}
-			m.TotalSize = 0
+			m.VotedEther = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5607,7 +9692,26 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				m.VotedEther |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
+			}
+			m.EligibleEther = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleEther |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5637,7 +9741,7 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5647,26 +9751,77 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 			if shift >= 64 {
 				return ErrIntOverflowBeaconChain
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType == 0 {
 				var v uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5683,7 +9838,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 						break
 					}
 				}
-				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+				m.Indices = append(m.Indices, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5718,8 +9873,8 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
-					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -5737,54 +9892,16 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 							break
 						}
 					}
-					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+					m.Indices = append(m.Indices, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var v int
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5794,17 +9911,16 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Proposer = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5814,25 +9930,23 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5859,7 +9973,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5882,10 +9996,10 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5907,6 +10021,91 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorRewards_Reward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5932,7 +10131,7 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards_Reward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5955,17 +10154,17 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5975,47 +10174,31 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			m.Finalized = bool(v != 0)
-		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GlobalParticipationRate = float32(math.Float32frombits(v))
-		case 4:
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.VotedEther = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6025,16 +10208,16 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotedEther |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
-			m.EligibleEther = 0
+			m.Reward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6044,7 +10227,7 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EligibleEther |= uint64(b&0x7F) << shift
+				m.Reward |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
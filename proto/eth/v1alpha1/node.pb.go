@@ -75,6 +75,8 @@ func (m *SyncStatus) GetSyncing() bool {
 type Genesis struct {
 	GenesisTime            *types.Timestamp `protobuf:"bytes,1,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
 	DepositContractAddress []byte           `protobuf:"bytes,2,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	GenesisValidatorsRoot  []byte           `protobuf:"bytes,3,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3" json:"genesis_validators_root,omitempty"`
+	GenesisForkVersion     []byte           `protobuf:"bytes,4,opt,name=genesis_fork_version,json=genesisForkVersion,proto3" json:"genesis_fork_version,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}         `json:"-"`
 	XXX_unrecognized       []byte           `json:"-"`
 	XXX_sizecache          int32            `json:"-"`
@@ -127,6 +129,20 @@ func (m *Genesis) GetDepositContractAddress() []byte {
 	return nil
 }
 
+func (m *Genesis) GetGenesisValidatorsRoot() []byte {
+	if m != nil {
+		return m.GenesisValidatorsRoot
+	}
+	return nil
+}
+
+func (m *Genesis) GetGenesisForkVersion() []byte {
+	if m != nil {
+		return m.GenesisForkVersion
+	}
+	return nil
+}
+
 type Version struct {
 	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	Metadata             string   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -229,452 +245,2400 @@ func (m *ImplementedServices) GetServices() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SyncStatus)(nil), "ethereum.eth.v1alpha1.SyncStatus")
-	proto.RegisterType((*Genesis)(nil), "ethereum.eth.v1alpha1.Genesis")
-	proto.RegisterType((*Version)(nil), "ethereum.eth.v1alpha1.Version")
-	proto.RegisterType((*ImplementedServices)(nil), "ethereum.eth.v1alpha1.ImplementedServices")
+type HostData struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/eth/v1alpha1/node.proto", fileDescriptor_98054421e2cad574) }
-
-var fileDescriptor_98054421e2cad574 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0xc9, 0xae, 0xd8, 0xdd, 0xec, 0x7a, 0x89, 0xb8, 0x0e, 0xb3, 0xbb, 0xb5, 0x8e, 0x20,
-	0x8b, 0x87, 0x84, 0xae, 0x08, 0x82, 0x88, 0xe8, 0x22, 0x45, 0x10, 0x0f, 0x53, 0xf1, 0xe0, 0xa5,
-	0xa4, 0x33, 0xcf, 0x99, 0x40, 0x93, 0x0c, 0x93, 0xd7, 0x42, 0xaf, 0xfd, 0x0a, 0x7e, 0x29, 0x6f,
-	0x0a, 0x7e, 0x01, 0x29, 0x7e, 0x10, 0x99, 0x99, 0x44, 0xc5, 0xb6, 0x0b, 0xde, 0xf2, 0xf2, 0x7f,
-	0xff, 0xf7, 0x4b, 0xff, 0xaf, 0x43, 0xcf, 0xab, 0xda, 0xa2, 0x15, 0x80, 0xa5, 0x58, 0x0c, 0xe5,
-	0xac, 0x2a, 0xe5, 0x50, 0x18, 0x9b, 0x03, 0x6f, 0xef, 0xd9, 0x1d, 0xc0, 0x12, 0x6a, 0x98, 0x6b,
-	0x0e, 0x58, 0xf2, 0xd0, 0x11, 0x9f, 0x15, 0xd6, 0x16, 0x33, 0x10, 0xb2, 0x52, 0x42, 0x1a, 0x63,
-	0x51, 0xa2, 0xb2, 0xc6, 0x75, 0xa6, 0xf8, 0xd4, 0xab, 0x6d, 0x35, 0x9d, 0x7f, 0x12, 0xa0, 0x2b,
-	0x5c, 0x7a, 0xf1, 0xde, 0xbf, 0x22, 0x2a, 0x0d, 0x0e, 0xa5, 0xae, 0xba, 0x86, 0xe4, 0x21, 0xa5,
-	0xe3, 0xa5, 0xc9, 0xc6, 0x28, 0x71, 0xee, 0x58, 0x44, 0x7b, 0x6e, 0x69, 0x32, 0x65, 0x8a, 0x88,
-	0x0c, 0xc8, 0xc5, 0x41, 0x1a, 0xca, 0x64, 0x45, 0x68, 0x6f, 0x04, 0x06, 0x9c, 0x72, 0xec, 0x39,
-	0x3d, 0x2e, 0xba, 0xe3, 0xa4, 0x19, 0xd7, 0xb6, 0x1e, 0x5d, 0xc6, 0xbc, 0x63, 0xf1, 0xc0, 0xe2,
-	0xef, 0x03, 0x2b, 0x3d, 0xf2, 0xfd, 0xcd, 0x0d, 0x7b, 0x4a, 0xa3, 0x1c, 0x2a, 0xeb, 0x14, 0x4e,
-	0x32, 0x6b, 0xb0, 0x96, 0x19, 0x4e, 0x64, 0x9e, 0xd7, 0xe0, 0x5c, 0xb4, 0x37, 0x20, 0x17, 0xc7,
-	0xe9, 0x89, 0xd7, 0xaf, 0xbc, 0xfc, 0xb2, 0x53, 0x93, 0x17, 0xb4, 0xf7, 0x01, 0x6a, 0xa7, 0xac,
-	0x69, 0x5e, 0xba, 0xe8, 0x8e, 0x2d, 0xfe, 0x30, 0x0d, 0x25, 0x8b, 0xe9, 0x81, 0x06, 0x94, 0xb9,
-	0x44, 0xd9, 0x8e, 0x3b, 0x4c, 0x7f, 0xd7, 0xc9, 0x90, 0xde, 0x7e, 0xa3, 0xab, 0x19, 0x68, 0x30,
-	0x08, 0xf9, 0x18, 0xea, 0x85, 0xca, 0xc0, 0x35, 0x16, 0xe7, 0xcf, 0x11, 0x19, 0xec, 0x37, 0x96,
-	0x50, 0x5f, 0x7e, 0xdd, 0xa7, 0x37, 0xde, 0xd9, 0x1c, 0x98, 0xa1, 0xb7, 0x46, 0x80, 0x7f, 0x85,
-	0x75, 0xb2, 0xf1, 0x83, 0x5f, 0x37, 0xc9, 0xc7, 0xf7, 0xf9, 0xd6, 0x35, 0xf2, 0x3f, 0xd6, 0x24,
-	0x59, 0x7d, 0xff, 0xf9, 0x79, 0xef, 0x8c, 0xc5, 0x9b, 0x7f, 0x05, 0xe1, 0x13, 0x67, 0x25, 0xa5,
-	0x23, 0xc0, 0x90, 0xf9, 0x2e, 0x58, 0x7f, 0x07, 0xcc, 0xfb, 0xae, 0x25, 0xf9, 0xa5, 0x78, 0x52,
-	0x48, 0xf6, 0x7f, 0x49, 0xde, 0x77, 0x2d, 0x29, 0xec, 0x66, 0x45, 0xe8, 0xdd, 0xb7, 0xca, 0xe1,
-	0xb6, 0x25, 0xec, 0xe2, 0x3e, 0xda, 0xc1, 0xdd, 0x32, 0x23, 0x79, 0xd0, 0xbe, 0xe1, 0x9c, 0x9d,
-	0x6e, 0xcb, 0xd5, 0x37, 0xbd, 0xba, 0xfa, 0xb2, 0xee, 0x93, 0x6f, 0xeb, 0x3e, 0xf9, 0xb1, 0xee,
-	0x93, 0x8f, 0x4f, 0x0a, 0x85, 0xe5, 0x7c, 0xca, 0x33, 0xab, 0x45, 0x55, 0x2f, 0x9d, 0x96, 0xa8,
-	0xb2, 0x99, 0x9c, 0xba, 0xae, 0x12, 0x9b, 0x5f, 0xec, 0x33, 0xc0, 0x72, 0x7a, 0xb3, 0xbd, 0x7f,
-	0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0xd1, 0xfd, 0x57, 0xd2, 0x03, 0x00, 0x00,
+func (m *HostData) Reset()         { *m = HostData{} }
+func (m *HostData) String() string { return proto.CompactTextString(m) }
+func (*HostData) ProtoMessage()    {}
+func (*HostData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{4}
+}
+func (m *HostData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostData.Merge(m, src)
+}
+func (m *HostData) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostData) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostData.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_HostData proto.InternalMessageInfo
 
-// NodeClient is the client API for Node service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NodeClient interface {
-	GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatus, error)
-	GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Genesis, error)
-	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error)
-	ListImplementedServices(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ImplementedServices, error)
+func (m *HostData) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
 }
 
-type nodeClient struct {
-	cc *grpc.ClientConn
+func (m *HostData) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func NewNodeClient(cc *grpc.ClientConn) NodeClient {
-	return &nodeClient{cc}
+type Peer struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reputation           int64    `protobuf:"varint,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *nodeClient) GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatus, error) {
-	out := new(SyncStatus)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetSyncStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{5}
+}
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(m, src)
+}
+func (m *Peer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
 }
 
-func (c *nodeClient) GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Genesis, error) {
-	out := new(Genesis)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetGenesis", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Peer proto.InternalMessageInfo
+
+func (m *Peer) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *nodeClient) GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error) {
-	out := new(Version)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Peer) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *nodeClient) ListImplementedServices(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ImplementedServices, error) {
-	out := new(ImplementedServices)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListImplementedServices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Peer) GetReputation() int64 {
+	if m != nil {
+		return m.Reputation
 	}
-	return out, nil
+	return 0
 }
 
-// NodeServer is the server API for Node service.
-type NodeServer interface {
-	GetSyncStatus(context.Context, *types.Empty) (*SyncStatus, error)
-	GetGenesis(context.Context, *types.Empty) (*Genesis, error)
-	GetVersion(context.Context, *types.Empty) (*Version, error)
-	ListImplementedServices(context.Context, *types.Empty) (*ImplementedServices, error)
+type Peers struct {
+	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
-	s.RegisterService(&_Node_serviceDesc, srv)
+func (m *Peers) Reset()         { *m = Peers{} }
+func (m *Peers) String() string { return proto.CompactTextString(m) }
+func (*Peers) ProtoMessage()    {}
+func (*Peers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{6}
 }
-
-func _Node_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NodeServer).GetSyncStatus(ctx, in)
+func (m *Peers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Peers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Peers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.Node/GetSyncStatus",
+}
+func (m *Peers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peers.Merge(m, src)
+}
+func (m *Peers) XXX_Size() int {
+	return m.Size()
+}
+func (m *Peers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peers proto.InternalMessageInfo
+
+func (m *Peers) GetPeers() []*Peer {
+	if m != nil {
+		return m.Peers
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).GetSyncStatus(ctx, req.(*types.Empty))
+	return nil
+}
+
+type FeatureFlags struct {
+	Enabled              []string `protobuf:"bytes,1,rep,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
+func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlags) ProtoMessage()    {}
+func (*FeatureFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{7}
+}
+func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *FeatureFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlags.Merge(m, src)
+}
+func (m *FeatureFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlags.DiscardUnknown(m)
 }
 
-func _Node_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_FeatureFlags proto.InternalMessageInfo
+
+func (m *FeatureFlags) GetEnabled() []string {
+	if m != nil {
+		return m.Enabled
 	}
-	if interceptor == nil {
-		return srv.(NodeServer).GetGenesis(ctx, in)
+	return nil
+}
+
+type Eth1Status struct {
+	Connected             bool             `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	ConnectionError       string           `protobuf:"bytes,2,opt,name=connection_error,json=connectionError,proto3" json:"connection_error,omitempty"`
+	LatestBlockNumber     uint64           `protobuf:"varint,3,opt,name=latest_block_number,json=latestBlockNumber,proto3" json:"latest_block_number,omitempty"`
+	LatestBlockHash       []byte           `protobuf:"bytes,4,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	LatestBlockTime       *types.Timestamp `protobuf:"bytes,5,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time,omitempty"`
+	DepositCount          uint64           `protobuf:"varint,6,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	ProcessedDepositCount uint64           `protobuf:"varint,7,opt,name=processed_deposit_count,json=processedDepositCount,proto3" json:"processed_deposit_count,omitempty"`
+	PendingDepositCount   uint64           `protobuf:"varint,8,opt,name=pending_deposit_count,json=pendingDepositCount,proto3" json:"pending_deposit_count,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
+	XXX_unrecognized      []byte           `json:"-"`
+	XXX_sizecache         int32            `json:"-"`
+}
+
+func (m *Eth1Status) Reset()         { *m = Eth1Status{} }
+func (m *Eth1Status) String() string { return proto.CompactTextString(m) }
+func (*Eth1Status) ProtoMessage()    {}
+func (*Eth1Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{8}
+}
+func (m *Eth1Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.Node/GetGenesis",
+}
+func (m *Eth1Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1Status.Merge(m, src)
+}
+func (m *Eth1Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1Status proto.InternalMessageInfo
+
+func (m *Eth1Status) GetConnected() bool {
+	if m != nil {
+		return m.Connected
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).GetGenesis(ctx, req.(*types.Empty))
+	return false
+}
+
+func (m *Eth1Status) GetConnectionError() string {
+	if m != nil {
+		return m.ConnectionError
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Node_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Eth1Status) GetLatestBlockNumber() uint64 {
+	if m != nil {
+		return m.LatestBlockNumber
 	}
-	if interceptor == nil {
-		return srv.(NodeServer).GetVersion(ctx, in)
+	return 0
+}
+
+func (m *Eth1Status) GetLatestBlockHash() []byte {
+	if m != nil {
+		return m.LatestBlockHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.Node/GetVersion",
+	return nil
+}
+
+func (m *Eth1Status) GetLatestBlockTime() *types.Timestamp {
+	if m != nil {
+		return m.LatestBlockTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).GetVersion(ctx, req.(*types.Empty))
+	return nil
+}
+
+func (m *Eth1Status) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Node_ListImplementedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Eth1Status) GetProcessedDepositCount() uint64 {
+	if m != nil {
+		return m.ProcessedDepositCount
 	}
-	if interceptor == nil {
-		return srv.(NodeServer).ListImplementedServices(ctx, in)
+	return 0
+}
+
+func (m *Eth1Status) GetPendingDepositCount() uint64 {
+	if m != nil {
+		return m.PendingDepositCount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.Node/ListImplementedServices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServer).ListImplementedServices(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Node_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.eth.v1alpha1.Node",
-	HandlerType: (*NodeServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSyncStatus",
-			Handler:    _Node_GetSyncStatus_Handler,
-		},
-		{
-			MethodName: "GetGenesis",
-			Handler:    _Node_GetGenesis_Handler,
-		},
-		{
-			MethodName: "GetVersion",
-			Handler:    _Node_GetVersion_Handler,
-		},
-		{
-			MethodName: "ListImplementedServices",
-			Handler:    _Node_ListImplementedServices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/eth/v1alpha1/node.proto",
+type ScheduledFork struct {
+	Version              []byte   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ScheduledFork) Reset()         { *m = ScheduledFork{} }
+func (m *ScheduledFork) String() string { return proto.CompactTextString(m) }
+func (*ScheduledFork) ProtoMessage()    {}
+func (*ScheduledFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{9}
 }
-
-func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Syncing {
-		dAtA[i] = 0x8
-		i++
-		if m.Syncing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ScheduledFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
-		i++
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *ScheduledFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledFork.Merge(m, src)
+}
+func (m *ScheduledFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledFork proto.InternalMessageInfo
+
+func (m *ScheduledFork) GetVersion() []byte {
+	if m != nil {
+		return m.Version
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Genesis) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ScheduledFork) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Genesis) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.GenesisTime != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNode(dAtA, i, uint64(m.GenesisTime.Size()))
-		n1, err := m.GenesisTime.MarshalTo(dAtA[i:])
+type ForkSchedule struct {
+	Forks                []*ScheduledFork `protobuf:"bytes,1,rep,name=forks,proto3" json:"forks,omitempty"`
+	CurrentFork          *ScheduledFork   `protobuf:"bytes,2,opt,name=current_fork,json=currentFork,proto3" json:"current_fork,omitempty"`
+	CurrentEpoch         uint64           `protobuf:"varint,3,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ForkSchedule) Reset()         { *m = ForkSchedule{} }
+func (m *ForkSchedule) String() string { return proto.CompactTextString(m) }
+func (*ForkSchedule) ProtoMessage()    {}
+func (*ForkSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{10}
+}
+func (m *ForkSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForkSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForkSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if len(m.DepositContractAddress) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNode(dAtA, i, uint64(len(m.DepositContractAddress)))
-		i += copy(dAtA[i:], m.DepositContractAddress)
+}
+func (m *ForkSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkSchedule.Merge(m, src)
+}
+func (m *ForkSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForkSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkSchedule proto.InternalMessageInfo
+
+func (m *ForkSchedule) GetForks() []*ScheduledFork {
+	if m != nil {
+		return m.Forks
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return nil
+}
+
+func (m *ForkSchedule) GetCurrentFork() *ScheduledFork {
+	if m != nil {
+		return m.CurrentFork
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Version) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (m *ForkSchedule) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SyncStatus)(nil), "ethereum.eth.v1alpha1.SyncStatus")
+	proto.RegisterType((*Genesis)(nil), "ethereum.eth.v1alpha1.Genesis")
+	proto.RegisterType((*Version)(nil), "ethereum.eth.v1alpha1.Version")
+	proto.RegisterType((*ImplementedServices)(nil), "ethereum.eth.v1alpha1.ImplementedServices")
+	proto.RegisterType((*HostData)(nil), "ethereum.eth.v1alpha1.HostData")
+	proto.RegisterType((*Peer)(nil), "ethereum.eth.v1alpha1.Peer")
+	proto.RegisterType((*Peers)(nil), "ethereum.eth.v1alpha1.Peers")
+	proto.RegisterType((*FeatureFlags)(nil), "ethereum.eth.v1alpha1.FeatureFlags")
+	proto.RegisterType((*Eth1Status)(nil), "ethereum.eth.v1alpha1.Eth1Status")
+	proto.RegisterType((*ScheduledFork)(nil), "ethereum.eth.v1alpha1.ScheduledFork")
+	proto.RegisterType((*ForkSchedule)(nil), "ethereum.eth.v1alpha1.ForkSchedule")
+}
+
+func init() { proto.RegisterFile("proto/eth/v1alpha1/node.proto", fileDescriptor_98054421e2cad574) }
+
+var fileDescriptor_98054421e2cad574 = []byte{
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0xd6, 0x6c, 0x7e, 0x26, 0xa9, 0x4c, 0x94, 0xdd, 0xce, 0x66, 0x33, 0x4c, 0xb2, 0x49, 0x70,
+	0x10, 0x0a, 0x7b, 0x98, 0x61, 0x82, 0x40, 0x68, 0x11, 0x5a, 0xed, 0x4f, 0x92, 0x5d, 0x09, 0xad,
+	0x90, 0x83, 0xf6, 0x80, 0x84, 0x46, 0x3d, 0x76, 0x65, 0x6c, 0xc5, 0xee, 0xb6, 0xba, 0xcb, 0x11,
+	0xb9, 0xee, 0x91, 0x2b, 0x2f, 0xc3, 0x23, 0x70, 0x44, 0xe2, 0x05, 0x50, 0xc4, 0x91, 0x33, 0x67,
+	0xd4, 0xed, 0xee, 0xcc, 0x24, 0x19, 0x07, 0xc2, 0xcd, 0xf5, 0xf3, 0xd5, 0xd7, 0x5d, 0xae, 0xfa,
+	0x6c, 0x78, 0x5c, 0x28, 0x49, 0xb2, 0x87, 0x94, 0xf4, 0xce, 0xfa, 0x3c, 0x2b, 0x12, 0xde, 0xef,
+	0x09, 0x19, 0x63, 0xd7, 0xfa, 0xd9, 0x1a, 0x52, 0x82, 0x0a, 0xcb, 0xbc, 0x8b, 0x94, 0x74, 0x7d,
+	0x46, 0x67, 0x73, 0x24, 0xe5, 0x28, 0xc3, 0x1e, 0x2f, 0xd2, 0x1e, 0x17, 0x42, 0x12, 0xa7, 0x54,
+	0x0a, 0x5d, 0x81, 0x3a, 0x1b, 0x2e, 0x6a, 0xad, 0x61, 0x79, 0xd2, 0xc3, 0xbc, 0xa0, 0x73, 0x17,
+	0xdc, 0xbe, 0x1e, 0xa4, 0x34, 0x47, 0x4d, 0x3c, 0x2f, 0xaa, 0x84, 0xe0, 0x63, 0x80, 0xe3, 0x73,
+	0x11, 0x1d, 0x13, 0xa7, 0x52, 0xb3, 0x36, 0x34, 0xf5, 0xb9, 0x88, 0x52, 0x31, 0x6a, 0x37, 0x76,
+	0x1a, 0x7b, 0x0b, 0xa1, 0x37, 0x83, 0xbf, 0x1a, 0xd0, 0x3c, 0x42, 0x81, 0x3a, 0xd5, 0xec, 0x6b,
+	0x68, 0x8d, 0xaa, 0xc7, 0x81, 0x29, 0x67, 0x53, 0x97, 0xf6, 0x3b, 0xdd, 0x8a, 0xab, 0xeb, 0xb9,
+	0xba, 0xdf, 0x79, 0xae, 0x70, 0xc9, 0xe5, 0x1b, 0x0f, 0xfb, 0x12, 0xda, 0x31, 0x16, 0x52, 0xa7,
+	0x34, 0x88, 0xa4, 0x20, 0xc5, 0x23, 0x1a, 0xf0, 0x38, 0x56, 0xa8, 0x75, 0xfb, 0xde, 0x4e, 0x63,
+	0xaf, 0x15, 0x3e, 0x72, 0xf1, 0x97, 0x2e, 0xfc, 0xbc, 0x8a, 0xb2, 0x2f, 0x60, 0xdd, 0x13, 0x9f,
+	0xf1, 0x2c, 0x8d, 0x39, 0x49, 0xa5, 0x07, 0x4a, 0x4a, 0x6a, 0xcf, 0x58, 0xe0, 0x9a, 0x0b, 0xbf,
+	0xbb, 0x8c, 0x86, 0x52, 0x12, 0xfb, 0x14, 0x1e, 0x7a, 0xdc, 0x89, 0x54, 0xa7, 0x83, 0x33, 0x54,
+	0x3a, 0x95, 0xa2, 0x3d, 0x6b, 0x41, 0xcc, 0xc5, 0x0e, 0xa5, 0x3a, 0x7d, 0x57, 0x45, 0x82, 0x67,
+	0xd0, 0x74, 0x8f, 0xa6, 0x27, 0x3e, 0xdf, 0x5c, 0x74, 0x31, 0xf4, 0x26, 0xeb, 0xc0, 0x42, 0x8e,
+	0xc4, 0x63, 0x4e, 0xdc, 0x1e, 0x7c, 0x31, 0xbc, 0xb4, 0x83, 0x3e, 0xac, 0xbe, 0xc9, 0x8b, 0x0c,
+	0x73, 0x14, 0x84, 0xf1, 0x31, 0xaa, 0xb3, 0x34, 0x42, 0x6d, 0x20, 0xda, 0x3d, 0xb7, 0x1b, 0x3b,
+	0x33, 0x06, 0xe2, 0xed, 0xe0, 0x39, 0x2c, 0xbc, 0x96, 0x9a, 0x5e, 0x71, 0xe2, 0x6c, 0x1d, 0x9a,
+	0x05, 0xa2, 0x1a, 0xa4, 0xb1, 0x23, 0x9d, 0x37, 0xe6, 0x9b, 0x98, 0x6d, 0xc2, 0xa2, 0xeb, 0x15,
+	0x9a, 0x6e, 0x99, 0x0a, 0x63, 0x47, 0xf0, 0x03, 0xcc, 0x7e, 0x8b, 0xa8, 0xfe, 0x27, 0x9c, 0x6d,
+	0x01, 0x28, 0x2c, 0xca, 0x6a, 0xbe, 0x6c, 0x4b, 0x67, 0xc2, 0x09, 0x4f, 0xf0, 0x14, 0xe6, 0x4c,
+	0x79, 0xcd, 0xfa, 0x30, 0x67, 0x0a, 0x56, 0x77, 0x58, 0xda, 0xdf, 0xe8, 0x4e, 0x1d, 0xdc, 0xae,
+	0x49, 0x0e, 0xab, 0xcc, 0x60, 0x0f, 0x5a, 0x87, 0xc8, 0xa9, 0x54, 0x78, 0x98, 0xf1, 0x91, 0x1d,
+	0x35, 0x14, 0x7c, 0x98, 0x61, 0xec, 0x1a, 0xe1, 0xcd, 0xe0, 0xa7, 0x19, 0x80, 0x03, 0x4a, 0xfa,
+	0x6e, 0x26, 0x37, 0x61, 0x31, 0x92, 0x42, 0x60, 0x44, 0x18, 0xbb, 0xa9, 0x1c, 0x3b, 0xd8, 0x27,
+	0x70, 0xdf, 0x19, 0xa9, 0x14, 0x03, 0x54, 0x4a, 0x2a, 0xf7, 0x2e, 0x56, 0xc6, 0xfe, 0x03, 0xe3,
+	0x66, 0x5d, 0x58, 0xcd, 0x38, 0xa1, 0xa6, 0xc1, 0x30, 0x93, 0xd1, 0xe9, 0x40, 0x94, 0xf9, 0x10,
+	0x95, 0xbd, 0xe6, 0x6c, 0xf8, 0xa0, 0x0a, 0xbd, 0x30, 0x91, 0xb7, 0x36, 0xc0, 0x9e, 0xc0, 0x83,
+	0x2b, 0xf9, 0x09, 0xd7, 0x89, 0x1b, 0x99, 0x95, 0x89, 0xec, 0xd7, 0x5c, 0x27, 0xec, 0xf0, 0x5a,
+	0xae, 0xdd, 0x8b, 0xb9, 0x7f, 0xdd, 0x8b, 0xc9, 0x3a, 0x76, 0x37, 0x76, 0x61, 0x79, 0xbc, 0x1b,
+	0xa5, 0xa0, 0xf6, 0xbc, 0x3d, 0x5d, 0xeb, 0x72, 0x21, 0x4a, 0x41, 0x66, 0x0d, 0x0a, 0x25, 0x23,
+	0xf3, 0xce, 0xe2, 0xc1, 0xd5, 0xf4, 0xa6, 0x4d, 0x5f, 0xbb, 0x0c, 0xbf, 0x9a, 0xc4, 0xed, 0xc3,
+	0x5a, 0x81, 0x22, 0x4e, 0xc5, 0xe8, 0x1a, 0x6a, 0xc1, 0xa2, 0x56, 0x5d, 0x70, 0x12, 0x13, 0x3c,
+	0x83, 0xe5, 0xe3, 0x28, 0xc1, 0xb8, 0xcc, 0x30, 0x36, 0x0b, 0x72, 0x7d, 0x1d, 0x5a, 0xe3, 0x75,
+	0x78, 0x08, 0x73, 0x58, 0xc8, 0x28, 0xb1, 0xfd, 0x9f, 0x0d, 0x2b, 0x23, 0xf8, 0xa5, 0x01, 0x2d,
+	0x03, 0xf4, 0x55, 0xd8, 0x53, 0x98, 0x33, 0x4b, 0xe8, 0x67, 0xe7, 0xa3, 0x9a, 0xd9, 0xb9, 0xc2,
+	0x1a, 0x56, 0x10, 0x76, 0x04, 0xad, 0xa8, 0x54, 0x0a, 0x05, 0xd9, 0x45, 0xb6, 0x4c, 0xff, 0xb5,
+	0xc4, 0x92, 0x43, 0xda, 0x5b, 0xec, 0xc2, 0xb2, 0x2f, 0x54, 0x9d, 0xb9, 0x9a, 0x02, 0x5f, 0xfd,
+	0xc0, 0xf8, 0xf6, 0xff, 0x6e, 0xc2, 0xec, 0x5b, 0x19, 0x23, 0x13, 0xb0, 0x7c, 0x84, 0x34, 0xa1,
+	0x93, 0x8f, 0x6e, 0xbc, 0xd3, 0x03, 0x23, 0xba, 0x9d, 0x0f, 0xeb, 0x4e, 0x72, 0x09, 0x0d, 0x82,
+	0xf7, 0xbf, 0xff, 0xf9, 0xf3, 0xbd, 0x4d, 0xd6, 0xb9, 0xf9, 0x15, 0xe8, 0x39, 0xb1, 0x65, 0x09,
+	0xc0, 0x11, 0x92, 0x97, 0xdb, 0x3a, 0xb2, 0xad, 0x1a, 0x32, 0x87, 0xbb, 0x95, 0xc9, 0x49, 0x9e,
+	0x63, 0xf2, 0x52, 0x77, 0x57, 0x26, 0xaf, 0x96, 0xb7, 0x31, 0xf9, 0xe9, 0x78, 0xdf, 0x80, 0xf5,
+	0x6f, 0x52, 0x4d, 0xd3, 0x54, 0xb1, 0x8e, 0xf7, 0x49, 0x0d, 0xef, 0x94, 0x1a, 0xc1, 0xae, 0x3d,
+	0xc3, 0x63, 0xb6, 0x31, 0xad, 0xaf, 0x9e, 0x28, 0x32, 0x1f, 0x31, 0x32, 0x2a, 0x5b, 0xcb, 0xb9,
+	0x5d, 0xc3, 0xe9, 0xa5, 0x39, 0xd8, 0xb6, 0x44, 0x1f, 0xb0, 0xf5, 0x29, 0x44, 0x89, 0xa9, 0x1c,
+	0xc1, 0xa2, 0xb9, 0x68, 0xa5, 0x94, 0x75, 0x34, 0x9b, 0xb7, 0x48, 0xa6, 0x0e, 0x76, 0x2c, 0x47,
+	0x87, 0xb5, 0xa7, 0x70, 0x58, 0x39, 0x65, 0x04, 0xf7, 0x0d, 0xc9, 0x15, 0x49, 0xad, 0xe3, 0xda,
+	0xad, 0xe1, 0x9a, 0x04, 0xdf, 0xda, 0xbf, 0x93, 0x2a, 0x51, 0xb3, 0x53, 0xbb, 0x08, 0x13, 0xe2,
+	0x7c, 0xd7, 0x45, 0x18, 0x43, 0x6f, 0xed, 0x23, 0x52, 0xd2, 0x67, 0x3f, 0xc2, 0xca, 0x11, 0xd2,
+	0x15, 0xed, 0xb8, 0xf3, 0x0d, 0x27, 0xc0, 0xc1, 0x9e, 0x25, 0x0c, 0xd8, 0xce, 0xb4, 0x1b, 0x9a,
+	0xdf, 0x02, 0xed, 0x32, 0x5f, 0xbc, 0xfc, 0xf5, 0x62, 0xab, 0xf1, 0xdb, 0xc5, 0x56, 0xe3, 0x8f,
+	0x8b, 0xad, 0xc6, 0xf7, 0x9f, 0x8f, 0x52, 0x4a, 0xca, 0x61, 0x37, 0x92, 0x79, 0xaf, 0x50, 0xe7,
+	0x3a, 0xe7, 0x94, 0x46, 0x19, 0x1f, 0xea, 0xca, 0xea, 0xdd, 0xfc, 0xa7, 0xfb, 0x0a, 0x29, 0x19,
+	0xce, 0x5b, 0xff, 0x67, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xce, 0xb9, 0xe8, 0xf4, 0x09,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatus, error)
+	GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Genesis, error)
+	GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error)
+	ListImplementedServices(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ImplementedServices, error)
+	GetHost(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HostData, error)
+	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Peers, error)
+	ListFeatureFlags(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*FeatureFlags, error)
+	GetEth1Status(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1Status, error)
+	GetForkSchedule(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ForkSchedule, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) GetSyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatus, error) {
+	out := new(SyncStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetSyncStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Version) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Version) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintNode(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
-	}
-	if len(m.Metadata) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintNode(dAtA, i, uint64(len(m.Metadata)))
-		i += copy(dAtA[i:], m.Metadata)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *nodeClient) GetGenesis(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Genesis, error) {
+	out := new(Genesis)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ImplementedServices) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *nodeClient) GetVersion(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Version, error) {
+	out := new(Version)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ImplementedServices) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, s := range m.Services {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+func (c *nodeClient) ListImplementedServices(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ImplementedServices, error) {
+	out := new(ImplementedServices)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListImplementedServices", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *nodeClient) GetHost(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HostData, error) {
+	out := new(HostData)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetHost", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *nodeClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Peers, error) {
+	out := new(Peers)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return out, nil
 }
-func (m *SyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *nodeClient) ListFeatureFlags(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*FeatureFlags, error) {
+	out := new(FeatureFlags)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListFeatureFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Syncing {
-		n += 2
+	return out, nil
+}
+
+func (c *nodeClient) GetEth1Status(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1Status, error) {
+	out := new(Eth1Status)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetEth1Status", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *nodeClient) GetForkSchedule(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ForkSchedule, error) {
+	out := new(ForkSchedule)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetForkSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Genesis) Size() (n int) {
-	if m == nil {
-		return 0
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	GetSyncStatus(context.Context, *types.Empty) (*SyncStatus, error)
+	GetGenesis(context.Context, *types.Empty) (*Genesis, error)
+	GetVersion(context.Context, *types.Empty) (*Version, error)
+	ListImplementedServices(context.Context, *types.Empty) (*ImplementedServices, error)
+	GetHost(context.Context, *types.Empty) (*HostData, error)
+	ListPeers(context.Context, *types.Empty) (*Peers, error)
+	ListFeatureFlags(context.Context, *types.Empty) (*FeatureFlags, error)
+	GetEth1Status(context.Context, *types.Empty) (*Eth1Status, error)
+	GetForkSchedule(context.Context, *types.Empty) (*ForkSchedule, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GenesisTime != nil {
-		l = m.GenesisTime.Size()
-		n += 1 + l + sovNode(uint64(l))
+	if interceptor == nil {
+		return srv.(NodeServer).GetSyncStatus(ctx, in)
 	}
-	l = len(m.DepositContractAddress)
-	if l > 0 {
-		n += 1 + l + sovNode(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetSyncStatus",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetSyncStatus(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Version) Size() (n int) {
-	if m == nil {
-		return 0
+func _Node_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovNode(uint64(l))
+	if interceptor == nil {
+		return srv.(NodeServer).GetGenesis(ctx, in)
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovNode(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetGenesis",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetGenesis(ctx, req.(*types.Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ImplementedServices) Size() (n int) {
-	if m == nil {
-		return 0
+func _Node_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Services) > 0 {
-		for _, s := range m.Services {
-			l = len(s)
-			n += 1 + l + sovNode(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(NodeServer).GetVersion(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetVersion",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetVersion(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovNode(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func _Node_ListImplementedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(NodeServer).ListImplementedServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListImplementedServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListImplementedServices(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozNode(x uint64) (n int) {
-	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Node_GetHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetHost(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListFeatureFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListFeatureFlags(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetEth1Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetEth1Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetEth1Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetEth1Status(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetForkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetForkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetForkSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetForkSchedule(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _Node_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _Node_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Node_GetVersion_Handler,
+		},
+		{
+			MethodName: "ListImplementedServices",
+			Handler:    _Node_ListImplementedServices_Handler,
+		},
+		{
+			MethodName: "GetHost",
+			Handler:    _Node_GetHost_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Node_ListPeers_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _Node_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "GetEth1Status",
+			Handler:    _Node_GetEth1Status_Handler,
+		},
+		{
+			MethodName: "GetForkSchedule",
+			Handler:    _Node_GetForkSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1alpha1/node.proto",
+}
+
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Syncing {
+		dAtA[i] = 0x8
+		i++
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Genesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Genesis) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.GenesisTime != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.GenesisTime.Size()))
+		n1, err := m.GenesisTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.DepositContractAddress) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i += copy(dAtA[i:], m.DepositContractAddress)
+	}
+	if len(m.GenesisValidatorsRoot) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.GenesisValidatorsRoot)))
+		i += copy(dAtA[i:], m.GenesisValidatorsRoot)
+	}
+	if len(m.GenesisForkVersion) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.GenesisForkVersion)))
+		i += copy(dAtA[i:], m.GenesisForkVersion)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Metadata) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Metadata)))
+		i += copy(dAtA[i:], m.Metadata)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ImplementedServices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImplementedServices) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *HostData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Peer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Peer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Reputation != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Reputation))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Peers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Peers) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, msg := range m.Peers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNode(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FeatureFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureFlags) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Enabled) > 0 {
+		for _, s := range m.Enabled {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Eth1Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1Status) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Connected {
+		dAtA[i] = 0x8
+		i++
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ConnectionError) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.ConnectionError)))
+		i += copy(dAtA[i:], m.ConnectionError)
+	}
+	if m.LatestBlockNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.LatestBlockNumber))
+	}
+	if len(m.LatestBlockHash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.LatestBlockHash)))
+		i += copy(dAtA[i:], m.LatestBlockHash)
+	}
+	if m.LatestBlockTime != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.LatestBlockTime.Size()))
+		n2, err := m.LatestBlockTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.DepositCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.DepositCount))
+	}
+	if m.ProcessedDepositCount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.ProcessedDepositCount))
+	}
+	if m.PendingDepositCount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.PendingDepositCount))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ScheduledFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledFork) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if m.Epoch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForkSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForkSchedule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Forks) > 0 {
+		for _, msg := range m.Forks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintNode(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.CurrentFork != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.CurrentFork.Size()))
+		n3, err := m.CurrentFork.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.CurrentEpoch != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.CurrentEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *SyncStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisTime != nil {
+		l = m.GenesisTime.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.GenesisValidatorsRoot)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.GenesisForkVersion)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImplementedServices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Services) > 0 {
+		for _, s := range m.Services {
+			l = len(s)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HostData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Peer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.Reputation != 0 {
+		n += 1 + sovNode(uint64(m.Reputation))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Peers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FeatureFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Enabled) > 0 {
+		for _, s := range m.Enabled {
+			l = len(s)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Connected {
+		n += 2
+	}
+	l = len(m.ConnectionError)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.LatestBlockNumber != 0 {
+		n += 1 + sovNode(uint64(m.LatestBlockNumber))
+	}
+	l = len(m.LatestBlockHash)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.LatestBlockTime != nil {
+		l = m.LatestBlockTime.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovNode(uint64(m.DepositCount))
+	}
+	if m.ProcessedDepositCount != 0 {
+		n += 1 + sovNode(uint64(m.ProcessedDepositCount))
+	}
+	if m.PendingDepositCount != 0 {
+		n += 1 + sovNode(uint64(m.PendingDepositCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduledFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovNode(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForkSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forks) > 0 {
+		for _, e := range m.Forks {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.CurrentFork != nil {
+		l = m.CurrentFork.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovNode(uint64(m.CurrentEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNode(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozNode(x uint64) (n int) {
+	return sovNode(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Genesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisTime == nil {
+				m.GenesisTime = &types.Timestamp{}
+			}
+			if err := m.GenesisTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractAddress == nil {
+				m.DepositContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidatorsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisValidatorsRoot = append(m.GenesisValidatorsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisValidatorsRoot == nil {
+				m.GenesisValidatorsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisForkVersion = append(m.GenesisForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisForkVersion == nil {
+				m.GenesisForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImplementedServices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImplementedServices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			m.Reputation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reputation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Peers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Peers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Peers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &Peer{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *FeatureFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -697,17 +2661,17 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeatureFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeatureFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -717,12 +2681,24 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Syncing = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enabled = append(m.Enabled, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
@@ -748,7 +2724,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Genesis) Unmarshal(dAtA []byte) error {
+func (m *Eth1Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,17 +2747,37 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Genesis: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Genesis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Connected = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -791,31 +2787,46 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisTime == nil {
-				m.GenesisTime = &types.Timestamp{}
+			m.ConnectionError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockNumber", wireType)
 			}
-			if err := m.GenesisTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LatestBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -842,11 +2853,104 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositContractAddress == nil {
-				m.DepositContractAddress = []byte{}
+			m.LatestBlockHash = append(m.LatestBlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestBlockHash == nil {
+				m.LatestBlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestBlockTime == nil {
+				m.LatestBlockTime = &types.Timestamp{}
+			}
+			if err := m.LatestBlockTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedDepositCount", wireType)
+			}
+			m.ProcessedDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDepositCount", wireType)
+			}
+			m.PendingDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
@@ -872,7 +2976,7 @@ func (m *Genesis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Version) Unmarshal(dAtA []byte) error {
+func (m *ScheduledFork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -895,17 +2999,17 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledFork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledFork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -915,29 +3019,31 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Version = append(m.Version[:0], dAtA[iNdEx:postIndex]...)
+			if m.Version == nil {
+				m.Version = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -947,24 +3053,11 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNode
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNode
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
@@ -990,7 +3083,7 @@ func (m *Version) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
+func (m *ForkSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1013,17 +3106,17 @@ func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImplementedServices: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForkSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImplementedServices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForkSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNode
@@ -1033,24 +3126,81 @@ func (m *ImplementedServices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNode
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNode
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, string(dAtA[iNdEx:postIndex]))
+			m.Forks = append(m.Forks, &ScheduledFork{})
+			if err := m.Forks[len(m.Forks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentFork == nil {
+				m.CurrentFork = &ScheduledFork{}
+			}
+			if err := m.CurrentFork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
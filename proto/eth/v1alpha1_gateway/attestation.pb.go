@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/eth/v1alpha1/attestation.proto
+
+package eth
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Attestation struct {
+	AggregationBits      []byte           `protobuf:"bytes,1,opt,name=aggregation_bits,json=aggregationBits,proto3" json:"aggregation_bits,omitempty"`
+	Data                 *AttestationData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	CustodyBits          []byte           `protobuf:"bytes,3,opt,name=custody_bits,json=custodyBits,proto3" json:"custody_bits,omitempty"`
+	Signature            []byte           `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8f395ba51cd84e0, []int{0}
+}
+
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Attestation.Unmarshal(m, b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return xxx_messageInfo_Attestation.Size(m)
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetAggregationBits() []byte {
+	if m != nil {
+		return m.AggregationBits
+	}
+	return nil
+}
+
+func (m *Attestation) GetData() *AttestationData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Attestation) GetCustodyBits() []byte {
+	if m != nil {
+		return m.CustodyBits
+	}
+	return nil
+}
+
+func (m *Attestation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type AttestationData struct {
+	BeaconBlockRoot      []byte      `protobuf:"bytes,1,opt,name=beacon_block_root,json=beaconBlockRoot,proto3" json:"beacon_block_root,omitempty"`
+	Source               *Checkpoint `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target               *Checkpoint `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Crosslink            *Crosslink  `protobuf:"bytes,4,opt,name=crosslink,proto3" json:"crosslink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AttestationData) Reset()         { *m = AttestationData{} }
+func (m *AttestationData) String() string { return proto.CompactTextString(m) }
+func (*AttestationData) ProtoMessage()    {}
+func (*AttestationData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8f395ba51cd84e0, []int{1}
+}
+
+func (m *AttestationData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationData.Unmarshal(m, b)
+}
+func (m *AttestationData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationData.Marshal(b, m, deterministic)
+}
+func (m *AttestationData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationData.Merge(m, src)
+}
+func (m *AttestationData) XXX_Size() int {
+	return xxx_messageInfo_AttestationData.Size(m)
+}
+func (m *AttestationData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationData proto.InternalMessageInfo
+
+func (m *AttestationData) GetBeaconBlockRoot() []byte {
+	if m != nil {
+		return m.BeaconBlockRoot
+	}
+	return nil
+}
+
+func (m *AttestationData) GetSource() *Checkpoint {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (m *AttestationData) GetTarget() *Checkpoint {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *AttestationData) GetCrosslink() *Crosslink {
+	if m != nil {
+		return m.Crosslink
+	}
+	return nil
+}
+
+type Checkpoint struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8f395ba51cd84e0, []int{2}
+}
+
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Checkpoint.Unmarshal(m, b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+}
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
+}
+func (m *Checkpoint) XXX_Size() int {
+	return xxx_messageInfo_Checkpoint.Size(m)
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Checkpoint) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type Crosslink struct {
+	Shard                uint64   `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StartEpoch           uint64   `protobuf:"varint,3,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,4,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	DataRoot             []byte   `protobuf:"bytes,5,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Crosslink) Reset()         { *m = Crosslink{} }
+func (m *Crosslink) String() string { return proto.CompactTextString(m) }
+func (*Crosslink) ProtoMessage()    {}
+func (*Crosslink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8f395ba51cd84e0, []int{3}
+}
+
+func (m *Crosslink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Crosslink.Unmarshal(m, b)
+}
+func (m *Crosslink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Crosslink.Marshal(b, m, deterministic)
+}
+func (m *Crosslink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Crosslink.Merge(m, src)
+}
+func (m *Crosslink) XXX_Size() int {
+	return xxx_messageInfo_Crosslink.Size(m)
+}
+func (m *Crosslink) XXX_DiscardUnknown() {
+	xxx_messageInfo_Crosslink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Crosslink proto.InternalMessageInfo
+
+func (m *Crosslink) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *Crosslink) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *Crosslink) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *Crosslink) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *Crosslink) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Attestation)(nil), "ethereum.eth.v1alpha1.Attestation")
+	proto.RegisterType((*AttestationData)(nil), "ethereum.eth.v1alpha1.AttestationData")
+	proto.RegisterType((*Checkpoint)(nil), "ethereum.eth.v1alpha1.Checkpoint")
+	proto.RegisterType((*Crosslink)(nil), "ethereum.eth.v1alpha1.Crosslink")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/attestation.proto", fileDescriptor_f8f395ba51cd84e0)
+}
+
+var fileDescriptor_f8f395ba51cd84e0 = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x56, 0xd2, 0xb4, 0x6a, 0xc6, 0x85, 0x50, 0x0b, 0xa4, 0x08, 0x0e, 0x09, 0x16, 0xa0, 0x1e,
+	0x88, 0x4d, 0x53, 0x28, 0x4a, 0x10, 0x48, 0x18, 0x38, 0x70, 0xf5, 0x91, 0x4b, 0xb4, 0xb6, 0xa7,
+	0xf6, 0x2a, 0x8e, 0xd7, 0xda, 0x1d, 0x23, 0xda, 0x07, 0xe0, 0xc5, 0x78, 0x01, 0x9e, 0x20, 0x0f,
+	0xd1, 0x23, 0x27, 0xe4, 0xd9, 0x84, 0x44, 0x85, 0x20, 0x0e, 0xdc, 0x3c, 0x3b, 0xdf, 0xdf, 0x7e,
+	0x5a, 0xc3, 0xa3, 0x4a, 0x2b, 0x52, 0x01, 0x52, 0x1e, 0x7c, 0x3e, 0x15, 0x45, 0x95, 0x8b, 0xd3,
+	0x40, 0x10, 0xa1, 0x21, 0x41, 0x52, 0x95, 0x3e, 0xaf, 0xdd, 0x7b, 0x48, 0x39, 0x6a, 0xac, 0x17,
+	0x3e, 0x52, 0xee, 0xaf, 0x81, 0xf7, 0x47, 0x99, 0xa4, 0xbc, 0x8e, 0xfd, 0x44, 0x2d, 0x82, 0x4c,
+	0x65, 0x2a, 0x60, 0x74, 0x5c, 0x5f, 0xf0, 0x64, 0x95, 0x9b, 0x2f, 0xab, 0xe2, 0x7d, 0x6f, 0x83,
+	0xf3, 0x76, 0xa3, 0xed, 0x2e, 0xe0, 0x8e, 0xc8, 0x32, 0x8d, 0x19, 0x8f, 0xb3, 0x58, 0x92, 0xe9,
+	0xb7, 0x86, 0xad, 0x93, 0xa3, 0x30, 0xbc, 0x5e, 0x0e, 0x6e, 0x1b, 0x73, 0x35, 0x5a, 0x88, 0x2f,
+	0x53, 0xef, 0xf9, 0xb3, 0xc9, 0xb9, 0xf7, 0x63, 0x39, 0x78, 0xba, 0x65, 0x57, 0xe9, 0x4b, 0xb3,
+	0x10, 0x24, 0x93, 0x42, 0xc4, 0x26, 0xc8, 0xd4, 0x28, 0x96, 0x74, 0x21, 0xb1, 0x48, 0xfd, 0x50,
+	0x52, 0x21, 0x0d, 0x45, 0xbd, 0x2d, 0xed, 0x50, 0x92, 0x71, 0xa7, 0xd0, 0x49, 0x05, 0x89, 0x7e,
+	0x7b, 0xd8, 0x3a, 0x71, 0xc6, 0x4f, 0xfc, 0x3f, 0xde, 0xc9, 0xdf, 0x0a, 0xf8, 0x5e, 0x90, 0x88,
+	0x98, 0xe3, 0x22, 0x1c, 0x25, 0xb5, 0x21, 0x95, 0x5e, 0xda, 0x98, 0x7b, 0xff, 0x2d, 0xa6, 0xb3,
+	0xd2, 0xe5, 0x88, 0x01, 0x74, 0x8d, 0xcc, 0x4a, 0x41, 0xb5, 0xc6, 0x7e, 0x87, 0x3d, 0x8e, 0xaf,
+	0x97, 0x83, 0x5b, 0x8d, 0x87, 0x91, 0x57, 0x38, 0xf5, 0x26, 0xe7, 0x5e, 0xb4, 0xc1, 0x78, 0x5f,
+	0xdb, 0xd0, 0xbb, 0x91, 0xd8, 0x7d, 0x0d, 0xc7, 0x31, 0x8a, 0xa4, 0x69, 0xb4, 0x50, 0xc9, 0x7c,
+	0xa6, 0x95, 0xa2, 0x55, 0xaf, 0x37, 0xc4, 0xce, 0xc6, 0x5e, 0xd4, 0xb3, 0xd8, 0xb0, 0x81, 0x46,
+	0x4a, 0x91, 0x3b, 0x81, 0x03, 0xa3, 0x6a, 0x9d, 0xe0, 0xaa, 0xa8, 0x87, 0x3b, 0x8a, 0x7a, 0x97,
+	0x63, 0x32, 0xaf, 0x94, 0x2c, 0x29, 0x5a, 0x11, 0x1a, 0x2a, 0x09, 0x9d, 0x21, 0x71, 0x3f, 0xff,
+	0x46, 0xb5, 0x04, 0xf7, 0x0d, 0x74, 0x13, 0xad, 0x8c, 0x29, 0x64, 0x39, 0xe7, 0x9b, 0x3b, 0xe3,
+	0xe1, 0x2e, 0xf6, 0x1a, 0x17, 0x6d, 0x28, 0xde, 0x47, 0x80, 0x8d, 0xaa, 0x7b, 0x17, 0xf6, 0xb1,
+	0x52, 0x49, 0xce, 0xd7, 0xee, 0x44, 0x76, 0x70, 0x1f, 0x43, 0x87, 0xbb, 0x68, 0xef, 0xea, 0x82,
+	0xd7, 0xde, 0xb7, 0x16, 0x74, 0x7f, 0x79, 0x34, 0x52, 0x26, 0x17, 0x3a, 0x5d, 0x4b, 0xf1, 0xe0,
+	0x8e, 0xc1, 0xa9, 0x84, 0xc6, 0x92, 0x66, 0x7f, 0x57, 0x04, 0x8b, 0xe2, 0x62, 0x07, 0xe0, 0x18,
+	0x12, 0x9a, 0x66, 0x36, 0xda, 0x1e, 0xeb, 0x01, 0x1f, 0x7d, 0xe0, 0x7c, 0x0f, 0xa0, 0x8b, 0x65,
+	0xba, 0x5a, 0x77, 0x78, 0x7d, 0x88, 0x65, 0x6a, 0x97, 0x3e, 0x74, 0x9b, 0x97, 0x68, 0xfd, 0xf6,
+	0x77, 0xf9, 0x1d, 0x36, 0x98, 0xc6, 0x2d, 0x7c, 0xf9, 0xe9, 0xc5, 0xce, 0x77, 0xc8, 0x53, 0xf0,
+	0xfb, 0x9f, 0xff, 0x0a, 0x29, 0x8f, 0x0f, 0xf8, 0xfc, 0xec, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x7e, 0x35, 0x15, 0x46, 0x1a, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/eth/v1alpha1/beacon_block.proto
+
+package eth
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BeaconBlock struct {
+	Slot                 uint64           `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentRoot           []byte           `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot            []byte           `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	Body                 *BeaconBlockBody `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Signature            []byte           `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BeaconBlock) Reset()         { *m = BeaconBlock{} }
+func (m *BeaconBlock) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlock) ProtoMessage()    {}
+func (*BeaconBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{0}
+}
+
+func (m *BeaconBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconBlock.Unmarshal(m, b)
+}
+func (m *BeaconBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconBlock.Marshal(b, m, deterministic)
+}
+func (m *BeaconBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlock.Merge(m, src)
+}
+func (m *BeaconBlock) XXX_Size() int {
+	return xxx_messageInfo_BeaconBlock.Size(m)
+}
+func (m *BeaconBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlock proto.InternalMessageInfo
+
+func (m *BeaconBlock) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconBlock) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlock) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlock) GetBody() *BeaconBlockBody {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *BeaconBlock) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type BeaconBlockBody struct {
+	RandaoReveal         []byte              `protobuf:"bytes,1,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	Eth1Data             *Eth1Data           `protobuf:"bytes,2,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Graffiti             []byte              `protobuf:"bytes,3,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	ProposerSlashings    []*ProposerSlashing `protobuf:"bytes,4,rep,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	AttesterSlashings    []*AttesterSlashing `protobuf:"bytes,5,rep,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	Attestations         []*Attestation      `protobuf:"bytes,6,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Deposits             []*Deposit          `protobuf:"bytes,7,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	VoluntaryExits       []*VoluntaryExit    `protobuf:"bytes,8,rep,name=voluntary_exits,json=voluntaryExits,proto3" json:"voluntary_exits,omitempty"`
+	Transfers            []*Transfer         `protobuf:"bytes,9,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BeaconBlockBody) Reset()         { *m = BeaconBlockBody{} }
+func (m *BeaconBlockBody) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockBody) ProtoMessage()    {}
+func (*BeaconBlockBody) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{1}
+}
+
+func (m *BeaconBlockBody) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconBlockBody.Unmarshal(m, b)
+}
+func (m *BeaconBlockBody) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconBlockBody.Marshal(b, m, deterministic)
+}
+func (m *BeaconBlockBody) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockBody.Merge(m, src)
+}
+func (m *BeaconBlockBody) XXX_Size() int {
+	return xxx_messageInfo_BeaconBlockBody.Size(m)
+}
+func (m *BeaconBlockBody) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockBody.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockBody proto.InternalMessageInfo
+
+func (m *BeaconBlockBody) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetEth1Data() *Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetGraffiti() []byte {
+	if m != nil {
+		return m.Graffiti
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetProposerSlashings() []*ProposerSlashing {
+	if m != nil {
+		return m.ProposerSlashings
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetAttesterSlashings() []*AttesterSlashing {
+	if m != nil {
+		return m.AttesterSlashings
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetDeposits() []*Deposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetVoluntaryExits() []*VoluntaryExit {
+	if m != nil {
+		return m.VoluntaryExits
+	}
+	return nil
+}
+
+func (m *BeaconBlockBody) GetTransfers() []*Transfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type ProposerSlashing struct {
+	ProposerIndex        uint64             `protobuf:"varint,1,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	Header_1             *BeaconBlockHeader `protobuf:"bytes,2,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty"`
+	Header_2             *BeaconBlockHeader `protobuf:"bytes,3,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ProposerSlashing) Reset()         { *m = ProposerSlashing{} }
+func (m *ProposerSlashing) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlashing) ProtoMessage()    {}
+func (*ProposerSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{2}
+}
+
+func (m *ProposerSlashing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposerSlashing.Unmarshal(m, b)
+}
+func (m *ProposerSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposerSlashing.Marshal(b, m, deterministic)
+}
+func (m *ProposerSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlashing.Merge(m, src)
+}
+func (m *ProposerSlashing) XXX_Size() int {
+	return xxx_messageInfo_ProposerSlashing.Size(m)
+}
+func (m *ProposerSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlashing proto.InternalMessageInfo
+
+func (m *ProposerSlashing) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *ProposerSlashing) GetHeader_1() *BeaconBlockHeader {
+	if m != nil {
+		return m.Header_1
+	}
+	return nil
+}
+
+func (m *ProposerSlashing) GetHeader_2() *BeaconBlockHeader {
+	if m != nil {
+		return m.Header_2
+	}
+	return nil
+}
+
+type AttesterSlashing struct {
+	Attestation_1        *IndexedAttestation `protobuf:"bytes,1,opt,name=attestation_1,json=attestation1,proto3" json:"attestation_1,omitempty"`
+	Attestation_2        *IndexedAttestation `protobuf:"bytes,2,opt,name=attestation_2,json=attestation2,proto3" json:"attestation_2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AttesterSlashing) Reset()         { *m = AttesterSlashing{} }
+func (m *AttesterSlashing) String() string { return proto.CompactTextString(m) }
+func (*AttesterSlashing) ProtoMessage()    {}
+func (*AttesterSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{3}
+}
+
+func (m *AttesterSlashing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttesterSlashing.Unmarshal(m, b)
+}
+func (m *AttesterSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttesterSlashing.Marshal(b, m, deterministic)
+}
+func (m *AttesterSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterSlashing.Merge(m, src)
+}
+func (m *AttesterSlashing) XXX_Size() int {
+	return xxx_messageInfo_AttesterSlashing.Size(m)
+}
+func (m *AttesterSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterSlashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterSlashing proto.InternalMessageInfo
+
+func (m *AttesterSlashing) GetAttestation_1() *IndexedAttestation {
+	if m != nil {
+		return m.Attestation_1
+	}
+	return nil
+}
+
+func (m *AttesterSlashing) GetAttestation_2() *IndexedAttestation {
+	if m != nil {
+		return m.Attestation_2
+	}
+	return nil
+}
+
+type Deposit struct {
+	Proof                [][]byte      `protobuf:"bytes,1,rep,name=proof,proto3" json:"proof,omitempty"`
+	Data                 *Deposit_Data `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{4}
+}
+
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deposit.Unmarshal(m, b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return xxx_messageInfo_Deposit.Size(m)
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *Deposit) GetData() *Deposit_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type Deposit_Data struct {
+	PublicKey             []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials []byte   `protobuf:"bytes,2,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	Amount                uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Signature             []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Deposit_Data) Reset()         { *m = Deposit_Data{} }
+func (m *Deposit_Data) String() string { return proto.CompactTextString(m) }
+func (*Deposit_Data) ProtoMessage()    {}
+func (*Deposit_Data) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{4, 0}
+}
+
+func (m *Deposit_Data) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deposit_Data.Unmarshal(m, b)
+}
+func (m *Deposit_Data) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deposit_Data.Marshal(b, m, deterministic)
+}
+func (m *Deposit_Data) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit_Data.Merge(m, src)
+}
+func (m *Deposit_Data) XXX_Size() int {
+	return xxx_messageInfo_Deposit_Data.Size(m)
+}
+func (m *Deposit_Data) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit_Data.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit_Data proto.InternalMessageInfo
+
+func (m *Deposit_Data) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Deposit_Data) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (m *Deposit_Data) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Deposit_Data) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type VoluntaryExit struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoluntaryExit) Reset()         { *m = VoluntaryExit{} }
+func (m *VoluntaryExit) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExit) ProtoMessage()    {}
+func (*VoluntaryExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{5}
+}
+
+func (m *VoluntaryExit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoluntaryExit.Unmarshal(m, b)
+}
+func (m *VoluntaryExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoluntaryExit.Marshal(b, m, deterministic)
+}
+func (m *VoluntaryExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExit.Merge(m, src)
+}
+func (m *VoluntaryExit) XXX_Size() int {
+	return xxx_messageInfo_VoluntaryExit.Size(m)
+}
+func (m *VoluntaryExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExit proto.InternalMessageInfo
+
+func (m *VoluntaryExit) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *VoluntaryExit) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *VoluntaryExit) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type Transfer struct {
+	SenderIndex               uint64   `protobuf:"varint,1,opt,name=sender_index,json=senderIndex,proto3" json:"sender_index,omitempty"`
+	RecipientIndex            uint64   `protobuf:"varint,2,opt,name=recipient_index,json=recipientIndex,proto3" json:"recipient_index,omitempty"`
+	Amount                    uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee                       uint64   `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Slot                      uint64   `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	SenderWithdrawalPublicKey []byte   `protobuf:"bytes,6,opt,name=sender_withdrawal_public_key,json=senderWithdrawalPublicKey,proto3" json:"sender_withdrawal_public_key,omitempty"`
+	Signature                 []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{6}
+}
+
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transfer.Unmarshal(m, b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return xxx_messageInfo_Transfer.Size(m)
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetSenderIndex() uint64 {
+	if m != nil {
+		return m.SenderIndex
+	}
+	return 0
+}
+
+func (m *Transfer) GetRecipientIndex() uint64 {
+	if m != nil {
+		return m.RecipientIndex
+	}
+	return 0
+}
+
+func (m *Transfer) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Transfer) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Transfer) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *Transfer) GetSenderWithdrawalPublicKey() []byte {
+	if m != nil {
+		return m.SenderWithdrawalPublicKey
+	}
+	return nil
+}
+
+func (m *Transfer) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type Eth1Data struct {
+	DepositRoot          []byte   `protobuf:"bytes,1,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,2,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1Data) Reset()         { *m = Eth1Data{} }
+func (m *Eth1Data) String() string { return proto.CompactTextString(m) }
+func (*Eth1Data) ProtoMessage()    {}
+func (*Eth1Data) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{7}
+}
+
+func (m *Eth1Data) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1Data.Unmarshal(m, b)
+}
+func (m *Eth1Data) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1Data.Marshal(b, m, deterministic)
+}
+func (m *Eth1Data) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1Data.Merge(m, src)
+}
+func (m *Eth1Data) XXX_Size() int {
+	return xxx_messageInfo_Eth1Data.Size(m)
+}
+func (m *Eth1Data) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1Data.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1Data proto.InternalMessageInfo
+
+func (m *Eth1Data) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *Eth1Data) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *Eth1Data) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type BeaconBlockHeader struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentRoot           []byte   `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	BodyRoot             []byte   `protobuf:"bytes,4,opt,name=body_root,json=bodyRoot,proto3" json:"body_root,omitempty"`
+	Signature            []byte   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconBlockHeader) Reset()         { *m = BeaconBlockHeader{} }
+func (m *BeaconBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockHeader) ProtoMessage()    {}
+func (*BeaconBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{8}
+}
+
+func (m *BeaconBlockHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconBlockHeader.Unmarshal(m, b)
+}
+func (m *BeaconBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconBlockHeader.Marshal(b, m, deterministic)
+}
+func (m *BeaconBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockHeader.Merge(m, src)
+}
+func (m *BeaconBlockHeader) XXX_Size() int {
+	return xxx_messageInfo_BeaconBlockHeader.Size(m)
+}
+func (m *BeaconBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockHeader proto.InternalMessageInfo
+
+func (m *BeaconBlockHeader) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconBlockHeader) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetBodyRoot() []byte {
+	if m != nil {
+		return m.BodyRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type IndexedAttestation struct {
+	CustodyBit_0Indices  []uint64         `protobuf:"varint,1,rep,packed,name=custody_bit_0_indices,json=custodyBit0Indices,proto3" json:"custody_bit_0_indices,omitempty"`
+	CustodyBit_1Indices  []uint64         `protobuf:"varint,2,rep,packed,name=custody_bit_1_indices,json=custodyBit1Indices,proto3" json:"custody_bit_1_indices,omitempty"`
+	Data                 *AttestationData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Signature            []byte           `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *IndexedAttestation) Reset()         { *m = IndexedAttestation{} }
+func (m *IndexedAttestation) String() string { return proto.CompactTextString(m) }
+func (*IndexedAttestation) ProtoMessage()    {}
+func (*IndexedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9369dd0265944233, []int{9}
+}
+
+func (m *IndexedAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexedAttestation.Unmarshal(m, b)
+}
+func (m *IndexedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexedAttestation.Marshal(b, m, deterministic)
+}
+func (m *IndexedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedAttestation.Merge(m, src)
+}
+func (m *IndexedAttestation) XXX_Size() int {
+	return xxx_messageInfo_IndexedAttestation.Size(m)
+}
+func (m *IndexedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedAttestation proto.InternalMessageInfo
+
+func (m *IndexedAttestation) GetCustodyBit_0Indices() []uint64 {
+	if m != nil {
+		return m.CustodyBit_0Indices
+	}
+	return nil
+}
+
+func (m *IndexedAttestation) GetCustodyBit_1Indices() []uint64 {
+	if m != nil {
+		return m.CustodyBit_1Indices
+	}
+	return nil
+}
+
+func (m *IndexedAttestation) GetData() *AttestationData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *IndexedAttestation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BeaconBlock)(nil), "ethereum.eth.v1alpha1.BeaconBlock")
+	proto.RegisterType((*BeaconBlockBody)(nil), "ethereum.eth.v1alpha1.BeaconBlockBody")
+	proto.RegisterType((*ProposerSlashing)(nil), "ethereum.eth.v1alpha1.ProposerSlashing")
+	proto.RegisterType((*AttesterSlashing)(nil), "ethereum.eth.v1alpha1.AttesterSlashing")
+	proto.RegisterType((*Deposit)(nil), "ethereum.eth.v1alpha1.Deposit")
+	proto.RegisterType((*Deposit_Data)(nil), "ethereum.eth.v1alpha1.Deposit.Data")
+	proto.RegisterType((*VoluntaryExit)(nil), "ethereum.eth.v1alpha1.VoluntaryExit")
+	proto.RegisterType((*Transfer)(nil), "ethereum.eth.v1alpha1.Transfer")
+	proto.RegisterType((*Eth1Data)(nil), "ethereum.eth.v1alpha1.Eth1Data")
+	proto.RegisterType((*BeaconBlockHeader)(nil), "ethereum.eth.v1alpha1.BeaconBlockHeader")
+	proto.RegisterType((*IndexedAttestation)(nil), "ethereum.eth.v1alpha1.IndexedAttestation")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/beacon_block.proto", fileDescriptor_9369dd0265944233)
+}
+
+var fileDescriptor_9369dd0265944233 = []byte{
+	// 1104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x05, 0x25, 0xca, 0x96, 0xae, 0x24, 0xff, 0x0c, 0x62, 0x43, 0x31, 0x3e, 0x7c, 0x12, 0x18,
+	0xa7, 0x51, 0x8b, 0x5a, 0x7f, 0x76, 0x9d, 0xc4, 0xed, 0xa6, 0x74, 0x0c, 0x38, 0x68, 0x11, 0x04,
+	0x6c, 0xd1, 0xa2, 0xdd, 0x10, 0x23, 0x72, 0x24, 0x12, 0xa6, 0x38, 0x04, 0x67, 0xe4, 0x58, 0xd9,
+	0xf4, 0x11, 0xba, 0xec, 0x7b, 0xf4, 0x15, 0xfa, 0x00, 0x7d, 0x03, 0x75, 0xd1, 0x7d, 0x17, 0x42,
+	0x1f, 0xa0, 0xe0, 0x0c, 0x45, 0x51, 0xb2, 0xe8, 0xda, 0xd9, 0x74, 0x37, 0x24, 0xcf, 0x3d, 0xe7,
+	0xf2, 0xce, 0x99, 0x3b, 0x17, 0x9e, 0x06, 0x21, 0xe5, 0xb4, 0x4d, 0xb8, 0xd3, 0xbe, 0xee, 0x62,
+	0x2f, 0x70, 0x70, 0xb7, 0xdd, 0x27, 0xd8, 0xa2, 0xbe, 0xd9, 0xf7, 0xa8, 0x75, 0xd5, 0x12, 0xdf,
+	0xd1, 0x1e, 0xe1, 0x0e, 0x09, 0xc9, 0x78, 0xd4, 0x22, 0xdc, 0x69, 0xcd, 0x91, 0x07, 0x47, 0x43,
+	0x97, 0x3b, 0xe3, 0x7e, 0xcb, 0xa2, 0xa3, 0xf6, 0x90, 0x0e, 0x69, 0x5b, 0xa0, 0xfb, 0xe3, 0x81,
+	0x78, 0x92, 0xd4, 0xd1, 0x4a, 0xb2, 0x1c, 0x1c, 0xae, 0x11, 0xc3, 0x9c, 0x13, 0xc6, 0x31, 0x77,
+	0xa9, 0x2f, 0x51, 0xda, 0xdf, 0x0a, 0x94, 0x75, 0x91, 0x82, 0x1e, 0x65, 0x80, 0x10, 0xa8, 0xcc,
+	0xa3, 0xbc, 0xa6, 0x34, 0x94, 0xa6, 0x6a, 0x88, 0x35, 0xea, 0x41, 0x39, 0xc0, 0x21, 0xf1, 0xb9,
+	0x19, 0x52, 0xca, 0x6b, 0xb9, 0x86, 0xd2, 0xac, 0xe8, 0xbb, 0xb3, 0x69, 0xbd, 0xca, 0xd8, 0xfb,
+	0x23, 0xe6, 0xbe, 0x27, 0x67, 0xda, 0x71, 0x4f, 0x33, 0x40, 0xa2, 0x0c, 0x4a, 0x39, 0xea, 0x00,
+	0x44, 0x42, 0x44, 0x86, 0xe4, 0xb3, 0x42, 0x4a, 0x02, 0x24, 0x22, 0xce, 0x40, 0xed, 0x53, 0x7b,
+	0x52, 0x53, 0x1b, 0x4a, 0xb3, 0xdc, 0xfb, 0xa8, 0xb5, 0xb6, 0x08, 0xad, 0x54, 0xae, 0x3a, 0xb5,
+	0x27, 0x86, 0x88, 0x41, 0x6d, 0x28, 0x31, 0x77, 0xe8, 0x63, 0x3e, 0x0e, 0x49, 0xad, 0xb0, 0x4e,
+	0xec, 0xe5, 0x69, 0x24, 0x36, 0xc7, 0x68, 0x7f, 0x16, 0x60, 0x7b, 0x85, 0x0a, 0x9d, 0x42, 0x35,
+	0xc4, 0xbe, 0x8d, 0xa9, 0x19, 0x92, 0x6b, 0x82, 0x3d, 0x51, 0x83, 0xb5, 0x44, 0x15, 0x89, 0x33,
+	0x04, 0x0c, 0x7d, 0x01, 0x25, 0xc2, 0x9d, 0xae, 0x69, 0x63, 0x8e, 0x45, 0x71, 0xca, 0xbd, 0x7a,
+	0x46, 0xf6, 0x17, 0xdc, 0xe9, 0xbe, 0xc2, 0x1c, 0x1b, 0x45, 0x12, 0xaf, 0xd0, 0x11, 0x14, 0x87,
+	0x21, 0x1e, 0x0c, 0x5c, 0xee, 0x66, 0x97, 0x29, 0x81, 0x20, 0x07, 0x50, 0x10, 0xd2, 0x80, 0x32,
+	0x12, 0x9a, 0xcc, 0xc3, 0xcc, 0x71, 0xfd, 0x21, 0xab, 0xa9, 0x8d, 0x7c, 0xb3, 0xdc, 0x7b, 0x96,
+	0xa1, 0xfa, 0x36, 0x0e, 0xf8, 0x26, 0xc6, 0xeb, 0x3b, 0xb3, 0x69, 0xbd, 0x12, 0x29, 0x8c, 0xf0,
+	0xcd, 0x99, 0xd6, 0x3d, 0xd5, 0x8c, 0xdd, 0x60, 0x05, 0xc3, 0xd0, 0x10, 0x90, 0xb4, 0xcb, 0x92,
+	0x52, 0xe1, 0x4e, 0xa5, 0x2f, 0xe3, 0x80, 0x44, 0x69, 0x7b, 0x36, 0xad, 0x97, 0x17, 0x4a, 0x9a,
+	0xb1, 0x8b, 0x57, 0x20, 0x0c, 0xfd, 0x00, 0x95, 0x94, 0x2f, 0x59, 0x6d, 0x43, 0x48, 0x68, 0x77,
+	0x4a, 0x08, 0xe8, 0xa2, 0x52, 0x92, 0xbd, 0xf7, 0x42, 0x33, 0x96, 0xa8, 0xd0, 0xd7, 0x50, 0xb4,
+	0x49, 0x40, 0x99, 0xcb, 0x59, 0x6d, 0x53, 0xd0, 0xfe, 0x3f, 0x83, 0xf6, 0x95, 0x84, 0xad, 0x29,
+	0x4d, 0xc2, 0x80, 0x4c, 0xd8, 0xbe, 0xa6, 0xde, 0xd8, 0xe7, 0x38, 0x9c, 0x98, 0xe4, 0x26, 0x22,
+	0x2d, 0x0a, 0xd2, 0xc3, 0x0c, 0xd2, 0xef, 0xe6, 0xe8, 0x8b, 0x9b, 0xb5, 0xd4, 0x5b, 0xd7, 0x69,
+	0x00, 0x43, 0x6f, 0xa0, 0xc4, 0x43, 0xec, 0xb3, 0x01, 0x09, 0x59, 0xad, 0x24, 0xa8, 0xb3, 0x9c,
+	0xf4, 0x6d, 0x8c, 0x5b, 0xa9, 0x70, 0x47, 0x33, 0x16, 0x14, 0xda, 0x6f, 0x0a, 0xec, 0xac, 0x6e,
+	0x3e, 0x7a, 0x0a, 0x5b, 0x89, 0x83, 0x5c, 0xdf, 0x26, 0x37, 0xf1, 0x59, 0xaf, 0xce, 0xdf, 0xbe,
+	0x8e, 0x5e, 0xa2, 0x73, 0x28, 0x3a, 0x04, 0xdb, 0x24, 0x34, 0xbb, 0xb1, 0xa9, 0x9b, 0xff, 0x7e,
+	0x24, 0x2f, 0x45, 0x84, 0xb1, 0x29, 0x23, 0xbb, 0x29, 0x92, 0x9e, 0x30, 0xf7, 0x07, 0x90, 0xf4,
+	0xb4, 0x5f, 0x15, 0xd8, 0x59, 0x35, 0x16, 0x7a, 0x03, 0xd5, 0xd4, 0x4e, 0x9b, 0x5d, 0xf1, 0x13,
+	0xe5, 0xde, 0xc7, 0x19, 0xf4, 0xe2, 0x9f, 0x88, 0x9d, 0x32, 0xcf, 0x92, 0x53, 0xba, 0xab, 0x7c,
+	0xbd, 0xf8, 0x9f, 0x3f, 0x90, 0xaf, 0xa7, 0xfd, 0x9e, 0x83, 0xcd, 0xd8, 0x53, 0xe8, 0x13, 0x28,
+	0x04, 0x21, 0xa5, 0x83, 0x9a, 0xd2, 0xc8, 0x37, 0x2b, 0xfa, 0xa3, 0xd9, 0xb4, 0xbe, 0x93, 0x3a,
+	0xdf, 0xc7, 0x9f, 0x46, 0x47, 0x5c, 0x42, 0xd0, 0x73, 0x50, 0x53, 0x7d, 0xe4, 0xc9, 0xdd, 0x6e,
+	0x6d, 0x89, 0x5e, 0x22, 0x02, 0x0e, 0xa6, 0x0a, 0xa8, 0xa2, 0xa1, 0x9c, 0x03, 0x04, 0xe3, 0xbe,
+	0xe7, 0x5a, 0xe6, 0x15, 0x99, 0xc4, 0x3d, 0xec, 0x70, 0x36, 0xad, 0x37, 0x16, 0x92, 0x27, 0x2f,
+	0xb4, 0x06, 0x0b, 0x88, 0x75, 0xe4, 0xe3, 0x11, 0x39, 0xd3, 0x82, 0x71, 0xff, 0x8a, 0x4c, 0x34,
+	0xa3, 0x24, 0xe3, 0xbe, 0x22, 0x13, 0x74, 0x09, 0xfb, 0xef, 0x5c, 0xee, 0xd8, 0x21, 0x7e, 0x87,
+	0x3d, 0xd3, 0x0a, 0x89, 0x4d, 0x7c, 0xee, 0x62, 0x8f, 0x65, 0x77, 0xff, 0xbd, 0x45, 0xc0, 0xf9,
+	0x02, 0x8f, 0xf6, 0x61, 0x03, 0x8f, 0xe8, 0xd8, 0x97, 0x97, 0x80, 0x6a, 0xc4, 0x4f, 0xcb, 0x2d,
+	0x5b, 0xbd, 0x47, 0xcb, 0xfe, 0x09, 0xaa, 0x4b, 0xe7, 0x09, 0x3d, 0x82, 0x02, 0x09, 0xa8, 0xe5,
+	0xc4, 0xfe, 0x95, 0x0f, 0xe8, 0x19, 0x6c, 0x5f, 0x63, 0xcf, 0xb5, 0x31, 0xa7, 0x73, 0x7f, 0xe7,
+	0xc4, 0xf7, 0xad, 0xe4, 0xb5, 0x34, 0xf8, 0x52, 0x02, 0xf9, 0x7b, 0x24, 0xf0, 0x47, 0x0e, 0x8a,
+	0xf3, 0x63, 0x87, 0x5e, 0x42, 0x85, 0x11, 0xdf, 0x5e, 0x3e, 0x43, 0xfa, 0xfe, 0x6c, 0x5a, 0x47,
+	0xa9, 0xca, 0x4a, 0x88, 0x66, 0x94, 0xe5, 0x42, 0x0a, 0xeb, 0xb0, 0x1d, 0x12, 0xcb, 0x0d, 0xdc,
+	0xe8, 0x46, 0x4d, 0x65, 0xa8, 0x3f, 0x9e, 0x4d, 0xeb, 0x7b, 0xa9, 0xe8, 0x04, 0xa5, 0x19, 0x5b,
+	0xc9, 0x5a, 0x72, 0x64, 0x55, 0x75, 0x07, 0xf2, 0x03, 0x22, 0xeb, 0xa9, 0x1a, 0xd1, 0x32, 0xb9,
+	0xd0, 0x0b, 0xa9, 0x0b, 0x9d, 0xc0, 0xff, 0xe2, 0xe4, 0x53, 0x9b, 0x9c, 0x32, 0xcd, 0xc6, 0x03,
+	0x4c, 0xf3, 0x58, 0x32, 0x7d, 0x9f, 0x10, 0xbd, 0x4d, 0x4c, 0xb4, 0x54, 0xe1, 0xcd, 0x7b, 0x54,
+	0xf8, 0x17, 0x05, 0x8a, 0xf3, 0x2b, 0x12, 0x9d, 0x40, 0x25, 0xee, 0xbc, 0x72, 0x86, 0x50, 0xb2,
+	0x8c, 0x57, 0x8e, 0x61, 0x62, 0x8a, 0x78, 0x02, 0xd5, 0x79, 0x94, 0x25, 0xea, 0x23, 0x37, 0x7f,
+	0x4e, 0x75, 0x2e, 0xaa, 0xd4, 0x01, 0x10, 0xf3, 0x96, 0xe9, 0x60, 0xe6, 0xdc, 0x31, 0x9c, 0x08,
+	0xd0, 0x25, 0x66, 0x8e, 0xf6, 0x97, 0x02, 0xbb, 0xb7, 0x5a, 0xd4, 0x7f, 0x38, 0x2c, 0xb5, 0xa0,
+	0x14, 0x0d, 0x3e, 0x32, 0x40, 0xcd, 0x1c, 0x1b, 0x22, 0x8c, 0xc0, 0x3f, 0x78, 0x40, 0xfa, 0x39,
+	0x07, 0xe8, 0x76, 0x93, 0x43, 0x17, 0xb0, 0x67, 0x8d, 0x19, 0x8f, 0xa4, 0xfb, 0x2e, 0x37, 0x3b,
+	0x91, 0x7f, 0x5d, 0x8b, 0x30, 0xd1, 0xda, 0x54, 0x1d, 0xcd, 0xa6, 0xf5, 0xad, 0xe4, 0x32, 0x3a,
+	0xe9, 0x44, 0xa4, 0x28, 0x0e, 0xd0, 0x5d, 0xde, 0x79, 0x2d, 0xd1, 0xab, 0x34, 0xdd, 0x84, 0x26,
+	0x77, 0x1f, 0x9a, 0xee, 0x9c, 0xe6, 0x2c, 0x6e, 0x96, 0xf9, 0x3b, 0x47, 0xc6, 0x54, 0xfe, 0x8b,
+	0x7e, 0xf9, 0xe0, 0xfe, 0xa3, 0x3f, 0xff, 0xf1, 0xb3, 0xd4, 0x00, 0x1e, 0x84, 0x13, 0x36, 0xc2,
+	0xdc, 0xb5, 0x3c, 0xdc, 0x67, 0xf2, 0xa9, 0x7d, 0x7b, 0xe0, 0xfe, 0x9c, 0x70, 0xa7, 0xbf, 0x21,
+	0xde, 0x1f, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x19, 0x8d, 0xef, 0xfe, 0x0b, 0x00, 0x00,
+}
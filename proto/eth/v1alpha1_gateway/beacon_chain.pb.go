@@ -0,0 +1,2842 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/eth/v1alpha1/beacon_chain.proto
+
+package eth
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListAttestationsRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*ListAttestationsRequest_BlockRoot
+	//	*ListAttestationsRequest_Slot
+	//	*ListAttestationsRequest_Epoch
+	QueryFilter          isListAttestationsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ListAttestationsRequest) Reset()         { *m = ListAttestationsRequest{} }
+func (m *ListAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAttestationsRequest) ProtoMessage()    {}
+func (*ListAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{0}
+}
+
+func (m *ListAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttestationsRequest.Unmarshal(m, b)
+}
+func (m *ListAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttestationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttestationsRequest.Merge(m, src)
+}
+func (m *ListAttestationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAttestationsRequest.Size(m)
+}
+func (m *ListAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttestationsRequest proto.InternalMessageInfo
+
+type isListAttestationsRequest_QueryFilter interface {
+	isListAttestationsRequest_QueryFilter()
+}
+
+type ListAttestationsRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3,oneof"`
+}
+
+type ListAttestationsRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof"`
+}
+
+type ListAttestationsRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3,oneof"`
+}
+
+func (*ListAttestationsRequest_BlockRoot) isListAttestationsRequest_QueryFilter() {}
+
+func (*ListAttestationsRequest_Slot) isListAttestationsRequest_QueryFilter() {}
+
+func (*ListAttestationsRequest_Epoch) isListAttestationsRequest_QueryFilter() {}
+
+func (m *ListAttestationsRequest) GetQueryFilter() isListAttestationsRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *ListAttestationsRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*ListAttestationsRequest_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (m *ListAttestationsRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*ListAttestationsRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *ListAttestationsRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*ListAttestationsRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ListAttestationsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ListAttestationsRequest_BlockRoot)(nil),
+		(*ListAttestationsRequest_Slot)(nil),
+		(*ListAttestationsRequest_Epoch)(nil),
+	}
+}
+
+type ListAttestationsResponse struct {
+	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListAttestationsResponse) Reset()         { *m = ListAttestationsResponse{} }
+func (m *ListAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAttestationsResponse) ProtoMessage()    {}
+func (*ListAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{1}
+}
+
+func (m *ListAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAttestationsResponse.Unmarshal(m, b)
+}
+func (m *ListAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAttestationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAttestationsResponse.Merge(m, src)
+}
+func (m *ListAttestationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAttestationsResponse.Size(m)
+}
+func (m *ListAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAttestationsResponse proto.InternalMessageInfo
+
+func (m *ListAttestationsResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type ListBlocksRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*ListBlocksRequest_Root
+	//	*ListBlocksRequest_Slot
+	//	*ListBlocksRequest_Epoch
+	//	*ListBlocksRequest_SlotRange
+	//	*ListBlocksRequest_ParentRoot
+	QueryFilter          isListBlocksRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PageSize             int32                           `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                          `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ListBlocksRequest) Reset()         { *m = ListBlocksRequest{} }
+func (m *ListBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBlocksRequest) ProtoMessage()    {}
+func (*ListBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{2}
+}
+
+func (m *ListBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlocksRequest.Unmarshal(m, b)
+}
+func (m *ListBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlocksRequest.Merge(m, src)
+}
+func (m *ListBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBlocksRequest.Size(m)
+}
+func (m *ListBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlocksRequest proto.InternalMessageInfo
+
+type isListBlocksRequest_QueryFilter interface {
+	isListBlocksRequest_QueryFilter()
+}
+
+type ListBlocksRequest_Root struct {
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3,oneof"`
+}
+
+type ListBlocksRequest_Slot struct {
+	Slot uint64 `protobuf:"varint,2,opt,name=slot,proto3,oneof"`
+}
+
+type ListBlocksRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3,oneof"`
+}
+
+type ListBlocksRequest_SlotRange struct {
+	SlotRange *SlotRange `protobuf:"bytes,4,opt,name=slot_range,json=slotRange,proto3,oneof"`
+}
+
+type ListBlocksRequest_ParentRoot struct {
+	ParentRoot []byte `protobuf:"bytes,5,opt,name=parent_root,json=parentRoot,proto3,oneof"`
+}
+
+func (*ListBlocksRequest_Root) isListBlocksRequest_QueryFilter() {}
+
+func (*ListBlocksRequest_Slot) isListBlocksRequest_QueryFilter() {}
+
+func (*ListBlocksRequest_Epoch) isListBlocksRequest_QueryFilter() {}
+
+func (*ListBlocksRequest_SlotRange) isListBlocksRequest_QueryFilter() {}
+
+func (*ListBlocksRequest_ParentRoot) isListBlocksRequest_QueryFilter() {}
+
+func (m *ListBlocksRequest) GetQueryFilter() isListBlocksRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_Root); ok {
+		return x.Root
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetSlot() uint64 {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *ListBlocksRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (m *ListBlocksRequest) GetSlotRange() *SlotRange {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_SlotRange); ok {
+		return x.SlotRange
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetParentRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*ListBlocksRequest_ParentRoot); ok {
+		return x.ParentRoot
+	}
+	return nil
+}
+
+func (m *ListBlocksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListBlocksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ListBlocksRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ListBlocksRequest_Root)(nil),
+		(*ListBlocksRequest_Slot)(nil),
+		(*ListBlocksRequest_Epoch)(nil),
+		(*ListBlocksRequest_SlotRange)(nil),
+		(*ListBlocksRequest_ParentRoot)(nil),
+	}
+}
+
+type SlotRange struct {
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotRange) Reset()         { *m = SlotRange{} }
+func (m *SlotRange) String() string { return proto.CompactTextString(m) }
+func (*SlotRange) ProtoMessage()    {}
+func (*SlotRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{3}
+}
+
+func (m *SlotRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlotRange.Unmarshal(m, b)
+}
+func (m *SlotRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlotRange.Marshal(b, m, deterministic)
+}
+func (m *SlotRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotRange.Merge(m, src)
+}
+func (m *SlotRange) XXX_Size() int {
+	return xxx_messageInfo_SlotRange.Size(m)
+}
+func (m *SlotRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotRange proto.InternalMessageInfo
+
+func (m *SlotRange) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *SlotRange) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+type ListBlocksResponse struct {
+	BlockContainers      []*BeaconBlockContainer `protobuf:"bytes,1,rep,name=block_containers,json=blockContainers,proto3" json:"block_containers,omitempty"`
+	NextPageToken        string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListBlocksResponse) Reset()         { *m = ListBlocksResponse{} }
+func (m *ListBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBlocksResponse) ProtoMessage()    {}
+func (*ListBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{4}
+}
+
+func (m *ListBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBlocksResponse.Unmarshal(m, b)
+}
+func (m *ListBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBlocksResponse.Merge(m, src)
+}
+func (m *ListBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBlocksResponse.Size(m)
+}
+func (m *ListBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBlocksResponse proto.InternalMessageInfo
+
+func (m *ListBlocksResponse) GetBlockContainers() []*BeaconBlockContainer {
+	if m != nil {
+		return m.BlockContainers
+	}
+	return nil
+}
+
+func (m *ListBlocksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListBlocksResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type BeaconBlockContainer struct {
+	Block                *BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte       `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Canonical            bool         `protobuf:"varint,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BeaconBlockContainer) Reset()         { *m = BeaconBlockContainer{} }
+func (m *BeaconBlockContainer) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockContainer) ProtoMessage()    {}
+func (*BeaconBlockContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{5}
+}
+
+func (m *BeaconBlockContainer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconBlockContainer.Unmarshal(m, b)
+}
+func (m *BeaconBlockContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconBlockContainer.Marshal(b, m, deterministic)
+}
+func (m *BeaconBlockContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockContainer.Merge(m, src)
+}
+func (m *BeaconBlockContainer) XXX_Size() int {
+	return xxx_messageInfo_BeaconBlockContainer.Size(m)
+}
+func (m *BeaconBlockContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockContainer proto.InternalMessageInfo
+
+func (m *BeaconBlockContainer) GetBlock() *BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BeaconBlockContainer) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockContainer) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+type ChainHead struct {
+	BlockRoot                  []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	BlockSlot                  uint64   `protobuf:"varint,2,opt,name=block_slot,json=blockSlot,proto3" json:"block_slot,omitempty"`
+	FinalizedSlot              uint64   `protobuf:"varint,3,opt,name=finalized_slot,json=finalizedSlot,proto3" json:"finalized_slot,omitempty"`
+	FinalizedBlockRoot         []byte   `protobuf:"bytes,4,opt,name=finalized_block_root,json=finalizedBlockRoot,proto3" json:"finalized_block_root,omitempty"`
+	JustifiedSlot              uint64   `protobuf:"varint,5,opt,name=justified_slot,json=justifiedSlot,proto3" json:"justified_slot,omitempty"`
+	JustifiedBlockRoot         []byte   `protobuf:"bytes,6,opt,name=justified_block_root,json=justifiedBlockRoot,proto3" json:"justified_block_root,omitempty"`
+	PreviousJustifiedSlot      uint64   `protobuf:"varint,7,opt,name=previous_justified_slot,json=previousJustifiedSlot,proto3" json:"previous_justified_slot,omitempty"`
+	PreviousJustifiedBlockRoot []byte   `protobuf:"bytes,8,opt,name=previous_justified_block_root,json=previousJustifiedBlockRoot,proto3" json:"previous_justified_block_root,omitempty"`
+	FinalizedEpoch             uint64   `protobuf:"varint,9,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	JustifiedEpoch             uint64   `protobuf:"varint,10,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	PreviousJustifiedEpoch     uint64   `protobuf:"varint,11,opt,name=previous_justified_epoch,json=previousJustifiedEpoch,proto3" json:"previous_justified_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *ChainHead) Reset()         { *m = ChainHead{} }
+func (m *ChainHead) String() string { return proto.CompactTextString(m) }
+func (*ChainHead) ProtoMessage()    {}
+func (*ChainHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{6}
+}
+
+func (m *ChainHead) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainHead.Unmarshal(m, b)
+}
+func (m *ChainHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainHead.Marshal(b, m, deterministic)
+}
+func (m *ChainHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainHead.Merge(m, src)
+}
+func (m *ChainHead) XXX_Size() int {
+	return xxx_messageInfo_ChainHead.Size(m)
+}
+func (m *ChainHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainHead proto.InternalMessageInfo
+
+func (m *ChainHead) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *ChainHead) GetBlockSlot() uint64 {
+	if m != nil {
+		return m.BlockSlot
+	}
+	return 0
+}
+
+func (m *ChainHead) GetFinalizedSlot() uint64 {
+	if m != nil {
+		return m.FinalizedSlot
+	}
+	return 0
+}
+
+func (m *ChainHead) GetFinalizedBlockRoot() []byte {
+	if m != nil {
+		return m.FinalizedBlockRoot
+	}
+	return nil
+}
+
+func (m *ChainHead) GetJustifiedSlot() uint64 {
+	if m != nil {
+		return m.JustifiedSlot
+	}
+	return 0
+}
+
+func (m *ChainHead) GetJustifiedBlockRoot() []byte {
+	if m != nil {
+		return m.JustifiedBlockRoot
+	}
+	return nil
+}
+
+func (m *ChainHead) GetPreviousJustifiedSlot() uint64 {
+	if m != nil {
+		return m.PreviousJustifiedSlot
+	}
+	return 0
+}
+
+func (m *ChainHead) GetPreviousJustifiedBlockRoot() []byte {
+	if m != nil {
+		return m.PreviousJustifiedBlockRoot
+	}
+	return nil
+}
+
+func (m *ChainHead) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHead) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHead) GetPreviousJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.PreviousJustifiedEpoch
+	}
+	return 0
+}
+
+type StreamValidatorRegistryChangesRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamValidatorRegistryChangesRequest) Reset()         { *m = StreamValidatorRegistryChangesRequest{} }
+func (m *StreamValidatorRegistryChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamValidatorRegistryChangesRequest) ProtoMessage()    {}
+func (*StreamValidatorRegistryChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{7}
+}
+
+func (m *StreamValidatorRegistryChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamValidatorRegistryChangesRequest.Unmarshal(m, b)
+}
+func (m *StreamValidatorRegistryChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamValidatorRegistryChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamValidatorRegistryChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamValidatorRegistryChangesRequest.Merge(m, src)
+}
+func (m *StreamValidatorRegistryChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamValidatorRegistryChangesRequest.Size(m)
+}
+func (m *StreamValidatorRegistryChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamValidatorRegistryChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamValidatorRegistryChangesRequest proto.InternalMessageInfo
+
+func (m *StreamValidatorRegistryChangesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorRegistryChanges struct {
+	Epoch                uint64                             `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PreviousEpoch        uint64                             `protobuf:"varint,2,opt,name=previous_epoch,json=previousEpoch,proto3" json:"previous_epoch,omitempty"`
+	Changes              []*ValidatorRegistryChanges_Change `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ValidatorRegistryChanges) Reset()         { *m = ValidatorRegistryChanges{} }
+func (m *ValidatorRegistryChanges) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryChanges) ProtoMessage()    {}
+func (*ValidatorRegistryChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{8}
+}
+
+func (m *ValidatorRegistryChanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRegistryChanges.Unmarshal(m, b)
+}
+func (m *ValidatorRegistryChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRegistryChanges.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRegistryChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryChanges.Merge(m, src)
+}
+func (m *ValidatorRegistryChanges) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRegistryChanges.Size(m)
+}
+func (m *ValidatorRegistryChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRegistryChanges proto.InternalMessageInfo
+
+func (m *ValidatorRegistryChanges) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges) GetPreviousEpoch() uint64 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges) GetChanges() []*ValidatorRegistryChanges_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type ValidatorRegistryChanges_Change struct {
+	PublicKey                []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index                    uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Activated                bool     `protobuf:"varint,3,opt,name=activated,proto3" json:"activated,omitempty"`
+	Exited                   bool     `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	Slashed                  bool     `protobuf:"varint,5,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	PreviousEffectiveBalance uint64   `protobuf:"varint,6,opt,name=previous_effective_balance,json=previousEffectiveBalance,proto3" json:"previous_effective_balance,omitempty"`
+	EffectiveBalance         uint64   `protobuf:"varint,7,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *ValidatorRegistryChanges_Change) Reset()         { *m = ValidatorRegistryChanges_Change{} }
+func (m *ValidatorRegistryChanges_Change) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistryChanges_Change) ProtoMessage()    {}
+func (*ValidatorRegistryChanges_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{8, 0}
+}
+
+func (m *ValidatorRegistryChanges_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRegistryChanges_Change.Unmarshal(m, b)
+}
+func (m *ValidatorRegistryChanges_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRegistryChanges_Change.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRegistryChanges_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistryChanges_Change.Merge(m, src)
+}
+func (m *ValidatorRegistryChanges_Change) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRegistryChanges_Change.Size(m)
+}
+func (m *ValidatorRegistryChanges_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistryChanges_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRegistryChanges_Change proto.InternalMessageInfo
+
+func (m *ValidatorRegistryChanges_Change) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorRegistryChanges_Change) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges_Change) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *ValidatorRegistryChanges_Change) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ValidatorRegistryChanges_Change) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
+func (m *ValidatorRegistryChanges_Change) GetPreviousEffectiveBalance() uint64 {
+	if m != nil {
+		return m.PreviousEffectiveBalance
+	}
+	return 0
+}
+
+func (m *ValidatorRegistryChanges_Change) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+type GetValidatorBalancesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorBalancesRequest) Reset()         { *m = GetValidatorBalancesRequest{} }
+func (m *GetValidatorBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorBalancesRequest) ProtoMessage()    {}
+func (*GetValidatorBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{9}
+}
+
+func (m *GetValidatorBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorBalancesRequest.Unmarshal(m, b)
+}
+func (m *GetValidatorBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorBalancesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorBalancesRequest.Merge(m, src)
+}
+func (m *GetValidatorBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorBalancesRequest.Size(m)
+}
+func (m *GetValidatorBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorBalancesRequest proto.InternalMessageInfo
+
+func (m *GetValidatorBalancesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GetValidatorBalancesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *GetValidatorBalancesRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *GetValidatorBalancesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetValidatorBalancesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorBalances struct {
+	Balances             []*ValidatorBalances_Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	Epoch                uint64                       `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NextPageToken        string                       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                        `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ValidatorBalances) Reset()         { *m = ValidatorBalances{} }
+func (m *ValidatorBalances) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalances) ProtoMessage()    {}
+func (*ValidatorBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{10}
+}
+
+func (m *ValidatorBalances) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalances.Unmarshal(m, b)
+}
+func (m *ValidatorBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalances.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalances.Merge(m, src)
+}
+func (m *ValidatorBalances) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalances.Size(m)
+}
+func (m *ValidatorBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalances proto.InternalMessageInfo
+
+func (m *ValidatorBalances) GetBalances() []*ValidatorBalances_Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *ValidatorBalances) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorBalances) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorBalances) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorBalances_Balance struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Balance              uint64   `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalances_Balance) Reset()         { *m = ValidatorBalances_Balance{} }
+func (m *ValidatorBalances_Balance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalances_Balance) ProtoMessage()    {}
+func (*ValidatorBalances_Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{10, 0}
+}
+
+func (m *ValidatorBalances_Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalances_Balance.Unmarshal(m, b)
+}
+func (m *ValidatorBalances_Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalances_Balance.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalances_Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalances_Balance.Merge(m, src)
+}
+func (m *ValidatorBalances_Balance) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalances_Balance.Size(m)
+}
+func (m *ValidatorBalances_Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalances_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalances_Balance proto.InternalMessageInfo
+
+func (m *ValidatorBalances_Balance) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorBalances_Balance) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorBalances_Balance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type GetValidatorsRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*GetValidatorsRequest_Epoch
+	//	*GetValidatorsRequest_Genesis
+	QueryFilter          isGetValidatorsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PageSize             int32                              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                             `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *GetValidatorsRequest) Reset()         { *m = GetValidatorsRequest{} }
+func (m *GetValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorsRequest) ProtoMessage()    {}
+func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{11}
+}
+
+func (m *GetValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorsRequest.Unmarshal(m, b)
+}
+func (m *GetValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorsRequest.Merge(m, src)
+}
+func (m *GetValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorsRequest.Size(m)
+}
+func (m *GetValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorsRequest proto.InternalMessageInfo
+
+type isGetValidatorsRequest_QueryFilter interface {
+	isGetValidatorsRequest_QueryFilter()
+}
+
+type GetValidatorsRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+
+type GetValidatorsRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,2,opt,name=genesis,proto3,oneof"`
+}
+
+func (*GetValidatorsRequest_Epoch) isGetValidatorsRequest_QueryFilter() {}
+
+func (*GetValidatorsRequest_Genesis) isGetValidatorsRequest_QueryFilter() {}
+
+func (m *GetValidatorsRequest) GetQueryFilter() isGetValidatorsRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *GetValidatorsRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*GetValidatorsRequest_Epoch); ok {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (m *GetValidatorsRequest) GetGenesis() bool {
+	if x, ok := m.GetQueryFilter().(*GetValidatorsRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
+func (m *GetValidatorsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetValidatorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GetValidatorsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GetValidatorsRequest_Epoch)(nil),
+		(*GetValidatorsRequest_Genesis)(nil),
+	}
+}
+
+type Validators struct {
+	Epoch                uint64       `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Validators           []*Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextPageToken        string       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32        `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Validators) Reset()         { *m = Validators{} }
+func (m *Validators) String() string { return proto.CompactTextString(m) }
+func (*Validators) ProtoMessage()    {}
+func (*Validators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{12}
+}
+
+func (m *Validators) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Validators.Unmarshal(m, b)
+}
+func (m *Validators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Validators.Marshal(b, m, deterministic)
+}
+func (m *Validators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validators.Merge(m, src)
+}
+func (m *Validators) XXX_Size() int {
+	return xxx_messageInfo_Validators.Size(m)
+}
+func (m *Validators) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validators proto.InternalMessageInfo
+
+func (m *Validators) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Validators) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *Validators) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Validators) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ListCommitteesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCommitteesRequest) Reset()         { *m = ListCommitteesRequest{} }
+func (m *ListCommitteesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommitteesRequest) ProtoMessage()    {}
+func (*ListCommitteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{13}
+}
+
+func (m *ListCommitteesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCommitteesRequest.Unmarshal(m, b)
+}
+func (m *ListCommitteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCommitteesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCommitteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommitteesRequest.Merge(m, src)
+}
+func (m *ListCommitteesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCommitteesRequest.Size(m)
+}
+func (m *ListCommitteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommitteesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommitteesRequest proto.InternalMessageInfo
+
+func (m *ListCommitteesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListCommitteesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCommitteesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type BeaconCommittees struct {
+	Epoch                uint64                            `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Committees           []*BeaconCommittees_CommitteeItem `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	ActiveValidatorCount uint64                            `protobuf:"varint,3,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	NextPageToken        string                            `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                             `protobuf:"varint,5,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *BeaconCommittees) Reset()         { *m = BeaconCommittees{} }
+func (m *BeaconCommittees) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommittees) ProtoMessage()    {}
+func (*BeaconCommittees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{14}
+}
+
+func (m *BeaconCommittees) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconCommittees.Unmarshal(m, b)
+}
+func (m *BeaconCommittees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconCommittees.Marshal(b, m, deterministic)
+}
+func (m *BeaconCommittees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommittees.Merge(m, src)
+}
+func (m *BeaconCommittees) XXX_Size() int {
+	return xxx_messageInfo_BeaconCommittees.Size(m)
+}
+func (m *BeaconCommittees) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommittees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommittees proto.InternalMessageInfo
+
+func (m *BeaconCommittees) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *BeaconCommittees) GetCommittees() []*BeaconCommittees_CommitteeItem {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+func (m *BeaconCommittees) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *BeaconCommittees) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *BeaconCommittees) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type BeaconCommittees_CommitteeItem struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Shard                uint64   `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	ValidatorIndices     []uint64 `protobuf:"varint,3,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconCommittees_CommitteeItem) Reset()         { *m = BeaconCommittees_CommitteeItem{} }
+func (m *BeaconCommittees_CommitteeItem) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommittees_CommitteeItem) ProtoMessage()    {}
+func (*BeaconCommittees_CommitteeItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{14, 0}
+}
+
+func (m *BeaconCommittees_CommitteeItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconCommittees_CommitteeItem.Unmarshal(m, b)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconCommittees_CommitteeItem.Marshal(b, m, deterministic)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommittees_CommitteeItem.Merge(m, src)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_Size() int {
+	return xxx_messageInfo_BeaconCommittees_CommitteeItem.Size(m)
+}
+func (m *BeaconCommittees_CommitteeItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommittees_CommitteeItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommittees_CommitteeItem proto.InternalMessageInfo
+
+func (m *BeaconCommittees_CommitteeItem) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconCommittees_CommitteeItem) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *BeaconCommittees_CommitteeItem) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+type GetValidatorActiveSetChangesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorActiveSetChangesRequest) Reset()         { *m = GetValidatorActiveSetChangesRequest{} }
+func (m *GetValidatorActiveSetChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorActiveSetChangesRequest) ProtoMessage()    {}
+func (*GetValidatorActiveSetChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{15}
+}
+
+func (m *GetValidatorActiveSetChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorActiveSetChangesRequest.Unmarshal(m, b)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorActiveSetChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorActiveSetChangesRequest.Merge(m, src)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorActiveSetChangesRequest.Size(m)
+}
+func (m *GetValidatorActiveSetChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorActiveSetChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorActiveSetChangesRequest proto.InternalMessageInfo
+
+func (m *GetValidatorActiveSetChangesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ActiveSetChanges struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ActivatedPublicKeys  [][]byte `protobuf:"bytes,2,rep,name=activated_public_keys,json=activatedPublicKeys,proto3" json:"activated_public_keys,omitempty"`
+	ExitedPublicKeys     [][]byte `protobuf:"bytes,3,rep,name=exited_public_keys,json=exitedPublicKeys,proto3" json:"exited_public_keys,omitempty"`
+	EjectedPublicKeys    [][]byte `protobuf:"bytes,4,rep,name=ejected_public_keys,json=ejectedPublicKeys,proto3" json:"ejected_public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveSetChanges) Reset()         { *m = ActiveSetChanges{} }
+func (m *ActiveSetChanges) String() string { return proto.CompactTextString(m) }
+func (*ActiveSetChanges) ProtoMessage()    {}
+func (*ActiveSetChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{16}
+}
+
+func (m *ActiveSetChanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveSetChanges.Unmarshal(m, b)
+}
+func (m *ActiveSetChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveSetChanges.Marshal(b, m, deterministic)
+}
+func (m *ActiveSetChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveSetChanges.Merge(m, src)
+}
+func (m *ActiveSetChanges) XXX_Size() int {
+	return xxx_messageInfo_ActiveSetChanges.Size(m)
+}
+func (m *ActiveSetChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveSetChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveSetChanges proto.InternalMessageInfo
+
+func (m *ActiveSetChanges) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ActiveSetChanges) GetActivatedPublicKeys() [][]byte {
+	if m != nil {
+		return m.ActivatedPublicKeys
+	}
+	return nil
+}
+
+func (m *ActiveSetChanges) GetExitedPublicKeys() [][]byte {
+	if m != nil {
+		return m.ExitedPublicKeys
+	}
+	return nil
+}
+
+func (m *ActiveSetChanges) GetEjectedPublicKeys() [][]byte {
+	if m != nil {
+		return m.EjectedPublicKeys
+	}
+	return nil
+}
+
+type ValidatorQueue struct {
+	ChurnLimit           uint64   `protobuf:"varint,1,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	ActivationPublicKeys [][]byte `protobuf:"bytes,2,rep,name=activation_public_keys,json=activationPublicKeys,proto3" json:"activation_public_keys,omitempty"`
+	ExitPublicKeys       [][]byte `protobuf:"bytes,3,rep,name=exit_public_keys,json=exitPublicKeys,proto3" json:"exit_public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorQueue) Reset()         { *m = ValidatorQueue{} }
+func (m *ValidatorQueue) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueue) ProtoMessage()    {}
+func (*ValidatorQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{17}
+}
+
+func (m *ValidatorQueue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorQueue.Unmarshal(m, b)
+}
+func (m *ValidatorQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorQueue.Marshal(b, m, deterministic)
+}
+func (m *ValidatorQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorQueue.Merge(m, src)
+}
+func (m *ValidatorQueue) XXX_Size() int {
+	return xxx_messageInfo_ValidatorQueue.Size(m)
+}
+func (m *ValidatorQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorQueue proto.InternalMessageInfo
+
+func (m *ValidatorQueue) GetChurnLimit() uint64 {
+	if m != nil {
+		return m.ChurnLimit
+	}
+	return 0
+}
+
+func (m *ValidatorQueue) GetActivationPublicKeys() [][]byte {
+	if m != nil {
+		return m.ActivationPublicKeys
+	}
+	return nil
+}
+
+func (m *ValidatorQueue) GetExitPublicKeys() [][]byte {
+	if m != nil {
+		return m.ExitPublicKeys
+	}
+	return nil
+}
+
+type ListValidatorAssignmentsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListValidatorAssignmentsRequest) Reset()         { *m = ListValidatorAssignmentsRequest{} }
+func (m *ListValidatorAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorAssignmentsRequest) ProtoMessage()    {}
+func (*ListValidatorAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{18}
+}
+
+func (m *ListValidatorAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValidatorAssignmentsRequest.Unmarshal(m, b)
+}
+func (m *ListValidatorAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValidatorAssignmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListValidatorAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorAssignmentsRequest.Merge(m, src)
+}
+func (m *ListValidatorAssignmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListValidatorAssignmentsRequest.Size(m)
+}
+func (m *ListValidatorAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorAssignmentsRequest proto.InternalMessageInfo
+
+func (m *ListValidatorAssignmentsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListValidatorAssignmentsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListValidatorAssignmentsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorAssignments struct {
+	Epoch                uint64                                      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Assignments          []*ValidatorAssignments_CommitteeAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	NextPageToken        string                                      `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                                       `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *ValidatorAssignments) Reset()         { *m = ValidatorAssignments{} }
+func (m *ValidatorAssignments) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAssignments) ProtoMessage()    {}
+func (*ValidatorAssignments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{19}
+}
+
+func (m *ValidatorAssignments) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAssignments.Unmarshal(m, b)
+}
+func (m *ValidatorAssignments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAssignments.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAssignments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAssignments.Merge(m, src)
+}
+func (m *ValidatorAssignments) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAssignments.Size(m)
+}
+func (m *ValidatorAssignments) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAssignments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAssignments proto.InternalMessageInfo
+
+func (m *ValidatorAssignments) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorAssignments) GetAssignments() []*ValidatorAssignments_CommitteeAssignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+func (m *ValidatorAssignments) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorAssignments) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorAssignments_CommitteeAssignment struct {
+	CrosslinkCommittees  []uint64 `protobuf:"varint,1,rep,packed,name=crosslink_committees,json=crosslinkCommittees,proto3" json:"crosslink_committees,omitempty"`
+	Shard                uint64   `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Proposer             bool     `protobuf:"varint,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) Reset() {
+	*m = ValidatorAssignments_CommitteeAssignment{}
+}
+func (m *ValidatorAssignments_CommitteeAssignment) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAssignments_CommitteeAssignment) ProtoMessage()    {}
+func (*ValidatorAssignments_CommitteeAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{19, 0}
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAssignments_CommitteeAssignment.Unmarshal(m, b)
+}
+func (m *ValidatorAssignments_CommitteeAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAssignments_CommitteeAssignment.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAssignments_CommitteeAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAssignments_CommitteeAssignment.Merge(m, src)
+}
+func (m *ValidatorAssignments_CommitteeAssignment) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAssignments_CommitteeAssignment.Size(m)
+}
+func (m *ValidatorAssignments_CommitteeAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAssignments_CommitteeAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAssignments_CommitteeAssignment proto.InternalMessageInfo
+
+func (m *ValidatorAssignments_CommitteeAssignment) GetCrosslinkCommittees() []uint64 {
+	if m != nil {
+		return m.CrosslinkCommittees
+	}
+	return nil
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) GetProposer() bool {
+	if m != nil {
+		return m.Proposer
+	}
+	return false
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type GetValidatorParticipationRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetValidatorParticipationRequest) Reset()         { *m = GetValidatorParticipationRequest{} }
+func (m *GetValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorParticipationRequest) ProtoMessage()    {}
+func (*GetValidatorParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{20}
+}
+
+func (m *GetValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorParticipationRequest.Unmarshal(m, b)
+}
+func (m *GetValidatorParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorParticipationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorParticipationRequest.Merge(m, src)
+}
+func (m *GetValidatorParticipationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorParticipationRequest.Size(m)
+}
+func (m *GetValidatorParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorParticipationRequest proto.InternalMessageInfo
+
+func (m *GetValidatorParticipationRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ValidatorParticipation struct {
+	Epoch                   uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Finalized               bool     `protobuf:"varint,2,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	GlobalParticipationRate float32  `protobuf:"fixed32,3,opt,name=global_participation_rate,json=globalParticipationRate,proto3" json:"global_participation_rate,omitempty"`
+	VotedEther              uint64   `protobuf:"varint,4,opt,name=voted_ether,json=votedEther,proto3" json:"voted_ether,omitempty"`
+	EligibleEther           uint64   `protobuf:"varint,5,opt,name=eligible_ether,json=eligibleEther,proto3" json:"eligible_ether,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{} }
+func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipation) ProtoMessage()    {}
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{21}
+}
+
+func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorParticipation.Unmarshal(m, b)
+}
+func (m *ValidatorParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorParticipation.Marshal(b, m, deterministic)
+}
+func (m *ValidatorParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipation.Merge(m, src)
+}
+func (m *ValidatorParticipation) XXX_Size() int {
+	return xxx_messageInfo_ValidatorParticipation.Size(m)
+}
+func (m *ValidatorParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipation proto.InternalMessageInfo
+
+func (m *ValidatorParticipation) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *ValidatorParticipation) GetGlobalParticipationRate() float32 {
+	if m != nil {
+		return m.GlobalParticipationRate
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetVotedEther() uint64 {
+	if m != nil {
+		return m.VotedEther
+	}
+	return 0
+}
+
+func (m *ValidatorParticipation) GetEligibleEther() uint64 {
+	if m != nil {
+		return m.EligibleEther
+	}
+	return 0
+}
+
+type ListValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListValidatorRewardsRequest) Reset()         { *m = ListValidatorRewardsRequest{} }
+func (m *ListValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListValidatorRewardsRequest) ProtoMessage()    {}
+func (*ListValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{22}
+}
+
+func (m *ListValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Unmarshal(m, b)
+}
+func (m *ListValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatorRewardsRequest.Merge(m, src)
+}
+func (m *ListValidatorRewardsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListValidatorRewardsRequest.Size(m)
+}
+func (m *ListValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatorRewardsRequest proto.InternalMessageInfo
+
+func (m *ListValidatorRewardsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ListValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ListValidatorRewardsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListValidatorRewardsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorRewards struct {
+	Epoch                uint64                     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewards_Reward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextPageToken        string                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                      `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{23}
+}
+
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRewards.Unmarshal(m, b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRewards.Size(m)
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorRewards) GetRewards() []*ValidatorRewards_Reward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *ValidatorRewards) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorRewards) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type ValidatorRewards_Reward struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Reward               int64    `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRewards_Reward) Reset()         { *m = ValidatorRewards_Reward{} }
+func (m *ValidatorRewards_Reward) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards_Reward) ProtoMessage()    {}
+func (*ValidatorRewards_Reward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{23, 0}
+}
+
+func (m *ValidatorRewards_Reward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRewards_Reward.Unmarshal(m, b)
+}
+func (m *ValidatorRewards_Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRewards_Reward.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRewards_Reward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards_Reward.Merge(m, src)
+}
+func (m *ValidatorRewards_Reward) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRewards_Reward.Size(m)
+}
+func (m *ValidatorRewards_Reward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards_Reward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards_Reward proto.InternalMessageInfo
+
+func (m *ValidatorRewards_Reward) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorRewards_Reward) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorRewards_Reward) GetReward() int64 {
+	if m != nil {
+		return m.Reward
+	}
+	return 0
+}
+
+type AttestationPoolResponse struct {
+	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
+func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationPoolResponse) ProtoMessage()    {}
+func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{24}
+}
+
+func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationPoolResponse.Unmarshal(m, b)
+}
+func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+}
+func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+}
+func (m *AttestationPoolResponse) XXX_Size() int {
+	return xxx_messageInfo_AttestationPoolResponse.Size(m)
+}
+func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+
+func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
+	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
+	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
+	proto.RegisterType((*SlotRange)(nil), "ethereum.eth.v1alpha1.SlotRange")
+	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
+	proto.RegisterType((*BeaconBlockContainer)(nil), "ethereum.eth.v1alpha1.BeaconBlockContainer")
+	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
+	proto.RegisterType((*StreamValidatorRegistryChangesRequest)(nil), "ethereum.eth.v1alpha1.StreamValidatorRegistryChangesRequest")
+	proto.RegisterType((*ValidatorRegistryChanges)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryChanges")
+	proto.RegisterType((*ValidatorRegistryChanges_Change)(nil), "ethereum.eth.v1alpha1.ValidatorRegistryChanges.Change")
+	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
+	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
+	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
+	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
+	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
+	proto.RegisterType((*ListCommitteesRequest)(nil), "ethereum.eth.v1alpha1.ListCommitteesRequest")
+	proto.RegisterType((*BeaconCommittees)(nil), "ethereum.eth.v1alpha1.BeaconCommittees")
+	proto.RegisterType((*BeaconCommittees_CommitteeItem)(nil), "ethereum.eth.v1alpha1.BeaconCommittees.CommitteeItem")
+	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
+	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
+	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
+	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
+	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
+	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
+	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
+	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
+	proto.RegisterType((*ListValidatorRewardsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.eth.v1alpha1.ValidatorRewards")
+	proto.RegisterType((*ValidatorRewards_Reward)(nil), "ethereum.eth.v1alpha1.ValidatorRewards.Reward")
+	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+}
+
+var fileDescriptor_678c88b69c3c78d4 = []byte{
+	// 2171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0xd4, 0x07, 0x9f, 0xbe, 0xc7, 0xb4, 0x4c, 0xd3, 0x76, 0x44, 0xaf, 0x2d, 0x8b,
+	0x8e, 0x6c, 0xd2, 0x56, 0x1c, 0xc7, 0x70, 0x52, 0xa4, 0x96, 0xa0, 0x5a, 0x6e, 0x7d, 0x50, 0xd7,
+	0x69, 0x0e, 0x05, 0x0a, 0x62, 0xb8, 0x1c, 0x91, 0x13, 0x2f, 0x77, 0xd7, 0xbb, 0x43, 0x55, 0x12,
+	0x7a, 0x69, 0x51, 0x14, 0x08, 0x7a, 0x2c, 0x10, 0xa0, 0x87, 0x16, 0x01, 0x7a, 0x0c, 0x7a, 0x0a,
+	0xd0, 0x1e, 0x7a, 0x68, 0x81, 0xdc, 0x8b, 0x02, 0xbd, 0xe7, 0x94, 0xbf, 0x20, 0x40, 0x0f, 0xbd,
+	0x05, 0x33, 0xb3, 0xdf, 0xdc, 0x21, 0x69, 0x40, 0x97, 0x9c, 0xc8, 0x79, 0xf3, 0x3e, 0x7e, 0xef,
+	0xbd, 0x79, 0x6f, 0x3e, 0x16, 0x36, 0x5d, 0xcf, 0x61, 0x4e, 0x8b, 0xb0, 0x7e, 0xeb, 0xf8, 0x01,
+	0xb6, 0xdc, 0x3e, 0x7e, 0xd0, 0xea, 0x10, 0x6c, 0x3a, 0x76, 0xdb, 0xec, 0x63, 0x6a, 0x37, 0xc5,
+	0x3c, 0xba, 0x44, 0x58, 0x9f, 0x78, 0x64, 0x38, 0x68, 0x12, 0xd6, 0x6f, 0x86, 0x9c, 0xb5, 0x7b,
+	0x3d, 0xca, 0xfa, 0xc3, 0x4e, 0xd3, 0x74, 0x06, 0xad, 0x9e, 0xd3, 0x73, 0x5a, 0x82, 0xbb, 0x33,
+	0x3c, 0x12, 0x23, 0xa9, 0x9a, 0xff, 0x93, 0x5a, 0x6a, 0xd7, 0x7a, 0x8e, 0xd3, 0xb3, 0x48, 0x0b,
+	0xbb, 0xb4, 0x85, 0x6d, 0xdb, 0x61, 0x98, 0x51, 0xc7, 0xf6, 0x83, 0xd9, 0xab, 0xc1, 0x6c, 0xa4,
+	0x83, 0x0c, 0x5c, 0x76, 0x1a, 0x4c, 0xde, 0xca, 0xc1, 0x89, 0x19, 0x23, 0xbe, 0xd4, 0x11, 0x70,
+	0x8d, 0xf1, 0xa6, 0x63, 0x39, 0xe6, 0xab, 0x80, 0x4d, 0xcf, 0x61, 0x3b, 0xc6, 0x16, 0xed, 0x62,
+	0xe6, 0x78, 0x92, 0x47, 0x3f, 0x81, 0xcb, 0x2f, 0xa8, 0xcf, 0x9e, 0xc6, 0x36, 0x7c, 0x83, 0xbc,
+	0x1e, 0x12, 0x9f, 0xa1, 0x0d, 0x00, 0xa1, 0xad, 0xed, 0x39, 0x0e, 0xab, 0x6a, 0x75, 0xad, 0xb1,
+	0x78, 0x70, 0xc1, 0x28, 0x0b, 0x9a, 0xe1, 0x38, 0x0c, 0x55, 0xa0, 0xe4, 0x5b, 0x0e, 0xab, 0x16,
+	0xea, 0x5a, 0xa3, 0x74, 0x70, 0xc1, 0x10, 0x23, 0xb4, 0x0e, 0x33, 0xc4, 0x75, 0xcc, 0x7e, 0xb5,
+	0x18, 0x90, 0xe5, 0x70, 0x77, 0x19, 0x16, 0x5f, 0x0f, 0x89, 0x77, 0xda, 0x3e, 0xa2, 0x16, 0x23,
+	0x9e, 0xde, 0x81, 0xea, 0xa8, 0x65, 0xdf, 0x75, 0x6c, 0x9f, 0xa0, 0x1f, 0xc1, 0x62, 0xc2, 0x6b,
+	0xbf, 0xaa, 0xd5, 0x8b, 0x8d, 0x85, 0x1d, 0xbd, 0x99, 0x9b, 0x9e, 0x66, 0x42, 0x85, 0x91, 0x92,
+	0xd3, 0x3f, 0x2d, 0xc0, 0x1a, 0x37, 0xb2, 0xcb, 0x31, 0x47, 0x8e, 0x55, 0xa0, 0x94, 0x72, 0x49,
+	0x8c, 0xde, 0xcc, 0x1b, 0xf4, 0x14, 0x80, 0xcf, 0xb7, 0x3d, 0x6c, 0xf7, 0x48, 0xb5, 0x54, 0xd7,
+	0x1a, 0x0b, 0x3b, 0x75, 0x05, 0xbe, 0x97, 0x96, 0xc3, 0x0c, 0xce, 0xc7, 0xc3, 0xe7, 0x87, 0x03,
+	0x74, 0x03, 0x16, 0x5c, 0xec, 0x11, 0x9b, 0xc9, 0x00, 0xcf, 0x04, 0x68, 0x40, 0x12, 0x45, 0x84,
+	0xaf, 0x42, 0xd9, 0xc5, 0x3d, 0xd2, 0xf6, 0xe9, 0x19, 0xa9, 0xce, 0xd6, 0xb5, 0xc6, 0x8c, 0x31,
+	0xcf, 0x09, 0x2f, 0xe9, 0x19, 0x41, 0xd7, 0x01, 0xc4, 0x24, 0x73, 0x5e, 0x11, 0xbb, 0x3a, 0x57,
+	0xd7, 0x1a, 0x65, 0x43, 0xb0, 0x7f, 0xc4, 0x09, 0x23, 0xf1, 0xde, 0x87, 0x72, 0x04, 0x84, 0xcb,
+	0xfa, 0x0c, 0x7b, 0xac, 0x2d, 0x5c, 0xe6, 0x81, 0x28, 0x19, 0x65, 0x41, 0xe1, 0x3c, 0xe8, 0x0a,
+	0xcc, 0x13, 0xbb, 0xdb, 0x8e, 0xe3, 0x61, 0xcc, 0x11, 0xbb, 0xcb, 0xa7, 0xf4, 0x2f, 0x35, 0x40,
+	0xc9, 0x90, 0x06, 0x19, 0xfb, 0x18, 0x56, 0xe5, 0x62, 0x31, 0x1d, 0x9b, 0x61, 0x6a, 0x13, 0x2f,
+	0xcc, 0xda, 0xb6, 0x22, 0x2a, 0xbb, 0x62, 0xc1, 0x0a, 0x35, 0x7b, 0xa1, 0x8c, 0xb1, 0xd2, 0x49,
+	0x8d, 0x7d, 0x74, 0x1b, 0x56, 0x6c, 0x72, 0xc2, 0xda, 0x09, 0x4f, 0x0b, 0xc2, 0xd3, 0x25, 0x4e,
+	0x3e, 0x0c, 0xbd, 0xe5, 0x0e, 0x31, 0x87, 0x61, 0x4b, 0x86, 0xaa, 0x28, 0x42, 0x55, 0x16, 0x14,
+	0x1e, 0x2b, 0xfd, 0x73, 0x0d, 0x2a, 0x79, 0x06, 0xd1, 0x63, 0x98, 0x11, 0x26, 0x45, 0x0c, 0xd4,
+	0x4b, 0x2c, 0x21, 0x6b, 0x48, 0x01, 0x74, 0x3f, 0x55, 0x1e, 0x1c, 0xd4, 0xe2, 0xee, 0xda, 0xb7,
+	0x5f, 0x6f, 0x2c, 0xf9, 0xfe, 0xd9, 0x3d, 0x8e, 0xe2, 0x89, 0xfe, 0xce, 0x8e, 0x9e, 0xac, 0x97,
+	0x6b, 0x50, 0x36, 0xb1, 0xed, 0xd8, 0xd4, 0xc4, 0x96, 0x80, 0x38, 0x6f, 0xc4, 0x04, 0xfd, 0xdf,
+	0x25, 0x28, 0xef, 0xf1, 0x5e, 0x74, 0x40, 0x70, 0x37, 0xa3, 0x5d, 0x9b, 0x42, 0xfb, 0xf5, 0x50,
+	0x22, 0x91, 0x35, 0x39, 0x2d, 0x52, 0xba, 0x09, 0xcb, 0x47, 0xd4, 0xc6, 0x16, 0x3d, 0x23, 0x41,
+	0x62, 0xc5, 0x8a, 0x36, 0x96, 0x22, 0xaa, 0x60, 0xdb, 0x83, 0x4a, 0xcc, 0x96, 0x40, 0x50, 0x52,
+	0x21, 0x40, 0x11, 0xfb, 0x6e, 0x04, 0x65, 0x13, 0x96, 0x3f, 0x19, 0xfa, 0x8c, 0x1e, 0xd1, 0xd0,
+	0xd6, 0x8c, 0xb4, 0x15, 0x51, 0x43, 0x5b, 0x31, 0x5b, 0xc2, 0xd6, 0xac, 0xd2, 0x56, 0xc4, 0x1e,
+	0xdb, 0x7a, 0x04, 0x97, 0x5d, 0x8f, 0x1c, 0x53, 0x67, 0xe8, 0xb7, 0x33, 0x46, 0xe7, 0x84, 0xd1,
+	0x4b, 0xe1, 0xf4, 0x8f, 0x53, 0xc6, 0x3f, 0x82, 0xeb, 0x39, 0x72, 0x09, 0x14, 0xf3, 0x2a, 0x14,
+	0xb5, 0x11, 0x85, 0x31, 0x9a, 0x2d, 0x58, 0x89, 0xc3, 0x27, 0x1b, 0x47, 0x59, 0xa0, 0x88, 0x83,
+	0xbf, 0x2f, 0xfa, 0xc7, 0x16, 0xac, 0xc4, 0x56, 0x25, 0x23, 0x48, 0xc6, 0x88, 0x2c, 0x19, 0x1f,
+	0x43, 0x35, 0x07, 0xa7, 0x94, 0x58, 0x10, 0x12, 0xeb, 0x23, 0x78, 0x84, 0xa4, 0xfe, 0x0b, 0xd8,
+	0x7c, 0xc9, 0x3c, 0x82, 0x07, 0x1f, 0x87, 0x3d, 0xdf, 0x20, 0x3d, 0xea, 0x33, 0xef, 0x74, 0xaf,
+	0xcf, 0x9b, 0x40, 0xd4, 0x0f, 0x1f, 0xc2, 0x82, 0x3b, 0xec, 0x58, 0xd4, 0x6c, 0xbf, 0x22, 0xa7,
+	0xb2, 0x6c, 0x17, 0x77, 0x2f, 0x7e, 0xfb, 0xf5, 0xc6, 0x4a, 0xec, 0xf8, 0x87, 0x77, 0x1f, 0x3e,
+	0xd6, 0x0d, 0x90, 0x7c, 0x3f, 0x21, 0xa7, 0xbe, 0xfe, 0xf7, 0x22, 0x54, 0x55, 0x9a, 0x51, 0x25,
+	0x6c, 0x9b, 0xb2, 0xb5, 0x04, 0x4d, 0x73, 0x13, 0x96, 0x23, 0x5f, 0xe4, 0xb4, 0x5c, 0xa6, 0x4b,
+	0x21, 0x55, 0xba, 0x7c, 0x08, 0x73, 0xa6, 0xd4, 0x53, 0x2d, 0x8a, 0x16, 0xf2, 0x48, 0x51, 0x95,
+	0x2a, 0xf3, 0x4d, 0xf9, 0x6b, 0x84, 0x6a, 0x6a, 0xbf, 0x2f, 0xc0, 0xac, 0xa4, 0xf1, 0xc2, 0x8a,
+	0x9d, 0xcd, 0x2f, 0x2c, 0xee, 0x69, 0x39, 0xf2, 0x94, 0xfb, 0x42, 0xed, 0x2e, 0x39, 0x09, 0xc0,
+	0xca, 0x01, 0x2f, 0x66, 0x6c, 0x32, 0x7a, 0x8c, 0x19, 0xe9, 0x86, 0xc5, 0x1c, 0x11, 0xd0, 0x3a,
+	0xcc, 0x92, 0x13, 0xca, 0xa7, 0x4a, 0x62, 0x2a, 0x18, 0xa1, 0x2a, 0xcc, 0xf9, 0x16, 0xf6, 0xfb,
+	0xa4, 0x2b, 0x4a, 0x62, 0xde, 0x08, 0x87, 0xe8, 0x03, 0xa8, 0xc5, 0xb1, 0x39, 0x3a, 0x22, 0x5c,
+	0x15, 0x69, 0x77, 0xb0, 0x85, 0x6d, 0x53, 0xf6, 0xfe, 0x92, 0x11, 0xad, 0x84, 0xfd, 0x90, 0x61,
+	0x57, 0xce, 0xa3, 0x6d, 0x58, 0x1b, 0x15, 0x92, 0xeb, 0x7f, 0x95, 0x64, 0x98, 0xf5, 0x7f, 0x6a,
+	0x70, 0xf5, 0x19, 0x61, 0x51, 0xf4, 0x02, 0x7a, 0x62, 0x7f, 0xcc, 0x4b, 0x5e, 0x66, 0x95, 0x14,
+	0xa6, 0x5a, 0x25, 0xdc, 0x61, 0x6a, 0x77, 0xa9, 0x19, 0xe4, 0xb2, 0x64, 0x84, 0xc3, 0xf4, 0xde,
+	0x56, 0x1a, 0xbb, 0xb7, 0xcd, 0x64, 0xf6, 0x36, 0xfd, 0x8b, 0x02, 0xac, 0x8d, 0xc0, 0x47, 0x2f,
+	0x60, 0x3e, 0x70, 0x3d, 0xdc, 0x7b, 0xee, 0x4f, 0x5a, 0x38, 0xa1, 0x6c, 0x33, 0xf8, 0x63, 0x44,
+	0x1a, 0xe2, 0x28, 0x14, 0x92, 0x51, 0xc8, 0xd9, 0x8f, 0x8a, 0x93, 0xf7, 0xa3, 0x52, 0x66, 0x3f,
+	0xaa, 0xbd, 0x82, 0xb9, 0x30, 0x75, 0xe7, 0xb5, 0x20, 0xab, 0x30, 0x17, 0x26, 0x5e, 0x76, 0xf6,
+	0x70, 0xa8, 0x7f, 0xa6, 0x41, 0x25, 0x99, 0xef, 0x28, 0xd1, 0xeb, 0xa9, 0x44, 0xc7, 0x87, 0x9b,
+	0x1a, 0xcc, 0xf5, 0x88, 0x4d, 0x7c, 0xea, 0x0b, 0x13, 0xf3, 0x07, 0x17, 0x8c, 0x90, 0x90, 0x4e,
+	0x5b, 0x71, 0x6c, 0xda, 0x4a, 0x93, 0x8e, 0x24, 0x5f, 0x68, 0x00, 0x31, 0x2a, 0xc5, 0xba, 0xfb,
+	0x21, 0x40, 0x74, 0x68, 0x95, 0xcb, 0x4e, 0x7d, 0xd2, 0x8a, 0x1b, 0x42, 0x42, 0xe6, 0x9c, 0x72,
+	0xa6, 0x53, 0xb8, 0xc4, 0x0f, 0x3e, 0x7b, 0xce, 0x60, 0x40, 0x19, 0x23, 0x93, 0xea, 0x25, 0x15,
+	0xa8, 0xc2, 0xd8, 0x40, 0x15, 0xb3, 0xeb, 0xfb, 0x9b, 0x02, 0xac, 0xca, 0x23, 0x47, 0x6c, 0x4d,
+	0x61, 0xe6, 0x67, 0x00, 0x66, 0xc4, 0x13, 0x84, 0xe7, 0xdd, 0xb1, 0xa7, 0x98, 0x58, 0x65, 0x33,
+	0xfa, 0xfb, 0x9c, 0x91, 0x81, 0x91, 0x50, 0x84, 0x1e, 0xc2, 0x3a, 0x96, 0xdd, 0x24, 0x0a, 0x64,
+	0xdb, 0x74, 0x86, 0x76, 0x78, 0x6c, 0xa8, 0xc8, 0xd9, 0x28, 0xe0, 0x7b, 0x7c, 0x2e, 0x2f, 0xd2,
+	0xa5, 0xc9, 0x91, 0x9e, 0xc9, 0x56, 0xc7, 0x11, 0x2c, 0xa5, 0x90, 0x21, 0x14, 0x9c, 0xcd, 0xa5,
+	0xe7, 0xf2, 0x64, 0x5e, 0x81, 0x19, 0xbf, 0x8f, 0xbd, 0x6e, 0x58, 0x05, 0x62, 0xc0, 0x1b, 0x61,
+	0x0c, 0x38, 0xdd, 0x79, 0x56, 0xa3, 0x89, 0xe7, 0x92, 0xae, 0xbf, 0x0f, 0x37, 0x93, 0x75, 0xf1,
+	0x54, 0xb8, 0xf4, 0x92, 0xb0, 0xcc, 0xfe, 0x98, 0x1b, 0x78, 0xfd, 0xff, 0x1a, 0xac, 0x66, 0x25,
+	0x14, 0x39, 0x7a, 0x06, 0x97, 0xa2, 0xad, 0xa1, 0x3d, 0x65, 0x13, 0xbd, 0x18, 0x49, 0x1c, 0xc6,
+	0xdd, 0xf4, 0x29, 0x20, 0xb9, 0x91, 0xa4, 0xb4, 0x14, 0xd5, 0x5a, 0x56, 0x25, 0x7b, 0x42, 0xc5,
+	0x1e, 0x5c, 0x24, 0x9f, 0x10, 0x33, 0xab, 0xa3, 0xa4, 0xd6, 0xb1, 0x16, 0xf0, 0xc7, 0x4a, 0xf4,
+	0x7f, 0x68, 0xb0, 0x1c, 0x85, 0xed, 0xa7, 0x43, 0x32, 0x24, 0x68, 0x03, 0x16, 0xcc, 0xfe, 0xd0,
+	0xb3, 0xdb, 0x16, 0x1d, 0xd0, 0x30, 0x53, 0x20, 0x48, 0x2f, 0x38, 0x05, 0x3d, 0x0f, 0x56, 0x94,
+	0xb8, 0x9a, 0x4d, 0x1b, 0x85, 0x4a, 0x2c, 0x92, 0xf0, 0xe1, 0x07, 0x20, 0xfc, 0x9a, 0x36, 0x08,
+	0xcb, 0x9c, 0x39, 0x81, 0xfe, 0x2b, 0x0d, 0x36, 0x78, 0x25, 0xc7, 0x89, 0xf7, 0x7d, 0xda, 0xb3,
+	0x07, 0xc4, 0x66, 0xdf, 0xa3, 0x3d, 0xf0, 0x8f, 0x45, 0xa8, 0xe4, 0x79, 0xa0, 0x80, 0x8e, 0x61,
+	0x01, 0xc7, 0x4c, 0x41, 0xa3, 0xf8, 0x70, 0x52, 0x1f, 0x4d, 0xe8, 0x8d, 0x9b, 0x45, 0x4c, 0x34,
+	0x92, 0x3a, 0xcf, 0x6b, 0x6f, 0xfc, 0x97, 0x06, 0x17, 0x73, 0x6c, 0xa1, 0x07, 0x50, 0x31, 0x3d,
+	0xc7, 0xf7, 0x2d, 0x6a, 0xf3, 0x6b, 0x66, 0xd4, 0xf3, 0x34, 0x11, 0xd3, 0x8b, 0xd1, 0x5c, 0xba,
+	0x65, 0xe6, 0xf4, 0x88, 0xb0, 0x9b, 0x14, 0x13, 0xdd, 0xa4, 0x06, 0xf3, 0xae, 0xe7, 0xb8, 0x8e,
+	0x4f, 0xbc, 0xe0, 0xc8, 0x16, 0x8d, 0x33, 0x3b, 0xf4, 0xcc, 0xe4, 0x1d, 0x5a, 0x7f, 0x0c, 0xf5,
+	0x64, 0x63, 0x39, 0xc4, 0x1e, 0xa3, 0x26, 0x75, 0xe5, 0x13, 0xc5, 0xd8, 0xae, 0xf2, 0x1f, 0x0d,
+	0xd6, 0xf3, 0xe5, 0x14, 0x79, 0xbd, 0x06, 0xe5, 0xe8, 0x6a, 0x21, 0x77, 0x6b, 0x23, 0x26, 0xa0,
+	0x27, 0x70, 0xa5, 0x67, 0x39, 0x1d, 0x6c, 0xb5, 0xdd, 0xa4, 0xae, 0xb6, 0x87, 0x99, 0xdc, 0xbd,
+	0x0b, 0xc6, 0x65, 0xc9, 0x90, 0xc6, 0x88, 0x99, 0xa8, 0xe8, 0x63, 0x87, 0xf7, 0x09, 0xb1, 0x46,
+	0x44, 0x54, 0x4a, 0x06, 0x08, 0xd2, 0x3e, 0xa7, 0xf0, 0xe3, 0x3c, 0xb1, 0x68, 0x8f, 0x76, 0x2c,
+	0x12, 0xf0, 0x04, 0xd7, 0xbc, 0x90, 0x2a, 0xd8, 0xc4, 0x71, 0x33, 0x55, 0x6e, 0x06, 0xf9, 0x25,
+	0xf6, 0xba, 0xdf, 0xa3, 0x52, 0xfb, 0x4b, 0x01, 0x56, 0xb3, 0xe8, 0x15, 0xb0, 0x0f, 0x60, 0xce,
+	0x93, 0x0c, 0x41, 0x89, 0x35, 0x27, 0xdf, 0x5d, 0x04, 0x7b, 0x53, 0xfe, 0x1a, 0xa1, 0xf8, 0x79,
+	0x55, 0x53, 0x1f, 0x66, 0xa5, 0xe6, 0x73, 0x3b, 0x68, 0xae, 0xc3, 0xac, 0xc4, 0x28, 0xf0, 0x14,
+	0x8d, 0x60, 0xa4, 0x63, 0xb8, 0x9c, 0x78, 0x89, 0x3b, 0x74, 0x1c, 0xeb, 0xbc, 0xdf, 0xf3, 0x76,
+	0xfe, 0x87, 0x60, 0x21, 0x38, 0xc4, 0xf4, 0x31, 0xb5, 0xd1, 0x9f, 0x35, 0x58, 0xcd, 0x3e, 0x22,
+	0x22, 0x55, 0xc4, 0x15, 0xef, 0x9c, 0xb5, 0xd6, 0xd4, 0xfc, 0xd2, 0x1b, 0xfd, 0xce, 0x6f, 0xfe,
+	0xfb, 0xcd, 0x1f, 0x0a, 0x37, 0xd1, 0x8d, 0xbc, 0x17, 0xd8, 0xe4, 0x73, 0xad, 0x8f, 0x3e, 0xd5,
+	0x60, 0x25, 0x13, 0x14, 0xb4, 0xde, 0x94, 0x2f, 0xc0, 0xcd, 0xf0, 0x05, 0xb8, 0xb9, 0x3f, 0x70,
+	0xd9, 0x69, 0xad, 0x39, 0x39, 0x1c, 0xc9, 0xa0, 0xea, 0x4d, 0x01, 0xa3, 0x81, 0x6e, 0x4f, 0x84,
+	0xd1, 0x72, 0xb9, 0xdd, 0xdf, 0x6a, 0x80, 0xe4, 0x83, 0x40, 0x2a, 0x5c, 0x2a, 0x38, 0x53, 0x64,
+	0x47, 0xbf, 0x2f, 0x20, 0xbc, 0x8d, 0x1a, 0x93, 0x21, 0xf8, 0xc2, 0xf2, 0x7d, 0x0d, 0xfd, 0x4e,
+	0x03, 0x88, 0x1f, 0x10, 0x51, 0x63, 0x4c, 0xf4, 0x53, 0xcf, 0xb6, 0xb5, 0x3b, 0x53, 0x70, 0x06,
+	0xa1, 0xb9, 0x29, 0x70, 0x5d, 0x47, 0x57, 0x73, 0x71, 0x75, 0xa4, 0x65, 0x17, 0x16, 0x9f, 0x89,
+	0x93, 0x5b, 0xf0, 0xe4, 0xa6, 0x0a, 0x84, 0xea, 0xb2, 0x11, 0x49, 0xea, 0xb7, 0x85, 0xb9, 0x3a,
+	0x7a, 0x2b, 0xd7, 0x9c, 0xf8, 0xc0, 0xd0, 0xe7, 0x16, 0x4e, 0x60, 0x51, 0x26, 0x20, 0xf0, 0xfd,
+	0x4d, 0x43, 0x9f, 0x78, 0x85, 0xd4, 0xdf, 0x16, 0x36, 0x6f, 0x21, 0x7d, 0x8c, 0x8b, 0x71, 0xd0,
+	0x7f, 0x05, 0x2b, 0xd2, 0xf2, 0x79, 0xb8, 0x7b, 0x4f, 0x98, 0xde, 0x42, 0x9b, 0xe3, 0xdd, 0x8d,
+	0xad, 0x7f, 0xa5, 0xc1, 0x5b, 0xe3, 0x9f, 0xa2, 0xd0, 0x07, 0xaa, 0xb7, 0xf3, 0x69, 0x5e, 0xb0,
+	0x94, 0x25, 0xac, 0x92, 0x53, 0x2d, 0xdc, 0xf8, 0xd2, 0xd8, 0xf2, 0x02, 0x89, 0xd8, 0x8b, 0xcf,
+	0x35, 0x79, 0x01, 0x1c, 0x7d, 0x78, 0xd8, 0x51, 0x98, 0x1f, 0xf3, 0xc8, 0x52, 0x6b, 0x4c, 0xfb,
+	0x34, 0xa1, 0x6a, 0x37, 0x09, 0xac, 0xd1, 0x9b, 0xc5, 0xaf, 0x35, 0x58, 0x4a, 0xdd, 0xf4, 0xd1,
+	0xf6, 0x14, 0xd0, 0x22, 0x4c, 0x37, 0x26, 0x61, 0xf2, 0xf5, 0xba, 0x00, 0x53, 0x43, 0x55, 0x15,
+	0x18, 0xf4, 0x99, 0x06, 0x15, 0x51, 0x92, 0xd9, 0xfb, 0xeb, 0xdd, 0x31, 0xf5, 0x3b, 0x72, 0xa9,
+	0xae, 0x6d, 0x4d, 0x79, 0x87, 0xd5, 0xb7, 0x04, 0xa2, 0x1b, 0x68, 0x23, 0x7f, 0x35, 0xc6, 0xf6,
+	0xff, 0xa6, 0xc1, 0xb5, 0x71, 0xd7, 0x3d, 0xf4, 0x64, 0x8a, 0x58, 0x29, 0xee, 0x88, 0x4a, 0xb8,
+	0x59, 0x7e, 0xfd, 0x81, 0x80, 0xbb, 0x8d, 0xee, 0x28, 0xb3, 0x29, 0x6f, 0xd6, 0x3e, 0x61, 0xc1,
+	0xeb, 0x25, 0x3a, 0x83, 0xb5, 0x24, 0x04, 0x79, 0xdf, 0x52, 0x95, 0xef, 0xe6, 0xa4, 0x1c, 0x0a,
+	0x71, 0x55, 0xcb, 0x4a, 0xc0, 0x78, 0x2d, 0xcc, 0xfc, 0x55, 0x93, 0x9f, 0xe9, 0x72, 0x6f, 0x1a,
+	0x8f, 0xc6, 0x64, 0x74, 0xcc, 0xe5, 0xaa, 0xb6, 0xfd, 0x06, 0xd7, 0x0e, 0xfd, 0xae, 0x40, 0x7a,
+	0x1b, 0xdd, 0x52, 0x07, 0x2c, 0x01, 0xe9, 0x4b, 0x0d, 0xae, 0x28, 0x8f, 0xde, 0xe8, 0xbd, 0x29,
+	0x32, 0x9c, 0x77, 0x58, 0xaf, 0xdd, 0x9b, 0x84, 0x38, 0x25, 0xa5, 0xda, 0x9a, 0x13, 0x98, 0x53,
+	0xc7, 0x71, 0xf4, 0xa7, 0xa0, 0x66, 0x46, 0x0e, 0x99, 0x3b, 0xd3, 0x44, 0x38, 0x7d, 0x9e, 0x56,
+	0x2e, 0xc5, 0x2c, 0xbf, 0xde, 0x10, 0x28, 0x75, 0x54, 0x1f, 0xd3, 0x04, 0x05, 0xe7, 0xee, 0x7b,
+	0x3f, 0x7f, 0x37, 0xf1, 0x09, 0xdc, 0xf5, 0x4e, 0xfd, 0x01, 0x66, 0xd4, 0xb4, 0x70, 0xc7, 0x97,
+	0xa3, 0xd6, 0xe8, 0xa7, 0xe6, 0xf7, 0x09, 0xeb, 0x77, 0x66, 0x05, 0xfd, 0x9d, 0xef, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xa1, 0x76, 0x3b, 0x88, 0x80, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconChainClient is the client API for BeaconChain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainClient interface {
+	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
+	AttestationPool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
+	StreamAttestations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamAttestationsClient, error)
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
+	GetChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainHead, error)
+	StreamBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamBlocksClient, error)
+	StreamChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamChainHeadClient, error)
+	StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryChangesClient, error)
+	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
+	ListBeaconCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*BeaconCommittees, error)
+	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
+	GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
+	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
+	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+	ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error)
+}
+
+type beaconChainClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
+	return &beaconChainClient{cc}
+}
+
+func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
+	out := new(ListAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) AttestationPool(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
+	out := new(AttestationPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) StreamAttestations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[0], "/ethereum.eth.v1alpha1.BeaconChain/StreamAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamAttestationsClient interface {
+	Recv() (*Attestation, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamAttestationsClient) Recv() (*Attestation, error) {
+	m := new(Attestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
+	out := new(ListBlocksResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainHead, error) {
+	out := new(ChainHead)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) StreamBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[1], "/ethereum.eth.v1alpha1.BeaconChain/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamBlocksClient interface {
+	Recv() (*BeaconBlock, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamBlocksClient) Recv() (*BeaconBlock, error) {
+	m := new(BeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainClient) StreamChainHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconChain_StreamChainHeadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[2], "/ethereum.eth.v1alpha1.BeaconChain/StreamChainHead", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamChainHeadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamChainHeadClient interface {
+	Recv() (*ChainHead, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamChainHeadClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamChainHeadClient) Recv() (*ChainHead, error) {
+	m := new(ChainHead)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainClient) StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChain_StreamValidatorRegistryChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChain_serviceDesc.Streams[3], "/ethereum.eth.v1alpha1.BeaconChain/StreamValidatorRegistryChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamValidatorRegistryChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChain_StreamValidatorRegistryChangesClient interface {
+	Recv() (*ValidatorRegistryChanges, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamValidatorRegistryChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamValidatorRegistryChangesClient) Recv() (*ValidatorRegistryChanges, error) {
+	m := new(ValidatorRegistryChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
+	out := new(ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
+	out := new(Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListBeaconCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*BeaconCommittees, error) {
+	out := new(BeaconCommittees)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
+	out := new(ActiveSetChanges)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
+	out := new(ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
+	out := new(ValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
+	out := new(ValidatorParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconChainClient) ListValidatorRewards(ctx context.Context, in *ListValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error) {
+	out := new(ValidatorRewards)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconChainServer is the server API for BeaconChain service.
+type BeaconChainServer interface {
+	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
+	AttestationPool(context.Context, *empty.Empty) (*AttestationPoolResponse, error)
+	StreamAttestations(*empty.Empty, BeaconChain_StreamAttestationsServer) error
+	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
+	GetChainHead(context.Context, *empty.Empty) (*ChainHead, error)
+	StreamBlocks(*empty.Empty, BeaconChain_StreamBlocksServer) error
+	StreamChainHead(*empty.Empty, BeaconChain_StreamChainHeadServer) error
+	StreamValidatorRegistryChanges(*StreamValidatorRegistryChangesRequest, BeaconChain_StreamValidatorRegistryChangesServer) error
+	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
+	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
+	ListBeaconCommittees(context.Context, *ListCommitteesRequest) (*BeaconCommittees, error)
+	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
+	GetValidatorQueue(context.Context, *empty.Empty) (*ValidatorQueue, error)
+	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
+	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+	ListValidatorRewards(context.Context, *ListValidatorRewardsRequest) (*ValidatorRewards, error)
+}
+
+func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
+	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+}
+
+func _BeaconChain_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).AttestationPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).AttestationPool(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_StreamAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamAttestations(m, &beaconChainStreamAttestationsServer{stream})
+}
+
+type BeaconChain_StreamAttestationsServer interface {
+	Send(*Attestation) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamAttestationsServer) Send(m *Attestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListBlocks(ctx, req.(*ListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetChainHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetChainHead(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamBlocks(m, &beaconChainStreamBlocksServer{stream})
+}
+
+type BeaconChain_StreamBlocksServer interface {
+	Send(*BeaconBlock) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamBlocksServer) Send(m *BeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_StreamChainHead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamChainHead(m, &beaconChainStreamChainHeadServer{stream})
+}
+
+type BeaconChain_StreamChainHeadServer interface {
+	Send(*ChainHead) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamChainHeadServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamChainHeadServer) Send(m *ChainHead) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_StreamValidatorRegistryChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamValidatorRegistryChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainServer).StreamValidatorRegistryChanges(m, &beaconChainStreamValidatorRegistryChangesServer{stream})
+}
+
+type BeaconChain_StreamValidatorRegistryChangesServer interface {
+	Send(*ValidatorRegistryChanges) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamValidatorRegistryChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamValidatorRegistryChangesServer) Send(m *ValidatorRegistryChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, req.(*GetValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_ListBeaconCommittees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListBeaconCommittees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBeaconCommittees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListBeaconCommittees(ctx, req.(*ListCommitteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorActiveSetChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, req.(*GetValidatorActiveSetChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_ListValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, req.(*ListValidatorAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconChain_ListValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorRewards(ctx, req.(*ListValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconChain_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
+	HandlerType: (*BeaconChainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttestations",
+			Handler:    _BeaconChain_ListAttestations_Handler,
+		},
+		{
+			MethodName: "AttestationPool",
+			Handler:    _BeaconChain_AttestationPool_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _BeaconChain_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetChainHead",
+			Handler:    _BeaconChain_GetChainHead_Handler,
+		},
+		{
+			MethodName: "ListValidatorBalances",
+			Handler:    _BeaconChain_ListValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _BeaconChain_GetValidators_Handler,
+		},
+		{
+			MethodName: "ListBeaconCommittees",
+			Handler:    _BeaconChain_ListBeaconCommittees_Handler,
+		},
+		{
+			MethodName: "GetValidatorActiveSetChanges",
+			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueue",
+			Handler:    _BeaconChain_GetValidatorQueue_Handler,
+		},
+		{
+			MethodName: "ListValidatorAssignments",
+			Handler:    _BeaconChain_ListValidatorAssignments_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipation",
+			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "ListValidatorRewards",
+			Handler:    _BeaconChain_ListValidatorRewards_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAttestations",
+			Handler:       _BeaconChain_StreamAttestations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _BeaconChain_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamChainHead",
+			Handler:       _BeaconChain_StreamChainHead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamValidatorRegistryChanges",
+			Handler:       _BeaconChain_StreamValidatorRegistryChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
+}
@@ -0,0 +1,1008 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/eth/v1alpha1/node.proto
+
+package eth
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SyncStatus struct {
+	Syncing              bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
+func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
+func (*SyncStatus) ProtoMessage()    {}
+func (*SyncStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{0}
+}
+
+func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatus.Unmarshal(m, b)
+}
+func (m *SyncStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatus.Marshal(b, m, deterministic)
+}
+func (m *SyncStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatus.Merge(m, src)
+}
+func (m *SyncStatus) XXX_Size() int {
+	return xxx_messageInfo_SyncStatus.Size(m)
+}
+func (m *SyncStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
+
+func (m *SyncStatus) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+type Genesis struct {
+	GenesisTime            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositContractAddress []byte               `protobuf:"bytes,2,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	GenesisValidatorsRoot  []byte               `protobuf:"bytes,3,opt,name=genesis_validators_root,json=genesisValidatorsRoot,proto3" json:"genesis_validators_root,omitempty"`
+	GenesisForkVersion     []byte               `protobuf:"bytes,4,opt,name=genesis_fork_version,json=genesisForkVersion,proto3" json:"genesis_fork_version,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *Genesis) Reset()         { *m = Genesis{} }
+func (m *Genesis) String() string { return proto.CompactTextString(m) }
+func (*Genesis) ProtoMessage()    {}
+func (*Genesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{1}
+}
+
+func (m *Genesis) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Genesis.Unmarshal(m, b)
+}
+func (m *Genesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Genesis.Marshal(b, m, deterministic)
+}
+func (m *Genesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Genesis.Merge(m, src)
+}
+func (m *Genesis) XXX_Size() int {
+	return xxx_messageInfo_Genesis.Size(m)
+}
+func (m *Genesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_Genesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Genesis proto.InternalMessageInfo
+
+func (m *Genesis) GetGenesisTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return nil
+}
+
+func (m *Genesis) GetDepositContractAddress() []byte {
+	if m != nil {
+		return m.DepositContractAddress
+	}
+	return nil
+}
+
+func (m *Genesis) GetGenesisValidatorsRoot() []byte {
+	if m != nil {
+		return m.GenesisValidatorsRoot
+	}
+	return nil
+}
+
+func (m *Genesis) GetGenesisForkVersion() []byte {
+	if m != nil {
+		return m.GenesisForkVersion
+	}
+	return nil
+}
+
+type Version struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Metadata             string   `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{2}
+}
+
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Version) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+type ImplementedServices struct {
+	Services             []string `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImplementedServices) Reset()         { *m = ImplementedServices{} }
+func (m *ImplementedServices) String() string { return proto.CompactTextString(m) }
+func (*ImplementedServices) ProtoMessage()    {}
+func (*ImplementedServices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{3}
+}
+
+func (m *ImplementedServices) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImplementedServices.Unmarshal(m, b)
+}
+func (m *ImplementedServices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImplementedServices.Marshal(b, m, deterministic)
+}
+func (m *ImplementedServices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImplementedServices.Merge(m, src)
+}
+func (m *ImplementedServices) XXX_Size() int {
+	return xxx_messageInfo_ImplementedServices.Size(m)
+}
+func (m *ImplementedServices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImplementedServices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImplementedServices proto.InternalMessageInfo
+
+func (m *ImplementedServices) GetServices() []string {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type HostData struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HostData) Reset()         { *m = HostData{} }
+func (m *HostData) String() string { return proto.CompactTextString(m) }
+func (*HostData) ProtoMessage()    {}
+func (*HostData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{4}
+}
+
+func (m *HostData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HostData.Unmarshal(m, b)
+}
+func (m *HostData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HostData.Marshal(b, m, deterministic)
+}
+func (m *HostData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostData.Merge(m, src)
+}
+func (m *HostData) XXX_Size() int {
+	return xxx_messageInfo_HostData.Size(m)
+}
+func (m *HostData) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostData proto.InternalMessageInfo
+
+func (m *HostData) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *HostData) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type Peer struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Reputation           int64    `protobuf:"varint,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Peer) Reset()         { *m = Peer{} }
+func (m *Peer) String() string { return proto.CompactTextString(m) }
+func (*Peer) ProtoMessage()    {}
+func (*Peer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{5}
+}
+
+func (m *Peer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Peer.Unmarshal(m, b)
+}
+func (m *Peer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Peer.Marshal(b, m, deterministic)
+}
+func (m *Peer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peer.Merge(m, src)
+}
+func (m *Peer) XXX_Size() int {
+	return xxx_messageInfo_Peer.Size(m)
+}
+func (m *Peer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peer proto.InternalMessageInfo
+
+func (m *Peer) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *Peer) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *Peer) GetReputation() int64 {
+	if m != nil {
+		return m.Reputation
+	}
+	return 0
+}
+
+type Peers struct {
+	Peers                []*Peer  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Peers) Reset()         { *m = Peers{} }
+func (m *Peers) String() string { return proto.CompactTextString(m) }
+func (*Peers) ProtoMessage()    {}
+func (*Peers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{6}
+}
+
+func (m *Peers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Peers.Unmarshal(m, b)
+}
+func (m *Peers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Peers.Marshal(b, m, deterministic)
+}
+func (m *Peers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Peers.Merge(m, src)
+}
+func (m *Peers) XXX_Size() int {
+	return xxx_messageInfo_Peers.Size(m)
+}
+func (m *Peers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Peers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Peers proto.InternalMessageInfo
+
+func (m *Peers) GetPeers() []*Peer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type FeatureFlags struct {
+	Enabled              []string `protobuf:"bytes,1,rep,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeatureFlags) Reset()         { *m = FeatureFlags{} }
+func (m *FeatureFlags) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlags) ProtoMessage()    {}
+func (*FeatureFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{7}
+}
+
+func (m *FeatureFlags) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureFlags.Unmarshal(m, b)
+}
+func (m *FeatureFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureFlags.Marshal(b, m, deterministic)
+}
+func (m *FeatureFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlags.Merge(m, src)
+}
+func (m *FeatureFlags) XXX_Size() int {
+	return xxx_messageInfo_FeatureFlags.Size(m)
+}
+func (m *FeatureFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureFlags proto.InternalMessageInfo
+
+func (m *FeatureFlags) GetEnabled() []string {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+type Eth1Status struct {
+	Connected             bool                 `protobuf:"varint,1,opt,name=connected,proto3" json:"connected,omitempty"`
+	ConnectionError       string               `protobuf:"bytes,2,opt,name=connection_error,json=connectionError,proto3" json:"connection_error,omitempty"`
+	LatestBlockNumber     uint64               `protobuf:"varint,3,opt,name=latest_block_number,json=latestBlockNumber,proto3" json:"latest_block_number,omitempty"`
+	LatestBlockHash       []byte               `protobuf:"bytes,4,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	LatestBlockTime       *timestamp.Timestamp `protobuf:"bytes,5,opt,name=latest_block_time,json=latestBlockTime,proto3" json:"latest_block_time,omitempty"`
+	DepositCount          uint64               `protobuf:"varint,6,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	ProcessedDepositCount uint64               `protobuf:"varint,7,opt,name=processed_deposit_count,json=processedDepositCount,proto3" json:"processed_deposit_count,omitempty"`
+	PendingDepositCount   uint64               `protobuf:"varint,8,opt,name=pending_deposit_count,json=pendingDepositCount,proto3" json:"pending_deposit_count,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
+}
+
+func (m *Eth1Status) Reset()         { *m = Eth1Status{} }
+func (m *Eth1Status) String() string { return proto.CompactTextString(m) }
+func (*Eth1Status) ProtoMessage()    {}
+func (*Eth1Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{8}
+}
+
+func (m *Eth1Status) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1Status.Unmarshal(m, b)
+}
+func (m *Eth1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1Status.Marshal(b, m, deterministic)
+}
+func (m *Eth1Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1Status.Merge(m, src)
+}
+func (m *Eth1Status) XXX_Size() int {
+	return xxx_messageInfo_Eth1Status.Size(m)
+}
+func (m *Eth1Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1Status proto.InternalMessageInfo
+
+func (m *Eth1Status) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *Eth1Status) GetConnectionError() string {
+	if m != nil {
+		return m.ConnectionError
+	}
+	return ""
+}
+
+func (m *Eth1Status) GetLatestBlockNumber() uint64 {
+	if m != nil {
+		return m.LatestBlockNumber
+	}
+	return 0
+}
+
+func (m *Eth1Status) GetLatestBlockHash() []byte {
+	if m != nil {
+		return m.LatestBlockHash
+	}
+	return nil
+}
+
+func (m *Eth1Status) GetLatestBlockTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LatestBlockTime
+	}
+	return nil
+}
+
+func (m *Eth1Status) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *Eth1Status) GetProcessedDepositCount() uint64 {
+	if m != nil {
+		return m.ProcessedDepositCount
+	}
+	return 0
+}
+
+func (m *Eth1Status) GetPendingDepositCount() uint64 {
+	if m != nil {
+		return m.PendingDepositCount
+	}
+	return 0
+}
+
+type ScheduledFork struct {
+	Version              []byte   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduledFork) Reset()         { *m = ScheduledFork{} }
+func (m *ScheduledFork) String() string { return proto.CompactTextString(m) }
+func (*ScheduledFork) ProtoMessage()    {}
+func (*ScheduledFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{9}
+}
+
+func (m *ScheduledFork) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledFork.Unmarshal(m, b)
+}
+func (m *ScheduledFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledFork.Marshal(b, m, deterministic)
+}
+func (m *ScheduledFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledFork.Merge(m, src)
+}
+func (m *ScheduledFork) XXX_Size() int {
+	return xxx_messageInfo_ScheduledFork.Size(m)
+}
+func (m *ScheduledFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledFork proto.InternalMessageInfo
+
+func (m *ScheduledFork) GetVersion() []byte {
+	if m != nil {
+		return m.Version
+	}
+	return nil
+}
+
+func (m *ScheduledFork) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ForkSchedule struct {
+	Forks                []*ScheduledFork `protobuf:"bytes,1,rep,name=forks,proto3" json:"forks,omitempty"`
+	CurrentFork          *ScheduledFork   `protobuf:"bytes,2,opt,name=current_fork,json=currentFork,proto3" json:"current_fork,omitempty"`
+	CurrentEpoch         uint64           `protobuf:"varint,3,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ForkSchedule) Reset()         { *m = ForkSchedule{} }
+func (m *ForkSchedule) String() string { return proto.CompactTextString(m) }
+func (*ForkSchedule) ProtoMessage()    {}
+func (*ForkSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{10}
+}
+
+func (m *ForkSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkSchedule.Unmarshal(m, b)
+}
+func (m *ForkSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkSchedule.Marshal(b, m, deterministic)
+}
+func (m *ForkSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkSchedule.Merge(m, src)
+}
+func (m *ForkSchedule) XXX_Size() int {
+	return xxx_messageInfo_ForkSchedule.Size(m)
+}
+func (m *ForkSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkSchedule proto.InternalMessageInfo
+
+func (m *ForkSchedule) GetForks() []*ScheduledFork {
+	if m != nil {
+		return m.Forks
+	}
+	return nil
+}
+
+func (m *ForkSchedule) GetCurrentFork() *ScheduledFork {
+	if m != nil {
+		return m.CurrentFork
+	}
+	return nil
+}
+
+func (m *ForkSchedule) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SyncStatus)(nil), "ethereum.eth.v1alpha1.SyncStatus")
+	proto.RegisterType((*Genesis)(nil), "ethereum.eth.v1alpha1.Genesis")
+	proto.RegisterType((*Version)(nil), "ethereum.eth.v1alpha1.Version")
+	proto.RegisterType((*ImplementedServices)(nil), "ethereum.eth.v1alpha1.ImplementedServices")
+	proto.RegisterType((*HostData)(nil), "ethereum.eth.v1alpha1.HostData")
+	proto.RegisterType((*Peer)(nil), "ethereum.eth.v1alpha1.Peer")
+	proto.RegisterType((*Peers)(nil), "ethereum.eth.v1alpha1.Peers")
+	proto.RegisterType((*FeatureFlags)(nil), "ethereum.eth.v1alpha1.FeatureFlags")
+	proto.RegisterType((*Eth1Status)(nil), "ethereum.eth.v1alpha1.Eth1Status")
+	proto.RegisterType((*ScheduledFork)(nil), "ethereum.eth.v1alpha1.ScheduledFork")
+	proto.RegisterType((*ForkSchedule)(nil), "ethereum.eth.v1alpha1.ForkSchedule")
+}
+
+func init() { proto.RegisterFile("proto/eth/v1alpha1/node.proto", fileDescriptor_98054421e2cad574) }
+
+var fileDescriptor_98054421e2cad574 = []byte{
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0xdc, 0x46,
+	0x14, 0xd7, 0x86, 0x3f, 0x0b, 0x8f, 0x45, 0x24, 0x43, 0x08, 0xdb, 0x85, 0x04, 0x6a, 0xaa, 0x8a,
+	0xe6, 0xb0, 0xdb, 0xa5, 0xea, 0x1f, 0xa5, 0xaa, 0xa2, 0xa4, 0x01, 0x12, 0xa9, 0x8a, 0x2a, 0x53,
+	0xe5, 0x50, 0xa9, 0xb2, 0x66, 0xed, 0xc7, 0xda, 0xc2, 0x9e, 0xb1, 0x66, 0x9e, 0x51, 0xb9, 0xe6,
+	0xd8, 0x6b, 0xbf, 0x4c, 0xbf, 0x47, 0xbf, 0x42, 0x8f, 0x3d, 0xf7, 0x5c, 0xcd, 0x78, 0x86, 0x5d,
+	0x60, 0x4d, 0x4b, 0x6e, 0x7e, 0x7f, 0x7e, 0xef, 0x37, 0xf3, 0xfc, 0xde, 0xcf, 0x86, 0xc7, 0xa5,
+	0x92, 0x24, 0x07, 0x48, 0xe9, 0xe0, 0x7c, 0xc8, 0xf3, 0x32, 0xe5, 0xc3, 0x81, 0x90, 0x09, 0xf6,
+	0xad, 0x9f, 0x6d, 0x20, 0xa5, 0xa8, 0xb0, 0x2a, 0xfa, 0x48, 0x69, 0xdf, 0x67, 0xf4, 0xb6, 0xc7,
+	0x52, 0x8e, 0x73, 0x1c, 0xf0, 0x32, 0x1b, 0x70, 0x21, 0x24, 0x71, 0xca, 0xa4, 0xd0, 0x35, 0xa8,
+	0xb7, 0xe5, 0xa2, 0xd6, 0x1a, 0x55, 0xa7, 0x03, 0x2c, 0x4a, 0xba, 0x70, 0xc1, 0x9d, 0xeb, 0x41,
+	0xca, 0x0a, 0xd4, 0xc4, 0x8b, 0xb2, 0x4e, 0x08, 0x3e, 0x05, 0x38, 0xb9, 0x10, 0xf1, 0x09, 0x71,
+	0xaa, 0x34, 0xeb, 0x42, 0x5b, 0x5f, 0x88, 0x38, 0x13, 0xe3, 0x6e, 0x6b, 0xb7, 0xb5, 0xbf, 0x14,
+	0x7a, 0x33, 0xf8, 0xbb, 0x05, 0xed, 0x63, 0x14, 0xa8, 0x33, 0xcd, 0xbe, 0x83, 0xce, 0xb8, 0x7e,
+	0x8c, 0x4c, 0x39, 0x9b, 0xba, 0x72, 0xd0, 0xeb, 0xd7, 0x5c, 0x7d, 0xcf, 0xd5, 0xff, 0xc9, 0x73,
+	0x85, 0x2b, 0x2e, 0xdf, 0x78, 0xd8, 0x37, 0xd0, 0x4d, 0xb0, 0x94, 0x3a, 0xa3, 0x28, 0x96, 0x82,
+	0x14, 0x8f, 0x29, 0xe2, 0x49, 0xa2, 0x50, 0xeb, 0xee, 0xbd, 0xdd, 0xd6, 0x7e, 0x27, 0x7c, 0xe4,
+	0xe2, 0xdf, 0xbb, 0xf0, 0x8b, 0x3a, 0xca, 0xbe, 0x82, 0x4d, 0x4f, 0x7c, 0xce, 0xf3, 0x2c, 0xe1,
+	0x24, 0x95, 0x8e, 0x94, 0x94, 0xd4, 0x9d, 0xb3, 0xc0, 0x0d, 0x17, 0x7e, 0x77, 0x19, 0x0d, 0xa5,
+	0x24, 0xf6, 0x39, 0x3c, 0xf4, 0xb8, 0x53, 0xa9, 0xce, 0xa2, 0x73, 0x54, 0x3a, 0x93, 0xa2, 0x3b,
+	0x6f, 0x41, 0xcc, 0xc5, 0x8e, 0xa4, 0x3a, 0x7b, 0x57, 0x47, 0x82, 0xe7, 0xd0, 0x76, 0x8f, 0xa6,
+	0x27, 0x3e, 0xdf, 0x5c, 0x74, 0x39, 0xf4, 0x26, 0xeb, 0xc1, 0x52, 0x81, 0xc4, 0x13, 0x4e, 0xdc,
+	0x1e, 0x7c, 0x39, 0xbc, 0xb4, 0x83, 0x21, 0xac, 0xbf, 0x29, 0xca, 0x1c, 0x0b, 0x14, 0x84, 0xc9,
+	0x09, 0xaa, 0xf3, 0x2c, 0x46, 0x6d, 0x20, 0xda, 0x3d, 0x77, 0x5b, 0xbb, 0x73, 0x06, 0xe2, 0xed,
+	0xe0, 0x05, 0x2c, 0xbd, 0x96, 0x9a, 0x5e, 0x71, 0xe2, 0x6c, 0x13, 0xda, 0x25, 0xa2, 0x8a, 0xb2,
+	0xc4, 0x91, 0x2e, 0x1a, 0xf3, 0x4d, 0xc2, 0xb6, 0x61, 0xd9, 0xf5, 0x0a, 0x4d, 0xb7, 0x4c, 0x85,
+	0x89, 0x23, 0xf8, 0x05, 0xe6, 0x7f, 0x44, 0x54, 0x1f, 0x08, 0x67, 0x4f, 0x00, 0x14, 0x96, 0x55,
+	0x3d, 0x5f, 0xb6, 0xa5, 0x73, 0xe1, 0x94, 0x27, 0x78, 0x06, 0x0b, 0xa6, 0xbc, 0x66, 0x43, 0x58,
+	0x30, 0x05, 0xeb, 0x3b, 0xac, 0x1c, 0x6c, 0xf5, 0x67, 0x0e, 0x6e, 0xdf, 0x24, 0x87, 0x75, 0x66,
+	0xb0, 0x0f, 0x9d, 0x23, 0xe4, 0x54, 0x29, 0x3c, 0xca, 0xf9, 0xd8, 0x8e, 0x1a, 0x0a, 0x3e, 0xca,
+	0x31, 0x71, 0x8d, 0xf0, 0x66, 0xf0, 0xdb, 0x1c, 0xc0, 0x21, 0xa5, 0x43, 0x37, 0x93, 0xdb, 0xb0,
+	0x1c, 0x4b, 0x21, 0x30, 0x26, 0x4c, 0xdc, 0x54, 0x4e, 0x1c, 0xec, 0x33, 0xb8, 0xef, 0x8c, 0x4c,
+	0x8a, 0x08, 0x95, 0x92, 0xca, 0xbd, 0x8b, 0xb5, 0x89, 0xff, 0xd0, 0xb8, 0x59, 0x1f, 0xd6, 0x73,
+	0x4e, 0xa8, 0x29, 0x1a, 0xe5, 0x32, 0x3e, 0x8b, 0x44, 0x55, 0x8c, 0x50, 0xd9, 0x6b, 0xce, 0x87,
+	0x0f, 0xea, 0xd0, 0x4b, 0x13, 0x79, 0x6b, 0x03, 0xec, 0x29, 0x3c, 0xb8, 0x92, 0x9f, 0x72, 0x9d,
+	0xba, 0x91, 0x59, 0x9b, 0xca, 0x7e, 0xcd, 0x75, 0xca, 0x8e, 0xae, 0xe5, 0xda, 0xbd, 0x58, 0xf8,
+	0xcf, 0xbd, 0x98, 0xae, 0x63, 0x77, 0x63, 0x0f, 0x56, 0x27, 0xbb, 0x51, 0x09, 0xea, 0x2e, 0xda,
+	0xd3, 0x75, 0x2e, 0x17, 0xa2, 0x12, 0x64, 0xd6, 0xa0, 0x54, 0x32, 0x36, 0xef, 0x2c, 0x89, 0xae,
+	0xa6, 0xb7, 0x6d, 0xfa, 0xc6, 0x65, 0xf8, 0xd5, 0x34, 0xee, 0x00, 0x36, 0x4a, 0x14, 0x49, 0x26,
+	0xc6, 0xd7, 0x50, 0x4b, 0x16, 0xb5, 0xee, 0x82, 0xd3, 0x98, 0xe0, 0x39, 0xac, 0x9e, 0xc4, 0x29,
+	0x26, 0x55, 0x8e, 0x89, 0x59, 0x90, 0xeb, 0xeb, 0xd0, 0x99, 0xac, 0xc3, 0x43, 0x58, 0xc0, 0x52,
+	0xc6, 0xa9, 0xed, 0xff, 0x7c, 0x58, 0x1b, 0xc1, 0x1f, 0x2d, 0xe8, 0x18, 0xa0, 0xaf, 0xc2, 0x9e,
+	0xc1, 0x82, 0x59, 0x42, 0x3f, 0x3b, 0x9f, 0x34, 0xcc, 0xce, 0x15, 0xd6, 0xb0, 0x86, 0xb0, 0x63,
+	0xe8, 0xc4, 0x95, 0x52, 0x28, 0xc8, 0x2e, 0xb2, 0x65, 0xfa, 0xbf, 0x25, 0x56, 0x1c, 0xd2, 0xde,
+	0x62, 0x0f, 0x56, 0x7d, 0xa1, 0xfa, 0xcc, 0xf5, 0x14, 0xf8, 0xea, 0x87, 0xc6, 0x77, 0xf0, 0x4f,
+	0x1b, 0xe6, 0xdf, 0xca, 0x04, 0x99, 0x80, 0xd5, 0x63, 0xa4, 0x29, 0x9d, 0x7c, 0x74, 0xe3, 0x9d,
+	0x1e, 0x1a, 0xd1, 0xed, 0x7d, 0xdc, 0x74, 0x92, 0x4b, 0x68, 0x10, 0xbc, 0xff, 0xf3, 0xaf, 0xdf,
+	0xef, 0x6d, 0xb3, 0xde, 0xcd, 0xaf, 0xc0, 0xc0, 0x89, 0x2d, 0x4b, 0x01, 0x8e, 0x91, 0xbc, 0xdc,
+	0x36, 0x91, 0x3d, 0x69, 0x20, 0x73, 0xb8, 0x5b, 0x99, 0x9c, 0xe4, 0x39, 0x26, 0x2f, 0x75, 0x77,
+	0x65, 0xf2, 0x6a, 0x79, 0x1b, 0x93, 0x9f, 0x8e, 0xf7, 0x2d, 0xd8, 0xfc, 0x21, 0xd3, 0x34, 0x4b,
+	0x15, 0x9b, 0x78, 0x9f, 0x36, 0xf0, 0xce, 0xa8, 0x11, 0xec, 0xd9, 0x33, 0x3c, 0x66, 0x5b, 0xb3,
+	0xfa, 0xea, 0x89, 0x62, 0xf3, 0x11, 0x23, 0xa3, 0xb2, 0x8d, 0x9c, 0x3b, 0x0d, 0x9c, 0x5e, 0x9a,
+	0x83, 0x1d, 0x4b, 0xf4, 0x11, 0xdb, 0x9c, 0x41, 0x94, 0x9a, 0xca, 0x31, 0x2c, 0x9b, 0x8b, 0xd6,
+	0x4a, 0xd9, 0x44, 0xb3, 0x7d, 0x8b, 0x64, 0xea, 0x60, 0xd7, 0x72, 0xf4, 0x58, 0x77, 0x06, 0x87,
+	0x95, 0x53, 0x46, 0x70, 0xdf, 0x90, 0x5c, 0x91, 0xd4, 0x26, 0xae, 0xbd, 0x06, 0xae, 0x69, 0xf0,
+	0xad, 0xfd, 0x3b, 0xad, 0x13, 0x35, 0x3b, 0xb3, 0x8b, 0x30, 0x25, 0xce, 0x77, 0x5d, 0x84, 0x09,
+	0xf4, 0xd6, 0x3e, 0x22, 0xa5, 0x43, 0xf6, 0x2b, 0xac, 0x1d, 0x23, 0x5d, 0xd1, 0x8e, 0x3b, 0xdf,
+	0x70, 0x0a, 0x1c, 0xec, 0x5b, 0xc2, 0x80, 0xed, 0xce, 0xba, 0xa1, 0xf9, 0x2d, 0xd0, 0x2e, 0xf3,
+	0xe5, 0xd7, 0x3f, 0x7f, 0x39, 0xce, 0x28, 0xad, 0x46, 0xfd, 0x58, 0x16, 0x83, 0x52, 0x5d, 0xe8,
+	0x82, 0x53, 0x16, 0xe7, 0x7c, 0xa4, 0x6b, 0x6b, 0x70, 0xf3, 0x3f, 0xee, 0x5b, 0xa4, 0x74, 0xb4,
+	0x68, 0xfd, 0x5f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x45, 0xa6, 0xf8, 0xe8, 0x09, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeClient interface {
+	GetSyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatus, error)
+	GetGenesis(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Genesis, error)
+	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error)
+	ListImplementedServices(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ImplementedServices, error)
+	GetHost(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HostData, error)
+	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Peers, error)
+	ListFeatureFlags(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeatureFlags, error)
+	GetEth1Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1Status, error)
+	GetForkSchedule(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForkSchedule, error)
+}
+
+type nodeClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeClient(cc *grpc.ClientConn) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) GetSyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatus, error) {
+	out := new(SyncStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetSyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetGenesis(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Genesis, error) {
+	out := new(Genesis)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Version, error) {
+	out := new(Version)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ListImplementedServices(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ImplementedServices, error) {
+	out := new(ImplementedServices)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListImplementedServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetHost(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HostData, error) {
+	out := new(HostData)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetHost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Peers, error) {
+	out := new(Peers)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ListFeatureFlags(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FeatureFlags, error) {
+	out := new(FeatureFlags)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/ListFeatureFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetEth1Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1Status, error) {
+	out := new(Eth1Status)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetEth1Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetForkSchedule(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForkSchedule, error) {
+	out := new(ForkSchedule)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.Node/GetForkSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeServer is the server API for Node service.
+type NodeServer interface {
+	GetSyncStatus(context.Context, *empty.Empty) (*SyncStatus, error)
+	GetGenesis(context.Context, *empty.Empty) (*Genesis, error)
+	GetVersion(context.Context, *empty.Empty) (*Version, error)
+	ListImplementedServices(context.Context, *empty.Empty) (*ImplementedServices, error)
+	GetHost(context.Context, *empty.Empty) (*HostData, error)
+	ListPeers(context.Context, *empty.Empty) (*Peers, error)
+	ListFeatureFlags(context.Context, *empty.Empty) (*FeatureFlags, error)
+	GetEth1Status(context.Context, *empty.Empty) (*Eth1Status, error)
+	GetForkSchedule(context.Context, *empty.Empty) (*ForkSchedule, error)
+}
+
+func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
+	s.RegisterService(&_Node_serviceDesc, srv)
+}
+
+func _Node_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetSyncStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetGenesis(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetVersion(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListImplementedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListImplementedServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListImplementedServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListImplementedServices(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetHost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetHost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetHost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetHost(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListPeers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/ListFeatureFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ListFeatureFlags(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetEth1Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetEth1Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetEth1Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetEth1Status(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetForkSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetForkSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.Node/GetForkSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetForkSchedule(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Node_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _Node_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetGenesis",
+			Handler:    _Node_GetGenesis_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Node_GetVersion_Handler,
+		},
+		{
+			MethodName: "ListImplementedServices",
+			Handler:    _Node_ListImplementedServices_Handler,
+		},
+		{
+			MethodName: "GetHost",
+			Handler:    _Node_GetHost_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Node_ListPeers_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _Node_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "GetEth1Status",
+			Handler:    _Node_GetEth1Status_Handler,
+		},
+		{
+			MethodName: "GetForkSchedule",
+			Handler:    _Node_GetForkSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1alpha1/node.proto",
+}
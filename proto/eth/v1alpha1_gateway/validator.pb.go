@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/eth/v1alpha1/validator.proto
+
+package eth
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type DutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DutiesRequest) Reset()         { *m = DutiesRequest{} }
+func (m *DutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*DutiesRequest) ProtoMessage()    {}
+func (*DutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{0}
+}
+
+func (m *DutiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesRequest.Unmarshal(m, b)
+}
+func (m *DutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesRequest.Marshal(b, m, deterministic)
+}
+func (m *DutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesRequest.Merge(m, src)
+}
+func (m *DutiesRequest) XXX_Size() int {
+	return xxx_messageInfo_DutiesRequest.Size(m)
+}
+func (m *DutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesRequest proto.InternalMessageInfo
+
+func (m *DutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DutiesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type DutiesResponse struct {
+	Duties               []*DutiesResponse_Duty `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DutiesResponse) Reset()         { *m = DutiesResponse{} }
+func (m *DutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*DutiesResponse) ProtoMessage()    {}
+func (*DutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{1}
+}
+
+func (m *DutiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesResponse.Unmarshal(m, b)
+}
+func (m *DutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesResponse.Marshal(b, m, deterministic)
+}
+func (m *DutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesResponse.Merge(m, src)
+}
+func (m *DutiesResponse) XXX_Size() int {
+	return xxx_messageInfo_DutiesResponse.Size(m)
+}
+func (m *DutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesResponse proto.InternalMessageInfo
+
+func (m *DutiesResponse) GetDuties() []*DutiesResponse_Duty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type DutiesResponse_Duty struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	AttestationSlot      uint64   `protobuf:"varint,2,opt,name=attestation_slot,json=attestationSlot,proto3" json:"attestation_slot,omitempty"`
+	AttestationShard     uint64   `protobuf:"varint,3,opt,name=attestation_shard,json=attestationShard,proto3" json:"attestation_shard,omitempty"`
+	BlockProposalSlot    uint64   `protobuf:"varint,4,opt,name=block_proposal_slot,json=blockProposalSlot,proto3" json:"block_proposal_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DutiesResponse_Duty) Reset()         { *m = DutiesResponse_Duty{} }
+func (m *DutiesResponse_Duty) String() string { return proto.CompactTextString(m) }
+func (*DutiesResponse_Duty) ProtoMessage()    {}
+func (*DutiesResponse_Duty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{1, 0}
+}
+
+func (m *DutiesResponse_Duty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutiesResponse_Duty.Unmarshal(m, b)
+}
+func (m *DutiesResponse_Duty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutiesResponse_Duty.Marshal(b, m, deterministic)
+}
+func (m *DutiesResponse_Duty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutiesResponse_Duty.Merge(m, src)
+}
+func (m *DutiesResponse_Duty) XXX_Size() int {
+	return xxx_messageInfo_DutiesResponse_Duty.Size(m)
+}
+func (m *DutiesResponse_Duty) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutiesResponse_Duty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutiesResponse_Duty proto.InternalMessageInfo
+
+func (m *DutiesResponse_Duty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DutiesResponse_Duty) GetAttestationSlot() uint64 {
+	if m != nil {
+		return m.AttestationSlot
+	}
+	return 0
+}
+
+func (m *DutiesResponse_Duty) GetAttestationShard() uint64 {
+	if m != nil {
+		return m.AttestationShard
+	}
+	return 0
+}
+
+func (m *DutiesResponse_Duty) GetBlockProposalSlot() uint64 {
+	if m != nil {
+		return m.BlockProposalSlot
+	}
+	return 0
+}
+
+type BlockRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{2}
+}
+
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRequest.Size(m)
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BlockRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
+}
+
+type AttestationDataRequest struct {
+	ProofOfCustodyBit    []byte   `protobuf:"bytes,1,opt,name=proof_of_custody_bit,json=proofOfCustodyBit,proto3" json:"proof_of_custody_bit,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Shard                uint64   `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationDataRequest) Reset()         { *m = AttestationDataRequest{} }
+func (m *AttestationDataRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationDataRequest) ProtoMessage()    {}
+func (*AttestationDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{3}
+}
+
+func (m *AttestationDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationDataRequest.Unmarshal(m, b)
+}
+func (m *AttestationDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationDataRequest.Marshal(b, m, deterministic)
+}
+func (m *AttestationDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationDataRequest.Merge(m, src)
+}
+func (m *AttestationDataRequest) XXX_Size() int {
+	return xxx_messageInfo_AttestationDataRequest.Size(m)
+}
+func (m *AttestationDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationDataRequest proto.InternalMessageInfo
+
+func (m *AttestationDataRequest) GetProofOfCustodyBit() []byte {
+	if m != nil {
+		return m.ProofOfCustodyBit
+	}
+	return nil
+}
+
+func (m *AttestationDataRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *AttestationDataRequest) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+type Validator struct {
+	PublicKey                  []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentials      []byte   `protobuf:"bytes,2,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty"`
+	EffectiveBalance           uint64   `protobuf:"varint,3,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	Slashed                    bool     `protobuf:"varint,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	ActivationEligibilityEpoch uint64   `protobuf:"varint,5,opt,name=activation_eligibility_epoch,json=activationEligibilityEpoch,proto3" json:"activation_eligibility_epoch,omitempty"`
+	ActivationEpoch            uint64   `protobuf:"varint,6,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	ExitEpoch                  uint64   `protobuf:"varint,7,opt,name=exit_epoch,json=exitEpoch,proto3" json:"exit_epoch,omitempty"`
+	WithdrawableEpoch          uint64   `protobuf:"varint,8,opt,name=withdrawable_epoch,json=withdrawableEpoch,proto3" json:"withdrawable_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86a2b3961d336368, []int{4}
+}
+
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Validator.Unmarshal(m, b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+}
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
+}
+func (m *Validator) XXX_Size() int {
+	return xxx_messageInfo_Validator.Size(m)
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+func (m *Validator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Validator) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+func (m *Validator) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+func (m *Validator) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
+func (m *Validator) GetActivationEligibilityEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEligibilityEpoch
+	}
+	return 0
+}
+
+func (m *Validator) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *Validator) GetExitEpoch() uint64 {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
+}
+
+func (m *Validator) GetWithdrawableEpoch() uint64 {
+	if m != nil {
+		return m.WithdrawableEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DutiesRequest)(nil), "ethereum.eth.v1alpha1.DutiesRequest")
+	proto.RegisterType((*DutiesResponse)(nil), "ethereum.eth.v1alpha1.DutiesResponse")
+	proto.RegisterType((*DutiesResponse_Duty)(nil), "ethereum.eth.v1alpha1.DutiesResponse.Duty")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.eth.v1alpha1.BlockRequest")
+	proto.RegisterType((*AttestationDataRequest)(nil), "ethereum.eth.v1alpha1.AttestationDataRequest")
+	proto.RegisterType((*Validator)(nil), "ethereum.eth.v1alpha1.Validator")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/validator.proto", fileDescriptor_86a2b3961d336368)
+}
+
+var fileDescriptor_86a2b3961d336368 = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xd6, 0x6e, 0x7e, 0x9a, 0x9c, 0x6e, 0x28, 0x99, 0xa4, 0xd1, 0x6a, 0x69, 0xc9, 0x6a, 0x9a,
+	0x54, 0x81, 0xb2, 0x36, 0x4d, 0xcb, 0x8f, 0xca, 0x05, 0xb0, 0x69, 0x14, 0x24, 0x24, 0x40, 0x5b,
+	0x89, 0x8b, 0x72, 0x61, 0x8d, 0xed, 0xb3, 0xeb, 0x51, 0x66, 0x3d, 0xae, 0x67, 0x9c, 0xe2, 0x48,
+	0x48, 0x88, 0x57, 0xe0, 0x02, 0x2e, 0xb8, 0xe1, 0x4d, 0x78, 0x07, 0xee, 0x73, 0xc5, 0x13, 0xe4,
+	0x09, 0x90, 0x67, 0xec, 0xd8, 0x81, 0xb5, 0x9a, 0x3b, 0xcf, 0x39, 0xdf, 0x7c, 0xe7, 0x9b, 0x33,
+	0xf3, 0x1d, 0x03, 0x4d, 0x52, 0xa9, 0xa5, 0x8b, 0x3a, 0x72, 0xcf, 0x1e, 0x33, 0x91, 0x44, 0xec,
+	0xb1, 0x7b, 0xc6, 0x04, 0x0f, 0x99, 0x96, 0xa9, 0x63, 0x92, 0xe4, 0x2e, 0xea, 0x08, 0x53, 0xcc,
+	0xe6, 0x0e, 0xea, 0xc8, 0xa9, 0x60, 0x83, 0xd1, 0x8c, 0xeb, 0x28, 0xf3, 0x9d, 0x40, 0xce, 0xdd,
+	0x99, 0x9c, 0x49, 0xd7, 0xa0, 0xfd, 0x6c, 0x6a, 0x56, 0x96, 0xb7, 0xf8, 0xb2, 0x2c, 0x83, 0x7b,
+	0x33, 0x29, 0x67, 0x02, 0x5d, 0x96, 0x70, 0x97, 0xc5, 0xb1, 0xd4, 0x4c, 0x73, 0x19, 0xab, 0x32,
+	0xfb, 0x4e, 0x99, 0xbd, 0xe2, 0xc0, 0x79, 0xa2, 0xf3, 0x32, 0xb9, 0xbf, 0x40, 0xa4, 0x8f, 0x2c,
+	0x90, 0xb1, 0xe7, 0x0b, 0x19, 0x9c, 0x96, 0xb0, 0xbd, 0x05, 0x30, 0xa6, 0x35, 0x2a, 0x5b, 0xca,
+	0xa2, 0xe8, 0x0f, 0xb0, 0xf1, 0x3c, 0xd3, 0x1c, 0xd5, 0x04, 0x5f, 0x65, 0xa8, 0x34, 0xd9, 0x86,
+	0x15, 0x4c, 0x64, 0x10, 0xf5, 0x3b, 0xc3, 0xce, 0xc1, 0xf2, 0xc4, 0x2e, 0xc8, 0x53, 0xb8, 0x9d,
+	0x64, 0xbe, 0xe0, 0x81, 0x77, 0x8a, 0xb9, 0xea, 0x77, 0x87, 0x4b, 0x07, 0xbd, 0xf1, 0xd6, 0xe5,
+	0xc5, 0xee, 0x1d, 0xa5, 0xce, 0x47, 0x8a, 0x9f, 0xe3, 0x33, 0xfa, 0xf9, 0x07, 0x4f, 0x3f, 0xa5,
+	0x13, 0xb0, 0xb8, 0xaf, 0x31, 0x57, 0xf4, 0xb7, 0x2e, 0xbc, 0x55, 0xb1, 0xab, 0x44, 0xc6, 0x0a,
+	0xc9, 0x18, 0x56, 0x43, 0x13, 0xe9, 0x77, 0x86, 0x4b, 0x07, 0xb7, 0x0f, 0xdf, 0x77, 0x16, 0xb6,
+	0xd3, 0xb9, 0xbe, 0xad, 0x58, 0xe6, 0x93, 0x72, 0xe7, 0xe0, 0xaf, 0x0e, 0x2c, 0x17, 0x01, 0xf2,
+	0x21, 0x40, 0xad, 0xca, 0x08, 0xee, 0x8d, 0x37, 0x2f, 0x2f, 0x76, 0x37, 0x6a, 0x51, 0x85, 0xa4,
+	0xf5, 0x2b, 0x49, 0xe4, 0x3d, 0x78, 0xbb, 0xd1, 0x03, 0x4f, 0x09, 0xa9, 0xfb, 0x5d, 0x73, 0xd0,
+	0x3b, 0x8d, 0xf8, 0x0b, 0x21, 0x35, 0x79, 0x04, 0x9b, 0xd7, 0xa0, 0x11, 0x4b, 0xc3, 0xfe, 0x92,
+	0xc1, 0x36, 0x39, 0x5e, 0x14, 0x71, 0xe2, 0xc0, 0x96, 0xe9, 0xbd, 0x97, 0xa4, 0x32, 0x91, 0x8a,
+	0x09, 0x4b, 0xbd, 0x6c, 0xe0, 0x9b, 0x26, 0xf5, 0x5d, 0x99, 0x29, 0xc8, 0xe9, 0x4b, 0xe8, 0x8d,
+	0x8b, 0x60, 0xd5, 0x75, 0x02, 0xcb, 0x66, 0x83, 0x6d, 0xba, 0xf9, 0x26, 0x1f, 0xc3, 0x46, 0xca,
+	0xe2, 0x90, 0x49, 0x2f, 0xc5, 0x33, 0x64, 0xc2, 0x08, 0x5d, 0x78, 0xc0, 0x9e, 0xc5, 0x4d, 0x0c,
+	0x8c, 0x2a, 0xd8, 0xf9, 0xb2, 0xd6, 0xf7, 0x9c, 0x69, 0x56, 0x55, 0x71, 0x61, 0x3b, 0x49, 0xa5,
+	0x9c, 0x7a, 0x72, 0xea, 0x05, 0x99, 0xd2, 0x32, 0xcc, 0x3d, 0x9f, 0xdb, 0xaa, 0xbd, 0xc9, 0xa6,
+	0xc9, 0x7d, 0x3b, 0x3d, 0xb2, 0x99, 0x31, 0xaf, 0x65, 0x75, 0x1b, 0xb2, 0xb6, 0x61, 0xa5, 0xd9,
+	0x0b, 0xbb, 0xa0, 0x7f, 0x2c, 0xc1, 0xfa, 0xf7, 0x95, 0x53, 0xc8, 0xd1, 0x82, 0x8b, 0xd9, 0xbb,
+	0xbc, 0xd8, 0x1d, 0x5e, 0xd3, 0x3d, 0x54, 0x09, 0x06, 0xa3, 0x98, 0xcd, 0xf1, 0x19, 0x4d, 0x32,
+	0xff, 0x14, 0xf3, 0x6b, 0x77, 0xf5, 0x15, 0xec, 0xbc, 0xe6, 0x3a, 0x0a, 0x53, 0xf6, 0x9a, 0x09,
+	0x2f, 0x48, 0x31, 0xc4, 0x58, 0x73, 0x26, 0xd4, 0xe2, 0x46, 0x3c, 0x39, 0xa4, 0x93, 0xbb, 0xf5,
+	0x86, 0xa3, 0x1a, 0x5f, 0x5c, 0x25, 0x4e, 0xa7, 0x18, 0x68, 0x7e, 0x86, 0x9e, 0xcf, 0x04, 0x8b,
+	0x03, 0xac, 0xae, 0xf2, 0x2a, 0x31, 0xb6, 0x71, 0xd2, 0x87, 0x5b, 0x4a, 0x30, 0x15, 0x61, 0x68,
+	0xae, 0x6f, 0x6d, 0x52, 0x2d, 0xc9, 0x17, 0x70, 0x8f, 0x15, 0x50, 0xfb, 0x20, 0x50, 0xf0, 0x19,
+	0xf7, 0xb9, 0xe0, 0x3a, 0xf7, 0xac, 0x63, 0x56, 0x0c, 0xe3, 0xa0, 0xc6, 0x1c, 0xd7, 0x90, 0x63,
+	0x63, 0xa3, 0xe2, 0xf9, 0x35, 0x18, 0xcc, 0xae, 0xd5, 0xf2, 0xf9, 0xd5, 0xbb, 0x0c, 0xf4, 0x3e,
+	0x00, 0xfe, 0xc8, 0x75, 0x09, 0xba, 0x65, 0x40, 0xeb, 0x45, 0xc4, 0xa6, 0x47, 0x40, 0xae, 0xce,
+	0xea, 0x0b, 0x2c, 0x61, 0x6b, 0xf6, 0xbd, 0x35, 0x33, 0x06, 0x7e, 0xf8, 0xe7, 0x0a, 0x6c, 0x8d,
+	0xcd, 0x8c, 0xf8, 0x46, 0x86, 0x58, 0x5f, 0xd4, 0xcf, 0x1d, 0x58, 0x3f, 0x41, 0x6d, 0xdd, 0x46,
+	0xf6, 0xde, 0x60, 0x46, 0xf3, 0x8a, 0x06, 0xfb, 0x37, 0xb2, 0x2c, 0x7d, 0xf8, 0xcb, 0xdf, 0xff,
+	0xfc, 0xda, 0x1d, 0x92, 0x77, 0x5b, 0xc6, 0xa9, 0x6b, 0xdd, 0x4c, 0xce, 0x61, 0xed, 0x04, 0xb5,
+	0x71, 0x03, 0x79, 0xd0, 0x42, 0xdd, 0xf4, 0xca, 0x80, 0xb6, 0x81, 0xcc, 0xf9, 0x0c, 0x94, 0xee,
+	0x9b, 0xe2, 0xbb, 0xe4, 0x7e, 0x5b, 0x71, 0x63, 0x49, 0xf2, 0x0a, 0x7a, 0xd6, 0x96, 0x68, 0xeb,
+	0xdf, 0x80, 0x7a, 0xb0, 0xe3, 0xd8, 0xe1, 0xec, 0x54, 0xc3, 0xd9, 0x39, 0x2e, 0x86, 0x73, 0x55,
+	0x92, 0xbe, 0xa1, 0xe4, 0xef, 0x1d, 0x20, 0x27, 0xa8, 0xff, 0xe3, 0x50, 0x32, 0x6a, 0xa9, 0xbc,
+	0xd8, 0xc9, 0x83, 0x87, 0x37, 0x83, 0xd3, 0x47, 0x46, 0xd4, 0x3e, 0x79, 0xd0, 0x26, 0xaa, 0x31,
+	0xc9, 0xc8, 0x4f, 0x40, 0xca, 0x6e, 0x34, 0x68, 0x5a, 0x7b, 0xd2, 0xc0, 0xb4, 0xf6, 0xa4, 0x2c,
+	0x4f, 0x6f, 0x52, 0x7e, 0xfc, 0xc9, 0xcb, 0x8f, 0x1a, 0xff, 0xd0, 0x24, 0xcd, 0xd5, 0x9c, 0x69,
+	0x1e, 0x08, 0xe6, 0x2b, 0xbb, 0x72, 0xff, 0xff, 0x47, 0xfb, 0x0c, 0x75, 0xe4, 0xaf, 0x9a, 0xf8,
+	0x93, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xec, 0xbf, 0x62, 0x15, 0xbe, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconNodeValidatorClient is the client API for BeaconNodeValidator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconNodeValidatorClient interface {
+	GetDuties(ctx context.Context, in *DutiesRequest, opts ...grpc.CallOption) (*DutiesResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *BeaconBlock, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetAttestationData(ctx context.Context, in *AttestationDataRequest, opts ...grpc.CallOption) (*AttestationData, error)
+	ProposeAttestation(ctx context.Context, in *Attestation, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type beaconNodeValidatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconNodeValidatorClient(cc *grpc.ClientConn) BeaconNodeValidatorClient {
+	return &beaconNodeValidatorClient{cc}
+}
+
+func (c *beaconNodeValidatorClient) GetDuties(ctx context.Context, in *DutiesRequest, opts ...grpc.CallOption) (*DutiesResponse, error) {
+	out := new(DutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconNodeValidatorClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BeaconBlock, error) {
+	out := new(BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconNodeValidatorClient) ProposeBlock(ctx context.Context, in *BeaconBlock, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconNodeValidatorClient) GetAttestationData(ctx context.Context, in *AttestationDataRequest, opts ...grpc.CallOption) (*AttestationData, error) {
+	out := new(AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetAttestationData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconNodeValidatorClient) ProposeAttestation(ctx context.Context, in *Attestation, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconNodeValidatorServer is the server API for BeaconNodeValidator service.
+type BeaconNodeValidatorServer interface {
+	GetDuties(context.Context, *DutiesRequest) (*DutiesResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*BeaconBlock, error)
+	ProposeBlock(context.Context, *BeaconBlock) (*empty.Empty, error)
+	GetAttestationData(context.Context, *AttestationDataRequest) (*AttestationData, error)
+	ProposeAttestation(context.Context, *Attestation) (*empty.Empty, error)
+}
+
+func RegisterBeaconNodeValidatorServer(s *grpc.Server, srv BeaconNodeValidatorServer) {
+	s.RegisterService(&_BeaconNodeValidator_serviceDesc, srv)
+}
+
+func _BeaconNodeValidator_GetDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconNodeValidatorServer).GetDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconNodeValidatorServer).GetDuties(ctx, req.(*DutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconNodeValidator_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconNodeValidatorServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconNodeValidatorServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconNodeValidator_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconNodeValidatorServer).ProposeBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconNodeValidatorServer).ProposeBlock(ctx, req.(*BeaconBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconNodeValidator_GetAttestationData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconNodeValidatorServer).GetAttestationData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetAttestationData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconNodeValidatorServer).GetAttestationData(ctx, req.(*AttestationDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconNodeValidator_ProposeAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Attestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconNodeValidatorServer).ProposeAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/ProposeAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconNodeValidatorServer).ProposeAttestation(ctx, req.(*Attestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconNodeValidator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.BeaconNodeValidator",
+	HandlerType: (*BeaconNodeValidatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDuties",
+			Handler:    _BeaconNodeValidator_GetDuties_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _BeaconNodeValidator_GetBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _BeaconNodeValidator_ProposeBlock_Handler,
+		},
+		{
+			MethodName: "GetAttestationData",
+			Handler:    _BeaconNodeValidator_GetAttestationData_Handler,
+		},
+		{
+			MethodName: "ProposeAttestation",
+			Handler:    _BeaconNodeValidator_ProposeAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1alpha1/validator.proto",
+}
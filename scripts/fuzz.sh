@@ -0,0 +1,29 @@
+#!/bin/bash
+
+# Builds a fuzzing harness of beacon-chain/fuzz for libFuzzer and runs it, keeping the
+# corpus under /tmp/fuzz/<harness>. Crashing inputs are written to the current directory.
+#
+# Usage: ./scripts/fuzz.sh <harness> [libFuzzer flags...]
+#   where <harness> is one of BeaconBlockSSZ, BeaconStateSSZ, AttestationSSZ,
+#   StateTransition or P2PMessage.
+#
+# Requires clang and go-fuzz-build:
+#   go get -u github.com/dvyukov/go-fuzz/go-fuzz-build
+
+set -e
+
+if [ -z "$1" ]
+then
+  echo "Usage: $0 <harness> [libFuzzer flags...]"
+  exit 1
+fi
+harness=$1
+shift
+
+dir=/tmp/fuzz/$harness
+mkdir -p "$dir/corpus"
+
+go-fuzz-build -libfuzzer -func "$harness" -o "$dir/$harness.a" github.com/prysmaticlabs/prysm/beacon-chain/fuzz
+clang -fsanitize=fuzzer "$dir/$harness.a" -o "$dir/$harness"
+
+"$dir/$harness" "$dir/corpus" "$@"
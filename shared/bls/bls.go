@@ -4,9 +4,12 @@
 package bls
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"io"
 
+	bls12 "github.com/phoreproject/bls"
 	g1 "github.com/phoreproject/bls/g1pubs"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
@@ -113,6 +116,76 @@ func (s *Signature) VerifyAggregate(pubKeys []*PublicKey, msg []byte, domain uin
 	return s.val.VerifyAggregateCommonWithDomain(keys, bytesutil.ToBytes32(msg), domain)
 }
 
+// VerifyBatch verifies signatures of distinct or identical messages, each signature signing
+// its respective message with its respective public key. Each signature and public key is
+// weighted by a random scalar before the batch is aggregated into a single verification, so
+// that invalid signatures cannot offset each other in the aggregate. This is vulnerable to
+// rogue public-key attack. Each user must provide a proof-of-knowledge of the public key.
+func VerifyBatch(sigs []*Signature, pubKeys []*PublicKey, msgs [][32]byte, domain uint64) (bool, error) {
+	if len(sigs) == 0 || len(sigs) != len(pubKeys) || len(sigs) != len(msgs) {
+		return false, nil
+	}
+	weightedSigs := make([]*g1.Signature, len(sigs))
+	// The weighted public keys of a same message are aggregated, as the messages of an
+	// aggregated verification must be distinct.
+	keysByMsg := make(map[[32]byte]*g1.PublicKey)
+	var distinctMsgs [][32]byte
+	for i := range sigs {
+		scalar, err := randScalar()
+		if err != nil {
+			return false, err
+		}
+		weightedSigs[i], err = weightSignature(sigs[i], scalar)
+		if err != nil {
+			return false, err
+		}
+		key, err := weightPublicKey(pubKeys[i], scalar)
+		if err != nil {
+			return false, err
+		}
+		if k, ok := keysByMsg[msgs[i]]; ok {
+			k.Aggregate(key)
+			continue
+		}
+		keysByMsg[msgs[i]] = key
+		distinctMsgs = append(distinctMsgs, msgs[i])
+	}
+	keys := make([]*g1.PublicKey, len(distinctMsgs))
+	for i, msg := range distinctMsgs {
+		keys[i] = keysByMsg[msg]
+	}
+	return g1.AggregateSignatures(weightedSigs).VerifyAggregateWithDomain(keys, distinctMsgs, domain), nil
+}
+
+// randScalar returns a random non-zero 64 bit scalar to weight a signature of a batch.
+func randScalar() (*bls12.FRRepr, error) {
+	var b [8]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return nil, fmt.Errorf("could not generate random scalar: %v", err)
+		}
+		if n := binary.LittleEndian.Uint64(b[:]); n != 0 {
+			return bls12.NewFRRepr(n), nil
+		}
+	}
+}
+
+func weightSignature(sig *Signature, scalar *bls12.FRRepr) (*g1.Signature, error) {
+	point, err := bls12.DecompressG2(sig.val.Serialize())
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress signature: %v", err)
+	}
+	return g1.NewSignatureFromG2(point.MulFR(scalar).ToAffine()), nil
+}
+
+func weightPublicKey(pub *PublicKey, scalar *bls12.FRRepr) (*g1.PublicKey, error) {
+	point, err := bls12.DecompressG1(pub.val.Serialize())
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress public key: %v", err)
+	}
+	return g1.NewPublicKeyFromG1(point.MulFR(scalar).ToAffine()), nil
+}
+
 // Marshal a signature into a byte slice.
 func (s *Signature) Marshal() []byte {
 	k := s.val.Serialize()
@@ -63,3 +63,67 @@ func TestVerifyAggregate_ReturnsFalseOnEmptyPubKeyList(t *testing.T) {
 			"of public keys.")
 	}
 }
+
+func TestVerifyBatch(t *testing.T) {
+	pubkeys := make([]*bls.PublicKey, 0, 10)
+	msgs := make([][32]byte, 0, 10)
+	sigs := make([]*bls.Signature, 0, 10)
+	for i := 0; i < 10; i++ {
+		priv, _ := bls.RandKey(rand.Reader)
+		// Every other pair signs the same message.
+		msg := [32]byte{'h', 'e', 'l', 'l', 'o', byte(i / 2)}
+		pubkeys = append(pubkeys, priv.PublicKey())
+		msgs = append(msgs, msg)
+		sigs = append(sigs, priv.Sign(msg[:], 0))
+	}
+	valid, err := bls.VerifyBatch(sigs, pubkeys, msgs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Error("Signatures did not verify")
+	}
+
+	msgs[0], msgs[2] = msgs[2], msgs[0]
+	valid, err = bls.VerifyBatch(sigs, pubkeys, msgs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("Expected signatures not to verify with swapped messages")
+	}
+	valid, err = bls.VerifyBatch(sigs, pubkeys[1:], msgs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("Expected signatures not to verify with mismatched public keys and messages")
+	}
+}
+
+func TestVerifyBatch_RejectsForgedPair(t *testing.T) {
+	priv1, _ := bls.RandKey(rand.Reader)
+	priv2, _ := bls.RandKey(rand.Reader)
+	pubkeys := []*bls.PublicKey{priv1.PublicKey(), priv2.PublicKey()}
+	msgs := [][32]byte{{'h', 'e', 'l', 'l', 'o'}, {'w', 'o', 'r', 'l', 'd'}}
+	sig1 := priv1.Sign(msgs[0][:], 0)
+	sig2 := priv2.Sign(msgs[1][:], 0)
+
+	// Neither forged signature is valid, but they sum to the aggregate of the valid
+	// signatures, which an unweighted aggregated verification would accept.
+	forged := []*bls.Signature{
+		bls.AggregateSignatures([]*bls.Signature{sig1, sig2}),
+		bls.AggregateSignatures(nil),
+	}
+	honestSum := bls.AggregateSignatures([]*bls.Signature{sig1, sig2}).Marshal()
+	if !bytes.Equal(bls.AggregateSignatures(forged).Marshal(), honestSum) {
+		t.Fatal("Expected the forged signatures to sum to the aggregate of the valid signatures")
+	}
+	valid, err := bls.VerifyBatch(forged, pubkeys, msgs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("Expected forged signatures not to verify")
+	}
+}
@@ -0,0 +1,164 @@
+// Package clockutil checks the local clock against NTP servers, as a skewed clock makes
+// the node miss its duties and reject blocks as coming from the future. The clock may
+// be corrected with an offset used by the slot timing of the node.
+package clockutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "clock")
+
+var clockOffsetSeconds = metrics.NewGauge(prometheus.GaugeOpts{
+	Name: "clock_offset_seconds",
+	Help: "The offset of the NTP server clocks from the local clock at the last check",
+})
+
+// adjustment of the local clock in nanoseconds, applied by Now.
+var adjustment int64
+
+// Now returns the local time, corrected by the adjustment set once the clock is found to
+// be skewed, if adjusting the clock is enabled.
+func Now() time.Time {
+	return time.Now().Add(time.Duration(atomic.LoadInt64(&adjustment)))
+}
+
+// Since returns the time elapsed since t according to Now.
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Until returns the duration until t according to Now.
+func Until(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// SetAdjustment sets the correction of the local clock applied by Now.
+func SetAdjustment(d time.Duration) {
+	atomic.StoreInt64(&adjustment, int64(d))
+}
+
+// Config options for the clock check.
+type Config struct {
+	Servers   []string
+	Interval  time.Duration
+	Timeout   time.Duration
+	Threshold time.Duration
+	// Adjust corrects the time returned by Now by the measured offset once it exceeds
+	// the threshold.
+	Adjust bool
+}
+
+// Service checks the offset of the local clock at startup and then every interval.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	query  func(server string, timeout time.Duration) (time.Duration, error)
+	lock   sync.RWMutex
+	offset time.Duration
+	err    error
+}
+
+// NewService creates a clock check querying the NTP servers.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+		query:  QueryOffset,
+	}
+}
+
+// Start checking the clock.
+func (s *Service) Start() {
+	log.WithField("servers", s.cfg.Servers).Info("Starting service")
+	go s.run()
+}
+
+// Stop checking the clock.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status returns an error if the last measured offset exceeds the threshold and the
+// clock is not adjusted.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.cfg.Adjust && s.skewed(s.offset) {
+		return fmt.Errorf("local clock is off by %v", s.offset)
+	}
+	return nil
+}
+
+func (s *Service) run() {
+	s.check()
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.check()
+		}
+	}
+}
+
+// check measures the offset of the local clock from the first NTP server which answers,
+// warning if it exceeds the threshold and adjusting the clock if enabled.
+func (s *Service) check() {
+	var offset time.Duration
+	var err error
+	for _, server := range s.cfg.Servers {
+		if offset, err = s.query(server, s.cfg.Timeout); err == nil {
+			break
+		}
+		log.WithError(err).WithField("server", server).Debug("Could not query ntp server")
+	}
+	if err != nil {
+		log.WithError(err).Warn("Could not check the local clock against any ntp server")
+		return
+	}
+
+	s.lock.Lock()
+	s.offset = offset
+	s.lock.Unlock()
+	clockOffsetSeconds.Set(offset.Seconds())
+
+	if !s.skewed(offset) {
+		if s.cfg.Adjust {
+			SetAdjustment(0)
+		}
+		log.WithField("offset", offset).Debug("Local clock is in sync")
+		return
+	}
+	if s.cfg.Adjust {
+		SetAdjustment(offset)
+		log.WithField("offset", offset).Warn("Local clock is skewed, adjusting slot timing by the offset")
+		return
+	}
+	log.WithField("offset", offset).Warn("Local clock is skewed, which causes missed duties and rejected blocks. Synchronize the system clock or enable --adjust-clock")
+}
+
+func (s *Service) skewed(offset time.Duration) bool {
+	return offset > s.cfg.Threshold || offset < -s.cfg.Threshold
+}
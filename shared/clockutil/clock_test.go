@@ -0,0 +1,51 @@
+package clockutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheck_Skewed(t *testing.T) {
+	defer SetAdjustment(0)
+	tests := []struct {
+		offset     time.Duration
+		adjust     bool
+		wantStatus bool
+		wantAdjust time.Duration
+	}{
+		{offset: 100 * time.Millisecond},
+		{offset: -2 * time.Second, wantStatus: true},
+		{offset: 2 * time.Second, adjust: true, wantAdjust: 2 * time.Second},
+		{offset: 100 * time.Millisecond, adjust: true},
+	}
+	for _, tt := range tests {
+		s := NewService(context.Background(), &Config{
+			Servers:   []string{"unreachable", "reachable"},
+			Threshold: time.Second,
+			Adjust:    tt.adjust,
+		})
+		s.query = func(server string, _ time.Duration) (time.Duration, error) {
+			if server == "unreachable" {
+				return 0, errors.New("timeout")
+			}
+			return tt.offset, nil
+		}
+		s.check()
+		if err := s.Status(); (err != nil) != tt.wantStatus {
+			t.Errorf("Unexpected status %v for offset %v with adjust %v", err, tt.offset, tt.adjust)
+		}
+		if got := time.Duration(adjustment); got != tt.wantAdjust {
+			t.Errorf("Wanted adjustment %v for offset %v, received %v", tt.wantAdjust, tt.offset, got)
+		}
+	}
+}
+
+func TestNow_Adjusted(t *testing.T) {
+	defer SetAdjustment(0)
+	SetAdjustment(time.Hour)
+	if d := Since(time.Now()); d < 59*time.Minute {
+		t.Errorf("Wanted clock adjusted by an hour, received %v", d)
+	}
+}
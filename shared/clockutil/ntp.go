@@ -0,0 +1,80 @@
+package clockutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+)
+
+// ntpEpochOffset is the number of seconds between the NTP epoch in 1900 and the unix
+// epoch in 1970.
+const ntpEpochOffset = 2208988800
+
+// QueryOffset asks the NTP server, given as host or host:port, for its time and returns
+// the offset of the server clock from the local clock, following the simple network time
+// protocol of RFC 4330.
+func QueryOffset(server string, timeout time.Duration) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	conn, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, fmt.Errorf("could not connect to ntp server %s: %v", server, err)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+
+	req := make([]byte, 48)
+	// Leap indicator 0, version 4 and client mode.
+	req[0] = 0<<6 | 4<<3 | 3
+	originate := time.Now()
+	binary.BigEndian.PutUint64(req[40:], toNTPTime(originate))
+	if _, err := conn.Write(req); err != nil {
+		return 0, fmt.Errorf("could not send ntp request: %v", err)
+	}
+	resp := make([]byte, 48)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, fmt.Errorf("could not read ntp response: %v", err)
+	}
+	destination := time.Now()
+	if n < 48 {
+		return 0, fmt.Errorf("ntp response of %d bytes is too short", n)
+	}
+	if mode := resp[0] & 0x7; mode != 4 {
+		return 0, fmt.Errorf("ntp response has mode %d, expected server mode 4", mode)
+	}
+	if stratum := resp[1]; stratum == 0 || stratum > 15 {
+		return 0, fmt.Errorf("ntp server is unsynchronized with stratum %d", stratum)
+	}
+	if binary.BigEndian.Uint64(resp[24:]) != toNTPTime(originate) {
+		return 0, fmt.Errorf("ntp response does not match the request")
+	}
+	receive := fromNTPTime(binary.BigEndian.Uint64(resp[32:]))
+	transmit := fromNTPTime(binary.BigEndian.Uint64(resp[40:]))
+	return offset(originate, receive, transmit, destination), nil
+}
+
+// offset of the server clock given the times a request was sent by the client, received
+// by the server, answered by the server and received by the client.
+func offset(originate, receive, transmit, destination time.Time) time.Duration {
+	return (receive.Sub(originate) + transmit.Sub(destination)) / 2
+}
+
+// toNTPTime converts the time to the 64 bit fixed point format of NTP.
+func toNTPTime(t time.Time) uint64 {
+	nanos := uint64(t.UnixNano()) + ntpEpochOffset*uint64(time.Second)
+	sec := nanos / uint64(time.Second)
+	frac := (nanos % uint64(time.Second)) << 32 / uint64(time.Second)
+	return sec<<32 | frac
+}
+
+// fromNTPTime converts the 64 bit fixed point format of NTP to a time.
+func fromNTPTime(ntp uint64) time.Time {
+	sec := int64(ntp>>32) - ntpEpochOffset
+	nanos := int64((ntp & 0xffffffff) * uint64(time.Second) >> 32)
+	return time.Unix(sec, nanos)
+}
@@ -0,0 +1,49 @@
+package clockutil
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestNTPTime(t *testing.T) {
+	now := time.Unix(1560000000, 123456789)
+	if d := fromNTPTime(toNTPTime(now)).Sub(now); d > time.Microsecond || d < -time.Microsecond {
+		t.Errorf("Wanted NTP time to round trip, off by %v", d)
+	}
+}
+
+func TestQueryOffset(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// The fake server answers with a clock ahead by 10 seconds.
+	skew := 10 * time.Second
+	go func() {
+		req := make([]byte, 48)
+		_, addr, err := conn.ReadFrom(req)
+		if err != nil {
+			return
+		}
+		resp := make([]byte, 48)
+		resp[0] = 4<<3 | 4
+		resp[1] = 2
+		copy(resp[24:32], req[40:48])
+		now := time.Now().Add(skew)
+		binary.BigEndian.PutUint64(resp[32:], toNTPTime(now))
+		binary.BigEndian.PutUint64(resp[40:], toNTPTime(now))
+		conn.WriteTo(resp, addr)
+	}()
+
+	offset, err := QueryOffset(conn.LocalAddr().String(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := offset - skew; d > 100*time.Millisecond || d < -100*time.Millisecond {
+		t.Errorf("Wanted offset of %v, received %v", skew, offset)
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-yaml/yaml"
+	"github.com/urfave/cli"
+)
+
+// ConfigFileFlag specifies a YAML or TOML file setting the values of the other flags.
+var ConfigFileFlag = cli.StringFlag{
+	Name:  "config-file",
+	Usage: "The path to a YAML or TOML file setting the flags, keyed by flag name. Flags set on the command line or through PRYSM_ prefixed environment variables take precedence over the file.",
+}
+
+// LoadConfigFile sets the flags from the file given by --config-file, if any. Flags set
+// on the command line are not overridden, so that they take precedence over the file,
+// which takes precedence over the defaults. Every key of the file must be the name of
+// one of the flags, and lists set every value of slice flags.
+func LoadConfigFile(ctx *cli.Context, flags []cli.Flag) error {
+	path := ctx.GlobalString(ConfigFileFlag.Name)
+	if path == "" {
+		return nil
+	}
+	values, err := ReadConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		known[flagName(f)] = true
+	}
+	for name, value := range values {
+		if !known[name] || name == ConfigFileFlag.Name {
+			return fmt.Errorf("unknown flag %q in config file %s", name, path)
+		}
+		if ctx.GlobalIsSet(name) {
+			continue
+		}
+		items, ok := value.([]interface{})
+		if !ok {
+			items = []interface{}{value}
+		}
+		for _, item := range items {
+			if err := ctx.GlobalSet(name, fmt.Sprint(item)); err != nil {
+				return fmt.Errorf("invalid value %v for flag %q in config file %s: %v", item, name, path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ReadConfigFile decodes the flag values of a YAML or TOML file, according to its
+// extension.
+func ReadConfigFile(path string) (map[string]interface{}, error) {
+	// #nosec - Inclusion of file via variable is OK for the config file.
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file: %v", err)
+	}
+	values := make(map[string]interface{})
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(enc, &values)
+	case ".toml":
+		err = toml.Unmarshal(enc, &values)
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q, expected .yaml, .yml or .toml", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not decode config file %s: %v", path, err)
+	}
+	return values, nil
+}
+
+// ConfigCommand returns the config command of an app with the given flags, whose dump
+// subcommand prints the effective value of every flag, once the config file and the
+// command line are applied.
+func ConfigCommand(flags []cli.Flag) cli.Command {
+	return cli.Command{
+		Name:  "config",
+		Usage: "inspect the configuration of the flags",
+		Subcommands: cli.Commands{
+			{
+				Name:  "dump",
+				Usage: "print the effective value of every flag in the format of --config-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "format",
+						Usage: "The format of the printed configuration (yaml, toml)",
+						Value: "yaml",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					return dumpConfig(ctx, flags, ctx.String("format"))
+				},
+			},
+		},
+	}
+}
+
+func dumpConfig(ctx *cli.Context, flags []cli.Flag, format string) error {
+	values := effectiveConfig(ctx, flags)
+	switch format {
+	case "yaml":
+		// Flags are printed in alphabetical order, as the keys of maps are sorted.
+		enc, err := yaml.Marshal(values)
+		if err != nil {
+			return fmt.Errorf("could not encode config: %v", err)
+		}
+		_, err = os.Stdout.Write(enc)
+		return err
+	case "toml":
+		return toml.NewEncoder(os.Stdout).Encode(values)
+	default:
+		return fmt.Errorf("unknown config format %s", format)
+	}
+}
+
+// effectiveConfig returns the value of every flag, keyed by flag name.
+func effectiveConfig(ctx *cli.Context, flags []cli.Flag) map[string]interface{} {
+	values := make(map[string]interface{}, len(flags))
+	for _, f := range flags {
+		name := flagName(f)
+		if name == ConfigFileFlag.Name {
+			continue
+		}
+		switch v := ctx.GlobalGeneric(name).(type) {
+		case *cli.StringSlice:
+			values[name] = v.Value()
+		case *cli.IntSlice:
+			values[name] = v.Value()
+		case *cli.Int64Slice:
+			values[name] = v.Value()
+		case flag.Getter:
+			values[name] = v.Get()
+		case flag.Value:
+			values[name] = v.String()
+		}
+	}
+	return values
+}
+
+// flagName returns the long name of the flag, without its aliases.
+func flagName(f cli.Flag) string {
+	return strings.TrimSpace(strings.Split(f.GetName(), ",")[0])
+}
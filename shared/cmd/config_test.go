@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+var testConfigFlags = []cli.Flag{
+	ConfigFileFlag,
+	cli.StringFlag{Name: "name", Value: "default"},
+	cli.Uint64Flag{Name: "count", Value: 1},
+	cli.BoolFlag{Name: "enabled"},
+	cli.StringSliceFlag{Name: "peers"},
+}
+
+// runWithConfig runs an app with the test flags and the args, returning the effective
+// configuration.
+func runWithConfig(args ...string) (map[string]interface{}, error) {
+	var values map[string]interface{}
+	app := cli.NewApp()
+	app.Flags = testConfigFlags
+	app.Before = func(ctx *cli.Context) error {
+		return LoadConfigFile(ctx, testConfigFlags)
+	}
+	app.Action = func(ctx *cli.Context) error {
+		values = effectiveConfig(ctx, testConfigFlags)
+		return nil
+	}
+	err := app.Run(append([]string{"test"}, args...))
+	return values, err
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigFile_Precedence(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "name: file\ncount: 5\npeers:\n  - a\n  - b\n")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	values, err := runWithConfig("--config-file", path, "--count", "7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"name":    "file",
+		"count":   uint64(7),
+		"enabled": false,
+		"peers":   []string{"a", "b"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Effective config = %v, want %v", values, want)
+	}
+}
+
+func TestLoadConfigFile_TOML(t *testing.T) {
+	path := writeConfigFile(t, "config.toml", "name = \"file\"\nenabled = true\n")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	values, err := runWithConfig("--config-file", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["name"] != "file" || values["enabled"] != true || values["count"] != uint64(1) {
+		t.Errorf("Unexpected effective config %v", values)
+	}
+}
+
+func TestLoadConfigFile_UnknownFlag(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "unknown: 1\n")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	if _, err := runWithConfig("--config-file", path); err == nil || !strings.Contains(err.Error(), "unknown flag") {
+		t.Errorf("Expected unknown flag error, got %v", err)
+	}
+}
+
+func TestLoadConfigFile_UnsupportedExtension(t *testing.T) {
+	path := writeConfigFile(t, "config.json", "{}")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	if _, err := runWithConfig("--config-file", path); err == nil {
+		t.Error("Expected error for unsupported config file extension")
+	}
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/urfave/cli"
+)
+
+var forceFlag = cli.BoolFlag{
+	Name:  "force",
+	Usage: "Do not ask for confirmation",
+}
+
+// DBCommand returns the db command of a client whose database is found at the path
+// returned by dbPath for the data directory. Its reset subcommand deletes the database
+// after confirmation and leaves the rest of the data directory, such as the node keys and
+// the configuration files, in place. Validator slashing protection data is never reset.
+func DBCommand(dbPath func(dataDir string) string) cli.Command {
+	return cli.Command{
+		Name:  "db",
+		Usage: "manage the database of the client",
+		Subcommands: cli.Commands{
+			{
+				Name: "reset",
+				Usage: "delete the database of a stopped client, keeping its keys and configuration. " +
+					"Validator slashing protection data is never reset.",
+				Flags: []cli.Flag{
+					DataDirFlag,
+					forceFlag,
+				},
+				Action: func(ctx *cli.Context) error {
+					return resetDB(dbPath(ctx.String(DataDirFlag.Name)), ctx.Bool(forceFlag.Name), os.Stdin, os.Stdout)
+				},
+			},
+		},
+	}
+}
+
+// dbLockTimeout is how long the reset waits for the lock of a database file before
+// considering it in use.
+var dbLockTimeout = 1 * time.Second
+
+// resetDB deletes the database directory, once the answer read from in confirms it unless
+// force is set. The reset is refused while a client holds the lock of a database file.
+func resetDB(dbPath string, force bool, in io.Reader, out io.Writer) error {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		fmt.Fprintf(out, "No database at %s\n", dbPath)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not access database: %v", err)
+	}
+	if err := checkUnlocked(dbPath); err != nil {
+		return err
+	}
+	if !force {
+		fmt.Fprintf(out, "The database at %s will be deleted, the client must be stopped.\nType \"yes\" to continue: ", dbPath)
+		answer, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("could not read confirmation: %v", err)
+		}
+		if strings.TrimSpace(answer) != "yes" {
+			return errors.New("database reset aborted")
+		}
+	}
+	if err := os.RemoveAll(dbPath); err != nil {
+		return fmt.Errorf("could not delete database: %v", err)
+	}
+	fmt.Fprintf(out, "Deleted database at %s\n", dbPath)
+	return nil
+}
+
+// checkUnlocked returns an error if a bolt database file of the directory is locked by a
+// running client. Files which cannot be opened as a database otherwise, such as corrupted
+// ones, do not prevent the reset.
+func checkUnlocked(dbPath string) error {
+	files, err := filepath.Glob(filepath.Join(dbPath, "*.db"))
+	if err != nil {
+		return fmt.Errorf("could not list database files: %v", err)
+	}
+	for _, file := range files {
+		boltDB, err := bolt.Open(file, 0600, &bolt.Options{Timeout: dbLockTimeout, ReadOnly: true})
+		if err == bolt.ErrTimeout {
+			return fmt.Errorf("database %s is in use, the client must be stopped", file)
+		}
+		if err != nil {
+			continue
+		}
+		if err := boltDB.Close(); err != nil {
+			return fmt.Errorf("could not close database %s: %v", file, err)
+		}
+	}
+	return nil
+}
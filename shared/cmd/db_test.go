@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// setupDataDir returns a data directory holding a database directory and a node key.
+func setupDataDir(t *testing.T) (string, string, string) {
+	dataDir, err := ioutil.TempDir("", "reset")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbPath := filepath.Join(dataDir, "chaindata")
+	if err := os.Mkdir(dbPath, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dbPath, "chain.db"), []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(dataDir, "network-key")
+	if err := ioutil.WriteFile(keyPath, []byte("key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return dataDir, dbPath, keyPath
+}
+
+func TestResetDB_DeletesDatabaseOnConfirmation(t *testing.T) {
+	dataDir, dbPath, keyPath := setupDataDir(t)
+	defer os.RemoveAll(dataDir)
+
+	var out bytes.Buffer
+	if err := resetDB(dbPath, false, strings.NewReader("yes\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Error("Expected the database to be deleted")
+	}
+	if _, err := os.Stat(keyPath); err != nil {
+		t.Errorf("Expected the node key to be kept: %v", err)
+	}
+}
+
+func TestResetDB_AbortsWithoutConfirmation(t *testing.T) {
+	dataDir, dbPath, _ := setupDataDir(t)
+	defer os.RemoveAll(dataDir)
+
+	for _, answer := range []string{"no\n", "\n", ""} {
+		var out bytes.Buffer
+		if err := resetDB(dbPath, false, strings.NewReader(answer), &out); err == nil {
+			t.Errorf("Expected answer %q to abort the reset", answer)
+		}
+		if _, err := os.Stat(dbPath); err != nil {
+			t.Errorf("Expected the database to be kept after answer %q: %v", answer, err)
+		}
+	}
+}
+
+func TestResetDB_Force(t *testing.T) {
+	dataDir, dbPath, _ := setupDataDir(t)
+	defer os.RemoveAll(dataDir)
+
+	var out bytes.Buffer
+	if err := resetDB(dbPath, true, strings.NewReader(""), &out); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Error("Expected the database to be deleted")
+	}
+	// Resetting a missing database is a no-op.
+	if err := resetDB(dbPath, true, strings.NewReader(""), &out); err != nil {
+		t.Errorf("Expected no error resetting a missing database, received %v", err)
+	}
+}
+
+func TestResetDB_RefusesLockedDatabase(t *testing.T) {
+	dataDir, dbPath, _ := setupDataDir(t)
+	defer os.RemoveAll(dataDir)
+	defer func(timeout time.Duration) { dbLockTimeout = timeout }(dbLockTimeout)
+	dbLockTimeout = 10 * time.Millisecond
+
+	// A running client holds the lock of its database.
+	boltDB, err := bolt.Open(filepath.Join(dbPath, "validator.db"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := resetDB(dbPath, true, strings.NewReader(""), &out); err == nil {
+		t.Error("Expected the reset of a database in use to be refused")
+	}
+	if _, err := os.Stat(dbPath); err != nil {
+		t.Errorf("Expected the database in use to be kept: %v", err)
+	}
+
+	if err := boltDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := resetDB(dbPath, true, strings.NewReader(""), &out); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dbPath); !os.IsNotExist(err) {
+		t.Error("Expected the database to be deleted once it is no longer in use")
+	}
+}
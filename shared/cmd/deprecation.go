@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var log = logrus.WithField("prefix", "flags")
+
+// DeprecatedFlag declares a flag which was renamed or removed. The flag is still
+// accepted, so that the scripts using it do not break, but it is hidden from the help
+// and setting it logs a warning and sets the flag replacing it, if any.
+type DeprecatedFlag struct {
+	Flag        cli.Flag // Flag is the deprecated flag, declared with Hidden set.
+	Replacement string   // Replacement is the name of the flag replacing it, empty if it was removed.
+}
+
+// DeprecatedFlags returns the flags of the deprecations, to be added to the flags of the
+// app.
+func DeprecatedFlags(deprecated []DeprecatedFlag) []cli.Flag {
+	flags := make([]cli.Flag, len(deprecated))
+	for i, d := range deprecated {
+		flags[i] = d.Flag
+	}
+	return flags
+}
+
+// ApplyDeprecatedFlags warns about each deprecated flag which is set, and sets the value
+// of its replacement. If the replacement is also set, it takes precedence over the
+// deprecated flag.
+func ApplyDeprecatedFlags(ctx *cli.Context, deprecated []DeprecatedFlag) error {
+	for _, d := range deprecated {
+		name := flagName(d.Flag)
+		if !ctx.GlobalIsSet(name) {
+			continue
+		}
+		if d.Replacement == "" {
+			log.Warnf("Flag --%s is deprecated and has no effect", name)
+			continue
+		}
+		log.Warnf("Flag --%s is deprecated, use --%s instead", name, d.Replacement)
+		if ctx.GlobalIsSet(d.Replacement) {
+			continue
+		}
+		for _, value := range flagValues(ctx.GlobalGeneric(name)) {
+			if err := ctx.GlobalSet(d.Replacement, value); err != nil {
+				return fmt.Errorf("could not set --%s from deprecated flag --%s: %v", d.Replacement, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// flagValues returns the values of the flag in the format they are set with, one for
+// each element of slice flags.
+func flagValues(value interface{}) []string {
+	switch v := value.(type) {
+	case *cli.StringSlice:
+		return v.Value()
+	case *cli.IntSlice:
+		values := make([]string, len(v.Value()))
+		for i, item := range v.Value() {
+			values[i] = fmt.Sprint(item)
+		}
+		return values
+	case *cli.Int64Slice:
+		values := make([]string, len(v.Value()))
+		for i, item := range v.Value() {
+			values[i] = fmt.Sprint(item)
+		}
+		return values
+	case flag.Value:
+		return []string{v.String()}
+	}
+	return nil
+}
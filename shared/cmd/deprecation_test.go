@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli"
+)
+
+var testDeprecatedFlags = []DeprecatedFlag{
+	{
+		Flag:        cli.StringFlag{Name: "old-name", Hidden: true},
+		Replacement: "name",
+	},
+	{
+		Flag:        cli.StringSliceFlag{Name: "old-peers", Hidden: true},
+		Replacement: "peers",
+	},
+	{
+		Flag: cli.BoolFlag{Name: "removed", Hidden: true},
+	},
+}
+
+// runWithDeprecatedFlags runs an app with the test flags and deprecations, returning
+// the value of the replacement flags.
+func runWithDeprecatedFlags(args ...string) (string, []string, error) {
+	var name string
+	var peers []string
+	app := cli.NewApp()
+	app.Flags = append([]cli.Flag{
+		cli.StringFlag{Name: "name", Value: "default"},
+		cli.StringSliceFlag{Name: "peers"},
+	}, DeprecatedFlags(testDeprecatedFlags)...)
+	app.Before = func(ctx *cli.Context) error {
+		return ApplyDeprecatedFlags(ctx, testDeprecatedFlags)
+	}
+	app.Action = func(ctx *cli.Context) error {
+		name = ctx.GlobalString("name")
+		peers = ctx.GlobalStringSlice("peers")
+		return nil
+	}
+	err := app.Run(append([]string{"test"}, args...))
+	return name, peers, err
+}
+
+func TestApplyDeprecatedFlags_SetsReplacement(t *testing.T) {
+	hook := logTest.NewGlobal()
+	name, peers, err := runWithDeprecatedFlags("--old-name", "value", "--old-peers", "a", "--old-peers", "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "value" {
+		t.Errorf("Expected name to be set from the deprecated flag, got %s", name)
+	}
+	if !reflect.DeepEqual(peers, []string{"a", "b"}) {
+		t.Errorf("Expected peers to be set from the deprecated flag, got %v", peers)
+	}
+	if len(hook.Entries) != 2 || hook.LastEntry().Level != logrus.WarnLevel {
+		t.Errorf("Expected a warning for each deprecated flag, got %v", hook.Entries)
+	}
+}
+
+func TestApplyDeprecatedFlags_ReplacementTakesPrecedence(t *testing.T) {
+	name, _, err := runWithDeprecatedFlags("--old-name", "old", "--name", "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "new" {
+		t.Errorf("Expected the replacement to take precedence, got %s", name)
+	}
+}
+
+func TestApplyDeprecatedFlags_Removed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	if _, _, err := runWithDeprecatedFlags("--removed"); err != nil {
+		t.Fatal(err)
+	}
+	if hook.LastEntry() == nil || hook.LastEntry().Message != "Flag --removed is deprecated and has no effect" {
+		t.Errorf("Expected a warning for the removed flag, got %v", hook.LastEntry())
+	}
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+// EnvPrefix is the prefix of the environment variables setting the flags.
+const EnvPrefix = "PRYSM_"
+
+// EnvVarName returns the environment variable setting the flag with the given name, such
+// as PRYSM_BEACON_RPC_PROVIDER for --beacon-rpc-provider.
+func EnvVarName(name string) string {
+	return EnvPrefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// LoadEnvVars sets the flags from their environment variables, named by EnvVarName. Flags
+// set on the command line are not overridden, so that they take precedence over the
+// environment, which takes precedence over the config file when loaded first. Slice flags
+// are set from comma separated values.
+func LoadEnvVars(ctx *cli.Context, flags []cli.Flag) error {
+	for _, f := range flags {
+		name := flagName(f)
+		value, ok := os.LookupEnv(EnvVarName(name))
+		if !ok || ctx.GlobalIsSet(name) {
+			continue
+		}
+		items := []string{value}
+		switch f.(type) {
+		case cli.StringSliceFlag, cli.IntSliceFlag, cli.Int64SliceFlag:
+			items = strings.Split(value, ",")
+		}
+		for _, item := range items {
+			if err := ctx.GlobalSet(name, strings.TrimSpace(item)); err != nil {
+				return fmt.Errorf("invalid value %q of environment variable %s: %v", value, EnvVarName(name), err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+func TestEnvVarName(t *testing.T) {
+	if name := EnvVarName("beacon-rpc-provider"); name != "PRYSM_BEACON_RPC_PROVIDER" {
+		t.Errorf("Wanted PRYSM_BEACON_RPC_PROVIDER, received %s", name)
+	}
+}
+
+func TestLoadEnvVars_Precedence(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "name: file\ncount: 5\n")
+	defer os.RemoveAll(filepath.Dir(path))
+	env := map[string]string{
+		"PRYSM_NAME":    "env",
+		"PRYSM_COUNT":   "6",
+		"PRYSM_ENABLED": "true",
+		"PRYSM_PEERS":   "a, b",
+	}
+	for k, v := range env {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(k)
+	}
+
+	var values map[string]interface{}
+	app := cli.NewApp()
+	app.Flags = testConfigFlags
+	app.Before = func(ctx *cli.Context) error {
+		if err := LoadEnvVars(ctx, testConfigFlags); err != nil {
+			return err
+		}
+		return LoadConfigFile(ctx, testConfigFlags)
+	}
+	app.Action = func(ctx *cli.Context) error {
+		values = effectiveConfig(ctx, testConfigFlags)
+		return nil
+	}
+	if err := app.Run([]string{"test", "--config-file", path, "--count", "7"}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"name":    "env",
+		"count":   uint64(7),
+		"enabled": true,
+		"peers":   []string{"a", "b"},
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("Effective config = %v, want %v", values, want)
+	}
+}
+
+func TestLoadEnvVars_InvalidValue(t *testing.T) {
+	if err := os.Setenv("PRYSM_COUNT", "many"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("PRYSM_COUNT")
+
+	app := cli.NewApp()
+	app.Flags = testConfigFlags
+	app.Before = func(ctx *cli.Context) error {
+		return LoadEnvVars(ctx, testConfigFlags)
+	}
+	app.Action = func(ctx *cli.Context) error {
+		return nil
+	}
+	if err := app.Run([]string{"test"}); err == nil {
+		t.Error("Wanted error for an invalid environment variable")
+	}
+}
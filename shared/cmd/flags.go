@@ -2,10 +2,17 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
 var (
+	// NetworkFlag defines the network preset setting the chain constants and deposit contract.
+	NetworkFlag = cli.StringFlag{
+		Name:  "network",
+		Usage: "The network preset to use (mainnet, minimal, interop, testnet). Defaults to testnet, or mainnet with --no-custom-config.",
+	}
 	// VerbosityFlag defines the logrus configuration.
 	VerbosityFlag = cli.StringFlag{
 		Name:  "verbosity",
@@ -28,11 +35,32 @@ var (
 		Name:  "tracing-process-name",
 		Usage: "The name to apply to tracing tag \"process_name\"",
 	}
-	// TracingEndpointFlag flag defines the http endpoint for serving traces to Jaeger.
+	// TracingExporterFlag defines the exporter sending the traces to a collector.
+	TracingExporterFlag = cli.StringFlag{
+		Name:  "tracing-exporter",
+		Usage: "The exporter sending traces to a collector (jaeger, otlp).",
+		Value: "jaeger",
+	}
+	// TracingEndpointFlag flag defines the http endpoint for serving traces to the collector.
 	TracingEndpointFlag = cli.StringFlag{
-		Name:  "tracing-endpoint",
-		Usage: "Tracing endpoint defines where beacon chain traces are exposed to Jaeger.",
-		Value: "http://127.0.0.1:14268",
+		Name: "tracing-endpoint",
+		Usage: "Tracing endpoint defines where beacon chain traces are exposed to the collector. Defaults to " +
+			"http://127.0.0.1:14268 for Jaeger and http://127.0.0.1:4318/v1/traces for OTLP.",
+	}
+	// TracingUsernameFlag defines the username for the basic auth of the tracing collector.
+	TracingUsernameFlag = cli.StringFlag{
+		Name:  "tracing-username",
+		Usage: "The username for the basic auth of the tracing collector.",
+	}
+	// TracingPasswordFlag defines the password for the basic auth of the tracing collector.
+	TracingPasswordFlag = cli.StringFlag{
+		Name:  "tracing-password",
+		Usage: "The password for the basic auth of the tracing collector.",
+	}
+	// TracingHeadersFlag defines headers sent to the OTLP collector, such as API keys.
+	TracingHeadersFlag = cli.StringSliceFlag{
+		Name:  "tracing-header",
+		Usage: "A key=value header sent to the OTLP collector. This flag may be used multiple times.",
 	}
 	// TraceSampleFractionFlag defines a flag to indicate what fraction of p2p
 	// messages are sampled for tracing.
@@ -105,7 +133,30 @@ var (
 		Name: "p2p-whitelist",
 		Usage: "The CIDR subnet for whitelisting peer connections. Example: 192.168.0.0/16 " +
 			"would whitelist connections to peers on your local network only. The default " +
-			"is to accept all connections.",
+			"is to accept all connections. When set, connections to peers outside of the " +
+			"whitelisted and allowed subnets are rejected.",
+	}
+	// P2PAllowList defines CIDR subnets to exclusively allow connections with.
+	P2PAllowList = cli.StringSliceFlag{
+		Name: "p2p-allowlist",
+		Usage: "The CIDR subnet for allowing only certain peer connections. Example: 192.168.0.0/16 " +
+			"would allow connections to peers on your local network only. This flag may be used " +
+			"multiple times. The default is to accept all connections. When set, connections to " +
+			"peers outside of the allowed and whitelisted subnets are rejected.",
+	}
+	// P2PDenyList defines CIDR subnets to reject connections with.
+	P2PDenyList = cli.StringSliceFlag{
+		Name: "p2p-denylist",
+		Usage: "The CIDR subnet for rejecting peer connections, for example to block an abusive " +
+			"range of addresses. This flag may be used multiple times. On SIGHUP, the beacon node " +
+			"replaces the denied subnets with the denylist of the config file.",
+	}
+	// P2PSecurity defines the libp2p security transports to offer, in order of preference.
+	P2PSecurity = cli.StringFlag{
+		Name: "p2p-security",
+		Usage: "Comma separated list of libp2p security transports to offer to peers, in order of " +
+			"preference. Supported transports are secio, tls and noise.",
+		Value: "secio,noise",
 	}
 	// ClearDB tells the beacon node to remove any previously stored data at the data directory.
 	ClearDB = cli.BoolFlag{
@@ -124,11 +175,81 @@ var (
 		Usage: "Specifies the upper limit of goroutines running before a status check fails",
 		Value: 5000,
 	}
+	// MaxHeapSizeFlag specifies the heap usage tolerated, before a status check fails.
+	MaxHeapSizeFlag = cli.Uint64Flag{
+		Name:  "max-heap-mb",
+		Usage: "Specifies the upper limit of heap usage in megabytes before a status check fails, 0 for no limit",
+	}
+	// ShutdownTimeoutFlag specifies the time each service is given to stop on shutdown.
+	ShutdownTimeoutFlag = cli.DurationFlag{
+		Name:  "shutdown-timeout",
+		Usage: "The time each service is given to stop on shutdown before moving on to the next one",
+		Value: 30 * time.Second,
+	}
+	// NTPServersFlag specifies the NTP servers the local clock is checked against.
+	NTPServersFlag = cli.StringFlag{
+		Name:  "ntp-servers",
+		Usage: "Comma separated NTP servers the local clock is checked against at startup and every hour, empty to disable the check",
+		Value: "pool.ntp.org,time.google.com",
+	}
+	// ClockSkewThresholdFlag specifies the offset of the local clock tolerated before warning.
+	ClockSkewThresholdFlag = cli.DurationFlag{
+		Name:  "clock-skew-threshold",
+		Usage: "The offset of the local clock from the NTP servers tolerated before warning, as it causes missed duties and rejected blocks",
+		Value: 500 * time.Millisecond,
+	}
+	// AdjustClockFlag enables the correction of the slot timing by the measured clock offset.
+	AdjustClockFlag = cli.BoolFlag{
+		Name:  "adjust-clock",
+		Usage: "Correct the slot timing of the node by the offset of the local clock once it exceeds the skew threshold",
+	}
+	// MaxOpenFilesFlag specifies the open file descriptors tolerated, before a status check fails.
+	MaxOpenFilesFlag = cli.IntFlag{
+		Name:  "max-open-files",
+		Usage: "Specifies the upper limit of open file descriptors before a status check fails, 0 for no limit",
+	}
 	// LogFileName specifies the log output file name.
 	LogFileName = cli.StringFlag{
 		Name:  "log-file",
 		Usage: "Specify log file name, relative or absolute",
 	}
+	// LogMaxSizeFlag specifies the size beyond which the log file is rotated.
+	LogMaxSizeFlag = cli.IntFlag{
+		Name:  "log-max-size",
+		Usage: "The size in megabytes beyond which the log file is rotated. 0 disables rotation.",
+		Value: 100,
+	}
+	// LogMaxBackupsFlag specifies the number of rotated log files to keep.
+	LogMaxBackupsFlag = cli.IntFlag{
+		Name:  "log-max-backups",
+		Usage: "The number of rotated log files to keep. 0 keeps all of them.",
+		Value: 10,
+	}
+	// LogMaxAgeFlag specifies the number of days after which rotated log files are removed.
+	LogMaxAgeFlag = cli.IntFlag{
+		Name:  "log-max-age",
+		Usage: "The number of days after which rotated log files are removed. 0 keeps all of them.",
+	}
+	// LogCompressFlag specifies whether rotated log files are compressed.
+	LogCompressFlag = cli.BoolFlag{
+		Name:  "log-compress",
+		Usage: "Compress rotated log files with gzip.",
+	}
+	// LogSyslogFlag specifies the syslog server to send logs to.
+	LogSyslogFlag = cli.StringFlag{
+		Name:  "log-syslog",
+		Usage: "Send logs to the syslog server at network://host:port, or to the local syslog daemon with local.",
+	}
+	// LogHTTPEndpointFlag specifies the HTTP endpoint to push logs to.
+	LogHTTPEndpointFlag = cli.StringFlag{
+		Name:  "log-http-endpoint",
+		Usage: "Push logs to an HTTP endpoint compatible with the Loki push API, e.g. http://localhost:3100/loki/api/v1/push.",
+	}
+	// LogHTTPLabelsFlag specifies the labels of the logs pushed to the HTTP endpoint.
+	LogHTTPLabelsFlag = cli.StringSliceFlag{
+		Name:  "log-http-label",
+		Usage: "A key=value label of the logs pushed to the HTTP endpoint. This flag may be used multiple times.",
+	}
 	// EnableUPnPFlag specifies if UPnP should be enabled or not. The default value is false.
 	EnableUPnPFlag = cli.BoolFlag{
 		Name:  "enable-upnp",
@@ -4,11 +4,11 @@ import (
 	"runtime"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 var (
-	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+	_ = metrics.NewGaugeFunc(prometheus.GaugeOpts{
 		Name: "go_maxprocs",
 		Help: "The result of runtime.GOMAXPROCS(0)",
 	}, func() float64 {
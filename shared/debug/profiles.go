@@ -0,0 +1,80 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// ProfileDurationFlag to specify the duration of the CPU profile captured on demand.
+var ProfileDurationFlag = cli.DurationFlag{
+	Name:  "profile-duration",
+	Usage: "The duration of the CPU profile captured on demand, on SIGUSR1 or through the admin RPC",
+	Value: 30 * time.Second,
+}
+
+// CaptureProfiles captures a CPU profile for the duration, then the heap and goroutine
+// profiles, for nodes started without profiling enabled. The profiles are written to a
+// new timestamped directory under dir, and their paths returned.
+func (h *HandlerT) CaptureProfiles(ctx context.Context, dir string, duration time.Duration) ([]string, error) {
+	dir = filepath.Join(expandHome(dir), "profiles", time.Now().UTC().Format("20060102T150405"))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not create profile directory: %v", err)
+	}
+
+	cpuFile := filepath.Join(dir, "cpu.pprof")
+	if err := h.StartCPUProfile(cpuFile); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(duration):
+	}
+	if err := h.StopCPUProfile(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	files := []string{cpuFile}
+	for _, name := range []string{"heap", "goroutine"} {
+		file := filepath.Join(dir, name+".pprof")
+		if err := writeProfile(name, file); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// CaptureProfilesOnSignal captures profiles to dir each time the process receives a
+// SIGUSR1, until stop is closed.
+func CaptureProfilesOnSignal(stop <-chan struct{}, dir string, duration time.Duration) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGUSR1)
+	defer signal.Stop(sigc)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-sigc:
+			log.WithField("duration", duration).Info("Got SIGUSR1, capturing profiles")
+			go func() {
+				files, err := Handler.CaptureProfiles(context.Background(), dir, duration)
+				if err != nil {
+					log.Errorf("Could not capture profiles: %v", err)
+					return
+				}
+				log.WithField("files", files).Info("Captured profiles")
+			}()
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestCaptureProfiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files, err := Handler.CaptureProfiles(context.Background(), dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 profiles, got %v", files)
+	}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() == 0 {
+			t.Errorf("Expected profile %s to be written", file)
+		}
+	}
+}
+
+func TestCaptureProfiles_AlreadyProfiling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := Handler.CaptureProfiles(ctx, dir, time.Minute); err != context.Canceled {
+			t.Errorf("Expected capture to be canceled, got %v", err)
+		}
+	}()
+	// Wait for the first capture to start profiling.
+	for i := 0; i < 100; i++ {
+		Handler.mu.Lock()
+		started := Handler.cpuW != nil
+		Handler.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := Handler.CaptureProfiles(context.Background(), dir, time.Millisecond); err == nil {
+		t.Error("Expected error capturing profiles while already profiling")
+	}
+	cancel()
+	<-done
+}
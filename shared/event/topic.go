@@ -0,0 +1,221 @@
+package event
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+)
+
+var (
+	droppedEvents = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "event_dropped_events",
+		Help: "The number of events dropped for subscribers too slow to receive them, by topic",
+	}, []string{"topic"})
+	unsubscribedEvents = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "event_unsubscribed_events",
+		Help: "The number of events sent to a topic without any subscriber, by topic",
+	}, []string{"topic"})
+)
+
+// Policy decides what happens to the events sent to a subscriber whose buffer is full.
+type Policy int
+
+const (
+	// Block makes Send wait until the subscriber has room for the event, as with a Feed.
+	Block Policy = iota
+	// DropNewest drops the event being sent.
+	DropNewest
+	// DropOldest drops the oldest buffered event to make room for the event being sent.
+	DropOldest
+)
+
+// Topic implements one-to-many subscriptions like a Feed, except that every subscriber
+// has its own buffer and policy for when the buffer is full, so that a slow subscriber
+// can be kept from holding back the sender and the other subscribers. Events dropped
+// under these policies, and events sent without any subscriber, are counted in metrics
+// labeled with the name of the topic.
+//
+// Topics can only be used with a single type, which is determined by the first Send or
+// Subscribe operation. Subsequent calls to these methods panic if the type does not
+// match.
+//
+// The zero value is ready to use, but unnamed.
+type Topic struct {
+	name  string
+	mu    sync.Mutex
+	subs  map[*topicSub]struct{}
+	etype reflect.Type
+}
+
+// NewTopic creates a topic whose metrics are labeled with the name.
+func NewTopic(name string) *Topic {
+	return &Topic{name: name}
+}
+
+// Subscribe adds a channel to the topic which receives the events sent while the
+// subscription is active. Like with a Feed, Send waits for the subscriber to receive
+// the previous event.
+func (t *Topic) Subscribe(channel interface{}) Subscription {
+	return t.SubscribeBuffered(channel, 0, Block)
+}
+
+// SubscribeBuffered adds a channel to the topic with a buffer of up to size events not
+// yet received. Once the buffer is full, the policy decides which event is dropped, or
+// if Send waits for the subscriber. Dropping policies buffer at least one event.
+func (t *Topic) SubscribeBuffered(channel interface{}, size int, policy Policy) Subscription {
+	if policy != Block && size < 1 {
+		size = 1
+	}
+	chanval := reflect.ValueOf(channel)
+	chantyp := chanval.Type()
+	if chantyp.Kind() != reflect.Chan || chantyp.ChanDir()&reflect.SendDir == 0 {
+		panic(errBadChannel)
+	}
+	sub := &topicSub{
+		topic:   t,
+		channel: chanval,
+		policy:  policy,
+		buf:     make(chan reflect.Value, size),
+		quit:    make(chan struct{}),
+		err:     make(chan error),
+	}
+
+	t.mu.Lock()
+	t.typecheck(chantyp.Elem(), "Subscribe")
+	if t.subs == nil {
+		t.subs = make(map[*topicSub]struct{})
+	}
+	t.subs[sub] = struct{}{}
+	t.mu.Unlock()
+
+	go sub.forward()
+	return sub
+}
+
+// Send delivers the value to every subscriber according to its policy, returning the
+// number of subscribers it was delivered to, which excludes the subscribers it was
+// dropped for.
+func (t *Topic) Send(value interface{}) int {
+	rvalue := reflect.ValueOf(value)
+
+	t.mu.Lock()
+	t.typecheck(rvalue.Type(), "Send")
+	subs := make([]*topicSub, 0, len(t.subs))
+	for sub := range t.subs {
+		subs = append(subs, sub)
+	}
+	t.mu.Unlock()
+
+	if len(subs) == 0 {
+		unsubscribedEvents.WithLabelValues(t.name).Inc()
+		return 0
+	}
+	nsent := 0
+	for _, sub := range subs {
+		if sub.deliver(rvalue) {
+			nsent++
+		}
+	}
+	return nsent
+}
+
+// typecheck sets the type of the topic or panics if the type does not match it. The
+// lock must be held.
+func (t *Topic) typecheck(typ reflect.Type, op string) {
+	if t.etype == nil {
+		t.etype = typ
+		return
+	}
+	if t.etype != typ {
+		panic(feedTypeError{op: op, got: typ, want: t.etype})
+	}
+}
+
+func (t *Topic) remove(sub *topicSub) {
+	t.mu.Lock()
+	delete(t.subs, sub)
+	t.mu.Unlock()
+}
+
+type topicSub struct {
+	topic   *Topic
+	channel reflect.Value
+	policy  Policy
+	buf     chan reflect.Value
+	quit    chan struct{}
+	once    sync.Once
+	err     chan error
+}
+
+// deliver adds the value to the buffer of the subscriber, returning false if it was
+// dropped or the subscriber is gone.
+func (s *topicSub) deliver(value reflect.Value) bool {
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.buf <- value:
+			return true
+		case <-s.quit:
+			return false
+		default:
+			droppedEvents.WithLabelValues(s.topic.name).Inc()
+			return false
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.buf <- value:
+				return true
+			case <-s.quit:
+				return false
+			default:
+			}
+			select {
+			case <-s.buf:
+				droppedEvents.WithLabelValues(s.topic.name).Inc()
+			default:
+			}
+		}
+	default:
+		select {
+		case s.buf <- value:
+			return true
+		case <-s.quit:
+			return false
+		}
+	}
+}
+
+// forward sends the buffered values to the channel of the subscriber until it
+// unsubscribes.
+func (s *topicSub) forward() {
+	quitCase := reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.quit)}
+	for {
+		select {
+		case value := <-s.buf:
+			cases := []reflect.SelectCase{
+				quitCase,
+				{Dir: reflect.SelectSend, Chan: s.channel, Send: value},
+			}
+			if chosen, _, _ := reflect.Select(cases); chosen == 0 {
+				return
+			}
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *topicSub) Unsubscribe() {
+	s.once.Do(func() {
+		s.topic.remove(s)
+		close(s.quit)
+		close(s.err)
+	})
+}
+
+func (s *topicSub) Err() <-chan error {
+	return s.err
+}
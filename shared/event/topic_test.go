@@ -0,0 +1,103 @@
+package event
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTopic_Subscribe(t *testing.T) {
+	topic := NewTopic("test")
+	ch := make(chan int)
+	sub := topic.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	done := make(chan int)
+	go func() {
+		done <- topic.Send(1)
+	}()
+	if v := <-ch; v != 1 {
+		t.Errorf("Wanted 1, received %d", v)
+	}
+	if n := <-done; n != 1 {
+		t.Errorf("Wanted event sent to 1 subscriber, sent to %d", n)
+	}
+}
+
+func TestTopic_NoSubscribers(t *testing.T) {
+	topic := NewTopic("test")
+	if n := topic.Send(1); n != 0 {
+		t.Errorf("Wanted event sent to 0 subscribers, sent to %d", n)
+	}
+	sub := topic.Subscribe(make(chan int))
+	sub.Unsubscribe()
+	if n := topic.Send(1); n != 0 {
+		t.Errorf("Wanted event sent to 0 subscribers after unsubscribing, sent to %d", n)
+	}
+	if _, ok := <-sub.Err(); ok {
+		t.Error("Wanted error channel closed after unsubscribing")
+	}
+}
+
+func TestTopic_DropNewest(t *testing.T) {
+	topic := NewTopic("test")
+	ch := make(chan int)
+	sub := topic.SubscribeBuffered(ch, 2, DropNewest)
+	defer sub.Unsubscribe()
+
+	// The first event is held by the subscriber waiting on the channel, the next two are
+	// buffered and the rest are dropped.
+	sent := 0
+	for i := 0; i < 10; i++ {
+		sent += topic.Send(i)
+		time.Sleep(time.Millisecond)
+	}
+	if sent >= 10 {
+		t.Fatalf("Wanted events dropped, all %d were sent", sent)
+	}
+	prev := -1
+	for i := 0; i < sent; i++ {
+		v := <-ch
+		if v <= prev {
+			t.Errorf("Wanted events in order, received %d after %d", v, prev)
+		}
+		prev = v
+	}
+}
+
+func TestTopic_DropOldest(t *testing.T) {
+	topic := NewTopic("test")
+	ch := make(chan int)
+	sub := topic.SubscribeBuffered(ch, 2, DropOldest)
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 10; i++ {
+		if n := topic.Send(i); n != 1 {
+			t.Fatalf("Wanted event %d sent to 1 subscriber, sent to %d", i, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// The oldest buffered events are dropped, so that the last events are received.
+	var last int
+	for i := 0; i < 3; i++ {
+		select {
+		case last = <-ch:
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for buffered events")
+		}
+	}
+	if last != 9 {
+		t.Errorf("Wanted the last event 9 to be buffered, received %d", last)
+	}
+}
+
+func TestTopic_TypeMismatch(t *testing.T) {
+	topic := NewTopic("test")
+	sub := topic.Subscribe(make(chan int))
+	defer sub.Unsubscribe()
+	defer func() {
+		if recover() == nil {
+			t.Error("Wanted panic when sending a value of a different type")
+		}
+	}()
+	topic.Send("string")
+}
@@ -17,6 +17,10 @@ The process for implementing new features using this package is as follows:
 package featureconfig
 
 import (
+	"reflect"
+	"sort"
+	"sync"
+
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -25,25 +29,48 @@ var log = logrus.WithField("prefix", "flags")
 
 // FeatureFlagConfig is a struct to represent what features the client will perform on runtime.
 type FeatureFlagConfig struct {
-	DisableHistoricalStatePruning bool // DisableHistoricalStatePruning when updating finalized states.
-	DisableGossipSub              bool // DisableGossipSub in p2p messaging.
-	EnableCommitteesCache         bool // EnableCommitteesCache for state transition.
-	EnableExcessDeposits          bool // EnableExcessDeposits in validator balances.
-	NoGenesisDelay                bool // NoGenesisDelay when processing a chain start genesis event.
+	DisableHistoricalStatePruning    bool // DisableHistoricalStatePruning when updating finalized states.
+	DisableGossipSub                 bool // DisableGossipSub in p2p messaging.
+	EnableBlockSignatureVerification bool // EnableBlockSignatureVerification when processing received blocks.
+	EnableCommitteesCache            bool // EnableCommitteesCache for state transition.
+	EnableExcessDeposits             bool // EnableExcessDeposits in validator balances.
+	NoGenesisDelay                   bool // NoGenesisDelay when processing a chain start genesis event.
 }
 
-var featureConfig *FeatureFlagConfig
+var (
+	featureConfig     *FeatureFlagConfig
+	featureConfigLock sync.RWMutex
+)
 
-// FeatureConfig retrieves feature config.
+// FeatureConfig retrieves feature config. The returned config must not be modified, as
+// features toggled at runtime replace the global config rather than modify it.
 func FeatureConfig() *FeatureFlagConfig {
+	featureConfigLock.RLock()
+	defer featureConfigLock.RUnlock()
 	if featureConfig == nil {
 		return &FeatureFlagConfig{}
 	}
 	return featureConfig
 }
 
+// EnabledFeatures returns the names of the features enabled in the global config,
+// sorted alphabetically.
+func EnabledFeatures() []string {
+	cfg := reflect.ValueOf(FeatureConfig()).Elem()
+	var enabled []string
+	for i := 0; i < cfg.NumField(); i++ {
+		if field := cfg.Field(i); field.Kind() == reflect.Bool && field.Bool() {
+			enabled = append(enabled, cfg.Type().Field(i).Name)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
+
 // InitFeatureConfig sets the global config equal to the config that is passed in.
 func InitFeatureConfig(c *FeatureFlagConfig) {
+	featureConfigLock.Lock()
+	defer featureConfigLock.Unlock()
 	featureConfig = c
 }
 
@@ -59,6 +86,10 @@ func ConfigureBeaconFeatures(ctx *cli.Context) {
 		log.Info("Disabled gossipsub, using floodsub")
 		cfg.DisableGossipSub = true
 	}
+	if ctx.GlobalBool(EnableBlockSignatureVerificationFlag.Name) {
+		log.Info("Enabled block signature verification")
+		cfg.EnableBlockSignatureVerification = true
+	}
 	if ctx.GlobalBool(NoGenesisDelayFlag.Name) {
 		log.Warn("Using non standard genesis delay. This may cause problems in a multi-node environment.")
 		cfg.NoGenesisDelay = true
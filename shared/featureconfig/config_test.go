@@ -2,6 +2,7 @@ package featureconfig
 
 import (
 	"flag"
+	"reflect"
 	"testing"
 
 	"github.com/urfave/cli"
@@ -27,3 +28,14 @@ func TestConfigureBeaconConfig(t *testing.T) {
 		t.Errorf("NoGenesisDelay in FeatureFlags incorrect. Wanted true, got false")
 	}
 }
+
+func TestEnabledFeatures(t *testing.T) {
+	InitFeatureConfig(&FeatureFlagConfig{
+		NoGenesisDelay:   true,
+		DisableGossipSub: true,
+	})
+	want := []string{"DisableGossipSub", "NoGenesisDelay"}
+	if got := EnabledFeatures(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected enabled features %v, received %v", want, got)
+	}
+}
@@ -1,6 +1,7 @@
 package featureconfig
 
 import (
+	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/urfave/cli"
 )
 
@@ -23,9 +24,15 @@ var (
 	// EnableExcessDepositsFlag enables a validator to have total amount deposited as more than the
 	// max deposit amount.
 	EnableExcessDepositsFlag = cli.BoolFlag{
-		Name:  "enables-excess-deposit",
+		Name:  "enable-excess-deposits",
 		Usage: "Enables balances more than max deposit amount for a validator",
 	}
+	// EnableBlockSignatureVerificationFlag verifies the signatures of the blocks received by
+	// the node, batching the proposer, randao and attestation signatures of each block.
+	EnableBlockSignatureVerificationFlag = cli.BoolFlag{
+		Name:  "enable-block-signature-verification",
+		Usage: "Verify the signatures of received blocks, batching the verification of proposer, randao and attestation signatures.",
+	}
 	// NoGenesisDelayFlag disables the standard genesis delay.
 	NoGenesisDelayFlag = cli.BoolFlag{
 		Name:  "no-genesis-delay",
@@ -33,6 +40,22 @@ var (
 	}
 )
 
+// DeprecatedValidatorFlags contains the feature flags of the validator client which were
+// renamed or removed.
+var DeprecatedValidatorFlags = []cmd.DeprecatedFlag{}
+
+// DeprecatedBeaconChainFlags contains the feature flags of the beacon-chain client which
+// were renamed or removed.
+var DeprecatedBeaconChainFlags = []cmd.DeprecatedFlag{
+	{
+		Flag: cli.BoolFlag{
+			Name:   "enables-excess-deposit",
+			Hidden: true,
+		},
+		Replacement: EnableExcessDepositsFlag.Name,
+	},
+}
+
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
 var ValidatorFlags = []cli.Flag{}
 
@@ -42,5 +65,6 @@ var BeaconChainFlags = []cli.Flag{
 	DisableHistoricalStatePruningFlag,
 	DisableGossipSubFlag,
 	EnableExcessDepositsFlag,
+	EnableBlockSignatureVerificationFlag,
 	NoGenesisDelayFlag,
 }
@@ -0,0 +1,89 @@
+package featureconfig
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// toggleableFeatures maps the flags of the features which can be toggled at runtime to
+// their field in FeatureFlagConfig. Only features which are checked each time they apply,
+// rather than once when the services start, can be toggled.
+var toggleableFeatures = map[string]string{
+	DisableHistoricalStatePruningFlag.Name: "DisableHistoricalStatePruning",
+}
+
+// ToggleableFeatures returns the flag names of the features which can be toggled at
+// runtime, sorted alphabetically.
+func ToggleableFeatures() []string {
+	names := make([]string, 0, len(toggleableFeatures))
+	for name := range toggleableFeatures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FeatureEnabled returns whether the feature of the flag, which must be toggleable at
+// runtime, is enabled.
+func FeatureEnabled(name string) (bool, error) {
+	field, ok := toggleableFeatures[name]
+	if !ok {
+		return false, fmt.Errorf("feature %q cannot be toggled at runtime", name)
+	}
+	return reflect.ValueOf(FeatureConfig()).Elem().FieldByName(field).Bool(), nil
+}
+
+// SetFeature enables or disables the feature of the flag at runtime, if it can be
+// toggled at runtime.
+func SetFeature(name string, enabled bool) error {
+	field, ok := toggleableFeatures[name]
+	if !ok {
+		return fmt.Errorf("feature %q cannot be toggled at runtime", name)
+	}
+
+	featureConfigLock.Lock()
+	defer featureConfigLock.Unlock()
+	cfg := FeatureFlagConfig{}
+	if featureConfig != nil {
+		cfg = *featureConfig
+	}
+	reflect.ValueOf(&cfg).Elem().FieldByName(field).SetBool(enabled)
+	featureConfig = &cfg
+
+	log.WithFields(logrus.Fields{
+		"feature": name,
+		"enabled": enabled,
+	}).Warn("Toggled feature at runtime")
+	return nil
+}
+
+// ReloadFeatures sets the features which can be toggled at runtime from the values of
+// their flags, such as read from the config file. The other flags are ignored, as they
+// only apply when the node starts, and the features whose flag has no value are left
+// unchanged.
+func ReloadFeatures(values map[string]interface{}) error {
+	for _, name := range ToggleableFeatures() {
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		enabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("invalid value %v for feature %q, expected a boolean", value, name)
+		}
+		current, err := FeatureEnabled(name)
+		if err != nil {
+			return err
+		}
+		if current == enabled {
+			continue
+		}
+		if err := SetFeature(name, enabled); err != nil {
+			return err
+		}
+	}
+	return nil
+}
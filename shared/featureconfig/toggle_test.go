@@ -0,0 +1,77 @@
+package featureconfig
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSetFeature(t *testing.T) {
+	InitFeatureConfig(&FeatureFlagConfig{NoGenesisDelay: true})
+	defer InitFeatureConfig(&FeatureFlagConfig{})
+	previous := FeatureConfig()
+
+	if err := SetFeature(DisableHistoricalStatePruningFlag.Name, true); err != nil {
+		t.Fatal(err)
+	}
+	if c := FeatureConfig(); !c.DisableHistoricalStatePruning || !c.NoGenesisDelay {
+		t.Errorf("Expected the feature to be enabled and the others unchanged, got %+v", c)
+	}
+	if previous.DisableHistoricalStatePruning {
+		t.Error("Expected the previous config not to be modified")
+	}
+	enabled, err := FeatureEnabled(DisableHistoricalStatePruningFlag.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !enabled {
+		t.Error("Expected the feature to be enabled")
+	}
+}
+
+func TestSetFeature_NotToggleable(t *testing.T) {
+	if err := SetFeature(NoGenesisDelayFlag.Name, true); err == nil {
+		t.Error("Expected error toggling a feature which cannot be toggled at runtime")
+	}
+	if err := SetFeature("unknown", true); err == nil {
+		t.Error("Expected error toggling an unknown feature")
+	}
+}
+
+func TestReloadFeatures(t *testing.T) {
+	InitFeatureConfig(&FeatureFlagConfig{DisableHistoricalStatePruning: true})
+	defer InitFeatureConfig(&FeatureFlagConfig{})
+
+	err := ReloadFeatures(map[string]interface{}{
+		DisableHistoricalStatePruningFlag.Name: false,
+		NoGenesisDelayFlag.Name:                true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := FeatureConfig(); c.DisableHistoricalStatePruning || c.NoGenesisDelay {
+		t.Errorf("Expected only the toggleable feature to be reloaded, got %+v", c)
+	}
+
+	if err := ReloadFeatures(map[string]interface{}{DisableHistoricalStatePruningFlag.Name: "yes"}); err == nil {
+		t.Error("Expected error reloading a feature from a non boolean value")
+	}
+}
+
+func TestSetFeature_Concurrent(t *testing.T) {
+	defer InitFeatureConfig(&FeatureFlagConfig{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(enabled bool) {
+			defer wg.Done()
+			if err := SetFeature(DisableHistoricalStatePruningFlag.Name, enabled); err != nil {
+				t.Error(err)
+			}
+		}(i%2 == 0)
+		go func() {
+			defer wg.Done()
+			_ = FeatureConfig().DisableHistoricalStatePruning
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,43 @@
+// Package grpcutil defines the options shared by the gRPC servers of Prysm, so that
+// every RPC service uniformly reports request counts, latencies and error codes to
+// Prometheus and propagates opencensus trace spans.
+package grpcutil
+
+import (
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	grpc_prometheus.EnableHandlingTimeHistogram()
+}
+
+// ServerOptions returns the options to create a gRPC server with. Every request is
+// traced, recovered from panics and counted by method and status code, before being
+// passed through the given service specific interceptors, so that requests rejected
+// by those interceptors are reported as well.
+func ServerOptions(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) []grpc.ServerOption {
+	unaryChain := append([]grpc.UnaryServerInterceptor{
+		recovery.UnaryServerInterceptor(),
+		grpc_prometheus.UnaryServerInterceptor,
+	}, unary...)
+	streamChain := append([]grpc.StreamServerInterceptor{
+		recovery.StreamServerInterceptor(),
+		grpc_prometheus.StreamServerInterceptor,
+	}, stream...)
+	return []grpc.ServerOption{
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(unaryChain...)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(streamChain...)),
+	}
+}
+
+// RegisterMetrics initializes the metrics of every method of the services registered
+// on the server, so that methods which were never called are reported with a count
+// of zero rather than missing. It must be called after all services are registered.
+func RegisterMetrics(server *grpc.Server) {
+	grpc_prometheus.Register(server)
+}
@@ -0,0 +1,52 @@
+package grpcutil
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestServerOptions_ChainsInterceptors(t *testing.T) {
+	var methods []string
+	recordMethod := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methods = append(methods, info.FullMethod)
+		if len(methods) > 1 {
+			panic("interceptor panicked")
+		}
+		return nil, status.Error(codes.PermissionDenied, "rejected")
+	}
+
+	server := grpc.NewServer(ServerOptions([]grpc.UnaryServerInterceptor{recordMethod}, nil)...)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	RegisterMetrics(server)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected error from service interceptor, received %v", err)
+	}
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); status.Code(err) != codes.Internal {
+		t.Errorf("Expected panic to be recovered as an internal error, received %v", err)
+	}
+	if len(methods) != 2 || methods[0] != "/grpc.health.v1.Health/Check" {
+		t.Errorf("Expected service interceptor to be called for each request, received %v", methods)
+	}
+}
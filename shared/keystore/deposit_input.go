@@ -1,6 +1,9 @@
 package keystore
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/prysmaticlabs/go-ssz"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -39,6 +42,31 @@ func DepositInput(depositKey *Key, withdrawalKey *Key, amountInGwei uint64) (*et
 	return di, nil
 }
 
+// InteropDeposits returns the deposit data of the max effective balance for every key
+// of the keystore, which is used as its own withdrawal key. Deposits are ordered by
+// public key, so that every node deriving a chain from the same keystore agrees on it.
+func InteropDeposits(directory string, password string) ([]*ethpb.Deposit_Data, error) {
+	keys, err := NewKeystore(directory).GetKeys(directory, params.BeaconConfig().ValidatorPrivkeyFileName, password)
+	if err != nil {
+		return nil, fmt.Errorf("could not load keystore: %v", err)
+	}
+	pubKeys := make([]string, 0, len(keys))
+	for pubKey := range keys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Strings(pubKeys)
+
+	deposits := make([]*ethpb.Deposit_Data, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		data, err := DepositInput(keys[pubKey], keys[pubKey], params.BeaconConfig().MaxEffectiveBalance)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate deposit data: %v", err)
+		}
+		deposits[i] = data
+	}
+	return deposits, nil
+}
+
 // withdrawalCredentialsHash forms a 32 byte hash of the withdrawal public
 // address.
 //
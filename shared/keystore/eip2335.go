@@ -0,0 +1,205 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/text/unicode/norm"
+)
+
+// eip2335Version is the version of the keystores defined by EIP-2335.
+const eip2335Version = 4
+
+// eip2335KeyJSON is a keystore of the standard format defined by EIP-2335, used by the
+// eth2 deposit CLI and the other eth2 clients.
+type eip2335KeyJSON struct {
+	Crypto      eip2335CryptoJSON `json:"crypto"`
+	Description string            `json:"description"`
+	PubKey      string            `json:"pubkey"`
+	Path        string            `json:"path"`
+	UUID        string            `json:"uuid"`
+	Version     int               `json:"version"`
+}
+
+type eip2335CryptoJSON struct {
+	KDF      eip2335ModuleJSON `json:"kdf"`
+	Checksum eip2335ModuleJSON `json:"checksum"`
+	Cipher   eip2335ModuleJSON `json:"cipher"`
+}
+
+type eip2335ModuleJSON struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+// GetKeyEIP2335 from an EIP-2335 keystore file using the filename path and a decryption
+// password.
+func (ks Store) GetKeyEIP2335(filename, password string) (*Key, error) {
+	// #nosec G304
+	keyjson, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptKeyEIP2335(keyjson, password)
+}
+
+// StoreKeyEIP2335 in filepath as an EIP-2335 keystore encrypted with a password.
+func (ks Store) StoreKeyEIP2335(filename string, key *Key, password string) error {
+	keyjson, err := EncryptKeyEIP2335(key, password, ks.kdf)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(filename, keyjson)
+}
+
+// EncryptKeyEIP2335 encrypts a key into an EIP-2335 keystore. The standard only defines
+// the scrypt and pbkdf2 key derivation functions, so the key derivation function must be
+// scrypt.
+func EncryptKeyEIP2335(key *Key, password string, kdf KDFParams) ([]byte, error) {
+	if kdf.Function != keyHeaderKDF {
+		return nil, fmt.Errorf("unsupported KDF for EIP-2335 keystores: %s", kdf.Function)
+	}
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, errors.New("reading from crypto/rand failed: " + err.Error())
+	}
+	derivedKey, kdfParamsJSON, err := kdf.deriveKey(eip2335Password(password), salt)
+	if err != nil {
+		return nil, err
+	}
+	kdfParamsJSON["salt"] = hex.EncodeToString(salt)
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, errors.New("reading from crypto/rand failed: " + err.Error())
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], key.SecretKey.Marshal(), iv)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(append(derivedKey[16:32], cipherText...))
+
+	id := key.ID
+	if id == nil {
+		id = uuid.NewRandom()
+	}
+	return json.Marshal(&eip2335KeyJSON{
+		Crypto: eip2335CryptoJSON{
+			KDF: eip2335ModuleJSON{
+				Function: kdf.Function,
+				Params:   kdfParamsJSON,
+			},
+			Checksum: eip2335ModuleJSON{
+				Function: "sha256",
+				Params:   map[string]interface{}{},
+				Message:  hex.EncodeToString(checksum[:]),
+			},
+			Cipher: eip2335ModuleJSON{
+				Function: "aes-128-ctr",
+				Params:   map[string]interface{}{"iv": hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		PubKey:  hex.EncodeToString(key.PublicKey.Marshal()),
+		UUID:    id.String(),
+		Version: eip2335Version,
+	})
+}
+
+// DecryptKeyEIP2335 decrypts a key from an EIP-2335 keystore. The public key of the
+// keystore, if any, must be the public key of the decrypted secret key.
+func DecryptKeyEIP2335(keyjson []byte, password string) (*Key, error) {
+	k := new(eip2335KeyJSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return nil, err
+	}
+	if k.Version != eip2335Version {
+		return nil, fmt.Errorf("unsupported keystore version %d, expected %d", k.Version, eip2335Version)
+	}
+	if k.Crypto.Checksum.Function != "sha256" {
+		return nil, fmt.Errorf("checksum not supported: %v", k.Crypto.Checksum.Function)
+	}
+	if k.Crypto.Cipher.Function != "aes-128-ctr" {
+		return nil, fmt.Errorf("cipher not supported: %v", k.Crypto.Cipher.Function)
+	}
+	if _, ok := k.Crypto.KDF.Params["salt"].(string); !ok {
+		return nil, errors.New("missing KDF salt")
+	}
+	ivHex, ok := k.Crypto.Cipher.Params["iv"].(string)
+	if !ok {
+		return nil, errors.New("missing cipher IV")
+	}
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := hex.DecodeString(k.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, err
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := getKDFKey(cryptoJSON{
+		KDF:       k.Crypto.KDF.Function,
+		KDFParams: k.Crypto.KDF.Params,
+	}, string(eip2335Password(password)))
+	if err != nil {
+		return nil, err
+	}
+	if len(derivedKey) < 32 {
+		return nil, fmt.Errorf("derived key of %d bytes is too short", len(derivedKey))
+	}
+	calculatedChecksum := sha256.Sum256(append(derivedKey[16:32], cipherText...))
+	if !bytes.Equal(calculatedChecksum[:], checksum) {
+		return nil, ErrDecrypt
+	}
+	keyBytes, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	secretKey, err := bls.SecretKeyFromBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := secretKey.PublicKey()
+	if k.PubKey != "" && !strings.EqualFold(k.PubKey, hex.EncodeToString(publicKey.Marshal())) {
+		return nil, errors.New("public key of the keystore does not match its secret key")
+	}
+	id := uuid.Parse(k.UUID)
+	if id == nil {
+		id = uuid.NewRandom()
+	}
+	return &Key{
+		ID:        id,
+		PublicKey: publicKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// eip2335Password normalizes the password of an EIP-2335 keystore to its NFKD form and
+// strips its control codes, so that the same password typed on different systems
+// decrypts the keystore.
+func eip2335Password(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if r < 0x20 || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
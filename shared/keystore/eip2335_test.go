@@ -0,0 +1,59 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+)
+
+func TestEncryptDecryptKeyEIP2335(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyjson, err := EncryptKeyEIP2335(key, "password", FastKDF)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := new(eip2335KeyJSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		t.Fatal(err)
+	}
+	if k.Version != 4 || k.Crypto.KDF.Function != "scrypt" || k.UUID != key.ID.String() {
+		t.Errorf("Unexpected keystore %s", keyjson)
+	}
+
+	decrypted, err := DecryptKeyEIP2335(keyjson, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted.SecretKey.Marshal(), key.SecretKey.Marshal()) {
+		t.Error("Decrypted secret key is not the encrypted one")
+	}
+	if _, err := DecryptKeyEIP2335(keyjson, "wrong password"); err != ErrDecrypt {
+		t.Errorf("Expected a decryption error with a wrong password, received %v", err)
+	}
+}
+
+func TestEncryptKeyEIP2335_UnsupportedKDF(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EncryptKeyEIP2335(key, "password", Argon2idKDF); err == nil {
+		t.Error("Expected an error encrypting an EIP-2335 keystore with argon2id")
+	}
+}
+
+func TestEIP2335Password_NormalizesPassword(t *testing.T) {
+	// The test password of EIP-2335 is normalized to "testpassword🔑".
+	password := "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511"
+	if got := string(eip2335Password(password)); got != "testpassword\U0001f511" {
+		t.Errorf("Expected normalized password %q, received %q", "testpassword\U0001f511", got)
+	}
+	if got := string(eip2335Password("pass\x7fword\n")); got != "password" {
+		t.Errorf("Expected control codes to be stripped, received %q", got)
+	}
+}
@@ -0,0 +1,93 @@
+package keystore
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	argon2idKDF   = "argon2id"
+	argon2idDKLen = 32
+)
+
+// KDFParams defines the key derivation function which derives the encryption key of a
+// keystore from its password, along with the parameters trading off the time to encrypt
+// and decrypt keys against the cost of brute forcing the password.
+type KDFParams struct {
+	Function string // Function is either scrypt or argon2id.
+
+	// Parameters of scrypt.
+	ScryptN int
+	ScryptR int
+	ScryptP int
+
+	// Parameters of argon2id, the memory being in KiB.
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+var (
+	// FastKDF derives keys with scrypt using 4MB of memory, taking approximately 100ms of
+	// CPU time on a modern processor. It suits validators with many keys on trusted hosts.
+	FastKDF = KDFParams{Function: keyHeaderKDF, ScryptN: LightScryptN, ScryptR: scryptR, ScryptP: LightScryptP}
+
+	// StandardKDF derives keys with scrypt using 256MB of memory, taking approximately 1s
+	// of CPU time on a modern processor.
+	StandardKDF = KDFParams{Function: keyHeaderKDF, ScryptN: StandardScryptN, ScryptR: scryptR, ScryptP: StandardScryptP}
+
+	// SecureKDF derives keys with scrypt using 1GB of memory, taking approximately 4s of
+	// CPU time on a modern processor.
+	SecureKDF = KDFParams{Function: keyHeaderKDF, ScryptN: 1 << 20, ScryptR: scryptR, ScryptP: 1}
+
+	// Argon2idKDF derives keys with argon2id using 64MB of memory over 3 passes and 4
+	// threads, as recommended for password hashing.
+	Argon2idKDF = KDFParams{Function: argon2idKDF, Argon2Time: 3, Argon2Memory: 64 * 1024, Argon2Threads: 4}
+)
+
+var kdfPresets = map[string]KDFParams{
+	"fast":      FastKDF,
+	"standard":  StandardKDF,
+	"secure":    SecureKDF,
+	argon2idKDF: Argon2idKDF,
+}
+
+// KDFByName returns the key derivation function preset with the name, one of fast,
+// standard, secure and argon2id.
+func KDFByName(name string) (KDFParams, error) {
+	kdf, ok := kdfPresets[name]
+	if !ok {
+		return KDFParams{}, fmt.Errorf("unknown key derivation function %q, expected fast, standard, secure or argon2id", name)
+	}
+	return kdf, nil
+}
+
+// deriveKey derives the encryption key from the password and salt, returning it along
+// with the kdf params to store in the keystore.
+func (kdf KDFParams) deriveKey(password []byte, salt []byte) ([]byte, map[string]interface{}, error) {
+	switch kdf.Function {
+	case keyHeaderKDF:
+		derivedKey, err := scrypt.Key(password, salt, kdf.ScryptN, kdf.ScryptR, kdf.ScryptP, scryptDKLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		return derivedKey, map[string]interface{}{
+			"n":     kdf.ScryptN,
+			"r":     kdf.ScryptR,
+			"p":     kdf.ScryptP,
+			"dklen": scryptDKLen,
+		}, nil
+	case argon2idKDF:
+		derivedKey := argon2.IDKey(password, salt, kdf.Argon2Time, kdf.Argon2Memory, kdf.Argon2Threads, argon2idDKLen)
+		return derivedKey, map[string]interface{}{
+			"t":     kdf.Argon2Time,
+			"m":     kdf.Argon2Memory,
+			"p":     kdf.Argon2Threads,
+			"dklen": argon2idDKLen,
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported KDF: %s", kdf.Function)
+	}
+}
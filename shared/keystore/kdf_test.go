@@ -0,0 +1,43 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestEncryptDecryptKey_Argon2id(t *testing.T) {
+	key, err := NewKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("key generation failed %v", err)
+	}
+	kdf := KDFParams{Function: argon2idKDF, Argon2Time: 1, Argon2Memory: 1024, Argon2Threads: 1}
+	keyjson, err := EncryptKeyWithKDF(key, "password", kdf)
+	if err != nil {
+		t.Fatalf("unable to encrypt key %v", err)
+	}
+
+	newkey, err := DecryptKey(keyjson, "password")
+	if err != nil {
+		t.Fatalf("unable to decrypt keystore %v", err)
+	}
+	if !bytes.Equal(newkey.SecretKey.Marshal(), key.SecretKey.Marshal()) {
+		t.Errorf("decrypted key's value is not equal %v", newkey.SecretKey.Marshal())
+	}
+	if _, err := DecryptKey(keyjson, "wrong password"); err != ErrDecrypt {
+		t.Errorf("Expected %v decrypting with a wrong password, got %v", ErrDecrypt, err)
+	}
+}
+
+func TestKDFByName(t *testing.T) {
+	kdf, err := KDFByName("secure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kdf != SecureKDF {
+		t.Errorf("Expected the secure preset, got %v", kdf)
+	}
+	if _, err := KDFByName("md5"); err == nil {
+		t.Error("Expected error with an unknown key derivation function")
+	}
+}
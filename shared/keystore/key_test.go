@@ -49,8 +49,7 @@ func TestStoreRandomKey(t *testing.T) {
 	filedir := tmpdir + "/keystore"
 	ks := &Store{
 		keysDirPath: filedir,
-		scryptN:     LightScryptN,
-		scryptP:     LightScryptP,
+		kdf:         FastKDF,
 	}
 
 	reader := rand.Reader
@@ -30,10 +30,15 @@ import (
 	"io"
 	"io/ioutil"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/pbkdf2"
 	"golang.org/x/crypto/scrypt"
 )
@@ -43,19 +48,28 @@ var (
 	ErrDecrypt = errors.New("could not decrypt key with given passphrase")
 )
 
-// Store defines a keystore with a directory path and scrypt values.
+var log = logrus.WithField("prefix", "keystore")
+
+// Keystore files are decrypted by at most maxDecryptionWorkers workers, as decrypting a
+// key with the standard scrypt parameters uses 256MB of memory, and the progress of the
+// decryption is logged every decryptionProgressInterval.
+var (
+	maxDecryptionWorkers       = 4
+	decryptionProgressInterval = 5 * time.Second
+)
+
+// Store defines a keystore with a directory path and the key derivation function
+// encrypting its keys.
 type Store struct {
 	keysDirPath string
-	scryptN     int
-	scryptP     int
+	kdf         KDFParams
 }
 
 // RetrievePubKey retrieves the public key from the keystore.
 func RetrievePubKey(directory string, password string) (*bls.PublicKey, error) {
 	ks := Store{
 		keysDirPath: directory,
-		scryptN:     StandardScryptN,
-		scryptP:     StandardScryptP,
+		kdf:         StandardKDF,
 	}
 	key, err := ks.GetKey(ks.keysDirPath, password)
 	return key.PublicKey, err
@@ -63,10 +77,15 @@ func RetrievePubKey(directory string, password string) (*bls.PublicKey, error) {
 
 // NewKeystore from a directory.
 func NewKeystore(directory string) Store {
+	return NewKeystoreWithKDF(directory, StandardKDF)
+}
+
+// NewKeystoreWithKDF from a directory, encrypting the stored keys with the key
+// derivation function.
+func NewKeystoreWithKDF(directory string, kdf KDFParams) Store {
 	return Store{
 		keysDirPath: directory,
-		scryptN:     StandardScryptN,
-		scryptP:     StandardScryptP,
+		kdf:         kdf,
 	}
 }
 
@@ -82,7 +101,8 @@ func (ks Store) GetKey(filename, password string) (*Key, error) {
 }
 
 // GetKeys from directory using the prefix to filter relevant files
-// and a decryption password.
+// and a decryption password. The files are decrypted concurrently, and
+// the errors of every file which could not be decrypted are returned.
 func (ks Store) GetKeys(directory, fileprefix, password string) (map[string]*Key, error) {
 	// Load the key from the keystore and decrypt its contents
 	// #nosec G304
@@ -90,31 +110,77 @@ func (ks Store) GetKeys(directory, fileprefix, password string) (map[string]*Key
 	if err != nil {
 		return nil, err
 	}
-	keys := make(map[string]*Key)
+	var filePaths []string
 	for _, f := range files {
 		n := f.Name()
 		filePath := filepath.Join(directory, n)
 		filePath = filepath.Clean(filePath)
 		cp := strings.Contains(n, strings.TrimPrefix(fileprefix, "/"))
 		if f.Mode().IsRegular() && cp {
-			// #nosec G304
-			keyjson, err := ioutil.ReadFile(filePath)
-			if err != nil {
-				return nil, err
-			}
-			key, err := DecryptKey(keyjson, password)
-			if err != nil {
-				return nil, err
-			}
-			keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+			filePaths = append(filePaths, filePath)
+		}
+	}
+
+	results := ks.decryptFiles(filePaths, password)
+	keys := make(map[string]*Key, len(filePaths))
+	var failures []string
+	lastLog := time.Now()
+	for i := range filePaths {
+		res := <-results
+		if res.err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", res.filePath, res.err))
+		} else {
+			keys[hex.EncodeToString(res.key.PublicKey.Marshal())] = res.key
+		}
+		if time.Since(lastLog) >= decryptionProgressInterval {
+			log.WithFields(logrus.Fields{
+				"decrypted": i + 1,
+				"total":     len(filePaths),
+			}).Info("Decrypting keystore files")
+			lastLog = time.Now()
 		}
 	}
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return nil, fmt.Errorf("could not decrypt %d of %d keystore files: %s", len(failures), len(filePaths), strings.Join(failures, "; "))
+	}
 	return keys, nil
 }
 
+type decryptionResult struct {
+	filePath string
+	key      *Key
+	err      error
+}
+
+// decryptFiles decrypts the keystore files with a bounded pool of workers, sending the
+// result of each file to the returned channel.
+func (ks Store) decryptFiles(filePaths []string, password string) <-chan *decryptionResult {
+	workers := runtime.NumCPU()
+	if workers > maxDecryptionWorkers {
+		workers = maxDecryptionWorkers
+	}
+	jobs := make(chan string, len(filePaths))
+	for _, filePath := range filePaths {
+		jobs <- filePath
+	}
+	close(jobs)
+
+	results := make(chan *decryptionResult, len(filePaths))
+	for i := 0; i < workers; i++ {
+		go func() {
+			for filePath := range jobs {
+				key, err := ks.GetKey(filePath, password)
+				results <- &decryptionResult{filePath: filePath, key: key, err: err}
+			}
+		}()
+	}
+	return results
+}
+
 // StoreKey in filepath and encrypt it with a password.
 func (ks Store) StoreKey(filename string, key *Key, auth string) error {
-	keyjson, err := EncryptKey(key, auth, ks.scryptN, ks.scryptP)
+	keyjson, err := EncryptKeyWithKDF(key, auth, ks.kdf)
 	if err != nil {
 		return err
 	}
@@ -131,20 +197,28 @@ func (ks Store) JoinPath(filename string) string {
 
 // StoreRandomKey generates a key, encrypts with 'auth' and stores in the given directory
 func StoreRandomKey(dir, password string, scryptN, scryptP int) error {
-	err := storeNewRandomKey(Store{dir, scryptN, scryptP}, rand.Reader, password)
+	kdf := KDFParams{Function: keyHeaderKDF, ScryptN: scryptN, ScryptR: scryptR, ScryptP: scryptP}
+	err := storeNewRandomKey(NewKeystoreWithKDF(dir, kdf), rand.Reader, password)
 	return err
 }
 
 // EncryptKey encrypts a key using the specified scrypt parameters into a json
 // blob that can be decrypted later on.
 func EncryptKey(key *Key, password string, scryptN, scryptP int) ([]byte, error) {
+	kdf := KDFParams{Function: keyHeaderKDF, ScryptN: scryptN, ScryptR: scryptR, ScryptP: scryptP}
+	return EncryptKeyWithKDF(key, password, kdf)
+}
+
+// EncryptKeyWithKDF encrypts a key using the specified key derivation function into a
+// json blob that can be decrypted later on.
+func EncryptKeyWithKDF(key *Key, password string, kdf KDFParams) ([]byte, error) {
 	authArray := []byte(password)
 	salt := make([]byte, 32)
 	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
 		panic("reading from crypto/rand failed: " + err.Error())
 	}
 
-	derivedKey, err := scrypt.Key(authArray, salt, scryptN, scryptR, scryptP, scryptDKLen)
+	derivedKey, kdfParamsJSON, err := kdf.deriveKey(authArray, salt)
 	if err != nil {
 		return nil, err
 	}
@@ -164,12 +238,7 @@ func EncryptKey(key *Key, password string, scryptN, scryptP int) ([]byte, error)
 
 	mac := Keccak256(derivedKey[16:32], cipherText)
 
-	scryptParamsJSON := make(map[string]interface{}, 5)
-	scryptParamsJSON["n"] = scryptN
-	scryptParamsJSON["r"] = scryptR
-	scryptParamsJSON["p"] = scryptP
-	scryptParamsJSON["dklen"] = scryptDKLen
-	scryptParamsJSON["salt"] = hex.EncodeToString(salt)
+	kdfParamsJSON["salt"] = hex.EncodeToString(salt)
 
 	cipherParamsJSON := cipherparamsJSON{
 		IV: hex.EncodeToString(iv),
@@ -179,8 +248,8 @@ func EncryptKey(key *Key, password string, scryptN, scryptP int) ([]byte, error)
 		Cipher:       "aes-128-ctr",
 		CipherText:   hex.EncodeToString(cipherText),
 		CipherParams: cipherParamsJSON,
-		KDF:          keyHeaderKDF,
-		KDFParams:    scryptParamsJSON,
+		KDF:          kdf.Function,
+		KDFParams:    kdfParamsJSON,
 		MAC:          hex.EncodeToString(mac),
 	}
 	encryptedJSON := encryptedKeyJSON{
@@ -271,6 +340,12 @@ func getKDFKey(cryptoJSON cryptoJSON, auth string) ([]byte, error) {
 		p := ensureInt(cryptoJSON.KDFParams["p"])
 		return scrypt.Key(authArray, salt, n, r, p, dkLen)
 
+	} else if cryptoJSON.KDF == argon2idKDF {
+		t := ensureInt(cryptoJSON.KDFParams["t"])
+		m := ensureInt(cryptoJSON.KDFParams["m"])
+		p := ensureInt(cryptoJSON.KDFParams["p"])
+		return argon2.IDKey(authArray, salt, uint32(t), uint32(m), uint8(p), uint32(dkLen)), nil
+
 	} else if cryptoJSON.KDF == "pbkdf2" {
 		c := ensureInt(cryptoJSON.KDFParams["c"])
 		prf := cryptoJSON.KDFParams["prf"].(string)
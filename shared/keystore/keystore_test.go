@@ -3,7 +3,10 @@ package keystore
 import (
 	"bytes"
 	"crypto/rand"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/pborman/uuid"
@@ -17,8 +20,7 @@ func TestStoreAndGetKey(t *testing.T) {
 	filedir := tmpdir + "/keystore"
 	ks := &Store{
 		keysDirPath: filedir,
-		scryptN:     LightScryptN,
-		scryptP:     LightScryptP,
+		kdf:         FastKDF,
 	}
 
 	key, err := NewKey(rand.Reader)
@@ -49,8 +51,7 @@ func TestStoreAndGetKeys(t *testing.T) {
 	filePrefix := "/keystore"
 	ks := &Store{
 		keysDirPath: tmpdir,
-		scryptN:     LightScryptN,
-		scryptP:     LightScryptP,
+		kdf:         FastKDF,
 	}
 
 	key, err := NewKey(rand.Reader)
@@ -123,3 +124,39 @@ func TestEncryptDecryptKey(t *testing.T) {
 	}
 
 }
+
+func TestGetKeys_ReportsUndecryptableFiles(t *testing.T) {
+	tmpdir := testutil.TempDir()
+	filedir := tmpdir + "/keystore-parallel"
+	defer os.RemoveAll(filedir)
+	ks := &Store{
+		keysDirPath: filedir,
+		kdf:         FastKDF,
+	}
+
+	for i := 0; i < 6; i++ {
+		key, err := NewKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("key generation failed %v", err)
+		}
+		if err := ks.StoreKey(fmt.Sprintf("%s/test-%d", filedir, i), key, "password"); err != nil {
+			t.Fatalf("unable to store key %v", err)
+		}
+	}
+	keys, err := ks.GetKeys(filedir, "test", "password")
+	if err != nil {
+		t.Fatalf("unable to get keys %v", err)
+	}
+	if len(keys) != 6 {
+		t.Errorf("Expected 6 keys, got %d", len(keys))
+	}
+
+	corrupted := filedir + "/test-corrupted"
+	if err := ioutil.WriteFile(corrupted, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ks.GetKeys(filedir, "test", "password")
+	if err == nil || !strings.Contains(err.Error(), corrupted) || !strings.Contains(err.Error(), "1 of 7") {
+		t.Errorf("Expected error reporting the corrupted file, got %v", err)
+	}
+}
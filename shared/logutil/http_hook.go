@@ -0,0 +1,140 @@
+package logutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Entries are pushed to the HTTP endpoint in batches, once the batch is full or the
+// flush interval has elapsed. Entries beyond the buffer size are dropped rather than
+// blocking the logging of the node while the endpoint is unreachable.
+var (
+	httpLogBatchSize     = 100
+	httpLogBufferSize    = 10000
+	httpLogFlushInterval = time.Second
+)
+
+// lokiPush is the body of a push request to the Loki HTTP API.
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// httpHook is a logrus hook pushing the log entries, formatted as JSON, to an HTTP
+// endpoint compatible with the Loki push API.
+type httpHook struct {
+	endpoint  string
+	labels    map[string]string
+	formatter logrus.Formatter
+	client    *http.Client
+	entries   chan [2]string
+}
+
+// ConfigureHTTPLogging pushes the logs to the HTTP endpoint, compatible with the Loki push
+// API, under a stream labeled with the job and the labels given as key=value.
+func ConfigureHTTPLogging(endpoint string, job string, labels []string) error {
+	hook, err := newHTTPHook(endpoint, job, labels)
+	if err != nil {
+		return err
+	}
+	go hook.run()
+	logrus.AddHook(hook)
+	logrus.WithField("endpoint", endpoint).Info("Logs will be pushed to HTTP endpoint")
+	return nil
+}
+
+func newHTTPHook(endpoint string, job string, labels []string) (*httpHook, error) {
+	if u, err := url.Parse(endpoint); err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid log endpoint %q", endpoint)
+	}
+	streamLabels := map[string]string{"job": job}
+	for _, label := range labels {
+		kv := strings.SplitN(label, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid log label %q, expected key=value", label)
+		}
+		streamLabels[kv[0]] = kv[1]
+	}
+	return &httpHook{
+		endpoint:  endpoint,
+		labels:    streamLabels,
+		formatter: &logrus.JSONFormatter{},
+		client:    &http.Client{Timeout: 10 * time.Second},
+		entries:   make(chan [2]string, httpLogBufferSize),
+	}, nil
+}
+
+// Levels of the entries sent by the hook.
+func (h *httpHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire queues the entry to be pushed to the endpoint.
+func (h *httpHook) Fire(entry *logrus.Entry) error {
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	select {
+	case h.entries <- [2]string{strconv.FormatInt(entry.Time.UnixNano(), 10), strings.TrimSuffix(string(line), "\n")}:
+	default:
+	}
+	return nil
+}
+
+func (h *httpHook) run() {
+	ticker := time.NewTicker(httpLogFlushInterval)
+	defer ticker.Stop()
+	var batch [][2]string
+	for {
+		select {
+		case value := <-h.entries:
+			batch = append(batch, value)
+			if len(batch) < httpLogBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		if err := h.push(batch); err != nil {
+			// Logging the failure would feed it back to the hook.
+			fmt.Fprintf(os.Stderr, "Could not push logs to %s: %v\n", h.endpoint, err)
+		}
+		batch = nil
+	}
+}
+
+func (h *httpHook) push(values [][2]string) error {
+	body, err := json.Marshal(&lokiPush{
+		Streams: []lokiStream{{Stream: h.labels, Values: values}},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Post(h.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package logutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestHTTPHook_PushesEntries(t *testing.T) {
+	pushes := make(chan *lokiPush, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		push := &lokiPush{}
+		if err := json.NewDecoder(r.Body).Decode(push); err != nil {
+			t.Error(err)
+		}
+		pushes <- push
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	hook, err := newHTTPHook(srv.URL, "beacon-chain", []string{"network=testnet"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go hook.run()
+
+	logger := logrus.New()
+	logger.AddHook(hook)
+	logger.WithField("slot", 1).Info("Processed block")
+
+	select {
+	case push := <-pushes:
+		stream := push.Streams[0]
+		if stream.Stream["job"] != "beacon-chain" || stream.Stream["network"] != "testnet" {
+			t.Errorf("Unexpected stream labels %v", stream.Stream)
+		}
+		if len(stream.Values) != 1 || !strings.Contains(stream.Values[0][1], "Processed block") {
+			t.Errorf("Unexpected stream values %v", stream.Values)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the logs to be pushed")
+	}
+}
+
+func TestNewHTTPHook_InvalidLabel(t *testing.T) {
+	if _, err := newHTTPHook("http://localhost:3100/loki/api/v1/push", "validator", []string{"network"}); err == nil {
+		t.Error("Expected error with a label without a value")
+	}
+}
@@ -12,9 +12,13 @@ import (
 )
 
 // ConfigurePersistentLogging adds a log-to-file writer. File content is identical to stdout.
-func ConfigurePersistentLogging(logFileName string) error {
+// The file is rotated according to the rotation config, if any.
+func ConfigurePersistentLogging(logFileName string, rotation *RotationConfig) error {
 	logrus.WithField("logFileName", logFileName).Info("Logs will be made persistent")
-	f, err := os.OpenFile(logFileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if rotation == nil {
+		rotation = &RotationConfig{}
+	}
+	f, err := newRotatingFile(logFileName, rotation)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,226 @@
+package logutil
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat is the format of the rotation time in the name of the backups, which
+// sorts the backups from oldest to newest.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// currentTime is replaced in tests to control the names of the backups.
+var currentTime = time.Now
+
+// RotationConfig defines when the log file is rotated and which backups are kept.
+type RotationConfig struct {
+	MaxSizeMB  int  // MaxSizeMB is the size of the log file, in megabytes, beyond which it is rotated. 0 disables rotation.
+	MaxBackups int  // MaxBackups is the number of rotated files to keep. 0 keeps all of them.
+	MaxAgeDays int  // MaxAgeDays is the number of days after which rotated files are removed. 0 keeps all of them.
+	Compress   bool // Compress the rotated files with gzip.
+}
+
+// rotatingFile is a log file writer which renames the file to a timestamped backup once
+// it exceeds its max size and starts a new file. The backups are compressed and removed
+// according to the rotation config in the background, so that writes are not blocked.
+type rotatingFile struct {
+	name      string
+	config    *RotationConfig
+	lock      sync.Mutex
+	file      *os.File
+	size      int64
+	closed    bool
+	cleanupCh chan time.Time
+	done      chan struct{}
+}
+
+func newRotatingFile(name string, config *RotationConfig) (*rotatingFile, error) {
+	r := &rotatingFile{
+		name:      name,
+		config:    config,
+		cleanupCh: make(chan time.Time, 1),
+		done:      make(chan struct{}),
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	go r.runCleanup()
+	return r, nil
+}
+
+// Write the bytes to the log file, rotating it first if they would exceed its max size.
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	// The log file may not be open if a previous rotation could not open it again.
+	if r.file == nil {
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	maxSize := int64(r.config.MaxSizeMB) * 1024 * 1024
+	if maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > maxSize {
+		if err := r.rotate(); err != nil {
+			// Keep writing to the current log file, the rotation is retried on the next write.
+			fmt.Fprintf(os.Stderr, "Could not rotate log file: %v\n", err)
+		}
+		if r.file == nil {
+			return 0, fmt.Errorf("could not open log file %s", r.name)
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close the log file and wait for the pending cleanup of the backups.
+func (r *rotatingFile) Close() error {
+	r.lock.Lock()
+	if r.closed {
+		r.lock.Unlock()
+		return nil
+	}
+	r.closed = true
+	close(r.cleanupCh)
+	var err error
+	if r.file != nil {
+		err = r.file.Close()
+	}
+	r.lock.Unlock()
+
+	<-r.done
+	return err
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+// rotate renames the log file to a backup, opens a new log file and schedules the cleanup
+// of the backups, the lock must be held by the caller. If the log file cannot be rotated,
+// it is reopened so that logs are not lost, or left closed if even that fails.
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.file = nil
+	now := currentTime()
+	backup := r.name + "." + now.UTC().Format(backupTimeFormat)
+	if err := os.Rename(r.name, backup); err != nil {
+		if openErr := r.open(); openErr != nil {
+			return fmt.Errorf("could not rename log file: %v, and could not reopen it: %v", err, openErr)
+		}
+		return fmt.Errorf("could not rename log file: %v", err)
+	}
+	if err := r.open(); err != nil {
+		// Restore the backup as the log file rather than dropping logs.
+		if renameErr := os.Rename(backup, r.name); renameErr != nil {
+			return fmt.Errorf("could not open new log file: %v, and could not restore it: %v", err, renameErr)
+		}
+		if openErr := r.open(); openErr != nil {
+			return fmt.Errorf("could not open new log file: %v, and could not reopen it: %v", err, openErr)
+		}
+		return fmt.Errorf("could not open new log file: %v", err)
+	}
+
+	// A pending cleanup also covers this backup.
+	select {
+	case r.cleanupCh <- now:
+	default:
+	}
+	return nil
+}
+
+// runCleanup cleans up the backups after each rotation until the log file is closed.
+func (r *rotatingFile) runCleanup() {
+	defer close(r.done)
+	for now := range r.cleanupCh {
+		if err := r.cleanup(now); err != nil {
+			fmt.Fprintf(os.Stderr, "Could not clean up log backups: %v\n", err)
+		}
+	}
+}
+
+// cleanup compresses the backups, if configured, and removes the backups beyond the
+// max number of backups or older than the max age at the given time.
+func (r *rotatingFile) cleanup(now time.Time) error {
+	backups, err := filepath.Glob(r.name + ".*")
+	if err != nil {
+		return err
+	}
+	// Newest backups first.
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	cutoff := now.Add(-time.Duration(r.config.MaxAgeDays) * 24 * time.Hour)
+	kept := 0
+	for _, backup := range backups {
+		rotatedAt, err := time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(backup, r.name+"."), ".gz"))
+		if err != nil {
+			// Not a backup of the log file.
+			continue
+		}
+		if (r.config.MaxBackups > 0 && kept >= r.config.MaxBackups) ||
+			(r.config.MaxAgeDays > 0 && rotatedAt.Before(cutoff)) {
+			if err := os.Remove(backup); err != nil {
+				return fmt.Errorf("could not remove log backup: %v", err)
+			}
+			continue
+		}
+		kept++
+		if r.config.Compress && !strings.HasSuffix(backup, ".gz") {
+			if err := compressFile(backup); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compressFile replaces the file with its gzip compressed copy.
+func compressFile(name string) error {
+	// #nosec - Inclusion of file via variable is OK for the log backups.
+	src, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("could not open log backup: %v", err)
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(name+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+	if err != nil {
+		return fmt.Errorf("could not create compressed log backup: %v", err)
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("could not compress log backup: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		dst.Close()
+		return fmt.Errorf("could not compress log backup: %v", err)
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
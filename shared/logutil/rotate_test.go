@@ -0,0 +1,154 @@
+package logutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupRotatingFile(t *testing.T, config *RotationConfig) (*rotatingFile, string) {
+	dir, err := ioutil.TempDir("", "logutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := newRotatingFile(filepath.Join(dir, "beacon.log"), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, dir
+}
+
+func advanceTime() func() {
+	now := time.Now()
+	currentTime = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return func() {
+		currentTime = time.Now
+	}
+}
+
+func TestRotatingFile_RotatesAtMaxSize(t *testing.T) {
+	defer advanceTime()()
+	r, dir := setupRotatingFile(t, &RotationConfig{MaxSizeMB: 1, MaxBackups: 2})
+	defer os.RemoveAll(dir)
+	defer r.Close()
+
+	line := []byte(strings.Repeat("a", 1024*1024-1) + "\n")
+	for i := 0; i < 4; i++ {
+		if _, err := r.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Wait for the backups to be cleaned up in the background.
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := filepath.Glob(r.name + ".*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Errorf("Expected 2 backups, got %v", backups)
+	}
+	info, err := os.Stat(r.name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != int64(len(line)) {
+		t.Errorf("Expected log file of size %d, got %d", len(line), info.Size())
+	}
+}
+
+func TestRotatingFile_CompressesBackups(t *testing.T) {
+	defer advanceTime()()
+	r, dir := setupRotatingFile(t, &RotationConfig{MaxSizeMB: 1, Compress: true})
+	defer os.RemoveAll(dir)
+	defer r.Close()
+
+	line := []byte(strings.Repeat("a", 1024*1024-1) + "\n")
+	for i := 0; i < 3; i++ {
+		if _, err := r.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Wait for the backups to be cleaned up in the background.
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	backups, err := filepath.Glob(r.name + ".*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("Expected 2 backups, got %v", backups)
+	}
+	for _, backup := range backups {
+		if !strings.HasSuffix(backup, ".gz") {
+			t.Errorf("Expected compressed backup, got %s", backup)
+		}
+	}
+}
+
+func TestRotatingFile_RemovesOldBackups(t *testing.T) {
+	r, dir := setupRotatingFile(t, &RotationConfig{MaxSizeMB: 1, MaxAgeDays: 1})
+	defer os.RemoveAll(dir)
+	defer r.Close()
+
+	old := r.name + "." + time.Now().Add(-48*time.Hour).UTC().Format(backupTimeFormat)
+	recent := r.name + "." + time.Now().Add(-time.Hour).UTC().Format(backupTimeFormat)
+	for _, name := range []string{old, recent} {
+		if err := ioutil.WriteFile(name, []byte("log"), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.cleanup(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(old); !os.IsNotExist(err) {
+		t.Error("Expected backup older than the max age to be removed")
+	}
+	if _, err := os.Stat(recent); err != nil {
+		t.Errorf("Expected recent backup to be kept: %v", err)
+	}
+}
+
+func TestRotatingFile_ReopensAfterFailedRotation(t *testing.T) {
+	defer advanceTime()()
+	r, dir := setupRotatingFile(t, &RotationConfig{MaxSizeMB: 1})
+	defer os.RemoveAll(dir)
+	defer r.Close()
+
+	line := []byte(strings.Repeat("a", 1024*1024-1) + "\n")
+	if _, err := r.Write(line); err != nil {
+		t.Fatal(err)
+	}
+	// Neither the log file nor a new one can be opened while its directory is missing.
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Write(line); err == nil {
+		t.Error("Expected error writing without a log file")
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Write(line); err != nil {
+		t.Fatalf("Expected the log file to be reopened: %v", err)
+	}
+	info, err := os.Stat(r.name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size() != int64(len(line)) {
+		t.Errorf("Expected log file of size %d, got %d", len(line), info.Size())
+	}
+}
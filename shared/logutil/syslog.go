@@ -0,0 +1,30 @@
+package logutil
+
+import (
+	"fmt"
+	"log/syslog"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+	lSyslog "github.com/sirupsen/logrus/hooks/syslog"
+)
+
+// ConfigureSyslog sends the logs to the syslog server at the address, given as
+// network://host:port, or to the local syslog daemon if the address is "local".
+func ConfigureSyslog(address string, tag string) error {
+	var network, raddr string
+	if address != "local" {
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid syslog address %q, expected network://host:port or local", address)
+		}
+		network, raddr = u.Scheme, u.Host
+	}
+	hook, err := lSyslog.NewSyslogHook(network, raddr, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return fmt.Errorf("could not connect to syslog: %v", err)
+	}
+	logrus.AddHook(hook)
+	logrus.WithField("address", address).Info("Logs will be sent to syslog")
+	return nil
+}
@@ -0,0 +1,83 @@
+// Package metrics owns the Prometheus registry of the node. The metrics of every service
+// are created with the constructors of this package, which register them with the
+// registry exposed by the prometheus service.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Registry of the metrics of the node, along with the standard Go and process collectors.
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+	// The standard collectors are removed from the default registry, so that they are not
+	// exported twice along with the metrics of libraries registered with it.
+	prometheus.Unregister(prometheus.NewGoCollector())
+	prometheus.Unregister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+}
+
+// Gatherer of the metrics of the node and of the libraries registered with the default
+// registry, such as the gRPC server metrics.
+func Gatherer() prometheus.Gatherer {
+	return prometheus.Gatherers{Registry, prometheus.DefaultGatherer}
+}
+
+// MustRegister registers the collectors with the registry, panicking on failure.
+func MustRegister(collectors ...prometheus.Collector) {
+	Registry.MustRegister(collectors...)
+}
+
+// NewCounter creates a counter registered with the registry.
+func NewCounter(opts prometheus.CounterOpts) prometheus.Counter {
+	c := prometheus.NewCounter(opts)
+	Registry.MustRegister(c)
+	return c
+}
+
+// NewCounterVec creates a counter vector registered with the registry.
+func NewCounterVec(opts prometheus.CounterOpts, labelNames []string) *prometheus.CounterVec {
+	c := prometheus.NewCounterVec(opts, labelNames)
+	Registry.MustRegister(c)
+	return c
+}
+
+// NewGauge creates a gauge registered with the registry.
+func NewGauge(opts prometheus.GaugeOpts) prometheus.Gauge {
+	g := prometheus.NewGauge(opts)
+	Registry.MustRegister(g)
+	return g
+}
+
+// NewGaugeVec creates a gauge vector registered with the registry.
+func NewGaugeVec(opts prometheus.GaugeOpts, labelNames []string) *prometheus.GaugeVec {
+	g := prometheus.NewGaugeVec(opts, labelNames)
+	Registry.MustRegister(g)
+	return g
+}
+
+// NewGaugeFunc creates a gauge whose value is given by the function, registered with the
+// registry.
+func NewGaugeFunc(opts prometheus.GaugeOpts, function func() float64) prometheus.GaugeFunc {
+	g := prometheus.NewGaugeFunc(opts, function)
+	Registry.MustRegister(g)
+	return g
+}
+
+// NewHistogram creates a histogram registered with the registry.
+func NewHistogram(opts prometheus.HistogramOpts) prometheus.Histogram {
+	h := prometheus.NewHistogram(opts)
+	Registry.MustRegister(h)
+	return h
+}
+
+// NewHistogramVec creates a histogram vector registered with the registry.
+func NewHistogramVec(opts prometheus.HistogramOpts, labelNames []string) *prometheus.HistogramVec {
+	h := prometheus.NewHistogramVec(opts, labelNames)
+	Registry.MustRegister(h)
+	return h
+}
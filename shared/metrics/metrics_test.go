@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestGatherer_ExportsStandardCollectorsOnce(t *testing.T) {
+	families, err := Gatherer().Gather()
+	if err != nil {
+		t.Fatalf("Could not gather metrics: %v", err)
+	}
+	found := false
+	for _, family := range families {
+		if family.GetName() == "go_goroutines" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected the Go collector metrics to be gathered")
+	}
+}
+
+func TestNewCounter_RegistersWithRegistry(t *testing.T) {
+	c := NewCounter(prometheus.CounterOpts{
+		Name: "test_metrics_counter",
+		Help: "A counter for testing",
+	})
+	c.Inc()
+	families, err := Registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() == "test_metrics_counter" {
+			if v := family.Metric[0].Counter.GetValue(); v != 1 {
+				t.Errorf("Expected counter value 1, got %f", v)
+			}
+			return
+		}
+	}
+	t.Error("Expected counter to be registered with the registry")
+}
@@ -7,19 +7,19 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 )
 
 var (
-	messagesCompleted = promauto.NewCounterVec(
+	messagesCompleted = metrics.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_sent_total",
 			Help: "Count of messages sent.",
 		},
 		[]string{"message"},
 	)
-	sendLatency = promauto.NewHistogramVec(
+	sendLatency = metrics.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "p2p_message_sent_latency_seconds",
 			Help:    "Latency of messages sent.",
@@ -27,7 +27,7 @@ var (
 		},
 		[]string{"message"},
 	)
-	messageSize = promauto.NewHistogramVec(
+	messageSize = metrics.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "p2p_message_received_bytes",
 			Help:    "Size of received messages.",
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Result codes prefixed to every response chunk. A non-success code is followed by
+// a UTF-8 encoded error message instead of a payload.
+const (
+	ResponseCodeSuccess        byte = 0
+	ResponseCodeInvalidRequest byte = 1
+	ResponseCodeServerError    byte = 2
+)
+
+// DefaultMaxChunkSize is the default upper bound, in bytes, of a single response chunk.
+// We accommodate chunks as large as ~17Mb as full beacon states are transmitted over the wire.
+const DefaultMaxChunkSize = maxMessageSize
+
+// DefaultMaxRequestCount is the default number of chunks accepted in a single response.
+const DefaultMaxRequestCount = 1024
+
+// ErrChunkTooLarge is returned when a chunk's length prefix exceeds the max chunk size.
+var ErrChunkTooLarge = errors.New("chunk exceeds max chunk size")
+
+// ErrTooManyChunks is returned when a response contains more chunks than the max request count.
+var ErrTooManyChunks = errors.New("response exceeds max request count")
+
+// writeChunk writes a single response chunk of the form:
+//
+//	result code (1 byte) | payload length (unsigned varint) | payload
+func writeChunk(w io.Writer, code byte, payload []byte, maxChunkSize uint64) error {
+	if uint64(len(payload)) > maxChunkSize {
+		return ErrChunkTooLarge
+	}
+	header := make([]byte, 1+binary.MaxVarintLen64)
+	header[0] = code
+	n := binary.PutUvarint(header[1:], uint64(len(payload)))
+	if _, err := w.Write(header[:1+n]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readChunk reads a single response chunk written by writeChunk. The length prefix
+// is checked against maxChunkSize before any payload is allocated.
+func readChunk(r *bufio.Reader, maxChunkSize uint64) (byte, []byte, error) {
+	code, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, fmt.Errorf("could not read chunk length: %v", err)
+	}
+	if length > maxChunkSize {
+		return 0, nil, ErrChunkTooLarge
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, fmt.Errorf("could not read chunk payload: %v", err)
+	}
+	return code, payload, nil
+}
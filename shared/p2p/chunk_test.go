@@ -0,0 +1,58 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestChunk_RoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	payloads := [][]byte{[]byte("first"), {}, []byte("third")}
+	for _, p := range payloads {
+		if err := writeChunk(buf, ResponseCodeSuccess, p, DefaultMaxChunkSize); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writeChunk(buf, ResponseCodeServerError, []byte("oops"), DefaultMaxChunkSize); err != nil {
+		t.Fatal(err)
+	}
+
+	r := bufio.NewReader(buf)
+	for _, want := range payloads {
+		code, payload, err := readChunk(r, DefaultMaxChunkSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != ResponseCodeSuccess {
+			t.Errorf("Expected success code, received %d", code)
+		}
+		if !bytes.Equal(payload, want) {
+			t.Errorf("Expected payload %q, received %q", want, payload)
+		}
+	}
+	code, payload, err := readChunk(r, DefaultMaxChunkSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != ResponseCodeServerError || string(payload) != "oops" {
+		t.Errorf("Expected server error chunk, received code %d with %q", code, payload)
+	}
+	if _, _, err := readChunk(r, DefaultMaxChunkSize); err != io.EOF {
+		t.Errorf("Expected EOF, received %v", err)
+	}
+}
+
+func TestChunk_TooLarge(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := writeChunk(buf, ResponseCodeSuccess, make([]byte, 11), 10); err != ErrChunkTooLarge {
+		t.Errorf("Expected %v when writing, received %v", ErrChunkTooLarge, err)
+	}
+	if err := writeChunk(buf, ResponseCodeSuccess, make([]byte, 11), 20); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := readChunk(bufio.NewReader(buf), 10); err != ErrChunkTooLarge {
+		t.Errorf("Expected %v when reading, received %v", ErrChunkTooLarge, err)
+	}
+}
@@ -0,0 +1,134 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/libp2p/go-libp2p"
+	filter "github.com/libp2p/go-maddr-filter"
+)
+
+// connectionFilters builds the multiaddress filters which libp2p uses to gate every
+// inbound and outbound connection. Connections to addresses in a denied CIDR subnet
+// are always rejected. If any allowed subnets are given, the default action becomes
+// deny: connections to addresses outside of those subnets are rejected as well.
+// Example: 192.168.0.0/16 may be allowed to accept only connections on your local
+// network.
+func connectionFilters(allowlist []string, denylist []string) (*filter.Filters, error) {
+	f := filter.NewFilters()
+	for _, cidr := range allowlist {
+		if cidr == "" {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist subnet %q: %v", cidr, err)
+		}
+		f.AddFilter(*ipnet, filter.ActionAccept)
+		f.DefaultAction = filter.ActionDeny
+	}
+	denied, err := parseSubnets(denylist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denylist: %v", err)
+	}
+	for _, ipnet := range denied {
+		f.AddFilter(ipnet, filter.ActionDeny)
+	}
+	return f, nil
+}
+
+// parseSubnets parses a list of CIDR subnets, skipping empty entries. The subnets are
+// keyed by their canonical string form, so equal subnets are listed once.
+func parseSubnets(cidrs []string) (map[string]net.IPNet, error) {
+	subnets := make(map[string]net.IPNet, len(cidrs))
+	for _, cidr := range cidrs {
+		if cidr == "" {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet %q: %v", cidr, err)
+		}
+		subnets[ipnet.String()] = *ipnet
+	}
+	return subnets, nil
+}
+
+// withConnectionFilters sets the multiaddress filters of the libp2p host.
+func withConnectionFilters(f *filter.Filters) libp2p.Option {
+	return func(cfg *libp2p.Config) error {
+		cfg.Filters = f
+		return nil
+	}
+}
+
+// DenySubnet rejects all future connections with peers in the given CIDR subnet and
+// closes any open connections to such peers, without requiring a restart of the node.
+// Denying an already denied subnet has no effect.
+func (s *Server) DenySubnet(cidr string) error {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid subnet %q: %v", cidr, err)
+	}
+	s.deniedSubnetsLock.Lock()
+	defer s.deniedSubnetsLock.Unlock()
+	if _, ok := s.deniedSubnets[ipnet.String()]; ok {
+		return nil
+	}
+	s.denySubnet(*ipnet)
+	s.closeBlockedConns()
+	return nil
+}
+
+// SetDeniedSubnets replaces the denied subnets with the given CIDR subnets. Subnets which
+// are already denied are kept, new subnets are denied and denied subnets which are not
+// listed anymore accept connections again. Open connections to peers in a denied subnet
+// are closed.
+func (s *Server) SetDeniedSubnets(cidrs []string) error {
+	subnets, err := parseSubnets(cidrs)
+	if err != nil {
+		return err
+	}
+	s.deniedSubnetsLock.Lock()
+	defer s.deniedSubnetsLock.Unlock()
+	for key, ipnet := range s.deniedSubnets {
+		if _, ok := subnets[key]; ok {
+			continue
+		}
+		s.filters.RemoveLiteral(ipnet)
+		delete(s.deniedSubnets, key)
+		log.WithField("subnet", key).Info("Removed subnet from the p2p denylist")
+	}
+	for key, ipnet := range subnets {
+		if _, ok := s.deniedSubnets[key]; ok {
+			continue
+		}
+		s.denySubnet(ipnet)
+	}
+	s.closeBlockedConns()
+	return nil
+}
+
+// denySubnet adds a deny filter for the subnet. The caller must hold deniedSubnetsLock.
+func (s *Server) denySubnet(ipnet net.IPNet) {
+	if s.deniedSubnets == nil {
+		s.deniedSubnets = make(map[string]net.IPNet)
+	}
+	s.filters.AddFilter(ipnet, filter.ActionDeny)
+	s.deniedSubnets[ipnet.String()] = ipnet
+	log.WithField("subnet", ipnet.String()).Info("Added subnet to the p2p denylist")
+}
+
+// closeBlockedConns closes the open connections to peers whose address is blocked by the
+// connection filters.
+func (s *Server) closeBlockedConns() {
+	for _, conn := range s.host.Network().Conns() {
+		if !s.filters.AddrBlocked(conn.RemoteMultiaddr()) {
+			continue
+		}
+		log.WithField("peer", conn.RemotePeer().Pretty()).Info("Closing connection to peer in denied subnet")
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Failed to close connection with peer")
+		}
+	}
+}
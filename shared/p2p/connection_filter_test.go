@@ -0,0 +1,124 @@
+package p2p
+
+import (
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestConnectionFilters(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowlist []string
+		denylist  []string
+		addr      string
+		blocked   bool
+	}{
+		{name: "no filters", addr: "/ip4/10.0.0.1/tcp/13000", blocked: false},
+		{name: "allowed subnet", allowlist: []string{"192.168.0.0/16"}, addr: "/ip4/192.168.1.1/tcp/13000", blocked: false},
+		{name: "outside allowed subnet", allowlist: []string{"192.168.0.0/16"}, addr: "/ip4/10.0.0.1/tcp/13000", blocked: true},
+		{name: "denied subnet", denylist: []string{"10.0.0.0/8"}, addr: "/ip4/10.0.0.1/tcp/13000", blocked: true},
+		{name: "outside denied subnet", denylist: []string{"10.0.0.0/8"}, addr: "/ip4/11.0.0.1/tcp/13000", blocked: false},
+		{name: "empty entries ignored", allowlist: []string{""}, addr: "/ip4/10.0.0.1/tcp/13000", blocked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := connectionFilters(tt.allowlist, tt.denylist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			addr, err := ma.NewMultiaddr(tt.addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if blocked := f.AddrBlocked(addr); blocked != tt.blocked {
+				t.Errorf("Expected blocked = %v for %s, received %v", tt.blocked, tt.addr, blocked)
+			}
+		})
+	}
+}
+
+func TestConnectionFilters_InvalidSubnet(t *testing.T) {
+	if _, err := connectionFilters([]string{"not-a-subnet"}, nil); err == nil {
+		t.Error("Expected error for invalid allowlist subnet")
+	}
+	if _, err := connectionFilters(nil, []string{"10.0.0.0/99"}); err == nil {
+		t.Error("Expected error for invalid denylist subnet")
+	}
+}
+
+func TestDenySubnet(t *testing.T) {
+	f, err := connectionFilters(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{host: hostWithConnMgr(t), filters: f}
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.AddrBlocked(addr) {
+		t.Fatal("Expected address to be allowed before denying its subnet")
+	}
+	if err := s.DenySubnet("10.0.0.0/8"); err != nil {
+		t.Fatal(err)
+	}
+	if !f.AddrBlocked(addr) {
+		t.Error("Expected address in denied subnet to be blocked")
+	}
+	if err := s.DenySubnet("10.0.0.0/8"); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.deniedSubnets) != 1 {
+		t.Errorf("Expected a subnet denied twice to be listed once, received %v", s.deniedSubnets)
+	}
+	if err := s.DenySubnet("not-a-subnet"); err == nil {
+		t.Error("Expected error for invalid subnet")
+	}
+}
+
+func TestSetDeniedSubnets(t *testing.T) {
+	f, err := connectionFilters(nil, []string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	denied, err := parseSubnets([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{host: hostWithConnMgr(t), filters: f, deniedSubnets: denied}
+	removed, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	added, err := ma.NewMultiaddr("/ip4/11.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reloading the same denylist twice must not stack filters.
+	for i := 0; i < 2; i++ {
+		if err := s.SetDeniedSubnets([]string{"11.0.0.0/8", "11.0.0.0/8"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if f.AddrBlocked(removed) {
+		t.Error("Expected address in subnet removed from the denylist to be allowed")
+	}
+	if !f.AddrBlocked(added) {
+		t.Error("Expected address in subnet added to the denylist to be blocked")
+	}
+	if len(s.deniedSubnets) != 1 {
+		t.Errorf("Expected a single denied subnet, received %v", s.deniedSubnets)
+	}
+
+	if err := s.SetDeniedSubnets(nil); err != nil {
+		t.Fatal(err)
+	}
+	if f.AddrBlocked(added) {
+		t.Error("Expected address to be allowed once the denylist is emptied")
+	}
+	if err := s.SetDeniedSubnets([]string{"not-a-subnet"}); err == nil {
+		t.Error("Expected error for invalid subnet")
+	}
+}
@@ -13,11 +13,16 @@ import (
 const (
 	RepRewardValidBlock       = 4
 	RepRewardValidAttestation = 1
+	RepRewardTimelyMessage    = 1
 
 	RepPenalityInvalidProtobuf    = -1000
 	RepPenalityInitialSyncFailure = -500
 	RepPenalityInvalidBlock       = -10
 	RepPenalityInvalidAttestation = -5
+	RepPenalityLateMessage        = -2
+
+	// RepBanThreshold is the score at or below which a peer is banned.
+	RepBanThreshold = -1000
 )
 
 func optionConnectionManager(maxPeers int) libp2p.Option {
@@ -39,6 +44,10 @@ func (s *Server) Reputation(peer peer.ID, val int) {
 		val += ti.Value
 	}
 	s.host.ConnManager().TagPeer(peer, TagReputation, val)
+	s.queueReputation(peer, val)
+	if val <= RepBanThreshold {
+		s.banPeer(peer)
+	}
 }
 
 // Disconnect will close all connections to the given peer.
@@ -0,0 +1,205 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/sirupsen/logrus"
+)
+
+// forkTopicOverlapEpochs is the number of epochs before and after a scheduled fork during
+// which the node is subscribed to the gossip topics of both fork digests. This way messages
+// from peers whose clocks are slightly ahead or behind around the fork are still received.
+const forkTopicOverlapEpochs = 2
+
+// ForkTopicUpdater switches gossip topics as the chain crosses a scheduled fork epoch.
+// Server implements this interface.
+type ForkTopicUpdater interface {
+	UpdateEpoch(epoch uint64)
+	UpdateForkDigests(current [4]byte, next [4]byte)
+}
+
+// ForkDigest identifies the fork of a network a node is following. It is the first 4 bytes
+// of the hash tree root of the fork version and the genesis validators root, so that nodes
+// on different networks, or on different sides of a fork, never share gossip topics.
+func ForkDigest(forkVersion []byte, genesisValidatorsRoot []byte) [4]byte {
+	var forkData [64]byte
+	copy(forkData[:32], forkVersion)
+	copy(forkData[32:], genesisValidatorsRoot)
+	h := hashutil.Hash(forkData[:])
+	var digest [4]byte
+	copy(digest[:], h[:4])
+	return digest
+}
+
+// TopicName returns the name of the gossip topic scoped to the given fork digest.
+// An empty digest leaves the topic unscoped.
+func TopicName(topic string, digest [4]byte) string {
+	if digest == ([4]byte{}) {
+		return topic
+	}
+	return fmt.Sprintf("/eth2/%x/%s", digest, topic)
+}
+
+// gossipHandler processes a message envelope received from a peer.
+type gossipHandler func(msg *pb.Envelope, peerID peer.ID)
+
+type registeredTopic struct {
+	message proto.Message
+	handler gossipHandler
+}
+
+// forkTopics tracks the fork digest used to publish messages, the digests whose topics
+// the node is subscribed to, and the next scheduled fork.
+type forkTopics struct {
+	lock          sync.Mutex
+	current       [4]byte
+	previous      [4]byte
+	next          [4]byte
+	nextEpoch     uint64
+	pendingFork   bool
+	topics        map[string]registeredTopic
+	subscribed    map[[4]byte]bool
+	subscriptions map[string]context.CancelFunc
+}
+
+// newForkTopics creates the fork topic state for the current fork digest. A fork is only
+// scheduled if a next digest is given which differs from the current one.
+func newForkTopics(current [4]byte, next [4]byte, nextEpoch uint64) *forkTopics {
+	return &forkTopics{
+		current:       current,
+		next:          next,
+		nextEpoch:     nextEpoch,
+		pendingFork:   next != current && next != [4]byte{},
+		topics:        make(map[string]registeredTopic),
+		subscribed:    map[[4]byte]bool{current: true},
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+}
+
+// forkState returns the server's fork topic state, creating an unscoped one if the
+// server was not configured with a fork digest.
+func (s *Server) forkState() *forkTopics {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.forks == nil {
+		s.forks = newForkTopics([4]byte{}, [4]byte{}, 0)
+	}
+	return s.forks
+}
+
+// publishDigest returns the fork digest messages are currently broadcast with.
+func (s *Server) publishDigest() [4]byte {
+	f := s.forkState()
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.current
+}
+
+// registerGossipTopic subscribes the handler to the topic under every fork digest the
+// node is currently subscribed to, and to any digest subscribed to later on.
+func (s *Server) registerGossipTopic(topic string, message proto.Message, handler gossipHandler) {
+	f := s.forkState()
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	t := registeredTopic{message: message, handler: handler}
+	f.topics[topic] = t
+	for digest := range f.subscribed {
+		s.subscribeTopic(f, topic, digest, t)
+	}
+}
+
+// UpdateEpoch moves the node's gossip topics along the fork schedule. Shortly before the
+// fork epoch the node subscribes to the topics of the new fork digest in addition to the
+// current ones, at the fork epoch it starts publishing on the new topics, and shortly after
+// the fork it unsubscribes from the old topics.
+func (s *Server) UpdateEpoch(epoch uint64) {
+	f := s.forkState()
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !f.pendingFork {
+		return
+	}
+	if epoch+forkTopicOverlapEpochs >= f.nextEpoch && !f.subscribed[f.next] {
+		log.WithField("forkEpoch", f.nextEpoch).Info("Subscribing to gossip topics of upcoming fork")
+		s.subscribeDigest(f, f.next)
+	}
+	if epoch >= f.nextEpoch && f.current != f.next {
+		log.WithFields(logrus.Fields{
+			"epoch":      epoch,
+			"forkDigest": fmt.Sprintf("%#x", f.next),
+		}).Info("Publishing on gossip topics of new fork")
+		f.previous = f.current
+		f.current = f.next
+	}
+	if epoch >= f.nextEpoch && epoch-f.nextEpoch >= forkTopicOverlapEpochs {
+		log.WithField("forkDigest", fmt.Sprintf("%#x", f.previous)).Info("Unsubscribing from gossip topics of previous fork")
+		s.unsubscribeDigest(f, f.previous)
+		f.pendingFork = false
+	}
+}
+
+// UpdateForkDigests replaces the fork digests the node publishes and subscribes with, for
+// example once the genesis validators root is known after chain start. The topics of the
+// previous digests are unsubscribed from.
+func (s *Server) UpdateForkDigests(current [4]byte, next [4]byte) {
+	f := s.forkState()
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.current == current && f.next == next {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"forkDigest":     fmt.Sprintf("%#x", current),
+		"nextForkDigest": fmt.Sprintf("%#x", next),
+	}).Info("Updating fork digests of gossip topics")
+	for digest := range f.subscribed {
+		s.unsubscribeDigest(f, digest)
+	}
+	f.current = current
+	f.previous = [4]byte{}
+	f.next = next
+	f.pendingFork = next != current && next != [4]byte{}
+	s.subscribeDigest(f, current)
+}
+
+// subscribeDigest subscribes to every registered topic under the fork digest. The fork
+// topics lock must be held.
+func (s *Server) subscribeDigest(f *forkTopics, digest [4]byte) {
+	f.subscribed[digest] = true
+	for topic, t := range f.topics {
+		s.subscribeTopic(f, topic, digest, t)
+	}
+}
+
+// unsubscribeDigest cancels the subscriptions to every registered topic under the fork
+// digest. The fork topics lock must be held.
+func (s *Server) unsubscribeDigest(f *forkTopics, digest [4]byte) {
+	delete(f.subscribed, digest)
+	for topic := range f.topics {
+		name := TopicName(topic, digest)
+		if cancel, ok := f.subscriptions[name]; ok {
+			cancel()
+			delete(f.subscriptions, name)
+		}
+	}
+}
+
+func (s *Server) subscribeTopic(f *forkTopics, topic string, digest [4]byte, t registeredTopic) {
+	name := TopicName(topic, digest)
+	if _, ok := f.subscriptions[name]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	if err := s.subscribeGossip(ctx, name, t.message, t.handler); err != nil {
+		cancel()
+		log.WithField("topic", name).Errorf("Failed to subscribe to topic: %v", err)
+		return
+	}
+	f.subscriptions[name] = cancel
+}
@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	bhost "github.com/libp2p/go-libp2p-blankhost"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
+	shardpb "github.com/prysmaticlabs/prysm/proto/sharding/p2p/v1"
+)
+
+var _ = ForkTopicUpdater(&Server{})
+
+func TestForkDigest_DiffersPerForkAndNetwork(t *testing.T) {
+	root := []byte{'A'}
+	a := ForkDigest([]byte{0, 0, 0, 0}, root)
+	if a != ForkDigest([]byte{0, 0, 0, 0}, root) {
+		t.Error("Expected fork digest to be deterministic")
+	}
+	if a == ForkDigest([]byte{0, 0, 0, 1}, root) {
+		t.Error("Expected different fork versions to have different digests")
+	}
+	if a == ForkDigest([]byte{0, 0, 0, 0}, []byte{'B'}) {
+		t.Error("Expected different networks to have different digests")
+	}
+}
+
+func TestTopicName(t *testing.T) {
+	if name := TopicName("topic", [4]byte{}); name != "topic" {
+		t.Errorf("Expected unscoped topic name, received %s", name)
+	}
+	if name := TopicName("topic", [4]byte{0xab, 0xcd, 0xef, 0x01}); name != "/eth2/abcdef01/topic" {
+		t.Errorf("Unexpected scoped topic name %s", name)
+	}
+}
+
+func TestUpdateEpoch_TransitionsTopicsAroundFork(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	gsub, err := pubsub.NewFloodSub(ctx, h)
+	if err != nil {
+		t.Fatalf("Failed to create pubsub: %v", err)
+	}
+
+	oldDigest := [4]byte{1, 1, 1, 1}
+	newDigest := [4]byte{2, 2, 2, 2}
+	s := &Server{
+		ctx:          ctx,
+		gsub:         gsub,
+		host:         h,
+		feeds:        make(map[reflect.Type]Feed),
+		mutex:        &sync.Mutex{},
+		topicMapping: make(map[reflect.Type]string),
+		forks:        newForkTopics(oldDigest, newDigest, 10),
+	}
+	topic := shardpb.Topic_COLLATION_BODY_REQUEST.String()
+	s.RegisterTopic(topic, &shardpb.CollationBodyRequest{})
+
+	oldTopic := TopicName(topic, oldDigest)
+	newTopic := TopicName(topic, newDigest)
+	tests := []struct {
+		epoch   uint64
+		topics  []string
+		publish [4]byte
+	}{
+		{epoch: 5, topics: []string{oldTopic}, publish: oldDigest},
+		{epoch: 8, topics: []string{oldTopic, newTopic}, publish: oldDigest},
+		{epoch: 10, topics: []string{oldTopic, newTopic}, publish: newDigest},
+		{epoch: 12, topics: []string{newTopic}, publish: newDigest},
+	}
+	for _, tt := range tests {
+		s.UpdateEpoch(tt.epoch)
+		// Short delay to let canceled subscriptions be removed.
+		time.Sleep(time.Millisecond * 10)
+		topics := gsub.GetTopics()
+		sort.Strings(topics)
+		sort.Strings(tt.topics)
+		if !reflect.DeepEqual(topics, tt.topics) {
+			t.Errorf("Epoch %d: expected subscribed topics %v, received %v", tt.epoch, tt.topics, topics)
+		}
+		if digest := s.publishDigest(); digest != tt.publish {
+			t.Errorf("Epoch %d: expected to publish with digest %#x, received %#x", tt.epoch, tt.publish, digest)
+		}
+	}
+}
+
+func TestUpdateEpoch_NoScheduledFork(t *testing.T) {
+	digest := [4]byte{1, 1, 1, 1}
+	s := &Server{
+		mutex: &sync.Mutex{},
+		forks: newForkTopics(digest, [4]byte{}, 0),
+	}
+	s.UpdateEpoch(100)
+	if s.publishDigest() != digest {
+		t.Error("Expected digest to be unchanged without a scheduled fork")
+	}
+}
+
+func TestUpdateForkDigests_ResubscribesTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	gsub, err := pubsub.NewFloodSub(ctx, h)
+	if err != nil {
+		t.Fatalf("Failed to create pubsub: %v", err)
+	}
+
+	oldDigest := [4]byte{1, 1, 1, 1}
+	newDigest := [4]byte{2, 2, 2, 2}
+	s := &Server{
+		ctx:          ctx,
+		gsub:         gsub,
+		host:         h,
+		feeds:        make(map[reflect.Type]Feed),
+		mutex:        &sync.Mutex{},
+		topicMapping: make(map[reflect.Type]string),
+		forks:        newForkTopics(oldDigest, [4]byte{}, 0),
+	}
+	topic := shardpb.Topic_COLLATION_BODY_REQUEST.String()
+	s.RegisterTopic(topic, &shardpb.CollationBodyRequest{})
+
+	s.UpdateForkDigests(newDigest, newDigest)
+	// Short delay to let canceled subscriptions be removed.
+	time.Sleep(time.Millisecond * 10)
+	if topics := gsub.GetTopics(); !reflect.DeepEqual(topics, []string{TopicName(topic, newDigest)}) {
+		t.Errorf("Expected to be subscribed to topics of new digest only, received %v", topics)
+	}
+	if s.publishDigest() != newDigest {
+		t.Error("Expected to publish with new digest")
+	}
+}
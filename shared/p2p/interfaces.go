@@ -27,3 +27,10 @@ type Subscriber interface {
 type ReputationManager interface {
 	Reputation(peer peer.ID, val int)
 }
+
+// PeersProvider represents a subset of the p2p.Server which exposes the node's own
+// host information and the peers it is connected to.
+type PeersProvider interface {
+	HostInfo() PeerInfo
+	Peers() []PeerInfo
+}
@@ -6,15 +6,15 @@ import (
 
 	host "github.com/libp2p/go-libp2p-host"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 var (
-	peerCountMetric = promauto.NewGauge(prometheus.GaugeOpts{
+	peerCountMetric = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "p2p_peer_count",
 		Help: "The number of currently connected peers",
 	})
-	propagationTimeMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+	propagationTimeMetric = metrics.NewHistogram(prometheus.HistogramOpts{
 		Name:    "p2p_propagation_time_sec",
 		Help:    "The time between message sent/received from peer",
 		Buckets: append(prometheus.DefBuckets, []float64{20, 30, 60, 90}...),
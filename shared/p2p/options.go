@@ -3,13 +3,15 @@ package p2p
 import (
 	"fmt"
 	"io/ioutil"
-	"net"
 	"os"
+	"strings"
 
 	"github.com/libp2p/go-libp2p"
 	crypto "github.com/libp2p/go-libp2p-crypto"
+	noise "github.com/libp2p/go-libp2p-noise"
 	peer "github.com/libp2p/go-libp2p-peer"
-	filter "github.com/libp2p/go-maddr-filter"
+	secio "github.com/libp2p/go-libp2p-secio"
+	tls "github.com/libp2p/go-libp2p-tls"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/shared/iputils"
 )
@@ -32,8 +34,8 @@ func buildOptions(cfg *ServerConfig) []libp2p.Option {
 		libp2p.ListenAddrs(listen),
 		libp2p.EnableRelay(), // Allows dialing to peers via relay.
 		optionConnectionManager(cfg.MaxPeers),
-		whitelistSubnet(cfg.WhitelistCIDR),
 		privKey(cfg.PrvKey),
+		securityTransports(cfg.SecurityTransports),
 	}
 
 	if cfg.EnableUPnP {
@@ -43,29 +45,60 @@ func buildOptions(cfg *ServerConfig) []libp2p.Option {
 	return options
 }
 
-// whitelistSubnet adds a whitelist multiaddress filter for a given CIDR subnet.
-// Example: 192.168.0.0/16 may be used to accept only connections on your local
-// network.
-func whitelistSubnet(cidr string) libp2p.Option {
-	if cidr == "" {
-		return func(_ *libp2p.Config) error {
-			return nil
-		}
-	}
+// Supported security transports, which may be passed to securityTransports.
+const (
+	SecurityNoise = "noise"
+	SecurityTLS   = "tls"
+	SecuritySecio = "secio"
+)
 
-	return func(cfg *libp2p.Config) error {
-		_, ipnet, err := net.ParseCIDR(cidr)
-		if err != nil {
-			return err
+// DefaultSecurityTransports offers secio first, which every Prysm node supports, and
+// noise as the transport other eth2 clients are standardizing on.
+var DefaultSecurityTransports = []string{SecuritySecio, SecurityNoise}
+
+// ParseSecurityTransports parses a comma separated list of security transports, such as
+// the value of the --p2p-security flag. Whitespace around the names is ignored, and an
+// error is returned for an unknown name rather than silently dropping the transport.
+func ParseSecurityTransports(list string) ([]string, error) {
+	var transports []string
+	for _, t := range strings.Split(list, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
 		}
-
-		if cfg.Filters == nil {
-			cfg.Filters = filter.NewFilters()
+		switch t {
+		case SecurityNoise, SecurityTLS, SecuritySecio:
+			transports = append(transports, t)
+		default:
+			return nil, fmt.Errorf("unknown p2p security transport %q", t)
 		}
-		cfg.Filters.AddFilter(*ipnet, filter.ActionAccept)
+	}
+	return transports, nil
+}
 
-		return nil
+// securityTransports configures the libp2p security transports in the given order of
+// preference. The transport used for a connection is negotiated with the remote peer
+// via multistream-select, so peers only need to have one transport in common.
+func securityTransports(transports []string) libp2p.Option {
+	if len(transports) == 0 {
+		transports = DefaultSecurityTransports
+	}
+	opts := []libp2p.Option{}
+	for _, t := range transports {
+		switch t {
+		case SecurityNoise:
+			opts = append(opts, libp2p.Security(noise.ID, noise.New))
+		case SecurityTLS:
+			opts = append(opts, libp2p.Security(tls.ID, tls.New))
+		case SecuritySecio:
+			opts = append(opts, libp2p.Security(secio.ID, secio.New))
+		default:
+			return func(_ *libp2p.Config) error {
+				return fmt.Errorf("unknown p2p security transport %q", t)
+			}
+		}
 	}
+	return libp2p.ChainOptions(opts...)
 }
 
 // Adds a private key to the libp2p option if the option was provided.
@@ -3,6 +3,7 @@ package p2p
 import (
 	"io/ioutil"
 	"os"
+	"reflect"
 	"testing"
 
 	crypto "github.com/libp2p/go-libp2p-crypto"
@@ -49,3 +50,41 @@ func TestPrivateKeyLoading(t *testing.T) {
 		t.Error("Private keys do not match")
 	}
 }
+
+func TestSecurityTransports(t *testing.T) {
+	tests := []struct {
+		transports []string
+		wanted     int
+	}{
+		{transports: nil, wanted: len(DefaultSecurityTransports)},
+		{transports: []string{SecurityNoise}, wanted: 1},
+		{transports: []string{SecurityNoise, SecurityTLS, SecuritySecio}, wanted: 3},
+	}
+	for _, tt := range tests {
+		var cfg config.Config
+		if err := cfg.Apply(securityTransports(tt.transports)); err != nil {
+			t.Fatalf("Could not apply option: %v", err)
+		}
+		if len(cfg.SecurityTransports) != tt.wanted {
+			t.Errorf("Expected %d security transports for %v, received %d", tt.wanted, tt.transports, len(cfg.SecurityTransports))
+		}
+	}
+
+	var cfg config.Config
+	if err := cfg.Apply(securityTransports([]string{"bogus"})); err == nil {
+		t.Error("Expected an error for an unknown security transport")
+	}
+}
+
+func TestParseSecurityTransports(t *testing.T) {
+	transports, err := ParseSecurityTransports("secio, noise ,tls,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(transports, []string{SecuritySecio, SecurityNoise, SecurityTLS}) {
+		t.Errorf("Unexpected security transports %v", transports)
+	}
+	if _, err := ParseSecurityTransports("secio,nosie"); err == nil {
+		t.Error("Expected an error for an unknown security transport")
+	}
+}
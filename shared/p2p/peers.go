@@ -0,0 +1,44 @@
+package p2p
+
+import (
+	"sort"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// PeerInfo describes a libp2p host along with its reputation score.
+type PeerInfo struct {
+	ID         peer.ID
+	Addrs      []ma.Multiaddr
+	Reputation int
+}
+
+// HostInfo returns the peer ID and listening addresses of the node's own host.
+func (s *Server) HostInfo() PeerInfo {
+	return PeerInfo{
+		ID:    s.host.ID(),
+		Addrs: s.host.Addrs(),
+	}
+}
+
+// Peers returns the currently connected peers with their known addresses and
+// reputation score, sorted by peer ID.
+func (s *Server) Peers() []PeerInfo {
+	pids := s.host.Network().Peers()
+	peers := make([]PeerInfo, 0, len(pids))
+	for _, pid := range pids {
+		info := PeerInfo{
+			ID:    pid,
+			Addrs: s.host.Peerstore().Addrs(pid),
+		}
+		if ti := s.host.ConnManager().GetTagInfo(pid); ti != nil {
+			info.Reputation = ti.Value
+		}
+		peers = append(peers, info)
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].ID < peers[j].ID
+	})
+	return peers
+}
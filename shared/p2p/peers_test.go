@@ -0,0 +1,42 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+)
+
+func TestPeers_IncludesReputation(t *testing.T) {
+	ctx := context.Background()
+	h := hostWithConnMgr(t)
+	defer h.Close()
+	remote := hostWithConnMgr(t)
+	defer remote.Close()
+
+	if err := h.Connect(ctx, peerstore.PeerInfo{ID: remote.ID(), Addrs: remote.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Server{
+		host: h,
+	}
+	s.Reputation(remote.ID(), 7)
+
+	if s.HostInfo().ID != h.ID() {
+		t.Errorf("Expected host ID %s, received %s", h.ID().Pretty(), s.HostInfo().ID.Pretty())
+	}
+	peers := s.Peers()
+	if len(peers) != 1 {
+		t.Fatalf("Expected 1 peer, received %d", len(peers))
+	}
+	if peers[0].ID != remote.ID() {
+		t.Errorf("Expected peer %s, received %s", remote.ID().Pretty(), peers[0].ID.Pretty())
+	}
+	if peers[0].Reputation != 7 {
+		t.Errorf("Expected reputation 7, received %d", peers[0].Reputation)
+	}
+	if len(peers[0].Addrs) == 0 {
+		t.Error("Expected peer addresses to be known")
+	}
+}
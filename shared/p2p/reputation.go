@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	host "github.com/libp2p/go-libp2p-host"
+	inet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// reputationFlushInterval is how often the scores updated since the last flush are
+// written to the reputation store.
+const reputationFlushInterval = 30 * time.Second
+
+// ReputationStore persists peer reputation scores so that known-bad peers remain
+// banned and known-good peers are preferred after the node restarts. Scores are
+// keyed by base58 peer ID.
+type ReputationStore interface {
+	PeerReputations() (map[string]int, error)
+	SavePeerReputations(scores map[string]int) error
+}
+
+// restoreReputations loads the persisted peer scores into the connection manager,
+// so well behaved peers are the last to be pruned, and bans peers whose score is at
+// or below RepBanThreshold.
+func (s *Server) restoreReputations() error {
+	if s.reputationStore == nil {
+		return nil
+	}
+	scores, err := s.reputationStore.PeerReputations()
+	if err != nil {
+		return err
+	}
+	for id, val := range scores {
+		pid, err := peer.IDB58Decode(id)
+		if err != nil {
+			log.WithError(err).WithField("peer", id).Debug("Skipping invalid persisted peer ID")
+			continue
+		}
+		s.host.ConnManager().TagPeer(pid, TagReputation, val)
+		if val <= RepBanThreshold {
+			s.banPeer(pid)
+		}
+	}
+	log.WithField("peers", len(scores)).Debug("Restored persisted peer reputations")
+	return nil
+}
+
+// queueReputation records the latest score of the peer, to be persisted on the next flush.
+func (s *Server) queueReputation(pid peer.ID, val int) {
+	if s.reputationStore == nil {
+		return
+	}
+	s.pendingReputationsLock.Lock()
+	defer s.pendingReputationsLock.Unlock()
+	if s.pendingReputations == nil {
+		s.pendingReputations = make(map[peer.ID]int)
+	}
+	s.pendingReputations[pid] = val
+}
+
+// flushReputations writes the scores updated since the last flush in a single
+// write to the reputation store.
+func (s *Server) flushReputations() {
+	s.pendingReputationsLock.Lock()
+	pending := s.pendingReputations
+	s.pendingReputations = nil
+	s.pendingReputationsLock.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	scores := make(map[string]int, len(pending))
+	for pid, val := range pending {
+		scores[pid.Pretty()] = val
+	}
+	if err := s.reputationStore.SavePeerReputations(scores); err != nil {
+		log.WithError(err).Error("Failed to persist peer reputations")
+	}
+}
+
+// persistReputations periodically flushes the updated peer scores until the context
+// is canceled.
+func (s *Server) persistReputations(ctx context.Context) {
+	if s.reputationStore == nil {
+		return
+	}
+	ticker := time.NewTicker(reputationFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.flushReputations()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// banPeer marks the peer as banned and closes any open connections to it.
+func (s *Server) banPeer(pid peer.ID) {
+	s.bannedPeersLock.Lock()
+	if s.bannedPeers == nil {
+		s.bannedPeers = make(map[peer.ID]bool)
+	}
+	s.bannedPeers[pid] = true
+	s.bannedPeersLock.Unlock()
+	if s.host.Network().Connectedness(pid) == inet.Connected {
+		s.Disconnect(pid)
+	}
+}
+
+// IsBanned returns true if the peer's reputation fell to or below RepBanThreshold.
+func (s *Server) IsBanned(pid peer.ID) bool {
+	s.bannedPeersLock.RLock()
+	defer s.bannedPeersLock.RUnlock()
+	return s.bannedPeers[pid]
+}
+
+// rejectBannedPeers adds a "Connected" event handler which immediately closes
+// connections opened by or to banned peers.
+func (s *Server) rejectBannedPeers(h host.Host) {
+	h.Network().Notify(&inet.NotifyBundle{
+		ConnectedF: func(net inet.Network, conn inet.Conn) {
+			if !s.IsBanned(conn.RemotePeer()) {
+				return
+			}
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				log.WithField("peer", conn.RemotePeer().Pretty()).Debug("Rejecting connection from banned peer")
+				if err := conn.Close(); err != nil {
+					log.WithError(err).Debug("Failed to close connection with banned peer")
+				}
+			}()
+		},
+	})
+}
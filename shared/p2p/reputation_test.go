@@ -0,0 +1,90 @@
+package p2p
+
+import (
+	"testing"
+
+	tu "github.com/libp2p/go-testutil"
+)
+
+type mockReputationStore struct {
+	scores map[string]int
+	saves  int
+}
+
+func (m *mockReputationStore) PeerReputations() (map[string]int, error) {
+	return m.scores, nil
+}
+
+func (m *mockReputationStore) SavePeerReputations(scores map[string]int) error {
+	m.saves++
+	for peerID, val := range scores {
+		m.scores[peerID] = val
+	}
+	return nil
+}
+
+func TestReputation_PersistsAndBans(t *testing.T) {
+	h := hostWithConnMgr(t)
+	store := &mockReputationStore{scores: make(map[string]int)}
+	s := &Server{
+		host:            h,
+		reputationStore: store,
+	}
+
+	pid := tu.RandPeerIDFatal(t)
+	h.ConnManager().Notifee().Connected(h.Network(), &tconn{pid: pid})
+
+	s.Reputation(pid, 2)
+	s.Reputation(pid, 3)
+	if len(store.scores) != 0 {
+		t.Error("Expected scores to be persisted on flush only")
+	}
+	s.flushReputations()
+	if store.scores[pid.Pretty()] != 5 {
+		t.Errorf("Expected persisted score 5, received %d", store.scores[pid.Pretty()])
+	}
+	if store.saves != 1 {
+		t.Errorf("Expected scores to be persisted in a single write, received %d writes", store.saves)
+	}
+	s.flushReputations()
+	if store.saves != 1 {
+		t.Error("Expected no write when no score changed since the last flush")
+	}
+	if s.IsBanned(pid) {
+		t.Error("Expected peer with a positive score to not be banned")
+	}
+
+	s.Reputation(pid, RepPenalityInvalidProtobuf)
+	if !s.IsBanned(pid) {
+		t.Error("Expected peer to be banned after falling below the ban threshold")
+	}
+}
+
+func TestRestoreReputations(t *testing.T) {
+	h := hostWithConnMgr(t)
+	good := tu.RandPeerIDFatal(t)
+	bad := tu.RandPeerIDFatal(t)
+	store := &mockReputationStore{scores: map[string]int{
+		good.Pretty(): 100,
+		bad.Pretty():  RepBanThreshold - 1,
+		"not-a-peer":  1,
+	}}
+	s := &Server{
+		host:            h,
+		reputationStore: store,
+	}
+	h.ConnManager().Notifee().Connected(h.Network(), &tconn{pid: good})
+
+	if err := s.restoreReputations(); err != nil {
+		t.Fatal(err)
+	}
+	if h.ConnManager().GetTagInfo(good).Value != 100 {
+		t.Errorf("Expected restored score 100, received %d", h.ConnManager().GetTagInfo(good).Value)
+	}
+	if s.IsBanned(good) {
+		t.Error("Expected known-good peer to not be banned")
+	}
+	if !s.IsBanned(bad) {
+		t.Error("Expected known-bad peer to remain banned after restart")
+	}
+}
@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	protocol "github.com/libp2p/go-libp2p-protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	rhost "github.com/libp2p/go-libp2p/p2p/host/routed"
+	filter "github.com/libp2p/go-maddr-filter"
 	"github.com/multiformats/go-multiaddr"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -37,6 +39,10 @@ import (
 
 const prysmProtocolPrefix = "/prysm/0.0.0"
 
+// chunkedProtocolSuffix is appended to a topic's protocol ID for streams carrying
+// chunked responses.
+const chunkedProtocolSuffix = "/chunked"
+
 // We accommodate p2p message sizes as large as ~17Mb as we are transmitting
 // full beacon states over the wire for our current implementation.
 const maxMessageSize = 1 << 24
@@ -47,6 +53,13 @@ type Sender interface {
 	Send(ctx context.Context, msg proto.Message, peer peer.ID) error
 }
 
+// ChunkedSender represents a struct that is able to send responses to a peer as
+// length prefixed chunks. Server implements this interface.
+type ChunkedSender interface {
+	SendChunked(ctx context.Context, msgs []proto.Message, peer peer.ID) error
+	MaxRequestCount() int
+}
+
 // Server is a placeholder for a p2p service. To be designed.
 type Server struct {
 	ctx           context.Context
@@ -61,6 +74,20 @@ type Server struct {
 	relayNodeAddr string
 	noDiscovery   bool
 	staticPeers   []string
+
+	reputationStore ReputationStore
+	bannedPeers     map[peer.ID]bool
+	bannedPeersLock sync.RWMutex
+	maxChunkSize    uint64
+	maxRequestCount int
+	filters         *filter.Filters
+	forks           *forkTopics
+
+	deniedSubnets     map[string]net.IPNet // Subnets of the deny filters, keyed by their string form.
+	deniedSubnetsLock sync.Mutex
+
+	pendingReputations     map[peer.ID]int
+	pendingReputationsLock sync.Mutex
 }
 
 // ServerConfig for peer to peer networking.
@@ -75,13 +102,28 @@ type ServerConfig struct {
 	MaxPeers               int
 	DepositContractAddress string
 	WhitelistCIDR          string
+	AllowlistCIDRs         []string
+	DenylistCIDRs          []string
 	EnableUPnP             bool
+	ReputationStore        ReputationStore
+	SecurityTransports     []string
+	MaxChunkSize           uint64
+	MaxRequestCount        int
+	ForkDigest             [4]byte
+	NextForkDigest         [4]byte
+	NextForkEpoch          uint64
 }
 
 // NewServer creates a new p2p server instance.
 func NewServer(cfg *ServerConfig) (*Server, error) {
 	ctx, cancel := context.WithCancel(context.Background())
+	filters, err := connectionFilters(append([]string{cfg.WhitelistCIDR}, cfg.AllowlistCIDRs...), cfg.DenylistCIDRs)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 	opts := buildOptions(cfg)
+	opts = append(opts, withConnectionFilters(filters))
 	if cfg.RelayNodeAddr != "" {
 		opts = append(opts, libp2p.AddrsFactory(withRelayAddrs(cfg.RelayNodeAddr)))
 	} else if cfg.HostAddress != "" {
@@ -154,20 +196,47 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 	setupPeerNegotiation(h, cfg.DepositContractAddress, exclusions)
 	setHandshakeHandler(h, cfg.DepositContractAddress)
 
-	return &Server{
-		ctx:           ctx,
-		cancel:        cancel,
-		feeds:         make(map[reflect.Type]Feed),
-		host:          h,
-		dht:           dht,
-		gsub:          gsub,
-		mutex:         &sync.Mutex{},
-		topicMapping:  make(map[reflect.Type]string),
-		bootstrapNode: cfg.BootstrapNodeAddr,
-		relayNodeAddr: cfg.RelayNodeAddr,
-		noDiscovery:   cfg.NoDiscovery,
-		staticPeers:   cfg.StaticPeers,
-	}, nil
+	deniedSubnets, err := parseSubnets(cfg.DenylistCIDRs)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	maxChunkSize := cfg.MaxChunkSize
+	if maxChunkSize == 0 {
+		maxChunkSize = DefaultMaxChunkSize
+	}
+	maxRequestCount := cfg.MaxRequestCount
+	if maxRequestCount <= 0 {
+		maxRequestCount = DefaultMaxRequestCount
+	}
+
+	s := &Server{
+		ctx:             ctx,
+		cancel:          cancel,
+		feeds:           make(map[reflect.Type]Feed),
+		host:            h,
+		dht:             dht,
+		gsub:            gsub,
+		mutex:           &sync.Mutex{},
+		topicMapping:    make(map[reflect.Type]string),
+		bootstrapNode:   cfg.BootstrapNodeAddr,
+		relayNodeAddr:   cfg.RelayNodeAddr,
+		noDiscovery:     cfg.NoDiscovery,
+		staticPeers:     cfg.StaticPeers,
+		reputationStore: cfg.ReputationStore,
+		bannedPeers:     make(map[peer.ID]bool),
+		maxChunkSize:    maxChunkSize,
+		maxRequestCount: maxRequestCount,
+		filters:         filters,
+		forks:           newForkTopics(cfg.ForkDigest, cfg.NextForkDigest, cfg.NextForkEpoch),
+		deniedSubnets:   deniedSubnets,
+	}
+	s.rejectBannedPeers(h)
+	if err := s.restoreReputations(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not restore peer reputations: %v", err)
+	}
+	return s, nil
 }
 
 func checkAvailablePort(port int) bool {
@@ -233,6 +302,7 @@ func (s *Server) Start() {
 	if len(peersToWatch) > 0 {
 		startPeerWatcher(ctx, s.host, peersToWatch...)
 	}
+	go s.persistReputations(s.ctx)
 }
 
 // Stop the main p2p loop.
@@ -240,6 +310,9 @@ func (s *Server) Stop() error {
 	log.Info("Stopping service")
 
 	s.cancel()
+	if s.reputationStore != nil {
+		s.flushReputations()
+	}
 	return nil
 }
 
@@ -265,11 +338,6 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 	msgType := messageType(message)
 	s.topicMapping[msgType] = topic
 
-	sub, err := s.gsub.Subscribe(topic)
-	if err != nil {
-		log.Errorf("Failed to subscribe to topic: %v", err)
-		return
-	}
 	feed := s.Feed(message)
 
 	// Reverse adapter order
@@ -344,6 +412,62 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 		}
 	})
 
+	// The chunks of a response are parts of a single message. Protobuf decodes concatenated
+	// encodings as the merge of the encoded messages, so the chunk payloads are joined and the
+	// response is passed to the handler as one message once the stream ends.
+	s.host.SetStreamHandler(protocol.ID(prysmProtocolPrefix+"/"+topic+chunkedProtocolSuffix), func(stream libp2pnet.Stream) {
+		log.WithField("topic", topic).Debug("Received new chunked stream")
+		defer stream.Close()
+		r := bufio.NewReader(stream)
+		var response *pb.Envelope
+		for count := 0; ; count++ {
+			if count >= s.maxRequestCount {
+				log.WithError(ErrTooManyChunks).WithField("topic", topic).Debug("Closing chunked stream")
+				return
+			}
+			code, payload, err := readChunk(r, s.maxChunkSize)
+			if err == io.EOF {
+				if response != nil {
+					handler(response, stream.Conn().RemotePeer())
+				}
+				return // end of stream
+			}
+			if err != nil {
+				log.WithError(err).Error("Could not read chunk from stream")
+				return
+			}
+			if code != ResponseCodeSuccess {
+				log.WithFields(logrus.Fields{
+					"code":  code,
+					"error": string(payload),
+					"peer":  stream.Conn().RemotePeer(),
+				}).Debug("Peer responded with an error")
+				return
+			}
+			msg := &pb.Envelope{}
+			if err := proto.Unmarshal(payload, msg); err != nil {
+				log.WithError(err).Error("Could not decode chunk payload")
+				s.Reputation(stream.Conn().RemotePeer(), RepPenalityInvalidProtobuf)
+				return
+			}
+			if response == nil {
+				response = msg
+				continue
+			}
+			response.Payload = append(response.Payload, msg.Payload...)
+		}
+	})
+
+	s.registerGossipTopic(topic, message, handler)
+}
+
+// subscribeGossip subscribes to the gossip topic and passes every message received on it
+// to the handler, until the context is canceled.
+func (s *Server) subscribeGossip(ctx context.Context, topic string, message proto.Message, handler gossipHandler) error {
+	sub, err := s.gsub.Subscribe(topic)
+	if err != nil {
+		return err
+	}
 	go func() {
 		defer sub.Cancel()
 
@@ -361,10 +485,10 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 		}()
 
 		for {
-			msg, err = sub.Next(s.ctx)
+			msg, err = sub.Next(ctx)
 
-			if s.ctx.Err() != nil {
-				log.WithError(s.ctx.Err()).Debug("Context error")
+			if ctx.Err() != nil {
+				log.WithError(ctx.Err()).Debug("Context error")
 				return
 			}
 			if err != nil {
@@ -385,6 +509,7 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 			handler(d, msg.GetFrom())
 		}
 	}()
+	return nil
 }
 
 // Attempts to convert some proto.Message to a string in a panic safe method.
@@ -465,6 +590,67 @@ func (s *Server) Send(ctx context.Context, msg proto.Message, peerID peer.ID) er
 	return w.WriteMsg(envelope)
 }
 
+// SendChunked sends the parts of a response to a specific peer as a single chunked
+// response, which the peer merges back into one message. Each part is written as a chunk
+// prefixed by its result code and length. At most the configured max request count of
+// parts is sent. Peers which do not support chunked responses are sent the merged
+// message instead.
+func (s *Server) SendChunked(ctx context.Context, msgs []proto.Message, peerID peer.ID) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	if len(msgs) > s.maxRequestCount {
+		return ErrTooManyChunks
+	}
+
+	topic := s.topicMapping[messageType(msgs[0])]
+	pid := protocol.ID(prysmProtocolPrefix + "/" + topic + chunkedProtocolSuffix)
+	supported, err := s.host.Peerstore().SupportsProtocols(peerID, string(pid))
+	if err != nil || len(supported) == 0 {
+		merged := proto.Clone(msgs[0])
+		for _, msg := range msgs[1:] {
+			proto.Merge(merged, msg)
+		}
+		return s.Send(ctx, merged, peerID)
+	}
+
+	ctx, span := trace.StartSpan(ctx, "p2p.SendChunked")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	stream, err := s.host.NewStream(ctx, peerID, pid)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for _, msg := range msgs {
+		b, err := proto.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		envelope := &pb.Envelope{
+			SpanContext: propagation.Binary(span.SpanContext()),
+			Payload:     b,
+			Timestamp:   types.TimestampNow(),
+		}
+		data, err := proto.Marshal(envelope)
+		if err != nil {
+			return err
+		}
+		if err := writeChunk(stream, ResponseCodeSuccess, data, s.maxChunkSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MaxRequestCount returns the max number of chunks sent or accepted in a single response.
+func (s *Server) MaxRequestCount() int {
+	return s.maxRequestCount
+}
+
 // Broadcast publishes a message to all localized peers using gossipsub.
 // msg must be a proto.Message that can be encoded into a byte array.
 // It publishes the first 100 chars of msg over the msg's mapped topic.
@@ -531,7 +717,7 @@ func (s *Server) Broadcast(ctx context.Context, msg proto.Message) {
 		return
 	}
 
-	if err := s.gsub.Publish(topic, data); err != nil {
+	if err := s.gsub.Publish(TopicName(topic, s.publishDigest()), data); err != nil {
 		log.Errorf("Failed to publish to gossipsub topic: %v", err)
 	}
 }
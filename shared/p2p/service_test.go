@@ -122,7 +122,7 @@ func TestSubscribeToTopic_onPubSub_OK(t *testing.T) {
 	sub := feed.Subscribe(ch)
 	defer sub.Unsubscribe()
 
-	testSubscribe(ctx, t, s, gsub, ch)
+	testSubscribe(ctx, t, &s, gsub, ch)
 }
 
 func TestSubscribeToTopic_directMessaging_OK(t *testing.T) {
@@ -208,10 +208,10 @@ func TestSubscribe_OK(t *testing.T) {
 	sub := s.Subscribe(&shardpb.CollationBodyRequest{}, ch)
 	defer sub.Unsubscribe()
 
-	testSubscribe(ctx, t, s, gsub, ch)
+	testSubscribe(ctx, t, &s, gsub, ch)
 }
 
-func testSubscribe(ctx context.Context, t *testing.T, s Server, gsub *pubsub.PubSub, ch chan Message) {
+func testSubscribe(ctx context.Context, t *testing.T, s *Server, gsub *pubsub.PubSub, ch chan Message) {
 	topic := shardpb.Topic_COLLATION_BODY_REQUEST
 
 	s.RegisterTopic(topic.String(), &shardpb.CollationBodyRequest{})
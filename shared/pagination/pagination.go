@@ -0,0 +1,53 @@
+// Package pagination implements the page tokens shared by the list RPCs of the beacon
+// node, so that no request can make the node build an unbounded response.
+package pagination
+
+import (
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartAndEndPage returns the start and end indices of the page of a list of totalSize
+// items which is selected by the page token and page size of a request, along with the
+// token of the next page. The next page token is left empty once the last page has been
+// reached. An empty page token selects the first page, a page size of zero selects the
+// default page size, and page sizes are capped at the max page size.
+func StartAndEndPage(pageToken string, pageSize int, totalSize int) (int, int, string, error) {
+	if pageToken == "" {
+		pageToken = "0"
+	}
+	if pageSize <= 0 {
+		pageSize = params.BeaconConfig().DefaultPageSize
+	}
+	// Input page size can't be greater than MaxPageSize.
+	if pageSize > params.BeaconConfig().MaxPageSize {
+		pageSize = params.BeaconConfig().MaxPageSize
+	}
+
+	token, err := strconv.Atoi(pageToken)
+	if err != nil {
+		return 0, 0, "", status.Errorf(codes.InvalidArgument, "could not convert page token: %v", err)
+	}
+
+	// Start page can not be greater than list size. The token is bounded before the start
+	// index is computed, so that large tokens can not overflow it.
+	if token < 0 || token > totalSize/pageSize || (token*pageSize >= totalSize && totalSize != 0) {
+		return 0, 0, "", status.Errorf(codes.InvalidArgument, "page token %d out of range for list of size %d", token, totalSize)
+	}
+	start := token * pageSize
+
+	// End page can not go out of bound.
+	end := start + pageSize
+	if end > totalSize {
+		end = totalSize
+	}
+
+	nextPageToken := ""
+	if end < totalSize {
+		nextPageToken = strconv.Itoa(token + 1)
+	}
+	return start, end, nextPageToken, nil
+}
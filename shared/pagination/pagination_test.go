@@ -0,0 +1,53 @@
+package pagination
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartAndEndPage(t *testing.T) {
+	maxPageSize := params.BeaconConfig().MaxPageSize
+	defaultPageSize := params.BeaconConfig().DefaultPageSize
+
+	tests := []struct {
+		token     string
+		pageSize  int
+		totalSize int
+		start     int
+		end       int
+		next      string
+	}{
+		{token: "", pageSize: 3, totalSize: 10, start: 0, end: 3, next: "1"},
+		{token: "1", pageSize: 3, totalSize: 10, start: 3, end: 6, next: "2"},
+		{token: "3", pageSize: 3, totalSize: 10, start: 9, end: 10, next: ""},
+		{token: "0", pageSize: 0, totalSize: 10 * defaultPageSize, start: 0, end: defaultPageSize, next: "1"},
+		{token: "1", pageSize: maxPageSize + 1, totalSize: 10 * maxPageSize, start: maxPageSize, end: 2 * maxPageSize, next: "2"},
+		{token: "0", pageSize: 3, totalSize: 0, start: 0, end: 0, next: ""},
+	}
+	for i, tt := range tests {
+		start, end, next, err := StartAndEndPage(tt.token, tt.pageSize, tt.totalSize)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i, err)
+		}
+		if start != tt.start || end != tt.end || next != tt.next {
+			t.Errorf("Test %d: expected page [%d, %d) with next token %q, received [%d, %d) with next token %q",
+				i, tt.start, tt.end, tt.next, start, end, next)
+		}
+	}
+}
+
+func TestStartAndEndPage_InvalidToken(t *testing.T) {
+	for _, token := range []string{"abc", "-1", strconv.Itoa(4), strconv.FormatInt(math.MaxInt64/2, 10)} {
+		if _, _, _, err := StartAndEndPage(token, 3, 10); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument error for token %q, received %v", token, err)
+		}
+	}
+	if _, _, _, err := StartAndEndPage("1", 3, 0); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument error for page of empty list, received %v", err)
+	}
+}
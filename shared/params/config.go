@@ -27,6 +27,8 @@ type BeaconChainConfig struct {
 	ShuffleRoundCount              uint64 `yaml:"SHUFFLE_ROUND_COUNT"`                // ShuffleRoundCount is used for retrieving the permuted index.
 	MinGenesisActiveValidatorCount uint64 `yaml:"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT"` // MinGenesisActiveValidatorCount defines how many validator deposits needed to kick off beacon chain.
 	MinGenesisTime                 uint64 `yaml:"MIN_GENESIS_TIME"`                   // MinGenesisTime is the time that needed to pass before kicking off beacon chain. Currently set to Jan/3/2020.
+	GenesisDelay                   uint64 `yaml:"GENESIS_DELAY"`                      // GenesisDelay is the number of seconds from the start of the day of the eth1 block triggering genesis to the genesis time.
+	TargetAggregatorsPerCommittee  uint64 `yaml:"TARGET_AGGREGATORS_PER_COMMITTEE"`   // TargetAggregatorsPerCommittee is the number of validators expected to aggregate the attestations of a committee.
 
 	// Gwei value constants.
 	MinDepositAmount          uint64 `yaml:"MIN_DEPOSIT_AMOUNT"`          // MinDepositAmount is the maximal amount of Gwei a validator can send to the deposit contract at once.
@@ -93,6 +95,8 @@ type BeaconChainConfig struct {
 	TestnetContractEndpoint   string        // TestnetContractEndpoint to fetch the contract address of the Prysmatic Labs testnet.
 	GoerliBlockTime           uint64        // GoerliBlockTime is the number of seconds on avg a Goerli block is created.
 	GenesisForkVersion        []byte        `yaml:"GENESIS_FORK_VERSION"` // GenesisForkVersion is used to track fork version between state transitions.
+	NextForkVersion           []byte        `yaml:"NEXT_FORK_VERSION"`    // NextForkVersion is the fork version of the next scheduled fork.
+	NextForkEpoch             uint64        `yaml:"NEXT_FORK_EPOCH"`      // NextForkEpoch is the epoch at which the next scheduled fork takes effect.
 	EmptySignature            [96]byte      // EmptySignature is used to represent a zeroed out BLS Signature.
 	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPageSize               int           // MaxPageSize defines the max page size for RPC server respond.
@@ -128,6 +132,8 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	ShuffleRoundCount:              90,
 	MinGenesisActiveValidatorCount: 65536,
 	MinGenesisTime:                 1578009600,
+	GenesisDelay:                   172800, // 2 days
+	TargetAggregatorsPerCommittee:  16,
 
 	// Gwei value constants.
 	MinDepositAmount:          1 * 1e9,
@@ -192,6 +198,8 @@ var defaultBeaconConfig = &BeaconChainConfig{
 	RPCSyncCheck:              1,
 	GoerliBlockTime:           14, // 14 seconds on average for a goerli block to be created.
 	GenesisForkVersion:        []byte{0, 0, 0, 0},
+	NextForkVersion:           []byte{0, 0, 0, 0},
+	NextForkEpoch:             1<<64 - 1,
 	EmptySignature:            [96]byte{},
 	DefaultPageSize:           250,
 	MaxPageSize:               500,
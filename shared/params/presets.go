@@ -0,0 +1,114 @@
+package params
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Names of the network presets.
+const (
+	MainnetPreset = "mainnet"
+	MinimalPreset = "minimal"
+	InteropPreset = "interop"
+	TestnetPreset = "testnet"
+)
+
+// InteropDepositContract is the deposit contract address of the interop network, which
+// the simulated eth1 chain of interop devnets assigns to its deposit contract.
+const InteropDepositContract = "0x1a8ef6cfc2fe6a9dd7f2d2bd9a0ae3c7d3b3cd69"
+
+// Preset is a named network configuration, setting the beacon chain constants, the
+// genesis fork version and the deposit contract of the network coherently.
+type Preset struct {
+	Name string
+	// Config returns a copy of the beacon chain config of the network.
+	Config func() *BeaconChainConfig
+	// DepositContract is the address of the deposit contract of the network. If empty,
+	// the address is fetched from the ContractEndpoint, or must be given by flag if the
+	// network has no endpoint, as for networks deploying their own contract.
+	DepositContract  string
+	ContractEndpoint string
+}
+
+var presets = map[string]*Preset{
+	MainnetPreset: {
+		Name: MainnetPreset,
+		Config: func() *BeaconChainConfig {
+			cfg := *defaultBeaconConfig
+			return &cfg
+		},
+	},
+	MinimalPreset: {
+		Name:   MinimalPreset,
+		Config: MinimalPresetConfig,
+	},
+	InteropPreset: {
+		Name:            InteropPreset,
+		Config:          InteropConfig,
+		DepositContract: InteropDepositContract,
+	},
+	TestnetPreset: {
+		Name:             TestnetPreset,
+		Config:           TestnetConfig,
+		ContractEndpoint: defaultBeaconConfig.TestnetContractEndpoint,
+	},
+}
+
+var activePreset = presets[MainnetPreset]
+
+// withForkVersion sets the genesis fork version of the network, which is also the next
+// fork version as long as no fork is scheduled. Networks use distinct fork versions so
+// that their fork digests, and hence their gossip topics and signing domains, differ.
+func withForkVersion(cfg *BeaconChainConfig, version []byte) *BeaconChainConfig {
+	cfg.GenesisForkVersion = version
+	cfg.NextForkVersion = version
+	return cfg
+}
+
+// MinimalPresetConfig retrieves the config of the minimal network, which is the minimal
+// spec config with its own genesis fork version.
+func MinimalPresetConfig() *BeaconChainConfig {
+	return withForkVersion(MinimalSpecConfig(), []byte{0, 0, 0, 1})
+}
+
+// InteropConfig retrieves the config used by clients for interoperability testing, which
+// is the minimal config with the genesis time set by the genesis state rather than a
+// min genesis time.
+func InteropConfig() *BeaconChainConfig {
+	interopConfig := MinimalSpecConfig()
+	interopConfig.MinGenesisTime = 0
+	interopConfig.Eth1FollowDistance = 16
+	return withForkVersion(interopConfig, []byte{0, 0, 0, 2})
+}
+
+// TestnetConfig retrieves the config of the Prysmatic Labs testnet, which is the demo
+// config with its own genesis fork version.
+func TestnetConfig() *BeaconChainConfig {
+	return withForkVersion(DemoBeaconConfig(), []byte{0, 0, 0, 3})
+}
+
+// PresetNames returns the names of the network presets, sorted alphabetically.
+func PresetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UsePreset sets the beacon chain config to the one of the named network preset.
+func UsePreset(name string) error {
+	preset, ok := presets[name]
+	if !ok {
+		return fmt.Errorf("unknown network preset %q, expected one of %v", name, PresetNames())
+	}
+	beaconConfig = preset.Config()
+	activePreset = preset
+	return nil
+}
+
+// ActivePreset returns the network preset in use, mainnet unless set by UsePreset.
+func ActivePreset() *Preset {
+	return activePreset
+}
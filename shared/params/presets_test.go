@@ -0,0 +1,77 @@
+package params
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUsePreset(t *testing.T) {
+	defer OverrideBeaconConfig(MainnetConfig())
+	defer func() {
+		activePreset = presets[MainnetPreset]
+	}()
+
+	if err := UsePreset(MinimalPreset); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(BeaconConfig(), MinimalPresetConfig()) {
+		t.Error("Expected the minimal config to be used")
+	}
+	if ActivePreset().Name != MinimalPreset {
+		t.Errorf("Expected active preset %s, got %s", MinimalPreset, ActivePreset().Name)
+	}
+
+	if err := UsePreset(TestnetPreset); err != nil {
+		t.Fatal(err)
+	}
+	if BeaconConfig().MaxEffectiveBalance != DemoBeaconConfig().MaxEffectiveBalance {
+		t.Error("Expected the demo config to be used for the testnet")
+	}
+	if ActivePreset().ContractEndpoint == "" {
+		t.Error("Expected the testnet to fetch its deposit contract from an endpoint")
+	}
+}
+
+func TestUsePreset_Unknown(t *testing.T) {
+	if err := UsePreset("unknown"); err == nil {
+		t.Error("Expected error using an unknown preset")
+	}
+}
+
+func TestUsePreset_CopiesConfig(t *testing.T) {
+	defer OverrideBeaconConfig(MainnetConfig())
+	defer func() {
+		activePreset = presets[MainnetPreset]
+	}()
+
+	if err := UsePreset(MainnetPreset); err != nil {
+		t.Fatal(err)
+	}
+	if BeaconConfig() == MainnetConfig() {
+		t.Error("Expected the preset to use a copy of the mainnet config")
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	want := []string{InteropPreset, MainnetPreset, MinimalPreset, TestnetPreset}
+	if got := PresetNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected preset names %v, got %v", want, got)
+	}
+}
+
+func TestPresets_DistinctForkVersions(t *testing.T) {
+	seen := make(map[string]string)
+	for _, name := range PresetNames() {
+		cfg := presets[name].Config()
+		if !bytes.Equal(cfg.GenesisForkVersion, cfg.NextForkVersion) {
+			t.Errorf("Expected no fork to be scheduled for %s", name)
+		}
+		version := fmt.Sprintf("%#x", cfg.GenesisForkVersion)
+		if other, ok := seen[version]; ok {
+			t.Errorf("Presets %s and %s share the genesis fork version %s", name, other, version)
+		}
+		seen[version] = name
+	}
+}
@@ -0,0 +1,69 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// Check reports whether a component of the node is healthy, returning an error
+// describing the failure otherwise.
+type Check func() error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// AddHealthCheck registers a check which must pass for the process to be considered
+// alive, such as the database being open. Health checks are reported by both the
+// /healthz and /readyz routes. Checks must be added before the service is started.
+func (s *Service) AddHealthCheck(name string, check Check) {
+	s.healthChecks = append(s.healthChecks, namedCheck{name: name, check: check})
+}
+
+// AddReadinessCheck registers a check which must pass for the node to be ready to
+// serve requests, such as being synced to the head of the chain. Readiness checks
+// are only reported by the /readyz route. Checks must be added before the service
+// is started.
+func (s *Service) AddReadinessCheck(name string, check Check) {
+	s.readinessChecks = append(s.readinessChecks, namedCheck{name: name, check: check})
+}
+
+// readyzHandler reports whether the node is ready to serve requests. Unlike /healthz,
+// which is used to restart a process which is stuck, a failing /readyz only means
+// requests should be routed to other nodes until the checks pass again.
+func (s *Service) readyzHandler(w http.ResponseWriter, _ *http.Request) {
+	var buf bytes.Buffer
+	ready := writeChecks(&buf, s.healthChecks)
+	if !writeChecks(&buf, s.readinessChecks) {
+		ready = false
+	}
+
+	if ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Errorf("Could not write readyz body %v", err)
+	}
+}
+
+// writeChecks runs the checks and writes their statuses to the buffer, returning
+// whether all of them passed.
+func writeChecks(buf *bytes.Buffer, checks []namedCheck) bool {
+	ok := true
+	for _, c := range checks {
+		status := "OK"
+		if err := c.check(); err != nil {
+			ok = false
+			status = "ERROR " + err.Error()
+		}
+		if _, err := buf.WriteString(fmt.Sprintf("%s: %s\n", c.name, status)); err != nil {
+			ok = false
+		}
+	}
+	return ok
+}
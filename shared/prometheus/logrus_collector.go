@@ -2,7 +2,7 @@ package prometheus
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/sirupsen/logrus"
 )
 
@@ -13,7 +13,7 @@ type LogrusCollector struct {
 
 var (
 	supportedLevels = []logrus.Level{logrus.InfoLevel, logrus.WarnLevel, logrus.ErrorLevel}
-	counterVec      = promauto.NewCounterVec(prometheus.CounterOpts{
+	counterVec      = metrics.NewCounterVec(prometheus.CounterOpts{
 		Name: "log_entries_total",
 		Help: "Total number of log messages.",
 	}, []string{"level", "prefix"})
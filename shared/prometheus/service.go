@@ -9,19 +9,25 @@ import (
 	"runtime/pprof"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 	"github.com/sirupsen/logrus"
 )
 
 var log = logrus.WithField("prefix", "prometheus")
 
 // Service provides Prometheus metrics via the /metrics route. This route will
-// show all the metrics registered with the Prometheus DefaultRegisterer.
+// show all the metrics registered with the registry of the metrics package, which
+// includes the standard Go and process collectors, along with the metrics of libraries
+// registered with the Prometheus DefaultRegisterer.
 type Service struct {
-	server      *http.Server
-	svcRegistry *shared.ServiceRegistry
-	failStatus  error
+	server          *http.Server
+	svcRegistry     *shared.ServiceRegistry
+	failStatus      error
+	healthChecks    []namedCheck
+	readinessChecks []namedCheck
 }
 
 // NewPrometheusService sets up a new instance for a given address host:port.
@@ -30,8 +36,9 @@ func NewPrometheusService(addr string, svcRegistry *shared.ServiceRegistry) *Ser
 	s := &Service{svcRegistry: svcRegistry}
 
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Gatherer(), promhttp.HandlerOpts{}))
 	mux.HandleFunc("/healthz", s.healthzHandler)
+	mux.HandleFunc("/readyz", s.readyzHandler)
 	mux.HandleFunc("/goroutinez", s.goroutinezHandler)
 
 	s.server = &http.Server{Addr: addr, Handler: mux}
@@ -60,6 +67,9 @@ func (s *Service) healthzHandler(w http.ResponseWriter, _ *http.Request) {
 			hasError = true
 		}
 	}
+	if !writeChecks(&buf, s.healthChecks) {
+		hasError = true
+	}
 
 	// Write status header
 	if hasError {
@@ -82,6 +92,16 @@ func (s *Service) goroutinezHandler(w http.ResponseWriter, _ *http.Request) {
 	pprof.Lookup("goroutine").WriteTo(w, 2)
 }
 
+// Register the collectors of a service with the registry exposed by the service.
+func (s *Service) Register(collectors ...prometheus.Collector) error {
+	for _, c := range collectors {
+		if err := metrics.Registry.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Start the prometheus service.
 func (s *Service) Start() {
 	log.WithField("endpoint", s.server.Addr).Info("Starting service")
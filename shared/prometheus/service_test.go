@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -85,6 +86,72 @@ func TestHealthz(t *testing.T) {
 
 }
 
+func TestHealthz_Checks(t *testing.T) {
+	s := NewPrometheusService("" /*addr*/, shared.NewServiceRegistry())
+	var dbErr error
+	s.AddHealthCheck("db", func() error { return dbErr })
+
+	req, err := http.NewRequest("GET", "/healthz", nil /*reader*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := http.HandlerFunc(s.healthzHandler)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Errorf("expected OK status but got %v", rr.Code)
+	}
+	if body := rr.Body.String(); !strings.Contains(body, "db: OK") {
+		t.Errorf("Expected body to contain db status, but got %v", body)
+	}
+
+	dbErr = errors.New("database not open")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusInternalServerError {
+		t.Errorf("expected error status but got %v", rr.Code)
+	}
+	if body := rr.Body.String(); !strings.Contains(body, "db: ERROR database not open") {
+		t.Errorf("Expected body to contain db status, but got %v", body)
+	}
+}
+
+func TestReadyz(t *testing.T) {
+	s := NewPrometheusService("" /*addr*/, shared.NewServiceRegistry())
+	var dbErr, syncErr error
+	s.AddHealthCheck("db", func() error { return dbErr })
+	s.AddReadinessCheck("sync", func() error { return syncErr })
+
+	req, err := http.NewRequest("GET", "/readyz", nil /*reader*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := http.HandlerFunc(s.readyzHandler)
+
+	tests := []struct {
+		dbErr   error
+		syncErr error
+		code    int
+		body    string
+	}{
+		{code: http.StatusOK, body: "db: OK\nsync: OK\n"},
+		{syncErr: errors.New("behind head"), code: http.StatusServiceUnavailable, body: "db: OK\nsync: ERROR behind head\n"},
+		{dbErr: errors.New("closed"), code: http.StatusServiceUnavailable, body: "db: ERROR closed\nsync: OK\n"},
+	}
+	for i, tt := range tests {
+		dbErr, syncErr = tt.dbErr, tt.syncErr
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		if rr.Code != tt.code {
+			t.Errorf("Test %d: expected status %d but got %d", i, tt.code, rr.Code)
+		}
+		if body := rr.Body.String(); body != tt.body {
+			t.Errorf("Test %d: expected body %q but got %q", i, tt.body, body)
+		}
+	}
+}
+
 func TestStatus(t *testing.T) {
 	failError := errors.New("failure")
 	s := &Service{failStatus: failError}
@@ -93,3 +160,27 @@ func TestStatus(t *testing.T) {
 		t.Errorf("Wanted: %v, got: %v", s.failStatus, s.Status())
 	}
 }
+
+func TestMetrics_RegisteredCollectors(t *testing.T) {
+	s := NewPrometheusService(":2112", nil)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "test_service_gauge",
+		Help: "A gauge for testing",
+	})
+	if err := s.Register(gauge); err != nil {
+		t.Fatal(err)
+	}
+	gauge.Set(3)
+
+	rr := httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(rr, httptest.NewRequest("GET", "/metrics", nil))
+	body := rr.Body.String()
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rr.Code, body)
+	}
+	for _, metric := range []string{"test_service_gauge 3", "go_goroutines", "process_start_time_seconds"} {
+		if !strings.Contains(body, metric) {
+			t.Errorf("Expected metrics to contain %q", metric)
+		}
+	}
+}
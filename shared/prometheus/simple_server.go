@@ -4,13 +4,14 @@ import (
 	"net/http"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 // RunSimpleServerOrDie is a blocking call to serve /metrics at the given
 // address.
 func RunSimpleServerOrDie(addr string) {
 	mux := http.NewServeMux()
-	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Gatherer(), promhttp.HandlerOpts{}))
 
 	svr := &http.Server{Addr: addr, Handler: mux}
 	log.Fatal(svr.ListenAndServe())
@@ -0,0 +1,178 @@
+// Package resourcemonitor samples the resource usage of the process, exporting it as
+// metrics and failing its status check once it exceeds the configured limits.
+package resourcemonitor
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "resourcemonitor")
+
+var (
+	goroutinesGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "resourcemonitor_goroutines",
+		Help: "The number of goroutines at the last sample",
+	})
+	heapBytesGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "resourcemonitor_heap_bytes",
+		Help: "The bytes of allocated heap objects at the last sample",
+	})
+	openFilesGauge = metrics.NewGauge(prometheus.GaugeOpts{
+		Name: "resourcemonitor_open_files",
+		Help: "The number of open file descriptors at the last sample, if supported by the platform",
+	})
+	gcPauseSeconds = metrics.NewHistogram(prometheus.HistogramOpts{
+		Name:    "resourcemonitor_gc_pause_seconds",
+		Help:    "The duration of the garbage collection pauses observed between samples",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 8),
+	})
+)
+
+// Config options for the resource monitor. Limits of 0 are not checked.
+type Config struct {
+	Interval      time.Duration
+	MaxGoroutines int
+	MaxHeapBytes  uint64
+	MaxOpenFiles  int
+}
+
+// Sample of the resource usage of the process.
+type Sample struct {
+	Goroutines int
+	HeapBytes  uint64
+	// OpenFiles is -1 if the platform does not expose the open file descriptors.
+	OpenFiles int
+	// LastGCPause is the duration of the last garbage collection pause.
+	LastGCPause time.Duration
+}
+
+// Service samples the resource usage of the process at a regular interval.
+type Service struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	cfg       *Config
+	lock      sync.RWMutex
+	latest    Sample
+	lastNumGC uint32
+}
+
+// NewService creates a resource monitor which samples the process every interval,
+// once started.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.Interval <= 0 {
+		cfg.Interval = 10 * time.Second
+	}
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start sampling the resource usage.
+func (s *Service) Start() {
+	log.WithField("interval", s.cfg.Interval).Info("Starting service")
+	s.sample()
+	go s.run()
+}
+
+// Stop sampling the resource usage.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status returns an error if the last sample exceeds any of the configured limits.
+func (s *Service) Status() error {
+	return s.cfg.check(s.Latest())
+}
+
+// Latest returns the last sample of the resource usage.
+func (s *Service) Latest() Sample {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.latest
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.sample()
+		}
+	}
+}
+
+// sample records the resource usage of the process, observing the garbage collection
+// pauses since the previous sample.
+func (s *Service) sample() {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	sample := Sample{
+		Goroutines: runtime.NumGoroutine(),
+		HeapBytes:  mem.HeapAlloc,
+		OpenFiles:  openFiles(),
+	}
+	if mem.NumGC > 0 {
+		sample.LastGCPause = time.Duration(mem.PauseNs[(mem.NumGC+255)%256])
+	}
+
+	s.lock.Lock()
+	// The runtime keeps the durations of the last 256 pauses in a circular buffer.
+	first := s.lastNumGC
+	if mem.NumGC > 256 && first < mem.NumGC-256 {
+		first = mem.NumGC - 256
+	}
+	for n := first; n < mem.NumGC; n++ {
+		gcPauseSeconds.Observe(time.Duration(mem.PauseNs[n%256]).Seconds())
+	}
+	s.lastNumGC = mem.NumGC
+	s.latest = sample
+	s.lock.Unlock()
+
+	goroutinesGauge.Set(float64(sample.Goroutines))
+	heapBytesGauge.Set(float64(sample.HeapBytes))
+	openFilesGauge.Set(float64(sample.OpenFiles))
+	if err := s.cfg.check(sample); err != nil {
+		log.WithError(err).Warn("Resource usage exceeds limit")
+	}
+}
+
+// check returns an error if the sample exceeds any of the limits.
+func (cfg *Config) check(sample Sample) error {
+	if cfg.MaxGoroutines > 0 && sample.Goroutines > cfg.MaxGoroutines {
+		return fmt.Errorf("too many goroutines %d, limit is %d", sample.Goroutines, cfg.MaxGoroutines)
+	}
+	if cfg.MaxHeapBytes > 0 && sample.HeapBytes > cfg.MaxHeapBytes {
+		return fmt.Errorf("heap usage of %d bytes exceeds the limit of %d bytes", sample.HeapBytes, cfg.MaxHeapBytes)
+	}
+	if cfg.MaxOpenFiles > 0 && sample.OpenFiles > cfg.MaxOpenFiles {
+		return fmt.Errorf("too many open files %d, limit is %d", sample.OpenFiles, cfg.MaxOpenFiles)
+	}
+	return nil
+}
+
+// openFiles returns the number of open file descriptors of the process, or -1 if the
+// platform does not expose them under /proc.
+func openFiles() int {
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return -1
+	}
+	return len(fds)
+}
@@ -0,0 +1,53 @@
+package resourcemonitor
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestStatus_Limits(t *testing.T) {
+	tests := []struct {
+		cfg     *Config
+		wantErr string
+	}{
+		{cfg: &Config{}},
+		{cfg: &Config{MaxGoroutines: 1}, wantErr: "too many goroutines"},
+		{cfg: &Config{MaxHeapBytes: 1}, wantErr: "heap usage"},
+		{cfg: &Config{MaxGoroutines: 1000000, MaxHeapBytes: 1 << 40}},
+	}
+	for _, tt := range tests {
+		s := NewService(context.Background(), tt.cfg)
+		s.sample()
+		err := s.Status()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("Unexpected status error with config %+v: %v", tt.cfg, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Wanted status error containing %q with config %+v, received %v", tt.wantErr, tt.cfg, err)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	s := NewService(context.Background(), &Config{})
+	runtime.GC()
+	s.sample()
+	sample := s.Latest()
+	if sample.Goroutines == 0 {
+		t.Error("Wanted goroutines to be sampled")
+	}
+	if sample.HeapBytes == 0 {
+		t.Error("Wanted heap usage to be sampled")
+	}
+	if runtime.GOOS == "linux" && sample.OpenFiles <= 0 {
+		t.Errorf("Wanted open files to be sampled on linux, received %d", sample.OpenFiles)
+	}
+	if s.lastNumGC == 0 {
+		t.Error("Wanted garbage collections to be observed")
+	}
+}
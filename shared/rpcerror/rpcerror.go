@@ -0,0 +1,56 @@
+// Package rpcerror defines machine readable reasons attached to the gRPC errors returned
+// by the beacon node when it rejects a block or attestation submitted by a validator, so
+// that validators can react to a rejection without parsing the error message.
+package rpcerror
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reason describes why a submission was rejected by the beacon node.
+type Reason string
+
+const (
+	// ReasonUnknownParent is returned when the parent of a block is not known to the node.
+	ReasonUnknownParent Reason = "UNKNOWN_PARENT"
+	// ReasonUnknownBlockRoot is returned when an attestation votes for a block which is
+	// not known to the node.
+	ReasonUnknownBlockRoot Reason = "UNKNOWN_BLOCK_ROOT"
+	// ReasonFutureSlot is returned when a submission is for a slot which has not started yet.
+	ReasonFutureSlot Reason = "FUTURE_SLOT"
+	// ReasonFailedStateTransition is returned when a block fails the state transition.
+	ReasonFailedStateTransition Reason = "FAILED_STATE_TRANSITION"
+	// ReasonSlashable is returned when the node refuses a submission which conflicts with
+	// one it already has from the same validator and would get the validator slashed.
+	ReasonSlashable Reason = "SLASHABLE"
+)
+
+// Errorf returns a gRPC status error with the given code and formatted message, which
+// carries the reason as an error detail.
+func Errorf(c codes.Code, reason Reason, format string, a ...interface{}) error {
+	st := status.New(c, fmt.Sprintf(format, a...))
+	withReason, err := st.WithDetails(&wrappers.StringValue{Value: string(reason)})
+	if err != nil {
+		return st.Err()
+	}
+	return withReason.Err()
+}
+
+// ReasonOf returns the reason carried by a gRPC status error, or an empty reason if the
+// error does not carry one.
+func ReasonOf(err error) Reason {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	for _, detail := range st.Details() {
+		if reason, ok := detail.(*wrappers.StringValue); ok {
+			return Reason(reason.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package rpcerror
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorf_CarriesReason(t *testing.T) {
+	err := Errorf(codes.FailedPrecondition, ReasonUnknownParent, "parent %#x not found", []byte{'a'})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected code %v, received %v", codes.FailedPrecondition, status.Code(err))
+	}
+	if got := ReasonOf(err); got != ReasonUnknownParent {
+		t.Errorf("Expected reason %s, received %s", ReasonUnknownParent, got)
+	}
+	if want := "parent 0x61 not found"; status.Convert(err).Message() != want {
+		t.Errorf("Expected message %q, received %q", want, status.Convert(err).Message())
+	}
+}
+
+func TestReasonOf_NoReason(t *testing.T) {
+	if got := ReasonOf(status.Error(codes.Internal, "failed")); got != "" {
+		t.Errorf("Expected no reason, received %s", got)
+	}
+	if got := ReasonOf(errors.New("failed")); got != "" {
+		t.Errorf("Expected no reason, received %s", got)
+	}
+}
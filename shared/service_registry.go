@@ -3,6 +3,7 @@ package shared
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -21,12 +22,23 @@ type Service interface {
 	Status() error
 }
 
+// DefaultStopTimeout is the time a service is given to stop before the shutdown moves on
+// to the next service.
+const DefaultStopTimeout = 30 * time.Second
+
 // ServiceRegistry provides a useful pattern for managing services.
 // It allows for ease of dependency management and ensures services
 // dependent on others use the same references in memory.
 type ServiceRegistry struct {
 	services     map[reflect.Type]Service // map of types to services.
 	serviceTypes []reflect.Type           // keep an ordered slice of registered service types.
+	closers      []namedCloser            // resources closed once every service is stopped.
+	stopTimeout  time.Duration            // time given to each service to stop.
+}
+
+type namedCloser struct {
+	name  string
+	close func() error
 }
 
 // NewServiceRegistry starts a registry instance for convenience
@@ -45,16 +57,54 @@ func (s *ServiceRegistry) StartAll() {
 	}
 }
 
-// StopAll ends every service in reverse order of registration, logging a
-// panic if any of them fail to stop.
+// StopAll ends every service in reverse order of registration, so that services are
+// stopped before the services they depend on, then closes the registered closers in
+// reverse order. A service which fails to stop, or does not stop within the stop
+// timeout, is logged and the shutdown moves on to the next one.
 func (s *ServiceRegistry) StopAll() {
+	timeout := s.stopTimeout
+	if timeout <= 0 {
+		timeout = DefaultStopTimeout
+	}
 	for i := len(s.serviceTypes) - 1; i >= 0; i-- {
 		kind := s.serviceTypes[i]
-		service := s.services[kind]
-		if err := service.Stop(); err != nil {
-			log.Panicf("Could not stop the following service: %v, %v", kind, err)
+		log.Debugf("Stopping service type %v", kind)
+		if err := stopWithTimeout(s.services[kind].Stop, timeout); err != nil {
+			log.Errorf("Could not stop the following service: %v, %v", kind, err)
 		}
 	}
+	for i := len(s.closers) - 1; i >= 0; i-- {
+		closer := s.closers[i]
+		if err := stopWithTimeout(closer.close, timeout); err != nil {
+			log.Errorf("Could not close %s: %v", closer.name, err)
+		}
+	}
+}
+
+// SetStopTimeout sets the time each service is given to stop, DefaultStopTimeout if 0.
+func (s *ServiceRegistry) SetStopTimeout(timeout time.Duration) {
+	s.stopTimeout = timeout
+}
+
+// RegisterCloser adds a resource shared by the services, such as the database, to be
+// closed once every service is stopped.
+func (s *ServiceRegistry) RegisterCloser(name string, close func() error) {
+	s.closers = append(s.closers, namedCloser{name: name, close: close})
+}
+
+// stopWithTimeout runs the stop function, returning an error if it fails or does not
+// return within the timeout.
+func stopWithTimeout(stop func() error, timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- stop()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v", timeout)
+	}
 }
 
 // Statuses returns a map of Service type -> error. The map will be populated
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 )
 
 type mockService struct {
@@ -142,3 +143,70 @@ func TestServiceStatus_OK(t *testing.T) {
 		t.Errorf("Received unexpected status for %T = %v", s, sStatus)
 	}
 }
+
+type orderedMockService struct {
+	name    string
+	stopped *[]string
+	block   chan struct{}
+}
+
+func (o *orderedMockService) Start() {
+}
+
+func (o *orderedMockService) Stop() error {
+	if o.block != nil {
+		<-o.block
+	}
+	*o.stopped = append(*o.stopped, o.name)
+	return nil
+}
+
+func (o *orderedMockService) Status() error {
+	return nil
+}
+
+type secondOrderedMockService struct {
+	orderedMockService
+}
+
+func TestStopAll_ReverseOrderThenClosers(t *testing.T) {
+	registry := NewServiceRegistry()
+	var stopped []string
+	if err := registry.RegisterService(&orderedMockService{name: "first", stopped: &stopped}); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.RegisterService(&secondOrderedMockService{orderedMockService{name: "second", stopped: &stopped}}); err != nil {
+		t.Fatal(err)
+	}
+	registry.RegisterCloser("db", func() error {
+		stopped = append(stopped, "db")
+		return nil
+	})
+
+	registry.StopAll()
+	want := []string{"second", "first", "db"}
+	if !reflect.DeepEqual(stopped, want) {
+		t.Errorf("Wanted stop order %v, received %v", want, stopped)
+	}
+}
+
+func TestStopAll_Timeout(t *testing.T) {
+	registry := NewServiceRegistry()
+	registry.SetStopTimeout(10 * time.Millisecond)
+	var stopped []string
+	block := make(chan struct{})
+	defer close(block)
+	if err := registry.RegisterService(&orderedMockService{name: "stuck", stopped: &stopped, block: block}); err != nil {
+		t.Fatal(err)
+	}
+	closed := false
+	registry.RegisterCloser("db", func() error {
+		closed = true
+		return nil
+	})
+
+	registry.StopAll()
+	if !closed {
+		t.Error("Wanted closers to run after a service timed out")
+	}
+}
@@ -2,6 +2,8 @@ package slotutil
 
 import (
 	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 )
 
 // SlotTicker is a special ticker for the beacon chain block.
@@ -30,11 +32,17 @@ func (s *SlotTicker) Done() {
 
 // GetSlotTicker is the constructor for SlotTicker.
 func GetSlotTicker(genesisTime time.Time, secondsPerSlot uint64) *SlotTicker {
+	return GetSlotTickerWithOffset(genesisTime, 0, secondsPerSlot)
+}
+
+// GetSlotTickerWithOffset returns a SlotTicker whose ticks happen the offset after the
+// start of every slot, such as a third into the slot, still emitting the slot number.
+func GetSlotTickerWithOffset(genesisTime time.Time, offset time.Duration, secondsPerSlot uint64) *SlotTicker {
 	ticker := &SlotTicker{
 		c:    make(chan uint64),
 		done: make(chan struct{}),
 	}
-	ticker.start(genesisTime, secondsPerSlot, time.Since, time.Until, time.After)
+	ticker.start(genesisTime.Add(offset), secondsPerSlot, clockutil.Since, clockutil.Until, time.After)
 	return ticker
 }
 
@@ -0,0 +1,41 @@
+package slotutil
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SlotStartTime returns the wall clock time at which the slot starts, given the unix
+// genesis time.
+func SlotStartTime(genesisTime uint64, slot uint64) time.Time {
+	duration := time.Duration(slot*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return time.Unix(int64(genesisTime), 0).Add(duration)
+}
+
+// SlotsSinceGenesis returns the number of slots started since the genesis time, which
+// is 0 before genesis. The local clock is corrected by the clock check, if enabled.
+func SlotsSinceGenesis(genesis time.Time) uint64 {
+	sinceGenesis := clockutil.Since(genesis)
+	if sinceGenesis < 0 {
+		return 0
+	}
+	return uint64(sinceGenesis / SlotDuration())
+}
+
+// CurrentSlot returns the slot of the wall clock time, given the unix genesis time.
+func CurrentSlot(genesisTime uint64) uint64 {
+	return SlotsSinceGenesis(time.Unix(int64(genesisTime), 0))
+}
+
+// SlotDuration returns the duration of a slot.
+func SlotDuration() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
+
+// DivideSlotBy returns the duration of a fraction of a slot, as an offset into the slot
+// such as the midpoint at which attestations are made.
+func DivideSlotBy(n int64) time.Duration {
+	return SlotDuration() / time.Duration(n)
+}
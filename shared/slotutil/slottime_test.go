@@ -0,0 +1,41 @@
+package slotutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSlotStartTime(t *testing.T) {
+	c := params.BeaconConfig()
+	c.SecondsPerSlot = 6
+	params.OverrideBeaconConfig(c)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	genesis := uint64(1000)
+	if got, want := SlotStartTime(genesis, 0), time.Unix(1000, 0); !got.Equal(want) {
+		t.Errorf("Wanted slot 0 to start at %v, received %v", want, got)
+	}
+	if got, want := SlotStartTime(genesis, 10), time.Unix(1060, 0); !got.Equal(want) {
+		t.Errorf("Wanted slot 10 to start at %v, received %v", want, got)
+	}
+	if got := DivideSlotBy(3); got != 2*time.Second {
+		t.Errorf("Wanted a third of a slot to be 2s, received %v", got)
+	}
+}
+
+func TestCurrentSlot(t *testing.T) {
+	c := params.BeaconConfig()
+	c.SecondsPerSlot = 6
+	params.OverrideBeaconConfig(c)
+	defer params.OverrideBeaconConfig(params.MainnetConfig())
+
+	now := uint64(time.Now().Unix())
+	if slot := CurrentSlot(now + 60); slot != 0 {
+		t.Errorf("Wanted slot 0 before genesis, received %d", slot)
+	}
+	if slot := CurrentSlot(now - 61); slot != 10 {
+		t.Errorf("Wanted slot 10, received %d", slot)
+	}
+}
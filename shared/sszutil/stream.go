@@ -0,0 +1,46 @@
+// Package sszutil provides helpers to send the SSZ encoding of very large objects, such
+// as the beacon state, in chunks over network connections.
+package sszutil
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+// ChunkSize is the size of the chunks written by EncodeChunks.
+const ChunkSize = 1 << 20
+
+// EncodeChunks writes the SSZ encoding of the value to the writer in chunks of at most
+// ChunkSize bytes. The value is encoded in full before the first chunk is written, so
+// that chunking bounds the size of the messages of writers such as gRPC streams rather
+// than the memory used to encode the value.
+func EncodeChunks(w io.Writer, val interface{}) (uint64, error) {
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		return 0, fmt.Errorf("could not encode value: %v", err)
+	}
+	for i := 0; i < len(enc); i += ChunkSize {
+		end := i + ChunkSize
+		if end > len(enc) {
+			end = len(enc)
+		}
+		if _, err := w.Write(enc[i:end]); err != nil {
+			return uint64(i), err
+		}
+	}
+	return uint64(len(enc)), nil
+}
+
+// ChunkSender is an io.Writer sending every write as a chunk, such as a message of a
+// gRPC stream. The chunk is only valid until the function returns.
+type ChunkSender func(chunk []byte) error
+
+// Write sends the bytes as a chunk.
+func (s ChunkSender) Write(p []byte) (int, error) {
+	if err := s(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
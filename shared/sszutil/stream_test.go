@@ -0,0 +1,53 @@
+package sszutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestEncodeChunks(t *testing.T) {
+	val := &pb.Fork{
+		PreviousVersion: []byte{1, 2, 3, 4},
+		CurrentVersion:  []byte{5, 6, 7, 8},
+		Epoch:           9,
+	}
+
+	var chunks int
+	buf := new(bytes.Buffer)
+	size, err := EncodeChunks(ChunkSender(func(chunk []byte) error {
+		chunks++
+		_, err := buf.Write(chunk)
+		return err
+	}), val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), enc) || size != uint64(len(enc)) {
+		t.Errorf("Expected chunked encoding %#x, got %#x", enc, buf.Bytes())
+	}
+	if chunks != 1 {
+		t.Errorf("Expected 1 chunk, got %d", chunks)
+	}
+
+	// Large values are sent in chunks.
+	chunks = 0
+	if _, err := EncodeChunks(ChunkSender(func(chunk []byte) error {
+		chunks++
+		if len(chunk) > ChunkSize {
+			t.Errorf("Chunk of %d bytes exceeds the chunk size", len(chunk))
+		}
+		return nil
+	}), make([]byte, 3*ChunkSize+1)); err != nil {
+		t.Fatal(err)
+	}
+	if chunks != 4 {
+		t.Errorf("Expected 4 chunks, got %d", chunks)
+	}
+}
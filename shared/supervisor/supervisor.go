@@ -0,0 +1,134 @@
+// Package supervisor runs the goroutines of a service, recovering their panics and
+// restarting them with a backoff, so that a panic in one goroutine is logged with its
+// stack trace and reported by the status of its service instead of crashing the node.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "supervisor")
+
+var (
+	goroutinePanics = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "service_goroutine_panics_total",
+		Help: "The number of panics recovered in the goroutines of each service",
+	}, []string{"service", "goroutine"})
+	goroutineRestarts = metrics.NewCounterVec(prometheus.CounterOpts{
+		Name: "service_goroutine_restarts_total",
+		Help: "The number of restarts of the goroutines of each service after a panic",
+	}, []string{"service", "goroutine"})
+)
+
+// Policy defines how a goroutine is restarted after a panic.
+type Policy struct {
+	// MaxRestarts is the number of times a goroutine is restarted, after which its
+	// service reports an error. A negative value restarts it indefinitely.
+	MaxRestarts int
+	// Backoff is the delay before the first restart, doubled at every restart.
+	Backoff time.Duration
+	// MaxBackoff bounds the delay between restarts.
+	MaxBackoff time.Duration
+}
+
+// DefaultPolicy is the restart policy of a supervisor created with the zero policy.
+var DefaultPolicy = Policy{
+	MaxRestarts: 5,
+	Backoff:     time.Second,
+	MaxBackoff:  time.Minute,
+}
+
+// Supervisor runs the goroutines of a service. Goroutines are no longer restarted once
+// the context of the supervisor is done, which is usually the context of the service.
+type Supervisor struct {
+	ctx     context.Context
+	service string
+	policy  Policy
+	lock    sync.RWMutex
+	err     error
+}
+
+// New creates a supervisor for the goroutines of the named service.
+func New(ctx context.Context, service string, policy Policy) *Supervisor {
+	if policy == (Policy{}) {
+		policy = DefaultPolicy
+	}
+	return &Supervisor{
+		ctx:     ctx,
+		service: service,
+		policy:  policy,
+	}
+}
+
+// Go runs the function in a new goroutine, restarting it according to the policy of
+// the supervisor whenever it panics. A function which returns is not restarted.
+func (s *Supervisor) Go(name string, fn func()) {
+	go func() {
+		backoff := s.policy.Backoff
+		for restarts := 0; ; restarts++ {
+			if !s.run(name, fn) || s.ctx.Err() != nil {
+				return
+			}
+			fields := logrus.Fields{
+				"service":   s.service,
+				"goroutine": name,
+			}
+			if s.policy.MaxRestarts >= 0 && restarts >= s.policy.MaxRestarts {
+				s.lock.Lock()
+				s.err = fmt.Errorf("goroutine %s panicked %d times and was not restarted", name, restarts+1)
+				s.lock.Unlock()
+				log.WithFields(fields).Error("Goroutine panicked too many times, not restarting it")
+				return
+			}
+
+			log.WithFields(fields).WithField("backoff", backoff).Warn("Restarting goroutine after panic")
+			select {
+			case <-time.After(backoff):
+			case <-s.ctx.Done():
+				return
+			}
+			goroutineRestarts.WithLabelValues(s.service, name).Inc()
+			backoff *= 2
+			if s.policy.MaxBackoff > 0 && backoff > s.policy.MaxBackoff {
+				backoff = s.policy.MaxBackoff
+			}
+		}
+	}()
+}
+
+// Status returns an error once a goroutine of the supervisor panicked more times than
+// the policy allows. A nil supervisor, as in a service built without its constructor,
+// has no error.
+func (s *Supervisor) Status() error {
+	if s == nil {
+		return nil
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// run calls the function, returning whether it panicked.
+func (s *Supervisor) run(name string, fn func()) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			goroutinePanics.WithLabelValues(s.service, name).Inc()
+			log.WithFields(logrus.Fields{
+				"service":   s.service,
+				"goroutine": name,
+				"panic":     r,
+			}).Errorf("Recovered panic in service goroutine\n%s", debug.Stack())
+		}
+	}()
+	fn()
+	return false
+}
@@ -0,0 +1,79 @@
+package supervisor
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitFor polls the condition until it holds or a second passed.
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Condition not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSupervisor_RestartsUntilMaxRestarts(t *testing.T) {
+	s := New(context.Background(), "test", Policy{MaxRestarts: 2, Backoff: time.Millisecond})
+	var runs int32
+	s.Go("panicking", func() {
+		atomic.AddInt32(&runs, 1)
+		panic("boom")
+	})
+
+	waitFor(t, func() bool { return s.Status() != nil })
+	if got := atomic.LoadInt32(&runs); got != 3 {
+		t.Errorf("Expected the goroutine to run 3 times, ran %d times", got)
+	}
+}
+
+func TestSupervisor_RecoversFromTransientPanic(t *testing.T) {
+	s := New(context.Background(), "test", Policy{MaxRestarts: 2, Backoff: time.Millisecond})
+	var runs int32
+	done := make(chan struct{})
+	s.Go("transient", func() {
+		if atomic.AddInt32(&runs, 1) == 1 {
+			panic("boom")
+		}
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Goroutine was not restarted")
+	}
+	// A goroutine which returns is not restarted.
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&runs); got != 2 {
+		t.Errorf("Expected the goroutine to run 2 times, ran %d times", got)
+	}
+	if err := s.Status(); err != nil {
+		t.Errorf("Expected no error after a recovered panic, received %v", err)
+	}
+}
+
+func TestSupervisor_NoRestartAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New(ctx, "test", Policy{MaxRestarts: -1, Backoff: time.Hour})
+	var runs int32
+	s.Go("panicking", func() {
+		atomic.AddInt32(&runs, 1)
+		panic("boom")
+	})
+
+	waitFor(t, func() bool { return atomic.LoadInt32(&runs) == 1 })
+	cancel()
+	time.Sleep(10 * time.Millisecond)
+	if got := atomic.LoadInt32(&runs); got != 1 {
+		t.Errorf("Expected the goroutine to run once, ran %d times", got)
+	}
+	if err := s.Status(); err != nil {
+		t.Errorf("Expected no error for a stopped service, received %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// Spans are sent to the OTLP collector in batches, once the batch is full or the flush
+// interval has elapsed. Spans beyond the buffer size are dropped rather than blocking
+// the traced code while the collector is unreachable.
+var (
+	otlpBatchSize     = 512
+	otlpBufferSize    = 8192
+	otlpFlushInterval = 5 * time.Second
+)
+
+// otlpExporter is a trace exporter sending the spans to a collector with the OTLP/HTTP
+// protocol, in its JSON encoding.
+type otlpExporter struct {
+	endpoint string
+	username string
+	password string
+	headers  map[string]string
+	resource otlpResource
+	client   *http.Client
+	spans    chan *trace.SpanData
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// The following types are the JSON encoding of an OTLP export trace service request.
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// OTLP span kinds and status codes.
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpStatusUnset      = 0
+	otlpStatusError      = 2
+)
+
+func newOTLPExporter(cfg *Config) (trace.Exporter, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultOTLPEndpoint
+	}
+	if u, err := url.Parse(endpoint); err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q", endpoint)
+	}
+	headers := make(map[string]string, len(cfg.Headers))
+	for _, header := range cfg.Headers {
+		kv := strings.SplitN(header, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid tracing header %q, expected key=value", header)
+		}
+		headers[kv[0]] = kv[1]
+	}
+	log.Infof("Starting OTLP exporter endpoint at address = %s", endpoint)
+	e := &otlpExporter{
+		endpoint: endpoint,
+		username: cfg.Username,
+		password: cfg.Password,
+		headers:  headers,
+		resource: otlpResource{
+			Attributes: []otlpAttribute{
+				stringAttribute("service.name", cfg.ServiceName),
+				stringAttribute("service.version", version.GetVersion()),
+				stringAttribute("process_name", cfg.ProcessName),
+			},
+		},
+		client: &http.Client{Timeout: 10 * time.Second},
+		spans:  make(chan *trace.SpanData, otlpBufferSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go e.run()
+	return e, nil
+}
+
+// ExportSpan queues the span to be sent to the collector.
+func (e *otlpExporter) ExportSpan(s *trace.SpanData) {
+	select {
+	case e.spans <- s:
+	default:
+	}
+}
+
+// Stop sends the buffered spans to the collector and ends the export loop. Spans exported
+// afterwards are dropped.
+func (e *otlpExporter) Stop() {
+	e.stopOnce.Do(func() {
+		close(e.stop)
+	})
+	<-e.done
+}
+
+func (e *otlpExporter) run() {
+	defer close(e.done)
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+	var batch []*trace.SpanData
+	for {
+		select {
+		case s := <-e.spans:
+			batch = append(batch, s)
+			if len(batch) < otlpBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		case <-e.stop:
+			e.drain(batch)
+			return
+		}
+		e.sendBatch(batch)
+		batch = nil
+	}
+}
+
+// drain sends the given batch along with the spans left in the buffer.
+func (e *otlpExporter) drain(batch []*trace.SpanData) {
+	for {
+		select {
+		case s := <-e.spans:
+			batch = append(batch, s)
+			if len(batch) < otlpBatchSize {
+				continue
+			}
+			e.sendBatch(batch)
+			batch = nil
+		default:
+			if len(batch) > 0 {
+				e.sendBatch(batch)
+			}
+			return
+		}
+	}
+}
+
+func (e *otlpExporter) sendBatch(batch []*trace.SpanData) {
+	if err := e.send(batch); err != nil {
+		log.WithError(err).Error("Failed to process span")
+	}
+}
+
+func (e *otlpExporter) send(batch []*trace.SpanData) error {
+	spans := make([]otlpSpan, len(batch))
+	for i, s := range batch {
+		spans[i] = toOTLPSpan(s)
+	}
+	body, err := json.Marshal(&otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: e.resource,
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "prysm", Version: version.GetVersion()},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	if e.username != "" || e.password != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func toOTLPSpan(s *trace.SpanData) otlpSpan {
+	span := otlpSpan{
+		TraceID:           hex.EncodeToString(s.TraceID[:]),
+		SpanID:            hex.EncodeToString(s.SpanID[:]),
+		Name:              s.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime.UnixNano(), 10),
+		Status:            otlpStatus{Code: otlpStatusUnset},
+	}
+	if s.ParentSpanID != (trace.SpanID{}) {
+		span.ParentSpanID = hex.EncodeToString(s.ParentSpanID[:])
+	}
+	switch s.SpanKind {
+	case trace.SpanKindServer:
+		span.Kind = otlpSpanKindServer
+	case trace.SpanKindClient:
+		span.Kind = otlpSpanKindClient
+	}
+	if s.Status.Code != trace.StatusCodeOK {
+		span.Status = otlpStatus{Code: otlpStatusError, Message: s.Status.Message}
+	}
+	for k, v := range s.Attributes {
+		span.Attributes = append(span.Attributes, toOTLPAttribute(k, v))
+	}
+	return span
+}
+
+func toOTLPAttribute(key string, value interface{}) otlpAttribute {
+	switch v := value.(type) {
+	case bool:
+		return otlpAttribute{Key: key, Value: otlpValue{BoolValue: &v}}
+	case int64:
+		i := strconv.FormatInt(v, 10)
+		return otlpAttribute{Key: key, Value: otlpValue{IntValue: &i}}
+	case float64:
+		return otlpAttribute{Key: key, Value: otlpValue{DoubleValue: &v}}
+	default:
+		return stringAttribute(key, fmt.Sprint(v))
+	}
+}
+
+func stringAttribute(key string, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
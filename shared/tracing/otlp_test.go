@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opencensus.io/trace"
+)
+
+func TestOTLPExporter_SendsSpans(t *testing.T) {
+	requests := make(chan *otlpRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "secret" {
+			t.Errorf("Expected configured header, got %v", r.Header)
+		}
+		req := &otlpRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Error(err)
+		}
+		requests <- req
+	}))
+	defer srv.Close()
+
+	exporter, err := newOTLPExporter(&Config{
+		ServiceName: "beacon-chain",
+		Endpoint:    srv.URL,
+		Headers:     []string{"X-Api-Key=secret"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := exporter.(*otlpExporter)
+	span := &trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{2},
+		},
+		Name:       "beacon-chain.blockchain.ReceiveBlock",
+		StartTime:  time.Now(),
+		EndTime:    time.Now(),
+		Attributes: map[string]interface{}{"slot": int64(5)},
+		Status:     trace.Status{Code: trace.StatusCodeInternal, Message: "failed"},
+	}
+	if err := e.send([]*trace.SpanData{span}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := <-requests
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].TraceID != "01000000000000000000000000000000" || spans[0].SpanID != "0200000000000000" {
+		t.Errorf("Unexpected span ids %s %s", spans[0].TraceID, spans[0].SpanID)
+	}
+	if spans[0].Status.Code != otlpStatusError {
+		t.Errorf("Expected error status, got %d", spans[0].Status.Code)
+	}
+	if len(spans[0].Attributes) != 1 || *spans[0].Attributes[0].Value.IntValue != "5" {
+		t.Errorf("Unexpected attributes %v", spans[0].Attributes)
+	}
+}
+
+func TestOTLPExporter_StopFlushesBufferedSpans(t *testing.T) {
+	requests := make(chan *otlpRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &otlpRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Error(err)
+		}
+		requests <- req
+	}))
+	defer srv.Close()
+
+	exporter, err := newOTLPExporter(&Config{ServiceName: "beacon-chain", Endpoint: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := exporter.(*otlpExporter)
+	for i := 0; i < 3; i++ {
+		e.ExportSpan(&trace.SpanData{Name: "span", StartTime: time.Now(), EndTime: time.Now()})
+	}
+	// The spans are buffered until the flush interval elapses, they must be sent on stop.
+	e.Stop()
+	e.Stop()
+
+	select {
+	case req := <-requests:
+		if spans := req.ResourceSpans[0].ScopeSpans[0].Spans; len(spans) != 3 {
+			t.Errorf("Expected 3 spans, got %d", len(spans))
+		}
+	default:
+		t.Fatal("Expected buffered spans to be sent on stop")
+	}
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	if err := Setup(&Config{ServiceName: "validator", Exporter: "zipkin", Enable: true}); err == nil {
+		t.Error("Expected error with an unknown exporter")
+	}
+}
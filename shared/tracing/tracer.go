@@ -2,6 +2,7 @@ package tracing
 
 import (
 	"errors"
+	"fmt"
 
 	"contrib.go.opencensus.io/exporter/jaeger"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -11,29 +12,99 @@ import (
 
 var log = logrus.WithField("prefix", "tracing")
 
+// exporter is the trace exporter registered by Setup, if any.
+var exporter trace.Exporter
+
+// Names of the supported trace exporters.
+const (
+	JaegerExporter = "jaeger"
+	OTLPExporter   = "otlp"
+)
+
+// Default endpoints of the collectors of each exporter.
+const (
+	defaultJaegerEndpoint = "http://127.0.0.1:14268"
+	defaultOTLPEndpoint   = "http://127.0.0.1:4318/v1/traces"
+)
+
+// Config for the tracing of a service.
+type Config struct {
+	ServiceName    string
+	ProcessName    string
+	Exporter       string   // Exporter is the name of the exporter, jaeger if empty.
+	Endpoint       string   // Endpoint of the collector, the default endpoint of the exporter if empty.
+	Username       string   // Username for the basic auth of the collector, if any.
+	Password       string   // Password for the basic auth of the collector, if any.
+	Headers        []string // Headers sent to the collector as key=value, for the OTLP exporter only.
+	SampleFraction float64
+	Enable         bool
+}
+
 // Setup creates and initializes a new tracing configuration..
-func Setup(serviceName, processName, endpoint string, sampleFraction float64, enable bool) error {
-	if !enable {
+func Setup(cfg *Config) error {
+	if !cfg.Enable {
 		trace.ApplyConfig(trace.Config{DefaultSampler: trace.NeverSample()})
 		return nil
 	}
 
-	if serviceName == "" {
+	if cfg.ServiceName == "" {
 		return errors.New("tracing service name cannot be empty")
 	}
 
 	trace.ApplyConfig(trace.Config{
-		DefaultSampler:          trace.ProbabilitySampler(sampleFraction),
+		DefaultSampler:          trace.ProbabilitySampler(cfg.SampleFraction),
 		MaxMessageEventsPerSpan: 500,
 	})
 
+	var e trace.Exporter
+	var err error
+	switch cfg.Exporter {
+	case JaegerExporter, "":
+		e, err = newJaegerExporter(cfg)
+	case OTLPExporter:
+		e, err = newOTLPExporter(cfg)
+	default:
+		return fmt.Errorf("unknown trace exporter %s, expected %s or %s", cfg.Exporter, JaegerExporter, OTLPExporter)
+	}
+	if err != nil {
+		return err
+	}
+	trace.RegisterExporter(e)
+	exporter = e
+
+	return nil
+}
+
+// Stop unregisters the trace exporter registered by Setup and sends the spans it buffers
+// to the collector, so that no span is lost when the node shuts down.
+func Stop() {
+	if exporter == nil {
+		return
+	}
+	trace.UnregisterExporter(exporter)
+	switch e := exporter.(type) {
+	case *otlpExporter:
+		e.Stop()
+	case *jaeger.Exporter:
+		e.Flush()
+	}
+	exporter = nil
+}
+
+func newJaegerExporter(cfg *Config) (trace.Exporter, error) {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultJaegerEndpoint
+	}
 	log.Infof("Starting Jaeger exporter endpoint at address = %s", endpoint)
-	exporter, err := jaeger.NewExporter(jaeger.Options{
+	return jaeger.NewExporter(jaeger.Options{
 		CollectorEndpoint: endpoint,
+		Username:          cfg.Username,
+		Password:          cfg.Password,
 		Process: jaeger.Process{
-			ServiceName: serviceName,
+			ServiceName: cfg.ServiceName,
 			Tags: []jaeger.Tag{
-				jaeger.StringTag("process_name", processName),
+				jaeger.StringTag("process_name", cfg.ProcessName),
 				jaeger.StringTag("version", version.GetVersion()),
 			},
 		},
@@ -42,10 +113,4 @@ func Setup(serviceName, processName, endpoint string, sampleFraction float64, en
 			log.WithError(err).Error("Failed to process span")
 		},
 	})
-	if err != nil {
-		return err
-	}
-	trace.RegisterExporter(exporter)
-
-	return nil
 }
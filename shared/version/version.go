@@ -2,13 +2,59 @@ package version
 
 import (
 	"fmt"
+	"runtime"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 // The value of these vars are set through linker options.
 var gitCommit = "Local build"
 var buildDate = "Moments ago"
 
+var buildInfo = metrics.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "prysm_build_info",
+	Help: "Always 1, labeled with the git commit, build date and Go version of the running build",
+}, []string{"commit", "build_date", "go_version"})
+
+func init() {
+	buildInfo.WithLabelValues(gitCommit, buildDate, GoVersion()).Set(1)
+}
+
 // GetVersion returns the version string of this build.
 func GetVersion() string {
 	return fmt.Sprintf("Git commit: %s. Built at: %s", gitCommit, buildDate)
 }
+
+// GitCommit returns the git commit this build was made from.
+func GitCommit() string {
+	return gitCommit
+}
+
+// BuildDate returns the date this build was made at.
+func BuildDate() string {
+	return buildDate
+}
+
+// GoVersion returns the version of Go this build was compiled with.
+func GoVersion() string {
+	return runtime.Version()
+}
+
+// GetBuildData returns the detailed build metadata, one item per line, as printed by
+// --version.
+func GetBuildData() string {
+	return fmt.Sprintf(
+		"Git commit: %s\nBuilt at: %s\nGo version: %s\nPlatform: %s/%s",
+		gitCommit, buildDate, GoVersion(), runtime.GOOS, runtime.GOARCH,
+	)
+}
+
+// Metadata returns the build metadata as space separated key=value pairs, for the
+// metadata of the version reported over RPC.
+func Metadata() string {
+	return fmt.Sprintf(
+		"commit=%s build_date=%q go=%s platform=%s/%s",
+		gitCommit, buildDate, GoVersion(), runtime.GOOS, runtime.GOARCH,
+	)
+}
@@ -10,23 +10,23 @@ import (
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/cluster"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/metrics"
 )
 
 var (
-	allocatedPkCount = promauto.NewGauge(prometheus.GaugeOpts{
+	allocatedPkCount = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "allocated_pk_count",
 		Help: "The number of allocated private keys",
 	})
-	assignedPkCount = promauto.NewGauge(prometheus.GaugeOpts{
+	assignedPkCount = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "assigned_pk_count",
 		Help: "The number of private keys currently assigned to alive pods",
 	})
-	blacklistedPKCount = promauto.NewGauge(prometheus.GaugeOpts{
+	blacklistedPKCount = metrics.NewGauge(prometheus.GaugeOpts{
 		Name: "blacklisted_pk_count",
 		Help: "The number of private keys which have been removed that are of exited validators",
 	})
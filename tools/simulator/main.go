@@ -0,0 +1,108 @@
+/**
+ * Simulator
+ *
+ * Launches a number of in-process beacon nodes and their validators on a
+ * simulated network, starting from a deterministic interop genesis, and
+ * advances the chain slot by slot. Useful for reproducing sync and fork
+ * choice issues deterministically.
+ *
+ * Usage: Run simulator --help for flag options.
+ */
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/simulator"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	_ "go.uber.org/automaxprocs"
+)
+
+var (
+	nodes          = flag.Int("nodes", 4, "Number of beacon nodes to launch")
+	validators     = flag.Uint64("validators", 64, "Number of genesis validators, spread evenly across the nodes")
+	slots          = flag.Uint64("slots", 16, "Number of slots to simulate")
+	partitionSlot  = flag.Uint64("partition-slot", 0, "Slot at which to split the nodes into two halves, 0 to never partition")
+	partitionSlots = flag.Uint64("partition-slots", 0, "Number of slots the network stays partitioned for")
+	dataDir        = flag.String("datadir", "", "Directory for the node databases, defaults to a temporary directory")
+	verbosity      = flag.String("verbosity", "info", "Logging verbosity (debug, info, warn, error)")
+
+	log = logrus.WithField("prefix", "simulator")
+)
+
+func main() {
+	flag.Parse()
+
+	level, err := logrus.ParseLevel(*verbosity)
+	if err != nil {
+		log.Fatalf("Could not parse verbosity: %v", err)
+	}
+	logrus.SetLevel(level)
+
+	sim, err := simulator.New(context.Background(), &simulator.Config{
+		NodeCount:      *nodes,
+		ValidatorCount: *validators,
+		DataDir:        *dataDir,
+	})
+	if err != nil {
+		log.Fatalf("Could not start simulation: %v", err)
+	}
+	defer sim.Stop()
+
+	for sim.Slot() < *slots {
+		if *partitionSlot != 0 {
+			switch sim.Slot() + 1 {
+			case *partitionSlot:
+				sim.Network().Partition(halves(*nodes)...)
+				log.WithField("slot", sim.Slot()+1).Info("Partitioning network")
+			case *partitionSlot + *partitionSlots:
+				sim.Network().Heal()
+				log.WithField("slot", sim.Slot()+1).Info("Healing network")
+			}
+		}
+		if _, err := sim.AdvanceSlot(); err != nil {
+			log.Errorf("Simulation failed: %v", err)
+			sim.Stop()
+			os.Exit(1)
+		}
+	}
+
+	for _, node := range sim.Nodes() {
+		head, root, err := node.Head()
+		if err != nil {
+			log.Fatalf("Could not retrieve head of node %d: %v", node.Index(), err)
+		}
+		log.WithFields(logrus.Fields{
+			"node": node.Index(),
+			"slot": head.Slot,
+			"root": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		}).Info("Node chain head")
+	}
+	agree, err := sim.HeadsAgree()
+	if err != nil {
+		log.Fatalf("Could not compare chain heads: %v", err)
+	}
+	if !agree {
+		log.Error("Nodes did not converge on a single chain head")
+		sim.Stop()
+		os.Exit(1)
+	}
+	log.Info("All nodes agree on the chain head")
+}
+
+// halves splits the node indices into two groups of roughly equal size.
+func halves(count int) [][]int {
+	var first, second []int
+	for i := 0; i < count; i++ {
+		if i < count/2 {
+			first = append(first, i)
+		} else {
+			second = append(second, i)
+		}
+	}
+	return [][]int{first, second}
+}
@@ -41,9 +41,15 @@ func VerifyAccountNotExists(directory string, password string) error {
 // generates a BLS private and public key, and then logs the serialized deposit input hex string
 // to be used in an ETH1.0 transaction by the validator.
 func NewValidatorAccount(directory string, password string) error {
+	return NewValidatorAccountWithKDF(directory, password, keystore.StandardKDF)
+}
+
+// NewValidatorAccountWithKDF sets up a validator account like NewValidatorAccount, encrypting
+// its keys with the key derivation function.
+func NewValidatorAccountWithKDF(directory string, password string, kdf keystore.KDFParams) error {
 	shardWithdrawalKeyFile := directory + params.BeaconConfig().WithdrawalPrivkeyFileName
 	validatorKeyFile := directory + params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(directory)
+	ks := keystore.NewKeystoreWithKDF(directory, kdf)
 	// If the keystore does not exists at the path, we create a new one for the validator.
 	shardWithdrawalKey, err := keystore.NewKey(rand.Reader)
 	if err != nil {
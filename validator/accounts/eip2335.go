@@ -0,0 +1,87 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ImportKeystores imports the EIP-2335 keystore at the import path, or every JSON
+// keystore of the directory at the import path, such as the keystores generated by the
+// eth2 deposit CLI. The keys are decrypted with the keystore password and stored as
+// validator keys of the account in the directory, encrypted with the account password
+// by the key derivation function.
+func ImportKeystores(directory string, password string, importPath string, keystorePassword string, kdf keystore.KDFParams) error {
+	files, err := keystoreFiles(importPath)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no keystore found at path %s", importPath)
+	}
+	ks := keystore.NewKeystoreWithKDF(directory, kdf)
+	for _, file := range files {
+		key, err := ks.GetKeyEIP2335(file, keystorePassword)
+		if err != nil {
+			return fmt.Errorf("could not decrypt keystore %s: %v", file, err)
+		}
+		validatorKeyFile := directory + params.BeaconConfig().ValidatorPrivkeyFileName + hex.EncodeToString(key.PublicKey.Marshal())[:12]
+		if err := ks.StoreKey(validatorKeyFile, key, password); err != nil {
+			return fmt.Errorf("unable to store key %v", err)
+		}
+		log.WithField("path", validatorKeyFile).Infof("Imported validator key %#x", key.PublicKey.Marshal())
+	}
+	return nil
+}
+
+// ExportKeystores exports the validator keys of the account in the directory, decrypted
+// with the account password, as EIP-2335 keystores in the export directory, so that they
+// can be used by the other eth2 clients. The keystores are encrypted with the keystore
+// password by the key derivation function, which must be scrypt.
+func ExportKeystores(directory string, password string, exportDir string, keystorePassword string, kdf keystore.KDFParams) error {
+	ks := keystore.NewKeystoreWithKDF(exportDir, kdf)
+	keys, err := ks.GetKeys(directory, params.BeaconConfig().ValidatorPrivkeyFileName, password)
+	if err != nil {
+		return fmt.Errorf("could not get validator keys: %v", err)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no validator key found at path %s", directory)
+	}
+	for pubKey, key := range keys {
+		file := ks.JoinPath(fmt.Sprintf("keystore-%s.json", pubKey))
+		if err := ks.StoreKeyEIP2335(file, key, keystorePassword); err != nil {
+			return fmt.Errorf("unable to export key %v", err)
+		}
+		log.WithField("path", file).Infof("Exported validator key %#x", key.PublicKey.Marshal())
+	}
+	return nil
+}
+
+// keystoreFiles returns the path if it is a file, or the JSON files of the directory at
+// the path.
+func keystoreFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range files {
+		if f.Mode().IsRegular() && strings.HasSuffix(f.Name(), ".json") {
+			paths = append(paths, filepath.Join(path, f.Name()))
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,75 @@
+package accounts
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestImportExportKeystores(t *testing.T) {
+	importDir := testutil.TempDir() + "/eip2335import"
+	directory := testutil.TempDir() + "/eip2335keystore"
+	exportDir := testutil.TempDir() + "/eip2335export"
+	for _, dir := range []string{importDir, directory, exportDir} {
+		defer os.RemoveAll(dir)
+	}
+
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeystoreWithKDF(importDir, keystore.FastKDF)
+	if err := ks.StoreKeyEIP2335(ks.JoinPath("keystore-0.json"), key, "keystore password"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ImportKeystores(directory, "password", importDir, "keystore password", keystore.FastKDF); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ks.GetKeys(directory, params.BeaconConfig().ValidatorPrivkeyFileName, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("Expected 1 imported key, received %d", len(keys))
+	}
+
+	if err := ExportKeystores(directory, "password", exportDir, "export password", keystore.FastKDF); err != nil {
+		t.Fatal(err)
+	}
+	files, err := keystoreFiles(exportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected 1 exported keystore, received %d", len(files))
+	}
+	exported, err := ks.GetKeyEIP2335(files[0], "export password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(exported.PublicKey.Marshal(), key.PublicKey.Marshal()) {
+		t.Error("Exported key is not the imported key")
+	}
+}
+
+func TestImportKeystores_WrongPassword(t *testing.T) {
+	importDir := testutil.TempDir() + "/eip2335wrongpassword"
+	defer os.RemoveAll(importDir)
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeystoreWithKDF(importDir, keystore.FastKDF)
+	if err := ks.StoreKeyEIP2335(ks.JoinPath("keystore-0.json"), key, "keystore password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ImportKeystores(testutil.TempDir()+"/eip2335unused", "password", importDir, "wrong", keystore.FastKDF); err == nil {
+		t.Error("Expected an error importing a keystore with a wrong password")
+	}
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// bearerToken attaches the auth token the beacon node requires from validator
+// clients to every RPC.
+type bearerToken string
+
+// GetRequestMetadata returns the authorization header of a request.
+func (t bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows the token to be used on insecure connections to
+// beacon nodes running on the same host.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// clientCredentials trusts the server certificate from the given file. If a client
+// certificate and key are given, they are presented to beacon nodes which require
+// mutual TLS.
+func clientCredentials(serverCertFile string, certFile string, keyFile string) (credentials.TransportCredentials, error) {
+	serverCert, err := ioutil.ReadFile(serverCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read server certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(serverCert) {
+		return nil, errors.New("could not parse server certificate")
+	}
+	cfg := &tls.Config{RootCAs: pool}
+	if certFile != "" && keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client TLS keys: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// ExitConfig for submitting the voluntary exit of a validator.
+type ExitConfig struct {
+	Endpoint       string
+	CertFlag       string
+	ClientCertFlag string
+	ClientKeyFlag  string
+	AuthToken      string
+	// Key is the keystore key of the exiting validator.
+	Key *keystore.Key
+}
+
+// Exit signs a voluntary exit of the validator for the current epoch, submits it to the
+// beacon node and waits until the exit is included in the canonical chain.
+func Exit(ctx context.Context, cfg *ExitConfig) error {
+	conn, err := dialBeaconNode(ctx, cfg.Endpoint, cfg.CertFlag, cfg.ClientCertFlag, cfg.ClientKeyFlag, cfg.AuthToken)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}()
+	beaconClient := pb.NewBeaconServiceClient(conn)
+	validatorClient := pb.NewValidatorServiceClient(conn)
+
+	exit, err := submitExit(ctx, beaconClient, validatorClient, cfg.Key)
+	if err != nil {
+		return err
+	}
+	pubKey := cfg.Key.PublicKey.Marshal()
+	log.WithFields(logrus.Fields{
+		"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
+		"validatorIndex": exit.ValidatorIndex,
+		"epoch":          exit.Epoch,
+	}).Info("Submitted voluntary exit, waiting for it to be included")
+
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	status, err := waitForExit(ctx, validatorClient, pubKey, interval)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
+		"status":    status.Status,
+		"exitEpoch": status.ExitEpoch,
+	}).Info("Voluntary exit included in the beacon chain")
+	return nil
+}
+
+// submitExit signs the voluntary exit of the validator for the epoch of the canonical
+// head and proposes it to the beacon node.
+func submitExit(
+	ctx context.Context,
+	beaconClient pb.BeaconServiceClient,
+	validatorClient pb.ValidatorServiceClient,
+	key *keystore.Key,
+) (*ethpb.VoluntaryExit, error) {
+	indexRes, err := validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{PublicKey: key.PublicKey.Marshal()})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch validator index: %v", err)
+	}
+	head, err := beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch canonical head: %v", err)
+	}
+	exit := &ethpb.VoluntaryExit{
+		Epoch:          head.Slot / params.BeaconConfig().SlotsPerEpoch,
+		ValidatorIndex: indexRes.Index,
+	}
+	domain, err := validatorClient.DomainData(ctx, &pb.DomainRequest{
+		Epoch:  exit.Epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch domain data: %v", err)
+	}
+	root, err := ssz.SigningRoot(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute signing root: %v", err)
+	}
+	exit.Signature = key.SecretKey.Sign(root[:], domain.SignatureDomain).Marshal()
+	if _, err := validatorClient.ProposeExit(ctx, exit); err != nil {
+		return nil, fmt.Errorf("could not propose exit: %v", err)
+	}
+	return exit, nil
+}
+
+// waitForExit polls the status of the validator at the given interval until its exit
+// was initiated by the inclusion of the voluntary exit in the canonical chain.
+func waitForExit(
+	ctx context.Context,
+	validatorClient pb.ValidatorServiceClient,
+	pubKey []byte,
+	interval time.Duration,
+) (*pb.ValidatorStatusResponse, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := validatorClient.ValidatorStatus(ctx, &pb.ValidatorIndexRequest{PublicKey: pubKey})
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch validator status: %v", err)
+		}
+		switch status.Status {
+		case pb.ValidatorStatus_INITIATED_EXIT,
+			pb.ValidatorStatus_WITHDRAWABLE,
+			pb.ValidatorStatus_EXITED,
+			pb.ValidatorStatus_EXITED_SLASHED:
+			return status, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
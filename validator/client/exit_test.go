@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestSubmitExit_SignsExitForCurrentEpoch(t *testing.T) {
+	_, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		&pb.ValidatorIndexRequest{PublicKey: validatorKey.PublicKey.Marshal()},
+	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil)
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Any(), // empty
+	).Return(&ethpb.BeaconBlock{Slot: 3*params.BeaconConfig().SlotsPerEpoch + 1}, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		&pb.DomainRequest{Epoch: 3, Domain: params.BeaconConfig().DomainVoluntaryExit},
+	).Return(&pb.DomainResponse{SignatureDomain: 7}, nil)
+	var proposed *ethpb.VoluntaryExit
+	m.validatorClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.VoluntaryExit{}),
+	).Return(&pb.ProposeExitResponse{}, nil).Do(func(_ context.Context, exit *ethpb.VoluntaryExit) {
+		proposed = exit
+	})
+
+	if _, err := submitExit(context.Background(), m.beaconClient, m.validatorClient, validatorKey); err != nil {
+		t.Fatal(err)
+	}
+	if proposed.Epoch != 3 || proposed.ValidatorIndex != 5 {
+		t.Errorf("Expected an exit of validator 5 at epoch 3, received %v", proposed)
+	}
+	root, err := ssz.SigningRoot(proposed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(proposed.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], validatorKey.PublicKey, 7) {
+		t.Error("Expected the exit to be signed by the validator key")
+	}
+}
+
+func TestWaitForExit_ReturnsOnceExitInitiated(t *testing.T) {
+	_, m, finish := setup(t)
+	defer finish()
+
+	gomock.InOrder(
+		m.validatorClient.EXPECT().ValidatorStatus(
+			gomock.Any(), // ctx
+			gomock.Any(), // request
+		).Return(&pb.ValidatorStatusResponse{Status: pb.ValidatorStatus_ACTIVE}, nil),
+		m.validatorClient.EXPECT().ValidatorStatus(
+			gomock.Any(), // ctx
+			gomock.Any(), // request
+		).Return(&pb.ValidatorStatusResponse{Status: pb.ValidatorStatus_INITIATED_EXIT, ExitEpoch: 10}, nil),
+	)
+
+	status, err := waitForExit(context.Background(), m.validatorClient, validatorKey.PublicKey.Marshal(), time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.ExitEpoch != 10 {
+		t.Errorf("Expected exit epoch 10, received %d", status.ExitEpoch)
+	}
+}
+
+func TestWaitForExit_ContextCanceled(t *testing.T) {
+	_, m, finish := setup(t)
+	defer finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.validatorClient.EXPECT().ValidatorStatus(
+		gomock.Any(), // ctx
+		gomock.Any(), // request
+	).Return(&pb.ValidatorStatusResponse{Status: pb.ValidatorStatus_ACTIVE}, nil).Do(func(_ context.Context, _ *pb.ValidatorIndexRequest) {
+		cancel()
+	})
+
+	if _, err := waitForExit(ctx, m.validatorClient, validatorKey.PublicKey.Marshal(), time.Hour); err != context.Canceled {
+		t.Errorf("Expected context canceled error, received %v", err)
+	}
+}
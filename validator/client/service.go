@@ -8,10 +8,10 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 )
 
 var log = logrus.WithField("prefix", "validator")
@@ -25,6 +25,15 @@ type ValidatorService struct {
 	conn                 *grpc.ClientConn
 	endpoint             string
 	withCert             string
+	withClientCert       string
+	withClientKey        string
+	authToken            string
+	remoteSigner         string
+	signerCert           string
+	signerClientCert     string
+	signerClientKey      string
+	signerConn           *grpc.ClientConn
+	db                   *db.ValidatorDB
 	key                  *keystore.Key
 	keys                 map[string]*keystore.Key
 	logValidatorBalances bool
@@ -34,15 +43,50 @@ type ValidatorService struct {
 type Config struct {
 	Endpoint             string
 	CertFlag             string
+	ClientCertFlag       string
+	ClientKeyFlag        string
+	AuthToken            string
 	KeystorePath         string
 	Password             string
 	LogValidatorBalances bool
+	// RemoteSigner is the endpoint of a remote signing service holding the validator
+	// keys, in which case no key is read from the keystore.
+	RemoteSigner           string
+	RemoteSignerCert       string
+	RemoteSignerClientCert string
+	RemoteSignerClientKey  string
+	ValidatorDB            *db.ValidatorDB
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	s := &ValidatorService{
+		ctx:                  ctx,
+		cancel:               cancel,
+		endpoint:             cfg.Endpoint,
+		withCert:             cfg.CertFlag,
+		withClientCert:       cfg.ClientCertFlag,
+		withClientKey:        cfg.ClientKeyFlag,
+		authToken:            cfg.AuthToken,
+		remoteSigner:         cfg.RemoteSigner,
+		signerCert:           cfg.RemoteSignerCert,
+		signerClientCert:     cfg.RemoteSignerClientCert,
+		signerClientKey:      cfg.RemoteSignerClientKey,
+		db:                   cfg.ValidatorDB,
+		logValidatorBalances: cfg.LogValidatorBalances,
+	}
+	if cfg.RemoteSigner != "" {
+		// The remote signer authenticates the client through its certificate, and must
+		// not be sent signing requests over an insecure connection.
+		if cfg.RemoteSignerCert == "" || cfg.RemoteSignerClientCert == "" || cfg.RemoteSignerClientKey == "" {
+			cancel()
+			return nil, errors.New("a remote signer requires a server certificate and a client certificate and key")
+		}
+		return s, nil
+	}
+
 	validatorFolder := cfg.KeystorePath
 	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
 	ks := keystore.NewKeystore(cfg.KeystorePath)
@@ -51,47 +95,34 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cancel()
 		return nil, fmt.Errorf("could not get private key: %v", err)
 	}
-	var key *keystore.Key
 	for _, v := range keys {
-		key = v
+		s.key = v
 		break
 	}
-	return &ValidatorService{
-		ctx:                  ctx,
-		cancel:               cancel,
-		endpoint:             cfg.Endpoint,
-		withCert:             cfg.CertFlag,
-		keys:                 keys,
-		key:                  key,
-		logValidatorBalances: cfg.LogValidatorBalances,
-	}, nil
+	s.keys = keys
+	return s, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	pubkeys := make([][]byte, 0)
-	for i := range v.keys {
-		log.WithField("publicKey", fmt.Sprintf("%#x", v.keys[i].PublicKey.Marshal())).Info("Initializing new validator service")
-		pubkey := v.keys[i].PublicKey.Marshal()
-		pubkeys = append(pubkeys, pubkey)
+	signer, err := v.signer()
+	if err != nil {
+		log.Errorf("Could not connect to remote signer: %v", err)
+		return
 	}
-
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
-		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
-			return
-		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	pubkeys, err := signer.PublicKeys(v.ctx)
+	if err != nil {
+		log.Errorf("Could not get validator public keys: %v", err)
+		return
+	}
+	for _, pubkey := range pubkeys {
+		log.WithField("publicKey", fmt.Sprintf("%#x", pubkey)).Info("Initializing new validator service")
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+
+	conn, err := dialBeaconNode(v.ctx, v.endpoint, v.withCert, v.withClientCert, v.withClientKey, v.authToken)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Error(err)
 		return
 	}
 	log.Info("Successfully started gRPC connection")
@@ -101,7 +132,8 @@ func (v *ValidatorService) Start() {
 		validatorClient:      pb.NewValidatorServiceClient(v.conn),
 		attesterClient:       pb.NewAttesterServiceClient(v.conn),
 		proposerClient:       pb.NewProposerServiceClient(v.conn),
-		keys:                 v.keys,
+		db:                   v.db,
+		signer:               signer,
 		pubkeys:              pubkeys,
 		logValidatorBalances: v.logValidatorBalances,
 		prevBalance:          make(map[[48]byte]uint64),
@@ -109,10 +141,61 @@ func (v *ValidatorService) Start() {
 	go run(v.ctx, v.validator)
 }
 
+// dialBeaconNode connects to the RPC endpoint of a beacon node, over TLS if a server
+// certificate is given, attaching the auth token to every request if one is given.
+func dialBeaconNode(ctx context.Context, endpoint string, cert string, clientCert string, clientKey string, authToken string) (*grpc.ClientConn, error) {
+	dialOpts := []grpc.DialOption{grpc.WithStatsHandler(&ocgrpc.ClientHandler{})}
+	if cert != "" {
+		creds, err := clientCredentials(cert, clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(creds))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	if authToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(bearerToken(authToken)))
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial endpoint: %s, %v", endpoint, err)
+	}
+	return conn, nil
+}
+
+// signer returns the signer of the validator keys, connecting to the remote signer if
+// the service uses one.
+func (v *ValidatorService) signer() (Signer, error) {
+	if v.remoteSigner == "" {
+		return &localSigner{keys: v.keys}, nil
+	}
+	creds, err := clientCredentials(v.signerCert, v.signerClientCert, v.signerClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not get valid credentials: %v", err)
+	}
+	conn, err := grpc.DialContext(v.ctx, v.remoteSigner,
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial endpoint: %s, %v", v.remoteSigner, err)
+	}
+	log.WithField("endpoint", v.remoteSigner).Info("Using remote signer")
+	v.signerConn = conn
+	return &remoteSigner{client: pb.NewRemoteSignerClient(conn)}, nil
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.signerConn != nil {
+		if err := v.signerConn.Close(); err != nil {
+			log.WithError(err).Error("Could not close remote signer connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -104,3 +104,13 @@ func TestStatus_NoConnectionError(t *testing.T) {
 		t.Errorf("Expected status check to fail if no connection is found, received: %v", err)
 	}
 }
+
+func TestBearerToken_RequestMetadata(t *testing.T) {
+	md, err := bearerToken("secret").GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if md["authorization"] != "Bearer secret" {
+		t.Errorf("Expected bearer authorization header, received %v", md)
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// Signer signs the messages of the validator keys, either with keys held locally or
+// through a remote signing service.
+type Signer interface {
+	// PublicKeys returns the public keys of the validator keys of the signer.
+	PublicKeys(ctx context.Context) ([][]byte, error)
+	// Sign signs the signing root of the request with the validator key of its public
+	// key, returning the marshaled signature.
+	Sign(ctx context.Context, req *pb.SignRequest) ([]byte, error)
+}
+
+// localSigner signs with the keys decrypted from the keystore, keyed by the hex encoding
+// of their public key.
+type localSigner struct {
+	keys map[string]*keystore.Key
+}
+
+func (s *localSigner) PublicKeys(_ context.Context) ([][]byte, error) {
+	pubKeys := make([][]byte, 0, len(s.keys))
+	for _, key := range s.keys {
+		pubKeys = append(pubKeys, key.PublicKey.Marshal())
+	}
+	return pubKeys, nil
+}
+
+func (s *localSigner) Sign(_ context.Context, req *pb.SignRequest) ([]byte, error) {
+	key, ok := s.keys[hex.EncodeToString(req.PublicKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key for public key %#x", req.PublicKey)
+	}
+	return key.SecretKey.Sign(req.SigningRoot, req.SignatureDomain).Marshal(), nil
+}
+
+// remoteSigner sends the signing requests to a remote signing service, so that the
+// validator client never holds the private keys.
+type remoteSigner struct {
+	client pb.RemoteSignerClient
+}
+
+func (s *remoteSigner) PublicKeys(ctx context.Context) ([][]byte, error) {
+	res, err := s.client.ListPublicKeys(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list public keys of remote signer: %v", err)
+	}
+	return res.PublicKeys, nil
+}
+
+func (s *remoteSigner) Sign(ctx context.Context, req *pb.SignRequest) ([]byte, error) {
+	res, err := s.client.Sign(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer could not sign: %v", err)
+	}
+	// A malformed signature would otherwise only be rejected by the beacon node.
+	if len(res.Signature) == 0 {
+		return nil, errors.New("empty signature from remote signer")
+	}
+	if _, err := bls.SignatureFromBytes(res.Signature); err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %v", err)
+	}
+	return res.Signature, nil
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeRemoteSigner signs the requests sent to it with the keys of a local signer.
+type fakeRemoteSigner struct {
+	signer    *localSigner
+	signature []byte
+	requests  []*pb.SignRequest
+}
+
+func (f *fakeRemoteSigner) ListPublicKeys(ctx context.Context, _ *ptypes.Empty, _ ...grpc.CallOption) (*pb.ListPublicKeysResponse, error) {
+	pubKeys, err := f.signer.PublicKeys(ctx)
+	return &pb.ListPublicKeysResponse{PublicKeys: pubKeys}, err
+}
+
+func (f *fakeRemoteSigner) Sign(ctx context.Context, req *pb.SignRequest, _ ...grpc.CallOption) (*pb.SignResponse, error) {
+	f.requests = append(f.requests, req)
+	if f.signature != nil {
+		return &pb.SignResponse{Signature: f.signature}, nil
+	}
+	sig, err := f.signer.Sign(ctx, req)
+	return &pb.SignResponse{Signature: sig}, err
+}
+
+func TestLocalSigner_Sign(t *testing.T) {
+	signer := &localSigner{keys: keyMap}
+	req := &pb.SignRequest{
+		PublicKey:       validatorKey.PublicKey.Marshal(),
+		SigningRoot:     []byte("root"),
+		SignatureDomain: 1,
+	}
+	sig, err := signer.Sign(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := validatorKey.SecretKey.Sign([]byte("root"), 1).Marshal(); !bytes.Equal(sig, want) {
+		t.Errorf("Expected signature %#x, received %#x", want, sig)
+	}
+
+	req.PublicKey = []byte("unknown")
+	if _, err := signer.Sign(context.Background(), req); err == nil {
+		t.Error("Expected an error signing with an unknown key")
+	}
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	remote := &fakeRemoteSigner{signer: &localSigner{keys: keyMap}}
+	signer := &remoteSigner{client: remote}
+
+	pubKeys, err := signer.PublicKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubKeys) != 1 || !bytes.Equal(pubKeys[0], validatorKey.PublicKey.Marshal()) {
+		t.Errorf("Unexpected public keys %#x", pubKeys)
+	}
+
+	req := &pb.SignRequest{
+		PublicKey:   validatorKey.PublicKey.Marshal(),
+		SigningRoot: []byte("root"),
+		Object:      &pb.SignRequest_Epoch{Epoch: 3},
+	}
+	sig, err := signer.Sign(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := validatorKey.SecretKey.Sign([]byte("root"), 0).Marshal(); !bytes.Equal(sig, want) {
+		t.Errorf("Expected signature %#x, received %#x", want, sig)
+	}
+	if len(remote.requests) != 1 || remote.requests[0].GetEpoch() != 3 {
+		t.Errorf("Expected the signed object to be sent to the remote signer, received %v", remote.requests)
+	}
+
+	remote.signature = []byte{}
+	if _, err := signer.Sign(context.Background(), req); err == nil {
+		t.Error("Expected an error for an empty signature")
+	}
+}
+
+func TestNewValidatorService_RemoteSignerRequiresTLS(t *testing.T) {
+	if _, err := NewValidatorService(context.Background(), &Config{
+		RemoteSigner:     "localhost:4001",
+		RemoteSignerCert: "signer.crt",
+	}); err == nil {
+		t.Error("Expected an error for a remote signer without a client certificate")
+	}
+}
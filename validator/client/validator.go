@@ -11,9 +11,9 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -26,7 +26,8 @@ type validator struct {
 	validatorClient      pb.ValidatorServiceClient
 	beaconClient         pb.BeaconServiceClient
 	attesterClient       pb.AttesterServiceClient
-	keys                 map[string]*keystore.Key
+	db                   *db.ValidatorDB
+	signer               Signer
 	pubkeys              [][]byte
 	prevBalance          map[[48]byte]uint64
 	logValidatorBalances bool
@@ -82,7 +83,11 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	req := &pb.ValidatorActivationRequest{
 		PublicKeys: v.pubkeys,
 	}
-	stream, err := v.validatorClient.WaitForActivation(ctx, req)
+	// The beacon node keeps streaming the statuses of the remaining keys once the first key
+	// is activated, so the stream is canceled on return.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := v.validatorClient.WaitForActivation(streamCtx, req)
 	if err != nil {
 		return fmt.Errorf("could not setup validator WaitForActivation streaming client: %v", err)
 	}
@@ -182,8 +187,7 @@ func (v *validator) NextSlot() <-chan uint64 {
 
 // SlotDeadline is the start time of the next slot.
 func (v *validator) SlotDeadline(slot uint64) time.Time {
-	secs := (slot + 1) * params.BeaconConfig().SecondsPerSlot
-	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(time.Duration(secs) * time.Second)
+	return slotutil.SlotStartTime(v.genesisTime, slot+1)
 }
 
 // UpdateAssignments checks the slot number to determine if the validator's
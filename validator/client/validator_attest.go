@@ -13,14 +13,15 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
-var delay = params.BeaconConfig().SecondsPerSlot / 2
-
 // AttestToBlockHead completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
@@ -29,7 +30,12 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 	ctx, span := trace.StartSpan(ctx, "validator.AttestToBlockHead")
 	defer span.End()
 
-	tpk := hex.EncodeToString(v.keys[pk].PublicKey.Marshal())[:12]
+	pubKey, err := hex.DecodeString(pk)
+	if err != nil {
+		log.WithError(err).Error("Failed to decode public key")
+		return
+	}
+	tpk := pk[:12]
 
 	span.AddAttributes(
 		trace.StringAttribute("validator", tpk),
@@ -39,7 +45,6 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
-	pubKey := v.keys[pk].PublicKey.Marshal()
 	var assignment *pb.AssignmentResponse_ValidatorAssignment
 	if v.assignments == nil {
 		log.Errorf("No assignments for validators")
@@ -106,7 +111,28 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 		}).Error("Failed to sign attestation data and custody bit")
 		return
 	}
-	sig := v.keys[pk].SecretKey.Sign(root[:], domain.SignatureDomain).Marshal()
+	// The epochs of the attestation are recorded before it is signed, so that no
+	// slashable attestation is ever signed even if the client crashes before broadcasting it.
+	if err := v.db.SaveAttestation(pubKey, data.Source.Epoch, data.Target.Epoch, root); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey":      tpk,
+			"sourceEpoch": data.Source.Epoch,
+			"targetEpoch": data.Target.Epoch,
+		}).Error("Refusing to sign slashable attestation")
+		return
+	}
+	sig, err := v.signer.Sign(ctx, &pb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &pb.SignRequest_AttestationData{AttestationData: data},
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey": tpk,
+		}).Error("Failed to sign attestation")
+		return
+	}
 
 	attestation := &ethpb.Attestation{
 		Data:            data,
@@ -117,7 +143,10 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 
 	attResp, err := v.attesterClient.SubmitAttestation(ctx, attestation)
 	if err != nil {
-		log.Errorf("Could not submit attestation to beacon node: %v", err)
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey": tpk,
+			"reason": rpcerror.ReasonOf(err),
+		}).Error("Could not submit attestation to beacon node")
 		return
 	}
 
@@ -147,8 +176,7 @@ func (v *validator) waitToSlotMidpoint(ctx context.Context, slot uint64) {
 	_, span := trace.StartSpan(ctx, "validator.waitToSlotMidpoint")
 	defer span.End()
 
-	duration := time.Duration(slot*params.BeaconConfig().SecondsPerSlot+delay) * time.Second
-	timeToBroadcast := time.Unix(int64(v.genesisTime), 0).Add(duration)
+	timeToBroadcast := slotutil.SlotStartTime(v.genesisTime, slot).Add(slotutil.DivideSlotBy(2))
 
-	time.Sleep(time.Until(timeToBroadcast))
+	time.Sleep(clockutil.Until(timeToBroadcast))
 }
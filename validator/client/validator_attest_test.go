@@ -163,8 +163,7 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	k := hex.EncodeToString(validatorKey.PublicKey.Marshal())
-	sig := validator.keys[k].SecretKey.Sign(root[:], 0).Marshal()
+	sig := validatorKey.SecretKey.Sign(root[:], 0).Marshal()
 	expectedAttestation.Signature = sig
 
 	if !proto.Equal(generatedAttestation, expectedAttestation) {
@@ -303,3 +302,42 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 		t.Errorf("Wanted length %d, received %d", 2, len(generatedAttestation.AggregationBits))
 	}
 }
+
+func TestAttestToBlockHead_RefusesSurroundVote(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.assignments = &pb.AssignmentResponse{ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: make([]uint64, 111),
+		}}}
+	// An attestation surrounded by the requested one was already signed.
+	if err := validator.db.SaveAttestation(validatorKey.PublicKey.Marshal(), 3, 4, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{
+		Index: 0,
+	}, nil)
+	m.attesterClient.EXPECT().RequestAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationRequest{}),
+	).Return(&ethpb.AttestationData{
+		BeaconBlockRoot: []byte{},
+		Target:          &ethpb.Checkpoint{Epoch: 5},
+		Source:          &ethpb.Checkpoint{Epoch: 2},
+		Crosslink:       &ethpb.Crosslink{},
+	}, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/)
+
+	validator.AttestToBlockHead(context.Background(), 30, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Refusing to sign slashable attestation")
+}
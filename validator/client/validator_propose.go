@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rpcerror"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -28,7 +29,12 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 	defer span.End()
 
 	epoch := slot / params.BeaconConfig().SlotsPerEpoch
-	tpk := hex.EncodeToString(v.keys[pk].PublicKey.Marshal())[:12]
+	pubKey, err := hex.DecodeString(pk)
+	if err != nil {
+		log.WithError(err).Error("Failed to decode public key")
+		return
+	}
+	tpk := pk[:12]
 
 	domain, err := v.validatorClient.DomainData(ctx, &pb.DomainRequest{Epoch: epoch, Domain: params.BeaconConfig().DomainRandao})
 	if err != nil {
@@ -37,11 +43,22 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 	}
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf, epoch)
-	randaoReveal := v.keys[pk].SecretKey.Sign(buf, domain.SignatureDomain)
+	randaoReveal, err := v.signer.Sign(ctx, &pb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     buf,
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &pb.SignRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey": tpk,
+		}).Error("Failed to sign randao reveal")
+		return
+	}
 
 	b, err := v.proposerClient.RequestBlock(ctx, &pb.BlockRequest{
 		Slot:         slot,
-		RandaoReveal: randaoReveal.Marshal(),
+		RandaoReveal: randaoReveal,
 	})
 	if err != nil {
 		log.WithError(err).Error("Failed to request block from beacon node")
@@ -61,14 +78,34 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pk string) {
 		}).Error("Failed to sign block")
 		return
 	}
-	signature := v.keys[pk].SecretKey.Sign(root[:], domain.SignatureDomain)
-	b.Signature = signature.Marshal()
+	// The slot of the block is recorded before it is signed, so that no other block is
+	// ever signed at that slot even if the client crashes before broadcasting it.
+	if err := v.db.SaveProposal(pubKey, b.Slot, root); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey": tpk,
+			"slot":   b.Slot,
+		}).Error("Refusing to sign slashable block")
+		return
+	}
+	b.Signature, err = v.signer.Sign(ctx, &pb.SignRequest{
+		PublicKey:       pubKey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &pb.SignRequest_Block{Block: b},
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"pubKey": tpk,
+		}).Error("Failed to sign block")
+		return
+	}
 
 	// Broadcast network the signed block via beacon chain node.
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, b)
 	if err != nil {
 		log.WithError(err).WithFields(logrus.Fields{
 			"pubKey": tpk,
+			"reason": rpcerror.ReasonOf(err),
 		}).Error("Failed to propose block")
 		return
 	}
@@ -29,15 +29,20 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		validatorClient: internal.NewMockValidatorServiceClient(ctrl),
 		attesterClient:  internal.NewMockAttesterServiceClient(ctrl),
 	}
+	db := internal.SetupDB(t)
 	validator := &validator{
 		proposerClient:  m.proposerClient,
 		beaconClient:    m.beaconClient,
 		attesterClient:  m.attesterClient,
 		validatorClient: m.validatorClient,
-		keys:            keyMap,
+		db:              db,
+		signer:          &localSigner{keys: keyMap},
 	}
 
-	return validator, m, ctrl.Finish
+	return validator, m, func() {
+		ctrl.Finish()
+		internal.TeardownDB(t, db)
+	}
 }
 
 func TestProposeBlock_DoesNotProposeGenesisBlock(t *testing.T) {
@@ -137,3 +142,27 @@ func TestProposeBlock_BroadcastsBlock(t *testing.T) {
 
 	validator.ProposeBlock(context.Background(), 1, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
 }
+
+func TestProposeBlock_RefusesDoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, finish := setup(t)
+	defer finish()
+
+	// Another block was already signed at the slot.
+	if err := validator.db.SaveProposal(validatorKey.PublicKey.Marshal(), 1, [32]byte{'A'}); err != nil {
+		t.Fatal(err)
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&pb.DomainResponse{}, nil /*err*/).Times(2)
+
+	m.proposerClient.EXPECT().RequestBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(&ethpb.BeaconBlock{Slot: 1, Body: &ethpb.BeaconBlockBody{}}, nil /*err*/)
+
+	validator.ProposeBlock(context.Background(), 1, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
+	testutil.AssertLogsContain(t, hook, "Refusing to sign slashable block")
+}
@@ -57,7 +57,7 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -90,7 +90,7 @@ func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -121,7 +121,7 @@ func TestWaitForChainStart_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -142,7 +142,7 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -167,22 +167,22 @@ func TestWaitActivation_ContextCanceled(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
 		&pb.ValidatorActivationResponse{
-			ActivatedPublicKeys: publicKeys(v.keys),
+			ActivatedPublicKeys: publicKeys(keyMap),
 		},
 		nil,
 	)
@@ -201,16 +201,16 @@ func TestWaitActivation_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, errors.New("failed stream"))
 	err := v.WaitForActivation(context.Background())
@@ -226,16 +226,16 @@ func TestWaitActivation_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -256,20 +256,23 @@ func TestWaitActivation_LogsActivationEpochOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMap)
 	resp := generateMockStatusResponse(v.pubkeys)
 	resp.Statuses[0].Status.Status = pb.ValidatorStatus_ACTIVE
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
+	var streamCtx context.Context
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			PublicKeys: publicKeys(v.keys),
+			PublicKeys: publicKeys(keyMap),
 		},
-	).Return(clientStream, nil)
+	).Return(clientStream, nil).Do(func(ctx context.Context, _ *pb.ValidatorActivationRequest) {
+		streamCtx = ctx
+	})
 	clientStream.EXPECT().Recv().Return(
 		resp,
 		nil,
@@ -278,6 +281,9 @@ func TestWaitActivation_LogsActivationEpochOK(t *testing.T) {
 		t.Errorf("Could not wait for activation: %v", err)
 	}
 	testutil.AssertLogsContain(t, hook, "Validator activated")
+	if streamCtx.Err() != context.Canceled {
+		t.Error("Expected activation stream to be canceled once a validator is activated")
+	}
 }
 
 func TestCanonicalHeadSlot_FailedRPC(t *testing.T) {
@@ -285,7 +291,7 @@ func TestCanonicalHeadSlot_FailedRPC(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -302,7 +308,7 @@ func TestCanonicalHeadSlot_OK(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		keys:         keyMap,
+		signer:       &localSigner{keys: keyMap},
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -324,11 +330,11 @@ func TestWaitMultipleActivation_LogsActivationEpochOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMapThreeValidators,
+		signer:          &localSigner{keys: keyMapThreeValidators},
 		pubkeys:         make([][]byte, 0),
 		validatorClient: client,
 	}
-	v.pubkeys = publicKeys(v.keys)
+	v.pubkeys = publicKeys(keyMapThreeValidators)
 	resp := generateMockStatusResponse(v.pubkeys)
 	resp.Statuses[0].Status.Status = pb.ValidatorStatus_ACTIVE
 	resp.Statuses[1].Status.Status = pb.ValidatorStatus_ACTIVE
@@ -354,7 +360,7 @@ func TestWaitActivation_NotAllValidatorsActivatedOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMapThreeValidators,
+		signer:          &localSigner{keys: keyMapThreeValidators},
 		validatorClient: client,
 		pubkeys:         publicKeys(keyMapThreeValidators),
 	}
@@ -387,7 +393,7 @@ func TestUpdateAssignments_DoesNothingWhenNotEpochStartAndAlreadyExistingAssignm
 
 	slot := uint64(1)
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		validatorClient: client,
 		assignments: &pb.AssignmentResponse{
 			ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
@@ -415,7 +421,7 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		validatorClient: client,
 		assignments: &pb.AssignmentResponse{
 			ValidatorAssignment: []*pb.AssignmentResponse_ValidatorAssignment{
@@ -459,7 +465,7 @@ func TestUpdateAssignments_OK(t *testing.T) {
 		},
 	}
 	v := validator{
-		keys:            keyMap,
+		signer:          &localSigner{keys: keyMap},
 		validatorClient: client,
 	}
 	client.EXPECT().CommitteeAssignment(
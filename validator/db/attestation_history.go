@@ -0,0 +1,53 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/boltdb/bolt"
+)
+
+var (
+	// ErrDoubleVote is returned when a validator key already signed a different
+	// attestation with the same target epoch.
+	ErrDoubleVote = errors.New("double vote")
+	// ErrSurroundVote is returned when an attestation surrounds, or is surrounded by, an
+	// attestation already signed by the validator key.
+	ErrSurroundVote = errors.New("surround vote")
+)
+
+// SaveAttestation records that the validator key signs the attestation with the source
+// and target epochs and the signing root. It returns ErrDoubleVote or ErrSurroundVote
+// without recording anything if the attestation is slashable together with one the key
+// already signed, as defined by is_slashable_attestation_data in the spec, in which case
+// the attestation must not be signed. Signing the same attestation again is allowed.
+func (db *ValidatorDB) SaveAttestation(pubKey []byte, sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(attestationHistoryBucket)
+		key := encodeHistoryKey(pubKey, targetEpoch)
+		if prev := bucket.Get(key); prev != nil {
+			if bytes.Equal(prev[8:], signingRoot[:]) {
+				return nil
+			}
+			return ErrDoubleVote
+		}
+
+		c := bucket.Cursor()
+		for k, v := c.Seek(pubKey); k != nil && bytes.HasPrefix(k, pubKey); k, v = c.Next() {
+			prevTarget := binary.BigEndian.Uint64(k[len(pubKey):])
+			prevSource := binary.BigEndian.Uint64(v[:8])
+			if sourceEpoch < prevSource && prevTarget < targetEpoch {
+				return ErrSurroundVote
+			}
+			if prevSource < sourceEpoch && targetEpoch < prevTarget {
+				return ErrSurroundVote
+			}
+		}
+
+		enc := make([]byte, 8+len(signingRoot))
+		binary.BigEndian.PutUint64(enc, sourceEpoch)
+		copy(enc[8:], signingRoot[:])
+		return bucket.Put(key, enc)
+	})
+}
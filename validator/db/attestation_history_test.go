@@ -0,0 +1,44 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestSaveAttestation_RefusesSlashableAttestations(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{'A'}
+
+	if err := db.SaveAttestation(pubKey, 2, 4, [32]byte{'a'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestation(pubKey, 4, 5, [32]byte{'b'}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		sourceEpoch uint64
+		targetEpoch uint64
+		root        [32]byte
+		err         error
+	}{
+		{name: "same attestation", sourceEpoch: 2, targetEpoch: 4, root: [32]byte{'a'}},
+		{name: "double vote", sourceEpoch: 2, targetEpoch: 4, root: [32]byte{'c'}, err: ErrDoubleVote},
+		{name: "double vote with another source", sourceEpoch: 3, targetEpoch: 4, root: [32]byte{'c'}, err: ErrDoubleVote},
+		{name: "surrounding", sourceEpoch: 1, targetEpoch: 6, root: [32]byte{'c'}, err: ErrSurroundVote},
+		{name: "surrounded", sourceEpoch: 3, targetEpoch: 3, root: [32]byte{'c'}, err: ErrSurroundVote},
+		{name: "later attestation", sourceEpoch: 5, targetEpoch: 6, root: [32]byte{'c'}},
+		{name: "same source", sourceEpoch: 2, targetEpoch: 3, root: [32]byte{'d'}},
+	}
+	for _, tt := range tests {
+		if err := db.SaveAttestation(pubKey, tt.sourceEpoch, tt.targetEpoch, tt.root); err != tt.err {
+			t.Errorf("%s: expected error %v, received %v", tt.name, tt.err, err)
+		}
+	}
+
+	// The history of each key is separate.
+	if err := db.SaveAttestation([]byte{'B'}, 1, 6, [32]byte{'c'}); err != nil {
+		t.Errorf("Expected an attestation of another key to be signed, received %v", err)
+	}
+}
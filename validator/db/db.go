@@ -0,0 +1,64 @@
+// Package db defines the slashing protection database of the validator client, which
+// records the blocks and attestations signed by each validator key so that the client
+// never signs a message that would get the validator slashed, even across restarts.
+package db
+
+import (
+	"errors"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// ValidatorDB persists the signing history of the validator keys of the client.
+type ValidatorDB struct {
+	db           *bolt.DB
+	DatabasePath string
+}
+
+// Close closes the underlying boltdb database.
+func (db *ValidatorDB) Close() error {
+	return db.db.Close()
+}
+
+func (db *ValidatorDB) update(fn func(*bolt.Tx) error) error {
+	return db.db.Update(fn)
+}
+
+func (db *ValidatorDB) view(fn func(*bolt.Tx) error) error {
+	return db.db.View(fn)
+}
+
+func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+	for _, bucket := range buckets {
+		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewDB opens the validator database in the directory, creating it if needed.
+func NewDB(dirPath string) (*ValidatorDB, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, "validator.db")
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+
+	db := &ValidatorDB{db: boltDB, DatabasePath: dirPath}
+	if err := db.update(func(tx *bolt.Tx) error {
+		return createBuckets(tx, proposalHistoryBucket, attestationHistoryBucket)
+	}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
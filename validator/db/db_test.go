@@ -0,0 +1,59 @@
+package db
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// setupDB instantiates and returns a ValidatorDB instance.
+func setupDB(t testing.TB) *ValidatorDB {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		t.Fatalf("Could not generate random file path: %v", err)
+	}
+	path := path.Join(testutil.TempDir(), fmt.Sprintf("/%d", randPath))
+	if err := os.RemoveAll(path); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+	db, err := NewDB(path)
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	return db
+}
+
+// teardownDB cleans up a test ValidatorDB instance.
+func teardownDB(t testing.TB, db *ValidatorDB) {
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	if err := os.RemoveAll(db.DatabasePath); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+}
+
+func TestNewDB_KeepsHistoryAcrossRestarts(t *testing.T) {
+	db := setupDB(t)
+	pubKey := []byte{'A'}
+	if err := db.SaveProposal(pubKey, 5, [32]byte{'a'}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	if err := db.SaveProposal(pubKey, 5, [32]byte{'b'}); err != ErrDoubleProposal {
+		t.Errorf("Expected a double proposal after reopening the database, received %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/boltdb/bolt"
+)
+
+// ErrDoubleProposal is returned when a validator key already signed a different block at
+// the same slot.
+var ErrDoubleProposal = errors.New("double proposal")
+
+// SaveProposal records that the validator key signs the block with the signing root at
+// the slot. It returns ErrDoubleProposal without recording anything if the key already
+// signed a different block at the slot, in which case the block must not be signed.
+// Signing the same block again is allowed.
+func (db *ValidatorDB) SaveProposal(pubKey []byte, slot uint64, signingRoot [32]byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(proposalHistoryBucket)
+		key := encodeHistoryKey(pubKey, slot)
+		if prev := bucket.Get(key); prev != nil {
+			if bytes.Equal(prev, signingRoot[:]) {
+				return nil
+			}
+			return ErrDoubleProposal
+		}
+		return bucket.Put(key, signingRoot[:])
+	})
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestSaveProposal_RefusesDoubleProposal(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte{'A'}
+
+	if err := db.SaveProposal(pubKey, 5, [32]byte{'a'}); err != nil {
+		t.Fatal(err)
+	}
+	// Signing the same block again is not slashable.
+	if err := db.SaveProposal(pubKey, 5, [32]byte{'a'}); err != nil {
+		t.Errorf("Expected the same block to be signed again, received %v", err)
+	}
+	if err := db.SaveProposal(pubKey, 5, [32]byte{'b'}); err != ErrDoubleProposal {
+		t.Errorf("Expected a double proposal, received %v", err)
+	}
+	if err := db.SaveProposal(pubKey, 6, [32]byte{'b'}); err != nil {
+		t.Errorf("Expected a block at another slot to be signed, received %v", err)
+	}
+	// The history of each key is separate.
+	if err := db.SaveProposal([]byte{'B'}, 5, [32]byte{'b'}); err != nil {
+		t.Errorf("Expected a block of another key to be signed, received %v", err)
+	}
+}
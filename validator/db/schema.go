@@ -0,0 +1,24 @@
+package db
+
+import (
+	"encoding/binary"
+)
+
+// The history of each validator key is keyed by its public key, followed by the
+// big-endian slot or epoch of the message so that the history of a key is iterated in
+// order.
+var (
+	// Signing roots of the signed blocks, keyed by public key and slot.
+	proposalHistoryBucket = []byte("proposal-history")
+	// Source epochs and signing roots of the signed attestations, keyed by public key
+	// and target epoch.
+	attestationHistoryBucket = []byte("attestation-history")
+)
+
+// encodeHistoryKey keys a message of the public key by its big-endian slot or epoch.
+func encodeHistoryKey(pubKey []byte, v uint64) []byte {
+	key := make([]byte, len(pubKey)+8)
+	copy(key, pubKey)
+	binary.BigEndian.PutUint64(key[len(pubKey):], v)
+	return key
+}
@@ -27,6 +27,44 @@ var (
 		Name:  "tls-cert",
 		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
 	}
+	// ClientCertFlag defines a flag for the certificate presented to beacon nodes which
+	// require mutual TLS.
+	ClientCertFlag = cli.StringFlag{
+		Name:  "tls-client-cert",
+		Usage: "Client certificate presented to the beacon node. Pass this and the tls-client-key flag if the beacon node requires mutual TLS.",
+	}
+	// ClientKeyFlag defines a flag for the key of the client certificate.
+	ClientKeyFlag = cli.StringFlag{
+		Name:  "tls-client-key",
+		Usage: "Key of the client certificate presented to the beacon node",
+	}
+	// RPCAuthTokenFlag defines a flag for the bearer token presented to the beacon node.
+	RPCAuthTokenFlag = cli.StringFlag{
+		Name:  "rpc-auth-token",
+		Usage: "Bearer token presented to a beacon node which requires authentication of validator clients",
+	}
+	// RemoteSignerFlag defines the endpoint of a remote signing service holding the validator keys.
+	RemoteSignerFlag = cli.StringFlag{
+		Name: "remote-signer",
+		Usage: "gRPC endpoint of a remote signing service holding the validator keys, such as an HSM-backed " +
+			"or air-gapped signer. No key is read from the keystore. Requires the remote-signer-tls-cert, " +
+			"remote-signer-tls-client-cert and remote-signer-tls-client-key flags.",
+	}
+	// RemoteSignerCertFlag defines the certificate of the remote signer.
+	RemoteSignerCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-cert",
+		Usage: "Certificate of the remote signer",
+	}
+	// RemoteSignerClientCertFlag defines the certificate presented to the remote signer.
+	RemoteSignerClientCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-cert",
+		Usage: "Client certificate authenticating the validator client to the remote signer",
+	}
+	// RemoteSignerClientKeyFlag defines the key of the certificate presented to the remote signer.
+	RemoteSignerClientKeyFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-client-key",
+		Usage: "Key of the client certificate presented to the remote signer",
+	}
 	// KeystorePathFlag defines the location of the keystore directory for a validator's account.
 	KeystorePathFlag = cmd.DirectoryFlag{
 		Name:  "keystore-path",
@@ -38,6 +76,38 @@ var (
 		Name:  "password",
 		Usage: "string value of the password for your validator private keys",
 	}
+	// KeystoreKDFFlag defines the key derivation function encrypting the keys of a new validator account.
+	KeystoreKDFFlag = cli.StringFlag{
+		Name: "keystore-kdf",
+		Usage: "The key derivation function encrypting the private keys of a new account, trading off the time " +
+			"to decrypt the keys at startup against security (fast, standard, secure, argon2id)",
+		Value: "standard",
+	}
+	// ImportPathFlag defines the EIP-2335 keystore file, or directory of keystore files, to import.
+	ImportPathFlag = cli.StringFlag{
+		Name:  "import-path",
+		Usage: "Path to an EIP-2335 keystore file, or a directory of keystore files such as generated by the eth2 deposit CLI, to import",
+	}
+	// ExportDirFlag defines the directory the validator keys are exported to as EIP-2335 keystores.
+	ExportDirFlag = cli.StringFlag{
+		Name:  "export-dir",
+		Usage: "Directory the validator keys are exported to as EIP-2335 keystores",
+	}
+	// KeystorePasswordFlag defines the password of the EIP-2335 keystores being imported or exported.
+	KeystorePasswordFlag = cli.StringFlag{
+		Name:  "keystore-password",
+		Usage: "Password of the EIP-2335 keystores being imported or exported",
+	}
+	// ExitPublicKeyFlag defines the public key of the validator to exit.
+	ExitPublicKeyFlag = cli.StringFlag{
+		Name:  "public-key",
+		Usage: "Hex encoded public key of the validator to exit, required if the keystore holds more than one key",
+	}
+	// ForceExitFlag defines whether to exit the validator without asking for confirmation.
+	ForceExitFlag = cli.BoolFlag{
+		Name:  "force",
+		Usage: "Exit the validator without asking for confirmation, an exit cannot be reversed",
+	}
 	// DisablePenaltyRewardLogFlag defines the ability to not log reward/penalty information during deployment
 	DisablePenaltyRewardLogFlag = cli.BoolFlag{
 		Name:  "disable-rewards-penalties-logging",
@@ -57,6 +57,26 @@ func (mr *MockProposerServiceClientMockRecorder) ProposeBlock(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).ProposeBlock), varargs...)
 }
 
+// PublishSignedBlock mocks base method
+func (m *MockProposerServiceClient) PublishSignedBlock(arg0 context.Context, arg1 *v1.SignedBlockRequest, arg2 ...grpc.CallOption) (*v1.ProposeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishSignedBlock", varargs...)
+	ret0, _ := ret[0].(*v1.ProposeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishSignedBlock indicates an expected call of PublishSignedBlock
+func (mr *MockProposerServiceClientMockRecorder) PublishSignedBlock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishSignedBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).PublishSignedBlock), varargs...)
+}
+
 // RequestBlock mocks base method
 func (m *MockProposerServiceClient) RequestBlock(arg0 context.Context, arg1 *v1.BlockRequest, arg2 ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
 	m.ctrl.T.Helper()
@@ -76,3 +96,23 @@ func (mr *MockProposerServiceClientMockRecorder) RequestBlock(arg0, arg1 interfa
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).RequestBlock), varargs...)
 }
+
+// RequestUnsignedBlock mocks base method
+func (m *MockProposerServiceClient) RequestUnsignedBlock(arg0 context.Context, arg1 *v1.BlockRequest, arg2 ...grpc.CallOption) (*v1.UnsignedBlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestUnsignedBlock", varargs...)
+	ret0, _ := ret[0].(*v1.UnsignedBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestUnsignedBlock indicates an expected call of RequestUnsignedBlock
+func (mr *MockProposerServiceClientMockRecorder) RequestUnsignedBlock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUnsignedBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).RequestUnsignedBlock), varargs...)
+}
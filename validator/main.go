@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"runtime"
@@ -12,9 +13,12 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/sirupsen/logrus"
@@ -32,7 +36,10 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		logrus.Fatal(err)
 	}
-	if !exists {
+	if ctx.GlobalString(flags.RemoteSignerFlag.Name) != "" {
+		// The keys are held by the remote signer, no keystore is needed.
+		logrus.Info("Using a remote signer, no local keystore is read")
+	} else if !exists {
 		// If an account does not exist, we create a new one and start the node.
 		keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
 		if err != nil {
@@ -94,12 +101,117 @@ func createValidatorAccount(ctx *cli.Context) (string, string, error) {
 		}
 	}
 
-	if err := accounts.NewValidatorAccount(keystoreDirectory, keystorePassword); err != nil {
+	kdf, err := keystore.KDFByName(ctx.String(flags.KeystoreKDFFlag.Name))
+	if err != nil {
+		return "", "", err
+	}
+	if err := accounts.NewValidatorAccountWithKDF(keystoreDirectory, keystorePassword, kdf); err != nil {
 		return "", "", fmt.Errorf("could not initialize validator account: %v", err)
 	}
 	return keystoreDirectory, keystorePassword, nil
 }
 
+// readPassword returns the value of the password flag, or prompts for the password if
+// the flag is not set.
+func readPassword(ctx *cli.Context, flag cli.StringFlag, prompt string) (string, error) {
+	if password := ctx.String(flag.Name); password != "" {
+		return password, nil
+	}
+	logrus.Info(prompt)
+	bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return "", fmt.Errorf("could not read password: %v", err)
+	}
+	return strings.Replace(string(bytePassword), "\n", "", -1), nil
+}
+
+// importKeystores imports the EIP-2335 keystores at the import path into the validator
+// account.
+func importKeystores(ctx *cli.Context) error {
+	keystorePassword, err := readPassword(ctx, flags.KeystorePasswordFlag, "Enter the password of the keystores to import:")
+	if err != nil {
+		return err
+	}
+	password, err := readPassword(ctx, flags.PasswordFlag, "Enter your validator account password:")
+	if err != nil {
+		return err
+	}
+	kdf, err := keystore.KDFByName(ctx.String(flags.KeystoreKDFFlag.Name))
+	if err != nil {
+		return err
+	}
+	return accounts.ImportKeystores(ctx.String(flags.KeystorePathFlag.Name), password, ctx.String(flags.ImportPathFlag.Name), keystorePassword, kdf)
+}
+
+// exportKeystores exports the keys of the validator account as EIP-2335 keystores.
+func exportKeystores(ctx *cli.Context) error {
+	password, err := readPassword(ctx, flags.PasswordFlag, "Enter your validator account password:")
+	if err != nil {
+		return err
+	}
+	keystorePassword, err := readPassword(ctx, flags.KeystorePasswordFlag, "Enter a password for the exported keystores:")
+	if err != nil {
+		return err
+	}
+	// EIP-2335 keystores are encrypted with scrypt, at the standard cost unless a
+	// cheaper or stronger scrypt preset is requested.
+	kdf := keystore.StandardKDF
+	if ctx.IsSet(flags.KeystoreKDFFlag.Name) {
+		if kdf, err = keystore.KDFByName(ctx.String(flags.KeystoreKDFFlag.Name)); err != nil {
+			return err
+		}
+	}
+	return accounts.ExportKeystores(ctx.String(flags.KeystorePathFlag.Name), password, ctx.String(flags.ExportDirFlag.Name), keystorePassword, kdf)
+}
+
+// exitValidator submits the voluntary exit of a validator of the account to the beacon
+// node once the user confirmed it, and waits until the exit is included.
+func exitValidator(ctx *cli.Context) error {
+	password, err := readPassword(ctx, flags.PasswordFlag, "Enter your validator account password:")
+	if err != nil {
+		return err
+	}
+	keystoreDirectory := ctx.String(flags.KeystorePathFlag.Name)
+	keys, err := keystore.NewKeystore(keystoreDirectory).GetKeys(keystoreDirectory, params.BeaconConfig().ValidatorPrivkeyFileName, password)
+	if err != nil {
+		return fmt.Errorf("could not get private keys: %v", err)
+	}
+	pubKey := strings.TrimPrefix(ctx.String(flags.ExitPublicKeyFlag.Name), "0x")
+	if pubKey == "" {
+		if len(keys) != 1 {
+			return fmt.Errorf("found %d keys in the keystore, select the validator to exit with --%s", len(keys), flags.ExitPublicKeyFlag.Name)
+		}
+		for k := range keys {
+			pubKey = k
+		}
+	}
+	key, ok := keys[pubKey]
+	if !ok {
+		return fmt.Errorf("no key for public key %s in the keystore", pubKey)
+	}
+
+	if !ctx.Bool(flags.ForceExitFlag.Name) {
+		logrus.Warnf("An exit cannot be reversed, validator %s will stop earning rewards and "+
+			"can only withdraw its balance once withdrawals are enabled. Type \"yes\" to exit the validator:", pubKey)
+		text, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(text) != "yes" {
+			return fmt.Errorf("exit of validator %s not confirmed", pubKey)
+		}
+	}
+
+	return client.Exit(context.Background(), &client.ExitConfig{
+		Endpoint:       ctx.String(flags.BeaconRPCProviderFlag.Name),
+		CertFlag:       ctx.String(flags.CertFlag.Name),
+		ClientCertFlag: ctx.String(flags.ClientCertFlag.Name),
+		ClientKeyFlag:  ctx.String(flags.ClientKeyFlag.Name),
+		AuthToken:      ctx.String(flags.RPCAuthTokenFlag.Name),
+		Key:            key,
+	})
+}
+
 func main() {
 	log := logrus.WithField("prefix", "main")
 	app := cli.NewApp()
@@ -107,6 +219,9 @@ func main() {
 	app.Usage = `launches an Ethereum Serenity validator client that interacts with a beacon chain,
 				 starts proposer services, shardp2p connections, and more`
 	app.Version = version.GetVersion()
+	cli.VersionPrinter = func(ctx *cli.Context) {
+		fmt.Printf("%s\n%s\n", ctx.App.Name, version.GetBuildData())
+	}
 	app.Action = startNode
 	app.Commands = []cli.Command{
 		{
@@ -122,6 +237,7 @@ contract in order to activate the validator client`,
 					Flags: []cli.Flag{
 						flags.KeystorePathFlag,
 						flags.PasswordFlag,
+						flags.KeystoreKDFFlag,
 					},
 					Action: func(ctx *cli.Context) {
 						if keystoreDir, _, err := createValidatorAccount(ctx); err != nil {
@@ -129,6 +245,61 @@ contract in order to activate the validator client`,
 						}
 					},
 				},
+				cli.Command{
+					Name: "import",
+					Description: `imports EIP-2335 keystores, such as the keystores generated by the eth2 deposit CLI
+or exported by other clients, into the validator account keystore`,
+					Flags: []cli.Flag{
+						flags.KeystorePathFlag,
+						flags.PasswordFlag,
+						flags.KeystoreKDFFlag,
+						flags.ImportPathFlag,
+						flags.KeystorePasswordFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := importKeystores(ctx); err != nil {
+							logrus.Fatalf("Could not import keystores: %v", err)
+						}
+					},
+				},
+				cli.Command{
+					Name: "export",
+					Description: `exports the validator keys of the account as EIP-2335 keystores, which can be
+imported by other clients`,
+					Flags: []cli.Flag{
+						flags.KeystorePathFlag,
+						flags.PasswordFlag,
+						flags.KeystoreKDFFlag,
+						flags.ExportDirFlag,
+						flags.KeystorePasswordFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := exportKeystores(ctx); err != nil {
+							logrus.Fatalf("Could not export keystores: %v", err)
+						}
+					},
+				},
+				cli.Command{
+					Name: "exit",
+					Description: `signs a voluntary exit of a validator of the account, submits it to the beacon node
+and waits until it is included in the beacon chain. An exit cannot be reversed`,
+					Flags: []cli.Flag{
+						flags.KeystorePathFlag,
+						flags.PasswordFlag,
+						flags.ExitPublicKeyFlag,
+						flags.ForceExitFlag,
+						flags.BeaconRPCProviderFlag,
+						flags.CertFlag,
+						flags.ClientCertFlag,
+						flags.ClientKeyFlag,
+						flags.RPCAuthTokenFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						if err := exitValidator(ctx); err != nil {
+							logrus.Fatalf("Could not exit validator: %v", err)
+						}
+					},
+				},
 			},
 		},
 	}
@@ -136,17 +307,35 @@ contract in order to activate the validator client`,
 		flags.NoCustomConfigFlag,
 		flags.BeaconRPCProviderFlag,
 		flags.CertFlag,
+		flags.ClientCertFlag,
+		flags.ClientKeyFlag,
+		flags.RPCAuthTokenFlag,
+		flags.RemoteSignerFlag,
+		flags.RemoteSignerCertFlag,
+		flags.RemoteSignerClientCertFlag,
+		flags.RemoteSignerClientKeyFlag,
 		flags.KeystorePathFlag,
 		flags.PasswordFlag,
+		flags.KeystoreKDFFlag,
 		flags.DisablePenaltyRewardLogFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
+		cmd.ConfigFileFlag,
+		cmd.NetworkFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingProcessNameFlag,
+		cmd.TracingExporterFlag,
 		cmd.TracingEndpointFlag,
+		cmd.TracingUsernameFlag,
+		cmd.TracingPasswordFlag,
+		cmd.TracingHeadersFlag,
 		cmd.TraceSampleFractionFlag,
 		cmd.BootstrapNode,
 		cmd.MonitoringPortFlag,
+		cmd.NTPServersFlag,
+		cmd.ClockSkewThresholdFlag,
+		cmd.AdjustClockFlag,
+		cmd.ShutdownTimeoutFlag,
 		cmd.LogFormat,
 		debug.PProfFlag,
 		debug.PProfAddrFlag,
@@ -154,13 +343,33 @@ contract in order to activate the validator client`,
 		debug.MemProfileRateFlag,
 		debug.CPUProfileFlag,
 		debug.TraceFlag,
+		debug.ProfileDurationFlag,
 		cmd.LogFileName,
+		cmd.LogMaxSizeFlag,
+		cmd.LogMaxBackupsFlag,
+		cmd.LogMaxAgeFlag,
+		cmd.LogCompressFlag,
+		cmd.LogSyslogFlag,
+		cmd.LogHTTPEndpointFlag,
+		cmd.LogHTTPLabelsFlag,
 		cmd.EnableUPnPFlag,
 	}
 
 	app.Flags = append(app.Flags, featureconfig.ValidatorFlags...)
+	app.Commands = append(app.Commands, cmd.ConfigCommand(app.Flags))
+	app.Flags = append(app.Flags, cmd.DeprecatedFlags(featureconfig.DeprecatedValidatorFlags)...)
 
 	app.Before = func(ctx *cli.Context) error {
+		if err := cmd.LoadEnvVars(ctx, app.Flags); err != nil {
+			return err
+		}
+		if err := cmd.LoadConfigFile(ctx, app.Flags); err != nil {
+			return err
+		}
+		if err := cmd.ApplyDeprecatedFlags(ctx, featureconfig.DeprecatedValidatorFlags); err != nil {
+			return err
+		}
+
 		format := ctx.GlobalString(cmd.LogFormat.Name)
 		switch format {
 		case "text":
@@ -184,10 +393,27 @@ contract in order to activate the validator client`,
 
 		logFileName := ctx.GlobalString(cmd.LogFileName.Name)
 		if logFileName != "" {
-			if err := logutil.ConfigurePersistentLogging(logFileName); err != nil {
+			rotation := &logutil.RotationConfig{
+				MaxSizeMB:  ctx.GlobalInt(cmd.LogMaxSizeFlag.Name),
+				MaxBackups: ctx.GlobalInt(cmd.LogMaxBackupsFlag.Name),
+				MaxAgeDays: ctx.GlobalInt(cmd.LogMaxAgeFlag.Name),
+				Compress:   ctx.GlobalBool(cmd.LogCompressFlag.Name),
+			}
+			if err := logutil.ConfigurePersistentLogging(logFileName, rotation); err != nil {
 				log.WithError(err).Error("Failed to configuring logging to disk.")
 			}
 		}
+		if address := ctx.GlobalString(cmd.LogSyslogFlag.Name); address != "" {
+			if err := logutil.ConfigureSyslog(address, "validator"); err != nil {
+				log.WithError(err).Error("Failed to configure logging to syslog")
+			}
+		}
+		if endpoint := ctx.GlobalString(cmd.LogHTTPEndpointFlag.Name); endpoint != "" {
+			labels := ctx.GlobalStringSlice(cmd.LogHTTPLabelsFlag.Name)
+			if err := logutil.ConfigureHTTPLogging(endpoint, "validator", labels); err != nil {
+				log.WithError(err).Error("Failed to configure logging to HTTP endpoint")
+			}
+		}
 
 		runtime.GOMAXPROCS(runtime.NumCPU())
 		return debug.Setup(ctx)
@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
+	"strings"
 	"sync"
 	"syscall"
 
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -19,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -26,39 +30,54 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+const slashingProtectionDBName = "slashingprotection"
+
 // ValidatorClient defines an instance of a sharding validator that manages
 // the entire lifecycle of services attached to it participating in
 // Ethereum Serenity.
 type ValidatorClient struct {
 	ctx      *cli.Context
 	services *shared.ServiceRegistry // Lifecycle and service store.
+	db       *db.ValidatorDB
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
 }
 
 // NewValidatorClient creates a new, Ethereum Serenity validator client.
 func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, error) {
-	if err := tracing.Setup(
-		"validator", // service name
-		ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
-		ctx.GlobalString(cmd.TracingEndpointFlag.Name),
-		ctx.GlobalFloat64(cmd.TraceSampleFractionFlag.Name),
-		ctx.GlobalBool(cmd.EnableTracingFlag.Name),
-	); err != nil {
+	if err := tracing.Setup(&tracing.Config{
+		ServiceName:    "validator",
+		ProcessName:    ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
+		Exporter:       ctx.GlobalString(cmd.TracingExporterFlag.Name),
+		Endpoint:       ctx.GlobalString(cmd.TracingEndpointFlag.Name),
+		Username:       ctx.GlobalString(cmd.TracingUsernameFlag.Name),
+		Password:       ctx.GlobalString(cmd.TracingPasswordFlag.Name),
+		Headers:        ctx.GlobalStringSlice(cmd.TracingHeadersFlag.Name),
+		SampleFraction: ctx.GlobalFloat64(cmd.TraceSampleFractionFlag.Name),
+		Enable:         ctx.GlobalBool(cmd.EnableTracingFlag.Name),
+	}); err != nil {
 		return nil, err
 	}
 	registry := shared.NewServiceRegistry()
+	registry.SetStopTimeout(ctx.GlobalDuration(cmd.ShutdownTimeoutFlag.Name))
 	ValidatorClient := &ValidatorClient{
 		ctx:      ctx,
 		services: registry,
 		stop:     make(chan struct{}),
 	}
 
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
-		log.Info("Using custom parameter configuration")
-		params.UseDemoBeaconConfig()
+	// Use the testnet preset unless a network is given or the --no-custom-config flag is set.
+	network := ctx.GlobalString(cmd.NetworkFlag.Name)
+	if network == "" {
+		network = params.TestnetPreset
+		if ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
+			network = params.MainnetPreset
+		}
+	}
+	if err := params.UsePreset(network); err != nil {
+		return nil, err
 	}
+	log.WithField("network", network).Info("Using network preset")
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
@@ -66,6 +85,14 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 		return nil, err
 	}
 
+	if err := ValidatorClient.registerClockCheck(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := ValidatorClient.startDB(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := ValidatorClient.registerClientService(ctx, password); err != nil {
 		return nil, err
 	}
@@ -73,6 +100,13 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 	return ValidatorClient, nil
 }
 
+// SlashingProtectionDBPath returns the path of the slashing protection database of a client
+// with the data directory. The validator client has no db reset command, so that the
+// signing history of the validator keys is never deleted.
+func SlashingProtectionDBPath(dataDir string) string {
+	return path.Join(dataDir, slashingProtectionDBName)
+}
+
 // Start every service in the validator client.
 func (s *ValidatorClient) Start() {
 	s.lock.Lock()
@@ -86,6 +120,12 @@ func (s *ValidatorClient) Start() {
 	stop := s.stop
 	s.lock.Unlock()
 
+	go debug.CaptureProfilesOnSignal(
+		stop,
+		s.ctx.GlobalString(cmd.DataDirFlag.Name),
+		s.ctx.GlobalDuration(debug.ProfileDurationFlag.Name),
+	)
+
 	go func() {
 		sigc := make(chan os.Signal, 1)
 		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
@@ -113,11 +153,26 @@ func (s *ValidatorClient) Close() {
 	defer s.lock.Unlock()
 
 	s.services.StopAll()
+	tracing.Stop()
 	log.Info("Stopping sharding validator")
 
 	close(s.stop)
 }
 
+func (s *ValidatorClient) startDB(ctx *cli.Context) error {
+	dbPath := SlashingProtectionDBPath(ctx.GlobalString(cmd.DataDirFlag.Name))
+	db, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+
+	log.WithField("path", dbPath).Info("Checking slashing protection db")
+	s.db = db
+	// The database is closed once every service using it is stopped.
+	s.services.RegisterCloser("database", db.Close)
+	return nil
+}
+
 func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -127,17 +182,45 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	return s.services.RegisterService(service)
 }
 
+func (s *ValidatorClient) registerClockCheck(ctx *cli.Context) error {
+	servers := ctx.GlobalString(cmd.NTPServersFlag.Name)
+	if servers == "" {
+		return nil
+	}
+	clock := clockutil.NewService(context.Background(), &clockutil.Config{
+		Servers:   strings.Split(servers, ","),
+		Threshold: ctx.GlobalDuration(cmd.ClockSkewThresholdFlag.Name),
+		Adjust:    ctx.GlobalBool(cmd.AdjustClockFlag.Name),
+	})
+	return s.services.RegisterService(clock)
+}
+
 func (s *ValidatorClient) registerClientService(ctx *cli.Context, password string) error {
 	endpoint := ctx.GlobalString(flags.BeaconRPCProviderFlag.Name)
 	keystoreDirectory := ctx.GlobalString(flags.KeystorePathFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
+	clientCert := ctx.GlobalString(flags.ClientCertFlag.Name)
+	clientKey := ctx.GlobalString(flags.ClientKeyFlag.Name)
+	authToken := ctx.GlobalString(flags.RPCAuthTokenFlag.Name)
+	remoteSigner := ctx.GlobalString(flags.RemoteSignerFlag.Name)
+	remoteSignerCert := ctx.GlobalString(flags.RemoteSignerCertFlag.Name)
+	remoteSignerClientCert := ctx.GlobalString(flags.RemoteSignerClientCertFlag.Name)
+	remoteSignerClientKey := ctx.GlobalString(flags.RemoteSignerClientKeyFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:             endpoint,
-		KeystorePath:         keystoreDirectory,
-		Password:             password,
-		LogValidatorBalances: logValidatorBalances,
-		CertFlag:             cert,
+		Endpoint:               endpoint,
+		KeystorePath:           keystoreDirectory,
+		Password:               password,
+		LogValidatorBalances:   logValidatorBalances,
+		CertFlag:               cert,
+		ClientCertFlag:         clientCert,
+		ClientKeyFlag:          clientKey,
+		AuthToken:              authToken,
+		RemoteSigner:           remoteSigner,
+		RemoteSignerCert:       remoteSignerCert,
+		RemoteSignerClientCert: remoteSignerClientCert,
+		RemoteSignerClientKey:  remoteSignerClientKey,
+		ValidatorDB:            s.db,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
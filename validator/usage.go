@@ -46,12 +46,22 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
+			cmd.ConfigFileFlag,
+			cmd.NetworkFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
+			cmd.TracingExporterFlag,
 			cmd.TracingEndpointFlag,
+			cmd.TracingUsernameFlag,
+			cmd.TracingPasswordFlag,
+			cmd.TracingHeadersFlag,
 			cmd.TraceSampleFractionFlag,
 			cmd.BootstrapNode,
 			cmd.MonitoringPortFlag,
+			cmd.NTPServersFlag,
+			cmd.ClockSkewThresholdFlag,
+			cmd.AdjustClockFlag,
+			cmd.ShutdownTimeoutFlag,
 		},
 	},
 	{
@@ -63,6 +73,7 @@ var appHelpFlagGroups = []flagGroup{
 			debug.MemProfileRateFlag,
 			debug.CPUProfileFlag,
 			debug.TraceFlag,
+			debug.ProfileDurationFlag,
 		},
 	},
 	{
@@ -71,8 +82,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.NoCustomConfigFlag,
 			flags.BeaconRPCProviderFlag,
 			flags.CertFlag,
+			flags.ClientCertFlag,
+			flags.ClientKeyFlag,
+			flags.RPCAuthTokenFlag,
+			flags.RemoteSignerFlag,
+			flags.RemoteSignerCertFlag,
+			flags.RemoteSignerClientCertFlag,
+			flags.RemoteSignerClientKeyFlag,
 			flags.KeystorePathFlag,
 			flags.PasswordFlag,
+			flags.KeystoreKDFFlag,
 			flags.DisablePenaltyRewardLogFlag,
 		},
 	},